@@ -27,6 +27,7 @@ import (
 	"github.com/wundergraph/wundergraph/pkg/codegeneration"
 	"github.com/wundergraph/wundergraph/pkg/cui"
 	"github.com/wundergraph/wundergraph/pkg/files"
+	"github.com/wundergraph/wundergraph/pkg/hooks"
 	"github.com/wundergraph/wundergraph/pkg/licensing"
 	"github.com/wundergraph/wundergraph/pkg/logging"
 	"github.com/wundergraph/wundergraph/pkg/node"
@@ -60,6 +61,7 @@ type cuiHandler struct {
 	wunderGraphDir    string
 	runtimeReloadChan chan<- struct{}
 	configBundler     *bundler.Bundler
+	hooksInspector    *hooks.Inspector
 	log               *zap.Logger
 }
 
@@ -79,6 +81,10 @@ func (h *cuiHandler) ClearCache() {
 	}
 }
 
+func (h *cuiHandler) HookInvocations() []hooks.HookInvocation {
+	return h.hooksInspector.Invocations()
+}
+
 func (h *cuiHandler) RebuildAndRestartApp() {
 	// This might not be available because it's initialized later
 	if h.configBundler != nil {
@@ -174,6 +180,9 @@ var upCmd = &cobra.Command{
 			enableTUI = false
 		}
 
+		// Records the latest hooks sent by the node, to inspect them from the console UI
+		hooksInspector := hooks.NewInspector(0)
+
 		var consoleUI *cui.UI
 		var consoleHandler *cuiHandler
 
@@ -183,6 +192,7 @@ var upCmd = &cobra.Command{
 				cancel:            cancel,
 				runtimeReloadChan: configFileChangeChan,
 				wunderGraphDir:    wunderGraphDir,
+				hooksInspector:    hooksInspector,
 				log:               log,
 			}
 
@@ -635,6 +645,7 @@ var upCmd = &cobra.Command{
 				node.WithTraceBatchTimeout(1000 * time.Millisecond),
 				node.WithDevMode(),
 				node.WithNATSDefaultServerURL(natsEmbeddedServerURL),
+				node.WithHooksInspector(hooksInspector),
 			}
 
 			options = append(options, node.WithServerConfigLoadHandler(func(config *node.WunderNodeConfig) {
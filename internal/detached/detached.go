@@ -0,0 +1,29 @@
+// Package detached provides contexts that outlive the request they were
+// created from, like context.WithoutCancel in Go 1.21
+package detached
+
+import (
+	"context"
+	"time"
+)
+
+type detachedContext struct {
+	parent context.Context
+}
+
+func (c detachedContext) Deadline() (time.Time, bool)       { return time.Time{}, false }
+func (c detachedContext) Done() <-chan struct{}             { return nil }
+func (c detachedContext) Err() error                        { return nil }
+func (c detachedContext) Value(key interface{}) interface{} { return c.parent.Value(key) }
+
+// Context returns a context with the values of parent, which is never
+// canceled and has no deadline
+func Context(parent context.Context) context.Context {
+	return detachedContext{parent: parent}
+}
+
+// WithTimeout returns a context with the values of parent, which is only
+// canceled once the timeout elapses or cancel is called
+func WithTimeout(parent context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(Context(parent), timeout)
+}
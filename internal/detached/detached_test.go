@@ -0,0 +1,27 @@
+package detached
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type key struct{}
+
+func TestContext(t *testing.T) {
+	parent, cancel := context.WithTimeout(context.WithValue(context.Background(), key{}, "value"), time.Millisecond)
+	cancel()
+
+	ctx, cancel := WithTimeout(parent, time.Minute)
+	defer cancel()
+	assert.NoError(t, ctx.Err())
+	assert.Equal(t, "value", ctx.Value(key{}))
+	deadline, ok := ctx.Deadline()
+	assert.True(t, ok)
+	assert.True(t, deadline.After(time.Now().Add(time.Second)))
+
+	cancel()
+	assert.ErrorIs(t, ctx.Err(), context.Canceled)
+}
@@ -7,6 +7,8 @@ OUT_DIR="./wgpb"
 
 mkdir -p ${OUT_DIR}
 
+# hooks.proto describes the gRPC hooks transport, which is implemented by hooks
+# servers in other languages and isn't used by the TypeScript packages
 protoc  \
   --plugin="${PROTOC_GEN_TS_PROTO_PATH}" \
   --proto_path=../../types/protos \
@@ -18,4 +20,4 @@ protoc  \
   --ts_proto_opt=outputJsonMethods=true \
   --ts_proto_opt=outputClientImpl=false \
   --ts_proto_out="${OUT_DIR}" \
-  ../../types/protos/*.proto
+  ../../types/protos/wundernode_config.proto
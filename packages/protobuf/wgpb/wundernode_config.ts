@@ -63,10 +63,59 @@ export function logLevelToJSON(object: LogLevel): string {
   }
 }
 
+export enum RoleMappingRuleKind {
+  RoleMappingClaimEquals = 0,
+  RoleMappingClaimContains = 1,
+  RoleMappingEmailDomain = 2,
+  RoleMappingGithubOrganization = 3,
+  RoleMappingGithubTeam = 4,
+}
+
+export function roleMappingRuleKindFromJSON(object: any): RoleMappingRuleKind {
+  switch (object) {
+    case 0:
+    case "RoleMappingClaimEquals":
+      return RoleMappingRuleKind.RoleMappingClaimEquals;
+    case 1:
+    case "RoleMappingClaimContains":
+      return RoleMappingRuleKind.RoleMappingClaimContains;
+    case 2:
+    case "RoleMappingEmailDomain":
+      return RoleMappingRuleKind.RoleMappingEmailDomain;
+    case 3:
+    case "RoleMappingGithubOrganization":
+      return RoleMappingRuleKind.RoleMappingGithubOrganization;
+    case 4:
+    case "RoleMappingGithubTeam":
+      return RoleMappingRuleKind.RoleMappingGithubTeam;
+    default:
+      throw new tsProtoGlobalThis.Error("Unrecognized enum value " + object + " for enum RoleMappingRuleKind");
+  }
+}
+
+export function roleMappingRuleKindToJSON(object: RoleMappingRuleKind): string {
+  switch (object) {
+    case RoleMappingRuleKind.RoleMappingClaimEquals:
+      return "RoleMappingClaimEquals";
+    case RoleMappingRuleKind.RoleMappingClaimContains:
+      return "RoleMappingClaimContains";
+    case RoleMappingRuleKind.RoleMappingEmailDomain:
+      return "RoleMappingEmailDomain";
+    case RoleMappingRuleKind.RoleMappingGithubOrganization:
+      return "RoleMappingGithubOrganization";
+    case RoleMappingRuleKind.RoleMappingGithubTeam:
+      return "RoleMappingGithubTeam";
+    default:
+      throw new tsProtoGlobalThis.Error("Unrecognized enum value " + object + " for enum RoleMappingRuleKind");
+  }
+}
+
 export enum AuthProviderKind {
   AuthProviderGithub = 0,
   AuthProviderOIDC = 1,
   AuthProviderAuth0 = 2,
+  AuthProviderOAuth2 = 3,
+  AuthProviderSAML = 4,
 }
 
 export function authProviderKindFromJSON(object: any): AuthProviderKind {
@@ -80,6 +129,12 @@ export function authProviderKindFromJSON(object: any): AuthProviderKind {
     case 2:
     case "AuthProviderAuth0":
       return AuthProviderKind.AuthProviderAuth0;
+    case 3:
+    case "AuthProviderOAuth2":
+      return AuthProviderKind.AuthProviderOAuth2;
+    case 4:
+    case "AuthProviderSAML":
+      return AuthProviderKind.AuthProviderSAML;
     default:
       throw new tsProtoGlobalThis.Error("Unrecognized enum value " + object + " for enum AuthProviderKind");
   }
@@ -93,6 +148,10 @@ export function authProviderKindToJSON(object: AuthProviderKind): string {
       return "AuthProviderOIDC";
     case AuthProviderKind.AuthProviderAuth0:
       return "AuthProviderAuth0";
+    case AuthProviderKind.AuthProviderOAuth2:
+      return "AuthProviderOAuth2";
+    case AuthProviderKind.AuthProviderSAML:
+      return "AuthProviderSAML";
     default:
       throw new tsProtoGlobalThis.Error("Unrecognized enum value " + object + " for enum AuthProviderKind");
   }
@@ -191,6 +250,10 @@ export function injectVariableKindToJSON(object: InjectVariableKind): string {
 
 export enum HookType {
   HTTP_TRANSPORT = 0,
+  /** HTTP_ORIGIN_REQUEST - Restricts the global onOriginRequest hook to the matching requests */
+  HTTP_ORIGIN_REQUEST = 1,
+  /** HTTP_ORIGIN_RESPONSE - Restricts the global onOriginResponse hook to the matching requests */
+  HTTP_ORIGIN_RESPONSE = 2,
 }
 
 export function hookTypeFromJSON(object: any): HookType {
@@ -198,6 +261,12 @@ export function hookTypeFromJSON(object: any): HookType {
     case 0:
     case "HTTP_TRANSPORT":
       return HookType.HTTP_TRANSPORT;
+    case 1:
+    case "HTTP_ORIGIN_REQUEST":
+      return HookType.HTTP_ORIGIN_REQUEST;
+    case 2:
+    case "HTTP_ORIGIN_RESPONSE":
+      return HookType.HTTP_ORIGIN_RESPONSE;
     default:
       throw new tsProtoGlobalThis.Error("Unrecognized enum value " + object + " for enum HookType");
   }
@@ -207,11 +276,64 @@ export function hookTypeToJSON(object: HookType): string {
   switch (object) {
     case HookType.HTTP_TRANSPORT:
       return "HTTP_TRANSPORT";
+    case HookType.HTTP_ORIGIN_REQUEST:
+      return "HTTP_ORIGIN_REQUEST";
+    case HookType.HTTP_ORIGIN_RESPONSE:
+      return "HTTP_ORIGIN_RESPONSE";
     default:
       throw new tsProtoGlobalThis.Error("Unrecognized enum value " + object + " for enum HookType");
   }
 }
 
+export enum ExpressionHookAction {
+  ExpressionHookSetHeader = 0,
+  ExpressionHookSetVariable = 1,
+  ExpressionHookReject = 2,
+  /** ExpressionHookSkip - Drop a subscription message */
+  ExpressionHookSkip = 3,
+  /** ExpressionHookSetEventField - Set a field of a subscription message */
+  ExpressionHookSetEventField = 4,
+}
+
+export function expressionHookActionFromJSON(object: any): ExpressionHookAction {
+  switch (object) {
+    case 0:
+    case "ExpressionHookSetHeader":
+      return ExpressionHookAction.ExpressionHookSetHeader;
+    case 1:
+    case "ExpressionHookSetVariable":
+      return ExpressionHookAction.ExpressionHookSetVariable;
+    case 2:
+    case "ExpressionHookReject":
+      return ExpressionHookAction.ExpressionHookReject;
+    case 3:
+    case "ExpressionHookSkip":
+      return ExpressionHookAction.ExpressionHookSkip;
+    case 4:
+    case "ExpressionHookSetEventField":
+      return ExpressionHookAction.ExpressionHookSetEventField;
+    default:
+      throw new tsProtoGlobalThis.Error("Unrecognized enum value " + object + " for enum ExpressionHookAction");
+  }
+}
+
+export function expressionHookActionToJSON(object: ExpressionHookAction): string {
+  switch (object) {
+    case ExpressionHookAction.ExpressionHookSetHeader:
+      return "ExpressionHookSetHeader";
+    case ExpressionHookAction.ExpressionHookSetVariable:
+      return "ExpressionHookSetVariable";
+    case ExpressionHookAction.ExpressionHookReject:
+      return "ExpressionHookReject";
+    case ExpressionHookAction.ExpressionHookSkip:
+      return "ExpressionHookSkip";
+    case ExpressionHookAction.ExpressionHookSetEventField:
+      return "ExpressionHookSetEventField";
+    default:
+      throw new tsProtoGlobalThis.Error("Unrecognized enum value " + object + " for enum ExpressionHookAction");
+  }
+}
+
 export enum ClaimType {
   /** ISSUER - iss */
   ISSUER = 0,
@@ -474,6 +596,7 @@ export enum DataSourceKind {
   SQLITE = 7,
   PRISMA = 8,
   NATSKV = 9,
+  WEBHOOK_EVENTS = 10,
 }
 
 export function dataSourceKindFromJSON(object: any): DataSourceKind {
@@ -508,6 +631,9 @@ export function dataSourceKindFromJSON(object: any): DataSourceKind {
     case 9:
     case "NATSKV":
       return DataSourceKind.NATSKV;
+    case 10:
+    case "WEBHOOK_EVENTS":
+      return DataSourceKind.WEBHOOK_EVENTS;
     default:
       throw new tsProtoGlobalThis.Error("Unrecognized enum value " + object + " for enum DataSourceKind");
   }
@@ -535,6 +661,8 @@ export function dataSourceKindToJSON(object: DataSourceKind): string {
       return "PRISMA";
     case DataSourceKind.NATSKV:
       return "NATSKV";
+    case DataSourceKind.WEBHOOK_EVENTS:
+      return "WEBHOOK_EVENTS";
     default:
       throw new tsProtoGlobalThis.Error("Unrecognized enum value " + object + " for enum DataSourceKind");
   }
@@ -786,8 +914,233 @@ export function argumentRenderConfigurationToJSON(object: ArgumentRenderConfigur
   }
 }
 
+export enum ImageFit {
+  ImageFitContain = 0,
+  ImageFitCover = 1,
+  ImageFitFill = 2,
+}
+
+export function imageFitFromJSON(object: any): ImageFit {
+  switch (object) {
+    case 0:
+    case "ImageFitContain":
+      return ImageFit.ImageFitContain;
+    case 1:
+    case "ImageFitCover":
+      return ImageFit.ImageFitCover;
+    case 2:
+    case "ImageFitFill":
+      return ImageFit.ImageFitFill;
+    default:
+      throw new tsProtoGlobalThis.Error("Unrecognized enum value " + object + " for enum ImageFit");
+  }
+}
+
+export function imageFitToJSON(object: ImageFit): string {
+  switch (object) {
+    case ImageFit.ImageFitContain:
+      return "ImageFitContain";
+    case ImageFit.ImageFitCover:
+      return "ImageFitCover";
+    case ImageFit.ImageFitFill:
+      return "ImageFitFill";
+    default:
+      throw new tsProtoGlobalThis.Error("Unrecognized enum value " + object + " for enum ImageFit");
+  }
+}
+
+export enum ImageFormat {
+  ImageFormatOriginal = 0,
+  ImageFormatJPEG = 1,
+  ImageFormatPNG = 2,
+  ImageFormatWebP = 3,
+}
+
+export function imageFormatFromJSON(object: any): ImageFormat {
+  switch (object) {
+    case 0:
+    case "ImageFormatOriginal":
+      return ImageFormat.ImageFormatOriginal;
+    case 1:
+    case "ImageFormatJPEG":
+      return ImageFormat.ImageFormatJPEG;
+    case 2:
+    case "ImageFormatPNG":
+      return ImageFormat.ImageFormatPNG;
+    case 3:
+    case "ImageFormatWebP":
+      return ImageFormat.ImageFormatWebP;
+    default:
+      throw new tsProtoGlobalThis.Error("Unrecognized enum value " + object + " for enum ImageFormat");
+  }
+}
+
+export function imageFormatToJSON(object: ImageFormat): string {
+  switch (object) {
+    case ImageFormat.ImageFormatOriginal:
+      return "ImageFormatOriginal";
+    case ImageFormat.ImageFormatJPEG:
+      return "ImageFormatJPEG";
+    case ImageFormat.ImageFormatPNG:
+      return "ImageFormatPNG";
+    case ImageFormat.ImageFormatWebP:
+      return "ImageFormatWebP";
+    default:
+      throw new tsProtoGlobalThis.Error("Unrecognized enum value " + object + " for enum ImageFormat");
+  }
+}
+
+export enum UploadStorageKind {
+  UploadStorageS3 = 0,
+  UploadStorageLocal = 1,
+  UploadStorageGCS = 2,
+  UploadStorageAzure = 3,
+}
+
+export function uploadStorageKindFromJSON(object: any): UploadStorageKind {
+  switch (object) {
+    case 0:
+    case "UploadStorageS3":
+      return UploadStorageKind.UploadStorageS3;
+    case 1:
+    case "UploadStorageLocal":
+      return UploadStorageKind.UploadStorageLocal;
+    case 2:
+    case "UploadStorageGCS":
+      return UploadStorageKind.UploadStorageGCS;
+    case 3:
+    case "UploadStorageAzure":
+      return UploadStorageKind.UploadStorageAzure;
+    default:
+      throw new tsProtoGlobalThis.Error("Unrecognized enum value " + object + " for enum UploadStorageKind");
+  }
+}
+
+export function uploadStorageKindToJSON(object: UploadStorageKind): string {
+  switch (object) {
+    case UploadStorageKind.UploadStorageS3:
+      return "UploadStorageS3";
+    case UploadStorageKind.UploadStorageLocal:
+      return "UploadStorageLocal";
+    case UploadStorageKind.UploadStorageGCS:
+      return "UploadStorageGCS";
+    case UploadStorageKind.UploadStorageAzure:
+      return "UploadStorageAzure";
+    default:
+      throw new tsProtoGlobalThis.Error("Unrecognized enum value " + object + " for enum UploadStorageKind");
+  }
+}
+
+export enum WebhookQueueKind {
+  WebhookQueueFile = 0,
+  WebhookQueueNats = 1,
+}
+
+export function webhookQueueKindFromJSON(object: any): WebhookQueueKind {
+  switch (object) {
+    case 0:
+    case "WebhookQueueFile":
+      return WebhookQueueKind.WebhookQueueFile;
+    case 1:
+    case "WebhookQueueNats":
+      return WebhookQueueKind.WebhookQueueNats;
+    default:
+      throw new tsProtoGlobalThis.Error("Unrecognized enum value " + object + " for enum WebhookQueueKind");
+  }
+}
+
+export function webhookQueueKindToJSON(object: WebhookQueueKind): string {
+  switch (object) {
+    case WebhookQueueKind.WebhookQueueFile:
+      return "WebhookQueueFile";
+    case WebhookQueueKind.WebhookQueueNats:
+      return "WebhookQueueNats";
+    default:
+      throw new tsProtoGlobalThis.Error("Unrecognized enum value " + object + " for enum WebhookQueueKind");
+  }
+}
+
+export enum HookFailureMode {
+  /** HookFailClosed - Fail the request when the hook fails */
+  HookFailClosed = 0,
+  /** HookFailOpen - Continue with the original data, as if the hook didn't modify it */
+  HookFailOpen = 1,
+  /** HookFailFallback - Use fallbackResponse as the response of the hook */
+  HookFailFallback = 2,
+}
+
+export function hookFailureModeFromJSON(object: any): HookFailureMode {
+  switch (object) {
+    case 0:
+    case "HookFailClosed":
+      return HookFailureMode.HookFailClosed;
+    case 1:
+    case "HookFailOpen":
+      return HookFailureMode.HookFailOpen;
+    case 2:
+    case "HookFailFallback":
+      return HookFailureMode.HookFailFallback;
+    default:
+      throw new tsProtoGlobalThis.Error("Unrecognized enum value " + object + " for enum HookFailureMode");
+  }
+}
+
+export function hookFailureModeToJSON(object: HookFailureMode): string {
+  switch (object) {
+    case HookFailureMode.HookFailClosed:
+      return "HookFailClosed";
+    case HookFailureMode.HookFailOpen:
+      return "HookFailOpen";
+    case HookFailureMode.HookFailFallback:
+      return "HookFailFallback";
+    default:
+      throw new tsProtoGlobalThis.Error("Unrecognized enum value " + object + " for enum HookFailureMode");
+  }
+}
+
+export enum HooksLoadBalancing {
+  HooksLoadBalancingRoundRobin = 0,
+  /** HooksLoadBalancingLeastOutstanding - Send each hook to the server with the fewest requests in flight */
+  HooksLoadBalancingLeastOutstanding = 1,
+}
+
+export function hooksLoadBalancingFromJSON(object: any): HooksLoadBalancing {
+  switch (object) {
+    case 0:
+    case "HooksLoadBalancingRoundRobin":
+      return HooksLoadBalancing.HooksLoadBalancingRoundRobin;
+    case 1:
+    case "HooksLoadBalancingLeastOutstanding":
+      return HooksLoadBalancing.HooksLoadBalancingLeastOutstanding;
+    default:
+      throw new tsProtoGlobalThis.Error("Unrecognized enum value " + object + " for enum HooksLoadBalancing");
+  }
+}
+
+export function hooksLoadBalancingToJSON(object: HooksLoadBalancing): string {
+  switch (object) {
+    case HooksLoadBalancing.HooksLoadBalancingRoundRobin:
+      return "HooksLoadBalancingRoundRobin";
+    case HooksLoadBalancing.HooksLoadBalancingLeastOutstanding:
+      return "HooksLoadBalancingLeastOutstanding";
+    default:
+      throw new tsProtoGlobalThis.Error("Unrecognized enum value " + object + " for enum HooksLoadBalancing");
+  }
+}
+
 export enum WebhookVerifierKind {
+  /** HMAC_SHA256 - Hex encoded HMAC-SHA256 of the body */
   HMAC_SHA256 = 0,
+  /** STRIPE - Stripe-Signature header with the HMAC-SHA256 of timestamp.body */
+  STRIPE = 1,
+  /** SLACK - X-Slack-Signature header with the HMAC-SHA256 of v0:timestamp:body */
+  SLACK = 2,
+  /** SVIX - Svix and Standard Webhooks signatures of id.timestamp.body */
+  SVIX = 3,
+  /** ED25519 - Ed25519 signature of the timestamp followed by the body */
+  ED25519 = 4,
+  /** RSA_SHA256 - RSASSA-PKCS1-v1_5 SHA-256 signature of the timestamp followed by the body */
+  RSA_SHA256 = 5,
 }
 
 export function webhookVerifierKindFromJSON(object: any): WebhookVerifierKind {
@@ -795,6 +1148,21 @@ export function webhookVerifierKindFromJSON(object: any): WebhookVerifierKind {
     case 0:
     case "HMAC_SHA256":
       return WebhookVerifierKind.HMAC_SHA256;
+    case 1:
+    case "STRIPE":
+      return WebhookVerifierKind.STRIPE;
+    case 2:
+    case "SLACK":
+      return WebhookVerifierKind.SLACK;
+    case 3:
+    case "SVIX":
+      return WebhookVerifierKind.SVIX;
+    case 4:
+    case "ED25519":
+      return WebhookVerifierKind.ED25519;
+    case 5:
+    case "RSA_SHA256":
+      return WebhookVerifierKind.RSA_SHA256;
     default:
       throw new tsProtoGlobalThis.Error("Unrecognized enum value " + object + " for enum WebhookVerifierKind");
   }
@@ -804,6 +1172,16 @@ export function webhookVerifierKindToJSON(object: WebhookVerifierKind): string {
   switch (object) {
     case WebhookVerifierKind.HMAC_SHA256:
       return "HMAC_SHA256";
+    case WebhookVerifierKind.STRIPE:
+      return "STRIPE";
+    case WebhookVerifierKind.SLACK:
+      return "SLACK";
+    case WebhookVerifierKind.SVIX:
+      return "SVIX";
+    case WebhookVerifierKind.ED25519:
+      return "ED25519";
+    case WebhookVerifierKind.RSA_SHA256:
+      return "RSA_SHA256";
     default:
       throw new tsProtoGlobalThis.Error("Unrecognized enum value " + object + " for enum WebhookVerifierKind");
   }
@@ -860,6 +1238,7 @@ export interface JwksAuthProvider {
   jwksJson: ConfigurationVariable | undefined;
   userInfoEndpoint: ConfigurationVariable | undefined;
   userInfoCacheTtlSeconds: number;
+  roleMappings: RoleMappingRule[];
 }
 
 export interface ApiAuthenticationHooks {
@@ -884,6 +1263,28 @@ export interface AuthProvider {
   kind: AuthProviderKind;
   githubConfig: GithubAuthProviderConfig | undefined;
   oidcConfig: OpenIDConnectAuthProviderConfig | undefined;
+  oauth2Config: OAuth2AuthProviderConfig | undefined;
+  samlConfig: SAMLAuthProviderConfig | undefined;
+  roleMappings: RoleMappingRule[];
+}
+
+/**
+ * RoleMappingRule assigns a role to the users matching it, without
+ * requiring a mutatingPostAuthentication hook
+ */
+export interface RoleMappingRule {
+  role: string;
+  kind: RoleMappingRuleKind;
+  /**
+   * claim is the claim name or a dot separated path to a custom claim,
+   * used by RoleMappingClaimEquals and RoleMappingClaimContains
+   */
+  claim: string;
+  /**
+   * value is the expected claim value, the email domain, the GitHub organization
+   * login or the GitHub team as organization/team-slug, depending on the kind
+   */
+  value: string;
 }
 
 export interface GithubAuthProviderConfig {
@@ -903,6 +1304,61 @@ export interface OpenIDConnectAuthProviderConfig {
   queryParameters: OpenIDConnectQueryParameter[];
 }
 
+export interface OAuth2AuthProviderConfig {
+  clientId: ConfigurationVariable | undefined;
+  clientSecret: ConfigurationVariable | undefined;
+  authorizationUrl: ConfigurationVariable | undefined;
+  tokenUrl: ConfigurationVariable | undefined;
+  userInfoUrl: ConfigurationVariable | undefined;
+  scopes: string[];
+  queryParameters: OpenIDConnectQueryParameter[];
+  /**
+   * claimMapping maps OpenID Connect claim names (e.g. sub, email, picture) to
+   * dot separated paths into the JSON object returned by userInfoUrl
+   */
+  claimMapping: { [key: string]: string };
+}
+
+export interface OAuth2AuthProviderConfig_ClaimMappingEntry {
+  key: string;
+  value: string;
+}
+
+export interface SAMLAuthProviderConfig {
+  /** either idpMetadataUrl or idpMetadataXml must be set */
+  idpMetadataUrl: ConfigurationVariable | undefined;
+  idpMetadataXml:
+    | ConfigurationVariable
+    | undefined;
+  /** entityId defaults to the URL of the service provider metadata */
+  entityId:
+    | ConfigurationVariable
+    | undefined;
+  /**
+   * PEM encoded certificate and RSA private key of the service provider,
+   * required for signing requests and decrypting assertions
+   */
+  certificate: ConfigurationVariable | undefined;
+  privateKey: ConfigurationVariable | undefined;
+  signRequests: boolean;
+  allowIdpInitiated: boolean;
+  nameIdFormat:
+    | ConfigurationVariable
+    | undefined;
+  /**
+   * attributeMapping maps OpenID Connect claim names (e.g. email, name) to
+   * SAML attribute names or friendly names
+   */
+  attributeMapping: { [key: string]: string };
+  /** rolesAttribute names the SAML attribute used to populate the user roles */
+  rolesAttribute: string;
+}
+
+export interface SAMLAuthProviderConfig_AttributeMappingEntry {
+  key: string;
+  value: string;
+}
+
 export interface Operation {
   name: string;
   content: string;
@@ -954,9 +1410,28 @@ export interface GraphQLDataSourceHooksConfiguration {
   onWSTransportConnectionInit: boolean;
 }
 
+export interface HeaderMatcher {
+  name: string;
+  /**
+   * Pattern for the header value, where * matches any characters including /
+   * and ? a single one. The header only needs to be present if it's empty
+   */
+  value: string;
+}
+
 export interface HookMatcher {
   operationType?: OperationType | undefined;
   datasources: string[];
+  /** Operation names or patterns, e.g. users/* or admin/** */
+  operationNames: string[];
+  /** HTTP methods of the request to the origin */
+  methods: string[];
+  /** Path patterns of the request to the origin */
+  paths: string[];
+  /** Headers of the request to the origin, all of them must match */
+  headers: HeaderMatcher[];
+  /** The user must have at least one of the roles */
+  roles: string[];
 }
 
 export interface Hook {
@@ -974,6 +1449,38 @@ export interface OperationHooksConfiguration {
   httpTransportOnRequest: boolean;
   httpTransportOnResponse: boolean;
   customResolve: boolean;
+  /** Expressions evaluated by the node before the preResolve hooks */
+  preResolveExpressions: ExpressionHook[];
+  /** Expressions evaluated by the node before the postResolve hooks */
+  postResolveExpressions: ExpressionHook[];
+  /** Run the onSubscriptionEvent hook for each message sent to a client */
+  onSubscriptionEvent: boolean;
+  /**
+   * Expressions evaluated by the node for each subscription message, before
+   * the onSubscriptionEvent hook
+   */
+  subscriptionEventExpressions: ExpressionHook[];
+}
+
+/** ExpressionHook is a CEL expression evaluated in-process by the node */
+export interface ExpressionHook {
+  action: ExpressionHookAction;
+  /** Optional boolean expression, the hook only runs when it evaluates to true */
+  condition: string;
+  /**
+   * Header name for ExpressionHookSetHeader, or dot separated path of the
+   * variable for ExpressionHookSetVariable or of the message field for
+   * ExpressionHookSetEventField
+   */
+  target: string;
+  /**
+   * Value for ExpressionHookSetHeader, ExpressionHookSetVariable and
+   * ExpressionHookSetEventField, or the error message for
+   * ExpressionHookReject
+   */
+  expression: string;
+  /** Status code used by ExpressionHookReject, defaults to 403 */
+  statusCode: number;
 }
 
 export interface MockResolveHookConfiguration {
@@ -1060,6 +1567,11 @@ export interface DataSourceConfiguration {
   requestTimeoutSeconds: number;
   id: string;
   customNatsKv: DataSourceCustomNatsKv | undefined;
+  customWebhookEvents:
+    | DataSourceCustomWebhookEvents
+    | undefined;
+  /** Expressions evaluated by the node before sending requests to the origin */
+  originRequestExpressions: ExpressionHook[];
 }
 
 export interface DirectiveConfiguration {
@@ -1076,6 +1588,15 @@ export interface DataSourceCustomNatsKv {
   bucketPrefix: ConfigurationVariable | undefined;
 }
 
+/**
+ * DataSourceCustom_WebhookEvents streams the payloads received by a
+ * webhook with publishEvents enabled to the subscriptions
+ */
+export interface DataSourceCustomWebhookEvents {
+  /** Name of the webhook */
+  webhook: string;
+}
+
 export interface DataSourceCustomREST {
   fetch: FetchConfiguration | undefined;
   subscription: RESTSubscriptionConfiguration | undefined;
@@ -1257,6 +1778,7 @@ export interface EnabledFeatures {
 export interface S3UploadProfileHooksConfiguration {
   preUpload: boolean;
   postUpload: boolean;
+  preDownload: boolean;
 }
 
 export interface S3UploadProfile {
@@ -1266,18 +1788,74 @@ export interface S3UploadProfile {
   allowedMimeTypes: string[];
   allowedFileExtensions: string[];
   metadataJSONSchema: string;
-  hooks: S3UploadProfileHooksConfiguration | undefined;
+  hooks:
+    | S3UploadProfileHooksConfiguration
+    | undefined;
+  /**
+   * Roles allowed to download and list files uploaded with this profile. If empty,
+   * any user allowed by requireAuthentication can download them
+   */
+  downloadRoles: string[];
+  /**
+   * Roles allowed to delete files uploaded with this profile. Users can always
+   * delete the files they uploaded
+   */
+  deleteRoles: string[];
+  /**
+   * Whether downloads are streamed through the node instead of redirecting
+   * to a presigned URL
+   */
+  proxyDownloads: boolean;
+  /** Images derived from the uploaded JPEG, PNG, GIF and WebP files */
+  imageTransformations: ImageTransformation[];
+  /**
+   * Whether the files uploaded with this profile can be listed, downloaded and
+   * deleted through the files endpoints of the provider
+   */
+  enableFiles: boolean;
+}
+
+export interface ImageTransformation {
+  /** Name of the transformation, appended to the key of the original file */
+  name: string;
+  /** Dimensions in pixels, if only one is set the other one keeps the aspect ratio */
+  width: number;
+  height: number;
+  fit: ImageFit;
+  format: ImageFormat;
+  /** JPEG quality between 1 and 100, 0 uses the default */
+  quality: number;
 }
 
 export interface S3UploadConfiguration {
   name: string;
-  endpoint: ConfigurationVariable | undefined;
-  accessKeyID: ConfigurationVariable | undefined;
-  secretAccessKey: ConfigurationVariable | undefined;
+  /** Optional for GCS and Azure, overrides their default endpoints */
+  endpoint:
+    | ConfigurationVariable
+    | undefined;
+  /** Account name for Azure */
+  accessKeyID:
+    | ConfigurationVariable
+    | undefined;
+  /** Account key for Azure */
+  secretAccessKey:
+    | ConfigurationVariable
+    | undefined;
+  /** Container name for Azure */
   bucketName: ConfigurationVariable | undefined;
   bucketLocation: ConfigurationVariable | undefined;
   useSSL: boolean;
   uploadProfiles: { [key: string]: S3UploadProfile };
+  storage: UploadStorageKind;
+  /** Directory for the local storage */
+  directory:
+    | ConfigurationVariable
+    | undefined;
+  /**
+   * Service account key for GCS, if empty the credentials are retrieved
+   * from the metadata server
+   */
+  credentialsJSON: ConfigurationVariable | undefined;
 }
 
 export interface S3UploadConfiguration_UploadProfilesEntry {
@@ -1298,6 +1876,7 @@ export interface UserDefinedApi {
   serverOptions: ServerOptions | undefined;
   nodeOptions: NodeOptions | undefined;
   experimentalConfig: ExperimentalConfiguration | undefined;
+  eventWebhooks: EventWebhookConfiguration[];
 }
 
 export interface ExperimentalConfiguration {
@@ -1333,6 +1912,21 @@ export interface NodeOptions {
   defaultHttpProxyUrl: ConfigurationVariable | undefined;
   openTelemetry: TelemetryOptions | undefined;
   prometheus: PrometheusOptions | undefined;
+  webhookQueue: WebhookQueueOptions | undefined;
+}
+
+export interface WebhookQueueOptions {
+  kind: WebhookQueueKind;
+  /**
+   * Directory used by the file queue, defaults to generated/webhook-queue
+   * inside the WunderGraph directory
+   */
+  directory:
+    | ConfigurationVariable
+    | undefined;
+  /** NATS server used by the JetStream queue, defaults to the embedded server */
+  natsUrl: ConfigurationVariable | undefined;
+  natsToken: ConfigurationVariable | undefined;
 }
 
 export interface TelemetryOptions {
@@ -1350,68 +1944,263 @@ export interface ServerOptions {
   serverUrl: ConfigurationVariable | undefined;
   listen: ListenerOptions | undefined;
   logger: ServerLogging | undefined;
-}
-
-export interface WebhookConfiguration {
-  /** Name of the webhook. */
-  name: string;
+  wasmHooks: WasmHooksOptions | undefined;
+  grpcHooks: GRPCHooksOptions | undefined;
+  serverPool:
+    | HooksServerPoolOptions
+    | undefined;
   /**
-   * The path to the bundled file.
-   * The path is relative to the bundle directory.
+   * Timeout, retries and failure handling for the hooks matching each
+   * policy, the first matching policy is used
    */
-  filePath: string;
-  verifier: WebhookVerifier | undefined;
-}
-
-export interface WebhookVerifier {
-  kind: WebhookVerifierKind;
-  secret: ConfigurationVariable | undefined;
-  signatureHeader: string;
-  signatureHeaderPrefix: string;
+  hookPolicies: HookPolicy[];
+  asyncHooks: AsyncHooksOptions | undefined;
 }
 
-export interface CorsConfiguration {
+export interface AsyncHooksOptions {
   /**
-   * AllowedOrigins is a list of origins a cross-domain request can be executed from.
-   * If the special "*" value is present in the list, all origins will be allowed.
-   * An origin may contain a wildcard (*) to replace 0 or more characters
-   * (i.e.: http://*.domain.com). Usage of wildcards implies a small performance penalty.
-   * Only one wildcard can be used per origin.
-   * Default value is ["*"]
+   * Patterns matching the hooks sent in the background without waiting
+   * for their response, e.g. operation/* /postResolve or
+   * authentication/postLogout. Only postResolve, postAuthentication,
+   * postLogout and postUpload hooks can be asynchronous.
    */
-  allowedOrigins: ConfigurationVariable[];
+  hooks: string[];
   /**
-   * AllowedMethods is a list of methods the client is allowed to use with
-   * cross-domain requests. Default value is simple methods (HEAD, GET and POST).
+   * Maximum number of queued hooks, hooks are dropped when the queue is
+   * full. Zero means 1000.
    */
-  allowedMethods: string[];
+  queueSize: number;
+  /** Number of hooks sent concurrently. Zero means 4. */
+  workers: number;
+  /** Store the queued hooks on disk, so they're sent after a restart */
+  durable: boolean;
   /**
-   * AllowedHeaders is list of non simple headers the client is allowed to use with
-   * cross-domain requests.
-   * If the special "*" value is present in the list, all headers will be allowed.
-   * Default value is [] but "Origin" is always appended to the list.
+   * Directory used by the durable queue, defaults to generated/hooks-queue
+   * inside the WunderGraph directory
    */
-  allowedHeaders: string[];
+  directory: ConfigurationVariable | undefined;
+}
+
+export interface HookPolicy {
   /**
-   * ExposedHeaders indicates which headers are safe to expose to the API of a CORS
-   * API specification
+   * Pattern matching the hook path, e.g. operation/* /postResolve or
+   * operation/Todos/**, with the same syntax as HooksServerRoute
    */
-  exposedHeaders: string[];
+  hook: string;
   /**
-   * MaxAge indicates how long (in seconds) the results of a preflight request
-   * can be cached
+   * Timeout for the hook including its retries in milliseconds. Zero
+   * means no timeout besides the 60s limit for each attempt.
    */
-  maxAge: number;
+  timeoutMs: number;
+  /** Retries after a failed attempt. Not set means 40. */
+  maxRetries?: number | undefined;
+  failureMode: HookFailureMode;
   /**
-   * AllowCredentials indicates whether the request can include user credentials like
-   * cookies, HTTP authentication or client side SSL certificates.
+   * JSON response of the hook used with HookFailFallback, e.g.
+   * {"response":{"data":null}}
    */
-  allowCredentials: boolean;
+  fallbackResponse: string;
 }
 
-export interface ConfigurationVariable {
-  kind: ConfigurationVariableKind;
-  staticVariableContent: string;
+export interface HooksServerPoolOptions {
+  /**
+   * Additional hooks servers, hooks are load balanced between serverUrl
+   * and these ones
+   */
+  serverUrls: ConfigurationVariable[];
+  loadBalancing: HooksLoadBalancing;
+  /** Interval between active health checks in seconds. Zero means 10. */
+  healthCheckIntervalSeconds: number;
+  /** Consecutive failed requests before a server is ejected. Zero means 5. */
+  maxFailures: number;
+  /** Time an ejected server stops receiving hooks in seconds. Zero means 30. */
+  ejectionSeconds: number;
+  /**
+   * Hooks matching a route are sent to its servers instead, the first
+   * matching route is used
+   */
+  routes: HooksServerRoute[];
+}
+
+export interface HooksServerRoute {
+  /**
+   * Pattern matching the hook path, e.g. operation/* /postResolve. A
+   * pattern ending in /** matches any path with that prefix, e.g.
+   * functions/**
+   */
+  hook: string;
+  serverUrls: ConfigurationVariable[];
+}
+
+export interface GRPCHooksOptions {
+  /**
+   * Address of the hooks server implementing the hookspb.Hooks service,
+   * e.g. localhost:9993. When set, hooks are sent using gRPC instead of
+   * HTTP.
+   */
+  address:
+    | ConfigurationVariable
+    | undefined;
+  /** Use TLS for the connection */
+  tls: boolean;
+}
+
+export interface WasmHooksOptions {
+  /**
+   * Path to the WebAssembly module implementing the hooks, relative to
+   * the WunderGraph directory. Hooks not implemented by the module are
+   * still sent to the hooks server.
+   */
+  modulePath:
+    | ConfigurationVariable
+    | undefined;
+  /** Memory limit for each hook execution in megabytes. Zero means 16. */
+  maxMemoryMb: number;
+  /** Time limit for each hook execution in milliseconds. Zero means 1000. */
+  timeoutMs: number;
+  hookLimits: WasmHookLimits[];
+}
+
+export interface WasmHookLimits {
+  /** Path of the hook, e.g. operation/Foo/preResolve */
+  hook: string;
+  maxMemoryMb: number;
+  timeoutMs: number;
+}
+
+export interface WebhookConfiguration {
+  /** Name of the webhook. */
+  name: string;
+  /**
+   * The path to the bundled file.
+   * The path is relative to the bundle directory.
+   */
+  filePath: string;
+  verifier:
+    | WebhookVerifier
+    | undefined;
+  /**
+   * Acknowledge verified POST requests immediately and deliver them to
+   * the hooks server from a durable queue, retrying failed deliveries
+   */
+  queued: boolean;
+  /**
+   * Maximum number of delivery attempts before moving a queued webhook
+   * to the dead letters. Zero means 10.
+   */
+  maxDeliveryAttempts: number;
+  /**
+   * Publish the verified payloads to the subscriptions using the
+   * WEBHOOK_EVENTS data source, besides forwarding them to the hooks server
+   */
+  publishEvents: boolean;
+}
+
+/**
+ * EventWebhookConfiguration notifies an external endpoint after
+ * mutations complete successfully
+ */
+export interface EventWebhookConfiguration {
+  /** Name of the webhook, used in the delivery status */
+  name: string;
+  /** URL receiving the events as POST requests */
+  url:
+    | ConfigurationVariable
+    | undefined;
+  /**
+   * Secret used to sign the events with the Standard Webhooks scheme.
+   * Secrets with the whsec_ prefix are base64 encoded.
+   */
+  secret:
+    | ConfigurationVariable
+    | undefined;
+  /** Names of the mutations triggering the webhook, empty means all of them */
+  operations: string[];
+  /**
+   * Optional JSON path (gjson syntax) evaluated against the response.
+   * Events are only sent if it matches a value other than null or false.
+   */
+  responseFilter: string;
+  /** Maximum number of delivery attempts. Zero means 10. */
+  maxDeliveryAttempts: number;
+}
+
+export interface WebhookVerifier {
+  kind: WebhookVerifierKind;
+  /**
+   * Secret used to verify the signatures. For ED25519 and RSA_SHA256
+   * it contains the public key, either PEM or hex/base64 encoded.
+   */
+  secret: ConfigurationVariable | undefined;
+  signatureHeader: string;
+  signatureHeaderPrefix: string;
+  /** Secrets also accepted while rotating them */
+  additionalSecrets: ConfigurationVariable[];
+  /**
+   * Header with the unix timestamp included in the signature. Only used by
+   * ED25519 and RSA_SHA256, since the other kinds define their own header.
+   */
+  timestampHeader: string;
+  /**
+   * Maximum difference between the signature timestamp and the current time
+   * in seconds. Zero means 300 seconds, negative disables checking it.
+   */
+  toleranceSeconds: number;
+  /**
+   * Header with a unique ID for each message, used for detecting replays
+   * instead of the signature. Unless the kind signs it (e.g. SVIX), anyone
+   * replaying a message can change it.
+   */
+  idHeader: string;
+  /**
+   * Number of recently verified messages remembered to reject replays,
+   * zero disables replay detection
+   */
+  replayCacheSize: number;
+}
+
+export interface CorsConfiguration {
+  /**
+   * AllowedOrigins is a list of origins a cross-domain request can be executed from.
+   * If the special "*" value is present in the list, all origins will be allowed.
+   * An origin may contain a wildcard (*) to replace 0 or more characters
+   * (i.e.: http://*.domain.com). Usage of wildcards implies a small performance penalty.
+   * Only one wildcard can be used per origin.
+   * Default value is ["*"]
+   */
+  allowedOrigins: ConfigurationVariable[];
+  /**
+   * AllowedMethods is a list of methods the client is allowed to use with
+   * cross-domain requests. Default value is simple methods (HEAD, GET and POST).
+   */
+  allowedMethods: string[];
+  /**
+   * AllowedHeaders is list of non simple headers the client is allowed to use with
+   * cross-domain requests.
+   * If the special "*" value is present in the list, all headers will be allowed.
+   * Default value is [] but "Origin" is always appended to the list.
+   */
+  allowedHeaders: string[];
+  /**
+   * ExposedHeaders indicates which headers are safe to expose to the API of a CORS
+   * API specification
+   */
+  exposedHeaders: string[];
+  /**
+   * MaxAge indicates how long (in seconds) the results of a preflight request
+   * can be cached
+   */
+  maxAge: number;
+  /**
+   * AllowCredentials indicates whether the request can include user credentials like
+   * cookies, HTTP authentication or client side SSL certificates.
+   */
+  allowCredentials: boolean;
+}
+
+export interface ConfigurationVariable {
+  kind: ConfigurationVariableKind;
+  staticVariableContent: string;
   environmentVariableName: string;
   environmentVariableDefaultValue: string;
   placeholderVariableName: string;
@@ -1618,7 +2407,13 @@ export const JwksBasedAuthentication = {
 };
 
 function createBaseJwksAuthProvider(): JwksAuthProvider {
-  return { jwksUrl: undefined, jwksJson: undefined, userInfoEndpoint: undefined, userInfoCacheTtlSeconds: 0 };
+  return {
+    jwksUrl: undefined,
+    jwksJson: undefined,
+    userInfoEndpoint: undefined,
+    userInfoCacheTtlSeconds: 0,
+    roleMappings: [],
+  };
 }
 
 export const JwksAuthProvider = {
@@ -1635,6 +2430,9 @@ export const JwksAuthProvider = {
     if (message.userInfoCacheTtlSeconds !== 0) {
       writer.uint32(32).int64(message.userInfoCacheTtlSeconds);
     }
+    for (const v of message.roleMappings) {
+      RoleMappingRule.encode(v!, writer.uint32(42).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -1673,6 +2471,13 @@ export const JwksAuthProvider = {
 
           message.userInfoCacheTtlSeconds = longToNumber(reader.int64() as Long);
           continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.roleMappings.push(RoleMappingRule.decode(reader, reader.uint32()));
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1690,6 +2495,9 @@ export const JwksAuthProvider = {
         ? ConfigurationVariable.fromJSON(object.userInfoEndpoint)
         : undefined,
       userInfoCacheTtlSeconds: isSet(object.userInfoCacheTtlSeconds) ? Number(object.userInfoCacheTtlSeconds) : 0,
+      roleMappings: Array.isArray(object?.roleMappings)
+        ? object.roleMappings.map((e: any) => RoleMappingRule.fromJSON(e))
+        : [],
     };
   },
 
@@ -1707,6 +2515,9 @@ export const JwksAuthProvider = {
     if (message.userInfoCacheTtlSeconds !== 0) {
       obj.userInfoCacheTtlSeconds = Math.round(message.userInfoCacheTtlSeconds);
     }
+    if (message.roleMappings?.length) {
+      obj.roleMappings = message.roleMappings.map((e) => RoleMappingRule.toJSON(e));
+    }
     return obj;
   },
 
@@ -1726,6 +2537,7 @@ export const JwksAuthProvider = {
       ? ConfigurationVariable.fromPartial(object.userInfoEndpoint)
       : undefined;
     message.userInfoCacheTtlSeconds = object.userInfoCacheTtlSeconds ?? 0;
+    message.roleMappings = object.roleMappings?.map((e) => RoleMappingRule.fromPartial(e)) || [];
     return message;
   },
 };
@@ -2019,7 +2831,15 @@ export const CookieBasedAuthentication = {
 };
 
 function createBaseAuthProvider(): AuthProvider {
-  return { id: "", kind: 0, githubConfig: undefined, oidcConfig: undefined };
+  return {
+    id: "",
+    kind: 0,
+    githubConfig: undefined,
+    oidcConfig: undefined,
+    oauth2Config: undefined,
+    samlConfig: undefined,
+    roleMappings: [],
+  };
 }
 
 export const AuthProvider = {
@@ -2036,6 +2856,15 @@ export const AuthProvider = {
     if (message.oidcConfig !== undefined) {
       OpenIDConnectAuthProviderConfig.encode(message.oidcConfig, writer.uint32(34).fork()).ldelim();
     }
+    if (message.oauth2Config !== undefined) {
+      OAuth2AuthProviderConfig.encode(message.oauth2Config, writer.uint32(42).fork()).ldelim();
+    }
+    if (message.samlConfig !== undefined) {
+      SAMLAuthProviderConfig.encode(message.samlConfig, writer.uint32(50).fork()).ldelim();
+    }
+    for (const v of message.roleMappings) {
+      RoleMappingRule.encode(v!, writer.uint32(58).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -2074,6 +2903,27 @@ export const AuthProvider = {
 
           message.oidcConfig = OpenIDConnectAuthProviderConfig.decode(reader, reader.uint32());
           continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.oauth2Config = OAuth2AuthProviderConfig.decode(reader, reader.uint32());
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.samlConfig = SAMLAuthProviderConfig.decode(reader, reader.uint32());
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.roleMappings.push(RoleMappingRule.decode(reader, reader.uint32()));
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -2089,6 +2939,11 @@ export const AuthProvider = {
       kind: isSet(object.kind) ? authProviderKindFromJSON(object.kind) : 0,
       githubConfig: isSet(object.githubConfig) ? GithubAuthProviderConfig.fromJSON(object.githubConfig) : undefined,
       oidcConfig: isSet(object.oidcConfig) ? OpenIDConnectAuthProviderConfig.fromJSON(object.oidcConfig) : undefined,
+      oauth2Config: isSet(object.oauth2Config) ? OAuth2AuthProviderConfig.fromJSON(object.oauth2Config) : undefined,
+      samlConfig: isSet(object.samlConfig) ? SAMLAuthProviderConfig.fromJSON(object.samlConfig) : undefined,
+      roleMappings: Array.isArray(object?.roleMappings)
+        ? object.roleMappings.map((e: any) => RoleMappingRule.fromJSON(e))
+        : [],
     };
   },
 
@@ -2106,6 +2961,15 @@ export const AuthProvider = {
     if (message.oidcConfig !== undefined) {
       obj.oidcConfig = OpenIDConnectAuthProviderConfig.toJSON(message.oidcConfig);
     }
+    if (message.oauth2Config !== undefined) {
+      obj.oauth2Config = OAuth2AuthProviderConfig.toJSON(message.oauth2Config);
+    }
+    if (message.samlConfig !== undefined) {
+      obj.samlConfig = SAMLAuthProviderConfig.toJSON(message.samlConfig);
+    }
+    if (message.roleMappings?.length) {
+      obj.roleMappings = message.roleMappings.map((e) => RoleMappingRule.toJSON(e));
+    }
     return obj;
   },
 
@@ -2123,6 +2987,118 @@ export const AuthProvider = {
     message.oidcConfig = (object.oidcConfig !== undefined && object.oidcConfig !== null)
       ? OpenIDConnectAuthProviderConfig.fromPartial(object.oidcConfig)
       : undefined;
+    message.oauth2Config = (object.oauth2Config !== undefined && object.oauth2Config !== null)
+      ? OAuth2AuthProviderConfig.fromPartial(object.oauth2Config)
+      : undefined;
+    message.samlConfig = (object.samlConfig !== undefined && object.samlConfig !== null)
+      ? SAMLAuthProviderConfig.fromPartial(object.samlConfig)
+      : undefined;
+    message.roleMappings = object.roleMappings?.map((e) => RoleMappingRule.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseRoleMappingRule(): RoleMappingRule {
+  return { role: "", kind: 0, claim: "", value: "" };
+}
+
+export const RoleMappingRule = {
+  encode(message: RoleMappingRule, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.role !== "") {
+      writer.uint32(10).string(message.role);
+    }
+    if (message.kind !== 0) {
+      writer.uint32(16).int32(message.kind);
+    }
+    if (message.claim !== "") {
+      writer.uint32(26).string(message.claim);
+    }
+    if (message.value !== "") {
+      writer.uint32(34).string(message.value);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): RoleMappingRule {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRoleMappingRule();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.role = reader.string();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.kind = reader.int32() as any;
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.claim = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.value = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): RoleMappingRule {
+    return {
+      role: isSet(object.role) ? String(object.role) : "",
+      kind: isSet(object.kind) ? roleMappingRuleKindFromJSON(object.kind) : 0,
+      claim: isSet(object.claim) ? String(object.claim) : "",
+      value: isSet(object.value) ? String(object.value) : "",
+    };
+  },
+
+  toJSON(message: RoleMappingRule): unknown {
+    const obj: any = {};
+    if (message.role !== "") {
+      obj.role = message.role;
+    }
+    if (message.kind !== 0) {
+      obj.kind = roleMappingRuleKindToJSON(message.kind);
+    }
+    if (message.claim !== "") {
+      obj.claim = message.claim;
+    }
+    if (message.value !== "") {
+      obj.value = message.value;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<RoleMappingRule>, I>>(base?: I): RoleMappingRule {
+    return RoleMappingRule.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<RoleMappingRule>, I>>(object: I): RoleMappingRule {
+    const message = createBaseRoleMappingRule();
+    message.role = object.role ?? "";
+    message.kind = object.kind ?? 0;
+    message.claim = object.claim ?? "";
+    message.value = object.value ?? "";
     return message;
   },
 };
@@ -2400,84 +3376,52 @@ export const OpenIDConnectAuthProviderConfig = {
   },
 };
 
-function createBaseOperation(): Operation {
+function createBaseOAuth2AuthProviderConfig(): OAuth2AuthProviderConfig {
   return {
-    name: "",
-    content: "",
-    operationType: 0,
-    variablesSchema: "",
-    responseSchema: "",
-    cacheConfig: undefined,
-    authenticationConfig: undefined,
-    liveQueryConfig: undefined,
-    authorizationConfig: undefined,
-    hooksConfiguration: undefined,
-    variablesConfiguration: undefined,
-    internal: false,
-    interpolationVariablesSchema: "",
-    postResolveTransformations: [],
-    engine: 0,
-    path: "",
+    clientId: undefined,
+    clientSecret: undefined,
+    authorizationUrl: undefined,
+    tokenUrl: undefined,
+    userInfoUrl: undefined,
+    scopes: [],
+    queryParameters: [],
+    claimMapping: {},
   };
 }
 
-export const Operation = {
-  encode(message: Operation, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.name !== "") {
-      writer.uint32(10).string(message.name);
+export const OAuth2AuthProviderConfig = {
+  encode(message: OAuth2AuthProviderConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.clientId !== undefined) {
+      ConfigurationVariable.encode(message.clientId, writer.uint32(10).fork()).ldelim();
     }
-    if (message.content !== "") {
-      writer.uint32(18).string(message.content);
+    if (message.clientSecret !== undefined) {
+      ConfigurationVariable.encode(message.clientSecret, writer.uint32(18).fork()).ldelim();
     }
-    if (message.operationType !== 0) {
-      writer.uint32(24).int32(message.operationType);
+    if (message.authorizationUrl !== undefined) {
+      ConfigurationVariable.encode(message.authorizationUrl, writer.uint32(26).fork()).ldelim();
     }
-    if (message.variablesSchema !== "") {
-      writer.uint32(34).string(message.variablesSchema);
-    }
-    if (message.responseSchema !== "") {
-      writer.uint32(42).string(message.responseSchema);
-    }
-    if (message.cacheConfig !== undefined) {
-      OperationCacheConfig.encode(message.cacheConfig, writer.uint32(58).fork()).ldelim();
-    }
-    if (message.authenticationConfig !== undefined) {
-      OperationAuthenticationConfig.encode(message.authenticationConfig, writer.uint32(66).fork()).ldelim();
-    }
-    if (message.liveQueryConfig !== undefined) {
-      OperationLiveQueryConfig.encode(message.liveQueryConfig, writer.uint32(74).fork()).ldelim();
-    }
-    if (message.authorizationConfig !== undefined) {
-      OperationAuthorizationConfig.encode(message.authorizationConfig, writer.uint32(82).fork()).ldelim();
-    }
-    if (message.hooksConfiguration !== undefined) {
-      OperationHooksConfiguration.encode(message.hooksConfiguration, writer.uint32(90).fork()).ldelim();
-    }
-    if (message.variablesConfiguration !== undefined) {
-      OperationVariablesConfiguration.encode(message.variablesConfiguration, writer.uint32(98).fork()).ldelim();
-    }
-    if (message.internal === true) {
-      writer.uint32(104).bool(message.internal);
-    }
-    if (message.interpolationVariablesSchema !== "") {
-      writer.uint32(114).string(message.interpolationVariablesSchema);
+    if (message.tokenUrl !== undefined) {
+      ConfigurationVariable.encode(message.tokenUrl, writer.uint32(34).fork()).ldelim();
     }
-    for (const v of message.postResolveTransformations) {
-      PostResolveTransformation.encode(v!, writer.uint32(122).fork()).ldelim();
+    if (message.userInfoUrl !== undefined) {
+      ConfigurationVariable.encode(message.userInfoUrl, writer.uint32(42).fork()).ldelim();
     }
-    if (message.engine !== 0) {
-      writer.uint32(128).int32(message.engine);
+    for (const v of message.scopes) {
+      writer.uint32(50).string(v!);
     }
-    if (message.path !== "") {
-      writer.uint32(138).string(message.path);
+    for (const v of message.queryParameters) {
+      OpenIDConnectQueryParameter.encode(v!, writer.uint32(58).fork()).ldelim();
     }
+    Object.entries(message.claimMapping).forEach(([key, value]) => {
+      OAuth2AuthProviderConfig_ClaimMappingEntry.encode({ key: key as any, value }, writer.uint32(66).fork()).ldelim();
+    });
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): Operation {
+  decode(input: _m0.Reader | Uint8Array, length?: number): OAuth2AuthProviderConfig {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseOperation();
+    const message = createBaseOAuth2AuthProviderConfig();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -2486,112 +3430,59 @@ export const Operation = {
             break;
           }
 
-          message.name = reader.string();
+          message.clientId = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.content = reader.string();
+          message.clientSecret = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
         case 3:
-          if (tag !== 24) {
+          if (tag !== 26) {
             break;
           }
 
-          message.operationType = reader.int32() as any;
+          message.authorizationUrl = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
         case 4:
           if (tag !== 34) {
             break;
           }
 
-          message.variablesSchema = reader.string();
+          message.tokenUrl = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
         case 5:
           if (tag !== 42) {
             break;
           }
 
-          message.responseSchema = reader.string();
-          continue;
-        case 7:
-          if (tag !== 58) {
-            break;
-          }
-
-          message.cacheConfig = OperationCacheConfig.decode(reader, reader.uint32());
-          continue;
-        case 8:
-          if (tag !== 66) {
-            break;
-          }
-
-          message.authenticationConfig = OperationAuthenticationConfig.decode(reader, reader.uint32());
-          continue;
-        case 9:
-          if (tag !== 74) {
-            break;
-          }
-
-          message.liveQueryConfig = OperationLiveQueryConfig.decode(reader, reader.uint32());
-          continue;
-        case 10:
-          if (tag !== 82) {
-            break;
-          }
-
-          message.authorizationConfig = OperationAuthorizationConfig.decode(reader, reader.uint32());
-          continue;
-        case 11:
-          if (tag !== 90) {
-            break;
-          }
-
-          message.hooksConfiguration = OperationHooksConfiguration.decode(reader, reader.uint32());
-          continue;
-        case 12:
-          if (tag !== 98) {
-            break;
-          }
-
-          message.variablesConfiguration = OperationVariablesConfiguration.decode(reader, reader.uint32());
-          continue;
-        case 13:
-          if (tag !== 104) {
-            break;
-          }
-
-          message.internal = reader.bool();
+          message.userInfoUrl = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
-        case 14:
-          if (tag !== 114) {
+        case 6:
+          if (tag !== 50) {
             break;
           }
 
-          message.interpolationVariablesSchema = reader.string();
+          message.scopes.push(reader.string());
           continue;
-        case 15:
-          if (tag !== 122) {
+        case 7:
+          if (tag !== 58) {
             break;
           }
 
-          message.postResolveTransformations.push(PostResolveTransformation.decode(reader, reader.uint32()));
+          message.queryParameters.push(OpenIDConnectQueryParameter.decode(reader, reader.uint32()));
           continue;
-        case 16:
-          if (tag !== 128) {
+        case 8:
+          if (tag !== 66) {
             break;
           }
 
-          message.engine = reader.int32() as any;
-          continue;
-        case 17:
-          if (tag !== 138) {
-            break;
+          const entry8 = OAuth2AuthProviderConfig_ClaimMappingEntry.decode(reader, reader.uint32());
+          if (entry8.value !== undefined) {
+            message.claimMapping[entry8.key] = entry8.value;
           }
-
-          message.path = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -2602,181 +3493,134 @@ export const Operation = {
     return message;
   },
 
-  fromJSON(object: any): Operation {
+  fromJSON(object: any): OAuth2AuthProviderConfig {
     return {
-      name: isSet(object.name) ? String(object.name) : "",
-      content: isSet(object.content) ? String(object.content) : "",
-      operationType: isSet(object.operationType) ? operationTypeFromJSON(object.operationType) : 0,
-      variablesSchema: isSet(object.variablesSchema) ? String(object.variablesSchema) : "",
-      responseSchema: isSet(object.responseSchema) ? String(object.responseSchema) : "",
-      cacheConfig: isSet(object.cacheConfig) ? OperationCacheConfig.fromJSON(object.cacheConfig) : undefined,
-      authenticationConfig: isSet(object.authenticationConfig)
-        ? OperationAuthenticationConfig.fromJSON(object.authenticationConfig)
-        : undefined,
-      liveQueryConfig: isSet(object.liveQueryConfig)
-        ? OperationLiveQueryConfig.fromJSON(object.liveQueryConfig)
-        : undefined,
-      authorizationConfig: isSet(object.authorizationConfig)
-        ? OperationAuthorizationConfig.fromJSON(object.authorizationConfig)
-        : undefined,
-      hooksConfiguration: isSet(object.hooksConfiguration)
-        ? OperationHooksConfiguration.fromJSON(object.hooksConfiguration)
-        : undefined,
-      variablesConfiguration: isSet(object.variablesConfiguration)
-        ? OperationVariablesConfiguration.fromJSON(object.variablesConfiguration)
+      clientId: isSet(object.clientId) ? ConfigurationVariable.fromJSON(object.clientId) : undefined,
+      clientSecret: isSet(object.clientSecret) ? ConfigurationVariable.fromJSON(object.clientSecret) : undefined,
+      authorizationUrl: isSet(object.authorizationUrl)
+        ? ConfigurationVariable.fromJSON(object.authorizationUrl)
         : undefined,
-      internal: isSet(object.internal) ? Boolean(object.internal) : false,
-      interpolationVariablesSchema: isSet(object.interpolationVariablesSchema)
-        ? String(object.interpolationVariablesSchema)
-        : "",
-      postResolveTransformations: Array.isArray(object?.postResolveTransformations)
-        ? object.postResolveTransformations.map((e: any) => PostResolveTransformation.fromJSON(e))
+      tokenUrl: isSet(object.tokenUrl) ? ConfigurationVariable.fromJSON(object.tokenUrl) : undefined,
+      userInfoUrl: isSet(object.userInfoUrl) ? ConfigurationVariable.fromJSON(object.userInfoUrl) : undefined,
+      scopes: Array.isArray(object?.scopes) ? object.scopes.map((e: any) => String(e)) : [],
+      queryParameters: Array.isArray(object?.queryParameters)
+        ? object.queryParameters.map((e: any) => OpenIDConnectQueryParameter.fromJSON(e))
         : [],
-      engine: isSet(object.engine) ? operationExecutionEngineFromJSON(object.engine) : 0,
-      path: isSet(object.path) ? String(object.path) : "",
+      claimMapping: isObject(object.claimMapping)
+        ? Object.entries(object.claimMapping).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+          acc[key] = String(value);
+          return acc;
+        }, {})
+        : {},
     };
   },
 
-  toJSON(message: Operation): unknown {
+  toJSON(message: OAuth2AuthProviderConfig): unknown {
     const obj: any = {};
-    if (message.name !== "") {
-      obj.name = message.name;
-    }
-    if (message.content !== "") {
-      obj.content = message.content;
-    }
-    if (message.operationType !== 0) {
-      obj.operationType = operationTypeToJSON(message.operationType);
-    }
-    if (message.variablesSchema !== "") {
-      obj.variablesSchema = message.variablesSchema;
-    }
-    if (message.responseSchema !== "") {
-      obj.responseSchema = message.responseSchema;
-    }
-    if (message.cacheConfig !== undefined) {
-      obj.cacheConfig = OperationCacheConfig.toJSON(message.cacheConfig);
-    }
-    if (message.authenticationConfig !== undefined) {
-      obj.authenticationConfig = OperationAuthenticationConfig.toJSON(message.authenticationConfig);
-    }
-    if (message.liveQueryConfig !== undefined) {
-      obj.liveQueryConfig = OperationLiveQueryConfig.toJSON(message.liveQueryConfig);
-    }
-    if (message.authorizationConfig !== undefined) {
-      obj.authorizationConfig = OperationAuthorizationConfig.toJSON(message.authorizationConfig);
+    if (message.clientId !== undefined) {
+      obj.clientId = ConfigurationVariable.toJSON(message.clientId);
     }
-    if (message.hooksConfiguration !== undefined) {
-      obj.hooksConfiguration = OperationHooksConfiguration.toJSON(message.hooksConfiguration);
+    if (message.clientSecret !== undefined) {
+      obj.clientSecret = ConfigurationVariable.toJSON(message.clientSecret);
     }
-    if (message.variablesConfiguration !== undefined) {
-      obj.variablesConfiguration = OperationVariablesConfiguration.toJSON(message.variablesConfiguration);
+    if (message.authorizationUrl !== undefined) {
+      obj.authorizationUrl = ConfigurationVariable.toJSON(message.authorizationUrl);
     }
-    if (message.internal === true) {
-      obj.internal = message.internal;
+    if (message.tokenUrl !== undefined) {
+      obj.tokenUrl = ConfigurationVariable.toJSON(message.tokenUrl);
     }
-    if (message.interpolationVariablesSchema !== "") {
-      obj.interpolationVariablesSchema = message.interpolationVariablesSchema;
+    if (message.userInfoUrl !== undefined) {
+      obj.userInfoUrl = ConfigurationVariable.toJSON(message.userInfoUrl);
     }
-    if (message.postResolveTransformations?.length) {
-      obj.postResolveTransformations = message.postResolveTransformations.map((e) =>
-        PostResolveTransformation.toJSON(e)
-      );
+    if (message.scopes?.length) {
+      obj.scopes = message.scopes;
     }
-    if (message.engine !== 0) {
-      obj.engine = operationExecutionEngineToJSON(message.engine);
+    if (message.queryParameters?.length) {
+      obj.queryParameters = message.queryParameters.map((e) => OpenIDConnectQueryParameter.toJSON(e));
     }
-    if (message.path !== "") {
-      obj.path = message.path;
+    if (message.claimMapping) {
+      const entries = Object.entries(message.claimMapping);
+      if (entries.length > 0) {
+        obj.claimMapping = {};
+        entries.forEach(([k, v]) => {
+          obj.claimMapping[k] = v;
+        });
+      }
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<Operation>, I>>(base?: I): Operation {
-    return Operation.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<OAuth2AuthProviderConfig>, I>>(base?: I): OAuth2AuthProviderConfig {
+    return OAuth2AuthProviderConfig.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<Operation>, I>>(object: I): Operation {
-    const message = createBaseOperation();
-    message.name = object.name ?? "";
-    message.content = object.content ?? "";
-    message.operationType = object.operationType ?? 0;
-    message.variablesSchema = object.variablesSchema ?? "";
-    message.responseSchema = object.responseSchema ?? "";
-    message.cacheConfig = (object.cacheConfig !== undefined && object.cacheConfig !== null)
-      ? OperationCacheConfig.fromPartial(object.cacheConfig)
+  fromPartial<I extends Exact<DeepPartial<OAuth2AuthProviderConfig>, I>>(object: I): OAuth2AuthProviderConfig {
+    const message = createBaseOAuth2AuthProviderConfig();
+    message.clientId = (object.clientId !== undefined && object.clientId !== null)
+      ? ConfigurationVariable.fromPartial(object.clientId)
       : undefined;
-    message.authenticationConfig = (object.authenticationConfig !== undefined && object.authenticationConfig !== null)
-      ? OperationAuthenticationConfig.fromPartial(object.authenticationConfig)
+    message.clientSecret = (object.clientSecret !== undefined && object.clientSecret !== null)
+      ? ConfigurationVariable.fromPartial(object.clientSecret)
       : undefined;
-    message.liveQueryConfig = (object.liveQueryConfig !== undefined && object.liveQueryConfig !== null)
-      ? OperationLiveQueryConfig.fromPartial(object.liveQueryConfig)
+    message.authorizationUrl = (object.authorizationUrl !== undefined && object.authorizationUrl !== null)
+      ? ConfigurationVariable.fromPartial(object.authorizationUrl)
       : undefined;
-    message.authorizationConfig = (object.authorizationConfig !== undefined && object.authorizationConfig !== null)
-      ? OperationAuthorizationConfig.fromPartial(object.authorizationConfig)
+    message.tokenUrl = (object.tokenUrl !== undefined && object.tokenUrl !== null)
+      ? ConfigurationVariable.fromPartial(object.tokenUrl)
       : undefined;
-    message.hooksConfiguration = (object.hooksConfiguration !== undefined && object.hooksConfiguration !== null)
-      ? OperationHooksConfiguration.fromPartial(object.hooksConfiguration)
+    message.userInfoUrl = (object.userInfoUrl !== undefined && object.userInfoUrl !== null)
+      ? ConfigurationVariable.fromPartial(object.userInfoUrl)
       : undefined;
-    message.variablesConfiguration =
-      (object.variablesConfiguration !== undefined && object.variablesConfiguration !== null)
-        ? OperationVariablesConfiguration.fromPartial(object.variablesConfiguration)
-        : undefined;
-    message.internal = object.internal ?? false;
-    message.interpolationVariablesSchema = object.interpolationVariablesSchema ?? "";
-    message.postResolveTransformations =
-      object.postResolveTransformations?.map((e) => PostResolveTransformation.fromPartial(e)) || [];
-    message.engine = object.engine ?? 0;
-    message.path = object.path ?? "";
+    message.scopes = object.scopes?.map((e) => e) || [];
+    message.queryParameters = object.queryParameters?.map((e) => OpenIDConnectQueryParameter.fromPartial(e)) || [];
+    message.claimMapping = Object.entries(object.claimMapping ?? {}).reduce<{ [key: string]: string }>(
+      (acc, [key, value]) => {
+        if (value !== undefined) {
+          acc[key] = String(value);
+        }
+        return acc;
+      },
+      {},
+    );
     return message;
   },
 };
 
-function createBasePostResolveTransformation(): PostResolveTransformation {
-  return { kind: 0, depth: 0, get: undefined };
+function createBaseOAuth2AuthProviderConfig_ClaimMappingEntry(): OAuth2AuthProviderConfig_ClaimMappingEntry {
+  return { key: "", value: "" };
 }
 
-export const PostResolveTransformation = {
-  encode(message: PostResolveTransformation, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.kind !== 0) {
-      writer.uint32(8).int32(message.kind);
-    }
-    if (message.depth !== 0) {
-      writer.uint32(16).int64(message.depth);
+export const OAuth2AuthProviderConfig_ClaimMappingEntry = {
+  encode(message: OAuth2AuthProviderConfig_ClaimMappingEntry, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.key !== "") {
+      writer.uint32(10).string(message.key);
     }
-    if (message.get !== undefined) {
-      PostResolveGetTransformation.encode(message.get, writer.uint32(26).fork()).ldelim();
+    if (message.value !== "") {
+      writer.uint32(18).string(message.value);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): PostResolveTransformation {
+  decode(input: _m0.Reader | Uint8Array, length?: number): OAuth2AuthProviderConfig_ClaimMappingEntry {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBasePostResolveTransformation();
+    const message = createBaseOAuth2AuthProviderConfig_ClaimMappingEntry();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.kind = reader.int32() as any;
+          message.key = reader.string();
           continue;
         case 2:
-          if (tag !== 16) {
-            break;
-          }
-
-          message.depth = longToNumber(reader.int64() as Long);
-          continue;
-        case 3:
-          if (tag !== 26) {
+          if (tag !== 18) {
             break;
           }
 
-          message.get = PostResolveGetTransformation.decode(reader, reader.uint32());
+          message.value = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -2787,62 +3631,94 @@ export const PostResolveTransformation = {
     return message;
   },
 
-  fromJSON(object: any): PostResolveTransformation {
-    return {
-      kind: isSet(object.kind) ? postResolveTransformationKindFromJSON(object.kind) : 0,
-      depth: isSet(object.depth) ? Number(object.depth) : 0,
-      get: isSet(object.get) ? PostResolveGetTransformation.fromJSON(object.get) : undefined,
-    };
+  fromJSON(object: any): OAuth2AuthProviderConfig_ClaimMappingEntry {
+    return { key: isSet(object.key) ? String(object.key) : "", value: isSet(object.value) ? String(object.value) : "" };
   },
 
-  toJSON(message: PostResolveTransformation): unknown {
+  toJSON(message: OAuth2AuthProviderConfig_ClaimMappingEntry): unknown {
     const obj: any = {};
-    if (message.kind !== 0) {
-      obj.kind = postResolveTransformationKindToJSON(message.kind);
-    }
-    if (message.depth !== 0) {
-      obj.depth = Math.round(message.depth);
+    if (message.key !== "") {
+      obj.key = message.key;
     }
-    if (message.get !== undefined) {
-      obj.get = PostResolveGetTransformation.toJSON(message.get);
+    if (message.value !== "") {
+      obj.value = message.value;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<PostResolveTransformation>, I>>(base?: I): PostResolveTransformation {
-    return PostResolveTransformation.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<OAuth2AuthProviderConfig_ClaimMappingEntry>, I>>(
+    base?: I,
+  ): OAuth2AuthProviderConfig_ClaimMappingEntry {
+    return OAuth2AuthProviderConfig_ClaimMappingEntry.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<PostResolveTransformation>, I>>(object: I): PostResolveTransformation {
-    const message = createBasePostResolveTransformation();
-    message.kind = object.kind ?? 0;
-    message.depth = object.depth ?? 0;
-    message.get = (object.get !== undefined && object.get !== null)
-      ? PostResolveGetTransformation.fromPartial(object.get)
-      : undefined;
+  fromPartial<I extends Exact<DeepPartial<OAuth2AuthProviderConfig_ClaimMappingEntry>, I>>(
+    object: I,
+  ): OAuth2AuthProviderConfig_ClaimMappingEntry {
+    const message = createBaseOAuth2AuthProviderConfig_ClaimMappingEntry();
+    message.key = object.key ?? "";
+    message.value = object.value ?? "";
     return message;
   },
 };
 
-function createBasePostResolveGetTransformation(): PostResolveGetTransformation {
-  return { from: [], to: [] };
+function createBaseSAMLAuthProviderConfig(): SAMLAuthProviderConfig {
+  return {
+    idpMetadataUrl: undefined,
+    idpMetadataXml: undefined,
+    entityId: undefined,
+    certificate: undefined,
+    privateKey: undefined,
+    signRequests: false,
+    allowIdpInitiated: false,
+    nameIdFormat: undefined,
+    attributeMapping: {},
+    rolesAttribute: "",
+  };
 }
 
-export const PostResolveGetTransformation = {
-  encode(message: PostResolveGetTransformation, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    for (const v of message.from) {
-      writer.uint32(10).string(v!);
+export const SAMLAuthProviderConfig = {
+  encode(message: SAMLAuthProviderConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.idpMetadataUrl !== undefined) {
+      ConfigurationVariable.encode(message.idpMetadataUrl, writer.uint32(10).fork()).ldelim();
     }
-    for (const v of message.to) {
-      writer.uint32(18).string(v!);
+    if (message.idpMetadataXml !== undefined) {
+      ConfigurationVariable.encode(message.idpMetadataXml, writer.uint32(18).fork()).ldelim();
+    }
+    if (message.entityId !== undefined) {
+      ConfigurationVariable.encode(message.entityId, writer.uint32(26).fork()).ldelim();
+    }
+    if (message.certificate !== undefined) {
+      ConfigurationVariable.encode(message.certificate, writer.uint32(34).fork()).ldelim();
+    }
+    if (message.privateKey !== undefined) {
+      ConfigurationVariable.encode(message.privateKey, writer.uint32(42).fork()).ldelim();
+    }
+    if (message.signRequests === true) {
+      writer.uint32(48).bool(message.signRequests);
+    }
+    if (message.allowIdpInitiated === true) {
+      writer.uint32(56).bool(message.allowIdpInitiated);
+    }
+    if (message.nameIdFormat !== undefined) {
+      ConfigurationVariable.encode(message.nameIdFormat, writer.uint32(66).fork()).ldelim();
+    }
+    Object.entries(message.attributeMapping).forEach(([key, value]) => {
+      SAMLAuthProviderConfig_AttributeMappingEntry.encode(
+        { key: key as any, value },
+        writer.uint32(74).fork(),
+      ).ldelim();
+    });
+    if (message.rolesAttribute !== "") {
+      writer.uint32(82).string(message.rolesAttribute);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): PostResolveGetTransformation {
+  decode(input: _m0.Reader | Uint8Array, length?: number): SAMLAuthProviderConfig {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBasePostResolveGetTransformation();
+    const message = createBaseSAMLAuthProviderConfig();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -2851,14 +3727,73 @@ export const PostResolveGetTransformation = {
             break;
           }
 
-          message.from.push(reader.string());
+          message.idpMetadataUrl = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.to.push(reader.string());
+          message.idpMetadataXml = ConfigurationVariable.decode(reader, reader.uint32());
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.entityId = ConfigurationVariable.decode(reader, reader.uint32());
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.certificate = ConfigurationVariable.decode(reader, reader.uint32());
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.privateKey = ConfigurationVariable.decode(reader, reader.uint32());
+          continue;
+        case 6:
+          if (tag !== 48) {
+            break;
+          }
+
+          message.signRequests = reader.bool();
+          continue;
+        case 7:
+          if (tag !== 56) {
+            break;
+          }
+
+          message.allowIdpInitiated = reader.bool();
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.nameIdFormat = ConfigurationVariable.decode(reader, reader.uint32());
+          continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
+
+          const entry9 = SAMLAuthProviderConfig_AttributeMappingEntry.decode(reader, reader.uint32());
+          if (entry9.value !== undefined) {
+            message.attributeMapping[entry9.key] = entry9.value;
+          }
+          continue;
+        case 10:
+          if (tag !== 82) {
+            break;
+          }
+
+          message.rolesAttribute = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -2869,52 +3804,126 @@ export const PostResolveGetTransformation = {
     return message;
   },
 
-  fromJSON(object: any): PostResolveGetTransformation {
+  fromJSON(object: any): SAMLAuthProviderConfig {
     return {
-      from: Array.isArray(object?.from) ? object.from.map((e: any) => String(e)) : [],
-      to: Array.isArray(object?.to) ? object.to.map((e: any) => String(e)) : [],
+      idpMetadataUrl: isSet(object.idpMetadataUrl) ? ConfigurationVariable.fromJSON(object.idpMetadataUrl) : undefined,
+      idpMetadataXml: isSet(object.idpMetadataXml) ? ConfigurationVariable.fromJSON(object.idpMetadataXml) : undefined,
+      entityId: isSet(object.entityId) ? ConfigurationVariable.fromJSON(object.entityId) : undefined,
+      certificate: isSet(object.certificate) ? ConfigurationVariable.fromJSON(object.certificate) : undefined,
+      privateKey: isSet(object.privateKey) ? ConfigurationVariable.fromJSON(object.privateKey) : undefined,
+      signRequests: isSet(object.signRequests) ? Boolean(object.signRequests) : false,
+      allowIdpInitiated: isSet(object.allowIdpInitiated) ? Boolean(object.allowIdpInitiated) : false,
+      nameIdFormat: isSet(object.nameIdFormat) ? ConfigurationVariable.fromJSON(object.nameIdFormat) : undefined,
+      attributeMapping: isObject(object.attributeMapping)
+        ? Object.entries(object.attributeMapping).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+          acc[key] = String(value);
+          return acc;
+        }, {})
+        : {},
+      rolesAttribute: isSet(object.rolesAttribute) ? String(object.rolesAttribute) : "",
     };
   },
 
-  toJSON(message: PostResolveGetTransformation): unknown {
+  toJSON(message: SAMLAuthProviderConfig): unknown {
     const obj: any = {};
-    if (message.from?.length) {
-      obj.from = message.from;
+    if (message.idpMetadataUrl !== undefined) {
+      obj.idpMetadataUrl = ConfigurationVariable.toJSON(message.idpMetadataUrl);
     }
-    if (message.to?.length) {
-      obj.to = message.to;
+    if (message.idpMetadataXml !== undefined) {
+      obj.idpMetadataXml = ConfigurationVariable.toJSON(message.idpMetadataXml);
+    }
+    if (message.entityId !== undefined) {
+      obj.entityId = ConfigurationVariable.toJSON(message.entityId);
+    }
+    if (message.certificate !== undefined) {
+      obj.certificate = ConfigurationVariable.toJSON(message.certificate);
+    }
+    if (message.privateKey !== undefined) {
+      obj.privateKey = ConfigurationVariable.toJSON(message.privateKey);
+    }
+    if (message.signRequests === true) {
+      obj.signRequests = message.signRequests;
+    }
+    if (message.allowIdpInitiated === true) {
+      obj.allowIdpInitiated = message.allowIdpInitiated;
+    }
+    if (message.nameIdFormat !== undefined) {
+      obj.nameIdFormat = ConfigurationVariable.toJSON(message.nameIdFormat);
+    }
+    if (message.attributeMapping) {
+      const entries = Object.entries(message.attributeMapping);
+      if (entries.length > 0) {
+        obj.attributeMapping = {};
+        entries.forEach(([k, v]) => {
+          obj.attributeMapping[k] = v;
+        });
+      }
+    }
+    if (message.rolesAttribute !== "") {
+      obj.rolesAttribute = message.rolesAttribute;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<PostResolveGetTransformation>, I>>(base?: I): PostResolveGetTransformation {
-    return PostResolveGetTransformation.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<SAMLAuthProviderConfig>, I>>(base?: I): SAMLAuthProviderConfig {
+    return SAMLAuthProviderConfig.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<PostResolveGetTransformation>, I>>(object: I): PostResolveGetTransformation {
-    const message = createBasePostResolveGetTransformation();
-    message.from = object.from?.map((e) => e) || [];
-    message.to = object.to?.map((e) => e) || [];
+  fromPartial<I extends Exact<DeepPartial<SAMLAuthProviderConfig>, I>>(object: I): SAMLAuthProviderConfig {
+    const message = createBaseSAMLAuthProviderConfig();
+    message.idpMetadataUrl = (object.idpMetadataUrl !== undefined && object.idpMetadataUrl !== null)
+      ? ConfigurationVariable.fromPartial(object.idpMetadataUrl)
+      : undefined;
+    message.idpMetadataXml = (object.idpMetadataXml !== undefined && object.idpMetadataXml !== null)
+      ? ConfigurationVariable.fromPartial(object.idpMetadataXml)
+      : undefined;
+    message.entityId = (object.entityId !== undefined && object.entityId !== null)
+      ? ConfigurationVariable.fromPartial(object.entityId)
+      : undefined;
+    message.certificate = (object.certificate !== undefined && object.certificate !== null)
+      ? ConfigurationVariable.fromPartial(object.certificate)
+      : undefined;
+    message.privateKey = (object.privateKey !== undefined && object.privateKey !== null)
+      ? ConfigurationVariable.fromPartial(object.privateKey)
+      : undefined;
+    message.signRequests = object.signRequests ?? false;
+    message.allowIdpInitiated = object.allowIdpInitiated ?? false;
+    message.nameIdFormat = (object.nameIdFormat !== undefined && object.nameIdFormat !== null)
+      ? ConfigurationVariable.fromPartial(object.nameIdFormat)
+      : undefined;
+    message.attributeMapping = Object.entries(object.attributeMapping ?? {}).reduce<{ [key: string]: string }>(
+      (acc, [key, value]) => {
+        if (value !== undefined) {
+          acc[key] = String(value);
+        }
+        return acc;
+      },
+      {},
+    );
+    message.rolesAttribute = object.rolesAttribute ?? "";
     return message;
   },
 };
 
-function createBaseOperationVariablesConfiguration(): OperationVariablesConfiguration {
-  return { injectVariables: [] };
+function createBaseSAMLAuthProviderConfig_AttributeMappingEntry(): SAMLAuthProviderConfig_AttributeMappingEntry {
+  return { key: "", value: "" };
 }
 
-export const OperationVariablesConfiguration = {
-  encode(message: OperationVariablesConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    for (const v of message.injectVariables) {
-      VariableInjectionConfiguration.encode(v!, writer.uint32(10).fork()).ldelim();
+export const SAMLAuthProviderConfig_AttributeMappingEntry = {
+  encode(message: SAMLAuthProviderConfig_AttributeMappingEntry, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.key !== "") {
+      writer.uint32(10).string(message.key);
+    }
+    if (message.value !== "") {
+      writer.uint32(18).string(message.value);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): OperationVariablesConfiguration {
+  decode(input: _m0.Reader | Uint8Array, length?: number): SAMLAuthProviderConfig_AttributeMappingEntry {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseOperationVariablesConfiguration();
+    const message = createBaseSAMLAuthProviderConfig_AttributeMappingEntry();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -2923,7 +3932,14 @@ export const OperationVariablesConfiguration = {
             break;
           }
 
-          message.injectVariables.push(VariableInjectionConfiguration.decode(reader, reader.uint32()));
+          message.key = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.value = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -2934,90 +3950,2133 @@ export const OperationVariablesConfiguration = {
     return message;
   },
 
-  fromJSON(object: any): OperationVariablesConfiguration {
-    return {
-      injectVariables: Array.isArray(object?.injectVariables)
-        ? object.injectVariables.map((e: any) => VariableInjectionConfiguration.fromJSON(e))
-        : [],
-    };
+  fromJSON(object: any): SAMLAuthProviderConfig_AttributeMappingEntry {
+    return { key: isSet(object.key) ? String(object.key) : "", value: isSet(object.value) ? String(object.value) : "" };
   },
 
-  toJSON(message: OperationVariablesConfiguration): unknown {
+  toJSON(message: SAMLAuthProviderConfig_AttributeMappingEntry): unknown {
     const obj: any = {};
-    if (message.injectVariables?.length) {
-      obj.injectVariables = message.injectVariables.map((e) => VariableInjectionConfiguration.toJSON(e));
+    if (message.key !== "") {
+      obj.key = message.key;
+    }
+    if (message.value !== "") {
+      obj.value = message.value;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<OperationVariablesConfiguration>, I>>(base?: I): OperationVariablesConfiguration {
-    return OperationVariablesConfiguration.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<SAMLAuthProviderConfig_AttributeMappingEntry>, I>>(
+    base?: I,
+  ): SAMLAuthProviderConfig_AttributeMappingEntry {
+    return SAMLAuthProviderConfig_AttributeMappingEntry.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<OperationVariablesConfiguration>, I>>(
+  fromPartial<I extends Exact<DeepPartial<SAMLAuthProviderConfig_AttributeMappingEntry>, I>>(
     object: I,
-  ): OperationVariablesConfiguration {
-    const message = createBaseOperationVariablesConfiguration();
-    message.injectVariables = object.injectVariables?.map((e) => VariableInjectionConfiguration.fromPartial(e)) || [];
+  ): SAMLAuthProviderConfig_AttributeMappingEntry {
+    const message = createBaseSAMLAuthProviderConfig_AttributeMappingEntry();
+    message.key = object.key ?? "";
+    message.value = object.value ?? "";
     return message;
   },
 };
 
-function createBaseVariableInjectionConfiguration(): VariableInjectionConfiguration {
-  return { variablePathComponents: [], variableKind: 0, dateFormat: "", environmentVariableName: "" };
+function createBaseOperation(): Operation {
+  return {
+    name: "",
+    content: "",
+    operationType: 0,
+    variablesSchema: "",
+    responseSchema: "",
+    cacheConfig: undefined,
+    authenticationConfig: undefined,
+    liveQueryConfig: undefined,
+    authorizationConfig: undefined,
+    hooksConfiguration: undefined,
+    variablesConfiguration: undefined,
+    internal: false,
+    interpolationVariablesSchema: "",
+    postResolveTransformations: [],
+    engine: 0,
+    path: "",
+  };
 }
 
-export const VariableInjectionConfiguration = {
-  encode(message: VariableInjectionConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    for (const v of message.variablePathComponents) {
-      writer.uint32(10).string(v!);
+export const Operation = {
+  encode(message: Operation, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
     }
-    if (message.variableKind !== 0) {
-      writer.uint32(16).int32(message.variableKind);
+    if (message.content !== "") {
+      writer.uint32(18).string(message.content);
+    }
+    if (message.operationType !== 0) {
+      writer.uint32(24).int32(message.operationType);
+    }
+    if (message.variablesSchema !== "") {
+      writer.uint32(34).string(message.variablesSchema);
+    }
+    if (message.responseSchema !== "") {
+      writer.uint32(42).string(message.responseSchema);
+    }
+    if (message.cacheConfig !== undefined) {
+      OperationCacheConfig.encode(message.cacheConfig, writer.uint32(58).fork()).ldelim();
+    }
+    if (message.authenticationConfig !== undefined) {
+      OperationAuthenticationConfig.encode(message.authenticationConfig, writer.uint32(66).fork()).ldelim();
+    }
+    if (message.liveQueryConfig !== undefined) {
+      OperationLiveQueryConfig.encode(message.liveQueryConfig, writer.uint32(74).fork()).ldelim();
+    }
+    if (message.authorizationConfig !== undefined) {
+      OperationAuthorizationConfig.encode(message.authorizationConfig, writer.uint32(82).fork()).ldelim();
+    }
+    if (message.hooksConfiguration !== undefined) {
+      OperationHooksConfiguration.encode(message.hooksConfiguration, writer.uint32(90).fork()).ldelim();
+    }
+    if (message.variablesConfiguration !== undefined) {
+      OperationVariablesConfiguration.encode(message.variablesConfiguration, writer.uint32(98).fork()).ldelim();
+    }
+    if (message.internal === true) {
+      writer.uint32(104).bool(message.internal);
+    }
+    if (message.interpolationVariablesSchema !== "") {
+      writer.uint32(114).string(message.interpolationVariablesSchema);
+    }
+    for (const v of message.postResolveTransformations) {
+      PostResolveTransformation.encode(v!, writer.uint32(122).fork()).ldelim();
+    }
+    if (message.engine !== 0) {
+      writer.uint32(128).int32(message.engine);
+    }
+    if (message.path !== "") {
+      writer.uint32(138).string(message.path);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Operation {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseOperation();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.content = reader.string();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.operationType = reader.int32() as any;
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.variablesSchema = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.responseSchema = reader.string();
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.cacheConfig = OperationCacheConfig.decode(reader, reader.uint32());
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.authenticationConfig = OperationAuthenticationConfig.decode(reader, reader.uint32());
+          continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
+
+          message.liveQueryConfig = OperationLiveQueryConfig.decode(reader, reader.uint32());
+          continue;
+        case 10:
+          if (tag !== 82) {
+            break;
+          }
+
+          message.authorizationConfig = OperationAuthorizationConfig.decode(reader, reader.uint32());
+          continue;
+        case 11:
+          if (tag !== 90) {
+            break;
+          }
+
+          message.hooksConfiguration = OperationHooksConfiguration.decode(reader, reader.uint32());
+          continue;
+        case 12:
+          if (tag !== 98) {
+            break;
+          }
+
+          message.variablesConfiguration = OperationVariablesConfiguration.decode(reader, reader.uint32());
+          continue;
+        case 13:
+          if (tag !== 104) {
+            break;
+          }
+
+          message.internal = reader.bool();
+          continue;
+        case 14:
+          if (tag !== 114) {
+            break;
+          }
+
+          message.interpolationVariablesSchema = reader.string();
+          continue;
+        case 15:
+          if (tag !== 122) {
+            break;
+          }
+
+          message.postResolveTransformations.push(PostResolveTransformation.decode(reader, reader.uint32()));
+          continue;
+        case 16:
+          if (tag !== 128) {
+            break;
+          }
+
+          message.engine = reader.int32() as any;
+          continue;
+        case 17:
+          if (tag !== 138) {
+            break;
+          }
+
+          message.path = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Operation {
+    return {
+      name: isSet(object.name) ? String(object.name) : "",
+      content: isSet(object.content) ? String(object.content) : "",
+      operationType: isSet(object.operationType) ? operationTypeFromJSON(object.operationType) : 0,
+      variablesSchema: isSet(object.variablesSchema) ? String(object.variablesSchema) : "",
+      responseSchema: isSet(object.responseSchema) ? String(object.responseSchema) : "",
+      cacheConfig: isSet(object.cacheConfig) ? OperationCacheConfig.fromJSON(object.cacheConfig) : undefined,
+      authenticationConfig: isSet(object.authenticationConfig)
+        ? OperationAuthenticationConfig.fromJSON(object.authenticationConfig)
+        : undefined,
+      liveQueryConfig: isSet(object.liveQueryConfig)
+        ? OperationLiveQueryConfig.fromJSON(object.liveQueryConfig)
+        : undefined,
+      authorizationConfig: isSet(object.authorizationConfig)
+        ? OperationAuthorizationConfig.fromJSON(object.authorizationConfig)
+        : undefined,
+      hooksConfiguration: isSet(object.hooksConfiguration)
+        ? OperationHooksConfiguration.fromJSON(object.hooksConfiguration)
+        : undefined,
+      variablesConfiguration: isSet(object.variablesConfiguration)
+        ? OperationVariablesConfiguration.fromJSON(object.variablesConfiguration)
+        : undefined,
+      internal: isSet(object.internal) ? Boolean(object.internal) : false,
+      interpolationVariablesSchema: isSet(object.interpolationVariablesSchema)
+        ? String(object.interpolationVariablesSchema)
+        : "",
+      postResolveTransformations: Array.isArray(object?.postResolveTransformations)
+        ? object.postResolveTransformations.map((e: any) => PostResolveTransformation.fromJSON(e))
+        : [],
+      engine: isSet(object.engine) ? operationExecutionEngineFromJSON(object.engine) : 0,
+      path: isSet(object.path) ? String(object.path) : "",
+    };
+  },
+
+  toJSON(message: Operation): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.content !== "") {
+      obj.content = message.content;
+    }
+    if (message.operationType !== 0) {
+      obj.operationType = operationTypeToJSON(message.operationType);
+    }
+    if (message.variablesSchema !== "") {
+      obj.variablesSchema = message.variablesSchema;
+    }
+    if (message.responseSchema !== "") {
+      obj.responseSchema = message.responseSchema;
+    }
+    if (message.cacheConfig !== undefined) {
+      obj.cacheConfig = OperationCacheConfig.toJSON(message.cacheConfig);
+    }
+    if (message.authenticationConfig !== undefined) {
+      obj.authenticationConfig = OperationAuthenticationConfig.toJSON(message.authenticationConfig);
+    }
+    if (message.liveQueryConfig !== undefined) {
+      obj.liveQueryConfig = OperationLiveQueryConfig.toJSON(message.liveQueryConfig);
+    }
+    if (message.authorizationConfig !== undefined) {
+      obj.authorizationConfig = OperationAuthorizationConfig.toJSON(message.authorizationConfig);
+    }
+    if (message.hooksConfiguration !== undefined) {
+      obj.hooksConfiguration = OperationHooksConfiguration.toJSON(message.hooksConfiguration);
+    }
+    if (message.variablesConfiguration !== undefined) {
+      obj.variablesConfiguration = OperationVariablesConfiguration.toJSON(message.variablesConfiguration);
+    }
+    if (message.internal === true) {
+      obj.internal = message.internal;
+    }
+    if (message.interpolationVariablesSchema !== "") {
+      obj.interpolationVariablesSchema = message.interpolationVariablesSchema;
+    }
+    if (message.postResolveTransformations?.length) {
+      obj.postResolveTransformations = message.postResolveTransformations.map((e) =>
+        PostResolveTransformation.toJSON(e)
+      );
+    }
+    if (message.engine !== 0) {
+      obj.engine = operationExecutionEngineToJSON(message.engine);
+    }
+    if (message.path !== "") {
+      obj.path = message.path;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<Operation>, I>>(base?: I): Operation {
+    return Operation.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<Operation>, I>>(object: I): Operation {
+    const message = createBaseOperation();
+    message.name = object.name ?? "";
+    message.content = object.content ?? "";
+    message.operationType = object.operationType ?? 0;
+    message.variablesSchema = object.variablesSchema ?? "";
+    message.responseSchema = object.responseSchema ?? "";
+    message.cacheConfig = (object.cacheConfig !== undefined && object.cacheConfig !== null)
+      ? OperationCacheConfig.fromPartial(object.cacheConfig)
+      : undefined;
+    message.authenticationConfig = (object.authenticationConfig !== undefined && object.authenticationConfig !== null)
+      ? OperationAuthenticationConfig.fromPartial(object.authenticationConfig)
+      : undefined;
+    message.liveQueryConfig = (object.liveQueryConfig !== undefined && object.liveQueryConfig !== null)
+      ? OperationLiveQueryConfig.fromPartial(object.liveQueryConfig)
+      : undefined;
+    message.authorizationConfig = (object.authorizationConfig !== undefined && object.authorizationConfig !== null)
+      ? OperationAuthorizationConfig.fromPartial(object.authorizationConfig)
+      : undefined;
+    message.hooksConfiguration = (object.hooksConfiguration !== undefined && object.hooksConfiguration !== null)
+      ? OperationHooksConfiguration.fromPartial(object.hooksConfiguration)
+      : undefined;
+    message.variablesConfiguration =
+      (object.variablesConfiguration !== undefined && object.variablesConfiguration !== null)
+        ? OperationVariablesConfiguration.fromPartial(object.variablesConfiguration)
+        : undefined;
+    message.internal = object.internal ?? false;
+    message.interpolationVariablesSchema = object.interpolationVariablesSchema ?? "";
+    message.postResolveTransformations =
+      object.postResolveTransformations?.map((e) => PostResolveTransformation.fromPartial(e)) || [];
+    message.engine = object.engine ?? 0;
+    message.path = object.path ?? "";
+    return message;
+  },
+};
+
+function createBasePostResolveTransformation(): PostResolveTransformation {
+  return { kind: 0, depth: 0, get: undefined };
+}
+
+export const PostResolveTransformation = {
+  encode(message: PostResolveTransformation, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.kind !== 0) {
+      writer.uint32(8).int32(message.kind);
+    }
+    if (message.depth !== 0) {
+      writer.uint32(16).int64(message.depth);
+    }
+    if (message.get !== undefined) {
+      PostResolveGetTransformation.encode(message.get, writer.uint32(26).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PostResolveTransformation {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePostResolveTransformation();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.kind = reader.int32() as any;
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.depth = longToNumber(reader.int64() as Long);
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.get = PostResolveGetTransformation.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PostResolveTransformation {
+    return {
+      kind: isSet(object.kind) ? postResolveTransformationKindFromJSON(object.kind) : 0,
+      depth: isSet(object.depth) ? Number(object.depth) : 0,
+      get: isSet(object.get) ? PostResolveGetTransformation.fromJSON(object.get) : undefined,
+    };
+  },
+
+  toJSON(message: PostResolveTransformation): unknown {
+    const obj: any = {};
+    if (message.kind !== 0) {
+      obj.kind = postResolveTransformationKindToJSON(message.kind);
+    }
+    if (message.depth !== 0) {
+      obj.depth = Math.round(message.depth);
+    }
+    if (message.get !== undefined) {
+      obj.get = PostResolveGetTransformation.toJSON(message.get);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<PostResolveTransformation>, I>>(base?: I): PostResolveTransformation {
+    return PostResolveTransformation.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<PostResolveTransformation>, I>>(object: I): PostResolveTransformation {
+    const message = createBasePostResolveTransformation();
+    message.kind = object.kind ?? 0;
+    message.depth = object.depth ?? 0;
+    message.get = (object.get !== undefined && object.get !== null)
+      ? PostResolveGetTransformation.fromPartial(object.get)
+      : undefined;
+    return message;
+  },
+};
+
+function createBasePostResolveGetTransformation(): PostResolveGetTransformation {
+  return { from: [], to: [] };
+}
+
+export const PostResolveGetTransformation = {
+  encode(message: PostResolveGetTransformation, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.from) {
+      writer.uint32(10).string(v!);
+    }
+    for (const v of message.to) {
+      writer.uint32(18).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PostResolveGetTransformation {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePostResolveGetTransformation();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.from.push(reader.string());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.to.push(reader.string());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PostResolveGetTransformation {
+    return {
+      from: Array.isArray(object?.from) ? object.from.map((e: any) => String(e)) : [],
+      to: Array.isArray(object?.to) ? object.to.map((e: any) => String(e)) : [],
+    };
+  },
+
+  toJSON(message: PostResolveGetTransformation): unknown {
+    const obj: any = {};
+    if (message.from?.length) {
+      obj.from = message.from;
+    }
+    if (message.to?.length) {
+      obj.to = message.to;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<PostResolveGetTransformation>, I>>(base?: I): PostResolveGetTransformation {
+    return PostResolveGetTransformation.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<PostResolveGetTransformation>, I>>(object: I): PostResolveGetTransformation {
+    const message = createBasePostResolveGetTransformation();
+    message.from = object.from?.map((e) => e) || [];
+    message.to = object.to?.map((e) => e) || [];
+    return message;
+  },
+};
+
+function createBaseOperationVariablesConfiguration(): OperationVariablesConfiguration {
+  return { injectVariables: [] };
+}
+
+export const OperationVariablesConfiguration = {
+  encode(message: OperationVariablesConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.injectVariables) {
+      VariableInjectionConfiguration.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): OperationVariablesConfiguration {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseOperationVariablesConfiguration();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.injectVariables.push(VariableInjectionConfiguration.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): OperationVariablesConfiguration {
+    return {
+      injectVariables: Array.isArray(object?.injectVariables)
+        ? object.injectVariables.map((e: any) => VariableInjectionConfiguration.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: OperationVariablesConfiguration): unknown {
+    const obj: any = {};
+    if (message.injectVariables?.length) {
+      obj.injectVariables = message.injectVariables.map((e) => VariableInjectionConfiguration.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<OperationVariablesConfiguration>, I>>(base?: I): OperationVariablesConfiguration {
+    return OperationVariablesConfiguration.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<OperationVariablesConfiguration>, I>>(
+    object: I,
+  ): OperationVariablesConfiguration {
+    const message = createBaseOperationVariablesConfiguration();
+    message.injectVariables = object.injectVariables?.map((e) => VariableInjectionConfiguration.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseVariableInjectionConfiguration(): VariableInjectionConfiguration {
+  return { variablePathComponents: [], variableKind: 0, dateFormat: "", environmentVariableName: "" };
+}
+
+export const VariableInjectionConfiguration = {
+  encode(message: VariableInjectionConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.variablePathComponents) {
+      writer.uint32(10).string(v!);
+    }
+    if (message.variableKind !== 0) {
+      writer.uint32(16).int32(message.variableKind);
+    }
+    if (message.dateFormat !== "") {
+      writer.uint32(26).string(message.dateFormat);
+    }
+    if (message.environmentVariableName !== "") {
+      writer.uint32(34).string(message.environmentVariableName);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): VariableInjectionConfiguration {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseVariableInjectionConfiguration();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.variablePathComponents.push(reader.string());
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.variableKind = reader.int32() as any;
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.dateFormat = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.environmentVariableName = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): VariableInjectionConfiguration {
+    return {
+      variablePathComponents: Array.isArray(object?.variablePathComponents)
+        ? object.variablePathComponents.map((e: any) => String(e))
+        : [],
+      variableKind: isSet(object.variableKind) ? injectVariableKindFromJSON(object.variableKind) : 0,
+      dateFormat: isSet(object.dateFormat) ? String(object.dateFormat) : "",
+      environmentVariableName: isSet(object.environmentVariableName) ? String(object.environmentVariableName) : "",
+    };
+  },
+
+  toJSON(message: VariableInjectionConfiguration): unknown {
+    const obj: any = {};
+    if (message.variablePathComponents?.length) {
+      obj.variablePathComponents = message.variablePathComponents;
+    }
+    if (message.variableKind !== 0) {
+      obj.variableKind = injectVariableKindToJSON(message.variableKind);
+    }
+    if (message.dateFormat !== "") {
+      obj.dateFormat = message.dateFormat;
+    }
+    if (message.environmentVariableName !== "") {
+      obj.environmentVariableName = message.environmentVariableName;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<VariableInjectionConfiguration>, I>>(base?: I): VariableInjectionConfiguration {
+    return VariableInjectionConfiguration.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<VariableInjectionConfiguration>, I>>(
+    object: I,
+  ): VariableInjectionConfiguration {
+    const message = createBaseVariableInjectionConfiguration();
+    message.variablePathComponents = object.variablePathComponents?.map((e) => e) || [];
+    message.variableKind = object.variableKind ?? 0;
+    message.dateFormat = object.dateFormat ?? "";
+    message.environmentVariableName = object.environmentVariableName ?? "";
+    return message;
+  },
+};
+
+function createBaseGraphQLDataSourceHooksConfiguration(): GraphQLDataSourceHooksConfiguration {
+  return { onWSTransportConnectionInit: false };
+}
+
+export const GraphQLDataSourceHooksConfiguration = {
+  encode(message: GraphQLDataSourceHooksConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.onWSTransportConnectionInit === true) {
+      writer.uint32(8).bool(message.onWSTransportConnectionInit);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GraphQLDataSourceHooksConfiguration {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGraphQLDataSourceHooksConfiguration();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.onWSTransportConnectionInit = reader.bool();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GraphQLDataSourceHooksConfiguration {
+    return {
+      onWSTransportConnectionInit: isSet(object.onWSTransportConnectionInit)
+        ? Boolean(object.onWSTransportConnectionInit)
+        : false,
+    };
+  },
+
+  toJSON(message: GraphQLDataSourceHooksConfiguration): unknown {
+    const obj: any = {};
+    if (message.onWSTransportConnectionInit === true) {
+      obj.onWSTransportConnectionInit = message.onWSTransportConnectionInit;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<GraphQLDataSourceHooksConfiguration>, I>>(
+    base?: I,
+  ): GraphQLDataSourceHooksConfiguration {
+    return GraphQLDataSourceHooksConfiguration.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<GraphQLDataSourceHooksConfiguration>, I>>(
+    object: I,
+  ): GraphQLDataSourceHooksConfiguration {
+    const message = createBaseGraphQLDataSourceHooksConfiguration();
+    message.onWSTransportConnectionInit = object.onWSTransportConnectionInit ?? false;
+    return message;
+  },
+};
+
+function createBaseHeaderMatcher(): HeaderMatcher {
+  return { name: "", value: "" };
+}
+
+export const HeaderMatcher = {
+  encode(message: HeaderMatcher, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.value !== "") {
+      writer.uint32(18).string(message.value);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): HeaderMatcher {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseHeaderMatcher();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.value = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): HeaderMatcher {
+    return {
+      name: isSet(object.name) ? String(object.name) : "",
+      value: isSet(object.value) ? String(object.value) : "",
+    };
+  },
+
+  toJSON(message: HeaderMatcher): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.value !== "") {
+      obj.value = message.value;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<HeaderMatcher>, I>>(base?: I): HeaderMatcher {
+    return HeaderMatcher.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<HeaderMatcher>, I>>(object: I): HeaderMatcher {
+    const message = createBaseHeaderMatcher();
+    message.name = object.name ?? "";
+    message.value = object.value ?? "";
+    return message;
+  },
+};
+
+function createBaseHookMatcher(): HookMatcher {
+  return {
+    operationType: undefined,
+    datasources: [],
+    operationNames: [],
+    methods: [],
+    paths: [],
+    headers: [],
+    roles: [],
+  };
+}
+
+export const HookMatcher = {
+  encode(message: HookMatcher, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.operationType !== undefined) {
+      writer.uint32(8).int32(message.operationType);
+    }
+    for (const v of message.datasources) {
+      writer.uint32(18).string(v!);
+    }
+    for (const v of message.operationNames) {
+      writer.uint32(26).string(v!);
+    }
+    for (const v of message.methods) {
+      writer.uint32(34).string(v!);
+    }
+    for (const v of message.paths) {
+      writer.uint32(42).string(v!);
+    }
+    for (const v of message.headers) {
+      HeaderMatcher.encode(v!, writer.uint32(50).fork()).ldelim();
+    }
+    for (const v of message.roles) {
+      writer.uint32(58).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): HookMatcher {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseHookMatcher();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.operationType = reader.int32() as any;
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.datasources.push(reader.string());
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.operationNames.push(reader.string());
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.methods.push(reader.string());
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.paths.push(reader.string());
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.headers.push(HeaderMatcher.decode(reader, reader.uint32()));
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.roles.push(reader.string());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): HookMatcher {
+    return {
+      operationType: isSet(object.operationType) ? operationTypeFromJSON(object.operationType) : undefined,
+      datasources: Array.isArray(object?.datasources) ? object.datasources.map((e: any) => String(e)) : [],
+      operationNames: Array.isArray(object?.operationNames) ? object.operationNames.map((e: any) => String(e)) : [],
+      methods: Array.isArray(object?.methods) ? object.methods.map((e: any) => String(e)) : [],
+      paths: Array.isArray(object?.paths) ? object.paths.map((e: any) => String(e)) : [],
+      headers: Array.isArray(object?.headers) ? object.headers.map((e: any) => HeaderMatcher.fromJSON(e)) : [],
+      roles: Array.isArray(object?.roles) ? object.roles.map((e: any) => String(e)) : [],
+    };
+  },
+
+  toJSON(message: HookMatcher): unknown {
+    const obj: any = {};
+    if (message.operationType !== undefined) {
+      obj.operationType = operationTypeToJSON(message.operationType);
+    }
+    if (message.datasources?.length) {
+      obj.datasources = message.datasources;
+    }
+    if (message.operationNames?.length) {
+      obj.operationNames = message.operationNames;
+    }
+    if (message.methods?.length) {
+      obj.methods = message.methods;
+    }
+    if (message.paths?.length) {
+      obj.paths = message.paths;
+    }
+    if (message.headers?.length) {
+      obj.headers = message.headers.map((e) => HeaderMatcher.toJSON(e));
+    }
+    if (message.roles?.length) {
+      obj.roles = message.roles;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<HookMatcher>, I>>(base?: I): HookMatcher {
+    return HookMatcher.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<HookMatcher>, I>>(object: I): HookMatcher {
+    const message = createBaseHookMatcher();
+    message.operationType = object.operationType ?? undefined;
+    message.datasources = object.datasources?.map((e) => e) || [];
+    message.operationNames = object.operationNames?.map((e) => e) || [];
+    message.methods = object.methods?.map((e) => e) || [];
+    message.paths = object.paths?.map((e) => e) || [];
+    message.headers = object.headers?.map((e) => HeaderMatcher.fromPartial(e)) || [];
+    message.roles = object.roles?.map((e) => e) || [];
+    return message;
+  },
+};
+
+function createBaseHook(): Hook {
+  return { id: "", type: 0, matcher: undefined };
+}
+
+export const Hook = {
+  encode(message: Hook, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== "") {
+      writer.uint32(10).string(message.id);
+    }
+    if (message.type !== 0) {
+      writer.uint32(16).int32(message.type);
+    }
+    if (message.matcher !== undefined) {
+      HookMatcher.encode(message.matcher, writer.uint32(26).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Hook {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseHook();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.id = reader.string();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.type = reader.int32() as any;
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.matcher = HookMatcher.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Hook {
+    return {
+      id: isSet(object.id) ? String(object.id) : "",
+      type: isSet(object.type) ? hookTypeFromJSON(object.type) : 0,
+      matcher: isSet(object.matcher) ? HookMatcher.fromJSON(object.matcher) : undefined,
+    };
+  },
+
+  toJSON(message: Hook): unknown {
+    const obj: any = {};
+    if (message.id !== "") {
+      obj.id = message.id;
+    }
+    if (message.type !== 0) {
+      obj.type = hookTypeToJSON(message.type);
+    }
+    if (message.matcher !== undefined) {
+      obj.matcher = HookMatcher.toJSON(message.matcher);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<Hook>, I>>(base?: I): Hook {
+    return Hook.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<Hook>, I>>(object: I): Hook {
+    const message = createBaseHook();
+    message.id = object.id ?? "";
+    message.type = object.type ?? 0;
+    message.matcher = (object.matcher !== undefined && object.matcher !== null)
+      ? HookMatcher.fromPartial(object.matcher)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseOperationHooksConfiguration(): OperationHooksConfiguration {
+  return {
+    preResolve: false,
+    postResolve: false,
+    mutatingPreResolve: false,
+    mutatingPostResolve: false,
+    mockResolve: undefined,
+    httpTransportOnRequest: false,
+    httpTransportOnResponse: false,
+    customResolve: false,
+    preResolveExpressions: [],
+    postResolveExpressions: [],
+    onSubscriptionEvent: false,
+    subscriptionEventExpressions: [],
+  };
+}
+
+export const OperationHooksConfiguration = {
+  encode(message: OperationHooksConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.preResolve === true) {
+      writer.uint32(8).bool(message.preResolve);
+    }
+    if (message.postResolve === true) {
+      writer.uint32(16).bool(message.postResolve);
+    }
+    if (message.mutatingPreResolve === true) {
+      writer.uint32(24).bool(message.mutatingPreResolve);
+    }
+    if (message.mutatingPostResolve === true) {
+      writer.uint32(32).bool(message.mutatingPostResolve);
+    }
+    if (message.mockResolve !== undefined) {
+      MockResolveHookConfiguration.encode(message.mockResolve, writer.uint32(42).fork()).ldelim();
+    }
+    if (message.httpTransportOnRequest === true) {
+      writer.uint32(48).bool(message.httpTransportOnRequest);
+    }
+    if (message.httpTransportOnResponse === true) {
+      writer.uint32(56).bool(message.httpTransportOnResponse);
+    }
+    if (message.customResolve === true) {
+      writer.uint32(64).bool(message.customResolve);
+    }
+    for (const v of message.preResolveExpressions) {
+      ExpressionHook.encode(v!, writer.uint32(74).fork()).ldelim();
+    }
+    for (const v of message.postResolveExpressions) {
+      ExpressionHook.encode(v!, writer.uint32(82).fork()).ldelim();
+    }
+    if (message.onSubscriptionEvent === true) {
+      writer.uint32(88).bool(message.onSubscriptionEvent);
+    }
+    for (const v of message.subscriptionEventExpressions) {
+      ExpressionHook.encode(v!, writer.uint32(98).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): OperationHooksConfiguration {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseOperationHooksConfiguration();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.preResolve = reader.bool();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.postResolve = reader.bool();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.mutatingPreResolve = reader.bool();
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.mutatingPostResolve = reader.bool();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.mockResolve = MockResolveHookConfiguration.decode(reader, reader.uint32());
+          continue;
+        case 6:
+          if (tag !== 48) {
+            break;
+          }
+
+          message.httpTransportOnRequest = reader.bool();
+          continue;
+        case 7:
+          if (tag !== 56) {
+            break;
+          }
+
+          message.httpTransportOnResponse = reader.bool();
+          continue;
+        case 8:
+          if (tag !== 64) {
+            break;
+          }
+
+          message.customResolve = reader.bool();
+          continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
+
+          message.preResolveExpressions.push(ExpressionHook.decode(reader, reader.uint32()));
+          continue;
+        case 10:
+          if (tag !== 82) {
+            break;
+          }
+
+          message.postResolveExpressions.push(ExpressionHook.decode(reader, reader.uint32()));
+          continue;
+        case 11:
+          if (tag !== 88) {
+            break;
+          }
+
+          message.onSubscriptionEvent = reader.bool();
+          continue;
+        case 12:
+          if (tag !== 98) {
+            break;
+          }
+
+          message.subscriptionEventExpressions.push(ExpressionHook.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): OperationHooksConfiguration {
+    return {
+      preResolve: isSet(object.preResolve) ? Boolean(object.preResolve) : false,
+      postResolve: isSet(object.postResolve) ? Boolean(object.postResolve) : false,
+      mutatingPreResolve: isSet(object.mutatingPreResolve) ? Boolean(object.mutatingPreResolve) : false,
+      mutatingPostResolve: isSet(object.mutatingPostResolve) ? Boolean(object.mutatingPostResolve) : false,
+      mockResolve: isSet(object.mockResolve) ? MockResolveHookConfiguration.fromJSON(object.mockResolve) : undefined,
+      httpTransportOnRequest: isSet(object.httpTransportOnRequest) ? Boolean(object.httpTransportOnRequest) : false,
+      httpTransportOnResponse: isSet(object.httpTransportOnResponse) ? Boolean(object.httpTransportOnResponse) : false,
+      customResolve: isSet(object.customResolve) ? Boolean(object.customResolve) : false,
+      preResolveExpressions: Array.isArray(object?.preResolveExpressions)
+        ? object.preResolveExpressions.map((e: any) => ExpressionHook.fromJSON(e))
+        : [],
+      postResolveExpressions: Array.isArray(object?.postResolveExpressions)
+        ? object.postResolveExpressions.map((e: any) => ExpressionHook.fromJSON(e))
+        : [],
+      onSubscriptionEvent: isSet(object.onSubscriptionEvent) ? Boolean(object.onSubscriptionEvent) : false,
+      subscriptionEventExpressions: Array.isArray(object?.subscriptionEventExpressions)
+        ? object.subscriptionEventExpressions.map((e: any) => ExpressionHook.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: OperationHooksConfiguration): unknown {
+    const obj: any = {};
+    if (message.preResolve === true) {
+      obj.preResolve = message.preResolve;
+    }
+    if (message.postResolve === true) {
+      obj.postResolve = message.postResolve;
+    }
+    if (message.mutatingPreResolve === true) {
+      obj.mutatingPreResolve = message.mutatingPreResolve;
+    }
+    if (message.mutatingPostResolve === true) {
+      obj.mutatingPostResolve = message.mutatingPostResolve;
+    }
+    if (message.mockResolve !== undefined) {
+      obj.mockResolve = MockResolveHookConfiguration.toJSON(message.mockResolve);
+    }
+    if (message.httpTransportOnRequest === true) {
+      obj.httpTransportOnRequest = message.httpTransportOnRequest;
+    }
+    if (message.httpTransportOnResponse === true) {
+      obj.httpTransportOnResponse = message.httpTransportOnResponse;
+    }
+    if (message.customResolve === true) {
+      obj.customResolve = message.customResolve;
+    }
+    if (message.preResolveExpressions?.length) {
+      obj.preResolveExpressions = message.preResolveExpressions.map((e) => ExpressionHook.toJSON(e));
+    }
+    if (message.postResolveExpressions?.length) {
+      obj.postResolveExpressions = message.postResolveExpressions.map((e) => ExpressionHook.toJSON(e));
+    }
+    if (message.onSubscriptionEvent === true) {
+      obj.onSubscriptionEvent = message.onSubscriptionEvent;
+    }
+    if (message.subscriptionEventExpressions?.length) {
+      obj.subscriptionEventExpressions = message.subscriptionEventExpressions.map((e) => ExpressionHook.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<OperationHooksConfiguration>, I>>(base?: I): OperationHooksConfiguration {
+    return OperationHooksConfiguration.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<OperationHooksConfiguration>, I>>(object: I): OperationHooksConfiguration {
+    const message = createBaseOperationHooksConfiguration();
+    message.preResolve = object.preResolve ?? false;
+    message.postResolve = object.postResolve ?? false;
+    message.mutatingPreResolve = object.mutatingPreResolve ?? false;
+    message.mutatingPostResolve = object.mutatingPostResolve ?? false;
+    message.mockResolve = (object.mockResolve !== undefined && object.mockResolve !== null)
+      ? MockResolveHookConfiguration.fromPartial(object.mockResolve)
+      : undefined;
+    message.httpTransportOnRequest = object.httpTransportOnRequest ?? false;
+    message.httpTransportOnResponse = object.httpTransportOnResponse ?? false;
+    message.customResolve = object.customResolve ?? false;
+    message.preResolveExpressions = object.preResolveExpressions?.map((e) => ExpressionHook.fromPartial(e)) || [];
+    message.postResolveExpressions = object.postResolveExpressions?.map((e) => ExpressionHook.fromPartial(e)) || [];
+    message.onSubscriptionEvent = object.onSubscriptionEvent ?? false;
+    message.subscriptionEventExpressions =
+      object.subscriptionEventExpressions?.map((e) => ExpressionHook.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseExpressionHook(): ExpressionHook {
+  return { action: 0, condition: "", target: "", expression: "", statusCode: 0 };
+}
+
+export const ExpressionHook = {
+  encode(message: ExpressionHook, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.action !== 0) {
+      writer.uint32(8).int32(message.action);
+    }
+    if (message.condition !== "") {
+      writer.uint32(18).string(message.condition);
+    }
+    if (message.target !== "") {
+      writer.uint32(26).string(message.target);
+    }
+    if (message.expression !== "") {
+      writer.uint32(34).string(message.expression);
+    }
+    if (message.statusCode !== 0) {
+      writer.uint32(40).int32(message.statusCode);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ExpressionHook {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseExpressionHook();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.action = reader.int32() as any;
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.condition = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.target = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.expression = reader.string();
+          continue;
+        case 5:
+          if (tag !== 40) {
+            break;
+          }
+
+          message.statusCode = reader.int32();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ExpressionHook {
+    return {
+      action: isSet(object.action) ? expressionHookActionFromJSON(object.action) : 0,
+      condition: isSet(object.condition) ? String(object.condition) : "",
+      target: isSet(object.target) ? String(object.target) : "",
+      expression: isSet(object.expression) ? String(object.expression) : "",
+      statusCode: isSet(object.statusCode) ? Number(object.statusCode) : 0,
+    };
+  },
+
+  toJSON(message: ExpressionHook): unknown {
+    const obj: any = {};
+    if (message.action !== 0) {
+      obj.action = expressionHookActionToJSON(message.action);
+    }
+    if (message.condition !== "") {
+      obj.condition = message.condition;
+    }
+    if (message.target !== "") {
+      obj.target = message.target;
+    }
+    if (message.expression !== "") {
+      obj.expression = message.expression;
+    }
+    if (message.statusCode !== 0) {
+      obj.statusCode = Math.round(message.statusCode);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ExpressionHook>, I>>(base?: I): ExpressionHook {
+    return ExpressionHook.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ExpressionHook>, I>>(object: I): ExpressionHook {
+    const message = createBaseExpressionHook();
+    message.action = object.action ?? 0;
+    message.condition = object.condition ?? "";
+    message.target = object.target ?? "";
+    message.expression = object.expression ?? "";
+    message.statusCode = object.statusCode ?? 0;
+    return message;
+  },
+};
+
+function createBaseMockResolveHookConfiguration(): MockResolveHookConfiguration {
+  return { enable: false, subscriptionPollingIntervalMillis: 0 };
+}
+
+export const MockResolveHookConfiguration = {
+  encode(message: MockResolveHookConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.enable === true) {
+      writer.uint32(8).bool(message.enable);
+    }
+    if (message.subscriptionPollingIntervalMillis !== 0) {
+      writer.uint32(16).int64(message.subscriptionPollingIntervalMillis);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MockResolveHookConfiguration {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMockResolveHookConfiguration();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.enable = reader.bool();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.subscriptionPollingIntervalMillis = longToNumber(reader.int64() as Long);
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): MockResolveHookConfiguration {
+    return {
+      enable: isSet(object.enable) ? Boolean(object.enable) : false,
+      subscriptionPollingIntervalMillis: isSet(object.subscriptionPollingIntervalMillis)
+        ? Number(object.subscriptionPollingIntervalMillis)
+        : 0,
+    };
+  },
+
+  toJSON(message: MockResolveHookConfiguration): unknown {
+    const obj: any = {};
+    if (message.enable === true) {
+      obj.enable = message.enable;
+    }
+    if (message.subscriptionPollingIntervalMillis !== 0) {
+      obj.subscriptionPollingIntervalMillis = Math.round(message.subscriptionPollingIntervalMillis);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<MockResolveHookConfiguration>, I>>(base?: I): MockResolveHookConfiguration {
+    return MockResolveHookConfiguration.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<MockResolveHookConfiguration>, I>>(object: I): MockResolveHookConfiguration {
+    const message = createBaseMockResolveHookConfiguration();
+    message.enable = object.enable ?? false;
+    message.subscriptionPollingIntervalMillis = object.subscriptionPollingIntervalMillis ?? 0;
+    return message;
+  },
+};
+
+function createBaseOperationAuthorizationConfig(): OperationAuthorizationConfig {
+  return { claims: [], roleConfig: undefined };
+}
+
+export const OperationAuthorizationConfig = {
+  encode(message: OperationAuthorizationConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.claims) {
+      ClaimConfig.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.roleConfig !== undefined) {
+      OperationRoleConfig.encode(message.roleConfig, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): OperationAuthorizationConfig {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseOperationAuthorizationConfig();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.claims.push(ClaimConfig.decode(reader, reader.uint32()));
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.roleConfig = OperationRoleConfig.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): OperationAuthorizationConfig {
+    return {
+      claims: Array.isArray(object?.claims) ? object.claims.map((e: any) => ClaimConfig.fromJSON(e)) : [],
+      roleConfig: isSet(object.roleConfig) ? OperationRoleConfig.fromJSON(object.roleConfig) : undefined,
+    };
+  },
+
+  toJSON(message: OperationAuthorizationConfig): unknown {
+    const obj: any = {};
+    if (message.claims?.length) {
+      obj.claims = message.claims.map((e) => ClaimConfig.toJSON(e));
+    }
+    if (message.roleConfig !== undefined) {
+      obj.roleConfig = OperationRoleConfig.toJSON(message.roleConfig);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<OperationAuthorizationConfig>, I>>(base?: I): OperationAuthorizationConfig {
+    return OperationAuthorizationConfig.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<OperationAuthorizationConfig>, I>>(object: I): OperationAuthorizationConfig {
+    const message = createBaseOperationAuthorizationConfig();
+    message.claims = object.claims?.map((e) => ClaimConfig.fromPartial(e)) || [];
+    message.roleConfig = (object.roleConfig !== undefined && object.roleConfig !== null)
+      ? OperationRoleConfig.fromPartial(object.roleConfig)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseOperationRoleConfig(): OperationRoleConfig {
+  return { requireMatchAll: [], requireMatchAny: [], denyMatchAll: [], denyMatchAny: [] };
+}
+
+export const OperationRoleConfig = {
+  encode(message: OperationRoleConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.requireMatchAll) {
+      writer.uint32(10).string(v!);
+    }
+    for (const v of message.requireMatchAny) {
+      writer.uint32(18).string(v!);
+    }
+    for (const v of message.denyMatchAll) {
+      writer.uint32(26).string(v!);
+    }
+    for (const v of message.denyMatchAny) {
+      writer.uint32(34).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): OperationRoleConfig {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseOperationRoleConfig();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.requireMatchAll.push(reader.string());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.requireMatchAny.push(reader.string());
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.denyMatchAll.push(reader.string());
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.denyMatchAny.push(reader.string());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): OperationRoleConfig {
+    return {
+      requireMatchAll: Array.isArray(object?.requireMatchAll) ? object.requireMatchAll.map((e: any) => String(e)) : [],
+      requireMatchAny: Array.isArray(object?.requireMatchAny) ? object.requireMatchAny.map((e: any) => String(e)) : [],
+      denyMatchAll: Array.isArray(object?.denyMatchAll) ? object.denyMatchAll.map((e: any) => String(e)) : [],
+      denyMatchAny: Array.isArray(object?.denyMatchAny) ? object.denyMatchAny.map((e: any) => String(e)) : [],
+    };
+  },
+
+  toJSON(message: OperationRoleConfig): unknown {
+    const obj: any = {};
+    if (message.requireMatchAll?.length) {
+      obj.requireMatchAll = message.requireMatchAll;
+    }
+    if (message.requireMatchAny?.length) {
+      obj.requireMatchAny = message.requireMatchAny;
+    }
+    if (message.denyMatchAll?.length) {
+      obj.denyMatchAll = message.denyMatchAll;
+    }
+    if (message.denyMatchAny?.length) {
+      obj.denyMatchAny = message.denyMatchAny;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<OperationRoleConfig>, I>>(base?: I): OperationRoleConfig {
+    return OperationRoleConfig.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<OperationRoleConfig>, I>>(object: I): OperationRoleConfig {
+    const message = createBaseOperationRoleConfig();
+    message.requireMatchAll = object.requireMatchAll?.map((e) => e) || [];
+    message.requireMatchAny = object.requireMatchAny?.map((e) => e) || [];
+    message.denyMatchAll = object.denyMatchAll?.map((e) => e) || [];
+    message.denyMatchAny = object.denyMatchAny?.map((e) => e) || [];
+    return message;
+  },
+};
+
+function createBaseCustomClaim(): CustomClaim {
+  return { name: "", jsonPathComponents: [], type: 0, required: false };
+}
+
+export const CustomClaim = {
+  encode(message: CustomClaim, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    for (const v of message.jsonPathComponents) {
+      writer.uint32(18).string(v!);
+    }
+    if (message.type !== 0) {
+      writer.uint32(24).int32(message.type);
+    }
+    if (message.required === true) {
+      writer.uint32(32).bool(message.required);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): CustomClaim {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCustomClaim();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.jsonPathComponents.push(reader.string());
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.type = reader.int32() as any;
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.required = reader.bool();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CustomClaim {
+    return {
+      name: isSet(object.name) ? String(object.name) : "",
+      jsonPathComponents: Array.isArray(object?.jsonPathComponents)
+        ? object.jsonPathComponents.map((e: any) => String(e))
+        : [],
+      type: isSet(object.type) ? valueTypeFromJSON(object.type) : 0,
+      required: isSet(object.required) ? Boolean(object.required) : false,
+    };
+  },
+
+  toJSON(message: CustomClaim): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.jsonPathComponents?.length) {
+      obj.jsonPathComponents = message.jsonPathComponents;
+    }
+    if (message.type !== 0) {
+      obj.type = valueTypeToJSON(message.type);
+    }
+    if (message.required === true) {
+      obj.required = message.required;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<CustomClaim>, I>>(base?: I): CustomClaim {
+    return CustomClaim.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<CustomClaim>, I>>(object: I): CustomClaim {
+    const message = createBaseCustomClaim();
+    message.name = object.name ?? "";
+    message.jsonPathComponents = object.jsonPathComponents?.map((e) => e) || [];
+    message.type = object.type ?? 0;
+    message.required = object.required ?? false;
+    return message;
+  },
+};
+
+function createBaseClaimConfig(): ClaimConfig {
+  return { variablePathComponents: [], claimType: 0, custom: undefined };
+}
+
+export const ClaimConfig = {
+  encode(message: ClaimConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.variablePathComponents) {
+      writer.uint32(10).string(v!);
+    }
+    if (message.claimType !== 0) {
+      writer.uint32(16).int32(message.claimType);
+    }
+    if (message.custom !== undefined) {
+      CustomClaim.encode(message.custom, writer.uint32(26).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ClaimConfig {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseClaimConfig();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.variablePathComponents.push(reader.string());
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.claimType = reader.int32() as any;
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.custom = CustomClaim.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ClaimConfig {
+    return {
+      variablePathComponents: Array.isArray(object?.variablePathComponents)
+        ? object.variablePathComponents.map((e: any) => String(e))
+        : [],
+      claimType: isSet(object.claimType) ? claimTypeFromJSON(object.claimType) : 0,
+      custom: isSet(object.custom) ? CustomClaim.fromJSON(object.custom) : undefined,
+    };
+  },
+
+  toJSON(message: ClaimConfig): unknown {
+    const obj: any = {};
+    if (message.variablePathComponents?.length) {
+      obj.variablePathComponents = message.variablePathComponents;
+    }
+    if (message.claimType !== 0) {
+      obj.claimType = claimTypeToJSON(message.claimType);
+    }
+    if (message.custom !== undefined) {
+      obj.custom = CustomClaim.toJSON(message.custom);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ClaimConfig>, I>>(base?: I): ClaimConfig {
+    return ClaimConfig.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ClaimConfig>, I>>(object: I): ClaimConfig {
+    const message = createBaseClaimConfig();
+    message.variablePathComponents = object.variablePathComponents?.map((e) => e) || [];
+    message.claimType = object.claimType ?? 0;
+    message.custom = (object.custom !== undefined && object.custom !== null)
+      ? CustomClaim.fromPartial(object.custom)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseOperationLiveQueryConfig(): OperationLiveQueryConfig {
+  return { enable: false, pollingIntervalSeconds: 0 };
+}
+
+export const OperationLiveQueryConfig = {
+  encode(message: OperationLiveQueryConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.enable === true) {
+      writer.uint32(8).bool(message.enable);
+    }
+    if (message.pollingIntervalSeconds !== 0) {
+      writer.uint32(16).int64(message.pollingIntervalSeconds);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): OperationLiveQueryConfig {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseOperationLiveQueryConfig();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.enable = reader.bool();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.pollingIntervalSeconds = longToNumber(reader.int64() as Long);
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
     }
-    if (message.dateFormat !== "") {
-      writer.uint32(26).string(message.dateFormat);
+    return message;
+  },
+
+  fromJSON(object: any): OperationLiveQueryConfig {
+    return {
+      enable: isSet(object.enable) ? Boolean(object.enable) : false,
+      pollingIntervalSeconds: isSet(object.pollingIntervalSeconds) ? Number(object.pollingIntervalSeconds) : 0,
+    };
+  },
+
+  toJSON(message: OperationLiveQueryConfig): unknown {
+    const obj: any = {};
+    if (message.enable === true) {
+      obj.enable = message.enable;
     }
-    if (message.environmentVariableName !== "") {
-      writer.uint32(34).string(message.environmentVariableName);
+    if (message.pollingIntervalSeconds !== 0) {
+      obj.pollingIntervalSeconds = Math.round(message.pollingIntervalSeconds);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<OperationLiveQueryConfig>, I>>(base?: I): OperationLiveQueryConfig {
+    return OperationLiveQueryConfig.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<OperationLiveQueryConfig>, I>>(object: I): OperationLiveQueryConfig {
+    const message = createBaseOperationLiveQueryConfig();
+    message.enable = object.enable ?? false;
+    message.pollingIntervalSeconds = object.pollingIntervalSeconds ?? 0;
+    return message;
+  },
+};
+
+function createBaseOperationAuthenticationConfig(): OperationAuthenticationConfig {
+  return { authRequired: false };
+}
+
+export const OperationAuthenticationConfig = {
+  encode(message: OperationAuthenticationConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.authRequired === true) {
+      writer.uint32(8).bool(message.authRequired);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): VariableInjectionConfiguration {
+  decode(input: _m0.Reader | Uint8Array, length?: number): OperationAuthenticationConfig {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseVariableInjectionConfiguration();
+    const message = createBaseOperationAuthenticationConfig();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 10) {
+          if (tag !== 8) {
             break;
           }
 
-          message.variablePathComponents.push(reader.string());
+          message.authRequired = reader.bool();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): OperationAuthenticationConfig {
+    return { authRequired: isSet(object.authRequired) ? Boolean(object.authRequired) : false };
+  },
+
+  toJSON(message: OperationAuthenticationConfig): unknown {
+    const obj: any = {};
+    if (message.authRequired === true) {
+      obj.authRequired = message.authRequired;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<OperationAuthenticationConfig>, I>>(base?: I): OperationAuthenticationConfig {
+    return OperationAuthenticationConfig.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<OperationAuthenticationConfig>, I>>(
+    object: I,
+  ): OperationAuthenticationConfig {
+    const message = createBaseOperationAuthenticationConfig();
+    message.authRequired = object.authRequired ?? false;
+    return message;
+  },
+};
+
+function createBaseOperationCacheConfig(): OperationCacheConfig {
+  return {
+    enable: undefined,
+    maxAge: undefined,
+    public: undefined,
+    staleWhileRevalidate: undefined,
+    mustRevalidate: undefined,
+  };
+}
+
+export const OperationCacheConfig = {
+  encode(message: OperationCacheConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.enable !== undefined) {
+      writer.uint32(8).bool(message.enable);
+    }
+    if (message.maxAge !== undefined) {
+      writer.uint32(16).int64(message.maxAge);
+    }
+    if (message.public !== undefined) {
+      writer.uint32(24).bool(message.public);
+    }
+    if (message.staleWhileRevalidate !== undefined) {
+      writer.uint32(32).int64(message.staleWhileRevalidate);
+    }
+    if (message.mustRevalidate !== undefined) {
+      writer.uint32(40).bool(message.mustRevalidate);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): OperationCacheConfig {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseOperationCacheConfig();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.enable = reader.bool();
           continue;
         case 2:
           if (tag !== 16) {
             break;
           }
 
-          message.variableKind = reader.int32() as any;
+          message.maxAge = longToNumber(reader.int64() as Long);
           continue;
         case 3:
-          if (tag !== 26) {
+          if (tag !== 24) {
             break;
           }
 
-          message.dateFormat = reader.string();
+          message.public = reader.bool();
           continue;
         case 4:
-          if (tag !== 34) {
+          if (tag !== 32) {
             break;
           }
 
-          message.environmentVariableName = reader.string();
+          message.staleWhileRevalidate = longToNumber(reader.int64() as Long);
+          continue;
+        case 5:
+          if (tag !== 40) {
+            break;
+          }
+
+          message.mustRevalidate = reader.bool();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -3028,66 +6087,89 @@ export const VariableInjectionConfiguration = {
     return message;
   },
 
-  fromJSON(object: any): VariableInjectionConfiguration {
+  fromJSON(object: any): OperationCacheConfig {
     return {
-      variablePathComponents: Array.isArray(object?.variablePathComponents)
-        ? object.variablePathComponents.map((e: any) => String(e))
-        : [],
-      variableKind: isSet(object.variableKind) ? injectVariableKindFromJSON(object.variableKind) : 0,
-      dateFormat: isSet(object.dateFormat) ? String(object.dateFormat) : "",
-      environmentVariableName: isSet(object.environmentVariableName) ? String(object.environmentVariableName) : "",
+      enable: isSet(object.enable) ? Boolean(object.enable) : undefined,
+      maxAge: isSet(object.maxAge) ? Number(object.maxAge) : undefined,
+      public: isSet(object.public) ? Boolean(object.public) : undefined,
+      staleWhileRevalidate: isSet(object.staleWhileRevalidate) ? Number(object.staleWhileRevalidate) : undefined,
+      mustRevalidate: isSet(object.mustRevalidate) ? Boolean(object.mustRevalidate) : undefined,
     };
   },
 
-  toJSON(message: VariableInjectionConfiguration): unknown {
+  toJSON(message: OperationCacheConfig): unknown {
     const obj: any = {};
-    if (message.variablePathComponents?.length) {
-      obj.variablePathComponents = message.variablePathComponents;
+    if (message.enable !== undefined) {
+      obj.enable = message.enable;
     }
-    if (message.variableKind !== 0) {
-      obj.variableKind = injectVariableKindToJSON(message.variableKind);
+    if (message.maxAge !== undefined) {
+      obj.maxAge = Math.round(message.maxAge);
     }
-    if (message.dateFormat !== "") {
-      obj.dateFormat = message.dateFormat;
+    if (message.public !== undefined) {
+      obj.public = message.public;
     }
-    if (message.environmentVariableName !== "") {
-      obj.environmentVariableName = message.environmentVariableName;
+    if (message.staleWhileRevalidate !== undefined) {
+      obj.staleWhileRevalidate = Math.round(message.staleWhileRevalidate);
+    }
+    if (message.mustRevalidate !== undefined) {
+      obj.mustRevalidate = message.mustRevalidate;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<VariableInjectionConfiguration>, I>>(base?: I): VariableInjectionConfiguration {
-    return VariableInjectionConfiguration.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<OperationCacheConfig>, I>>(base?: I): OperationCacheConfig {
+    return OperationCacheConfig.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<VariableInjectionConfiguration>, I>>(
-    object: I,
-  ): VariableInjectionConfiguration {
-    const message = createBaseVariableInjectionConfiguration();
-    message.variablePathComponents = object.variablePathComponents?.map((e) => e) || [];
-    message.variableKind = object.variableKind ?? 0;
-    message.dateFormat = object.dateFormat ?? "";
-    message.environmentVariableName = object.environmentVariableName ?? "";
+  fromPartial<I extends Exact<DeepPartial<OperationCacheConfig>, I>>(object: I): OperationCacheConfig {
+    const message = createBaseOperationCacheConfig();
+    message.enable = object.enable ?? undefined;
+    message.maxAge = object.maxAge ?? undefined;
+    message.public = object.public ?? undefined;
+    message.staleWhileRevalidate = object.staleWhileRevalidate ?? undefined;
+    message.mustRevalidate = object.mustRevalidate ?? undefined;
     return message;
   },
 };
 
-function createBaseGraphQLDataSourceHooksConfiguration(): GraphQLDataSourceHooksConfiguration {
-  return { onWSTransportConnectionInit: false };
+function createBaseEngineConfiguration(): EngineConfiguration {
+  return {
+    defaultFlushInterval: 0,
+    datasourceConfigurations: [],
+    fieldConfigurations: [],
+    graphqlSchema: "",
+    typeConfigurations: [],
+    stringStorage: {},
+  };
 }
 
-export const GraphQLDataSourceHooksConfiguration = {
-  encode(message: GraphQLDataSourceHooksConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.onWSTransportConnectionInit === true) {
-      writer.uint32(8).bool(message.onWSTransportConnectionInit);
+export const EngineConfiguration = {
+  encode(message: EngineConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.defaultFlushInterval !== 0) {
+      writer.uint32(8).int64(message.defaultFlushInterval);
+    }
+    for (const v of message.datasourceConfigurations) {
+      DataSourceConfiguration.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+    for (const v of message.fieldConfigurations) {
+      FieldConfiguration.encode(v!, writer.uint32(26).fork()).ldelim();
+    }
+    if (message.graphqlSchema !== "") {
+      writer.uint32(34).string(message.graphqlSchema);
+    }
+    for (const v of message.typeConfigurations) {
+      TypeConfiguration.encode(v!, writer.uint32(42).fork()).ldelim();
     }
+    Object.entries(message.stringStorage).forEach(([key, value]) => {
+      EngineConfiguration_StringStorageEntry.encode({ key: key as any, value }, writer.uint32(50).fork()).ldelim();
+    });
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): GraphQLDataSourceHooksConfiguration {
+  decode(input: _m0.Reader | Uint8Array, length?: number): EngineConfiguration {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseGraphQLDataSourceHooksConfiguration();
+    const message = createBaseEngineConfiguration();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -3096,7 +6178,45 @@ export const GraphQLDataSourceHooksConfiguration = {
             break;
           }
 
-          message.onWSTransportConnectionInit = reader.bool();
+          message.defaultFlushInterval = longToNumber(reader.int64() as Long);
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.datasourceConfigurations.push(DataSourceConfiguration.decode(reader, reader.uint32()));
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.fieldConfigurations.push(FieldConfiguration.decode(reader, reader.uint32()));
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.graphqlSchema = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.typeConfigurations.push(TypeConfiguration.decode(reader, reader.uint32()));
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          const entry6 = EngineConfiguration_StringStorageEntry.decode(reader, reader.uint32());
+          if (entry6.value !== undefined) {
+            message.stringStorage[entry6.key] = entry6.value;
+          }
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -3107,72 +6227,117 @@ export const GraphQLDataSourceHooksConfiguration = {
     return message;
   },
 
-  fromJSON(object: any): GraphQLDataSourceHooksConfiguration {
+  fromJSON(object: any): EngineConfiguration {
     return {
-      onWSTransportConnectionInit: isSet(object.onWSTransportConnectionInit)
-        ? Boolean(object.onWSTransportConnectionInit)
-        : false,
+      defaultFlushInterval: isSet(object.defaultFlushInterval) ? Number(object.defaultFlushInterval) : 0,
+      datasourceConfigurations: Array.isArray(object?.datasourceConfigurations)
+        ? object.datasourceConfigurations.map((e: any) => DataSourceConfiguration.fromJSON(e))
+        : [],
+      fieldConfigurations: Array.isArray(object?.fieldConfigurations)
+        ? object.fieldConfigurations.map((e: any) => FieldConfiguration.fromJSON(e))
+        : [],
+      graphqlSchema: isSet(object.graphqlSchema) ? String(object.graphqlSchema) : "",
+      typeConfigurations: Array.isArray(object?.typeConfigurations)
+        ? object.typeConfigurations.map((e: any) => TypeConfiguration.fromJSON(e))
+        : [],
+      stringStorage: isObject(object.stringStorage)
+        ? Object.entries(object.stringStorage).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+          acc[key] = String(value);
+          return acc;
+        }, {})
+        : {},
     };
   },
 
-  toJSON(message: GraphQLDataSourceHooksConfiguration): unknown {
+  toJSON(message: EngineConfiguration): unknown {
     const obj: any = {};
-    if (message.onWSTransportConnectionInit === true) {
-      obj.onWSTransportConnectionInit = message.onWSTransportConnectionInit;
+    if (message.defaultFlushInterval !== 0) {
+      obj.defaultFlushInterval = Math.round(message.defaultFlushInterval);
+    }
+    if (message.datasourceConfigurations?.length) {
+      obj.datasourceConfigurations = message.datasourceConfigurations.map((e) => DataSourceConfiguration.toJSON(e));
+    }
+    if (message.fieldConfigurations?.length) {
+      obj.fieldConfigurations = message.fieldConfigurations.map((e) => FieldConfiguration.toJSON(e));
+    }
+    if (message.graphqlSchema !== "") {
+      obj.graphqlSchema = message.graphqlSchema;
+    }
+    if (message.typeConfigurations?.length) {
+      obj.typeConfigurations = message.typeConfigurations.map((e) => TypeConfiguration.toJSON(e));
+    }
+    if (message.stringStorage) {
+      const entries = Object.entries(message.stringStorage);
+      if (entries.length > 0) {
+        obj.stringStorage = {};
+        entries.forEach(([k, v]) => {
+          obj.stringStorage[k] = v;
+        });
+      }
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<GraphQLDataSourceHooksConfiguration>, I>>(
-    base?: I,
-  ): GraphQLDataSourceHooksConfiguration {
-    return GraphQLDataSourceHooksConfiguration.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<EngineConfiguration>, I>>(base?: I): EngineConfiguration {
+    return EngineConfiguration.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<GraphQLDataSourceHooksConfiguration>, I>>(
-    object: I,
-  ): GraphQLDataSourceHooksConfiguration {
-    const message = createBaseGraphQLDataSourceHooksConfiguration();
-    message.onWSTransportConnectionInit = object.onWSTransportConnectionInit ?? false;
+  fromPartial<I extends Exact<DeepPartial<EngineConfiguration>, I>>(object: I): EngineConfiguration {
+    const message = createBaseEngineConfiguration();
+    message.defaultFlushInterval = object.defaultFlushInterval ?? 0;
+    message.datasourceConfigurations =
+      object.datasourceConfigurations?.map((e) => DataSourceConfiguration.fromPartial(e)) || [];
+    message.fieldConfigurations = object.fieldConfigurations?.map((e) => FieldConfiguration.fromPartial(e)) || [];
+    message.graphqlSchema = object.graphqlSchema ?? "";
+    message.typeConfigurations = object.typeConfigurations?.map((e) => TypeConfiguration.fromPartial(e)) || [];
+    message.stringStorage = Object.entries(object.stringStorage ?? {}).reduce<{ [key: string]: string }>(
+      (acc, [key, value]) => {
+        if (value !== undefined) {
+          acc[key] = String(value);
+        }
+        return acc;
+      },
+      {},
+    );
     return message;
   },
 };
 
-function createBaseHookMatcher(): HookMatcher {
-  return { operationType: undefined, datasources: [] };
+function createBaseEngineConfiguration_StringStorageEntry(): EngineConfiguration_StringStorageEntry {
+  return { key: "", value: "" };
 }
 
-export const HookMatcher = {
-  encode(message: HookMatcher, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.operationType !== undefined) {
-      writer.uint32(8).int32(message.operationType);
+export const EngineConfiguration_StringStorageEntry = {
+  encode(message: EngineConfiguration_StringStorageEntry, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.key !== "") {
+      writer.uint32(10).string(message.key);
     }
-    for (const v of message.datasources) {
-      writer.uint32(18).string(v!);
+    if (message.value !== "") {
+      writer.uint32(18).string(message.value);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): HookMatcher {
+  decode(input: _m0.Reader | Uint8Array, length?: number): EngineConfiguration_StringStorageEntry {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseHookMatcher();
+    const message = createBaseEngineConfiguration_StringStorageEntry();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.operationType = reader.int32() as any;
+          message.key = reader.string();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.datasources.push(reader.string());
+          message.value = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -3183,58 +6348,53 @@ export const HookMatcher = {
     return message;
   },
 
-  fromJSON(object: any): HookMatcher {
-    return {
-      operationType: isSet(object.operationType) ? operationTypeFromJSON(object.operationType) : undefined,
-      datasources: Array.isArray(object?.datasources) ? object.datasources.map((e: any) => String(e)) : [],
-    };
+  fromJSON(object: any): EngineConfiguration_StringStorageEntry {
+    return { key: isSet(object.key) ? String(object.key) : "", value: isSet(object.value) ? String(object.value) : "" };
   },
 
-  toJSON(message: HookMatcher): unknown {
+  toJSON(message: EngineConfiguration_StringStorageEntry): unknown {
     const obj: any = {};
-    if (message.operationType !== undefined) {
-      obj.operationType = operationTypeToJSON(message.operationType);
+    if (message.key !== "") {
+      obj.key = message.key;
     }
-    if (message.datasources?.length) {
-      obj.datasources = message.datasources;
+    if (message.value !== "") {
+      obj.value = message.value;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<HookMatcher>, I>>(base?: I): HookMatcher {
-    return HookMatcher.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<EngineConfiguration_StringStorageEntry>, I>>(
+    base?: I,
+  ): EngineConfiguration_StringStorageEntry {
+    return EngineConfiguration_StringStorageEntry.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<HookMatcher>, I>>(object: I): HookMatcher {
-    const message = createBaseHookMatcher();
-    message.operationType = object.operationType ?? undefined;
-    message.datasources = object.datasources?.map((e) => e) || [];
+  fromPartial<I extends Exact<DeepPartial<EngineConfiguration_StringStorageEntry>, I>>(
+    object: I,
+  ): EngineConfiguration_StringStorageEntry {
+    const message = createBaseEngineConfiguration_StringStorageEntry();
+    message.key = object.key ?? "";
+    message.value = object.value ?? "";
     return message;
   },
 };
 
-function createBaseHook(): Hook {
-  return { id: "", type: 0, matcher: undefined };
+function createBaseInternedString(): InternedString {
+  return { key: "" };
 }
 
-export const Hook = {
-  encode(message: Hook, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.id !== "") {
-      writer.uint32(10).string(message.id);
-    }
-    if (message.type !== 0) {
-      writer.uint32(16).int32(message.type);
-    }
-    if (message.matcher !== undefined) {
-      HookMatcher.encode(message.matcher, writer.uint32(26).fork()).ldelim();
+export const InternedString = {
+  encode(message: InternedString, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.key !== "") {
+      writer.uint32(10).string(message.key);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): Hook {
+  decode(input: _m0.Reader | Uint8Array, length?: number): InternedString {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseHook();
+    const message = createBaseInternedString();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -3243,21 +6403,7 @@ export const Hook = {
             break;
           }
 
-          message.id = reader.string();
-          continue;
-        case 2:
-          if (tag !== 16) {
-            break;
-          }
-
-          message.type = reader.int32() as any;
-          continue;
-        case 3:
-          if (tag !== 26) {
-            break;
-          }
-
-          message.matcher = HookMatcher.decode(reader, reader.uint32());
+          message.key = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -3268,89 +6414,99 @@ export const Hook = {
     return message;
   },
 
-  fromJSON(object: any): Hook {
-    return {
-      id: isSet(object.id) ? String(object.id) : "",
-      type: isSet(object.type) ? hookTypeFromJSON(object.type) : 0,
-      matcher: isSet(object.matcher) ? HookMatcher.fromJSON(object.matcher) : undefined,
-    };
+  fromJSON(object: any): InternedString {
+    return { key: isSet(object.key) ? String(object.key) : "" };
   },
 
-  toJSON(message: Hook): unknown {
+  toJSON(message: InternedString): unknown {
     const obj: any = {};
-    if (message.id !== "") {
-      obj.id = message.id;
-    }
-    if (message.type !== 0) {
-      obj.type = hookTypeToJSON(message.type);
-    }
-    if (message.matcher !== undefined) {
-      obj.matcher = HookMatcher.toJSON(message.matcher);
+    if (message.key !== "") {
+      obj.key = message.key;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<Hook>, I>>(base?: I): Hook {
-    return Hook.fromPartial(base ?? {});
-  },
-
-  fromPartial<I extends Exact<DeepPartial<Hook>, I>>(object: I): Hook {
-    const message = createBaseHook();
-    message.id = object.id ?? "";
-    message.type = object.type ?? 0;
-    message.matcher = (object.matcher !== undefined && object.matcher !== null)
-      ? HookMatcher.fromPartial(object.matcher)
-      : undefined;
+  create<I extends Exact<DeepPartial<InternedString>, I>>(base?: I): InternedString {
+    return InternedString.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<InternedString>, I>>(object: I): InternedString {
+    const message = createBaseInternedString();
+    message.key = object.key ?? "";
     return message;
   },
 };
 
-function createBaseOperationHooksConfiguration(): OperationHooksConfiguration {
+function createBaseDataSourceConfiguration(): DataSourceConfiguration {
   return {
-    preResolve: false,
-    postResolve: false,
-    mutatingPreResolve: false,
-    mutatingPostResolve: false,
-    mockResolve: undefined,
-    httpTransportOnRequest: false,
-    httpTransportOnResponse: false,
-    customResolve: false,
+    kind: 0,
+    rootNodes: [],
+    childNodes: [],
+    overrideFieldPathFromAlias: false,
+    customRest: undefined,
+    customGraphql: undefined,
+    customStatic: undefined,
+    customDatabase: undefined,
+    directives: [],
+    requestTimeoutSeconds: 0,
+    id: "",
+    customNatsKv: undefined,
+    customWebhookEvents: undefined,
+    originRequestExpressions: [],
   };
 }
 
-export const OperationHooksConfiguration = {
-  encode(message: OperationHooksConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.preResolve === true) {
-      writer.uint32(8).bool(message.preResolve);
+export const DataSourceConfiguration = {
+  encode(message: DataSourceConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.kind !== 0) {
+      writer.uint32(8).int32(message.kind);
     }
-    if (message.postResolve === true) {
-      writer.uint32(16).bool(message.postResolve);
+    for (const v of message.rootNodes) {
+      TypeField.encode(v!, writer.uint32(18).fork()).ldelim();
     }
-    if (message.mutatingPreResolve === true) {
-      writer.uint32(24).bool(message.mutatingPreResolve);
+    for (const v of message.childNodes) {
+      TypeField.encode(v!, writer.uint32(26).fork()).ldelim();
     }
-    if (message.mutatingPostResolve === true) {
-      writer.uint32(32).bool(message.mutatingPostResolve);
+    if (message.overrideFieldPathFromAlias === true) {
+      writer.uint32(32).bool(message.overrideFieldPathFromAlias);
     }
-    if (message.mockResolve !== undefined) {
-      MockResolveHookConfiguration.encode(message.mockResolve, writer.uint32(42).fork()).ldelim();
+    if (message.customRest !== undefined) {
+      DataSourceCustomREST.encode(message.customRest, writer.uint32(42).fork()).ldelim();
     }
-    if (message.httpTransportOnRequest === true) {
-      writer.uint32(48).bool(message.httpTransportOnRequest);
+    if (message.customGraphql !== undefined) {
+      DataSourceCustomGraphQL.encode(message.customGraphql, writer.uint32(50).fork()).ldelim();
     }
-    if (message.httpTransportOnResponse === true) {
-      writer.uint32(56).bool(message.httpTransportOnResponse);
+    if (message.customStatic !== undefined) {
+      DataSourceCustomStatic.encode(message.customStatic, writer.uint32(58).fork()).ldelim();
     }
-    if (message.customResolve === true) {
-      writer.uint32(64).bool(message.customResolve);
+    if (message.customDatabase !== undefined) {
+      DataSourceCustomDatabase.encode(message.customDatabase, writer.uint32(66).fork()).ldelim();
+    }
+    for (const v of message.directives) {
+      DirectiveConfiguration.encode(v!, writer.uint32(74).fork()).ldelim();
+    }
+    if (message.requestTimeoutSeconds !== 0) {
+      writer.uint32(80).int64(message.requestTimeoutSeconds);
+    }
+    if (message.id !== "") {
+      writer.uint32(90).string(message.id);
+    }
+    if (message.customNatsKv !== undefined) {
+      DataSourceCustomNatsKv.encode(message.customNatsKv, writer.uint32(98).fork()).ldelim();
+    }
+    if (message.customWebhookEvents !== undefined) {
+      DataSourceCustomWebhookEvents.encode(message.customWebhookEvents, writer.uint32(106).fork()).ldelim();
+    }
+    for (const v of message.originRequestExpressions) {
+      ExpressionHook.encode(v!, writer.uint32(114).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): OperationHooksConfiguration {
+  decode(input: _m0.Reader | Uint8Array, length?: number): DataSourceConfiguration {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseOperationHooksConfiguration();
+    const message = createBaseDataSourceConfiguration();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -3359,240 +6515,98 @@ export const OperationHooksConfiguration = {
             break;
           }
 
-          message.preResolve = reader.bool();
+          message.kind = reader.int32() as any;
           continue;
         case 2:
-          if (tag !== 16) {
+          if (tag !== 18) {
             break;
           }
 
-          message.postResolve = reader.bool();
+          message.rootNodes.push(TypeField.decode(reader, reader.uint32()));
           continue;
         case 3:
-          if (tag !== 24) {
+          if (tag !== 26) {
             break;
           }
 
-          message.mutatingPreResolve = reader.bool();
+          message.childNodes.push(TypeField.decode(reader, reader.uint32()));
           continue;
         case 4:
           if (tag !== 32) {
             break;
           }
 
-          message.mutatingPostResolve = reader.bool();
+          message.overrideFieldPathFromAlias = reader.bool();
           continue;
         case 5:
           if (tag !== 42) {
             break;
           }
 
-          message.mockResolve = MockResolveHookConfiguration.decode(reader, reader.uint32());
+          message.customRest = DataSourceCustomREST.decode(reader, reader.uint32());
           continue;
         case 6:
-          if (tag !== 48) {
+          if (tag !== 50) {
             break;
           }
 
-          message.httpTransportOnRequest = reader.bool();
+          message.customGraphql = DataSourceCustomGraphQL.decode(reader, reader.uint32());
           continue;
         case 7:
-          if (tag !== 56) {
+          if (tag !== 58) {
             break;
           }
 
-          message.httpTransportOnResponse = reader.bool();
+          message.customStatic = DataSourceCustomStatic.decode(reader, reader.uint32());
           continue;
         case 8:
-          if (tag !== 64) {
-            break;
-          }
-
-          message.customResolve = reader.bool();
-          continue;
-      }
-      if ((tag & 7) === 4 || tag === 0) {
-        break;
-      }
-      reader.skipType(tag & 7);
-    }
-    return message;
-  },
-
-  fromJSON(object: any): OperationHooksConfiguration {
-    return {
-      preResolve: isSet(object.preResolve) ? Boolean(object.preResolve) : false,
-      postResolve: isSet(object.postResolve) ? Boolean(object.postResolve) : false,
-      mutatingPreResolve: isSet(object.mutatingPreResolve) ? Boolean(object.mutatingPreResolve) : false,
-      mutatingPostResolve: isSet(object.mutatingPostResolve) ? Boolean(object.mutatingPostResolve) : false,
-      mockResolve: isSet(object.mockResolve) ? MockResolveHookConfiguration.fromJSON(object.mockResolve) : undefined,
-      httpTransportOnRequest: isSet(object.httpTransportOnRequest) ? Boolean(object.httpTransportOnRequest) : false,
-      httpTransportOnResponse: isSet(object.httpTransportOnResponse) ? Boolean(object.httpTransportOnResponse) : false,
-      customResolve: isSet(object.customResolve) ? Boolean(object.customResolve) : false,
-    };
-  },
-
-  toJSON(message: OperationHooksConfiguration): unknown {
-    const obj: any = {};
-    if (message.preResolve === true) {
-      obj.preResolve = message.preResolve;
-    }
-    if (message.postResolve === true) {
-      obj.postResolve = message.postResolve;
-    }
-    if (message.mutatingPreResolve === true) {
-      obj.mutatingPreResolve = message.mutatingPreResolve;
-    }
-    if (message.mutatingPostResolve === true) {
-      obj.mutatingPostResolve = message.mutatingPostResolve;
-    }
-    if (message.mockResolve !== undefined) {
-      obj.mockResolve = MockResolveHookConfiguration.toJSON(message.mockResolve);
-    }
-    if (message.httpTransportOnRequest === true) {
-      obj.httpTransportOnRequest = message.httpTransportOnRequest;
-    }
-    if (message.httpTransportOnResponse === true) {
-      obj.httpTransportOnResponse = message.httpTransportOnResponse;
-    }
-    if (message.customResolve === true) {
-      obj.customResolve = message.customResolve;
-    }
-    return obj;
-  },
-
-  create<I extends Exact<DeepPartial<OperationHooksConfiguration>, I>>(base?: I): OperationHooksConfiguration {
-    return OperationHooksConfiguration.fromPartial(base ?? {});
-  },
-
-  fromPartial<I extends Exact<DeepPartial<OperationHooksConfiguration>, I>>(object: I): OperationHooksConfiguration {
-    const message = createBaseOperationHooksConfiguration();
-    message.preResolve = object.preResolve ?? false;
-    message.postResolve = object.postResolve ?? false;
-    message.mutatingPreResolve = object.mutatingPreResolve ?? false;
-    message.mutatingPostResolve = object.mutatingPostResolve ?? false;
-    message.mockResolve = (object.mockResolve !== undefined && object.mockResolve !== null)
-      ? MockResolveHookConfiguration.fromPartial(object.mockResolve)
-      : undefined;
-    message.httpTransportOnRequest = object.httpTransportOnRequest ?? false;
-    message.httpTransportOnResponse = object.httpTransportOnResponse ?? false;
-    message.customResolve = object.customResolve ?? false;
-    return message;
-  },
-};
-
-function createBaseMockResolveHookConfiguration(): MockResolveHookConfiguration {
-  return { enable: false, subscriptionPollingIntervalMillis: 0 };
-}
-
-export const MockResolveHookConfiguration = {
-  encode(message: MockResolveHookConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.enable === true) {
-      writer.uint32(8).bool(message.enable);
-    }
-    if (message.subscriptionPollingIntervalMillis !== 0) {
-      writer.uint32(16).int64(message.subscriptionPollingIntervalMillis);
-    }
-    return writer;
-  },
-
-  decode(input: _m0.Reader | Uint8Array, length?: number): MockResolveHookConfiguration {
-    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
-    let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseMockResolveHookConfiguration();
-    while (reader.pos < end) {
-      const tag = reader.uint32();
-      switch (tag >>> 3) {
-        case 1:
-          if (tag !== 8) {
-            break;
-          }
-
-          message.enable = reader.bool();
-          continue;
-        case 2:
-          if (tag !== 16) {
+          if (tag !== 66) {
             break;
           }
 
-          message.subscriptionPollingIntervalMillis = longToNumber(reader.int64() as Long);
+          message.customDatabase = DataSourceCustomDatabase.decode(reader, reader.uint32());
           continue;
-      }
-      if ((tag & 7) === 4 || tag === 0) {
-        break;
-      }
-      reader.skipType(tag & 7);
-    }
-    return message;
-  },
-
-  fromJSON(object: any): MockResolveHookConfiguration {
-    return {
-      enable: isSet(object.enable) ? Boolean(object.enable) : false,
-      subscriptionPollingIntervalMillis: isSet(object.subscriptionPollingIntervalMillis)
-        ? Number(object.subscriptionPollingIntervalMillis)
-        : 0,
-    };
-  },
-
-  toJSON(message: MockResolveHookConfiguration): unknown {
-    const obj: any = {};
-    if (message.enable === true) {
-      obj.enable = message.enable;
-    }
-    if (message.subscriptionPollingIntervalMillis !== 0) {
-      obj.subscriptionPollingIntervalMillis = Math.round(message.subscriptionPollingIntervalMillis);
-    }
-    return obj;
-  },
-
-  create<I extends Exact<DeepPartial<MockResolveHookConfiguration>, I>>(base?: I): MockResolveHookConfiguration {
-    return MockResolveHookConfiguration.fromPartial(base ?? {});
-  },
-
-  fromPartial<I extends Exact<DeepPartial<MockResolveHookConfiguration>, I>>(object: I): MockResolveHookConfiguration {
-    const message = createBaseMockResolveHookConfiguration();
-    message.enable = object.enable ?? false;
-    message.subscriptionPollingIntervalMillis = object.subscriptionPollingIntervalMillis ?? 0;
-    return message;
-  },
-};
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
 
-function createBaseOperationAuthorizationConfig(): OperationAuthorizationConfig {
-  return { claims: [], roleConfig: undefined };
-}
+          message.directives.push(DirectiveConfiguration.decode(reader, reader.uint32()));
+          continue;
+        case 10:
+          if (tag !== 80) {
+            break;
+          }
 
-export const OperationAuthorizationConfig = {
-  encode(message: OperationAuthorizationConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    for (const v of message.claims) {
-      ClaimConfig.encode(v!, writer.uint32(10).fork()).ldelim();
-    }
-    if (message.roleConfig !== undefined) {
-      OperationRoleConfig.encode(message.roleConfig, writer.uint32(18).fork()).ldelim();
-    }
-    return writer;
-  },
+          message.requestTimeoutSeconds = longToNumber(reader.int64() as Long);
+          continue;
+        case 11:
+          if (tag !== 90) {
+            break;
+          }
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): OperationAuthorizationConfig {
-    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
-    let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseOperationAuthorizationConfig();
-    while (reader.pos < end) {
-      const tag = reader.uint32();
-      switch (tag >>> 3) {
-        case 1:
-          if (tag !== 10) {
+          message.id = reader.string();
+          continue;
+        case 12:
+          if (tag !== 98) {
             break;
           }
 
-          message.claims.push(ClaimConfig.decode(reader, reader.uint32()));
+          message.customNatsKv = DataSourceCustomNatsKv.decode(reader, reader.uint32());
           continue;
-        case 2:
-          if (tag !== 18) {
+        case 13:
+          if (tag !== 106) {
             break;
           }
 
-          message.roleConfig = OperationRoleConfig.decode(reader, reader.uint32());
+          message.customWebhookEvents = DataSourceCustomWebhookEvents.decode(reader, reader.uint32());
+          continue;
+        case 14:
+          if (tag !== 114) {
+            break;
+          }
+
+          message.originRequestExpressions.push(ExpressionHook.decode(reader, reader.uint32()));
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -3603,63 +6617,137 @@ export const OperationAuthorizationConfig = {
     return message;
   },
 
-  fromJSON(object: any): OperationAuthorizationConfig {
+  fromJSON(object: any): DataSourceConfiguration {
     return {
-      claims: Array.isArray(object?.claims) ? object.claims.map((e: any) => ClaimConfig.fromJSON(e)) : [],
-      roleConfig: isSet(object.roleConfig) ? OperationRoleConfig.fromJSON(object.roleConfig) : undefined,
+      kind: isSet(object.kind) ? dataSourceKindFromJSON(object.kind) : 0,
+      rootNodes: Array.isArray(object?.rootNodes) ? object.rootNodes.map((e: any) => TypeField.fromJSON(e)) : [],
+      childNodes: Array.isArray(object?.childNodes) ? object.childNodes.map((e: any) => TypeField.fromJSON(e)) : [],
+      overrideFieldPathFromAlias: isSet(object.overrideFieldPathFromAlias)
+        ? Boolean(object.overrideFieldPathFromAlias)
+        : false,
+      customRest: isSet(object.customRest) ? DataSourceCustomREST.fromJSON(object.customRest) : undefined,
+      customGraphql: isSet(object.customGraphql) ? DataSourceCustomGraphQL.fromJSON(object.customGraphql) : undefined,
+      customStatic: isSet(object.customStatic) ? DataSourceCustomStatic.fromJSON(object.customStatic) : undefined,
+      customDatabase: isSet(object.customDatabase)
+        ? DataSourceCustomDatabase.fromJSON(object.customDatabase)
+        : undefined,
+      directives: Array.isArray(object?.directives)
+        ? object.directives.map((e: any) => DirectiveConfiguration.fromJSON(e))
+        : [],
+      requestTimeoutSeconds: isSet(object.requestTimeoutSeconds) ? Number(object.requestTimeoutSeconds) : 0,
+      id: isSet(object.id) ? String(object.id) : "",
+      customNatsKv: isSet(object.customNatsKv) ? DataSourceCustomNatsKv.fromJSON(object.customNatsKv) : undefined,
+      customWebhookEvents: isSet(object.customWebhookEvents)
+        ? DataSourceCustomWebhookEvents.fromJSON(object.customWebhookEvents)
+        : undefined,
+      originRequestExpressions: Array.isArray(object?.originRequestExpressions)
+        ? object.originRequestExpressions.map((e: any) => ExpressionHook.fromJSON(e))
+        : [],
     };
   },
 
-  toJSON(message: OperationAuthorizationConfig): unknown {
+  toJSON(message: DataSourceConfiguration): unknown {
     const obj: any = {};
-    if (message.claims?.length) {
-      obj.claims = message.claims.map((e) => ClaimConfig.toJSON(e));
+    if (message.kind !== 0) {
+      obj.kind = dataSourceKindToJSON(message.kind);
     }
-    if (message.roleConfig !== undefined) {
-      obj.roleConfig = OperationRoleConfig.toJSON(message.roleConfig);
+    if (message.rootNodes?.length) {
+      obj.rootNodes = message.rootNodes.map((e) => TypeField.toJSON(e));
+    }
+    if (message.childNodes?.length) {
+      obj.childNodes = message.childNodes.map((e) => TypeField.toJSON(e));
+    }
+    if (message.overrideFieldPathFromAlias === true) {
+      obj.overrideFieldPathFromAlias = message.overrideFieldPathFromAlias;
+    }
+    if (message.customRest !== undefined) {
+      obj.customRest = DataSourceCustomREST.toJSON(message.customRest);
+    }
+    if (message.customGraphql !== undefined) {
+      obj.customGraphql = DataSourceCustomGraphQL.toJSON(message.customGraphql);
+    }
+    if (message.customStatic !== undefined) {
+      obj.customStatic = DataSourceCustomStatic.toJSON(message.customStatic);
+    }
+    if (message.customDatabase !== undefined) {
+      obj.customDatabase = DataSourceCustomDatabase.toJSON(message.customDatabase);
+    }
+    if (message.directives?.length) {
+      obj.directives = message.directives.map((e) => DirectiveConfiguration.toJSON(e));
+    }
+    if (message.requestTimeoutSeconds !== 0) {
+      obj.requestTimeoutSeconds = Math.round(message.requestTimeoutSeconds);
+    }
+    if (message.id !== "") {
+      obj.id = message.id;
+    }
+    if (message.customNatsKv !== undefined) {
+      obj.customNatsKv = DataSourceCustomNatsKv.toJSON(message.customNatsKv);
+    }
+    if (message.customWebhookEvents !== undefined) {
+      obj.customWebhookEvents = DataSourceCustomWebhookEvents.toJSON(message.customWebhookEvents);
+    }
+    if (message.originRequestExpressions?.length) {
+      obj.originRequestExpressions = message.originRequestExpressions.map((e) => ExpressionHook.toJSON(e));
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<OperationAuthorizationConfig>, I>>(base?: I): OperationAuthorizationConfig {
-    return OperationAuthorizationConfig.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<DataSourceConfiguration>, I>>(base?: I): DataSourceConfiguration {
+    return DataSourceConfiguration.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<OperationAuthorizationConfig>, I>>(object: I): OperationAuthorizationConfig {
-    const message = createBaseOperationAuthorizationConfig();
-    message.claims = object.claims?.map((e) => ClaimConfig.fromPartial(e)) || [];
-    message.roleConfig = (object.roleConfig !== undefined && object.roleConfig !== null)
-      ? OperationRoleConfig.fromPartial(object.roleConfig)
+  fromPartial<I extends Exact<DeepPartial<DataSourceConfiguration>, I>>(object: I): DataSourceConfiguration {
+    const message = createBaseDataSourceConfiguration();
+    message.kind = object.kind ?? 0;
+    message.rootNodes = object.rootNodes?.map((e) => TypeField.fromPartial(e)) || [];
+    message.childNodes = object.childNodes?.map((e) => TypeField.fromPartial(e)) || [];
+    message.overrideFieldPathFromAlias = object.overrideFieldPathFromAlias ?? false;
+    message.customRest = (object.customRest !== undefined && object.customRest !== null)
+      ? DataSourceCustomREST.fromPartial(object.customRest)
+      : undefined;
+    message.customGraphql = (object.customGraphql !== undefined && object.customGraphql !== null)
+      ? DataSourceCustomGraphQL.fromPartial(object.customGraphql)
+      : undefined;
+    message.customStatic = (object.customStatic !== undefined && object.customStatic !== null)
+      ? DataSourceCustomStatic.fromPartial(object.customStatic)
+      : undefined;
+    message.customDatabase = (object.customDatabase !== undefined && object.customDatabase !== null)
+      ? DataSourceCustomDatabase.fromPartial(object.customDatabase)
+      : undefined;
+    message.directives = object.directives?.map((e) => DirectiveConfiguration.fromPartial(e)) || [];
+    message.requestTimeoutSeconds = object.requestTimeoutSeconds ?? 0;
+    message.id = object.id ?? "";
+    message.customNatsKv = (object.customNatsKv !== undefined && object.customNatsKv !== null)
+      ? DataSourceCustomNatsKv.fromPartial(object.customNatsKv)
+      : undefined;
+    message.customWebhookEvents = (object.customWebhookEvents !== undefined && object.customWebhookEvents !== null)
+      ? DataSourceCustomWebhookEvents.fromPartial(object.customWebhookEvents)
       : undefined;
+    message.originRequestExpressions = object.originRequestExpressions?.map((e) => ExpressionHook.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseOperationRoleConfig(): OperationRoleConfig {
-  return { requireMatchAll: [], requireMatchAny: [], denyMatchAll: [], denyMatchAny: [] };
+function createBaseDirectiveConfiguration(): DirectiveConfiguration {
+  return { directiveName: "", renameTo: "" };
 }
 
-export const OperationRoleConfig = {
-  encode(message: OperationRoleConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    for (const v of message.requireMatchAll) {
-      writer.uint32(10).string(v!);
-    }
-    for (const v of message.requireMatchAny) {
-      writer.uint32(18).string(v!);
-    }
-    for (const v of message.denyMatchAll) {
-      writer.uint32(26).string(v!);
+export const DirectiveConfiguration = {
+  encode(message: DirectiveConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.directiveName !== "") {
+      writer.uint32(10).string(message.directiveName);
     }
-    for (const v of message.denyMatchAny) {
-      writer.uint32(34).string(v!);
+    if (message.renameTo !== "") {
+      writer.uint32(18).string(message.renameTo);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): OperationRoleConfig {
+  decode(input: _m0.Reader | Uint8Array, length?: number): DirectiveConfiguration {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseOperationRoleConfig();
+    const message = createBaseDirectiveConfiguration();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -3668,28 +6756,14 @@ export const OperationRoleConfig = {
             break;
           }
 
-          message.requireMatchAll.push(reader.string());
+          message.directiveName = reader.string();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.requireMatchAny.push(reader.string());
-          continue;
-        case 3:
-          if (tag !== 26) {
-            break;
-          }
-
-          message.denyMatchAll.push(reader.string());
-          continue;
-        case 4:
-          if (tag !== 34) {
-            break;
-          }
-
-          message.denyMatchAny.push(reader.string());
+          message.renameTo = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -3700,71 +6774,67 @@ export const OperationRoleConfig = {
     return message;
   },
 
-  fromJSON(object: any): OperationRoleConfig {
+  fromJSON(object: any): DirectiveConfiguration {
     return {
-      requireMatchAll: Array.isArray(object?.requireMatchAll) ? object.requireMatchAll.map((e: any) => String(e)) : [],
-      requireMatchAny: Array.isArray(object?.requireMatchAny) ? object.requireMatchAny.map((e: any) => String(e)) : [],
-      denyMatchAll: Array.isArray(object?.denyMatchAll) ? object.denyMatchAll.map((e: any) => String(e)) : [],
-      denyMatchAny: Array.isArray(object?.denyMatchAny) ? object.denyMatchAny.map((e: any) => String(e)) : [],
+      directiveName: isSet(object.directiveName) ? String(object.directiveName) : "",
+      renameTo: isSet(object.renameTo) ? String(object.renameTo) : "",
     };
   },
 
-  toJSON(message: OperationRoleConfig): unknown {
+  toJSON(message: DirectiveConfiguration): unknown {
     const obj: any = {};
-    if (message.requireMatchAll?.length) {
-      obj.requireMatchAll = message.requireMatchAll;
-    }
-    if (message.requireMatchAny?.length) {
-      obj.requireMatchAny = message.requireMatchAny;
-    }
-    if (message.denyMatchAll?.length) {
-      obj.denyMatchAll = message.denyMatchAll;
+    if (message.directiveName !== "") {
+      obj.directiveName = message.directiveName;
     }
-    if (message.denyMatchAny?.length) {
-      obj.denyMatchAny = message.denyMatchAny;
+    if (message.renameTo !== "") {
+      obj.renameTo = message.renameTo;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<OperationRoleConfig>, I>>(base?: I): OperationRoleConfig {
-    return OperationRoleConfig.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<DirectiveConfiguration>, I>>(base?: I): DirectiveConfiguration {
+    return DirectiveConfiguration.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<OperationRoleConfig>, I>>(object: I): OperationRoleConfig {
-    const message = createBaseOperationRoleConfig();
-    message.requireMatchAll = object.requireMatchAll?.map((e) => e) || [];
-    message.requireMatchAny = object.requireMatchAny?.map((e) => e) || [];
-    message.denyMatchAll = object.denyMatchAll?.map((e) => e) || [];
-    message.denyMatchAny = object.denyMatchAny?.map((e) => e) || [];
+  fromPartial<I extends Exact<DeepPartial<DirectiveConfiguration>, I>>(object: I): DirectiveConfiguration {
+    const message = createBaseDirectiveConfiguration();
+    message.directiveName = object.directiveName ?? "";
+    message.renameTo = object.renameTo ?? "";
     return message;
   },
 };
 
-function createBaseCustomClaim(): CustomClaim {
-  return { name: "", jsonPathComponents: [], type: 0, required: false };
+function createBaseDataSourceCustomNatsKv(): DataSourceCustomNatsKv {
+  return { serverURL: "", bucketName: "", operation: 0, history: 0, token: "", bucketPrefix: undefined };
 }
 
-export const CustomClaim = {
-  encode(message: CustomClaim, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.name !== "") {
-      writer.uint32(10).string(message.name);
+export const DataSourceCustomNatsKv = {
+  encode(message: DataSourceCustomNatsKv, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.serverURL !== "") {
+      writer.uint32(10).string(message.serverURL);
     }
-    for (const v of message.jsonPathComponents) {
-      writer.uint32(18).string(v!);
+    if (message.bucketName !== "") {
+      writer.uint32(18).string(message.bucketName);
+    }
+    if (message.operation !== 0) {
+      writer.uint32(24).int32(message.operation);
+    }
+    if (message.history !== 0) {
+      writer.uint32(32).int32(message.history);
     }
-    if (message.type !== 0) {
-      writer.uint32(24).int32(message.type);
+    if (message.token !== "") {
+      writer.uint32(42).string(message.token);
     }
-    if (message.required === true) {
-      writer.uint32(32).bool(message.required);
+    if (message.bucketPrefix !== undefined) {
+      ConfigurationVariable.encode(message.bucketPrefix, writer.uint32(50).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): CustomClaim {
+  decode(input: _m0.Reader | Uint8Array, length?: number): DataSourceCustomNatsKv {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseCustomClaim();
+    const message = createBaseDataSourceCustomNatsKv();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -3773,28 +6843,42 @@ export const CustomClaim = {
             break;
           }
 
-          message.name = reader.string();
+          message.serverURL = reader.string();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.jsonPathComponents.push(reader.string());
+          message.bucketName = reader.string();
           continue;
         case 3:
           if (tag !== 24) {
             break;
           }
 
-          message.type = reader.int32() as any;
+          message.operation = reader.int32() as any;
           continue;
         case 4:
           if (tag !== 32) {
             break;
           }
 
-          message.required = reader.bool();
+          message.history = reader.int32();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.token = reader.string();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.bucketPrefix = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -3805,70 +6889,74 @@ export const CustomClaim = {
     return message;
   },
 
-  fromJSON(object: any): CustomClaim {
+  fromJSON(object: any): DataSourceCustomNatsKv {
     return {
-      name: isSet(object.name) ? String(object.name) : "",
-      jsonPathComponents: Array.isArray(object?.jsonPathComponents)
-        ? object.jsonPathComponents.map((e: any) => String(e))
-        : [],
-      type: isSet(object.type) ? valueTypeFromJSON(object.type) : 0,
-      required: isSet(object.required) ? Boolean(object.required) : false,
+      serverURL: isSet(object.serverURL) ? String(object.serverURL) : "",
+      bucketName: isSet(object.bucketName) ? String(object.bucketName) : "",
+      operation: isSet(object.operation) ? natsKvOperationFromJSON(object.operation) : 0,
+      history: isSet(object.history) ? Number(object.history) : 0,
+      token: isSet(object.token) ? String(object.token) : "",
+      bucketPrefix: isSet(object.bucketPrefix) ? ConfigurationVariable.fromJSON(object.bucketPrefix) : undefined,
     };
   },
 
-  toJSON(message: CustomClaim): unknown {
+  toJSON(message: DataSourceCustomNatsKv): unknown {
     const obj: any = {};
-    if (message.name !== "") {
-      obj.name = message.name;
+    if (message.serverURL !== "") {
+      obj.serverURL = message.serverURL;
     }
-    if (message.jsonPathComponents?.length) {
-      obj.jsonPathComponents = message.jsonPathComponents;
+    if (message.bucketName !== "") {
+      obj.bucketName = message.bucketName;
     }
-    if (message.type !== 0) {
-      obj.type = valueTypeToJSON(message.type);
+    if (message.operation !== 0) {
+      obj.operation = natsKvOperationToJSON(message.operation);
     }
-    if (message.required === true) {
-      obj.required = message.required;
+    if (message.history !== 0) {
+      obj.history = Math.round(message.history);
+    }
+    if (message.token !== "") {
+      obj.token = message.token;
+    }
+    if (message.bucketPrefix !== undefined) {
+      obj.bucketPrefix = ConfigurationVariable.toJSON(message.bucketPrefix);
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<CustomClaim>, I>>(base?: I): CustomClaim {
-    return CustomClaim.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<DataSourceCustomNatsKv>, I>>(base?: I): DataSourceCustomNatsKv {
+    return DataSourceCustomNatsKv.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<CustomClaim>, I>>(object: I): CustomClaim {
-    const message = createBaseCustomClaim();
-    message.name = object.name ?? "";
-    message.jsonPathComponents = object.jsonPathComponents?.map((e) => e) || [];
-    message.type = object.type ?? 0;
-    message.required = object.required ?? false;
+  fromPartial<I extends Exact<DeepPartial<DataSourceCustomNatsKv>, I>>(object: I): DataSourceCustomNatsKv {
+    const message = createBaseDataSourceCustomNatsKv();
+    message.serverURL = object.serverURL ?? "";
+    message.bucketName = object.bucketName ?? "";
+    message.operation = object.operation ?? 0;
+    message.history = object.history ?? 0;
+    message.token = object.token ?? "";
+    message.bucketPrefix = (object.bucketPrefix !== undefined && object.bucketPrefix !== null)
+      ? ConfigurationVariable.fromPartial(object.bucketPrefix)
+      : undefined;
     return message;
   },
 };
 
-function createBaseClaimConfig(): ClaimConfig {
-  return { variablePathComponents: [], claimType: 0, custom: undefined };
+function createBaseDataSourceCustomWebhookEvents(): DataSourceCustomWebhookEvents {
+  return { webhook: "" };
 }
 
-export const ClaimConfig = {
-  encode(message: ClaimConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    for (const v of message.variablePathComponents) {
-      writer.uint32(10).string(v!);
-    }
-    if (message.claimType !== 0) {
-      writer.uint32(16).int32(message.claimType);
-    }
-    if (message.custom !== undefined) {
-      CustomClaim.encode(message.custom, writer.uint32(26).fork()).ldelim();
+export const DataSourceCustomWebhookEvents = {
+  encode(message: DataSourceCustomWebhookEvents, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.webhook !== "") {
+      writer.uint32(10).string(message.webhook);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ClaimConfig {
+  decode(input: _m0.Reader | Uint8Array, length?: number): DataSourceCustomWebhookEvents {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseClaimConfig();
+    const message = createBaseDataSourceCustomWebhookEvents();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -3877,21 +6965,7 @@ export const ClaimConfig = {
             break;
           }
 
-          message.variablePathComponents.push(reader.string());
-          continue;
-        case 2:
-          if (tag !== 16) {
-            break;
-          }
-
-          message.claimType = reader.int32() as any;
-          continue;
-        case 3:
-          if (tag !== 26) {
-            break;
-          }
-
-          message.custom = CustomClaim.decode(reader, reader.uint32());
+          message.webhook = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -3902,80 +6976,86 @@ export const ClaimConfig = {
     return message;
   },
 
-  fromJSON(object: any): ClaimConfig {
-    return {
-      variablePathComponents: Array.isArray(object?.variablePathComponents)
-        ? object.variablePathComponents.map((e: any) => String(e))
-        : [],
-      claimType: isSet(object.claimType) ? claimTypeFromJSON(object.claimType) : 0,
-      custom: isSet(object.custom) ? CustomClaim.fromJSON(object.custom) : undefined,
-    };
+  fromJSON(object: any): DataSourceCustomWebhookEvents {
+    return { webhook: isSet(object.webhook) ? String(object.webhook) : "" };
   },
 
-  toJSON(message: ClaimConfig): unknown {
+  toJSON(message: DataSourceCustomWebhookEvents): unknown {
     const obj: any = {};
-    if (message.variablePathComponents?.length) {
-      obj.variablePathComponents = message.variablePathComponents;
-    }
-    if (message.claimType !== 0) {
-      obj.claimType = claimTypeToJSON(message.claimType);
-    }
-    if (message.custom !== undefined) {
-      obj.custom = CustomClaim.toJSON(message.custom);
+    if (message.webhook !== "") {
+      obj.webhook = message.webhook;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<ClaimConfig>, I>>(base?: I): ClaimConfig {
-    return ClaimConfig.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<DataSourceCustomWebhookEvents>, I>>(base?: I): DataSourceCustomWebhookEvents {
+    return DataSourceCustomWebhookEvents.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<ClaimConfig>, I>>(object: I): ClaimConfig {
-    const message = createBaseClaimConfig();
-    message.variablePathComponents = object.variablePathComponents?.map((e) => e) || [];
-    message.claimType = object.claimType ?? 0;
-    message.custom = (object.custom !== undefined && object.custom !== null)
-      ? CustomClaim.fromPartial(object.custom)
-      : undefined;
+  fromPartial<I extends Exact<DeepPartial<DataSourceCustomWebhookEvents>, I>>(
+    object: I,
+  ): DataSourceCustomWebhookEvents {
+    const message = createBaseDataSourceCustomWebhookEvents();
+    message.webhook = object.webhook ?? "";
     return message;
   },
 };
 
-function createBaseOperationLiveQueryConfig(): OperationLiveQueryConfig {
-  return { enable: false, pollingIntervalSeconds: 0 };
+function createBaseDataSourceCustomREST(): DataSourceCustomREST {
+  return { fetch: undefined, subscription: undefined, statusCodeTypeMappings: [], defaultTypeName: "" };
 }
 
-export const OperationLiveQueryConfig = {
-  encode(message: OperationLiveQueryConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.enable === true) {
-      writer.uint32(8).bool(message.enable);
+export const DataSourceCustomREST = {
+  encode(message: DataSourceCustomREST, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.fetch !== undefined) {
+      FetchConfiguration.encode(message.fetch, writer.uint32(10).fork()).ldelim();
     }
-    if (message.pollingIntervalSeconds !== 0) {
-      writer.uint32(16).int64(message.pollingIntervalSeconds);
+    if (message.subscription !== undefined) {
+      RESTSubscriptionConfiguration.encode(message.subscription, writer.uint32(18).fork()).ldelim();
+    }
+    for (const v of message.statusCodeTypeMappings) {
+      StatusCodeTypeMapping.encode(v!, writer.uint32(26).fork()).ldelim();
+    }
+    if (message.defaultTypeName !== "") {
+      writer.uint32(34).string(message.defaultTypeName);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): OperationLiveQueryConfig {
+  decode(input: _m0.Reader | Uint8Array, length?: number): DataSourceCustomREST {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseOperationLiveQueryConfig();
+    const message = createBaseDataSourceCustomREST();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.enable = reader.bool();
+          message.fetch = FetchConfiguration.decode(reader, reader.uint32());
           continue;
         case 2:
-          if (tag !== 16) {
+          if (tag !== 18) {
             break;
           }
 
-          message.pollingIntervalSeconds = longToNumber(reader.int64() as Long);
+          message.subscription = RESTSubscriptionConfiguration.decode(reader, reader.uint32());
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.statusCodeTypeMappings.push(StatusCodeTypeMapping.decode(reader, reader.uint32()));
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.defaultTypeName = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -3986,52 +7066,77 @@ export const OperationLiveQueryConfig = {
     return message;
   },
 
-  fromJSON(object: any): OperationLiveQueryConfig {
+  fromJSON(object: any): DataSourceCustomREST {
     return {
-      enable: isSet(object.enable) ? Boolean(object.enable) : false,
-      pollingIntervalSeconds: isSet(object.pollingIntervalSeconds) ? Number(object.pollingIntervalSeconds) : 0,
+      fetch: isSet(object.fetch) ? FetchConfiguration.fromJSON(object.fetch) : undefined,
+      subscription: isSet(object.subscription)
+        ? RESTSubscriptionConfiguration.fromJSON(object.subscription)
+        : undefined,
+      statusCodeTypeMappings: Array.isArray(object?.statusCodeTypeMappings)
+        ? object.statusCodeTypeMappings.map((e: any) => StatusCodeTypeMapping.fromJSON(e))
+        : [],
+      defaultTypeName: isSet(object.defaultTypeName) ? String(object.defaultTypeName) : "",
     };
   },
 
-  toJSON(message: OperationLiveQueryConfig): unknown {
+  toJSON(message: DataSourceCustomREST): unknown {
     const obj: any = {};
-    if (message.enable === true) {
-      obj.enable = message.enable;
+    if (message.fetch !== undefined) {
+      obj.fetch = FetchConfiguration.toJSON(message.fetch);
     }
-    if (message.pollingIntervalSeconds !== 0) {
-      obj.pollingIntervalSeconds = Math.round(message.pollingIntervalSeconds);
+    if (message.subscription !== undefined) {
+      obj.subscription = RESTSubscriptionConfiguration.toJSON(message.subscription);
+    }
+    if (message.statusCodeTypeMappings?.length) {
+      obj.statusCodeTypeMappings = message.statusCodeTypeMappings.map((e) => StatusCodeTypeMapping.toJSON(e));
+    }
+    if (message.defaultTypeName !== "") {
+      obj.defaultTypeName = message.defaultTypeName;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<OperationLiveQueryConfig>, I>>(base?: I): OperationLiveQueryConfig {
-    return OperationLiveQueryConfig.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<DataSourceCustomREST>, I>>(base?: I): DataSourceCustomREST {
+    return DataSourceCustomREST.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<OperationLiveQueryConfig>, I>>(object: I): OperationLiveQueryConfig {
-    const message = createBaseOperationLiveQueryConfig();
-    message.enable = object.enable ?? false;
-    message.pollingIntervalSeconds = object.pollingIntervalSeconds ?? 0;
+  fromPartial<I extends Exact<DeepPartial<DataSourceCustomREST>, I>>(object: I): DataSourceCustomREST {
+    const message = createBaseDataSourceCustomREST();
+    message.fetch = (object.fetch !== undefined && object.fetch !== null)
+      ? FetchConfiguration.fromPartial(object.fetch)
+      : undefined;
+    message.subscription = (object.subscription !== undefined && object.subscription !== null)
+      ? RESTSubscriptionConfiguration.fromPartial(object.subscription)
+      : undefined;
+    message.statusCodeTypeMappings = object.statusCodeTypeMappings?.map((e) => StatusCodeTypeMapping.fromPartial(e)) ||
+      [];
+    message.defaultTypeName = object.defaultTypeName ?? "";
     return message;
   },
 };
 
-function createBaseOperationAuthenticationConfig(): OperationAuthenticationConfig {
-  return { authRequired: false };
+function createBaseStatusCodeTypeMapping(): StatusCodeTypeMapping {
+  return { statusCode: 0, typeName: "", injectStatusCodeIntoBody: false };
 }
 
-export const OperationAuthenticationConfig = {
-  encode(message: OperationAuthenticationConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.authRequired === true) {
-      writer.uint32(8).bool(message.authRequired);
+export const StatusCodeTypeMapping = {
+  encode(message: StatusCodeTypeMapping, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.statusCode !== 0) {
+      writer.uint32(8).int64(message.statusCode);
+    }
+    if (message.typeName !== "") {
+      writer.uint32(18).string(message.typeName);
+    }
+    if (message.injectStatusCodeIntoBody === true) {
+      writer.uint32(24).bool(message.injectStatusCodeIntoBody);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): OperationAuthenticationConfig {
+  decode(input: _m0.Reader | Uint8Array, length?: number): StatusCodeTypeMapping {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseOperationAuthenticationConfig();
+    const message = createBaseStatusCodeTypeMapping();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -4040,7 +7145,21 @@ export const OperationAuthenticationConfig = {
             break;
           }
 
-          message.authRequired = reader.bool();
+          message.statusCode = longToNumber(reader.int64() as Long);
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.typeName = reader.string();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.injectStatusCodeIntoBody = reader.bool();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -4051,102 +7170,125 @@ export const OperationAuthenticationConfig = {
     return message;
   },
 
-  fromJSON(object: any): OperationAuthenticationConfig {
-    return { authRequired: isSet(object.authRequired) ? Boolean(object.authRequired) : false };
+  fromJSON(object: any): StatusCodeTypeMapping {
+    return {
+      statusCode: isSet(object.statusCode) ? Number(object.statusCode) : 0,
+      typeName: isSet(object.typeName) ? String(object.typeName) : "",
+      injectStatusCodeIntoBody: isSet(object.injectStatusCodeIntoBody)
+        ? Boolean(object.injectStatusCodeIntoBody)
+        : false,
+    };
   },
 
-  toJSON(message: OperationAuthenticationConfig): unknown {
+  toJSON(message: StatusCodeTypeMapping): unknown {
     const obj: any = {};
-    if (message.authRequired === true) {
-      obj.authRequired = message.authRequired;
+    if (message.statusCode !== 0) {
+      obj.statusCode = Math.round(message.statusCode);
+    }
+    if (message.typeName !== "") {
+      obj.typeName = message.typeName;
+    }
+    if (message.injectStatusCodeIntoBody === true) {
+      obj.injectStatusCodeIntoBody = message.injectStatusCodeIntoBody;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<OperationAuthenticationConfig>, I>>(base?: I): OperationAuthenticationConfig {
-    return OperationAuthenticationConfig.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<StatusCodeTypeMapping>, I>>(base?: I): StatusCodeTypeMapping {
+    return StatusCodeTypeMapping.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<OperationAuthenticationConfig>, I>>(
-    object: I,
-  ): OperationAuthenticationConfig {
-    const message = createBaseOperationAuthenticationConfig();
-    message.authRequired = object.authRequired ?? false;
+  fromPartial<I extends Exact<DeepPartial<StatusCodeTypeMapping>, I>>(object: I): StatusCodeTypeMapping {
+    const message = createBaseStatusCodeTypeMapping();
+    message.statusCode = object.statusCode ?? 0;
+    message.typeName = object.typeName ?? "";
+    message.injectStatusCodeIntoBody = object.injectStatusCodeIntoBody ?? false;
     return message;
   },
 };
 
-function createBaseOperationCacheConfig(): OperationCacheConfig {
+function createBaseDataSourceCustomGraphQL(): DataSourceCustomGraphQL {
   return {
-    enable: undefined,
-    maxAge: undefined,
-    public: undefined,
-    staleWhileRevalidate: undefined,
-    mustRevalidate: undefined,
+    fetch: undefined,
+    subscription: undefined,
+    federation: undefined,
+    upstreamSchema: undefined,
+    hooksConfiguration: undefined,
+    customScalarTypeFields: [],
   };
 }
 
-export const OperationCacheConfig = {
-  encode(message: OperationCacheConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.enable !== undefined) {
-      writer.uint32(8).bool(message.enable);
+export const DataSourceCustomGraphQL = {
+  encode(message: DataSourceCustomGraphQL, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.fetch !== undefined) {
+      FetchConfiguration.encode(message.fetch, writer.uint32(10).fork()).ldelim();
     }
-    if (message.maxAge !== undefined) {
-      writer.uint32(16).int64(message.maxAge);
+    if (message.subscription !== undefined) {
+      GraphQLSubscriptionConfiguration.encode(message.subscription, writer.uint32(18).fork()).ldelim();
     }
-    if (message.public !== undefined) {
-      writer.uint32(24).bool(message.public);
+    if (message.federation !== undefined) {
+      GraphQLFederationConfiguration.encode(message.federation, writer.uint32(26).fork()).ldelim();
     }
-    if (message.staleWhileRevalidate !== undefined) {
-      writer.uint32(32).int64(message.staleWhileRevalidate);
+    if (message.upstreamSchema !== undefined) {
+      InternedString.encode(message.upstreamSchema, writer.uint32(34).fork()).ldelim();
     }
-    if (message.mustRevalidate !== undefined) {
-      writer.uint32(40).bool(message.mustRevalidate);
+    if (message.hooksConfiguration !== undefined) {
+      GraphQLDataSourceHooksConfiguration.encode(message.hooksConfiguration, writer.uint32(42).fork()).ldelim();
+    }
+    for (const v of message.customScalarTypeFields) {
+      SingleTypeField.encode(v!, writer.uint32(50).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): OperationCacheConfig {
+  decode(input: _m0.Reader | Uint8Array, length?: number): DataSourceCustomGraphQL {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseOperationCacheConfig();
+    const message = createBaseDataSourceCustomGraphQL();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.enable = reader.bool();
+          message.fetch = FetchConfiguration.decode(reader, reader.uint32());
           continue;
         case 2:
-          if (tag !== 16) {
+          if (tag !== 18) {
             break;
           }
 
-          message.maxAge = longToNumber(reader.int64() as Long);
+          message.subscription = GraphQLSubscriptionConfiguration.decode(reader, reader.uint32());
           continue;
         case 3:
-          if (tag !== 24) {
+          if (tag !== 26) {
             break;
           }
 
-          message.public = reader.bool();
+          message.federation = GraphQLFederationConfiguration.decode(reader, reader.uint32());
           continue;
         case 4:
-          if (tag !== 32) {
+          if (tag !== 34) {
             break;
           }
 
-          message.staleWhileRevalidate = longToNumber(reader.int64() as Long);
+          message.upstreamSchema = InternedString.decode(reader, reader.uint32());
           continue;
         case 5:
-          if (tag !== 40) {
+          if (tag !== 42) {
             break;
           }
 
-          message.mustRevalidate = reader.bool();
+          message.hooksConfiguration = GraphQLDataSourceHooksConfiguration.decode(reader, reader.uint32());
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.customScalarTypeFields.push(SingleTypeField.decode(reader, reader.uint32()));
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -4157,136 +7299,154 @@ export const OperationCacheConfig = {
     return message;
   },
 
-  fromJSON(object: any): OperationCacheConfig {
+  fromJSON(object: any): DataSourceCustomGraphQL {
     return {
-      enable: isSet(object.enable) ? Boolean(object.enable) : undefined,
-      maxAge: isSet(object.maxAge) ? Number(object.maxAge) : undefined,
-      public: isSet(object.public) ? Boolean(object.public) : undefined,
-      staleWhileRevalidate: isSet(object.staleWhileRevalidate) ? Number(object.staleWhileRevalidate) : undefined,
-      mustRevalidate: isSet(object.mustRevalidate) ? Boolean(object.mustRevalidate) : undefined,
+      fetch: isSet(object.fetch) ? FetchConfiguration.fromJSON(object.fetch) : undefined,
+      subscription: isSet(object.subscription)
+        ? GraphQLSubscriptionConfiguration.fromJSON(object.subscription)
+        : undefined,
+      federation: isSet(object.federation) ? GraphQLFederationConfiguration.fromJSON(object.federation) : undefined,
+      upstreamSchema: isSet(object.upstreamSchema) ? InternedString.fromJSON(object.upstreamSchema) : undefined,
+      hooksConfiguration: isSet(object.hooksConfiguration)
+        ? GraphQLDataSourceHooksConfiguration.fromJSON(object.hooksConfiguration)
+        : undefined,
+      customScalarTypeFields: Array.isArray(object?.customScalarTypeFields)
+        ? object.customScalarTypeFields.map((e: any) => SingleTypeField.fromJSON(e))
+        : [],
     };
   },
 
-  toJSON(message: OperationCacheConfig): unknown {
+  toJSON(message: DataSourceCustomGraphQL): unknown {
     const obj: any = {};
-    if (message.enable !== undefined) {
-      obj.enable = message.enable;
+    if (message.fetch !== undefined) {
+      obj.fetch = FetchConfiguration.toJSON(message.fetch);
     }
-    if (message.maxAge !== undefined) {
-      obj.maxAge = Math.round(message.maxAge);
+    if (message.subscription !== undefined) {
+      obj.subscription = GraphQLSubscriptionConfiguration.toJSON(message.subscription);
     }
-    if (message.public !== undefined) {
-      obj.public = message.public;
+    if (message.federation !== undefined) {
+      obj.federation = GraphQLFederationConfiguration.toJSON(message.federation);
     }
-    if (message.staleWhileRevalidate !== undefined) {
-      obj.staleWhileRevalidate = Math.round(message.staleWhileRevalidate);
+    if (message.upstreamSchema !== undefined) {
+      obj.upstreamSchema = InternedString.toJSON(message.upstreamSchema);
     }
-    if (message.mustRevalidate !== undefined) {
-      obj.mustRevalidate = message.mustRevalidate;
+    if (message.hooksConfiguration !== undefined) {
+      obj.hooksConfiguration = GraphQLDataSourceHooksConfiguration.toJSON(message.hooksConfiguration);
+    }
+    if (message.customScalarTypeFields?.length) {
+      obj.customScalarTypeFields = message.customScalarTypeFields.map((e) => SingleTypeField.toJSON(e));
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<OperationCacheConfig>, I>>(base?: I): OperationCacheConfig {
-    return OperationCacheConfig.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<DataSourceCustomGraphQL>, I>>(base?: I): DataSourceCustomGraphQL {
+    return DataSourceCustomGraphQL.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<OperationCacheConfig>, I>>(object: I): OperationCacheConfig {
-    const message = createBaseOperationCacheConfig();
-    message.enable = object.enable ?? undefined;
-    message.maxAge = object.maxAge ?? undefined;
-    message.public = object.public ?? undefined;
-    message.staleWhileRevalidate = object.staleWhileRevalidate ?? undefined;
-    message.mustRevalidate = object.mustRevalidate ?? undefined;
+  fromPartial<I extends Exact<DeepPartial<DataSourceCustomGraphQL>, I>>(object: I): DataSourceCustomGraphQL {
+    const message = createBaseDataSourceCustomGraphQL();
+    message.fetch = (object.fetch !== undefined && object.fetch !== null)
+      ? FetchConfiguration.fromPartial(object.fetch)
+      : undefined;
+    message.subscription = (object.subscription !== undefined && object.subscription !== null)
+      ? GraphQLSubscriptionConfiguration.fromPartial(object.subscription)
+      : undefined;
+    message.federation = (object.federation !== undefined && object.federation !== null)
+      ? GraphQLFederationConfiguration.fromPartial(object.federation)
+      : undefined;
+    message.upstreamSchema = (object.upstreamSchema !== undefined && object.upstreamSchema !== null)
+      ? InternedString.fromPartial(object.upstreamSchema)
+      : undefined;
+    message.hooksConfiguration = (object.hooksConfiguration !== undefined && object.hooksConfiguration !== null)
+      ? GraphQLDataSourceHooksConfiguration.fromPartial(object.hooksConfiguration)
+      : undefined;
+    message.customScalarTypeFields = object.customScalarTypeFields?.map((e) => SingleTypeField.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseEngineConfiguration(): EngineConfiguration {
+function createBaseDataSourceCustomDatabase(): DataSourceCustomDatabase {
   return {
-    defaultFlushInterval: 0,
-    datasourceConfigurations: [],
-    fieldConfigurations: [],
-    graphqlSchema: "",
-    typeConfigurations: [],
-    stringStorage: {},
+    databaseURL: undefined,
+    prismaSchema: undefined,
+    graphqlSchema: undefined,
+    closeTimeoutSeconds: 0,
+    jsonTypeFields: [],
+    jsonInputVariables: [],
   };
 }
 
-export const EngineConfiguration = {
-  encode(message: EngineConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.defaultFlushInterval !== 0) {
-      writer.uint32(8).int64(message.defaultFlushInterval);
+export const DataSourceCustomDatabase = {
+  encode(message: DataSourceCustomDatabase, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.databaseURL !== undefined) {
+      ConfigurationVariable.encode(message.databaseURL, writer.uint32(10).fork()).ldelim();
     }
-    for (const v of message.datasourceConfigurations) {
-      DataSourceConfiguration.encode(v!, writer.uint32(18).fork()).ldelim();
+    if (message.prismaSchema !== undefined) {
+      InternedString.encode(message.prismaSchema, writer.uint32(18).fork()).ldelim();
     }
-    for (const v of message.fieldConfigurations) {
-      FieldConfiguration.encode(v!, writer.uint32(26).fork()).ldelim();
+    if (message.graphqlSchema !== undefined) {
+      InternedString.encode(message.graphqlSchema, writer.uint32(26).fork()).ldelim();
     }
-    if (message.graphqlSchema !== "") {
-      writer.uint32(34).string(message.graphqlSchema);
+    if (message.closeTimeoutSeconds !== 0) {
+      writer.uint32(32).int64(message.closeTimeoutSeconds);
     }
-    for (const v of message.typeConfigurations) {
-      TypeConfiguration.encode(v!, writer.uint32(42).fork()).ldelim();
+    for (const v of message.jsonTypeFields) {
+      SingleTypeField.encode(v!, writer.uint32(42).fork()).ldelim();
+    }
+    for (const v of message.jsonInputVariables) {
+      writer.uint32(50).string(v!);
     }
-    Object.entries(message.stringStorage).forEach(([key, value]) => {
-      EngineConfiguration_StringStorageEntry.encode({ key: key as any, value }, writer.uint32(50).fork()).ldelim();
-    });
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): EngineConfiguration {
+  decode(input: _m0.Reader | Uint8Array, length?: number): DataSourceCustomDatabase {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseEngineConfiguration();
+    const message = createBaseDataSourceCustomDatabase();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.defaultFlushInterval = longToNumber(reader.int64() as Long);
+          message.databaseURL = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.datasourceConfigurations.push(DataSourceConfiguration.decode(reader, reader.uint32()));
+          message.prismaSchema = InternedString.decode(reader, reader.uint32());
           continue;
         case 3:
           if (tag !== 26) {
             break;
           }
 
-          message.fieldConfigurations.push(FieldConfiguration.decode(reader, reader.uint32()));
+          message.graphqlSchema = InternedString.decode(reader, reader.uint32());
           continue;
         case 4:
-          if (tag !== 34) {
+          if (tag !== 32) {
             break;
           }
 
-          message.graphqlSchema = reader.string();
+          message.closeTimeoutSeconds = longToNumber(reader.int64() as Long);
           continue;
         case 5:
           if (tag !== 42) {
             break;
           }
 
-          message.typeConfigurations.push(TypeConfiguration.decode(reader, reader.uint32()));
+          message.jsonTypeFields.push(SingleTypeField.decode(reader, reader.uint32()));
           continue;
         case 6:
           if (tag !== 50) {
             break;
           }
 
-          const entry6 = EngineConfiguration_StringStorageEntry.decode(reader, reader.uint32());
-          if (entry6.value !== undefined) {
-            message.stringStorage[entry6.key] = entry6.value;
-          }
+          message.jsonInputVariables.push(reader.string());
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -4297,117 +7457,101 @@ export const EngineConfiguration = {
     return message;
   },
 
-  fromJSON(object: any): EngineConfiguration {
+  fromJSON(object: any): DataSourceCustomDatabase {
     return {
-      defaultFlushInterval: isSet(object.defaultFlushInterval) ? Number(object.defaultFlushInterval) : 0,
-      datasourceConfigurations: Array.isArray(object?.datasourceConfigurations)
-        ? object.datasourceConfigurations.map((e: any) => DataSourceConfiguration.fromJSON(e))
-        : [],
-      fieldConfigurations: Array.isArray(object?.fieldConfigurations)
-        ? object.fieldConfigurations.map((e: any) => FieldConfiguration.fromJSON(e))
+      databaseURL: isSet(object.databaseURL) ? ConfigurationVariable.fromJSON(object.databaseURL) : undefined,
+      prismaSchema: isSet(object.prismaSchema) ? InternedString.fromJSON(object.prismaSchema) : undefined,
+      graphqlSchema: isSet(object.graphqlSchema) ? InternedString.fromJSON(object.graphqlSchema) : undefined,
+      closeTimeoutSeconds: isSet(object.closeTimeoutSeconds) ? Number(object.closeTimeoutSeconds) : 0,
+      jsonTypeFields: Array.isArray(object?.jsonTypeFields)
+        ? object.jsonTypeFields.map((e: any) => SingleTypeField.fromJSON(e))
         : [],
-      graphqlSchema: isSet(object.graphqlSchema) ? String(object.graphqlSchema) : "",
-      typeConfigurations: Array.isArray(object?.typeConfigurations)
-        ? object.typeConfigurations.map((e: any) => TypeConfiguration.fromJSON(e))
+      jsonInputVariables: Array.isArray(object?.jsonInputVariables)
+        ? object.jsonInputVariables.map((e: any) => String(e))
         : [],
-      stringStorage: isObject(object.stringStorage)
-        ? Object.entries(object.stringStorage).reduce<{ [key: string]: string }>((acc, [key, value]) => {
-          acc[key] = String(value);
-          return acc;
-        }, {})
-        : {},
     };
   },
 
-  toJSON(message: EngineConfiguration): unknown {
+  toJSON(message: DataSourceCustomDatabase): unknown {
     const obj: any = {};
-    if (message.defaultFlushInterval !== 0) {
-      obj.defaultFlushInterval = Math.round(message.defaultFlushInterval);
+    if (message.databaseURL !== undefined) {
+      obj.databaseURL = ConfigurationVariable.toJSON(message.databaseURL);
     }
-    if (message.datasourceConfigurations?.length) {
-      obj.datasourceConfigurations = message.datasourceConfigurations.map((e) => DataSourceConfiguration.toJSON(e));
+    if (message.prismaSchema !== undefined) {
+      obj.prismaSchema = InternedString.toJSON(message.prismaSchema);
     }
-    if (message.fieldConfigurations?.length) {
-      obj.fieldConfigurations = message.fieldConfigurations.map((e) => FieldConfiguration.toJSON(e));
+    if (message.graphqlSchema !== undefined) {
+      obj.graphqlSchema = InternedString.toJSON(message.graphqlSchema);
     }
-    if (message.graphqlSchema !== "") {
-      obj.graphqlSchema = message.graphqlSchema;
+    if (message.closeTimeoutSeconds !== 0) {
+      obj.closeTimeoutSeconds = Math.round(message.closeTimeoutSeconds);
     }
-    if (message.typeConfigurations?.length) {
-      obj.typeConfigurations = message.typeConfigurations.map((e) => TypeConfiguration.toJSON(e));
+    if (message.jsonTypeFields?.length) {
+      obj.jsonTypeFields = message.jsonTypeFields.map((e) => SingleTypeField.toJSON(e));
     }
-    if (message.stringStorage) {
-      const entries = Object.entries(message.stringStorage);
-      if (entries.length > 0) {
-        obj.stringStorage = {};
-        entries.forEach(([k, v]) => {
-          obj.stringStorage[k] = v;
-        });
-      }
+    if (message.jsonInputVariables?.length) {
+      obj.jsonInputVariables = message.jsonInputVariables;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<EngineConfiguration>, I>>(base?: I): EngineConfiguration {
-    return EngineConfiguration.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<DataSourceCustomDatabase>, I>>(base?: I): DataSourceCustomDatabase {
+    return DataSourceCustomDatabase.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<EngineConfiguration>, I>>(object: I): EngineConfiguration {
-    const message = createBaseEngineConfiguration();
-    message.defaultFlushInterval = object.defaultFlushInterval ?? 0;
-    message.datasourceConfigurations =
-      object.datasourceConfigurations?.map((e) => DataSourceConfiguration.fromPartial(e)) || [];
-    message.fieldConfigurations = object.fieldConfigurations?.map((e) => FieldConfiguration.fromPartial(e)) || [];
-    message.graphqlSchema = object.graphqlSchema ?? "";
-    message.typeConfigurations = object.typeConfigurations?.map((e) => TypeConfiguration.fromPartial(e)) || [];
-    message.stringStorage = Object.entries(object.stringStorage ?? {}).reduce<{ [key: string]: string }>(
-      (acc, [key, value]) => {
-        if (value !== undefined) {
-          acc[key] = String(value);
-        }
-        return acc;
-      },
-      {},
-    );
+  fromPartial<I extends Exact<DeepPartial<DataSourceCustomDatabase>, I>>(object: I): DataSourceCustomDatabase {
+    const message = createBaseDataSourceCustomDatabase();
+    message.databaseURL = (object.databaseURL !== undefined && object.databaseURL !== null)
+      ? ConfigurationVariable.fromPartial(object.databaseURL)
+      : undefined;
+    message.prismaSchema = (object.prismaSchema !== undefined && object.prismaSchema !== null)
+      ? InternedString.fromPartial(object.prismaSchema)
+      : undefined;
+    message.graphqlSchema = (object.graphqlSchema !== undefined && object.graphqlSchema !== null)
+      ? InternedString.fromPartial(object.graphqlSchema)
+      : undefined;
+    message.closeTimeoutSeconds = object.closeTimeoutSeconds ?? 0;
+    message.jsonTypeFields = object.jsonTypeFields?.map((e) => SingleTypeField.fromPartial(e)) || [];
+    message.jsonInputVariables = object.jsonInputVariables?.map((e) => e) || [];
     return message;
   },
 };
 
-function createBaseEngineConfiguration_StringStorageEntry(): EngineConfiguration_StringStorageEntry {
-  return { key: "", value: "" };
+function createBaseGraphQLFederationConfiguration(): GraphQLFederationConfiguration {
+  return { enabled: false, serviceSdl: "" };
 }
 
-export const EngineConfiguration_StringStorageEntry = {
-  encode(message: EngineConfiguration_StringStorageEntry, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.key !== "") {
-      writer.uint32(10).string(message.key);
+export const GraphQLFederationConfiguration = {
+  encode(message: GraphQLFederationConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.enabled === true) {
+      writer.uint32(8).bool(message.enabled);
     }
-    if (message.value !== "") {
-      writer.uint32(18).string(message.value);
+    if (message.serviceSdl !== "") {
+      writer.uint32(18).string(message.serviceSdl);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): EngineConfiguration_StringStorageEntry {
+  decode(input: _m0.Reader | Uint8Array, length?: number): GraphQLFederationConfiguration {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseEngineConfiguration_StringStorageEntry();
+    const message = createBaseGraphQLFederationConfiguration();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 10) {
+          if (tag !== 8) {
             break;
           }
 
-          message.key = reader.string();
+          message.enabled = reader.bool();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.value = reader.string();
+          message.serviceSdl = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -4418,53 +7562,54 @@ export const EngineConfiguration_StringStorageEntry = {
     return message;
   },
 
-  fromJSON(object: any): EngineConfiguration_StringStorageEntry {
-    return { key: isSet(object.key) ? String(object.key) : "", value: isSet(object.value) ? String(object.value) : "" };
+  fromJSON(object: any): GraphQLFederationConfiguration {
+    return {
+      enabled: isSet(object.enabled) ? Boolean(object.enabled) : false,
+      serviceSdl: isSet(object.serviceSdl) ? String(object.serviceSdl) : "",
+    };
   },
 
-  toJSON(message: EngineConfiguration_StringStorageEntry): unknown {
+  toJSON(message: GraphQLFederationConfiguration): unknown {
     const obj: any = {};
-    if (message.key !== "") {
-      obj.key = message.key;
+    if (message.enabled === true) {
+      obj.enabled = message.enabled;
     }
-    if (message.value !== "") {
-      obj.value = message.value;
+    if (message.serviceSdl !== "") {
+      obj.serviceSdl = message.serviceSdl;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<EngineConfiguration_StringStorageEntry>, I>>(
-    base?: I,
-  ): EngineConfiguration_StringStorageEntry {
-    return EngineConfiguration_StringStorageEntry.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<GraphQLFederationConfiguration>, I>>(base?: I): GraphQLFederationConfiguration {
+    return GraphQLFederationConfiguration.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<EngineConfiguration_StringStorageEntry>, I>>(
+  fromPartial<I extends Exact<DeepPartial<GraphQLFederationConfiguration>, I>>(
     object: I,
-  ): EngineConfiguration_StringStorageEntry {
-    const message = createBaseEngineConfiguration_StringStorageEntry();
-    message.key = object.key ?? "";
-    message.value = object.value ?? "";
+  ): GraphQLFederationConfiguration {
+    const message = createBaseGraphQLFederationConfiguration();
+    message.enabled = object.enabled ?? false;
+    message.serviceSdl = object.serviceSdl ?? "";
     return message;
   },
 };
 
-function createBaseInternedString(): InternedString {
-  return { key: "" };
+function createBaseDataSourceCustomStatic(): DataSourceCustomStatic {
+  return { data: undefined };
 }
 
-export const InternedString = {
-  encode(message: InternedString, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.key !== "") {
-      writer.uint32(10).string(message.key);
+export const DataSourceCustomStatic = {
+  encode(message: DataSourceCustomStatic, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.data !== undefined) {
+      ConfigurationVariable.encode(message.data, writer.uint32(10).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): InternedString {
+  decode(input: _m0.Reader | Uint8Array, length?: number): DataSourceCustomStatic {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseInternedString();
+    const message = createBaseDataSourceCustomStatic();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -4473,7 +7618,87 @@ export const InternedString = {
             break;
           }
 
-          message.key = reader.string();
+          message.data = ConfigurationVariable.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DataSourceCustomStatic {
+    return { data: isSet(object.data) ? ConfigurationVariable.fromJSON(object.data) : undefined };
+  },
+
+  toJSON(message: DataSourceCustomStatic): unknown {
+    const obj: any = {};
+    if (message.data !== undefined) {
+      obj.data = ConfigurationVariable.toJSON(message.data);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<DataSourceCustomStatic>, I>>(base?: I): DataSourceCustomStatic {
+    return DataSourceCustomStatic.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<DataSourceCustomStatic>, I>>(object: I): DataSourceCustomStatic {
+    const message = createBaseDataSourceCustomStatic();
+    message.data = (object.data !== undefined && object.data !== null)
+      ? ConfigurationVariable.fromPartial(object.data)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseGraphQLSubscriptionConfiguration(): GraphQLSubscriptionConfiguration {
+  return { enabled: false, url: undefined, useSSE: false };
+}
+
+export const GraphQLSubscriptionConfiguration = {
+  encode(message: GraphQLSubscriptionConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.enabled === true) {
+      writer.uint32(8).bool(message.enabled);
+    }
+    if (message.url !== undefined) {
+      ConfigurationVariable.encode(message.url, writer.uint32(18).fork()).ldelim();
+    }
+    if (message.useSSE === true) {
+      writer.uint32(24).bool(message.useSSE);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GraphQLSubscriptionConfiguration {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGraphQLSubscriptionConfiguration();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.enabled = reader.bool();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.url = ConfigurationVariable.decode(reader, reader.uint32());
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.useSSE = reader.bool();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -4484,177 +7709,187 @@ export const InternedString = {
     return message;
   },
 
-  fromJSON(object: any): InternedString {
-    return { key: isSet(object.key) ? String(object.key) : "" };
+  fromJSON(object: any): GraphQLSubscriptionConfiguration {
+    return {
+      enabled: isSet(object.enabled) ? Boolean(object.enabled) : false,
+      url: isSet(object.url) ? ConfigurationVariable.fromJSON(object.url) : undefined,
+      useSSE: isSet(object.useSSE) ? Boolean(object.useSSE) : false,
+    };
   },
 
-  toJSON(message: InternedString): unknown {
+  toJSON(message: GraphQLSubscriptionConfiguration): unknown {
     const obj: any = {};
-    if (message.key !== "") {
-      obj.key = message.key;
+    if (message.enabled === true) {
+      obj.enabled = message.enabled;
+    }
+    if (message.url !== undefined) {
+      obj.url = ConfigurationVariable.toJSON(message.url);
+    }
+    if (message.useSSE === true) {
+      obj.useSSE = message.useSSE;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<InternedString>, I>>(base?: I): InternedString {
-    return InternedString.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<GraphQLSubscriptionConfiguration>, I>>(
+    base?: I,
+  ): GraphQLSubscriptionConfiguration {
+    return GraphQLSubscriptionConfiguration.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<InternedString>, I>>(object: I): InternedString {
-    const message = createBaseInternedString();
-    message.key = object.key ?? "";
+  fromPartial<I extends Exact<DeepPartial<GraphQLSubscriptionConfiguration>, I>>(
+    object: I,
+  ): GraphQLSubscriptionConfiguration {
+    const message = createBaseGraphQLSubscriptionConfiguration();
+    message.enabled = object.enabled ?? false;
+    message.url = (object.url !== undefined && object.url !== null)
+      ? ConfigurationVariable.fromPartial(object.url)
+      : undefined;
+    message.useSSE = object.useSSE ?? false;
     return message;
   },
 };
 
-function createBaseDataSourceConfiguration(): DataSourceConfiguration {
+function createBaseFetchConfiguration(): FetchConfiguration {
   return {
-    kind: 0,
-    rootNodes: [],
-    childNodes: [],
-    overrideFieldPathFromAlias: false,
-    customRest: undefined,
-    customGraphql: undefined,
-    customStatic: undefined,
-    customDatabase: undefined,
-    directives: [],
-    requestTimeoutSeconds: 0,
-    id: "",
-    customNatsKv: undefined,
+    url: undefined,
+    method: 0,
+    header: {},
+    body: undefined,
+    query: [],
+    upstreamAuthentication: undefined,
+    urlEncodeBody: false,
+    mTLS: undefined,
+    baseUrl: undefined,
+    path: undefined,
+    httpProxyUrl: undefined,
   };
 }
 
-export const DataSourceConfiguration = {
-  encode(message: DataSourceConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.kind !== 0) {
-      writer.uint32(8).int32(message.kind);
-    }
-    for (const v of message.rootNodes) {
-      TypeField.encode(v!, writer.uint32(18).fork()).ldelim();
-    }
-    for (const v of message.childNodes) {
-      TypeField.encode(v!, writer.uint32(26).fork()).ldelim();
+export const FetchConfiguration = {
+  encode(message: FetchConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.url !== undefined) {
+      ConfigurationVariable.encode(message.url, writer.uint32(10).fork()).ldelim();
     }
-    if (message.overrideFieldPathFromAlias === true) {
-      writer.uint32(32).bool(message.overrideFieldPathFromAlias);
+    if (message.method !== 0) {
+      writer.uint32(16).int32(message.method);
     }
-    if (message.customRest !== undefined) {
-      DataSourceCustomREST.encode(message.customRest, writer.uint32(42).fork()).ldelim();
+    Object.entries(message.header).forEach(([key, value]) => {
+      FetchConfiguration_HeaderEntry.encode({ key: key as any, value }, writer.uint32(26).fork()).ldelim();
+    });
+    if (message.body !== undefined) {
+      ConfigurationVariable.encode(message.body, writer.uint32(34).fork()).ldelim();
     }
-    if (message.customGraphql !== undefined) {
-      DataSourceCustomGraphQL.encode(message.customGraphql, writer.uint32(50).fork()).ldelim();
+    for (const v of message.query) {
+      URLQueryConfiguration.encode(v!, writer.uint32(42).fork()).ldelim();
     }
-    if (message.customStatic !== undefined) {
-      DataSourceCustomStatic.encode(message.customStatic, writer.uint32(58).fork()).ldelim();
+    if (message.upstreamAuthentication !== undefined) {
+      UpstreamAuthentication.encode(message.upstreamAuthentication, writer.uint32(50).fork()).ldelim();
     }
-    if (message.customDatabase !== undefined) {
-      DataSourceCustomDatabase.encode(message.customDatabase, writer.uint32(66).fork()).ldelim();
+    if (message.urlEncodeBody === true) {
+      writer.uint32(56).bool(message.urlEncodeBody);
     }
-    for (const v of message.directives) {
-      DirectiveConfiguration.encode(v!, writer.uint32(74).fork()).ldelim();
+    if (message.mTLS !== undefined) {
+      MTLSConfiguration.encode(message.mTLS, writer.uint32(66).fork()).ldelim();
     }
-    if (message.requestTimeoutSeconds !== 0) {
-      writer.uint32(80).int64(message.requestTimeoutSeconds);
+    if (message.baseUrl !== undefined) {
+      ConfigurationVariable.encode(message.baseUrl, writer.uint32(74).fork()).ldelim();
     }
-    if (message.id !== "") {
-      writer.uint32(90).string(message.id);
+    if (message.path !== undefined) {
+      ConfigurationVariable.encode(message.path, writer.uint32(82).fork()).ldelim();
     }
-    if (message.customNatsKv !== undefined) {
-      DataSourceCustomNatsKv.encode(message.customNatsKv, writer.uint32(98).fork()).ldelim();
+    if (message.httpProxyUrl !== undefined) {
+      ConfigurationVariable.encode(message.httpProxyUrl, writer.uint32(90).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): DataSourceConfiguration {
+  decode(input: _m0.Reader | Uint8Array, length?: number): FetchConfiguration {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseDataSourceConfiguration();
+    const message = createBaseFetchConfiguration();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.kind = reader.int32() as any;
+          message.url = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
         case 2:
-          if (tag !== 18) {
+          if (tag !== 16) {
             break;
           }
 
-          message.rootNodes.push(TypeField.decode(reader, reader.uint32()));
+          message.method = reader.int32() as any;
           continue;
         case 3:
           if (tag !== 26) {
             break;
           }
 
-          message.childNodes.push(TypeField.decode(reader, reader.uint32()));
+          const entry3 = FetchConfiguration_HeaderEntry.decode(reader, reader.uint32());
+          if (entry3.value !== undefined) {
+            message.header[entry3.key] = entry3.value;
+          }
           continue;
         case 4:
-          if (tag !== 32) {
+          if (tag !== 34) {
             break;
           }
 
-          message.overrideFieldPathFromAlias = reader.bool();
+          message.body = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
         case 5:
           if (tag !== 42) {
             break;
           }
 
-          message.customRest = DataSourceCustomREST.decode(reader, reader.uint32());
+          message.query.push(URLQueryConfiguration.decode(reader, reader.uint32()));
           continue;
         case 6:
           if (tag !== 50) {
             break;
           }
 
-          message.customGraphql = DataSourceCustomGraphQL.decode(reader, reader.uint32());
+          message.upstreamAuthentication = UpstreamAuthentication.decode(reader, reader.uint32());
           continue;
         case 7:
-          if (tag !== 58) {
+          if (tag !== 56) {
             break;
           }
 
-          message.customStatic = DataSourceCustomStatic.decode(reader, reader.uint32());
+          message.urlEncodeBody = reader.bool();
           continue;
         case 8:
           if (tag !== 66) {
             break;
           }
 
-          message.customDatabase = DataSourceCustomDatabase.decode(reader, reader.uint32());
+          message.mTLS = MTLSConfiguration.decode(reader, reader.uint32());
           continue;
         case 9:
           if (tag !== 74) {
             break;
           }
 
-          message.directives.push(DirectiveConfiguration.decode(reader, reader.uint32()));
+          message.baseUrl = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
         case 10:
-          if (tag !== 80) {
+          if (tag !== 82) {
             break;
           }
 
-          message.requestTimeoutSeconds = longToNumber(reader.int64() as Long);
+          message.path = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
         case 11:
           if (tag !== 90) {
             break;
           }
 
-          message.id = reader.string();
-          continue;
-        case 12:
-          if (tag !== 98) {
-            break;
-          }
-
-          message.customNatsKv = DataSourceCustomNatsKv.decode(reader, reader.uint32());
+          message.httpProxyUrl = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -4665,121 +7900,133 @@ export const DataSourceConfiguration = {
     return message;
   },
 
-  fromJSON(object: any): DataSourceConfiguration {
+  fromJSON(object: any): FetchConfiguration {
     return {
-      kind: isSet(object.kind) ? dataSourceKindFromJSON(object.kind) : 0,
-      rootNodes: Array.isArray(object?.rootNodes) ? object.rootNodes.map((e: any) => TypeField.fromJSON(e)) : [],
-      childNodes: Array.isArray(object?.childNodes) ? object.childNodes.map((e: any) => TypeField.fromJSON(e)) : [],
-      overrideFieldPathFromAlias: isSet(object.overrideFieldPathFromAlias)
-        ? Boolean(object.overrideFieldPathFromAlias)
-        : false,
-      customRest: isSet(object.customRest) ? DataSourceCustomREST.fromJSON(object.customRest) : undefined,
-      customGraphql: isSet(object.customGraphql) ? DataSourceCustomGraphQL.fromJSON(object.customGraphql) : undefined,
-      customStatic: isSet(object.customStatic) ? DataSourceCustomStatic.fromJSON(object.customStatic) : undefined,
-      customDatabase: isSet(object.customDatabase)
-        ? DataSourceCustomDatabase.fromJSON(object.customDatabase)
+      url: isSet(object.url) ? ConfigurationVariable.fromJSON(object.url) : undefined,
+      method: isSet(object.method) ? hTTPMethodFromJSON(object.method) : 0,
+      header: isObject(object.header)
+        ? Object.entries(object.header).reduce<{ [key: string]: HTTPHeader }>((acc, [key, value]) => {
+          acc[key] = HTTPHeader.fromJSON(value);
+          return acc;
+        }, {})
+        : {},
+      body: isSet(object.body) ? ConfigurationVariable.fromJSON(object.body) : undefined,
+      query: Array.isArray(object?.query) ? object.query.map((e: any) => URLQueryConfiguration.fromJSON(e)) : [],
+      upstreamAuthentication: isSet(object.upstreamAuthentication)
+        ? UpstreamAuthentication.fromJSON(object.upstreamAuthentication)
         : undefined,
-      directives: Array.isArray(object?.directives)
-        ? object.directives.map((e: any) => DirectiveConfiguration.fromJSON(e))
-        : [],
-      requestTimeoutSeconds: isSet(object.requestTimeoutSeconds) ? Number(object.requestTimeoutSeconds) : 0,
-      id: isSet(object.id) ? String(object.id) : "",
-      customNatsKv: isSet(object.customNatsKv) ? DataSourceCustomNatsKv.fromJSON(object.customNatsKv) : undefined,
+      urlEncodeBody: isSet(object.urlEncodeBody) ? Boolean(object.urlEncodeBody) : false,
+      mTLS: isSet(object.mTLS) ? MTLSConfiguration.fromJSON(object.mTLS) : undefined,
+      baseUrl: isSet(object.baseUrl) ? ConfigurationVariable.fromJSON(object.baseUrl) : undefined,
+      path: isSet(object.path) ? ConfigurationVariable.fromJSON(object.path) : undefined,
+      httpProxyUrl: isSet(object.httpProxyUrl) ? ConfigurationVariable.fromJSON(object.httpProxyUrl) : undefined,
     };
   },
 
-  toJSON(message: DataSourceConfiguration): unknown {
+  toJSON(message: FetchConfiguration): unknown {
     const obj: any = {};
-    if (message.kind !== 0) {
-      obj.kind = dataSourceKindToJSON(message.kind);
-    }
-    if (message.rootNodes?.length) {
-      obj.rootNodes = message.rootNodes.map((e) => TypeField.toJSON(e));
+    if (message.url !== undefined) {
+      obj.url = ConfigurationVariable.toJSON(message.url);
     }
-    if (message.childNodes?.length) {
-      obj.childNodes = message.childNodes.map((e) => TypeField.toJSON(e));
+    if (message.method !== 0) {
+      obj.method = hTTPMethodToJSON(message.method);
     }
-    if (message.overrideFieldPathFromAlias === true) {
-      obj.overrideFieldPathFromAlias = message.overrideFieldPathFromAlias;
+    if (message.header) {
+      const entries = Object.entries(message.header);
+      if (entries.length > 0) {
+        obj.header = {};
+        entries.forEach(([k, v]) => {
+          obj.header[k] = HTTPHeader.toJSON(v);
+        });
+      }
     }
-    if (message.customRest !== undefined) {
-      obj.customRest = DataSourceCustomREST.toJSON(message.customRest);
+    if (message.body !== undefined) {
+      obj.body = ConfigurationVariable.toJSON(message.body);
     }
-    if (message.customGraphql !== undefined) {
-      obj.customGraphql = DataSourceCustomGraphQL.toJSON(message.customGraphql);
+    if (message.query?.length) {
+      obj.query = message.query.map((e) => URLQueryConfiguration.toJSON(e));
     }
-    if (message.customStatic !== undefined) {
-      obj.customStatic = DataSourceCustomStatic.toJSON(message.customStatic);
+    if (message.upstreamAuthentication !== undefined) {
+      obj.upstreamAuthentication = UpstreamAuthentication.toJSON(message.upstreamAuthentication);
     }
-    if (message.customDatabase !== undefined) {
-      obj.customDatabase = DataSourceCustomDatabase.toJSON(message.customDatabase);
+    if (message.urlEncodeBody === true) {
+      obj.urlEncodeBody = message.urlEncodeBody;
     }
-    if (message.directives?.length) {
-      obj.directives = message.directives.map((e) => DirectiveConfiguration.toJSON(e));
+    if (message.mTLS !== undefined) {
+      obj.mTLS = MTLSConfiguration.toJSON(message.mTLS);
     }
-    if (message.requestTimeoutSeconds !== 0) {
-      obj.requestTimeoutSeconds = Math.round(message.requestTimeoutSeconds);
+    if (message.baseUrl !== undefined) {
+      obj.baseUrl = ConfigurationVariable.toJSON(message.baseUrl);
     }
-    if (message.id !== "") {
-      obj.id = message.id;
+    if (message.path !== undefined) {
+      obj.path = ConfigurationVariable.toJSON(message.path);
     }
-    if (message.customNatsKv !== undefined) {
-      obj.customNatsKv = DataSourceCustomNatsKv.toJSON(message.customNatsKv);
+    if (message.httpProxyUrl !== undefined) {
+      obj.httpProxyUrl = ConfigurationVariable.toJSON(message.httpProxyUrl);
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<DataSourceConfiguration>, I>>(base?: I): DataSourceConfiguration {
-    return DataSourceConfiguration.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<FetchConfiguration>, I>>(base?: I): FetchConfiguration {
+    return FetchConfiguration.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<DataSourceConfiguration>, I>>(object: I): DataSourceConfiguration {
-    const message = createBaseDataSourceConfiguration();
-    message.kind = object.kind ?? 0;
-    message.rootNodes = object.rootNodes?.map((e) => TypeField.fromPartial(e)) || [];
-    message.childNodes = object.childNodes?.map((e) => TypeField.fromPartial(e)) || [];
-    message.overrideFieldPathFromAlias = object.overrideFieldPathFromAlias ?? false;
-    message.customRest = (object.customRest !== undefined && object.customRest !== null)
-      ? DataSourceCustomREST.fromPartial(object.customRest)
+  fromPartial<I extends Exact<DeepPartial<FetchConfiguration>, I>>(object: I): FetchConfiguration {
+    const message = createBaseFetchConfiguration();
+    message.url = (object.url !== undefined && object.url !== null)
+      ? ConfigurationVariable.fromPartial(object.url)
       : undefined;
-    message.customGraphql = (object.customGraphql !== undefined && object.customGraphql !== null)
-      ? DataSourceCustomGraphQL.fromPartial(object.customGraphql)
+    message.method = object.method ?? 0;
+    message.header = Object.entries(object.header ?? {}).reduce<{ [key: string]: HTTPHeader }>((acc, [key, value]) => {
+      if (value !== undefined) {
+        acc[key] = HTTPHeader.fromPartial(value);
+      }
+      return acc;
+    }, {});
+    message.body = (object.body !== undefined && object.body !== null)
+      ? ConfigurationVariable.fromPartial(object.body)
       : undefined;
-    message.customStatic = (object.customStatic !== undefined && object.customStatic !== null)
-      ? DataSourceCustomStatic.fromPartial(object.customStatic)
+    message.query = object.query?.map((e) => URLQueryConfiguration.fromPartial(e)) || [];
+    message.upstreamAuthentication =
+      (object.upstreamAuthentication !== undefined && object.upstreamAuthentication !== null)
+        ? UpstreamAuthentication.fromPartial(object.upstreamAuthentication)
+        : undefined;
+    message.urlEncodeBody = object.urlEncodeBody ?? false;
+    message.mTLS = (object.mTLS !== undefined && object.mTLS !== null)
+      ? MTLSConfiguration.fromPartial(object.mTLS)
       : undefined;
-    message.customDatabase = (object.customDatabase !== undefined && object.customDatabase !== null)
-      ? DataSourceCustomDatabase.fromPartial(object.customDatabase)
+    message.baseUrl = (object.baseUrl !== undefined && object.baseUrl !== null)
+      ? ConfigurationVariable.fromPartial(object.baseUrl)
       : undefined;
-    message.directives = object.directives?.map((e) => DirectiveConfiguration.fromPartial(e)) || [];
-    message.requestTimeoutSeconds = object.requestTimeoutSeconds ?? 0;
-    message.id = object.id ?? "";
-    message.customNatsKv = (object.customNatsKv !== undefined && object.customNatsKv !== null)
-      ? DataSourceCustomNatsKv.fromPartial(object.customNatsKv)
+    message.path = (object.path !== undefined && object.path !== null)
+      ? ConfigurationVariable.fromPartial(object.path)
+      : undefined;
+    message.httpProxyUrl = (object.httpProxyUrl !== undefined && object.httpProxyUrl !== null)
+      ? ConfigurationVariable.fromPartial(object.httpProxyUrl)
       : undefined;
     return message;
   },
 };
 
-function createBaseDirectiveConfiguration(): DirectiveConfiguration {
-  return { directiveName: "", renameTo: "" };
+function createBaseFetchConfiguration_HeaderEntry(): FetchConfiguration_HeaderEntry {
+  return { key: "", value: undefined };
 }
 
-export const DirectiveConfiguration = {
-  encode(message: DirectiveConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.directiveName !== "") {
-      writer.uint32(10).string(message.directiveName);
+export const FetchConfiguration_HeaderEntry = {
+  encode(message: FetchConfiguration_HeaderEntry, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.key !== "") {
+      writer.uint32(10).string(message.key);
     }
-    if (message.renameTo !== "") {
-      writer.uint32(18).string(message.renameTo);
+    if (message.value !== undefined) {
+      HTTPHeader.encode(message.value, writer.uint32(18).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): DirectiveConfiguration {
+  decode(input: _m0.Reader | Uint8Array, length?: number): FetchConfiguration_HeaderEntry {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseDirectiveConfiguration();
+    const message = createBaseFetchConfiguration_HeaderEntry();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -4788,14 +8035,14 @@ export const DirectiveConfiguration = {
             break;
           }
 
-          message.directiveName = reader.string();
+          message.key = reader.string();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.renameTo = reader.string();
+          message.value = HTTPHeader.decode(reader, reader.uint32());
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -4806,67 +8053,62 @@ export const DirectiveConfiguration = {
     return message;
   },
 
-  fromJSON(object: any): DirectiveConfiguration {
+  fromJSON(object: any): FetchConfiguration_HeaderEntry {
     return {
-      directiveName: isSet(object.directiveName) ? String(object.directiveName) : "",
-      renameTo: isSet(object.renameTo) ? String(object.renameTo) : "",
+      key: isSet(object.key) ? String(object.key) : "",
+      value: isSet(object.value) ? HTTPHeader.fromJSON(object.value) : undefined,
     };
   },
 
-  toJSON(message: DirectiveConfiguration): unknown {
+  toJSON(message: FetchConfiguration_HeaderEntry): unknown {
     const obj: any = {};
-    if (message.directiveName !== "") {
-      obj.directiveName = message.directiveName;
+    if (message.key !== "") {
+      obj.key = message.key;
     }
-    if (message.renameTo !== "") {
-      obj.renameTo = message.renameTo;
+    if (message.value !== undefined) {
+      obj.value = HTTPHeader.toJSON(message.value);
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<DirectiveConfiguration>, I>>(base?: I): DirectiveConfiguration {
-    return DirectiveConfiguration.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<FetchConfiguration_HeaderEntry>, I>>(base?: I): FetchConfiguration_HeaderEntry {
+    return FetchConfiguration_HeaderEntry.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<DirectiveConfiguration>, I>>(object: I): DirectiveConfiguration {
-    const message = createBaseDirectiveConfiguration();
-    message.directiveName = object.directiveName ?? "";
-    message.renameTo = object.renameTo ?? "";
+  fromPartial<I extends Exact<DeepPartial<FetchConfiguration_HeaderEntry>, I>>(
+    object: I,
+  ): FetchConfiguration_HeaderEntry {
+    const message = createBaseFetchConfiguration_HeaderEntry();
+    message.key = object.key ?? "";
+    message.value = (object.value !== undefined && object.value !== null)
+      ? HTTPHeader.fromPartial(object.value)
+      : undefined;
     return message;
   },
 };
 
-function createBaseDataSourceCustomNatsKv(): DataSourceCustomNatsKv {
-  return { serverURL: "", bucketName: "", operation: 0, history: 0, token: "", bucketPrefix: undefined };
+function createBaseMTLSConfiguration(): MTLSConfiguration {
+  return { key: undefined, cert: undefined, insecureSkipVerify: false };
 }
 
-export const DataSourceCustomNatsKv = {
-  encode(message: DataSourceCustomNatsKv, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.serverURL !== "") {
-      writer.uint32(10).string(message.serverURL);
-    }
-    if (message.bucketName !== "") {
-      writer.uint32(18).string(message.bucketName);
-    }
-    if (message.operation !== 0) {
-      writer.uint32(24).int32(message.operation);
-    }
-    if (message.history !== 0) {
-      writer.uint32(32).int32(message.history);
+export const MTLSConfiguration = {
+  encode(message: MTLSConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.key !== undefined) {
+      ConfigurationVariable.encode(message.key, writer.uint32(10).fork()).ldelim();
     }
-    if (message.token !== "") {
-      writer.uint32(42).string(message.token);
+    if (message.cert !== undefined) {
+      ConfigurationVariable.encode(message.cert, writer.uint32(18).fork()).ldelim();
     }
-    if (message.bucketPrefix !== undefined) {
-      ConfigurationVariable.encode(message.bucketPrefix, writer.uint32(50).fork()).ldelim();
+    if (message.insecureSkipVerify === true) {
+      writer.uint32(24).bool(message.insecureSkipVerify);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): DataSourceCustomNatsKv {
+  decode(input: _m0.Reader | Uint8Array, length?: number): MTLSConfiguration {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseDataSourceCustomNatsKv();
+    const message = createBaseMTLSConfiguration();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -4875,42 +8117,21 @@ export const DataSourceCustomNatsKv = {
             break;
           }
 
-          message.serverURL = reader.string();
+          message.key = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.bucketName = reader.string();
+          message.cert = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
         case 3:
           if (tag !== 24) {
             break;
           }
 
-          message.operation = reader.int32() as any;
-          continue;
-        case 4:
-          if (tag !== 32) {
-            break;
-          }
-
-          message.history = reader.int32();
-          continue;
-        case 5:
-          if (tag !== 42) {
-            break;
-          }
-
-          message.token = reader.string();
-          continue;
-        case 6:
-          if (tag !== 50) {
-            break;
-          }
-
-          message.bucketPrefix = ConfigurationVariable.decode(reader, reader.uint32());
+          message.insecureSkipVerify = reader.bool();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -4921,113 +8142,96 @@ export const DataSourceCustomNatsKv = {
     return message;
   },
 
-  fromJSON(object: any): DataSourceCustomNatsKv {
+  fromJSON(object: any): MTLSConfiguration {
     return {
-      serverURL: isSet(object.serverURL) ? String(object.serverURL) : "",
-      bucketName: isSet(object.bucketName) ? String(object.bucketName) : "",
-      operation: isSet(object.operation) ? natsKvOperationFromJSON(object.operation) : 0,
-      history: isSet(object.history) ? Number(object.history) : 0,
-      token: isSet(object.token) ? String(object.token) : "",
-      bucketPrefix: isSet(object.bucketPrefix) ? ConfigurationVariable.fromJSON(object.bucketPrefix) : undefined,
+      key: isSet(object.key) ? ConfigurationVariable.fromJSON(object.key) : undefined,
+      cert: isSet(object.cert) ? ConfigurationVariable.fromJSON(object.cert) : undefined,
+      insecureSkipVerify: isSet(object.insecureSkipVerify) ? Boolean(object.insecureSkipVerify) : false,
     };
   },
 
-  toJSON(message: DataSourceCustomNatsKv): unknown {
+  toJSON(message: MTLSConfiguration): unknown {
     const obj: any = {};
-    if (message.serverURL !== "") {
-      obj.serverURL = message.serverURL;
-    }
-    if (message.bucketName !== "") {
-      obj.bucketName = message.bucketName;
-    }
-    if (message.operation !== 0) {
-      obj.operation = natsKvOperationToJSON(message.operation);
-    }
-    if (message.history !== 0) {
-      obj.history = Math.round(message.history);
+    if (message.key !== undefined) {
+      obj.key = ConfigurationVariable.toJSON(message.key);
     }
-    if (message.token !== "") {
-      obj.token = message.token;
+    if (message.cert !== undefined) {
+      obj.cert = ConfigurationVariable.toJSON(message.cert);
     }
-    if (message.bucketPrefix !== undefined) {
-      obj.bucketPrefix = ConfigurationVariable.toJSON(message.bucketPrefix);
+    if (message.insecureSkipVerify === true) {
+      obj.insecureSkipVerify = message.insecureSkipVerify;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<DataSourceCustomNatsKv>, I>>(base?: I): DataSourceCustomNatsKv {
-    return DataSourceCustomNatsKv.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<MTLSConfiguration>, I>>(base?: I): MTLSConfiguration {
+    return MTLSConfiguration.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<DataSourceCustomNatsKv>, I>>(object: I): DataSourceCustomNatsKv {
-    const message = createBaseDataSourceCustomNatsKv();
-    message.serverURL = object.serverURL ?? "";
-    message.bucketName = object.bucketName ?? "";
-    message.operation = object.operation ?? 0;
-    message.history = object.history ?? 0;
-    message.token = object.token ?? "";
-    message.bucketPrefix = (object.bucketPrefix !== undefined && object.bucketPrefix !== null)
-      ? ConfigurationVariable.fromPartial(object.bucketPrefix)
+  fromPartial<I extends Exact<DeepPartial<MTLSConfiguration>, I>>(object: I): MTLSConfiguration {
+    const message = createBaseMTLSConfiguration();
+    message.key = (object.key !== undefined && object.key !== null)
+      ? ConfigurationVariable.fromPartial(object.key)
       : undefined;
+    message.cert = (object.cert !== undefined && object.cert !== null)
+      ? ConfigurationVariable.fromPartial(object.cert)
+      : undefined;
+    message.insecureSkipVerify = object.insecureSkipVerify ?? false;
     return message;
   },
 };
 
-function createBaseDataSourceCustomREST(): DataSourceCustomREST {
-  return { fetch: undefined, subscription: undefined, statusCodeTypeMappings: [], defaultTypeName: "" };
+function createBaseUpstreamAuthentication(): UpstreamAuthentication {
+  return { kind: 0, jwtConfig: undefined, jwtWithAccessTokenExchangeConfig: undefined };
 }
 
-export const DataSourceCustomREST = {
-  encode(message: DataSourceCustomREST, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.fetch !== undefined) {
-      FetchConfiguration.encode(message.fetch, writer.uint32(10).fork()).ldelim();
-    }
-    if (message.subscription !== undefined) {
-      RESTSubscriptionConfiguration.encode(message.subscription, writer.uint32(18).fork()).ldelim();
+export const UpstreamAuthentication = {
+  encode(message: UpstreamAuthentication, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.kind !== 0) {
+      writer.uint32(8).int32(message.kind);
     }
-    for (const v of message.statusCodeTypeMappings) {
-      StatusCodeTypeMapping.encode(v!, writer.uint32(26).fork()).ldelim();
+    if (message.jwtConfig !== undefined) {
+      JwtUpstreamAuthenticationConfig.encode(message.jwtConfig, writer.uint32(18).fork()).ldelim();
     }
-    if (message.defaultTypeName !== "") {
-      writer.uint32(34).string(message.defaultTypeName);
+    if (message.jwtWithAccessTokenExchangeConfig !== undefined) {
+      JwtUpstreamAuthenticationWithAccessTokenExchange.encode(
+        message.jwtWithAccessTokenExchangeConfig,
+        writer.uint32(26).fork(),
+      ).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): DataSourceCustomREST {
+  decode(input: _m0.Reader | Uint8Array, length?: number): UpstreamAuthentication {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseDataSourceCustomREST();
+    const message = createBaseUpstreamAuthentication();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 10) {
+          if (tag !== 8) {
             break;
           }
 
-          message.fetch = FetchConfiguration.decode(reader, reader.uint32());
+          message.kind = reader.int32() as any;
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.subscription = RESTSubscriptionConfiguration.decode(reader, reader.uint32());
+          message.jwtConfig = JwtUpstreamAuthenticationConfig.decode(reader, reader.uint32());
           continue;
         case 3:
           if (tag !== 26) {
             break;
           }
 
-          message.statusCodeTypeMappings.push(StatusCodeTypeMapping.decode(reader, reader.uint32()));
-          continue;
-        case 4:
-          if (tag !== 34) {
-            break;
-          }
-
-          message.defaultTypeName = reader.string();
+          message.jwtWithAccessTokenExchangeConfig = JwtUpstreamAuthenticationWithAccessTokenExchange.decode(
+            reader,
+            reader.uint32(),
+          );
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -5038,100 +8242,85 @@ export const DataSourceCustomREST = {
     return message;
   },
 
-  fromJSON(object: any): DataSourceCustomREST {
+  fromJSON(object: any): UpstreamAuthentication {
     return {
-      fetch: isSet(object.fetch) ? FetchConfiguration.fromJSON(object.fetch) : undefined,
-      subscription: isSet(object.subscription)
-        ? RESTSubscriptionConfiguration.fromJSON(object.subscription)
+      kind: isSet(object.kind) ? upstreamAuthenticationKindFromJSON(object.kind) : 0,
+      jwtConfig: isSet(object.jwtConfig) ? JwtUpstreamAuthenticationConfig.fromJSON(object.jwtConfig) : undefined,
+      jwtWithAccessTokenExchangeConfig: isSet(object.jwtWithAccessTokenExchangeConfig)
+        ? JwtUpstreamAuthenticationWithAccessTokenExchange.fromJSON(object.jwtWithAccessTokenExchangeConfig)
         : undefined,
-      statusCodeTypeMappings: Array.isArray(object?.statusCodeTypeMappings)
-        ? object.statusCodeTypeMappings.map((e: any) => StatusCodeTypeMapping.fromJSON(e))
-        : [],
-      defaultTypeName: isSet(object.defaultTypeName) ? String(object.defaultTypeName) : "",
     };
   },
 
-  toJSON(message: DataSourceCustomREST): unknown {
+  toJSON(message: UpstreamAuthentication): unknown {
     const obj: any = {};
-    if (message.fetch !== undefined) {
-      obj.fetch = FetchConfiguration.toJSON(message.fetch);
-    }
-    if (message.subscription !== undefined) {
-      obj.subscription = RESTSubscriptionConfiguration.toJSON(message.subscription);
+    if (message.kind !== 0) {
+      obj.kind = upstreamAuthenticationKindToJSON(message.kind);
     }
-    if (message.statusCodeTypeMappings?.length) {
-      obj.statusCodeTypeMappings = message.statusCodeTypeMappings.map((e) => StatusCodeTypeMapping.toJSON(e));
+    if (message.jwtConfig !== undefined) {
+      obj.jwtConfig = JwtUpstreamAuthenticationConfig.toJSON(message.jwtConfig);
     }
-    if (message.defaultTypeName !== "") {
-      obj.defaultTypeName = message.defaultTypeName;
+    if (message.jwtWithAccessTokenExchangeConfig !== undefined) {
+      obj.jwtWithAccessTokenExchangeConfig = JwtUpstreamAuthenticationWithAccessTokenExchange.toJSON(
+        message.jwtWithAccessTokenExchangeConfig,
+      );
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<DataSourceCustomREST>, I>>(base?: I): DataSourceCustomREST {
-    return DataSourceCustomREST.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<UpstreamAuthentication>, I>>(base?: I): UpstreamAuthentication {
+    return UpstreamAuthentication.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<DataSourceCustomREST>, I>>(object: I): DataSourceCustomREST {
-    const message = createBaseDataSourceCustomREST();
-    message.fetch = (object.fetch !== undefined && object.fetch !== null)
-      ? FetchConfiguration.fromPartial(object.fetch)
-      : undefined;
-    message.subscription = (object.subscription !== undefined && object.subscription !== null)
-      ? RESTSubscriptionConfiguration.fromPartial(object.subscription)
+  fromPartial<I extends Exact<DeepPartial<UpstreamAuthentication>, I>>(object: I): UpstreamAuthentication {
+    const message = createBaseUpstreamAuthentication();
+    message.kind = object.kind ?? 0;
+    message.jwtConfig = (object.jwtConfig !== undefined && object.jwtConfig !== null)
+      ? JwtUpstreamAuthenticationConfig.fromPartial(object.jwtConfig)
       : undefined;
-    message.statusCodeTypeMappings = object.statusCodeTypeMappings?.map((e) => StatusCodeTypeMapping.fromPartial(e)) ||
-      [];
-    message.defaultTypeName = object.defaultTypeName ?? "";
+    message.jwtWithAccessTokenExchangeConfig =
+      (object.jwtWithAccessTokenExchangeConfig !== undefined && object.jwtWithAccessTokenExchangeConfig !== null)
+        ? JwtUpstreamAuthenticationWithAccessTokenExchange.fromPartial(object.jwtWithAccessTokenExchangeConfig)
+        : undefined;
     return message;
   },
 };
 
-function createBaseStatusCodeTypeMapping(): StatusCodeTypeMapping {
-  return { statusCode: 0, typeName: "", injectStatusCodeIntoBody: false };
+function createBaseJwtUpstreamAuthenticationConfig(): JwtUpstreamAuthenticationConfig {
+  return { secret: undefined, signingMethod: 0 };
 }
 
-export const StatusCodeTypeMapping = {
-  encode(message: StatusCodeTypeMapping, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.statusCode !== 0) {
-      writer.uint32(8).int64(message.statusCode);
-    }
-    if (message.typeName !== "") {
-      writer.uint32(18).string(message.typeName);
+export const JwtUpstreamAuthenticationConfig = {
+  encode(message: JwtUpstreamAuthenticationConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.secret !== undefined) {
+      ConfigurationVariable.encode(message.secret, writer.uint32(10).fork()).ldelim();
     }
-    if (message.injectStatusCodeIntoBody === true) {
-      writer.uint32(24).bool(message.injectStatusCodeIntoBody);
+    if (message.signingMethod !== 0) {
+      writer.uint32(16).int32(message.signingMethod);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): StatusCodeTypeMapping {
+  decode(input: _m0.Reader | Uint8Array, length?: number): JwtUpstreamAuthenticationConfig {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseStatusCodeTypeMapping();
+    const message = createBaseJwtUpstreamAuthenticationConfig();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.statusCode = longToNumber(reader.int64() as Long);
+          message.secret = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
         case 2:
-          if (tag !== 18) {
-            break;
-          }
-
-          message.typeName = reader.string();
-          continue;
-        case 3:
-          if (tag !== 24) {
+          if (tag !== 16) {
             break;
           }
 
-          message.injectStatusCodeIntoBody = reader.bool();
+          message.signingMethod = reader.int32() as any;
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -5142,81 +8331,65 @@ export const StatusCodeTypeMapping = {
     return message;
   },
 
-  fromJSON(object: any): StatusCodeTypeMapping {
+  fromJSON(object: any): JwtUpstreamAuthenticationConfig {
     return {
-      statusCode: isSet(object.statusCode) ? Number(object.statusCode) : 0,
-      typeName: isSet(object.typeName) ? String(object.typeName) : "",
-      injectStatusCodeIntoBody: isSet(object.injectStatusCodeIntoBody)
-        ? Boolean(object.injectStatusCodeIntoBody)
-        : false,
+      secret: isSet(object.secret) ? ConfigurationVariable.fromJSON(object.secret) : undefined,
+      signingMethod: isSet(object.signingMethod) ? signingMethodFromJSON(object.signingMethod) : 0,
     };
   },
 
-  toJSON(message: StatusCodeTypeMapping): unknown {
+  toJSON(message: JwtUpstreamAuthenticationConfig): unknown {
     const obj: any = {};
-    if (message.statusCode !== 0) {
-      obj.statusCode = Math.round(message.statusCode);
-    }
-    if (message.typeName !== "") {
-      obj.typeName = message.typeName;
+    if (message.secret !== undefined) {
+      obj.secret = ConfigurationVariable.toJSON(message.secret);
     }
-    if (message.injectStatusCodeIntoBody === true) {
-      obj.injectStatusCodeIntoBody = message.injectStatusCodeIntoBody;
+    if (message.signingMethod !== 0) {
+      obj.signingMethod = signingMethodToJSON(message.signingMethod);
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<StatusCodeTypeMapping>, I>>(base?: I): StatusCodeTypeMapping {
-    return StatusCodeTypeMapping.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<JwtUpstreamAuthenticationConfig>, I>>(base?: I): JwtUpstreamAuthenticationConfig {
+    return JwtUpstreamAuthenticationConfig.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<StatusCodeTypeMapping>, I>>(object: I): StatusCodeTypeMapping {
-    const message = createBaseStatusCodeTypeMapping();
-    message.statusCode = object.statusCode ?? 0;
-    message.typeName = object.typeName ?? "";
-    message.injectStatusCodeIntoBody = object.injectStatusCodeIntoBody ?? false;
+  fromPartial<I extends Exact<DeepPartial<JwtUpstreamAuthenticationConfig>, I>>(
+    object: I,
+  ): JwtUpstreamAuthenticationConfig {
+    const message = createBaseJwtUpstreamAuthenticationConfig();
+    message.secret = (object.secret !== undefined && object.secret !== null)
+      ? ConfigurationVariable.fromPartial(object.secret)
+      : undefined;
+    message.signingMethod = object.signingMethod ?? 0;
     return message;
   },
 };
 
-function createBaseDataSourceCustomGraphQL(): DataSourceCustomGraphQL {
-  return {
-    fetch: undefined,
-    subscription: undefined,
-    federation: undefined,
-    upstreamSchema: undefined,
-    hooksConfiguration: undefined,
-    customScalarTypeFields: [],
-  };
+function createBaseJwtUpstreamAuthenticationWithAccessTokenExchange(): JwtUpstreamAuthenticationWithAccessTokenExchange {
+  return { secret: undefined, signingMethod: 0, accessTokenExchangeEndpoint: undefined };
 }
 
-export const DataSourceCustomGraphQL = {
-  encode(message: DataSourceCustomGraphQL, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.fetch !== undefined) {
-      FetchConfiguration.encode(message.fetch, writer.uint32(10).fork()).ldelim();
-    }
-    if (message.subscription !== undefined) {
-      GraphQLSubscriptionConfiguration.encode(message.subscription, writer.uint32(18).fork()).ldelim();
-    }
-    if (message.federation !== undefined) {
-      GraphQLFederationConfiguration.encode(message.federation, writer.uint32(26).fork()).ldelim();
-    }
-    if (message.upstreamSchema !== undefined) {
-      InternedString.encode(message.upstreamSchema, writer.uint32(34).fork()).ldelim();
+export const JwtUpstreamAuthenticationWithAccessTokenExchange = {
+  encode(
+    message: JwtUpstreamAuthenticationWithAccessTokenExchange,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.secret !== undefined) {
+      ConfigurationVariable.encode(message.secret, writer.uint32(10).fork()).ldelim();
     }
-    if (message.hooksConfiguration !== undefined) {
-      GraphQLDataSourceHooksConfiguration.encode(message.hooksConfiguration, writer.uint32(42).fork()).ldelim();
+    if (message.signingMethod !== 0) {
+      writer.uint32(16).int32(message.signingMethod);
     }
-    for (const v of message.customScalarTypeFields) {
-      SingleTypeField.encode(v!, writer.uint32(50).fork()).ldelim();
+    if (message.accessTokenExchangeEndpoint !== undefined) {
+      ConfigurationVariable.encode(message.accessTokenExchangeEndpoint, writer.uint32(26).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): DataSourceCustomGraphQL {
+  decode(input: _m0.Reader | Uint8Array, length?: number): JwtUpstreamAuthenticationWithAccessTokenExchange {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseDataSourceCustomGraphQL();
+    const message = createBaseJwtUpstreamAuthenticationWithAccessTokenExchange();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -5225,42 +8398,21 @@ export const DataSourceCustomGraphQL = {
             break;
           }
 
-          message.fetch = FetchConfiguration.decode(reader, reader.uint32());
+          message.secret = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
         case 2:
-          if (tag !== 18) {
+          if (tag !== 16) {
             break;
           }
 
-          message.subscription = GraphQLSubscriptionConfiguration.decode(reader, reader.uint32());
+          message.signingMethod = reader.int32() as any;
           continue;
         case 3:
           if (tag !== 26) {
             break;
           }
 
-          message.federation = GraphQLFederationConfiguration.decode(reader, reader.uint32());
-          continue;
-        case 4:
-          if (tag !== 34) {
-            break;
-          }
-
-          message.upstreamSchema = InternedString.decode(reader, reader.uint32());
-          continue;
-        case 5:
-          if (tag !== 42) {
-            break;
-          }
-
-          message.hooksConfiguration = GraphQLDataSourceHooksConfiguration.decode(reader, reader.uint32());
-          continue;
-        case 6:
-          if (tag !== 50) {
-            break;
-          }
-
-          message.customScalarTypeFields.push(SingleTypeField.decode(reader, reader.uint32()));
+          message.accessTokenExchangeEndpoint = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -5271,154 +8423,97 @@ export const DataSourceCustomGraphQL = {
     return message;
   },
 
-  fromJSON(object: any): DataSourceCustomGraphQL {
+  fromJSON(object: any): JwtUpstreamAuthenticationWithAccessTokenExchange {
     return {
-      fetch: isSet(object.fetch) ? FetchConfiguration.fromJSON(object.fetch) : undefined,
-      subscription: isSet(object.subscription)
-        ? GraphQLSubscriptionConfiguration.fromJSON(object.subscription)
-        : undefined,
-      federation: isSet(object.federation) ? GraphQLFederationConfiguration.fromJSON(object.federation) : undefined,
-      upstreamSchema: isSet(object.upstreamSchema) ? InternedString.fromJSON(object.upstreamSchema) : undefined,
-      hooksConfiguration: isSet(object.hooksConfiguration)
-        ? GraphQLDataSourceHooksConfiguration.fromJSON(object.hooksConfiguration)
-        : undefined,
-      customScalarTypeFields: Array.isArray(object?.customScalarTypeFields)
-        ? object.customScalarTypeFields.map((e: any) => SingleTypeField.fromJSON(e))
-        : [],
-    };
-  },
-
-  toJSON(message: DataSourceCustomGraphQL): unknown {
-    const obj: any = {};
-    if (message.fetch !== undefined) {
-      obj.fetch = FetchConfiguration.toJSON(message.fetch);
-    }
-    if (message.subscription !== undefined) {
-      obj.subscription = GraphQLSubscriptionConfiguration.toJSON(message.subscription);
-    }
-    if (message.federation !== undefined) {
-      obj.federation = GraphQLFederationConfiguration.toJSON(message.federation);
-    }
-    if (message.upstreamSchema !== undefined) {
-      obj.upstreamSchema = InternedString.toJSON(message.upstreamSchema);
+      secret: isSet(object.secret) ? ConfigurationVariable.fromJSON(object.secret) : undefined,
+      signingMethod: isSet(object.signingMethod) ? signingMethodFromJSON(object.signingMethod) : 0,
+      accessTokenExchangeEndpoint: isSet(object.accessTokenExchangeEndpoint)
+        ? ConfigurationVariable.fromJSON(object.accessTokenExchangeEndpoint)
+        : undefined,
+    };
+  },
+
+  toJSON(message: JwtUpstreamAuthenticationWithAccessTokenExchange): unknown {
+    const obj: any = {};
+    if (message.secret !== undefined) {
+      obj.secret = ConfigurationVariable.toJSON(message.secret);
     }
-    if (message.hooksConfiguration !== undefined) {
-      obj.hooksConfiguration = GraphQLDataSourceHooksConfiguration.toJSON(message.hooksConfiguration);
+    if (message.signingMethod !== 0) {
+      obj.signingMethod = signingMethodToJSON(message.signingMethod);
     }
-    if (message.customScalarTypeFields?.length) {
-      obj.customScalarTypeFields = message.customScalarTypeFields.map((e) => SingleTypeField.toJSON(e));
+    if (message.accessTokenExchangeEndpoint !== undefined) {
+      obj.accessTokenExchangeEndpoint = ConfigurationVariable.toJSON(message.accessTokenExchangeEndpoint);
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<DataSourceCustomGraphQL>, I>>(base?: I): DataSourceCustomGraphQL {
-    return DataSourceCustomGraphQL.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<JwtUpstreamAuthenticationWithAccessTokenExchange>, I>>(
+    base?: I,
+  ): JwtUpstreamAuthenticationWithAccessTokenExchange {
+    return JwtUpstreamAuthenticationWithAccessTokenExchange.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<DataSourceCustomGraphQL>, I>>(object: I): DataSourceCustomGraphQL {
-    const message = createBaseDataSourceCustomGraphQL();
-    message.fetch = (object.fetch !== undefined && object.fetch !== null)
-      ? FetchConfiguration.fromPartial(object.fetch)
-      : undefined;
-    message.subscription = (object.subscription !== undefined && object.subscription !== null)
-      ? GraphQLSubscriptionConfiguration.fromPartial(object.subscription)
-      : undefined;
-    message.federation = (object.federation !== undefined && object.federation !== null)
-      ? GraphQLFederationConfiguration.fromPartial(object.federation)
-      : undefined;
-    message.upstreamSchema = (object.upstreamSchema !== undefined && object.upstreamSchema !== null)
-      ? InternedString.fromPartial(object.upstreamSchema)
-      : undefined;
-    message.hooksConfiguration = (object.hooksConfiguration !== undefined && object.hooksConfiguration !== null)
-      ? GraphQLDataSourceHooksConfiguration.fromPartial(object.hooksConfiguration)
+  fromPartial<I extends Exact<DeepPartial<JwtUpstreamAuthenticationWithAccessTokenExchange>, I>>(
+    object: I,
+  ): JwtUpstreamAuthenticationWithAccessTokenExchange {
+    const message = createBaseJwtUpstreamAuthenticationWithAccessTokenExchange();
+    message.secret = (object.secret !== undefined && object.secret !== null)
+      ? ConfigurationVariable.fromPartial(object.secret)
       : undefined;
-    message.customScalarTypeFields = object.customScalarTypeFields?.map((e) => SingleTypeField.fromPartial(e)) || [];
+    message.signingMethod = object.signingMethod ?? 0;
+    message.accessTokenExchangeEndpoint =
+      (object.accessTokenExchangeEndpoint !== undefined && object.accessTokenExchangeEndpoint !== null)
+        ? ConfigurationVariable.fromPartial(object.accessTokenExchangeEndpoint)
+        : undefined;
     return message;
   },
 };
 
-function createBaseDataSourceCustomDatabase(): DataSourceCustomDatabase {
-  return {
-    databaseURL: undefined,
-    prismaSchema: undefined,
-    graphqlSchema: undefined,
-    closeTimeoutSeconds: 0,
-    jsonTypeFields: [],
-    jsonInputVariables: [],
-  };
+function createBaseRESTSubscriptionConfiguration(): RESTSubscriptionConfiguration {
+  return { enabled: false, pollingIntervalMillis: 0, skipPublishSameResponse: false };
 }
 
-export const DataSourceCustomDatabase = {
-  encode(message: DataSourceCustomDatabase, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.databaseURL !== undefined) {
-      ConfigurationVariable.encode(message.databaseURL, writer.uint32(10).fork()).ldelim();
-    }
-    if (message.prismaSchema !== undefined) {
-      InternedString.encode(message.prismaSchema, writer.uint32(18).fork()).ldelim();
-    }
-    if (message.graphqlSchema !== undefined) {
-      InternedString.encode(message.graphqlSchema, writer.uint32(26).fork()).ldelim();
-    }
-    if (message.closeTimeoutSeconds !== 0) {
-      writer.uint32(32).int64(message.closeTimeoutSeconds);
+export const RESTSubscriptionConfiguration = {
+  encode(message: RESTSubscriptionConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.enabled === true) {
+      writer.uint32(8).bool(message.enabled);
     }
-    for (const v of message.jsonTypeFields) {
-      SingleTypeField.encode(v!, writer.uint32(42).fork()).ldelim();
+    if (message.pollingIntervalMillis !== 0) {
+      writer.uint32(16).int64(message.pollingIntervalMillis);
     }
-    for (const v of message.jsonInputVariables) {
-      writer.uint32(50).string(v!);
+    if (message.skipPublishSameResponse === true) {
+      writer.uint32(24).bool(message.skipPublishSameResponse);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): DataSourceCustomDatabase {
+  decode(input: _m0.Reader | Uint8Array, length?: number): RESTSubscriptionConfiguration {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseDataSourceCustomDatabase();
+    const message = createBaseRESTSubscriptionConfiguration();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 10) {
+          if (tag !== 8) {
             break;
           }
 
-          message.databaseURL = ConfigurationVariable.decode(reader, reader.uint32());
+          message.enabled = reader.bool();
           continue;
         case 2:
-          if (tag !== 18) {
+          if (tag !== 16) {
             break;
           }
 
-          message.prismaSchema = InternedString.decode(reader, reader.uint32());
+          message.pollingIntervalMillis = longToNumber(reader.int64() as Long);
           continue;
         case 3:
-          if (tag !== 26) {
-            break;
-          }
-
-          message.graphqlSchema = InternedString.decode(reader, reader.uint32());
-          continue;
-        case 4:
-          if (tag !== 32) {
-            break;
-          }
-
-          message.closeTimeoutSeconds = longToNumber(reader.int64() as Long);
-          continue;
-        case 5:
-          if (tag !== 42) {
-            break;
-          }
-
-          message.jsonTypeFields.push(SingleTypeField.decode(reader, reader.uint32()));
-          continue;
-        case 6:
-          if (tag !== 50) {
+          if (tag !== 24) {
             break;
           }
 
-          message.jsonInputVariables.push(reader.string());
+          message.skipPublishSameResponse = reader.bool();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -5429,101 +8524,78 @@ export const DataSourceCustomDatabase = {
     return message;
   },
 
-  fromJSON(object: any): DataSourceCustomDatabase {
+  fromJSON(object: any): RESTSubscriptionConfiguration {
     return {
-      databaseURL: isSet(object.databaseURL) ? ConfigurationVariable.fromJSON(object.databaseURL) : undefined,
-      prismaSchema: isSet(object.prismaSchema) ? InternedString.fromJSON(object.prismaSchema) : undefined,
-      graphqlSchema: isSet(object.graphqlSchema) ? InternedString.fromJSON(object.graphqlSchema) : undefined,
-      closeTimeoutSeconds: isSet(object.closeTimeoutSeconds) ? Number(object.closeTimeoutSeconds) : 0,
-      jsonTypeFields: Array.isArray(object?.jsonTypeFields)
-        ? object.jsonTypeFields.map((e: any) => SingleTypeField.fromJSON(e))
-        : [],
-      jsonInputVariables: Array.isArray(object?.jsonInputVariables)
-        ? object.jsonInputVariables.map((e: any) => String(e))
-        : [],
+      enabled: isSet(object.enabled) ? Boolean(object.enabled) : false,
+      pollingIntervalMillis: isSet(object.pollingIntervalMillis) ? Number(object.pollingIntervalMillis) : 0,
+      skipPublishSameResponse: isSet(object.skipPublishSameResponse) ? Boolean(object.skipPublishSameResponse) : false,
     };
   },
 
-  toJSON(message: DataSourceCustomDatabase): unknown {
+  toJSON(message: RESTSubscriptionConfiguration): unknown {
     const obj: any = {};
-    if (message.databaseURL !== undefined) {
-      obj.databaseURL = ConfigurationVariable.toJSON(message.databaseURL);
-    }
-    if (message.prismaSchema !== undefined) {
-      obj.prismaSchema = InternedString.toJSON(message.prismaSchema);
-    }
-    if (message.graphqlSchema !== undefined) {
-      obj.graphqlSchema = InternedString.toJSON(message.graphqlSchema);
-    }
-    if (message.closeTimeoutSeconds !== 0) {
-      obj.closeTimeoutSeconds = Math.round(message.closeTimeoutSeconds);
+    if (message.enabled === true) {
+      obj.enabled = message.enabled;
     }
-    if (message.jsonTypeFields?.length) {
-      obj.jsonTypeFields = message.jsonTypeFields.map((e) => SingleTypeField.toJSON(e));
+    if (message.pollingIntervalMillis !== 0) {
+      obj.pollingIntervalMillis = Math.round(message.pollingIntervalMillis);
     }
-    if (message.jsonInputVariables?.length) {
-      obj.jsonInputVariables = message.jsonInputVariables;
+    if (message.skipPublishSameResponse === true) {
+      obj.skipPublishSameResponse = message.skipPublishSameResponse;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<DataSourceCustomDatabase>, I>>(base?: I): DataSourceCustomDatabase {
-    return DataSourceCustomDatabase.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<RESTSubscriptionConfiguration>, I>>(base?: I): RESTSubscriptionConfiguration {
+    return RESTSubscriptionConfiguration.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<DataSourceCustomDatabase>, I>>(object: I): DataSourceCustomDatabase {
-    const message = createBaseDataSourceCustomDatabase();
-    message.databaseURL = (object.databaseURL !== undefined && object.databaseURL !== null)
-      ? ConfigurationVariable.fromPartial(object.databaseURL)
-      : undefined;
-    message.prismaSchema = (object.prismaSchema !== undefined && object.prismaSchema !== null)
-      ? InternedString.fromPartial(object.prismaSchema)
-      : undefined;
-    message.graphqlSchema = (object.graphqlSchema !== undefined && object.graphqlSchema !== null)
-      ? InternedString.fromPartial(object.graphqlSchema)
-      : undefined;
-    message.closeTimeoutSeconds = object.closeTimeoutSeconds ?? 0;
-    message.jsonTypeFields = object.jsonTypeFields?.map((e) => SingleTypeField.fromPartial(e)) || [];
-    message.jsonInputVariables = object.jsonInputVariables?.map((e) => e) || [];
+  fromPartial<I extends Exact<DeepPartial<RESTSubscriptionConfiguration>, I>>(
+    object: I,
+  ): RESTSubscriptionConfiguration {
+    const message = createBaseRESTSubscriptionConfiguration();
+    message.enabled = object.enabled ?? false;
+    message.pollingIntervalMillis = object.pollingIntervalMillis ?? 0;
+    message.skipPublishSameResponse = object.skipPublishSameResponse ?? false;
     return message;
   },
 };
 
-function createBaseGraphQLFederationConfiguration(): GraphQLFederationConfiguration {
-  return { enabled: false, serviceSdl: "" };
+function createBaseURLQueryConfiguration(): URLQueryConfiguration {
+  return { name: "", value: "" };
 }
 
-export const GraphQLFederationConfiguration = {
-  encode(message: GraphQLFederationConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.enabled === true) {
-      writer.uint32(8).bool(message.enabled);
+export const URLQueryConfiguration = {
+  encode(message: URLQueryConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
     }
-    if (message.serviceSdl !== "") {
-      writer.uint32(18).string(message.serviceSdl);
+    if (message.value !== "") {
+      writer.uint32(18).string(message.value);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): GraphQLFederationConfiguration {
+  decode(input: _m0.Reader | Uint8Array, length?: number): URLQueryConfiguration {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseGraphQLFederationConfiguration();
+    const message = createBaseURLQueryConfiguration();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.enabled = reader.bool();
+          message.name = reader.string();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.serviceSdl = reader.string();
+          message.value = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -5534,54 +8606,52 @@ export const GraphQLFederationConfiguration = {
     return message;
   },
 
-  fromJSON(object: any): GraphQLFederationConfiguration {
+  fromJSON(object: any): URLQueryConfiguration {
     return {
-      enabled: isSet(object.enabled) ? Boolean(object.enabled) : false,
-      serviceSdl: isSet(object.serviceSdl) ? String(object.serviceSdl) : "",
+      name: isSet(object.name) ? String(object.name) : "",
+      value: isSet(object.value) ? String(object.value) : "",
     };
   },
 
-  toJSON(message: GraphQLFederationConfiguration): unknown {
+  toJSON(message: URLQueryConfiguration): unknown {
     const obj: any = {};
-    if (message.enabled === true) {
-      obj.enabled = message.enabled;
+    if (message.name !== "") {
+      obj.name = message.name;
     }
-    if (message.serviceSdl !== "") {
-      obj.serviceSdl = message.serviceSdl;
+    if (message.value !== "") {
+      obj.value = message.value;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<GraphQLFederationConfiguration>, I>>(base?: I): GraphQLFederationConfiguration {
-    return GraphQLFederationConfiguration.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<URLQueryConfiguration>, I>>(base?: I): URLQueryConfiguration {
+    return URLQueryConfiguration.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<GraphQLFederationConfiguration>, I>>(
-    object: I,
-  ): GraphQLFederationConfiguration {
-    const message = createBaseGraphQLFederationConfiguration();
-    message.enabled = object.enabled ?? false;
-    message.serviceSdl = object.serviceSdl ?? "";
+  fromPartial<I extends Exact<DeepPartial<URLQueryConfiguration>, I>>(object: I): URLQueryConfiguration {
+    const message = createBaseURLQueryConfiguration();
+    message.name = object.name ?? "";
+    message.value = object.value ?? "";
     return message;
   },
 };
 
-function createBaseDataSourceCustomStatic(): DataSourceCustomStatic {
-  return { data: undefined };
+function createBaseHTTPHeader(): HTTPHeader {
+  return { values: [] };
 }
 
-export const DataSourceCustomStatic = {
-  encode(message: DataSourceCustomStatic, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.data !== undefined) {
-      ConfigurationVariable.encode(message.data, writer.uint32(10).fork()).ldelim();
+export const HTTPHeader = {
+  encode(message: HTTPHeader, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.values) {
+      ConfigurationVariable.encode(v!, writer.uint32(10).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): DataSourceCustomStatic {
+  decode(input: _m0.Reader | Uint8Array, length?: number): HTTPHeader {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseDataSourceCustomStatic();
+    const message = createBaseHTTPHeader();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -5590,7 +8660,7 @@ export const DataSourceCustomStatic = {
             break;
           }
 
-          message.data = ConfigurationVariable.decode(reader, reader.uint32());
+          message.values.push(ConfigurationVariable.decode(reader, reader.uint32()));
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -5601,76 +8671,66 @@ export const DataSourceCustomStatic = {
     return message;
   },
 
-  fromJSON(object: any): DataSourceCustomStatic {
-    return { data: isSet(object.data) ? ConfigurationVariable.fromJSON(object.data) : undefined };
+  fromJSON(object: any): HTTPHeader {
+    return {
+      values: Array.isArray(object?.values) ? object.values.map((e: any) => ConfigurationVariable.fromJSON(e)) : [],
+    };
   },
 
-  toJSON(message: DataSourceCustomStatic): unknown {
+  toJSON(message: HTTPHeader): unknown {
     const obj: any = {};
-    if (message.data !== undefined) {
-      obj.data = ConfigurationVariable.toJSON(message.data);
+    if (message.values?.length) {
+      obj.values = message.values.map((e) => ConfigurationVariable.toJSON(e));
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<DataSourceCustomStatic>, I>>(base?: I): DataSourceCustomStatic {
-    return DataSourceCustomStatic.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<HTTPHeader>, I>>(base?: I): HTTPHeader {
+    return HTTPHeader.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<DataSourceCustomStatic>, I>>(object: I): DataSourceCustomStatic {
-    const message = createBaseDataSourceCustomStatic();
-    message.data = (object.data !== undefined && object.data !== null)
-      ? ConfigurationVariable.fromPartial(object.data)
-      : undefined;
+  fromPartial<I extends Exact<DeepPartial<HTTPHeader>, I>>(object: I): HTTPHeader {
+    const message = createBaseHTTPHeader();
+    message.values = object.values?.map((e) => ConfigurationVariable.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseGraphQLSubscriptionConfiguration(): GraphQLSubscriptionConfiguration {
-  return { enabled: false, url: undefined, useSSE: false };
+function createBaseTypeConfiguration(): TypeConfiguration {
+  return { typeName: "", renameTo: "" };
 }
 
-export const GraphQLSubscriptionConfiguration = {
-  encode(message: GraphQLSubscriptionConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.enabled === true) {
-      writer.uint32(8).bool(message.enabled);
-    }
-    if (message.url !== undefined) {
-      ConfigurationVariable.encode(message.url, writer.uint32(18).fork()).ldelim();
+export const TypeConfiguration = {
+  encode(message: TypeConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.typeName !== "") {
+      writer.uint32(10).string(message.typeName);
     }
-    if (message.useSSE === true) {
-      writer.uint32(24).bool(message.useSSE);
+    if (message.renameTo !== "") {
+      writer.uint32(18).string(message.renameTo);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): GraphQLSubscriptionConfiguration {
+  decode(input: _m0.Reader | Uint8Array, length?: number): TypeConfiguration {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseGraphQLSubscriptionConfiguration();
+    const message = createBaseTypeConfiguration();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.enabled = reader.bool();
+          message.typeName = reader.string();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.url = ConfigurationVariable.decode(reader, reader.uint32());
-          continue;
-        case 3:
-          if (tag !== 24) {
-            break;
-          }
-
-          message.useSSE = reader.bool();
+          message.renameTo = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -5681,105 +8741,78 @@ export const GraphQLSubscriptionConfiguration = {
     return message;
   },
 
-  fromJSON(object: any): GraphQLSubscriptionConfiguration {
+  fromJSON(object: any): TypeConfiguration {
     return {
-      enabled: isSet(object.enabled) ? Boolean(object.enabled) : false,
-      url: isSet(object.url) ? ConfigurationVariable.fromJSON(object.url) : undefined,
-      useSSE: isSet(object.useSSE) ? Boolean(object.useSSE) : false,
+      typeName: isSet(object.typeName) ? String(object.typeName) : "",
+      renameTo: isSet(object.renameTo) ? String(object.renameTo) : "",
     };
   },
 
-  toJSON(message: GraphQLSubscriptionConfiguration): unknown {
+  toJSON(message: TypeConfiguration): unknown {
     const obj: any = {};
-    if (message.enabled === true) {
-      obj.enabled = message.enabled;
-    }
-    if (message.url !== undefined) {
-      obj.url = ConfigurationVariable.toJSON(message.url);
+    if (message.typeName !== "") {
+      obj.typeName = message.typeName;
     }
-    if (message.useSSE === true) {
-      obj.useSSE = message.useSSE;
+    if (message.renameTo !== "") {
+      obj.renameTo = message.renameTo;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<GraphQLSubscriptionConfiguration>, I>>(
-    base?: I,
-  ): GraphQLSubscriptionConfiguration {
-    return GraphQLSubscriptionConfiguration.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<TypeConfiguration>, I>>(base?: I): TypeConfiguration {
+    return TypeConfiguration.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<GraphQLSubscriptionConfiguration>, I>>(
-    object: I,
-  ): GraphQLSubscriptionConfiguration {
-    const message = createBaseGraphQLSubscriptionConfiguration();
-    message.enabled = object.enabled ?? false;
-    message.url = (object.url !== undefined && object.url !== null)
-      ? ConfigurationVariable.fromPartial(object.url)
-      : undefined;
-    message.useSSE = object.useSSE ?? false;
+  fromPartial<I extends Exact<DeepPartial<TypeConfiguration>, I>>(object: I): TypeConfiguration {
+    const message = createBaseTypeConfiguration();
+    message.typeName = object.typeName ?? "";
+    message.renameTo = object.renameTo ?? "";
     return message;
   },
 };
 
-function createBaseFetchConfiguration(): FetchConfiguration {
+function createBaseFieldConfiguration(): FieldConfiguration {
   return {
-    url: undefined,
-    method: 0,
-    header: {},
-    body: undefined,
-    query: [],
-    upstreamAuthentication: undefined,
-    urlEncodeBody: false,
-    mTLS: undefined,
-    baseUrl: undefined,
-    path: undefined,
-    httpProxyUrl: undefined,
+    typeName: "",
+    fieldName: "",
+    disableDefaultFieldMapping: false,
+    path: [],
+    argumentsConfiguration: [],
+    requiresFields: [],
+    unescapeResponseJson: false,
   };
 }
 
-export const FetchConfiguration = {
-  encode(message: FetchConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.url !== undefined) {
-      ConfigurationVariable.encode(message.url, writer.uint32(10).fork()).ldelim();
-    }
-    if (message.method !== 0) {
-      writer.uint32(16).int32(message.method);
-    }
-    Object.entries(message.header).forEach(([key, value]) => {
-      FetchConfiguration_HeaderEntry.encode({ key: key as any, value }, writer.uint32(26).fork()).ldelim();
-    });
-    if (message.body !== undefined) {
-      ConfigurationVariable.encode(message.body, writer.uint32(34).fork()).ldelim();
-    }
-    for (const v of message.query) {
-      URLQueryConfiguration.encode(v!, writer.uint32(42).fork()).ldelim();
+export const FieldConfiguration = {
+  encode(message: FieldConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.typeName !== "") {
+      writer.uint32(10).string(message.typeName);
     }
-    if (message.upstreamAuthentication !== undefined) {
-      UpstreamAuthentication.encode(message.upstreamAuthentication, writer.uint32(50).fork()).ldelim();
+    if (message.fieldName !== "") {
+      writer.uint32(18).string(message.fieldName);
     }
-    if (message.urlEncodeBody === true) {
-      writer.uint32(56).bool(message.urlEncodeBody);
+    if (message.disableDefaultFieldMapping === true) {
+      writer.uint32(24).bool(message.disableDefaultFieldMapping);
     }
-    if (message.mTLS !== undefined) {
-      MTLSConfiguration.encode(message.mTLS, writer.uint32(66).fork()).ldelim();
+    for (const v of message.path) {
+      writer.uint32(34).string(v!);
     }
-    if (message.baseUrl !== undefined) {
-      ConfigurationVariable.encode(message.baseUrl, writer.uint32(74).fork()).ldelim();
+    for (const v of message.argumentsConfiguration) {
+      ArgumentConfiguration.encode(v!, writer.uint32(50).fork()).ldelim();
     }
-    if (message.path !== undefined) {
-      ConfigurationVariable.encode(message.path, writer.uint32(82).fork()).ldelim();
+    for (const v of message.requiresFields) {
+      writer.uint32(58).string(v!);
     }
-    if (message.httpProxyUrl !== undefined) {
-      ConfigurationVariable.encode(message.httpProxyUrl, writer.uint32(90).fork()).ldelim();
+    if (message.unescapeResponseJson === true) {
+      writer.uint32(64).bool(message.unescapeResponseJson);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): FetchConfiguration {
+  decode(input: _m0.Reader | Uint8Array, length?: number): FieldConfiguration {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseFetchConfiguration();
+    const message = createBaseFieldConfiguration();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -5788,217 +8821,138 @@ export const FetchConfiguration = {
             break;
           }
 
-          message.url = ConfigurationVariable.decode(reader, reader.uint32());
+          message.typeName = reader.string();
           continue;
         case 2:
-          if (tag !== 16) {
+          if (tag !== 18) {
             break;
           }
 
-          message.method = reader.int32() as any;
+          message.fieldName = reader.string();
           continue;
         case 3:
-          if (tag !== 26) {
+          if (tag !== 24) {
             break;
           }
 
-          const entry3 = FetchConfiguration_HeaderEntry.decode(reader, reader.uint32());
-          if (entry3.value !== undefined) {
-            message.header[entry3.key] = entry3.value;
-          }
+          message.disableDefaultFieldMapping = reader.bool();
           continue;
         case 4:
           if (tag !== 34) {
             break;
           }
 
-          message.body = ConfigurationVariable.decode(reader, reader.uint32());
-          continue;
-        case 5:
-          if (tag !== 42) {
-            break;
-          }
-
-          message.query.push(URLQueryConfiguration.decode(reader, reader.uint32()));
+          message.path.push(reader.string());
           continue;
         case 6:
           if (tag !== 50) {
             break;
           }
 
-          message.upstreamAuthentication = UpstreamAuthentication.decode(reader, reader.uint32());
+          message.argumentsConfiguration.push(ArgumentConfiguration.decode(reader, reader.uint32()));
           continue;
         case 7:
-          if (tag !== 56) {
+          if (tag !== 58) {
             break;
           }
 
-          message.urlEncodeBody = reader.bool();
+          message.requiresFields.push(reader.string());
           continue;
         case 8:
-          if (tag !== 66) {
-            break;
-          }
-
-          message.mTLS = MTLSConfiguration.decode(reader, reader.uint32());
-          continue;
-        case 9:
-          if (tag !== 74) {
-            break;
-          }
-
-          message.baseUrl = ConfigurationVariable.decode(reader, reader.uint32());
-          continue;
-        case 10:
-          if (tag !== 82) {
-            break;
-          }
-
-          message.path = ConfigurationVariable.decode(reader, reader.uint32());
-          continue;
-        case 11:
-          if (tag !== 90) {
+          if (tag !== 64) {
             break;
           }
-
-          message.httpProxyUrl = ConfigurationVariable.decode(reader, reader.uint32());
-          continue;
-      }
-      if ((tag & 7) === 4 || tag === 0) {
-        break;
-      }
-      reader.skipType(tag & 7);
-    }
-    return message;
-  },
-
-  fromJSON(object: any): FetchConfiguration {
-    return {
-      url: isSet(object.url) ? ConfigurationVariable.fromJSON(object.url) : undefined,
-      method: isSet(object.method) ? hTTPMethodFromJSON(object.method) : 0,
-      header: isObject(object.header)
-        ? Object.entries(object.header).reduce<{ [key: string]: HTTPHeader }>((acc, [key, value]) => {
-          acc[key] = HTTPHeader.fromJSON(value);
-          return acc;
-        }, {})
-        : {},
-      body: isSet(object.body) ? ConfigurationVariable.fromJSON(object.body) : undefined,
-      query: Array.isArray(object?.query) ? object.query.map((e: any) => URLQueryConfiguration.fromJSON(e)) : [],
-      upstreamAuthentication: isSet(object.upstreamAuthentication)
-        ? UpstreamAuthentication.fromJSON(object.upstreamAuthentication)
-        : undefined,
-      urlEncodeBody: isSet(object.urlEncodeBody) ? Boolean(object.urlEncodeBody) : false,
-      mTLS: isSet(object.mTLS) ? MTLSConfiguration.fromJSON(object.mTLS) : undefined,
-      baseUrl: isSet(object.baseUrl) ? ConfigurationVariable.fromJSON(object.baseUrl) : undefined,
-      path: isSet(object.path) ? ConfigurationVariable.fromJSON(object.path) : undefined,
-      httpProxyUrl: isSet(object.httpProxyUrl) ? ConfigurationVariable.fromJSON(object.httpProxyUrl) : undefined,
+
+          message.unescapeResponseJson = reader.bool();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): FieldConfiguration {
+    return {
+      typeName: isSet(object.typeName) ? String(object.typeName) : "",
+      fieldName: isSet(object.fieldName) ? String(object.fieldName) : "",
+      disableDefaultFieldMapping: isSet(object.disableDefaultFieldMapping)
+        ? Boolean(object.disableDefaultFieldMapping)
+        : false,
+      path: Array.isArray(object?.path) ? object.path.map((e: any) => String(e)) : [],
+      argumentsConfiguration: Array.isArray(object?.argumentsConfiguration)
+        ? object.argumentsConfiguration.map((e: any) => ArgumentConfiguration.fromJSON(e))
+        : [],
+      requiresFields: Array.isArray(object?.requiresFields) ? object.requiresFields.map((e: any) => String(e)) : [],
+      unescapeResponseJson: isSet(object.unescapeResponseJson) ? Boolean(object.unescapeResponseJson) : false,
     };
   },
 
-  toJSON(message: FetchConfiguration): unknown {
+  toJSON(message: FieldConfiguration): unknown {
     const obj: any = {};
-    if (message.url !== undefined) {
-      obj.url = ConfigurationVariable.toJSON(message.url);
-    }
-    if (message.method !== 0) {
-      obj.method = hTTPMethodToJSON(message.method);
-    }
-    if (message.header) {
-      const entries = Object.entries(message.header);
-      if (entries.length > 0) {
-        obj.header = {};
-        entries.forEach(([k, v]) => {
-          obj.header[k] = HTTPHeader.toJSON(v);
-        });
-      }
-    }
-    if (message.body !== undefined) {
-      obj.body = ConfigurationVariable.toJSON(message.body);
-    }
-    if (message.query?.length) {
-      obj.query = message.query.map((e) => URLQueryConfiguration.toJSON(e));
+    if (message.typeName !== "") {
+      obj.typeName = message.typeName;
     }
-    if (message.upstreamAuthentication !== undefined) {
-      obj.upstreamAuthentication = UpstreamAuthentication.toJSON(message.upstreamAuthentication);
+    if (message.fieldName !== "") {
+      obj.fieldName = message.fieldName;
     }
-    if (message.urlEncodeBody === true) {
-      obj.urlEncodeBody = message.urlEncodeBody;
+    if (message.disableDefaultFieldMapping === true) {
+      obj.disableDefaultFieldMapping = message.disableDefaultFieldMapping;
     }
-    if (message.mTLS !== undefined) {
-      obj.mTLS = MTLSConfiguration.toJSON(message.mTLS);
+    if (message.path?.length) {
+      obj.path = message.path;
     }
-    if (message.baseUrl !== undefined) {
-      obj.baseUrl = ConfigurationVariable.toJSON(message.baseUrl);
+    if (message.argumentsConfiguration?.length) {
+      obj.argumentsConfiguration = message.argumentsConfiguration.map((e) => ArgumentConfiguration.toJSON(e));
     }
-    if (message.path !== undefined) {
-      obj.path = ConfigurationVariable.toJSON(message.path);
+    if (message.requiresFields?.length) {
+      obj.requiresFields = message.requiresFields;
     }
-    if (message.httpProxyUrl !== undefined) {
-      obj.httpProxyUrl = ConfigurationVariable.toJSON(message.httpProxyUrl);
+    if (message.unescapeResponseJson === true) {
+      obj.unescapeResponseJson = message.unescapeResponseJson;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<FetchConfiguration>, I>>(base?: I): FetchConfiguration {
-    return FetchConfiguration.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<FieldConfiguration>, I>>(base?: I): FieldConfiguration {
+    return FieldConfiguration.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<FetchConfiguration>, I>>(object: I): FetchConfiguration {
-    const message = createBaseFetchConfiguration();
-    message.url = (object.url !== undefined && object.url !== null)
-      ? ConfigurationVariable.fromPartial(object.url)
-      : undefined;
-    message.method = object.method ?? 0;
-    message.header = Object.entries(object.header ?? {}).reduce<{ [key: string]: HTTPHeader }>((acc, [key, value]) => {
-      if (value !== undefined) {
-        acc[key] = HTTPHeader.fromPartial(value);
-      }
-      return acc;
-    }, {});
-    message.body = (object.body !== undefined && object.body !== null)
-      ? ConfigurationVariable.fromPartial(object.body)
-      : undefined;
-    message.query = object.query?.map((e) => URLQueryConfiguration.fromPartial(e)) || [];
-    message.upstreamAuthentication =
-      (object.upstreamAuthentication !== undefined && object.upstreamAuthentication !== null)
-        ? UpstreamAuthentication.fromPartial(object.upstreamAuthentication)
-        : undefined;
-    message.urlEncodeBody = object.urlEncodeBody ?? false;
-    message.mTLS = (object.mTLS !== undefined && object.mTLS !== null)
-      ? MTLSConfiguration.fromPartial(object.mTLS)
-      : undefined;
-    message.baseUrl = (object.baseUrl !== undefined && object.baseUrl !== null)
-      ? ConfigurationVariable.fromPartial(object.baseUrl)
-      : undefined;
-    message.path = (object.path !== undefined && object.path !== null)
-      ? ConfigurationVariable.fromPartial(object.path)
-      : undefined;
-    message.httpProxyUrl = (object.httpProxyUrl !== undefined && object.httpProxyUrl !== null)
-      ? ConfigurationVariable.fromPartial(object.httpProxyUrl)
-      : undefined;
+  fromPartial<I extends Exact<DeepPartial<FieldConfiguration>, I>>(object: I): FieldConfiguration {
+    const message = createBaseFieldConfiguration();
+    message.typeName = object.typeName ?? "";
+    message.fieldName = object.fieldName ?? "";
+    message.disableDefaultFieldMapping = object.disableDefaultFieldMapping ?? false;
+    message.path = object.path?.map((e) => e) || [];
+    message.argumentsConfiguration = object.argumentsConfiguration?.map((e) => ArgumentConfiguration.fromPartial(e)) ||
+      [];
+    message.requiresFields = object.requiresFields?.map((e) => e) || [];
+    message.unescapeResponseJson = object.unescapeResponseJson ?? false;
     return message;
   },
 };
 
-function createBaseFetchConfiguration_HeaderEntry(): FetchConfiguration_HeaderEntry {
-  return { key: "", value: undefined };
+function createBaseTypeField(): TypeField {
+  return { typeName: "", fieldNames: [] };
 }
 
-export const FetchConfiguration_HeaderEntry = {
-  encode(message: FetchConfiguration_HeaderEntry, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.key !== "") {
-      writer.uint32(10).string(message.key);
+export const TypeField = {
+  encode(message: TypeField, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.typeName !== "") {
+      writer.uint32(10).string(message.typeName);
     }
-    if (message.value !== undefined) {
-      HTTPHeader.encode(message.value, writer.uint32(18).fork()).ldelim();
+    for (const v of message.fieldNames) {
+      writer.uint32(18).string(v!);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): FetchConfiguration_HeaderEntry {
+  decode(input: _m0.Reader | Uint8Array, length?: number): TypeField {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseFetchConfiguration_HeaderEntry();
+    const message = createBaseTypeField();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -6007,14 +8961,14 @@ export const FetchConfiguration_HeaderEntry = {
             break;
           }
 
-          message.key = reader.string();
+          message.typeName = reader.string();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.value = HTTPHeader.decode(reader, reader.uint32());
+          message.fieldNames.push(reader.string());
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -6025,62 +8979,55 @@ export const FetchConfiguration_HeaderEntry = {
     return message;
   },
 
-  fromJSON(object: any): FetchConfiguration_HeaderEntry {
+  fromJSON(object: any): TypeField {
     return {
-      key: isSet(object.key) ? String(object.key) : "",
-      value: isSet(object.value) ? HTTPHeader.fromJSON(object.value) : undefined,
+      typeName: isSet(object.typeName) ? String(object.typeName) : "",
+      fieldNames: Array.isArray(object?.fieldNames) ? object.fieldNames.map((e: any) => String(e)) : [],
     };
   },
 
-  toJSON(message: FetchConfiguration_HeaderEntry): unknown {
+  toJSON(message: TypeField): unknown {
     const obj: any = {};
-    if (message.key !== "") {
-      obj.key = message.key;
+    if (message.typeName !== "") {
+      obj.typeName = message.typeName;
     }
-    if (message.value !== undefined) {
-      obj.value = HTTPHeader.toJSON(message.value);
+    if (message.fieldNames?.length) {
+      obj.fieldNames = message.fieldNames;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<FetchConfiguration_HeaderEntry>, I>>(base?: I): FetchConfiguration_HeaderEntry {
-    return FetchConfiguration_HeaderEntry.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<TypeField>, I>>(base?: I): TypeField {
+    return TypeField.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<FetchConfiguration_HeaderEntry>, I>>(
-    object: I,
-  ): FetchConfiguration_HeaderEntry {
-    const message = createBaseFetchConfiguration_HeaderEntry();
-    message.key = object.key ?? "";
-    message.value = (object.value !== undefined && object.value !== null)
-      ? HTTPHeader.fromPartial(object.value)
-      : undefined;
+  fromPartial<I extends Exact<DeepPartial<TypeField>, I>>(object: I): TypeField {
+    const message = createBaseTypeField();
+    message.typeName = object.typeName ?? "";
+    message.fieldNames = object.fieldNames?.map((e) => e) || [];
     return message;
   },
 };
 
-function createBaseMTLSConfiguration(): MTLSConfiguration {
-  return { key: undefined, cert: undefined, insecureSkipVerify: false };
+function createBaseSingleTypeField(): SingleTypeField {
+  return { typeName: "", fieldName: "" };
 }
 
-export const MTLSConfiguration = {
-  encode(message: MTLSConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.key !== undefined) {
-      ConfigurationVariable.encode(message.key, writer.uint32(10).fork()).ldelim();
-    }
-    if (message.cert !== undefined) {
-      ConfigurationVariable.encode(message.cert, writer.uint32(18).fork()).ldelim();
+export const SingleTypeField = {
+  encode(message: SingleTypeField, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.typeName !== "") {
+      writer.uint32(10).string(message.typeName);
     }
-    if (message.insecureSkipVerify === true) {
-      writer.uint32(24).bool(message.insecureSkipVerify);
+    if (message.fieldName !== "") {
+      writer.uint32(18).string(message.fieldName);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): MTLSConfiguration {
+  decode(input: _m0.Reader | Uint8Array, length?: number): SingleTypeField {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseMTLSConfiguration();
+    const message = createBaseSingleTypeField();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -6089,21 +9036,14 @@ export const MTLSConfiguration = {
             break;
           }
 
-          message.key = ConfigurationVariable.decode(reader, reader.uint32());
+          message.typeName = reader.string();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.cert = ConfigurationVariable.decode(reader, reader.uint32());
-          continue;
-        case 3:
-          if (tag !== 24) {
-            break;
-          }
-
-          message.insecureSkipVerify = reader.bool();
+          message.fieldName = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -6114,96 +9054,101 @@ export const MTLSConfiguration = {
     return message;
   },
 
-  fromJSON(object: any): MTLSConfiguration {
+  fromJSON(object: any): SingleTypeField {
     return {
-      key: isSet(object.key) ? ConfigurationVariable.fromJSON(object.key) : undefined,
-      cert: isSet(object.cert) ? ConfigurationVariable.fromJSON(object.cert) : undefined,
-      insecureSkipVerify: isSet(object.insecureSkipVerify) ? Boolean(object.insecureSkipVerify) : false,
+      typeName: isSet(object.typeName) ? String(object.typeName) : "",
+      fieldName: isSet(object.fieldName) ? String(object.fieldName) : "",
     };
   },
 
-  toJSON(message: MTLSConfiguration): unknown {
+  toJSON(message: SingleTypeField): unknown {
     const obj: any = {};
-    if (message.key !== undefined) {
-      obj.key = ConfigurationVariable.toJSON(message.key);
-    }
-    if (message.cert !== undefined) {
-      obj.cert = ConfigurationVariable.toJSON(message.cert);
+    if (message.typeName !== "") {
+      obj.typeName = message.typeName;
     }
-    if (message.insecureSkipVerify === true) {
-      obj.insecureSkipVerify = message.insecureSkipVerify;
+    if (message.fieldName !== "") {
+      obj.fieldName = message.fieldName;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<MTLSConfiguration>, I>>(base?: I): MTLSConfiguration {
-    return MTLSConfiguration.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<SingleTypeField>, I>>(base?: I): SingleTypeField {
+    return SingleTypeField.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<MTLSConfiguration>, I>>(object: I): MTLSConfiguration {
-    const message = createBaseMTLSConfiguration();
-    message.key = (object.key !== undefined && object.key !== null)
-      ? ConfigurationVariable.fromPartial(object.key)
-      : undefined;
-    message.cert = (object.cert !== undefined && object.cert !== null)
-      ? ConfigurationVariable.fromPartial(object.cert)
-      : undefined;
-    message.insecureSkipVerify = object.insecureSkipVerify ?? false;
+  fromPartial<I extends Exact<DeepPartial<SingleTypeField>, I>>(object: I): SingleTypeField {
+    const message = createBaseSingleTypeField();
+    message.typeName = object.typeName ?? "";
+    message.fieldName = object.fieldName ?? "";
     return message;
   },
 };
 
-function createBaseUpstreamAuthentication(): UpstreamAuthentication {
-  return { kind: 0, jwtConfig: undefined, jwtWithAccessTokenExchangeConfig: undefined };
+function createBaseArgumentConfiguration(): ArgumentConfiguration {
+  return { name: "", sourceType: 0, sourcePath: [], renderConfiguration: 0, renameTypeTo: "" };
 }
 
-export const UpstreamAuthentication = {
-  encode(message: UpstreamAuthentication, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.kind !== 0) {
-      writer.uint32(8).int32(message.kind);
+export const ArgumentConfiguration = {
+  encode(message: ArgumentConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
     }
-    if (message.jwtConfig !== undefined) {
-      JwtUpstreamAuthenticationConfig.encode(message.jwtConfig, writer.uint32(18).fork()).ldelim();
+    if (message.sourceType !== 0) {
+      writer.uint32(16).int32(message.sourceType);
     }
-    if (message.jwtWithAccessTokenExchangeConfig !== undefined) {
-      JwtUpstreamAuthenticationWithAccessTokenExchange.encode(
-        message.jwtWithAccessTokenExchangeConfig,
-        writer.uint32(26).fork(),
-      ).ldelim();
+    for (const v of message.sourcePath) {
+      writer.uint32(26).string(v!);
+    }
+    if (message.renderConfiguration !== 0) {
+      writer.uint32(32).int32(message.renderConfiguration);
+    }
+    if (message.renameTypeTo !== "") {
+      writer.uint32(42).string(message.renameTypeTo);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): UpstreamAuthentication {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ArgumentConfiguration {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseUpstreamAuthentication();
+    const message = createBaseArgumentConfiguration();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.kind = reader.int32() as any;
+          message.name = reader.string();
           continue;
         case 2:
-          if (tag !== 18) {
+          if (tag !== 16) {
             break;
           }
 
-          message.jwtConfig = JwtUpstreamAuthenticationConfig.decode(reader, reader.uint32());
+          message.sourceType = reader.int32() as any;
           continue;
         case 3:
           if (tag !== 26) {
             break;
           }
 
-          message.jwtWithAccessTokenExchangeConfig = JwtUpstreamAuthenticationWithAccessTokenExchange.decode(
-            reader,
-            reader.uint32(),
-          );
+          message.sourcePath.push(reader.string());
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.renderConfiguration = reader.int32() as any;
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.renameTypeTo = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -6214,69 +9159,95 @@ export const UpstreamAuthentication = {
     return message;
   },
 
-  fromJSON(object: any): UpstreamAuthentication {
+  fromJSON(object: any): ArgumentConfiguration {
     return {
-      kind: isSet(object.kind) ? upstreamAuthenticationKindFromJSON(object.kind) : 0,
-      jwtConfig: isSet(object.jwtConfig) ? JwtUpstreamAuthenticationConfig.fromJSON(object.jwtConfig) : undefined,
-      jwtWithAccessTokenExchangeConfig: isSet(object.jwtWithAccessTokenExchangeConfig)
-        ? JwtUpstreamAuthenticationWithAccessTokenExchange.fromJSON(object.jwtWithAccessTokenExchangeConfig)
-        : undefined,
+      name: isSet(object.name) ? String(object.name) : "",
+      sourceType: isSet(object.sourceType) ? argumentSourceFromJSON(object.sourceType) : 0,
+      sourcePath: Array.isArray(object?.sourcePath) ? object.sourcePath.map((e: any) => String(e)) : [],
+      renderConfiguration: isSet(object.renderConfiguration)
+        ? argumentRenderConfigurationFromJSON(object.renderConfiguration)
+        : 0,
+      renameTypeTo: isSet(object.renameTypeTo) ? String(object.renameTypeTo) : "",
     };
   },
 
-  toJSON(message: UpstreamAuthentication): unknown {
+  toJSON(message: ArgumentConfiguration): unknown {
     const obj: any = {};
-    if (message.kind !== 0) {
-      obj.kind = upstreamAuthenticationKindToJSON(message.kind);
+    if (message.name !== "") {
+      obj.name = message.name;
     }
-    if (message.jwtConfig !== undefined) {
-      obj.jwtConfig = JwtUpstreamAuthenticationConfig.toJSON(message.jwtConfig);
+    if (message.sourceType !== 0) {
+      obj.sourceType = argumentSourceToJSON(message.sourceType);
     }
-    if (message.jwtWithAccessTokenExchangeConfig !== undefined) {
-      obj.jwtWithAccessTokenExchangeConfig = JwtUpstreamAuthenticationWithAccessTokenExchange.toJSON(
-        message.jwtWithAccessTokenExchangeConfig,
-      );
+    if (message.sourcePath?.length) {
+      obj.sourcePath = message.sourcePath;
+    }
+    if (message.renderConfiguration !== 0) {
+      obj.renderConfiguration = argumentRenderConfigurationToJSON(message.renderConfiguration);
+    }
+    if (message.renameTypeTo !== "") {
+      obj.renameTypeTo = message.renameTypeTo;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<UpstreamAuthentication>, I>>(base?: I): UpstreamAuthentication {
-    return UpstreamAuthentication.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<ArgumentConfiguration>, I>>(base?: I): ArgumentConfiguration {
+    return ArgumentConfiguration.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<UpstreamAuthentication>, I>>(object: I): UpstreamAuthentication {
-    const message = createBaseUpstreamAuthentication();
-    message.kind = object.kind ?? 0;
-    message.jwtConfig = (object.jwtConfig !== undefined && object.jwtConfig !== null)
-      ? JwtUpstreamAuthenticationConfig.fromPartial(object.jwtConfig)
-      : undefined;
-    message.jwtWithAccessTokenExchangeConfig =
-      (object.jwtWithAccessTokenExchangeConfig !== undefined && object.jwtWithAccessTokenExchangeConfig !== null)
-        ? JwtUpstreamAuthenticationWithAccessTokenExchange.fromPartial(object.jwtWithAccessTokenExchangeConfig)
-        : undefined;
+  fromPartial<I extends Exact<DeepPartial<ArgumentConfiguration>, I>>(object: I): ArgumentConfiguration {
+    const message = createBaseArgumentConfiguration();
+    message.name = object.name ?? "";
+    message.sourceType = object.sourceType ?? 0;
+    message.sourcePath = object.sourcePath?.map((e) => e) || [];
+    message.renderConfiguration = object.renderConfiguration ?? 0;
+    message.renameTypeTo = object.renameTypeTo ?? "";
     return message;
   },
 };
 
-function createBaseJwtUpstreamAuthenticationConfig(): JwtUpstreamAuthenticationConfig {
-  return { secret: undefined, signingMethod: 0 };
+function createBaseWunderGraphConfiguration(): WunderGraphConfiguration {
+  return {
+    api: undefined,
+    apiId: "",
+    environmentIds: [],
+    dangerouslyEnableGraphQLEndpoint: false,
+    configHash: "",
+    enabledFeatures: undefined,
+    hooks: [],
+  };
 }
 
-export const JwtUpstreamAuthenticationConfig = {
-  encode(message: JwtUpstreamAuthenticationConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.secret !== undefined) {
-      ConfigurationVariable.encode(message.secret, writer.uint32(10).fork()).ldelim();
+export const WunderGraphConfiguration = {
+  encode(message: WunderGraphConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.api !== undefined) {
+      UserDefinedApi.encode(message.api, writer.uint32(10).fork()).ldelim();
     }
-    if (message.signingMethod !== 0) {
-      writer.uint32(16).int32(message.signingMethod);
+    if (message.apiId !== "") {
+      writer.uint32(18).string(message.apiId);
+    }
+    for (const v of message.environmentIds) {
+      writer.uint32(26).string(v!);
+    }
+    if (message.dangerouslyEnableGraphQLEndpoint === true) {
+      writer.uint32(32).bool(message.dangerouslyEnableGraphQLEndpoint);
+    }
+    if (message.configHash !== "") {
+      writer.uint32(42).string(message.configHash);
+    }
+    if (message.enabledFeatures !== undefined) {
+      EnabledFeatures.encode(message.enabledFeatures, writer.uint32(50).fork()).ldelim();
+    }
+    for (const v of message.hooks) {
+      Hook.encode(v!, writer.uint32(58).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): JwtUpstreamAuthenticationConfig {
+  decode(input: _m0.Reader | Uint8Array, length?: number): WunderGraphConfiguration {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseJwtUpstreamAuthenticationConfig();
+    const message = createBaseWunderGraphConfiguration();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -6285,14 +9256,49 @@ export const JwtUpstreamAuthenticationConfig = {
             break;
           }
 
-          message.secret = ConfigurationVariable.decode(reader, reader.uint32());
+          message.api = UserDefinedApi.decode(reader, reader.uint32());
           continue;
         case 2:
-          if (tag !== 16) {
+          if (tag !== 18) {
             break;
           }
 
-          message.signingMethod = reader.int32() as any;
+          message.apiId = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.environmentIds.push(reader.string());
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.dangerouslyEnableGraphQLEndpoint = reader.bool();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.configHash = reader.string();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.enabledFeatures = EnabledFeatures.decode(reader, reader.uint32());
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.hooks.push(Hook.decode(reader, reader.uint32()));
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -6303,88 +9309,138 @@ export const JwtUpstreamAuthenticationConfig = {
     return message;
   },
 
-  fromJSON(object: any): JwtUpstreamAuthenticationConfig {
+  fromJSON(object: any): WunderGraphConfiguration {
     return {
-      secret: isSet(object.secret) ? ConfigurationVariable.fromJSON(object.secret) : undefined,
-      signingMethod: isSet(object.signingMethod) ? signingMethodFromJSON(object.signingMethod) : 0,
+      api: isSet(object.api) ? UserDefinedApi.fromJSON(object.api) : undefined,
+      apiId: isSet(object.apiId) ? String(object.apiId) : "",
+      environmentIds: Array.isArray(object?.environmentIds) ? object.environmentIds.map((e: any) => String(e)) : [],
+      dangerouslyEnableGraphQLEndpoint: isSet(object.dangerouslyEnableGraphQLEndpoint)
+        ? Boolean(object.dangerouslyEnableGraphQLEndpoint)
+        : false,
+      configHash: isSet(object.configHash) ? String(object.configHash) : "",
+      enabledFeatures: isSet(object.enabledFeatures) ? EnabledFeatures.fromJSON(object.enabledFeatures) : undefined,
+      hooks: Array.isArray(object?.hooks) ? object.hooks.map((e: any) => Hook.fromJSON(e)) : [],
     };
   },
 
-  toJSON(message: JwtUpstreamAuthenticationConfig): unknown {
+  toJSON(message: WunderGraphConfiguration): unknown {
     const obj: any = {};
-    if (message.secret !== undefined) {
-      obj.secret = ConfigurationVariable.toJSON(message.secret);
+    if (message.api !== undefined) {
+      obj.api = UserDefinedApi.toJSON(message.api);
     }
-    if (message.signingMethod !== 0) {
-      obj.signingMethod = signingMethodToJSON(message.signingMethod);
+    if (message.apiId !== "") {
+      obj.apiId = message.apiId;
+    }
+    if (message.environmentIds?.length) {
+      obj.environmentIds = message.environmentIds;
+    }
+    if (message.dangerouslyEnableGraphQLEndpoint === true) {
+      obj.dangerouslyEnableGraphQLEndpoint = message.dangerouslyEnableGraphQLEndpoint;
+    }
+    if (message.configHash !== "") {
+      obj.configHash = message.configHash;
+    }
+    if (message.enabledFeatures !== undefined) {
+      obj.enabledFeatures = EnabledFeatures.toJSON(message.enabledFeatures);
+    }
+    if (message.hooks?.length) {
+      obj.hooks = message.hooks.map((e) => Hook.toJSON(e));
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<JwtUpstreamAuthenticationConfig>, I>>(base?: I): JwtUpstreamAuthenticationConfig {
-    return JwtUpstreamAuthenticationConfig.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<WunderGraphConfiguration>, I>>(base?: I): WunderGraphConfiguration {
+    return WunderGraphConfiguration.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<JwtUpstreamAuthenticationConfig>, I>>(
-    object: I,
-  ): JwtUpstreamAuthenticationConfig {
-    const message = createBaseJwtUpstreamAuthenticationConfig();
-    message.secret = (object.secret !== undefined && object.secret !== null)
-      ? ConfigurationVariable.fromPartial(object.secret)
+  fromPartial<I extends Exact<DeepPartial<WunderGraphConfiguration>, I>>(object: I): WunderGraphConfiguration {
+    const message = createBaseWunderGraphConfiguration();
+    message.api = (object.api !== undefined && object.api !== null)
+      ? UserDefinedApi.fromPartial(object.api)
       : undefined;
-    message.signingMethod = object.signingMethod ?? 0;
+    message.apiId = object.apiId ?? "";
+    message.environmentIds = object.environmentIds?.map((e) => e) || [];
+    message.dangerouslyEnableGraphQLEndpoint = object.dangerouslyEnableGraphQLEndpoint ?? false;
+    message.configHash = object.configHash ?? "";
+    message.enabledFeatures = (object.enabledFeatures !== undefined && object.enabledFeatures !== null)
+      ? EnabledFeatures.fromPartial(object.enabledFeatures)
+      : undefined;
+    message.hooks = object.hooks?.map((e) => Hook.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseJwtUpstreamAuthenticationWithAccessTokenExchange(): JwtUpstreamAuthenticationWithAccessTokenExchange {
-  return { secret: undefined, signingMethod: 0, accessTokenExchangeEndpoint: undefined };
+function createBaseEnabledFeatures(): EnabledFeatures {
+  return {
+    apiCount: 0,
+    schemaExtension: false,
+    customJSONScalars: false,
+    customIntScalars: false,
+    customFloatScalars: false,
+  };
 }
 
-export const JwtUpstreamAuthenticationWithAccessTokenExchange = {
-  encode(
-    message: JwtUpstreamAuthenticationWithAccessTokenExchange,
-    writer: _m0.Writer = _m0.Writer.create(),
-  ): _m0.Writer {
-    if (message.secret !== undefined) {
-      ConfigurationVariable.encode(message.secret, writer.uint32(10).fork()).ldelim();
+export const EnabledFeatures = {
+  encode(message: EnabledFeatures, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.apiCount !== 0) {
+      writer.uint32(8).int32(message.apiCount);
     }
-    if (message.signingMethod !== 0) {
-      writer.uint32(16).int32(message.signingMethod);
+    if (message.schemaExtension === true) {
+      writer.uint32(16).bool(message.schemaExtension);
     }
-    if (message.accessTokenExchangeEndpoint !== undefined) {
-      ConfigurationVariable.encode(message.accessTokenExchangeEndpoint, writer.uint32(26).fork()).ldelim();
+    if (message.customJSONScalars === true) {
+      writer.uint32(24).bool(message.customJSONScalars);
+    }
+    if (message.customIntScalars === true) {
+      writer.uint32(32).bool(message.customIntScalars);
+    }
+    if (message.customFloatScalars === true) {
+      writer.uint32(40).bool(message.customFloatScalars);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): JwtUpstreamAuthenticationWithAccessTokenExchange {
+  decode(input: _m0.Reader | Uint8Array, length?: number): EnabledFeatures {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseJwtUpstreamAuthenticationWithAccessTokenExchange();
+    const message = createBaseEnabledFeatures();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 10) {
+          if (tag !== 8) {
             break;
           }
 
-          message.secret = ConfigurationVariable.decode(reader, reader.uint32());
+          message.apiCount = reader.int32();
           continue;
         case 2:
           if (tag !== 16) {
             break;
           }
 
-          message.signingMethod = reader.int32() as any;
+          message.schemaExtension = reader.bool();
           continue;
         case 3:
-          if (tag !== 26) {
+          if (tag !== 24) {
             break;
           }
 
-          message.accessTokenExchangeEndpoint = ConfigurationVariable.decode(reader, reader.uint32());
+          message.customJSONScalars = reader.bool();
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.customIntScalars = reader.bool();
+          continue;
+        case 5:
+          if (tag !== 40) {
+            break;
+          }
+
+          message.customFloatScalars = reader.bool();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -6395,74 +9451,73 @@ export const JwtUpstreamAuthenticationWithAccessTokenExchange = {
     return message;
   },
 
-  fromJSON(object: any): JwtUpstreamAuthenticationWithAccessTokenExchange {
+  fromJSON(object: any): EnabledFeatures {
     return {
-      secret: isSet(object.secret) ? ConfigurationVariable.fromJSON(object.secret) : undefined,
-      signingMethod: isSet(object.signingMethod) ? signingMethodFromJSON(object.signingMethod) : 0,
-      accessTokenExchangeEndpoint: isSet(object.accessTokenExchangeEndpoint)
-        ? ConfigurationVariable.fromJSON(object.accessTokenExchangeEndpoint)
-        : undefined,
+      apiCount: isSet(object.apiCount) ? Number(object.apiCount) : 0,
+      schemaExtension: isSet(object.schemaExtension) ? Boolean(object.schemaExtension) : false,
+      customJSONScalars: isSet(object.customJSONScalars) ? Boolean(object.customJSONScalars) : false,
+      customIntScalars: isSet(object.customIntScalars) ? Boolean(object.customIntScalars) : false,
+      customFloatScalars: isSet(object.customFloatScalars) ? Boolean(object.customFloatScalars) : false,
     };
   },
 
-  toJSON(message: JwtUpstreamAuthenticationWithAccessTokenExchange): unknown {
+  toJSON(message: EnabledFeatures): unknown {
     const obj: any = {};
-    if (message.secret !== undefined) {
-      obj.secret = ConfigurationVariable.toJSON(message.secret);
+    if (message.apiCount !== 0) {
+      obj.apiCount = Math.round(message.apiCount);
     }
-    if (message.signingMethod !== 0) {
-      obj.signingMethod = signingMethodToJSON(message.signingMethod);
+    if (message.schemaExtension === true) {
+      obj.schemaExtension = message.schemaExtension;
     }
-    if (message.accessTokenExchangeEndpoint !== undefined) {
-      obj.accessTokenExchangeEndpoint = ConfigurationVariable.toJSON(message.accessTokenExchangeEndpoint);
+    if (message.customJSONScalars === true) {
+      obj.customJSONScalars = message.customJSONScalars;
+    }
+    if (message.customIntScalars === true) {
+      obj.customIntScalars = message.customIntScalars;
+    }
+    if (message.customFloatScalars === true) {
+      obj.customFloatScalars = message.customFloatScalars;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<JwtUpstreamAuthenticationWithAccessTokenExchange>, I>>(
-    base?: I,
-  ): JwtUpstreamAuthenticationWithAccessTokenExchange {
-    return JwtUpstreamAuthenticationWithAccessTokenExchange.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<EnabledFeatures>, I>>(base?: I): EnabledFeatures {
+    return EnabledFeatures.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<JwtUpstreamAuthenticationWithAccessTokenExchange>, I>>(
-    object: I,
-  ): JwtUpstreamAuthenticationWithAccessTokenExchange {
-    const message = createBaseJwtUpstreamAuthenticationWithAccessTokenExchange();
-    message.secret = (object.secret !== undefined && object.secret !== null)
-      ? ConfigurationVariable.fromPartial(object.secret)
-      : undefined;
-    message.signingMethod = object.signingMethod ?? 0;
-    message.accessTokenExchangeEndpoint =
-      (object.accessTokenExchangeEndpoint !== undefined && object.accessTokenExchangeEndpoint !== null)
-        ? ConfigurationVariable.fromPartial(object.accessTokenExchangeEndpoint)
-        : undefined;
+  fromPartial<I extends Exact<DeepPartial<EnabledFeatures>, I>>(object: I): EnabledFeatures {
+    const message = createBaseEnabledFeatures();
+    message.apiCount = object.apiCount ?? 0;
+    message.schemaExtension = object.schemaExtension ?? false;
+    message.customJSONScalars = object.customJSONScalars ?? false;
+    message.customIntScalars = object.customIntScalars ?? false;
+    message.customFloatScalars = object.customFloatScalars ?? false;
     return message;
   },
 };
 
-function createBaseRESTSubscriptionConfiguration(): RESTSubscriptionConfiguration {
-  return { enabled: false, pollingIntervalMillis: 0, skipPublishSameResponse: false };
+function createBaseS3UploadProfileHooksConfiguration(): S3UploadProfileHooksConfiguration {
+  return { preUpload: false, postUpload: false, preDownload: false };
 }
 
-export const RESTSubscriptionConfiguration = {
-  encode(message: RESTSubscriptionConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.enabled === true) {
-      writer.uint32(8).bool(message.enabled);
+export const S3UploadProfileHooksConfiguration = {
+  encode(message: S3UploadProfileHooksConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.preUpload === true) {
+      writer.uint32(8).bool(message.preUpload);
     }
-    if (message.pollingIntervalMillis !== 0) {
-      writer.uint32(16).int64(message.pollingIntervalMillis);
+    if (message.postUpload === true) {
+      writer.uint32(16).bool(message.postUpload);
     }
-    if (message.skipPublishSameResponse === true) {
-      writer.uint32(24).bool(message.skipPublishSameResponse);
+    if (message.preDownload === true) {
+      writer.uint32(24).bool(message.preDownload);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): RESTSubscriptionConfiguration {
+  decode(input: _m0.Reader | Uint8Array, length?: number): S3UploadProfileHooksConfiguration {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseRESTSubscriptionConfiguration();
+    const message = createBaseS3UploadProfileHooksConfiguration();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -6471,21 +9526,21 @@ export const RESTSubscriptionConfiguration = {
             break;
           }
 
-          message.enabled = reader.bool();
+          message.preUpload = reader.bool();
           continue;
         case 2:
           if (tag !== 16) {
             break;
           }
 
-          message.pollingIntervalMillis = longToNumber(reader.int64() as Long);
+          message.postUpload = reader.bool();
           continue;
         case 3:
           if (tag !== 24) {
             break;
           }
 
-          message.skipPublishSameResponse = reader.bool();
+          message.preDownload = reader.bool();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -6496,143 +9551,193 @@ export const RESTSubscriptionConfiguration = {
     return message;
   },
 
-  fromJSON(object: any): RESTSubscriptionConfiguration {
+  fromJSON(object: any): S3UploadProfileHooksConfiguration {
     return {
-      enabled: isSet(object.enabled) ? Boolean(object.enabled) : false,
-      pollingIntervalMillis: isSet(object.pollingIntervalMillis) ? Number(object.pollingIntervalMillis) : 0,
-      skipPublishSameResponse: isSet(object.skipPublishSameResponse) ? Boolean(object.skipPublishSameResponse) : false,
+      preUpload: isSet(object.preUpload) ? Boolean(object.preUpload) : false,
+      postUpload: isSet(object.postUpload) ? Boolean(object.postUpload) : false,
+      preDownload: isSet(object.preDownload) ? Boolean(object.preDownload) : false,
     };
   },
 
-  toJSON(message: RESTSubscriptionConfiguration): unknown {
+  toJSON(message: S3UploadProfileHooksConfiguration): unknown {
     const obj: any = {};
-    if (message.enabled === true) {
-      obj.enabled = message.enabled;
+    if (message.preUpload === true) {
+      obj.preUpload = message.preUpload;
     }
-    if (message.pollingIntervalMillis !== 0) {
-      obj.pollingIntervalMillis = Math.round(message.pollingIntervalMillis);
+    if (message.postUpload === true) {
+      obj.postUpload = message.postUpload;
     }
-    if (message.skipPublishSameResponse === true) {
-      obj.skipPublishSameResponse = message.skipPublishSameResponse;
+    if (message.preDownload === true) {
+      obj.preDownload = message.preDownload;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<RESTSubscriptionConfiguration>, I>>(base?: I): RESTSubscriptionConfiguration {
-    return RESTSubscriptionConfiguration.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<S3UploadProfileHooksConfiguration>, I>>(
+    base?: I,
+  ): S3UploadProfileHooksConfiguration {
+    return S3UploadProfileHooksConfiguration.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<RESTSubscriptionConfiguration>, I>>(
+  fromPartial<I extends Exact<DeepPartial<S3UploadProfileHooksConfiguration>, I>>(
     object: I,
-  ): RESTSubscriptionConfiguration {
-    const message = createBaseRESTSubscriptionConfiguration();
-    message.enabled = object.enabled ?? false;
-    message.pollingIntervalMillis = object.pollingIntervalMillis ?? 0;
-    message.skipPublishSameResponse = object.skipPublishSameResponse ?? false;
+  ): S3UploadProfileHooksConfiguration {
+    const message = createBaseS3UploadProfileHooksConfiguration();
+    message.preUpload = object.preUpload ?? false;
+    message.postUpload = object.postUpload ?? false;
+    message.preDownload = object.preDownload ?? false;
     return message;
   },
 };
 
-function createBaseURLQueryConfiguration(): URLQueryConfiguration {
-  return { name: "", value: "" };
+function createBaseS3UploadProfile(): S3UploadProfile {
+  return {
+    requireAuthentication: false,
+    maxAllowedUploadSizeBytes: 0,
+    maxAllowedFiles: 0,
+    allowedMimeTypes: [],
+    allowedFileExtensions: [],
+    metadataJSONSchema: "",
+    hooks: undefined,
+    downloadRoles: [],
+    deleteRoles: [],
+    proxyDownloads: false,
+    imageTransformations: [],
+    enableFiles: false,
+  };
 }
 
-export const URLQueryConfiguration = {
-  encode(message: URLQueryConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.name !== "") {
-      writer.uint32(10).string(message.name);
+export const S3UploadProfile = {
+  encode(message: S3UploadProfile, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.requireAuthentication === true) {
+      writer.uint32(8).bool(message.requireAuthentication);
     }
-    if (message.value !== "") {
-      writer.uint32(18).string(message.value);
+    if (message.maxAllowedUploadSizeBytes !== 0) {
+      writer.uint32(16).int32(message.maxAllowedUploadSizeBytes);
+    }
+    if (message.maxAllowedFiles !== 0) {
+      writer.uint32(24).int32(message.maxAllowedFiles);
+    }
+    for (const v of message.allowedMimeTypes) {
+      writer.uint32(34).string(v!);
+    }
+    for (const v of message.allowedFileExtensions) {
+      writer.uint32(42).string(v!);
+    }
+    if (message.metadataJSONSchema !== "") {
+      writer.uint32(50).string(message.metadataJSONSchema);
+    }
+    if (message.hooks !== undefined) {
+      S3UploadProfileHooksConfiguration.encode(message.hooks, writer.uint32(58).fork()).ldelim();
+    }
+    for (const v of message.downloadRoles) {
+      writer.uint32(66).string(v!);
+    }
+    for (const v of message.deleteRoles) {
+      writer.uint32(74).string(v!);
+    }
+    if (message.proxyDownloads === true) {
+      writer.uint32(80).bool(message.proxyDownloads);
+    }
+    for (const v of message.imageTransformations) {
+      ImageTransformation.encode(v!, writer.uint32(90).fork()).ldelim();
+    }
+    if (message.enableFiles === true) {
+      writer.uint32(96).bool(message.enableFiles);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): URLQueryConfiguration {
+  decode(input: _m0.Reader | Uint8Array, length?: number): S3UploadProfile {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseURLQueryConfiguration();
+    const message = createBaseS3UploadProfile();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 10) {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.requireAuthentication = reader.bool();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.maxAllowedUploadSizeBytes = reader.int32();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.maxAllowedFiles = reader.int32();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.allowedMimeTypes.push(reader.string());
+          continue;
+        case 5:
+          if (tag !== 42) {
             break;
           }
 
-          message.name = reader.string();
+          message.allowedFileExtensions.push(reader.string());
           continue;
-        case 2:
-          if (tag !== 18) {
+        case 6:
+          if (tag !== 50) {
             break;
           }
 
-          message.value = reader.string();
+          message.metadataJSONSchema = reader.string();
           continue;
-      }
-      if ((tag & 7) === 4 || tag === 0) {
-        break;
-      }
-      reader.skipType(tag & 7);
-    }
-    return message;
-  },
-
-  fromJSON(object: any): URLQueryConfiguration {
-    return {
-      name: isSet(object.name) ? String(object.name) : "",
-      value: isSet(object.value) ? String(object.value) : "",
-    };
-  },
-
-  toJSON(message: URLQueryConfiguration): unknown {
-    const obj: any = {};
-    if (message.name !== "") {
-      obj.name = message.name;
-    }
-    if (message.value !== "") {
-      obj.value = message.value;
-    }
-    return obj;
-  },
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
 
-  create<I extends Exact<DeepPartial<URLQueryConfiguration>, I>>(base?: I): URLQueryConfiguration {
-    return URLQueryConfiguration.fromPartial(base ?? {});
-  },
+          message.hooks = S3UploadProfileHooksConfiguration.decode(reader, reader.uint32());
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
 
-  fromPartial<I extends Exact<DeepPartial<URLQueryConfiguration>, I>>(object: I): URLQueryConfiguration {
-    const message = createBaseURLQueryConfiguration();
-    message.name = object.name ?? "";
-    message.value = object.value ?? "";
-    return message;
-  },
-};
+          message.downloadRoles.push(reader.string());
+          continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
 
-function createBaseHTTPHeader(): HTTPHeader {
-  return { values: [] };
-}
+          message.deleteRoles.push(reader.string());
+          continue;
+        case 10:
+          if (tag !== 80) {
+            break;
+          }
 
-export const HTTPHeader = {
-  encode(message: HTTPHeader, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    for (const v of message.values) {
-      ConfigurationVariable.encode(v!, writer.uint32(10).fork()).ldelim();
-    }
-    return writer;
-  },
+          message.proxyDownloads = reader.bool();
+          continue;
+        case 11:
+          if (tag !== 90) {
+            break;
+          }
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): HTTPHeader {
-    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
-    let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseHTTPHeader();
-    while (reader.pos < end) {
-      const tag = reader.uint32();
-      switch (tag >>> 3) {
-        case 1:
-          if (tag !== 10) {
+          message.imageTransformations.push(ImageTransformation.decode(reader, reader.uint32()));
+          continue;
+        case 12:
+          if (tag !== 96) {
             break;
           }
 
-          message.values.push(ConfigurationVariable.decode(reader, reader.uint32()));
+          message.enableFiles = reader.bool();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -6643,50 +9748,125 @@ export const HTTPHeader = {
     return message;
   },
 
-  fromJSON(object: any): HTTPHeader {
+  fromJSON(object: any): S3UploadProfile {
     return {
-      values: Array.isArray(object?.values) ? object.values.map((e: any) => ConfigurationVariable.fromJSON(e)) : [],
+      requireAuthentication: isSet(object.requireAuthentication) ? Boolean(object.requireAuthentication) : false,
+      maxAllowedUploadSizeBytes: isSet(object.maxAllowedUploadSizeBytes) ? Number(object.maxAllowedUploadSizeBytes) : 0,
+      maxAllowedFiles: isSet(object.maxAllowedFiles) ? Number(object.maxAllowedFiles) : 0,
+      allowedMimeTypes: Array.isArray(object?.allowedMimeTypes)
+        ? object.allowedMimeTypes.map((e: any) => String(e))
+        : [],
+      allowedFileExtensions: Array.isArray(object?.allowedFileExtensions)
+        ? object.allowedFileExtensions.map((e: any) => String(e))
+        : [],
+      metadataJSONSchema: isSet(object.metadataJSONSchema) ? String(object.metadataJSONSchema) : "",
+      hooks: isSet(object.hooks) ? S3UploadProfileHooksConfiguration.fromJSON(object.hooks) : undefined,
+      downloadRoles: Array.isArray(object?.downloadRoles) ? object.downloadRoles.map((e: any) => String(e)) : [],
+      deleteRoles: Array.isArray(object?.deleteRoles) ? object.deleteRoles.map((e: any) => String(e)) : [],
+      proxyDownloads: isSet(object.proxyDownloads) ? Boolean(object.proxyDownloads) : false,
+      imageTransformations: Array.isArray(object?.imageTransformations)
+        ? object.imageTransformations.map((e: any) => ImageTransformation.fromJSON(e))
+        : [],
+      enableFiles: isSet(object.enableFiles) ? Boolean(object.enableFiles) : false,
     };
   },
 
-  toJSON(message: HTTPHeader): unknown {
+  toJSON(message: S3UploadProfile): unknown {
     const obj: any = {};
-    if (message.values?.length) {
-      obj.values = message.values.map((e) => ConfigurationVariable.toJSON(e));
+    if (message.requireAuthentication === true) {
+      obj.requireAuthentication = message.requireAuthentication;
+    }
+    if (message.maxAllowedUploadSizeBytes !== 0) {
+      obj.maxAllowedUploadSizeBytes = Math.round(message.maxAllowedUploadSizeBytes);
+    }
+    if (message.maxAllowedFiles !== 0) {
+      obj.maxAllowedFiles = Math.round(message.maxAllowedFiles);
+    }
+    if (message.allowedMimeTypes?.length) {
+      obj.allowedMimeTypes = message.allowedMimeTypes;
+    }
+    if (message.allowedFileExtensions?.length) {
+      obj.allowedFileExtensions = message.allowedFileExtensions;
+    }
+    if (message.metadataJSONSchema !== "") {
+      obj.metadataJSONSchema = message.metadataJSONSchema;
+    }
+    if (message.hooks !== undefined) {
+      obj.hooks = S3UploadProfileHooksConfiguration.toJSON(message.hooks);
+    }
+    if (message.downloadRoles?.length) {
+      obj.downloadRoles = message.downloadRoles;
+    }
+    if (message.deleteRoles?.length) {
+      obj.deleteRoles = message.deleteRoles;
+    }
+    if (message.proxyDownloads === true) {
+      obj.proxyDownloads = message.proxyDownloads;
+    }
+    if (message.imageTransformations?.length) {
+      obj.imageTransformations = message.imageTransformations.map((e) => ImageTransformation.toJSON(e));
+    }
+    if (message.enableFiles === true) {
+      obj.enableFiles = message.enableFiles;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<HTTPHeader>, I>>(base?: I): HTTPHeader {
-    return HTTPHeader.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<S3UploadProfile>, I>>(base?: I): S3UploadProfile {
+    return S3UploadProfile.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<HTTPHeader>, I>>(object: I): HTTPHeader {
-    const message = createBaseHTTPHeader();
-    message.values = object.values?.map((e) => ConfigurationVariable.fromPartial(e)) || [];
+  fromPartial<I extends Exact<DeepPartial<S3UploadProfile>, I>>(object: I): S3UploadProfile {
+    const message = createBaseS3UploadProfile();
+    message.requireAuthentication = object.requireAuthentication ?? false;
+    message.maxAllowedUploadSizeBytes = object.maxAllowedUploadSizeBytes ?? 0;
+    message.maxAllowedFiles = object.maxAllowedFiles ?? 0;
+    message.allowedMimeTypes = object.allowedMimeTypes?.map((e) => e) || [];
+    message.allowedFileExtensions = object.allowedFileExtensions?.map((e) => e) || [];
+    message.metadataJSONSchema = object.metadataJSONSchema ?? "";
+    message.hooks = (object.hooks !== undefined && object.hooks !== null)
+      ? S3UploadProfileHooksConfiguration.fromPartial(object.hooks)
+      : undefined;
+    message.downloadRoles = object.downloadRoles?.map((e) => e) || [];
+    message.deleteRoles = object.deleteRoles?.map((e) => e) || [];
+    message.proxyDownloads = object.proxyDownloads ?? false;
+    message.imageTransformations = object.imageTransformations?.map((e) => ImageTransformation.fromPartial(e)) || [];
+    message.enableFiles = object.enableFiles ?? false;
     return message;
   },
 };
 
-function createBaseTypeConfiguration(): TypeConfiguration {
-  return { typeName: "", renameTo: "" };
+function createBaseImageTransformation(): ImageTransformation {
+  return { name: "", width: 0, height: 0, fit: 0, format: 0, quality: 0 };
 }
 
-export const TypeConfiguration = {
-  encode(message: TypeConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.typeName !== "") {
-      writer.uint32(10).string(message.typeName);
+export const ImageTransformation = {
+  encode(message: ImageTransformation, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
     }
-    if (message.renameTo !== "") {
-      writer.uint32(18).string(message.renameTo);
+    if (message.width !== 0) {
+      writer.uint32(16).int32(message.width);
+    }
+    if (message.height !== 0) {
+      writer.uint32(24).int32(message.height);
+    }
+    if (message.fit !== 0) {
+      writer.uint32(32).int32(message.fit);
+    }
+    if (message.format !== 0) {
+      writer.uint32(40).int32(message.format);
+    }
+    if (message.quality !== 0) {
+      writer.uint32(48).int32(message.quality);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): TypeConfiguration {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ImageTransformation {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseTypeConfiguration();
+    const message = createBaseImageTransformation();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -6695,14 +9875,42 @@ export const TypeConfiguration = {
             break;
           }
 
-          message.typeName = reader.string();
+          message.name = reader.string();
           continue;
         case 2:
-          if (tag !== 18) {
+          if (tag !== 16) {
             break;
           }
 
-          message.renameTo = reader.string();
+          message.width = reader.int32();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.height = reader.int32();
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.fit = reader.int32() as any;
+          continue;
+        case 5:
+          if (tag !== 40) {
+            break;
+          }
+
+          message.format = reader.int32() as any;
+          continue;
+        case 6:
+          if (tag !== 48) {
+            break;
+          }
+
+          message.quality = reader.int32();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -6713,78 +9921,114 @@ export const TypeConfiguration = {
     return message;
   },
 
-  fromJSON(object: any): TypeConfiguration {
+  fromJSON(object: any): ImageTransformation {
     return {
-      typeName: isSet(object.typeName) ? String(object.typeName) : "",
-      renameTo: isSet(object.renameTo) ? String(object.renameTo) : "",
+      name: isSet(object.name) ? String(object.name) : "",
+      width: isSet(object.width) ? Number(object.width) : 0,
+      height: isSet(object.height) ? Number(object.height) : 0,
+      fit: isSet(object.fit) ? imageFitFromJSON(object.fit) : 0,
+      format: isSet(object.format) ? imageFormatFromJSON(object.format) : 0,
+      quality: isSet(object.quality) ? Number(object.quality) : 0,
     };
   },
 
-  toJSON(message: TypeConfiguration): unknown {
+  toJSON(message: ImageTransformation): unknown {
     const obj: any = {};
-    if (message.typeName !== "") {
-      obj.typeName = message.typeName;
+    if (message.name !== "") {
+      obj.name = message.name;
     }
-    if (message.renameTo !== "") {
-      obj.renameTo = message.renameTo;
+    if (message.width !== 0) {
+      obj.width = Math.round(message.width);
+    }
+    if (message.height !== 0) {
+      obj.height = Math.round(message.height);
+    }
+    if (message.fit !== 0) {
+      obj.fit = imageFitToJSON(message.fit);
+    }
+    if (message.format !== 0) {
+      obj.format = imageFormatToJSON(message.format);
+    }
+    if (message.quality !== 0) {
+      obj.quality = Math.round(message.quality);
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<TypeConfiguration>, I>>(base?: I): TypeConfiguration {
-    return TypeConfiguration.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<ImageTransformation>, I>>(base?: I): ImageTransformation {
+    return ImageTransformation.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<TypeConfiguration>, I>>(object: I): TypeConfiguration {
-    const message = createBaseTypeConfiguration();
-    message.typeName = object.typeName ?? "";
-    message.renameTo = object.renameTo ?? "";
+  fromPartial<I extends Exact<DeepPartial<ImageTransformation>, I>>(object: I): ImageTransformation {
+    const message = createBaseImageTransformation();
+    message.name = object.name ?? "";
+    message.width = object.width ?? 0;
+    message.height = object.height ?? 0;
+    message.fit = object.fit ?? 0;
+    message.format = object.format ?? 0;
+    message.quality = object.quality ?? 0;
     return message;
   },
 };
 
-function createBaseFieldConfiguration(): FieldConfiguration {
+function createBaseS3UploadConfiguration(): S3UploadConfiguration {
   return {
-    typeName: "",
-    fieldName: "",
-    disableDefaultFieldMapping: false,
-    path: [],
-    argumentsConfiguration: [],
-    requiresFields: [],
-    unescapeResponseJson: false,
+    name: "",
+    endpoint: undefined,
+    accessKeyID: undefined,
+    secretAccessKey: undefined,
+    bucketName: undefined,
+    bucketLocation: undefined,
+    useSSL: false,
+    uploadProfiles: {},
+    storage: 0,
+    directory: undefined,
+    credentialsJSON: undefined,
   };
 }
 
-export const FieldConfiguration = {
-  encode(message: FieldConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.typeName !== "") {
-      writer.uint32(10).string(message.typeName);
+export const S3UploadConfiguration = {
+  encode(message: S3UploadConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
     }
-    if (message.fieldName !== "") {
-      writer.uint32(18).string(message.fieldName);
+    if (message.endpoint !== undefined) {
+      ConfigurationVariable.encode(message.endpoint, writer.uint32(18).fork()).ldelim();
+    }
+    if (message.accessKeyID !== undefined) {
+      ConfigurationVariable.encode(message.accessKeyID, writer.uint32(26).fork()).ldelim();
+    }
+    if (message.secretAccessKey !== undefined) {
+      ConfigurationVariable.encode(message.secretAccessKey, writer.uint32(34).fork()).ldelim();
     }
-    if (message.disableDefaultFieldMapping === true) {
-      writer.uint32(24).bool(message.disableDefaultFieldMapping);
+    if (message.bucketName !== undefined) {
+      ConfigurationVariable.encode(message.bucketName, writer.uint32(42).fork()).ldelim();
     }
-    for (const v of message.path) {
-      writer.uint32(34).string(v!);
+    if (message.bucketLocation !== undefined) {
+      ConfigurationVariable.encode(message.bucketLocation, writer.uint32(50).fork()).ldelim();
     }
-    for (const v of message.argumentsConfiguration) {
-      ArgumentConfiguration.encode(v!, writer.uint32(50).fork()).ldelim();
+    if (message.useSSL === true) {
+      writer.uint32(56).bool(message.useSSL);
     }
-    for (const v of message.requiresFields) {
-      writer.uint32(58).string(v!);
+    Object.entries(message.uploadProfiles).forEach(([key, value]) => {
+      S3UploadConfiguration_UploadProfilesEntry.encode({ key: key as any, value }, writer.uint32(66).fork()).ldelim();
+    });
+    if (message.storage !== 0) {
+      writer.uint32(72).int32(message.storage);
     }
-    if (message.unescapeResponseJson === true) {
-      writer.uint32(64).bool(message.unescapeResponseJson);
+    if (message.directory !== undefined) {
+      ConfigurationVariable.encode(message.directory, writer.uint32(82).fork()).ldelim();
+    }
+    if (message.credentialsJSON !== undefined) {
+      ConfigurationVariable.encode(message.credentialsJSON, writer.uint32(90).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): FieldConfiguration {
+  decode(input: _m0.Reader | Uint8Array, length?: number): S3UploadConfiguration {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseFieldConfiguration();
+    const message = createBaseS3UploadConfiguration();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -6793,154 +10037,80 @@ export const FieldConfiguration = {
             break;
           }
 
-          message.typeName = reader.string();
+          message.name = reader.string();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.fieldName = reader.string();
+          message.endpoint = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
         case 3:
-          if (tag !== 24) {
+          if (tag !== 26) {
             break;
           }
 
-          message.disableDefaultFieldMapping = reader.bool();
+          message.accessKeyID = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
         case 4:
           if (tag !== 34) {
             break;
           }
 
-          message.path.push(reader.string());
+          message.secretAccessKey = ConfigurationVariable.decode(reader, reader.uint32());
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.bucketName = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
         case 6:
           if (tag !== 50) {
             break;
           }
 
-          message.argumentsConfiguration.push(ArgumentConfiguration.decode(reader, reader.uint32()));
+          message.bucketLocation = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
         case 7:
-          if (tag !== 58) {
+          if (tag !== 56) {
             break;
           }
 
-          message.requiresFields.push(reader.string());
+          message.useSSL = reader.bool();
           continue;
         case 8:
-          if (tag !== 64) {
+          if (tag !== 66) {
             break;
           }
 
-          message.unescapeResponseJson = reader.bool();
+          const entry8 = S3UploadConfiguration_UploadProfilesEntry.decode(reader, reader.uint32());
+          if (entry8.value !== undefined) {
+            message.uploadProfiles[entry8.key] = entry8.value;
+          }
           continue;
-      }
-      if ((tag & 7) === 4 || tag === 0) {
-        break;
-      }
-      reader.skipType(tag & 7);
-    }
-    return message;
-  },
-
-  fromJSON(object: any): FieldConfiguration {
-    return {
-      typeName: isSet(object.typeName) ? String(object.typeName) : "",
-      fieldName: isSet(object.fieldName) ? String(object.fieldName) : "",
-      disableDefaultFieldMapping: isSet(object.disableDefaultFieldMapping)
-        ? Boolean(object.disableDefaultFieldMapping)
-        : false,
-      path: Array.isArray(object?.path) ? object.path.map((e: any) => String(e)) : [],
-      argumentsConfiguration: Array.isArray(object?.argumentsConfiguration)
-        ? object.argumentsConfiguration.map((e: any) => ArgumentConfiguration.fromJSON(e))
-        : [],
-      requiresFields: Array.isArray(object?.requiresFields) ? object.requiresFields.map((e: any) => String(e)) : [],
-      unescapeResponseJson: isSet(object.unescapeResponseJson) ? Boolean(object.unescapeResponseJson) : false,
-    };
-  },
-
-  toJSON(message: FieldConfiguration): unknown {
-    const obj: any = {};
-    if (message.typeName !== "") {
-      obj.typeName = message.typeName;
-    }
-    if (message.fieldName !== "") {
-      obj.fieldName = message.fieldName;
-    }
-    if (message.disableDefaultFieldMapping === true) {
-      obj.disableDefaultFieldMapping = message.disableDefaultFieldMapping;
-    }
-    if (message.path?.length) {
-      obj.path = message.path;
-    }
-    if (message.argumentsConfiguration?.length) {
-      obj.argumentsConfiguration = message.argumentsConfiguration.map((e) => ArgumentConfiguration.toJSON(e));
-    }
-    if (message.requiresFields?.length) {
-      obj.requiresFields = message.requiresFields;
-    }
-    if (message.unescapeResponseJson === true) {
-      obj.unescapeResponseJson = message.unescapeResponseJson;
-    }
-    return obj;
-  },
-
-  create<I extends Exact<DeepPartial<FieldConfiguration>, I>>(base?: I): FieldConfiguration {
-    return FieldConfiguration.fromPartial(base ?? {});
-  },
-
-  fromPartial<I extends Exact<DeepPartial<FieldConfiguration>, I>>(object: I): FieldConfiguration {
-    const message = createBaseFieldConfiguration();
-    message.typeName = object.typeName ?? "";
-    message.fieldName = object.fieldName ?? "";
-    message.disableDefaultFieldMapping = object.disableDefaultFieldMapping ?? false;
-    message.path = object.path?.map((e) => e) || [];
-    message.argumentsConfiguration = object.argumentsConfiguration?.map((e) => ArgumentConfiguration.fromPartial(e)) ||
-      [];
-    message.requiresFields = object.requiresFields?.map((e) => e) || [];
-    message.unescapeResponseJson = object.unescapeResponseJson ?? false;
-    return message;
-  },
-};
-
-function createBaseTypeField(): TypeField {
-  return { typeName: "", fieldNames: [] };
-}
-
-export const TypeField = {
-  encode(message: TypeField, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.typeName !== "") {
-      writer.uint32(10).string(message.typeName);
-    }
-    for (const v of message.fieldNames) {
-      writer.uint32(18).string(v!);
-    }
-    return writer;
-  },
+        case 9:
+          if (tag !== 72) {
+            break;
+          }
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): TypeField {
-    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
-    let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseTypeField();
-    while (reader.pos < end) {
-      const tag = reader.uint32();
-      switch (tag >>> 3) {
-        case 1:
-          if (tag !== 10) {
+          message.storage = reader.int32() as any;
+          continue;
+        case 10:
+          if (tag !== 82) {
             break;
           }
 
-          message.typeName = reader.string();
+          message.directory = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
-        case 2:
-          if (tag !== 18) {
+        case 11:
+          if (tag !== 90) {
             break;
           }
 
-          message.fieldNames.push(reader.string());
+          message.credentialsJSON = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -6951,55 +10121,137 @@ export const TypeField = {
     return message;
   },
 
-  fromJSON(object: any): TypeField {
+  fromJSON(object: any): S3UploadConfiguration {
     return {
-      typeName: isSet(object.typeName) ? String(object.typeName) : "",
-      fieldNames: Array.isArray(object?.fieldNames) ? object.fieldNames.map((e: any) => String(e)) : [],
+      name: isSet(object.name) ? String(object.name) : "",
+      endpoint: isSet(object.endpoint) ? ConfigurationVariable.fromJSON(object.endpoint) : undefined,
+      accessKeyID: isSet(object.accessKeyID) ? ConfigurationVariable.fromJSON(object.accessKeyID) : undefined,
+      secretAccessKey: isSet(object.secretAccessKey)
+        ? ConfigurationVariable.fromJSON(object.secretAccessKey)
+        : undefined,
+      bucketName: isSet(object.bucketName) ? ConfigurationVariable.fromJSON(object.bucketName) : undefined,
+      bucketLocation: isSet(object.bucketLocation) ? ConfigurationVariable.fromJSON(object.bucketLocation) : undefined,
+      useSSL: isSet(object.useSSL) ? Boolean(object.useSSL) : false,
+      uploadProfiles: isObject(object.uploadProfiles)
+        ? Object.entries(object.uploadProfiles).reduce<{ [key: string]: S3UploadProfile }>((acc, [key, value]) => {
+          acc[key] = S3UploadProfile.fromJSON(value);
+          return acc;
+        }, {})
+        : {},
+      storage: isSet(object.storage) ? uploadStorageKindFromJSON(object.storage) : 0,
+      directory: isSet(object.directory) ? ConfigurationVariable.fromJSON(object.directory) : undefined,
+      credentialsJSON: isSet(object.credentialsJSON)
+        ? ConfigurationVariable.fromJSON(object.credentialsJSON)
+        : undefined,
     };
   },
 
-  toJSON(message: TypeField): unknown {
+  toJSON(message: S3UploadConfiguration): unknown {
     const obj: any = {};
-    if (message.typeName !== "") {
-      obj.typeName = message.typeName;
+    if (message.name !== "") {
+      obj.name = message.name;
     }
-    if (message.fieldNames?.length) {
-      obj.fieldNames = message.fieldNames;
+    if (message.endpoint !== undefined) {
+      obj.endpoint = ConfigurationVariable.toJSON(message.endpoint);
+    }
+    if (message.accessKeyID !== undefined) {
+      obj.accessKeyID = ConfigurationVariable.toJSON(message.accessKeyID);
+    }
+    if (message.secretAccessKey !== undefined) {
+      obj.secretAccessKey = ConfigurationVariable.toJSON(message.secretAccessKey);
+    }
+    if (message.bucketName !== undefined) {
+      obj.bucketName = ConfigurationVariable.toJSON(message.bucketName);
+    }
+    if (message.bucketLocation !== undefined) {
+      obj.bucketLocation = ConfigurationVariable.toJSON(message.bucketLocation);
+    }
+    if (message.useSSL === true) {
+      obj.useSSL = message.useSSL;
+    }
+    if (message.uploadProfiles) {
+      const entries = Object.entries(message.uploadProfiles);
+      if (entries.length > 0) {
+        obj.uploadProfiles = {};
+        entries.forEach(([k, v]) => {
+          obj.uploadProfiles[k] = S3UploadProfile.toJSON(v);
+        });
+      }
+    }
+    if (message.storage !== 0) {
+      obj.storage = uploadStorageKindToJSON(message.storage);
+    }
+    if (message.directory !== undefined) {
+      obj.directory = ConfigurationVariable.toJSON(message.directory);
+    }
+    if (message.credentialsJSON !== undefined) {
+      obj.credentialsJSON = ConfigurationVariable.toJSON(message.credentialsJSON);
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<TypeField>, I>>(base?: I): TypeField {
-    return TypeField.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<S3UploadConfiguration>, I>>(base?: I): S3UploadConfiguration {
+    return S3UploadConfiguration.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<TypeField>, I>>(object: I): TypeField {
-    const message = createBaseTypeField();
-    message.typeName = object.typeName ?? "";
-    message.fieldNames = object.fieldNames?.map((e) => e) || [];
+  fromPartial<I extends Exact<DeepPartial<S3UploadConfiguration>, I>>(object: I): S3UploadConfiguration {
+    const message = createBaseS3UploadConfiguration();
+    message.name = object.name ?? "";
+    message.endpoint = (object.endpoint !== undefined && object.endpoint !== null)
+      ? ConfigurationVariable.fromPartial(object.endpoint)
+      : undefined;
+    message.accessKeyID = (object.accessKeyID !== undefined && object.accessKeyID !== null)
+      ? ConfigurationVariable.fromPartial(object.accessKeyID)
+      : undefined;
+    message.secretAccessKey = (object.secretAccessKey !== undefined && object.secretAccessKey !== null)
+      ? ConfigurationVariable.fromPartial(object.secretAccessKey)
+      : undefined;
+    message.bucketName = (object.bucketName !== undefined && object.bucketName !== null)
+      ? ConfigurationVariable.fromPartial(object.bucketName)
+      : undefined;
+    message.bucketLocation = (object.bucketLocation !== undefined && object.bucketLocation !== null)
+      ? ConfigurationVariable.fromPartial(object.bucketLocation)
+      : undefined;
+    message.useSSL = object.useSSL ?? false;
+    message.uploadProfiles = Object.entries(object.uploadProfiles ?? {}).reduce<{ [key: string]: S3UploadProfile }>(
+      (acc, [key, value]) => {
+        if (value !== undefined) {
+          acc[key] = S3UploadProfile.fromPartial(value);
+        }
+        return acc;
+      },
+      {},
+    );
+    message.storage = object.storage ?? 0;
+    message.directory = (object.directory !== undefined && object.directory !== null)
+      ? ConfigurationVariable.fromPartial(object.directory)
+      : undefined;
+    message.credentialsJSON = (object.credentialsJSON !== undefined && object.credentialsJSON !== null)
+      ? ConfigurationVariable.fromPartial(object.credentialsJSON)
+      : undefined;
     return message;
   },
 };
 
-function createBaseSingleTypeField(): SingleTypeField {
-  return { typeName: "", fieldName: "" };
+function createBaseS3UploadConfiguration_UploadProfilesEntry(): S3UploadConfiguration_UploadProfilesEntry {
+  return { key: "", value: undefined };
 }
 
-export const SingleTypeField = {
-  encode(message: SingleTypeField, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.typeName !== "") {
-      writer.uint32(10).string(message.typeName);
+export const S3UploadConfiguration_UploadProfilesEntry = {
+  encode(message: S3UploadConfiguration_UploadProfilesEntry, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.key !== "") {
+      writer.uint32(10).string(message.key);
     }
-    if (message.fieldName !== "") {
-      writer.uint32(18).string(message.fieldName);
+    if (message.value !== undefined) {
+      S3UploadProfile.encode(message.value, writer.uint32(18).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): SingleTypeField {
+  decode(input: _m0.Reader | Uint8Array, length?: number): S3UploadConfiguration_UploadProfilesEntry {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseSingleTypeField();
+    const message = createBaseS3UploadConfiguration_UploadProfilesEntry();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -7008,14 +10260,14 @@ export const SingleTypeField = {
             break;
           }
 
-          message.typeName = reader.string();
+          message.key = reader.string();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.fieldName = reader.string();
+          message.value = S3UploadProfile.decode(reader, reader.uint32());
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -7026,251 +10278,201 @@ export const SingleTypeField = {
     return message;
   },
 
-  fromJSON(object: any): SingleTypeField {
+  fromJSON(object: any): S3UploadConfiguration_UploadProfilesEntry {
     return {
-      typeName: isSet(object.typeName) ? String(object.typeName) : "",
-      fieldName: isSet(object.fieldName) ? String(object.fieldName) : "",
+      key: isSet(object.key) ? String(object.key) : "",
+      value: isSet(object.value) ? S3UploadProfile.fromJSON(object.value) : undefined,
     };
   },
 
-  toJSON(message: SingleTypeField): unknown {
+  toJSON(message: S3UploadConfiguration_UploadProfilesEntry): unknown {
     const obj: any = {};
-    if (message.typeName !== "") {
-      obj.typeName = message.typeName;
+    if (message.key !== "") {
+      obj.key = message.key;
     }
-    if (message.fieldName !== "") {
-      obj.fieldName = message.fieldName;
+    if (message.value !== undefined) {
+      obj.value = S3UploadProfile.toJSON(message.value);
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<SingleTypeField>, I>>(base?: I): SingleTypeField {
-    return SingleTypeField.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<S3UploadConfiguration_UploadProfilesEntry>, I>>(
+    base?: I,
+  ): S3UploadConfiguration_UploadProfilesEntry {
+    return S3UploadConfiguration_UploadProfilesEntry.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<SingleTypeField>, I>>(object: I): SingleTypeField {
-    const message = createBaseSingleTypeField();
-    message.typeName = object.typeName ?? "";
-    message.fieldName = object.fieldName ?? "";
+  fromPartial<I extends Exact<DeepPartial<S3UploadConfiguration_UploadProfilesEntry>, I>>(
+    object: I,
+  ): S3UploadConfiguration_UploadProfilesEntry {
+    const message = createBaseS3UploadConfiguration_UploadProfilesEntry();
+    message.key = object.key ?? "";
+    message.value = (object.value !== undefined && object.value !== null)
+      ? S3UploadProfile.fromPartial(object.value)
+      : undefined;
     return message;
   },
 };
 
-function createBaseArgumentConfiguration(): ArgumentConfiguration {
-  return { name: "", sourceType: 0, sourcePath: [], renderConfiguration: 0, renameTypeTo: "" };
+function createBaseUserDefinedApi(): UserDefinedApi {
+  return {
+    engineConfiguration: undefined,
+    enableGraphqlEndpoint: false,
+    operations: [],
+    invalidOperationNames: [],
+    corsConfiguration: undefined,
+    authenticationConfig: undefined,
+    s3UploadConfiguration: [],
+    allowedHostNames: [],
+    webhooks: [],
+    serverOptions: undefined,
+    nodeOptions: undefined,
+    experimentalConfig: undefined,
+    eventWebhooks: [],
+  };
 }
 
-export const ArgumentConfiguration = {
-  encode(message: ArgumentConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.name !== "") {
-      writer.uint32(10).string(message.name);
+export const UserDefinedApi = {
+  encode(message: UserDefinedApi, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.engineConfiguration !== undefined) {
+      EngineConfiguration.encode(message.engineConfiguration, writer.uint32(26).fork()).ldelim();
     }
-    if (message.sourceType !== 0) {
-      writer.uint32(16).int32(message.sourceType);
+    if (message.enableGraphqlEndpoint === true) {
+      writer.uint32(40).bool(message.enableGraphqlEndpoint);
     }
-    for (const v of message.sourcePath) {
-      writer.uint32(26).string(v!);
+    for (const v of message.operations) {
+      Operation.encode(v!, writer.uint32(50).fork()).ldelim();
     }
-    if (message.renderConfiguration !== 0) {
-      writer.uint32(32).int32(message.renderConfiguration);
+    for (const v of message.invalidOperationNames) {
+      writer.uint32(130).string(v!);
     }
-    if (message.renameTypeTo !== "") {
-      writer.uint32(42).string(message.renameTypeTo);
+    if (message.corsConfiguration !== undefined) {
+      CorsConfiguration.encode(message.corsConfiguration, writer.uint32(58).fork()).ldelim();
+    }
+    if (message.authenticationConfig !== undefined) {
+      ApiAuthenticationConfig.encode(message.authenticationConfig, writer.uint32(66).fork()).ldelim();
+    }
+    for (const v of message.s3UploadConfiguration) {
+      S3UploadConfiguration.encode(v!, writer.uint32(74).fork()).ldelim();
+    }
+    for (const v of message.allowedHostNames) {
+      ConfigurationVariable.encode(v!, writer.uint32(90).fork()).ldelim();
+    }
+    for (const v of message.webhooks) {
+      WebhookConfiguration.encode(v!, writer.uint32(98).fork()).ldelim();
+    }
+    if (message.serverOptions !== undefined) {
+      ServerOptions.encode(message.serverOptions, writer.uint32(114).fork()).ldelim();
+    }
+    if (message.nodeOptions !== undefined) {
+      NodeOptions.encode(message.nodeOptions, writer.uint32(122).fork()).ldelim();
+    }
+    if (message.experimentalConfig !== undefined) {
+      ExperimentalConfiguration.encode(message.experimentalConfig, writer.uint32(138).fork()).ldelim();
+    }
+    for (const v of message.eventWebhooks) {
+      EventWebhookConfiguration.encode(v!, writer.uint32(146).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ArgumentConfiguration {
+  decode(input: _m0.Reader | Uint8Array, length?: number): UserDefinedApi {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseArgumentConfiguration();
+    const message = createBaseUserDefinedApi();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
-        case 1:
-          if (tag !== 10) {
+        case 3:
+          if (tag !== 26) {
             break;
           }
 
-          message.name = reader.string();
+          message.engineConfiguration = EngineConfiguration.decode(reader, reader.uint32());
           continue;
-        case 2:
-          if (tag !== 16) {
+        case 5:
+          if (tag !== 40) {
             break;
           }
 
-          message.sourceType = reader.int32() as any;
+          message.enableGraphqlEndpoint = reader.bool();
           continue;
-        case 3:
-          if (tag !== 26) {
+        case 6:
+          if (tag !== 50) {
             break;
           }
 
-          message.sourcePath.push(reader.string());
+          message.operations.push(Operation.decode(reader, reader.uint32()));
           continue;
-        case 4:
-          if (tag !== 32) {
+        case 16:
+          if (tag !== 130) {
             break;
           }
 
-          message.renderConfiguration = reader.int32() as any;
+          message.invalidOperationNames.push(reader.string());
           continue;
-        case 5:
-          if (tag !== 42) {
+        case 7:
+          if (tag !== 58) {
             break;
           }
 
-          message.renameTypeTo = reader.string();
+          message.corsConfiguration = CorsConfiguration.decode(reader, reader.uint32());
           continue;
-      }
-      if ((tag & 7) === 4 || tag === 0) {
-        break;
-      }
-      reader.skipType(tag & 7);
-    }
-    return message;
-  },
-
-  fromJSON(object: any): ArgumentConfiguration {
-    return {
-      name: isSet(object.name) ? String(object.name) : "",
-      sourceType: isSet(object.sourceType) ? argumentSourceFromJSON(object.sourceType) : 0,
-      sourcePath: Array.isArray(object?.sourcePath) ? object.sourcePath.map((e: any) => String(e)) : [],
-      renderConfiguration: isSet(object.renderConfiguration)
-        ? argumentRenderConfigurationFromJSON(object.renderConfiguration)
-        : 0,
-      renameTypeTo: isSet(object.renameTypeTo) ? String(object.renameTypeTo) : "",
-    };
-  },
-
-  toJSON(message: ArgumentConfiguration): unknown {
-    const obj: any = {};
-    if (message.name !== "") {
-      obj.name = message.name;
-    }
-    if (message.sourceType !== 0) {
-      obj.sourceType = argumentSourceToJSON(message.sourceType);
-    }
-    if (message.sourcePath?.length) {
-      obj.sourcePath = message.sourcePath;
-    }
-    if (message.renderConfiguration !== 0) {
-      obj.renderConfiguration = argumentRenderConfigurationToJSON(message.renderConfiguration);
-    }
-    if (message.renameTypeTo !== "") {
-      obj.renameTypeTo = message.renameTypeTo;
-    }
-    return obj;
-  },
-
-  create<I extends Exact<DeepPartial<ArgumentConfiguration>, I>>(base?: I): ArgumentConfiguration {
-    return ArgumentConfiguration.fromPartial(base ?? {});
-  },
-
-  fromPartial<I extends Exact<DeepPartial<ArgumentConfiguration>, I>>(object: I): ArgumentConfiguration {
-    const message = createBaseArgumentConfiguration();
-    message.name = object.name ?? "";
-    message.sourceType = object.sourceType ?? 0;
-    message.sourcePath = object.sourcePath?.map((e) => e) || [];
-    message.renderConfiguration = object.renderConfiguration ?? 0;
-    message.renameTypeTo = object.renameTypeTo ?? "";
-    return message;
-  },
-};
-
-function createBaseWunderGraphConfiguration(): WunderGraphConfiguration {
-  return {
-    api: undefined,
-    apiId: "",
-    environmentIds: [],
-    dangerouslyEnableGraphQLEndpoint: false,
-    configHash: "",
-    enabledFeatures: undefined,
-    hooks: [],
-  };
-}
-
-export const WunderGraphConfiguration = {
-  encode(message: WunderGraphConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.api !== undefined) {
-      UserDefinedApi.encode(message.api, writer.uint32(10).fork()).ldelim();
-    }
-    if (message.apiId !== "") {
-      writer.uint32(18).string(message.apiId);
-    }
-    for (const v of message.environmentIds) {
-      writer.uint32(26).string(v!);
-    }
-    if (message.dangerouslyEnableGraphQLEndpoint === true) {
-      writer.uint32(32).bool(message.dangerouslyEnableGraphQLEndpoint);
-    }
-    if (message.configHash !== "") {
-      writer.uint32(42).string(message.configHash);
-    }
-    if (message.enabledFeatures !== undefined) {
-      EnabledFeatures.encode(message.enabledFeatures, writer.uint32(50).fork()).ldelim();
-    }
-    for (const v of message.hooks) {
-      Hook.encode(v!, writer.uint32(58).fork()).ldelim();
-    }
-    return writer;
-  },
-
-  decode(input: _m0.Reader | Uint8Array, length?: number): WunderGraphConfiguration {
-    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
-    let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseWunderGraphConfiguration();
-    while (reader.pos < end) {
-      const tag = reader.uint32();
-      switch (tag >>> 3) {
-        case 1:
-          if (tag !== 10) {
+        case 8:
+          if (tag !== 66) {
             break;
           }
 
-          message.api = UserDefinedApi.decode(reader, reader.uint32());
+          message.authenticationConfig = ApiAuthenticationConfig.decode(reader, reader.uint32());
           continue;
-        case 2:
-          if (tag !== 18) {
+        case 9:
+          if (tag !== 74) {
             break;
           }
 
-          message.apiId = reader.string();
+          message.s3UploadConfiguration.push(S3UploadConfiguration.decode(reader, reader.uint32()));
           continue;
-        case 3:
-          if (tag !== 26) {
+        case 11:
+          if (tag !== 90) {
             break;
           }
 
-          message.environmentIds.push(reader.string());
+          message.allowedHostNames.push(ConfigurationVariable.decode(reader, reader.uint32()));
           continue;
-        case 4:
-          if (tag !== 32) {
+        case 12:
+          if (tag !== 98) {
             break;
           }
 
-          message.dangerouslyEnableGraphQLEndpoint = reader.bool();
+          message.webhooks.push(WebhookConfiguration.decode(reader, reader.uint32()));
           continue;
-        case 5:
-          if (tag !== 42) {
+        case 14:
+          if (tag !== 114) {
             break;
           }
 
-          message.configHash = reader.string();
+          message.serverOptions = ServerOptions.decode(reader, reader.uint32());
           continue;
-        case 6:
-          if (tag !== 50) {
+        case 15:
+          if (tag !== 122) {
             break;
           }
 
-          message.enabledFeatures = EnabledFeatures.decode(reader, reader.uint32());
+          message.nodeOptions = NodeOptions.decode(reader, reader.uint32());
           continue;
-        case 7:
-          if (tag !== 58) {
+        case 17:
+          if (tag !== 138) {
             break;
           }
 
-          message.hooks.push(Hook.decode(reader, reader.uint32()));
+          message.experimentalConfig = ExperimentalConfiguration.decode(reader, reader.uint32());
+          continue;
+        case 18:
+          if (tag !== 146) {
+            break;
+          }
+
+          message.eventWebhooks.push(EventWebhookConfiguration.decode(reader, reader.uint32()));
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -7281,138 +10483,215 @@ export const WunderGraphConfiguration = {
     return message;
   },
 
-  fromJSON(object: any): WunderGraphConfiguration {
+  fromJSON(object: any): UserDefinedApi {
     return {
-      api: isSet(object.api) ? UserDefinedApi.fromJSON(object.api) : undefined,
-      apiId: isSet(object.apiId) ? String(object.apiId) : "",
-      environmentIds: Array.isArray(object?.environmentIds) ? object.environmentIds.map((e: any) => String(e)) : [],
-      dangerouslyEnableGraphQLEndpoint: isSet(object.dangerouslyEnableGraphQLEndpoint)
-        ? Boolean(object.dangerouslyEnableGraphQLEndpoint)
-        : false,
-      configHash: isSet(object.configHash) ? String(object.configHash) : "",
-      enabledFeatures: isSet(object.enabledFeatures) ? EnabledFeatures.fromJSON(object.enabledFeatures) : undefined,
-      hooks: Array.isArray(object?.hooks) ? object.hooks.map((e: any) => Hook.fromJSON(e)) : [],
+      engineConfiguration: isSet(object.engineConfiguration)
+        ? EngineConfiguration.fromJSON(object.engineConfiguration)
+        : undefined,
+      enableGraphqlEndpoint: isSet(object.enableGraphqlEndpoint) ? Boolean(object.enableGraphqlEndpoint) : false,
+      operations: Array.isArray(object?.operations) ? object.operations.map((e: any) => Operation.fromJSON(e)) : [],
+      invalidOperationNames: Array.isArray(object?.invalidOperationNames)
+        ? object.invalidOperationNames.map((e: any) => String(e))
+        : [],
+      corsConfiguration: isSet(object.corsConfiguration)
+        ? CorsConfiguration.fromJSON(object.corsConfiguration)
+        : undefined,
+      authenticationConfig: isSet(object.authenticationConfig)
+        ? ApiAuthenticationConfig.fromJSON(object.authenticationConfig)
+        : undefined,
+      s3UploadConfiguration: Array.isArray(object?.s3UploadConfiguration)
+        ? object.s3UploadConfiguration.map((e: any) => S3UploadConfiguration.fromJSON(e))
+        : [],
+      allowedHostNames: Array.isArray(object?.allowedHostNames)
+        ? object.allowedHostNames.map((e: any) => ConfigurationVariable.fromJSON(e))
+        : [],
+      webhooks: Array.isArray(object?.webhooks)
+        ? object.webhooks.map((e: any) => WebhookConfiguration.fromJSON(e))
+        : [],
+      serverOptions: isSet(object.serverOptions) ? ServerOptions.fromJSON(object.serverOptions) : undefined,
+      nodeOptions: isSet(object.nodeOptions) ? NodeOptions.fromJSON(object.nodeOptions) : undefined,
+      experimentalConfig: isSet(object.experimentalConfig)
+        ? ExperimentalConfiguration.fromJSON(object.experimentalConfig)
+        : undefined,
+      eventWebhooks: Array.isArray(object?.eventWebhooks)
+        ? object.eventWebhooks.map((e: any) => EventWebhookConfiguration.fromJSON(e))
+        : [],
     };
   },
 
-  toJSON(message: WunderGraphConfiguration): unknown {
+  toJSON(message: UserDefinedApi): unknown {
     const obj: any = {};
-    if (message.api !== undefined) {
-      obj.api = UserDefinedApi.toJSON(message.api);
+    if (message.engineConfiguration !== undefined) {
+      obj.engineConfiguration = EngineConfiguration.toJSON(message.engineConfiguration);
     }
-    if (message.apiId !== "") {
-      obj.apiId = message.apiId;
+    if (message.enableGraphqlEndpoint === true) {
+      obj.enableGraphqlEndpoint = message.enableGraphqlEndpoint;
     }
-    if (message.environmentIds?.length) {
-      obj.environmentIds = message.environmentIds;
+    if (message.operations?.length) {
+      obj.operations = message.operations.map((e) => Operation.toJSON(e));
     }
-    if (message.dangerouslyEnableGraphQLEndpoint === true) {
-      obj.dangerouslyEnableGraphQLEndpoint = message.dangerouslyEnableGraphQLEndpoint;
+    if (message.invalidOperationNames?.length) {
+      obj.invalidOperationNames = message.invalidOperationNames;
     }
-    if (message.configHash !== "") {
-      obj.configHash = message.configHash;
+    if (message.corsConfiguration !== undefined) {
+      obj.corsConfiguration = CorsConfiguration.toJSON(message.corsConfiguration);
     }
-    if (message.enabledFeatures !== undefined) {
-      obj.enabledFeatures = EnabledFeatures.toJSON(message.enabledFeatures);
+    if (message.authenticationConfig !== undefined) {
+      obj.authenticationConfig = ApiAuthenticationConfig.toJSON(message.authenticationConfig);
     }
-    if (message.hooks?.length) {
-      obj.hooks = message.hooks.map((e) => Hook.toJSON(e));
+    if (message.s3UploadConfiguration?.length) {
+      obj.s3UploadConfiguration = message.s3UploadConfiguration.map((e) => S3UploadConfiguration.toJSON(e));
+    }
+    if (message.allowedHostNames?.length) {
+      obj.allowedHostNames = message.allowedHostNames.map((e) => ConfigurationVariable.toJSON(e));
+    }
+    if (message.webhooks?.length) {
+      obj.webhooks = message.webhooks.map((e) => WebhookConfiguration.toJSON(e));
+    }
+    if (message.serverOptions !== undefined) {
+      obj.serverOptions = ServerOptions.toJSON(message.serverOptions);
+    }
+    if (message.nodeOptions !== undefined) {
+      obj.nodeOptions = NodeOptions.toJSON(message.nodeOptions);
+    }
+    if (message.experimentalConfig !== undefined) {
+      obj.experimentalConfig = ExperimentalConfiguration.toJSON(message.experimentalConfig);
+    }
+    if (message.eventWebhooks?.length) {
+      obj.eventWebhooks = message.eventWebhooks.map((e) => EventWebhookConfiguration.toJSON(e));
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<WunderGraphConfiguration>, I>>(base?: I): WunderGraphConfiguration {
-    return WunderGraphConfiguration.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<UserDefinedApi>, I>>(base?: I): UserDefinedApi {
+    return UserDefinedApi.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<WunderGraphConfiguration>, I>>(object: I): WunderGraphConfiguration {
-    const message = createBaseWunderGraphConfiguration();
-    message.api = (object.api !== undefined && object.api !== null)
-      ? UserDefinedApi.fromPartial(object.api)
+  fromPartial<I extends Exact<DeepPartial<UserDefinedApi>, I>>(object: I): UserDefinedApi {
+    const message = createBaseUserDefinedApi();
+    message.engineConfiguration = (object.engineConfiguration !== undefined && object.engineConfiguration !== null)
+      ? EngineConfiguration.fromPartial(object.engineConfiguration)
       : undefined;
-    message.apiId = object.apiId ?? "";
-    message.environmentIds = object.environmentIds?.map((e) => e) || [];
-    message.dangerouslyEnableGraphQLEndpoint = object.dangerouslyEnableGraphQLEndpoint ?? false;
-    message.configHash = object.configHash ?? "";
-    message.enabledFeatures = (object.enabledFeatures !== undefined && object.enabledFeatures !== null)
-      ? EnabledFeatures.fromPartial(object.enabledFeatures)
+    message.enableGraphqlEndpoint = object.enableGraphqlEndpoint ?? false;
+    message.operations = object.operations?.map((e) => Operation.fromPartial(e)) || [];
+    message.invalidOperationNames = object.invalidOperationNames?.map((e) => e) || [];
+    message.corsConfiguration = (object.corsConfiguration !== undefined && object.corsConfiguration !== null)
+      ? CorsConfiguration.fromPartial(object.corsConfiguration)
       : undefined;
-    message.hooks = object.hooks?.map((e) => Hook.fromPartial(e)) || [];
+    message.authenticationConfig = (object.authenticationConfig !== undefined && object.authenticationConfig !== null)
+      ? ApiAuthenticationConfig.fromPartial(object.authenticationConfig)
+      : undefined;
+    message.s3UploadConfiguration = object.s3UploadConfiguration?.map((e) => S3UploadConfiguration.fromPartial(e)) ||
+      [];
+    message.allowedHostNames = object.allowedHostNames?.map((e) => ConfigurationVariable.fromPartial(e)) || [];
+    message.webhooks = object.webhooks?.map((e) => WebhookConfiguration.fromPartial(e)) || [];
+    message.serverOptions = (object.serverOptions !== undefined && object.serverOptions !== null)
+      ? ServerOptions.fromPartial(object.serverOptions)
+      : undefined;
+    message.nodeOptions = (object.nodeOptions !== undefined && object.nodeOptions !== null)
+      ? NodeOptions.fromPartial(object.nodeOptions)
+      : undefined;
+    message.experimentalConfig = (object.experimentalConfig !== undefined && object.experimentalConfig !== null)
+      ? ExperimentalConfiguration.fromPartial(object.experimentalConfig)
+      : undefined;
+    message.eventWebhooks = object.eventWebhooks?.map((e) => EventWebhookConfiguration.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseEnabledFeatures(): EnabledFeatures {
-  return {
-    apiCount: 0,
-    schemaExtension: false,
-    customJSONScalars: false,
-    customIntScalars: false,
-    customFloatScalars: false,
-  };
+function createBaseExperimentalConfiguration(): ExperimentalConfiguration {
+  return { orm: false };
 }
 
-export const EnabledFeatures = {
-  encode(message: EnabledFeatures, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.apiCount !== 0) {
-      writer.uint32(8).int32(message.apiCount);
+export const ExperimentalConfiguration = {
+  encode(message: ExperimentalConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.orm === true) {
+      writer.uint32(8).bool(message.orm);
     }
-    if (message.schemaExtension === true) {
-      writer.uint32(16).bool(message.schemaExtension);
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ExperimentalConfiguration {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseExperimentalConfiguration();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.orm = reader.bool();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
     }
-    if (message.customJSONScalars === true) {
-      writer.uint32(24).bool(message.customJSONScalars);
+    return message;
+  },
+
+  fromJSON(object: any): ExperimentalConfiguration {
+    return { orm: isSet(object.orm) ? Boolean(object.orm) : false };
+  },
+
+  toJSON(message: ExperimentalConfiguration): unknown {
+    const obj: any = {};
+    if (message.orm === true) {
+      obj.orm = message.orm;
     }
-    if (message.customIntScalars === true) {
-      writer.uint32(32).bool(message.customIntScalars);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ExperimentalConfiguration>, I>>(base?: I): ExperimentalConfiguration {
+    return ExperimentalConfiguration.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ExperimentalConfiguration>, I>>(object: I): ExperimentalConfiguration {
+    const message = createBaseExperimentalConfiguration();
+    message.orm = object.orm ?? false;
+    return message;
+  },
+};
+
+function createBaseListenerOptions(): ListenerOptions {
+  return { host: undefined, port: undefined };
+}
+
+export const ListenerOptions = {
+  encode(message: ListenerOptions, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.host !== undefined) {
+      ConfigurationVariable.encode(message.host, writer.uint32(10).fork()).ldelim();
     }
-    if (message.customFloatScalars === true) {
-      writer.uint32(40).bool(message.customFloatScalars);
+    if (message.port !== undefined) {
+      ConfigurationVariable.encode(message.port, writer.uint32(18).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): EnabledFeatures {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListenerOptions {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseEnabledFeatures();
+    const message = createBaseListenerOptions();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.apiCount = reader.int32();
+          message.host = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
         case 2:
-          if (tag !== 16) {
-            break;
-          }
-
-          message.schemaExtension = reader.bool();
-          continue;
-        case 3:
-          if (tag !== 24) {
-            break;
-          }
-
-          message.customJSONScalars = reader.bool();
-          continue;
-        case 4:
-          if (tag !== 32) {
-            break;
-          }
-
-          message.customIntScalars = reader.bool();
-          continue;
-        case 5:
-          if (tag !== 40) {
+          if (tag !== 18) {
             break;
           }
 
-          message.customFloatScalars = reader.bool();
+          message.port = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -7423,86 +10702,65 @@ export const EnabledFeatures = {
     return message;
   },
 
-  fromJSON(object: any): EnabledFeatures {
+  fromJSON(object: any): ListenerOptions {
     return {
-      apiCount: isSet(object.apiCount) ? Number(object.apiCount) : 0,
-      schemaExtension: isSet(object.schemaExtension) ? Boolean(object.schemaExtension) : false,
-      customJSONScalars: isSet(object.customJSONScalars) ? Boolean(object.customJSONScalars) : false,
-      customIntScalars: isSet(object.customIntScalars) ? Boolean(object.customIntScalars) : false,
-      customFloatScalars: isSet(object.customFloatScalars) ? Boolean(object.customFloatScalars) : false,
+      host: isSet(object.host) ? ConfigurationVariable.fromJSON(object.host) : undefined,
+      port: isSet(object.port) ? ConfigurationVariable.fromJSON(object.port) : undefined,
     };
   },
 
-  toJSON(message: EnabledFeatures): unknown {
+  toJSON(message: ListenerOptions): unknown {
     const obj: any = {};
-    if (message.apiCount !== 0) {
-      obj.apiCount = Math.round(message.apiCount);
-    }
-    if (message.schemaExtension === true) {
-      obj.schemaExtension = message.schemaExtension;
-    }
-    if (message.customJSONScalars === true) {
-      obj.customJSONScalars = message.customJSONScalars;
-    }
-    if (message.customIntScalars === true) {
-      obj.customIntScalars = message.customIntScalars;
+    if (message.host !== undefined) {
+      obj.host = ConfigurationVariable.toJSON(message.host);
     }
-    if (message.customFloatScalars === true) {
-      obj.customFloatScalars = message.customFloatScalars;
+    if (message.port !== undefined) {
+      obj.port = ConfigurationVariable.toJSON(message.port);
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<EnabledFeatures>, I>>(base?: I): EnabledFeatures {
-    return EnabledFeatures.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<ListenerOptions>, I>>(base?: I): ListenerOptions {
+    return ListenerOptions.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<EnabledFeatures>, I>>(object: I): EnabledFeatures {
-    const message = createBaseEnabledFeatures();
-    message.apiCount = object.apiCount ?? 0;
-    message.schemaExtension = object.schemaExtension ?? false;
-    message.customJSONScalars = object.customJSONScalars ?? false;
-    message.customIntScalars = object.customIntScalars ?? false;
-    message.customFloatScalars = object.customFloatScalars ?? false;
+  fromPartial<I extends Exact<DeepPartial<ListenerOptions>, I>>(object: I): ListenerOptions {
+    const message = createBaseListenerOptions();
+    message.host = (object.host !== undefined && object.host !== null)
+      ? ConfigurationVariable.fromPartial(object.host)
+      : undefined;
+    message.port = (object.port !== undefined && object.port !== null)
+      ? ConfigurationVariable.fromPartial(object.port)
+      : undefined;
     return message;
   },
 };
 
-function createBaseS3UploadProfileHooksConfiguration(): S3UploadProfileHooksConfiguration {
-  return { preUpload: false, postUpload: false };
+function createBaseInternalListenerOptions(): InternalListenerOptions {
+  return { port: undefined };
 }
 
-export const S3UploadProfileHooksConfiguration = {
-  encode(message: S3UploadProfileHooksConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.preUpload === true) {
-      writer.uint32(8).bool(message.preUpload);
-    }
-    if (message.postUpload === true) {
-      writer.uint32(16).bool(message.postUpload);
+export const InternalListenerOptions = {
+  encode(message: InternalListenerOptions, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.port !== undefined) {
+      ConfigurationVariable.encode(message.port, writer.uint32(10).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): S3UploadProfileHooksConfiguration {
+  decode(input: _m0.Reader | Uint8Array, length?: number): InternalListenerOptions {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseS3UploadProfileHooksConfiguration();
+    const message = createBaseInternalListenerOptions();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 8) {
-            break;
-          }
-
-          message.preUpload = reader.bool();
-          continue;
-        case 2:
-          if (tag !== 16) {
+          if (tag !== 10) {
             break;
           }
 
-          message.postUpload = reader.bool();
+          message.port = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -7513,133 +10771,126 @@ export const S3UploadProfileHooksConfiguration = {
     return message;
   },
 
-  fromJSON(object: any): S3UploadProfileHooksConfiguration {
-    return {
-      preUpload: isSet(object.preUpload) ? Boolean(object.preUpload) : false,
-      postUpload: isSet(object.postUpload) ? Boolean(object.postUpload) : false,
-    };
+  fromJSON(object: any): InternalListenerOptions {
+    return { port: isSet(object.port) ? ConfigurationVariable.fromJSON(object.port) : undefined };
   },
 
-  toJSON(message: S3UploadProfileHooksConfiguration): unknown {
+  toJSON(message: InternalListenerOptions): unknown {
     const obj: any = {};
-    if (message.preUpload === true) {
-      obj.preUpload = message.preUpload;
-    }
-    if (message.postUpload === true) {
-      obj.postUpload = message.postUpload;
+    if (message.port !== undefined) {
+      obj.port = ConfigurationVariable.toJSON(message.port);
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<S3UploadProfileHooksConfiguration>, I>>(
-    base?: I,
-  ): S3UploadProfileHooksConfiguration {
-    return S3UploadProfileHooksConfiguration.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<InternalListenerOptions>, I>>(base?: I): InternalListenerOptions {
+    return InternalListenerOptions.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<S3UploadProfileHooksConfiguration>, I>>(
-    object: I,
-  ): S3UploadProfileHooksConfiguration {
-    const message = createBaseS3UploadProfileHooksConfiguration();
-    message.preUpload = object.preUpload ?? false;
-    message.postUpload = object.postUpload ?? false;
+  fromPartial<I extends Exact<DeepPartial<InternalListenerOptions>, I>>(object: I): InternalListenerOptions {
+    const message = createBaseInternalListenerOptions();
+    message.port = (object.port !== undefined && object.port !== null)
+      ? ConfigurationVariable.fromPartial(object.port)
+      : undefined;
     return message;
   },
 };
 
-function createBaseS3UploadProfile(): S3UploadProfile {
-  return {
-    requireAuthentication: false,
-    maxAllowedUploadSizeBytes: 0,
-    maxAllowedFiles: 0,
-    allowedMimeTypes: [],
-    allowedFileExtensions: [],
-    metadataJSONSchema: "",
-    hooks: undefined,
-  };
+function createBaseNodeLogging(): NodeLogging {
+  return { level: undefined };
 }
 
-export const S3UploadProfile = {
-  encode(message: S3UploadProfile, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.requireAuthentication === true) {
-      writer.uint32(8).bool(message.requireAuthentication);
-    }
-    if (message.maxAllowedUploadSizeBytes !== 0) {
-      writer.uint32(16).int32(message.maxAllowedUploadSizeBytes);
-    }
-    if (message.maxAllowedFiles !== 0) {
-      writer.uint32(24).int32(message.maxAllowedFiles);
-    }
-    for (const v of message.allowedMimeTypes) {
-      writer.uint32(34).string(v!);
-    }
-    for (const v of message.allowedFileExtensions) {
-      writer.uint32(42).string(v!);
-    }
-    if (message.metadataJSONSchema !== "") {
-      writer.uint32(50).string(message.metadataJSONSchema);
-    }
-    if (message.hooks !== undefined) {
-      S3UploadProfileHooksConfiguration.encode(message.hooks, writer.uint32(58).fork()).ldelim();
+export const NodeLogging = {
+  encode(message: NodeLogging, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.level !== undefined) {
+      ConfigurationVariable.encode(message.level, writer.uint32(10).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): S3UploadProfile {
+  decode(input: _m0.Reader | Uint8Array, length?: number): NodeLogging {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseS3UploadProfile();
+    const message = createBaseNodeLogging();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.requireAuthentication = reader.bool();
+          message.level = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
-        case 2:
-          if (tag !== 16) {
-            break;
-          }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
 
-          message.maxAllowedUploadSizeBytes = reader.int32();
-          continue;
-        case 3:
-          if (tag !== 24) {
-            break;
-          }
+  fromJSON(object: any): NodeLogging {
+    return { level: isSet(object.level) ? ConfigurationVariable.fromJSON(object.level) : undefined };
+  },
 
-          message.maxAllowedFiles = reader.int32();
-          continue;
-        case 4:
-          if (tag !== 34) {
-            break;
-          }
+  toJSON(message: NodeLogging): unknown {
+    const obj: any = {};
+    if (message.level !== undefined) {
+      obj.level = ConfigurationVariable.toJSON(message.level);
+    }
+    return obj;
+  },
 
-          message.allowedMimeTypes.push(reader.string());
-          continue;
-        case 5:
-          if (tag !== 42) {
-            break;
-          }
+  create<I extends Exact<DeepPartial<NodeLogging>, I>>(base?: I): NodeLogging {
+    return NodeLogging.fromPartial(base ?? {});
+  },
 
-          message.allowedFileExtensions.push(reader.string());
-          continue;
-        case 6:
-          if (tag !== 50) {
+  fromPartial<I extends Exact<DeepPartial<NodeLogging>, I>>(object: I): NodeLogging {
+    const message = createBaseNodeLogging();
+    message.level = (object.level !== undefined && object.level !== null)
+      ? ConfigurationVariable.fromPartial(object.level)
+      : undefined;
+    return message;
+  },
+};
+
+function createBasePrometheusOptions(): PrometheusOptions {
+  return { enabled: undefined, port: undefined };
+}
+
+export const PrometheusOptions = {
+  encode(message: PrometheusOptions, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.enabled !== undefined) {
+      ConfigurationVariable.encode(message.enabled, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.port !== undefined) {
+      ConfigurationVariable.encode(message.port, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PrometheusOptions {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePrometheusOptions();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
             break;
           }
 
-          message.metadataJSONSchema = reader.string();
+          message.enabled = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
-        case 7:
-          if (tag !== 58) {
+        case 2:
+          if (tag !== 18) {
             break;
           }
 
-          message.hooks = S3UploadProfileHooksConfiguration.decode(reader, reader.uint32());
+          message.port = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -7650,113 +10901,98 @@ export const S3UploadProfile = {
     return message;
   },
 
-  fromJSON(object: any): S3UploadProfile {
+  fromJSON(object: any): PrometheusOptions {
     return {
-      requireAuthentication: isSet(object.requireAuthentication) ? Boolean(object.requireAuthentication) : false,
-      maxAllowedUploadSizeBytes: isSet(object.maxAllowedUploadSizeBytes) ? Number(object.maxAllowedUploadSizeBytes) : 0,
-      maxAllowedFiles: isSet(object.maxAllowedFiles) ? Number(object.maxAllowedFiles) : 0,
-      allowedMimeTypes: Array.isArray(object?.allowedMimeTypes)
-        ? object.allowedMimeTypes.map((e: any) => String(e))
-        : [],
-      allowedFileExtensions: Array.isArray(object?.allowedFileExtensions)
-        ? object.allowedFileExtensions.map((e: any) => String(e))
-        : [],
-      metadataJSONSchema: isSet(object.metadataJSONSchema) ? String(object.metadataJSONSchema) : "",
-      hooks: isSet(object.hooks) ? S3UploadProfileHooksConfiguration.fromJSON(object.hooks) : undefined,
+      enabled: isSet(object.enabled) ? ConfigurationVariable.fromJSON(object.enabled) : undefined,
+      port: isSet(object.port) ? ConfigurationVariable.fromJSON(object.port) : undefined,
     };
   },
 
-  toJSON(message: S3UploadProfile): unknown {
+  toJSON(message: PrometheusOptions): unknown {
     const obj: any = {};
-    if (message.requireAuthentication === true) {
-      obj.requireAuthentication = message.requireAuthentication;
-    }
-    if (message.maxAllowedUploadSizeBytes !== 0) {
-      obj.maxAllowedUploadSizeBytes = Math.round(message.maxAllowedUploadSizeBytes);
-    }
-    if (message.maxAllowedFiles !== 0) {
-      obj.maxAllowedFiles = Math.round(message.maxAllowedFiles);
-    }
-    if (message.allowedMimeTypes?.length) {
-      obj.allowedMimeTypes = message.allowedMimeTypes;
-    }
-    if (message.allowedFileExtensions?.length) {
-      obj.allowedFileExtensions = message.allowedFileExtensions;
-    }
-    if (message.metadataJSONSchema !== "") {
-      obj.metadataJSONSchema = message.metadataJSONSchema;
+    if (message.enabled !== undefined) {
+      obj.enabled = ConfigurationVariable.toJSON(message.enabled);
     }
-    if (message.hooks !== undefined) {
-      obj.hooks = S3UploadProfileHooksConfiguration.toJSON(message.hooks);
+    if (message.port !== undefined) {
+      obj.port = ConfigurationVariable.toJSON(message.port);
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<S3UploadProfile>, I>>(base?: I): S3UploadProfile {
-    return S3UploadProfile.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<PrometheusOptions>, I>>(base?: I): PrometheusOptions {
+    return PrometheusOptions.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<S3UploadProfile>, I>>(object: I): S3UploadProfile {
-    const message = createBaseS3UploadProfile();
-    message.requireAuthentication = object.requireAuthentication ?? false;
-    message.maxAllowedUploadSizeBytes = object.maxAllowedUploadSizeBytes ?? 0;
-    message.maxAllowedFiles = object.maxAllowedFiles ?? 0;
-    message.allowedMimeTypes = object.allowedMimeTypes?.map((e) => e) || [];
-    message.allowedFileExtensions = object.allowedFileExtensions?.map((e) => e) || [];
-    message.metadataJSONSchema = object.metadataJSONSchema ?? "";
-    message.hooks = (object.hooks !== undefined && object.hooks !== null)
-      ? S3UploadProfileHooksConfiguration.fromPartial(object.hooks)
+  fromPartial<I extends Exact<DeepPartial<PrometheusOptions>, I>>(object: I): PrometheusOptions {
+    const message = createBasePrometheusOptions();
+    message.enabled = (object.enabled !== undefined && object.enabled !== null)
+      ? ConfigurationVariable.fromPartial(object.enabled)
+      : undefined;
+    message.port = (object.port !== undefined && object.port !== null)
+      ? ConfigurationVariable.fromPartial(object.port)
       : undefined;
     return message;
   },
 };
 
-function createBaseS3UploadConfiguration(): S3UploadConfiguration {
+function createBaseNodeOptions(): NodeOptions {
   return {
-    name: "",
-    endpoint: undefined,
-    accessKeyID: undefined,
-    secretAccessKey: undefined,
-    bucketName: undefined,
-    bucketLocation: undefined,
-    useSSL: false,
-    uploadProfiles: {},
+    nodeUrl: undefined,
+    publicNodeUrl: undefined,
+    listen: undefined,
+    logger: undefined,
+    defaultRequestTimeoutSeconds: 0,
+    listenInternal: undefined,
+    nodeInternalUrl: undefined,
+    defaultHttpProxyUrl: undefined,
+    openTelemetry: undefined,
+    prometheus: undefined,
+    webhookQueue: undefined,
   };
 }
 
-export const S3UploadConfiguration = {
-  encode(message: S3UploadConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.name !== "") {
-      writer.uint32(10).string(message.name);
+export const NodeOptions = {
+  encode(message: NodeOptions, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.nodeUrl !== undefined) {
+      ConfigurationVariable.encode(message.nodeUrl, writer.uint32(10).fork()).ldelim();
     }
-    if (message.endpoint !== undefined) {
-      ConfigurationVariable.encode(message.endpoint, writer.uint32(18).fork()).ldelim();
+    if (message.publicNodeUrl !== undefined) {
+      ConfigurationVariable.encode(message.publicNodeUrl, writer.uint32(34).fork()).ldelim();
     }
-    if (message.accessKeyID !== undefined) {
-      ConfigurationVariable.encode(message.accessKeyID, writer.uint32(26).fork()).ldelim();
+    if (message.listen !== undefined) {
+      ListenerOptions.encode(message.listen, writer.uint32(18).fork()).ldelim();
     }
-    if (message.secretAccessKey !== undefined) {
-      ConfigurationVariable.encode(message.secretAccessKey, writer.uint32(34).fork()).ldelim();
+    if (message.logger !== undefined) {
+      NodeLogging.encode(message.logger, writer.uint32(26).fork()).ldelim();
     }
-    if (message.bucketName !== undefined) {
-      ConfigurationVariable.encode(message.bucketName, writer.uint32(42).fork()).ldelim();
+    if (message.defaultRequestTimeoutSeconds !== 0) {
+      writer.uint32(40).int64(message.defaultRequestTimeoutSeconds);
     }
-    if (message.bucketLocation !== undefined) {
-      ConfigurationVariable.encode(message.bucketLocation, writer.uint32(50).fork()).ldelim();
+    if (message.listenInternal !== undefined) {
+      InternalListenerOptions.encode(message.listenInternal, writer.uint32(50).fork()).ldelim();
     }
-    if (message.useSSL === true) {
-      writer.uint32(56).bool(message.useSSL);
+    if (message.nodeInternalUrl !== undefined) {
+      ConfigurationVariable.encode(message.nodeInternalUrl, writer.uint32(58).fork()).ldelim();
+    }
+    if (message.defaultHttpProxyUrl !== undefined) {
+      ConfigurationVariable.encode(message.defaultHttpProxyUrl, writer.uint32(66).fork()).ldelim();
+    }
+    if (message.openTelemetry !== undefined) {
+      TelemetryOptions.encode(message.openTelemetry, writer.uint32(74).fork()).ldelim();
+    }
+    if (message.prometheus !== undefined) {
+      PrometheusOptions.encode(message.prometheus, writer.uint32(82).fork()).ldelim();
+    }
+    if (message.webhookQueue !== undefined) {
+      WebhookQueueOptions.encode(message.webhookQueue, writer.uint32(90).fork()).ldelim();
     }
-    Object.entries(message.uploadProfiles).forEach(([key, value]) => {
-      S3UploadConfiguration_UploadProfilesEntry.encode({ key: key as any, value }, writer.uint32(66).fork()).ldelim();
-    });
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): S3UploadConfiguration {
+  decode(input: _m0.Reader | Uint8Array, length?: number): NodeOptions {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseS3UploadConfiguration();
+    const message = createBaseNodeOptions();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -7765,59 +11001,77 @@ export const S3UploadConfiguration = {
             break;
           }
 
-          message.name = reader.string();
+          message.nodeUrl = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
-        case 2:
-          if (tag !== 18) {
+        case 4:
+          if (tag !== 34) {
             break;
           }
 
-          message.endpoint = ConfigurationVariable.decode(reader, reader.uint32());
+          message.publicNodeUrl = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
-        case 3:
-          if (tag !== 26) {
+        case 2:
+          if (tag !== 18) {
             break;
           }
 
-          message.accessKeyID = ConfigurationVariable.decode(reader, reader.uint32());
+          message.listen = ListenerOptions.decode(reader, reader.uint32());
           continue;
-        case 4:
-          if (tag !== 34) {
+        case 3:
+          if (tag !== 26) {
             break;
           }
 
-          message.secretAccessKey = ConfigurationVariable.decode(reader, reader.uint32());
+          message.logger = NodeLogging.decode(reader, reader.uint32());
           continue;
         case 5:
-          if (tag !== 42) {
+          if (tag !== 40) {
             break;
           }
 
-          message.bucketName = ConfigurationVariable.decode(reader, reader.uint32());
+          message.defaultRequestTimeoutSeconds = longToNumber(reader.int64() as Long);
           continue;
         case 6:
           if (tag !== 50) {
             break;
           }
 
-          message.bucketLocation = ConfigurationVariable.decode(reader, reader.uint32());
+          message.listenInternal = InternalListenerOptions.decode(reader, reader.uint32());
           continue;
         case 7:
-          if (tag !== 56) {
+          if (tag !== 58) {
             break;
           }
 
-          message.useSSL = reader.bool();
+          message.nodeInternalUrl = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
         case 8:
           if (tag !== 66) {
             break;
           }
 
-          const entry8 = S3UploadConfiguration_UploadProfilesEntry.decode(reader, reader.uint32());
-          if (entry8.value !== undefined) {
-            message.uploadProfiles[entry8.key] = entry8.value;
+          message.defaultHttpProxyUrl = ConfigurationVariable.decode(reader, reader.uint32());
+          continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
+
+          message.openTelemetry = TelemetryOptions.decode(reader, reader.uint32());
+          continue;
+        case 10:
+          if (tag !== 82) {
+            break;
+          }
+
+          message.prometheus = PrometheusOptions.decode(reader, reader.uint32());
+          continue;
+        case 11:
+          if (tag !== 90) {
+            break;
           }
+
+          message.webhookQueue = WebhookQueueOptions.decode(reader, reader.uint32());
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -7828,132 +11082,164 @@ export const S3UploadConfiguration = {
     return message;
   },
 
-  fromJSON(object: any): S3UploadConfiguration {
+  fromJSON(object: any): NodeOptions {
     return {
-      name: isSet(object.name) ? String(object.name) : "",
-      endpoint: isSet(object.endpoint) ? ConfigurationVariable.fromJSON(object.endpoint) : undefined,
-      accessKeyID: isSet(object.accessKeyID) ? ConfigurationVariable.fromJSON(object.accessKeyID) : undefined,
-      secretAccessKey: isSet(object.secretAccessKey)
-        ? ConfigurationVariable.fromJSON(object.secretAccessKey)
+      nodeUrl: isSet(object.nodeUrl) ? ConfigurationVariable.fromJSON(object.nodeUrl) : undefined,
+      publicNodeUrl: isSet(object.publicNodeUrl) ? ConfigurationVariable.fromJSON(object.publicNodeUrl) : undefined,
+      listen: isSet(object.listen) ? ListenerOptions.fromJSON(object.listen) : undefined,
+      logger: isSet(object.logger) ? NodeLogging.fromJSON(object.logger) : undefined,
+      defaultRequestTimeoutSeconds: isSet(object.defaultRequestTimeoutSeconds)
+        ? Number(object.defaultRequestTimeoutSeconds)
+        : 0,
+      listenInternal: isSet(object.listenInternal)
+        ? InternalListenerOptions.fromJSON(object.listenInternal)
         : undefined,
-      bucketName: isSet(object.bucketName) ? ConfigurationVariable.fromJSON(object.bucketName) : undefined,
-      bucketLocation: isSet(object.bucketLocation) ? ConfigurationVariable.fromJSON(object.bucketLocation) : undefined,
-      useSSL: isSet(object.useSSL) ? Boolean(object.useSSL) : false,
-      uploadProfiles: isObject(object.uploadProfiles)
-        ? Object.entries(object.uploadProfiles).reduce<{ [key: string]: S3UploadProfile }>((acc, [key, value]) => {
-          acc[key] = S3UploadProfile.fromJSON(value);
-          return acc;
-        }, {})
-        : {},
+      nodeInternalUrl: isSet(object.nodeInternalUrl)
+        ? ConfigurationVariable.fromJSON(object.nodeInternalUrl)
+        : undefined,
+      defaultHttpProxyUrl: isSet(object.defaultHttpProxyUrl)
+        ? ConfigurationVariable.fromJSON(object.defaultHttpProxyUrl)
+        : undefined,
+      openTelemetry: isSet(object.openTelemetry) ? TelemetryOptions.fromJSON(object.openTelemetry) : undefined,
+      prometheus: isSet(object.prometheus) ? PrometheusOptions.fromJSON(object.prometheus) : undefined,
+      webhookQueue: isSet(object.webhookQueue) ? WebhookQueueOptions.fromJSON(object.webhookQueue) : undefined,
     };
   },
 
-  toJSON(message: S3UploadConfiguration): unknown {
+  toJSON(message: NodeOptions): unknown {
     const obj: any = {};
-    if (message.name !== "") {
-      obj.name = message.name;
+    if (message.nodeUrl !== undefined) {
+      obj.nodeUrl = ConfigurationVariable.toJSON(message.nodeUrl);
     }
-    if (message.endpoint !== undefined) {
-      obj.endpoint = ConfigurationVariable.toJSON(message.endpoint);
+    if (message.publicNodeUrl !== undefined) {
+      obj.publicNodeUrl = ConfigurationVariable.toJSON(message.publicNodeUrl);
     }
-    if (message.accessKeyID !== undefined) {
-      obj.accessKeyID = ConfigurationVariable.toJSON(message.accessKeyID);
+    if (message.listen !== undefined) {
+      obj.listen = ListenerOptions.toJSON(message.listen);
     }
-    if (message.secretAccessKey !== undefined) {
-      obj.secretAccessKey = ConfigurationVariable.toJSON(message.secretAccessKey);
+    if (message.logger !== undefined) {
+      obj.logger = NodeLogging.toJSON(message.logger);
+    }
+    if (message.defaultRequestTimeoutSeconds !== 0) {
+      obj.defaultRequestTimeoutSeconds = Math.round(message.defaultRequestTimeoutSeconds);
+    }
+    if (message.listenInternal !== undefined) {
+      obj.listenInternal = InternalListenerOptions.toJSON(message.listenInternal);
     }
-    if (message.bucketName !== undefined) {
-      obj.bucketName = ConfigurationVariable.toJSON(message.bucketName);
+    if (message.nodeInternalUrl !== undefined) {
+      obj.nodeInternalUrl = ConfigurationVariable.toJSON(message.nodeInternalUrl);
     }
-    if (message.bucketLocation !== undefined) {
-      obj.bucketLocation = ConfigurationVariable.toJSON(message.bucketLocation);
+    if (message.defaultHttpProxyUrl !== undefined) {
+      obj.defaultHttpProxyUrl = ConfigurationVariable.toJSON(message.defaultHttpProxyUrl);
     }
-    if (message.useSSL === true) {
-      obj.useSSL = message.useSSL;
+    if (message.openTelemetry !== undefined) {
+      obj.openTelemetry = TelemetryOptions.toJSON(message.openTelemetry);
     }
-    if (message.uploadProfiles) {
-      const entries = Object.entries(message.uploadProfiles);
-      if (entries.length > 0) {
-        obj.uploadProfiles = {};
-        entries.forEach(([k, v]) => {
-          obj.uploadProfiles[k] = S3UploadProfile.toJSON(v);
-        });
-      }
+    if (message.prometheus !== undefined) {
+      obj.prometheus = PrometheusOptions.toJSON(message.prometheus);
+    }
+    if (message.webhookQueue !== undefined) {
+      obj.webhookQueue = WebhookQueueOptions.toJSON(message.webhookQueue);
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<S3UploadConfiguration>, I>>(base?: I): S3UploadConfiguration {
-    return S3UploadConfiguration.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<NodeOptions>, I>>(base?: I): NodeOptions {
+    return NodeOptions.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<S3UploadConfiguration>, I>>(object: I): S3UploadConfiguration {
-    const message = createBaseS3UploadConfiguration();
-    message.name = object.name ?? "";
-    message.endpoint = (object.endpoint !== undefined && object.endpoint !== null)
-      ? ConfigurationVariable.fromPartial(object.endpoint)
+  fromPartial<I extends Exact<DeepPartial<NodeOptions>, I>>(object: I): NodeOptions {
+    const message = createBaseNodeOptions();
+    message.nodeUrl = (object.nodeUrl !== undefined && object.nodeUrl !== null)
+      ? ConfigurationVariable.fromPartial(object.nodeUrl)
       : undefined;
-    message.accessKeyID = (object.accessKeyID !== undefined && object.accessKeyID !== null)
-      ? ConfigurationVariable.fromPartial(object.accessKeyID)
+    message.publicNodeUrl = (object.publicNodeUrl !== undefined && object.publicNodeUrl !== null)
+      ? ConfigurationVariable.fromPartial(object.publicNodeUrl)
       : undefined;
-    message.secretAccessKey = (object.secretAccessKey !== undefined && object.secretAccessKey !== null)
-      ? ConfigurationVariable.fromPartial(object.secretAccessKey)
+    message.listen = (object.listen !== undefined && object.listen !== null)
+      ? ListenerOptions.fromPartial(object.listen)
       : undefined;
-    message.bucketName = (object.bucketName !== undefined && object.bucketName !== null)
-      ? ConfigurationVariable.fromPartial(object.bucketName)
+    message.logger = (object.logger !== undefined && object.logger !== null)
+      ? NodeLogging.fromPartial(object.logger)
       : undefined;
-    message.bucketLocation = (object.bucketLocation !== undefined && object.bucketLocation !== null)
-      ? ConfigurationVariable.fromPartial(object.bucketLocation)
+    message.defaultRequestTimeoutSeconds = object.defaultRequestTimeoutSeconds ?? 0;
+    message.listenInternal = (object.listenInternal !== undefined && object.listenInternal !== null)
+      ? InternalListenerOptions.fromPartial(object.listenInternal)
+      : undefined;
+    message.nodeInternalUrl = (object.nodeInternalUrl !== undefined && object.nodeInternalUrl !== null)
+      ? ConfigurationVariable.fromPartial(object.nodeInternalUrl)
+      : undefined;
+    message.defaultHttpProxyUrl = (object.defaultHttpProxyUrl !== undefined && object.defaultHttpProxyUrl !== null)
+      ? ConfigurationVariable.fromPartial(object.defaultHttpProxyUrl)
+      : undefined;
+    message.openTelemetry = (object.openTelemetry !== undefined && object.openTelemetry !== null)
+      ? TelemetryOptions.fromPartial(object.openTelemetry)
+      : undefined;
+    message.prometheus = (object.prometheus !== undefined && object.prometheus !== null)
+      ? PrometheusOptions.fromPartial(object.prometheus)
+      : undefined;
+    message.webhookQueue = (object.webhookQueue !== undefined && object.webhookQueue !== null)
+      ? WebhookQueueOptions.fromPartial(object.webhookQueue)
       : undefined;
-    message.useSSL = object.useSSL ?? false;
-    message.uploadProfiles = Object.entries(object.uploadProfiles ?? {}).reduce<{ [key: string]: S3UploadProfile }>(
-      (acc, [key, value]) => {
-        if (value !== undefined) {
-          acc[key] = S3UploadProfile.fromPartial(value);
-        }
-        return acc;
-      },
-      {},
-    );
     return message;
   },
 };
 
-function createBaseS3UploadConfiguration_UploadProfilesEntry(): S3UploadConfiguration_UploadProfilesEntry {
-  return { key: "", value: undefined };
+function createBaseWebhookQueueOptions(): WebhookQueueOptions {
+  return { kind: 0, directory: undefined, natsUrl: undefined, natsToken: undefined };
 }
 
-export const S3UploadConfiguration_UploadProfilesEntry = {
-  encode(message: S3UploadConfiguration_UploadProfilesEntry, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.key !== "") {
-      writer.uint32(10).string(message.key);
+export const WebhookQueueOptions = {
+  encode(message: WebhookQueueOptions, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.kind !== 0) {
+      writer.uint32(8).int32(message.kind);
     }
-    if (message.value !== undefined) {
-      S3UploadProfile.encode(message.value, writer.uint32(18).fork()).ldelim();
+    if (message.directory !== undefined) {
+      ConfigurationVariable.encode(message.directory, writer.uint32(18).fork()).ldelim();
+    }
+    if (message.natsUrl !== undefined) {
+      ConfigurationVariable.encode(message.natsUrl, writer.uint32(26).fork()).ldelim();
+    }
+    if (message.natsToken !== undefined) {
+      ConfigurationVariable.encode(message.natsToken, writer.uint32(34).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): S3UploadConfiguration_UploadProfilesEntry {
+  decode(input: _m0.Reader | Uint8Array, length?: number): WebhookQueueOptions {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseS3UploadConfiguration_UploadProfilesEntry();
+    const message = createBaseWebhookQueueOptions();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 10) {
+          if (tag !== 8) {
             break;
           }
 
-          message.key = reader.string();
+          message.kind = reader.int32() as any;
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.value = S3UploadProfile.decode(reader, reader.uint32());
+          message.directory = ConfigurationVariable.decode(reader, reader.uint32());
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.natsUrl = ConfigurationVariable.decode(reader, reader.uint32());
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.natsToken = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -7964,190 +11250,107 @@ export const S3UploadConfiguration_UploadProfilesEntry = {
     return message;
   },
 
-  fromJSON(object: any): S3UploadConfiguration_UploadProfilesEntry {
+  fromJSON(object: any): WebhookQueueOptions {
     return {
-      key: isSet(object.key) ? String(object.key) : "",
-      value: isSet(object.value) ? S3UploadProfile.fromJSON(object.value) : undefined,
+      kind: isSet(object.kind) ? webhookQueueKindFromJSON(object.kind) : 0,
+      directory: isSet(object.directory) ? ConfigurationVariable.fromJSON(object.directory) : undefined,
+      natsUrl: isSet(object.natsUrl) ? ConfigurationVariable.fromJSON(object.natsUrl) : undefined,
+      natsToken: isSet(object.natsToken) ? ConfigurationVariable.fromJSON(object.natsToken) : undefined,
     };
   },
 
-  toJSON(message: S3UploadConfiguration_UploadProfilesEntry): unknown {
+  toJSON(message: WebhookQueueOptions): unknown {
     const obj: any = {};
-    if (message.key !== "") {
-      obj.key = message.key;
+    if (message.kind !== 0) {
+      obj.kind = webhookQueueKindToJSON(message.kind);
     }
-    if (message.value !== undefined) {
-      obj.value = S3UploadProfile.toJSON(message.value);
+    if (message.directory !== undefined) {
+      obj.directory = ConfigurationVariable.toJSON(message.directory);
+    }
+    if (message.natsUrl !== undefined) {
+      obj.natsUrl = ConfigurationVariable.toJSON(message.natsUrl);
+    }
+    if (message.natsToken !== undefined) {
+      obj.natsToken = ConfigurationVariable.toJSON(message.natsToken);
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<S3UploadConfiguration_UploadProfilesEntry>, I>>(
-    base?: I,
-  ): S3UploadConfiguration_UploadProfilesEntry {
-    return S3UploadConfiguration_UploadProfilesEntry.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<WebhookQueueOptions>, I>>(base?: I): WebhookQueueOptions {
+    return WebhookQueueOptions.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<S3UploadConfiguration_UploadProfilesEntry>, I>>(
-    object: I,
-  ): S3UploadConfiguration_UploadProfilesEntry {
-    const message = createBaseS3UploadConfiguration_UploadProfilesEntry();
-    message.key = object.key ?? "";
-    message.value = (object.value !== undefined && object.value !== null)
-      ? S3UploadProfile.fromPartial(object.value)
+  fromPartial<I extends Exact<DeepPartial<WebhookQueueOptions>, I>>(object: I): WebhookQueueOptions {
+    const message = createBaseWebhookQueueOptions();
+    message.kind = object.kind ?? 0;
+    message.directory = (object.directory !== undefined && object.directory !== null)
+      ? ConfigurationVariable.fromPartial(object.directory)
+      : undefined;
+    message.natsUrl = (object.natsUrl !== undefined && object.natsUrl !== null)
+      ? ConfigurationVariable.fromPartial(object.natsUrl)
+      : undefined;
+    message.natsToken = (object.natsToken !== undefined && object.natsToken !== null)
+      ? ConfigurationVariable.fromPartial(object.natsToken)
       : undefined;
     return message;
   },
 };
 
-function createBaseUserDefinedApi(): UserDefinedApi {
-  return {
-    engineConfiguration: undefined,
-    enableGraphqlEndpoint: false,
-    operations: [],
-    invalidOperationNames: [],
-    corsConfiguration: undefined,
-    authenticationConfig: undefined,
-    s3UploadConfiguration: [],
-    allowedHostNames: [],
-    webhooks: [],
-    serverOptions: undefined,
-    nodeOptions: undefined,
-    experimentalConfig: undefined,
-  };
+function createBaseTelemetryOptions(): TelemetryOptions {
+  return { enabled: undefined, exporterHttpEndpoint: undefined, sampler: undefined, authToken: undefined };
 }
 
-export const UserDefinedApi = {
-  encode(message: UserDefinedApi, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.engineConfiguration !== undefined) {
-      EngineConfiguration.encode(message.engineConfiguration, writer.uint32(26).fork()).ldelim();
-    }
-    if (message.enableGraphqlEndpoint === true) {
-      writer.uint32(40).bool(message.enableGraphqlEndpoint);
-    }
-    for (const v of message.operations) {
-      Operation.encode(v!, writer.uint32(50).fork()).ldelim();
-    }
-    for (const v of message.invalidOperationNames) {
-      writer.uint32(130).string(v!);
-    }
-    if (message.corsConfiguration !== undefined) {
-      CorsConfiguration.encode(message.corsConfiguration, writer.uint32(58).fork()).ldelim();
-    }
-    if (message.authenticationConfig !== undefined) {
-      ApiAuthenticationConfig.encode(message.authenticationConfig, writer.uint32(66).fork()).ldelim();
-    }
-    for (const v of message.s3UploadConfiguration) {
-      S3UploadConfiguration.encode(v!, writer.uint32(74).fork()).ldelim();
-    }
-    for (const v of message.allowedHostNames) {
-      ConfigurationVariable.encode(v!, writer.uint32(90).fork()).ldelim();
-    }
-    for (const v of message.webhooks) {
-      WebhookConfiguration.encode(v!, writer.uint32(98).fork()).ldelim();
-    }
-    if (message.serverOptions !== undefined) {
-      ServerOptions.encode(message.serverOptions, writer.uint32(114).fork()).ldelim();
-    }
-    if (message.nodeOptions !== undefined) {
-      NodeOptions.encode(message.nodeOptions, writer.uint32(122).fork()).ldelim();
+export const TelemetryOptions = {
+  encode(message: TelemetryOptions, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.enabled !== undefined) {
+      ConfigurationVariable.encode(message.enabled, writer.uint32(10).fork()).ldelim();
     }
-    if (message.experimentalConfig !== undefined) {
-      ExperimentalConfiguration.encode(message.experimentalConfig, writer.uint32(138).fork()).ldelim();
+    if (message.exporterHttpEndpoint !== undefined) {
+      ConfigurationVariable.encode(message.exporterHttpEndpoint, writer.uint32(18).fork()).ldelim();
     }
-    return writer;
-  },
-
-  decode(input: _m0.Reader | Uint8Array, length?: number): UserDefinedApi {
-    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
-    let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseUserDefinedApi();
-    while (reader.pos < end) {
-      const tag = reader.uint32();
-      switch (tag >>> 3) {
-        case 3:
-          if (tag !== 26) {
-            break;
-          }
-
-          message.engineConfiguration = EngineConfiguration.decode(reader, reader.uint32());
-          continue;
-        case 5:
-          if (tag !== 40) {
-            break;
-          }
-
-          message.enableGraphqlEndpoint = reader.bool();
-          continue;
-        case 6:
-          if (tag !== 50) {
-            break;
-          }
-
-          message.operations.push(Operation.decode(reader, reader.uint32()));
-          continue;
-        case 16:
-          if (tag !== 130) {
-            break;
-          }
-
-          message.invalidOperationNames.push(reader.string());
-          continue;
-        case 7:
-          if (tag !== 58) {
-            break;
-          }
-
-          message.corsConfiguration = CorsConfiguration.decode(reader, reader.uint32());
-          continue;
-        case 8:
-          if (tag !== 66) {
-            break;
-          }
-
-          message.authenticationConfig = ApiAuthenticationConfig.decode(reader, reader.uint32());
-          continue;
-        case 9:
-          if (tag !== 74) {
-            break;
-          }
-
-          message.s3UploadConfiguration.push(S3UploadConfiguration.decode(reader, reader.uint32()));
-          continue;
-        case 11:
-          if (tag !== 90) {
-            break;
-          }
+    if (message.sampler !== undefined) {
+      ConfigurationVariable.encode(message.sampler, writer.uint32(26).fork()).ldelim();
+    }
+    if (message.authToken !== undefined) {
+      ConfigurationVariable.encode(message.authToken, writer.uint32(34).fork()).ldelim();
+    }
+    return writer;
+  },
 
-          message.allowedHostNames.push(ConfigurationVariable.decode(reader, reader.uint32()));
-          continue;
-        case 12:
-          if (tag !== 98) {
+  decode(input: _m0.Reader | Uint8Array, length?: number): TelemetryOptions {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTelemetryOptions();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
             break;
           }
 
-          message.webhooks.push(WebhookConfiguration.decode(reader, reader.uint32()));
+          message.enabled = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
-        case 14:
-          if (tag !== 114) {
+        case 2:
+          if (tag !== 18) {
             break;
           }
 
-          message.serverOptions = ServerOptions.decode(reader, reader.uint32());
+          message.exporterHttpEndpoint = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
-        case 15:
-          if (tag !== 122) {
+        case 3:
+          if (tag !== 26) {
             break;
           }
 
-          message.nodeOptions = NodeOptions.decode(reader, reader.uint32());
+          message.sampler = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
-        case 17:
-          if (tag !== 138) {
+        case 4:
+          if (tag !== 34) {
             break;
           }
 
-          message.experimentalConfig = ExperimentalConfiguration.decode(reader, reader.uint32());
+          message.authToken = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -8158,140 +11361,81 @@ export const UserDefinedApi = {
     return message;
   },
 
-  fromJSON(object: any): UserDefinedApi {
+  fromJSON(object: any): TelemetryOptions {
     return {
-      engineConfiguration: isSet(object.engineConfiguration)
-        ? EngineConfiguration.fromJSON(object.engineConfiguration)
-        : undefined,
-      enableGraphqlEndpoint: isSet(object.enableGraphqlEndpoint) ? Boolean(object.enableGraphqlEndpoint) : false,
-      operations: Array.isArray(object?.operations) ? object.operations.map((e: any) => Operation.fromJSON(e)) : [],
-      invalidOperationNames: Array.isArray(object?.invalidOperationNames)
-        ? object.invalidOperationNames.map((e: any) => String(e))
-        : [],
-      corsConfiguration: isSet(object.corsConfiguration)
-        ? CorsConfiguration.fromJSON(object.corsConfiguration)
-        : undefined,
-      authenticationConfig: isSet(object.authenticationConfig)
-        ? ApiAuthenticationConfig.fromJSON(object.authenticationConfig)
-        : undefined,
-      s3UploadConfiguration: Array.isArray(object?.s3UploadConfiguration)
-        ? object.s3UploadConfiguration.map((e: any) => S3UploadConfiguration.fromJSON(e))
-        : [],
-      allowedHostNames: Array.isArray(object?.allowedHostNames)
-        ? object.allowedHostNames.map((e: any) => ConfigurationVariable.fromJSON(e))
-        : [],
-      webhooks: Array.isArray(object?.webhooks)
-        ? object.webhooks.map((e: any) => WebhookConfiguration.fromJSON(e))
-        : [],
-      serverOptions: isSet(object.serverOptions) ? ServerOptions.fromJSON(object.serverOptions) : undefined,
-      nodeOptions: isSet(object.nodeOptions) ? NodeOptions.fromJSON(object.nodeOptions) : undefined,
-      experimentalConfig: isSet(object.experimentalConfig)
-        ? ExperimentalConfiguration.fromJSON(object.experimentalConfig)
+      enabled: isSet(object.enabled) ? ConfigurationVariable.fromJSON(object.enabled) : undefined,
+      exporterHttpEndpoint: isSet(object.exporterHttpEndpoint)
+        ? ConfigurationVariable.fromJSON(object.exporterHttpEndpoint)
         : undefined,
+      sampler: isSet(object.sampler) ? ConfigurationVariable.fromJSON(object.sampler) : undefined,
+      authToken: isSet(object.authToken) ? ConfigurationVariable.fromJSON(object.authToken) : undefined,
     };
   },
 
-  toJSON(message: UserDefinedApi): unknown {
+  toJSON(message: TelemetryOptions): unknown {
     const obj: any = {};
-    if (message.engineConfiguration !== undefined) {
-      obj.engineConfiguration = EngineConfiguration.toJSON(message.engineConfiguration);
-    }
-    if (message.enableGraphqlEndpoint === true) {
-      obj.enableGraphqlEndpoint = message.enableGraphqlEndpoint;
-    }
-    if (message.operations?.length) {
-      obj.operations = message.operations.map((e) => Operation.toJSON(e));
-    }
-    if (message.invalidOperationNames?.length) {
-      obj.invalidOperationNames = message.invalidOperationNames;
-    }
-    if (message.corsConfiguration !== undefined) {
-      obj.corsConfiguration = CorsConfiguration.toJSON(message.corsConfiguration);
-    }
-    if (message.authenticationConfig !== undefined) {
-      obj.authenticationConfig = ApiAuthenticationConfig.toJSON(message.authenticationConfig);
-    }
-    if (message.s3UploadConfiguration?.length) {
-      obj.s3UploadConfiguration = message.s3UploadConfiguration.map((e) => S3UploadConfiguration.toJSON(e));
-    }
-    if (message.allowedHostNames?.length) {
-      obj.allowedHostNames = message.allowedHostNames.map((e) => ConfigurationVariable.toJSON(e));
-    }
-    if (message.webhooks?.length) {
-      obj.webhooks = message.webhooks.map((e) => WebhookConfiguration.toJSON(e));
+    if (message.enabled !== undefined) {
+      obj.enabled = ConfigurationVariable.toJSON(message.enabled);
     }
-    if (message.serverOptions !== undefined) {
-      obj.serverOptions = ServerOptions.toJSON(message.serverOptions);
+    if (message.exporterHttpEndpoint !== undefined) {
+      obj.exporterHttpEndpoint = ConfigurationVariable.toJSON(message.exporterHttpEndpoint);
     }
-    if (message.nodeOptions !== undefined) {
-      obj.nodeOptions = NodeOptions.toJSON(message.nodeOptions);
+    if (message.sampler !== undefined) {
+      obj.sampler = ConfigurationVariable.toJSON(message.sampler);
     }
-    if (message.experimentalConfig !== undefined) {
-      obj.experimentalConfig = ExperimentalConfiguration.toJSON(message.experimentalConfig);
+    if (message.authToken !== undefined) {
+      obj.authToken = ConfigurationVariable.toJSON(message.authToken);
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<UserDefinedApi>, I>>(base?: I): UserDefinedApi {
-    return UserDefinedApi.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<TelemetryOptions>, I>>(base?: I): TelemetryOptions {
+    return TelemetryOptions.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<UserDefinedApi>, I>>(object: I): UserDefinedApi {
-    const message = createBaseUserDefinedApi();
-    message.engineConfiguration = (object.engineConfiguration !== undefined && object.engineConfiguration !== null)
-      ? EngineConfiguration.fromPartial(object.engineConfiguration)
-      : undefined;
-    message.enableGraphqlEndpoint = object.enableGraphqlEndpoint ?? false;
-    message.operations = object.operations?.map((e) => Operation.fromPartial(e)) || [];
-    message.invalidOperationNames = object.invalidOperationNames?.map((e) => e) || [];
-    message.corsConfiguration = (object.corsConfiguration !== undefined && object.corsConfiguration !== null)
-      ? CorsConfiguration.fromPartial(object.corsConfiguration)
-      : undefined;
-    message.authenticationConfig = (object.authenticationConfig !== undefined && object.authenticationConfig !== null)
-      ? ApiAuthenticationConfig.fromPartial(object.authenticationConfig)
+  fromPartial<I extends Exact<DeepPartial<TelemetryOptions>, I>>(object: I): TelemetryOptions {
+    const message = createBaseTelemetryOptions();
+    message.enabled = (object.enabled !== undefined && object.enabled !== null)
+      ? ConfigurationVariable.fromPartial(object.enabled)
       : undefined;
-    message.s3UploadConfiguration = object.s3UploadConfiguration?.map((e) => S3UploadConfiguration.fromPartial(e)) ||
-      [];
-    message.allowedHostNames = object.allowedHostNames?.map((e) => ConfigurationVariable.fromPartial(e)) || [];
-    message.webhooks = object.webhooks?.map((e) => WebhookConfiguration.fromPartial(e)) || [];
-    message.serverOptions = (object.serverOptions !== undefined && object.serverOptions !== null)
-      ? ServerOptions.fromPartial(object.serverOptions)
+    message.exporterHttpEndpoint = (object.exporterHttpEndpoint !== undefined && object.exporterHttpEndpoint !== null)
+      ? ConfigurationVariable.fromPartial(object.exporterHttpEndpoint)
       : undefined;
-    message.nodeOptions = (object.nodeOptions !== undefined && object.nodeOptions !== null)
-      ? NodeOptions.fromPartial(object.nodeOptions)
+    message.sampler = (object.sampler !== undefined && object.sampler !== null)
+      ? ConfigurationVariable.fromPartial(object.sampler)
       : undefined;
-    message.experimentalConfig = (object.experimentalConfig !== undefined && object.experimentalConfig !== null)
-      ? ExperimentalConfiguration.fromPartial(object.experimentalConfig)
+    message.authToken = (object.authToken !== undefined && object.authToken !== null)
+      ? ConfigurationVariable.fromPartial(object.authToken)
       : undefined;
     return message;
   },
 };
 
-function createBaseExperimentalConfiguration(): ExperimentalConfiguration {
-  return { orm: false };
+function createBaseServerLogging(): ServerLogging {
+  return { level: undefined };
 }
 
-export const ExperimentalConfiguration = {
-  encode(message: ExperimentalConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.orm === true) {
-      writer.uint32(8).bool(message.orm);
+export const ServerLogging = {
+  encode(message: ServerLogging, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.level !== undefined) {
+      ConfigurationVariable.encode(message.level, writer.uint32(10).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ExperimentalConfiguration {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ServerLogging {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseExperimentalConfiguration();
+    const message = createBaseServerLogging();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.orm = reader.bool();
+          message.level = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -8302,48 +11446,77 @@ export const ExperimentalConfiguration = {
     return message;
   },
 
-  fromJSON(object: any): ExperimentalConfiguration {
-    return { orm: isSet(object.orm) ? Boolean(object.orm) : false };
+  fromJSON(object: any): ServerLogging {
+    return { level: isSet(object.level) ? ConfigurationVariable.fromJSON(object.level) : undefined };
   },
 
-  toJSON(message: ExperimentalConfiguration): unknown {
+  toJSON(message: ServerLogging): unknown {
     const obj: any = {};
-    if (message.orm === true) {
-      obj.orm = message.orm;
+    if (message.level !== undefined) {
+      obj.level = ConfigurationVariable.toJSON(message.level);
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<ExperimentalConfiguration>, I>>(base?: I): ExperimentalConfiguration {
-    return ExperimentalConfiguration.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<ServerLogging>, I>>(base?: I): ServerLogging {
+    return ServerLogging.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<ExperimentalConfiguration>, I>>(object: I): ExperimentalConfiguration {
-    const message = createBaseExperimentalConfiguration();
-    message.orm = object.orm ?? false;
+  fromPartial<I extends Exact<DeepPartial<ServerLogging>, I>>(object: I): ServerLogging {
+    const message = createBaseServerLogging();
+    message.level = (object.level !== undefined && object.level !== null)
+      ? ConfigurationVariable.fromPartial(object.level)
+      : undefined;
     return message;
   },
 };
 
-function createBaseListenerOptions(): ListenerOptions {
-  return { host: undefined, port: undefined };
+function createBaseServerOptions(): ServerOptions {
+  return {
+    serverUrl: undefined,
+    listen: undefined,
+    logger: undefined,
+    wasmHooks: undefined,
+    grpcHooks: undefined,
+    serverPool: undefined,
+    hookPolicies: [],
+    asyncHooks: undefined,
+  };
 }
 
-export const ListenerOptions = {
-  encode(message: ListenerOptions, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.host !== undefined) {
-      ConfigurationVariable.encode(message.host, writer.uint32(10).fork()).ldelim();
+export const ServerOptions = {
+  encode(message: ServerOptions, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.serverUrl !== undefined) {
+      ConfigurationVariable.encode(message.serverUrl, writer.uint32(10).fork()).ldelim();
     }
-    if (message.port !== undefined) {
-      ConfigurationVariable.encode(message.port, writer.uint32(18).fork()).ldelim();
+    if (message.listen !== undefined) {
+      ListenerOptions.encode(message.listen, writer.uint32(18).fork()).ldelim();
+    }
+    if (message.logger !== undefined) {
+      ServerLogging.encode(message.logger, writer.uint32(26).fork()).ldelim();
+    }
+    if (message.wasmHooks !== undefined) {
+      WasmHooksOptions.encode(message.wasmHooks, writer.uint32(34).fork()).ldelim();
+    }
+    if (message.grpcHooks !== undefined) {
+      GRPCHooksOptions.encode(message.grpcHooks, writer.uint32(42).fork()).ldelim();
+    }
+    if (message.serverPool !== undefined) {
+      HooksServerPoolOptions.encode(message.serverPool, writer.uint32(50).fork()).ldelim();
+    }
+    for (const v of message.hookPolicies) {
+      HookPolicy.encode(v!, writer.uint32(58).fork()).ldelim();
+    }
+    if (message.asyncHooks !== undefined) {
+      AsyncHooksOptions.encode(message.asyncHooks, writer.uint32(66).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ListenerOptions {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ServerOptions {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseListenerOptions();
+    const message = createBaseServerOptions();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -8352,14 +11525,56 @@ export const ListenerOptions = {
             break;
           }
 
-          message.host = ConfigurationVariable.decode(reader, reader.uint32());
+          message.serverUrl = ConfigurationVariable.decode(reader, reader.uint32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.listen = ListenerOptions.decode(reader, reader.uint32());
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.logger = ServerLogging.decode(reader, reader.uint32());
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.wasmHooks = WasmHooksOptions.decode(reader, reader.uint32());
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.grpcHooks = GRPCHooksOptions.decode(reader, reader.uint32());
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.serverPool = HooksServerPoolOptions.decode(reader, reader.uint32());
           continue;
-        case 2:
-          if (tag !== 18) {
+        case 7:
+          if (tag !== 58) {
             break;
           }
 
-          message.port = ConfigurationVariable.decode(reader, reader.uint32());
+          message.hookPolicies.push(HookPolicy.decode(reader, reader.uint32()));
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.asyncHooks = AsyncHooksOptions.decode(reader, reader.uint32());
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -8370,56 +11585,110 @@ export const ListenerOptions = {
     return message;
   },
 
-  fromJSON(object: any): ListenerOptions {
+  fromJSON(object: any): ServerOptions {
     return {
-      host: isSet(object.host) ? ConfigurationVariable.fromJSON(object.host) : undefined,
-      port: isSet(object.port) ? ConfigurationVariable.fromJSON(object.port) : undefined,
+      serverUrl: isSet(object.serverUrl) ? ConfigurationVariable.fromJSON(object.serverUrl) : undefined,
+      listen: isSet(object.listen) ? ListenerOptions.fromJSON(object.listen) : undefined,
+      logger: isSet(object.logger) ? ServerLogging.fromJSON(object.logger) : undefined,
+      wasmHooks: isSet(object.wasmHooks) ? WasmHooksOptions.fromJSON(object.wasmHooks) : undefined,
+      grpcHooks: isSet(object.grpcHooks) ? GRPCHooksOptions.fromJSON(object.grpcHooks) : undefined,
+      serverPool: isSet(object.serverPool) ? HooksServerPoolOptions.fromJSON(object.serverPool) : undefined,
+      hookPolicies: Array.isArray(object?.hookPolicies)
+        ? object.hookPolicies.map((e: any) => HookPolicy.fromJSON(e))
+        : [],
+      asyncHooks: isSet(object.asyncHooks) ? AsyncHooksOptions.fromJSON(object.asyncHooks) : undefined,
     };
   },
 
-  toJSON(message: ListenerOptions): unknown {
+  toJSON(message: ServerOptions): unknown {
     const obj: any = {};
-    if (message.host !== undefined) {
-      obj.host = ConfigurationVariable.toJSON(message.host);
+    if (message.serverUrl !== undefined) {
+      obj.serverUrl = ConfigurationVariable.toJSON(message.serverUrl);
     }
-    if (message.port !== undefined) {
-      obj.port = ConfigurationVariable.toJSON(message.port);
+    if (message.listen !== undefined) {
+      obj.listen = ListenerOptions.toJSON(message.listen);
+    }
+    if (message.logger !== undefined) {
+      obj.logger = ServerLogging.toJSON(message.logger);
+    }
+    if (message.wasmHooks !== undefined) {
+      obj.wasmHooks = WasmHooksOptions.toJSON(message.wasmHooks);
+    }
+    if (message.grpcHooks !== undefined) {
+      obj.grpcHooks = GRPCHooksOptions.toJSON(message.grpcHooks);
+    }
+    if (message.serverPool !== undefined) {
+      obj.serverPool = HooksServerPoolOptions.toJSON(message.serverPool);
+    }
+    if (message.hookPolicies?.length) {
+      obj.hookPolicies = message.hookPolicies.map((e) => HookPolicy.toJSON(e));
+    }
+    if (message.asyncHooks !== undefined) {
+      obj.asyncHooks = AsyncHooksOptions.toJSON(message.asyncHooks);
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<ListenerOptions>, I>>(base?: I): ListenerOptions {
-    return ListenerOptions.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<ServerOptions>, I>>(base?: I): ServerOptions {
+    return ServerOptions.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<ListenerOptions>, I>>(object: I): ListenerOptions {
-    const message = createBaseListenerOptions();
-    message.host = (object.host !== undefined && object.host !== null)
-      ? ConfigurationVariable.fromPartial(object.host)
+  fromPartial<I extends Exact<DeepPartial<ServerOptions>, I>>(object: I): ServerOptions {
+    const message = createBaseServerOptions();
+    message.serverUrl = (object.serverUrl !== undefined && object.serverUrl !== null)
+      ? ConfigurationVariable.fromPartial(object.serverUrl)
       : undefined;
-    message.port = (object.port !== undefined && object.port !== null)
-      ? ConfigurationVariable.fromPartial(object.port)
+    message.listen = (object.listen !== undefined && object.listen !== null)
+      ? ListenerOptions.fromPartial(object.listen)
+      : undefined;
+    message.logger = (object.logger !== undefined && object.logger !== null)
+      ? ServerLogging.fromPartial(object.logger)
+      : undefined;
+    message.wasmHooks = (object.wasmHooks !== undefined && object.wasmHooks !== null)
+      ? WasmHooksOptions.fromPartial(object.wasmHooks)
+      : undefined;
+    message.grpcHooks = (object.grpcHooks !== undefined && object.grpcHooks !== null)
+      ? GRPCHooksOptions.fromPartial(object.grpcHooks)
+      : undefined;
+    message.serverPool = (object.serverPool !== undefined && object.serverPool !== null)
+      ? HooksServerPoolOptions.fromPartial(object.serverPool)
+      : undefined;
+    message.hookPolicies = object.hookPolicies?.map((e) => HookPolicy.fromPartial(e)) || [];
+    message.asyncHooks = (object.asyncHooks !== undefined && object.asyncHooks !== null)
+      ? AsyncHooksOptions.fromPartial(object.asyncHooks)
       : undefined;
     return message;
   },
 };
 
-function createBaseInternalListenerOptions(): InternalListenerOptions {
-  return { port: undefined };
+function createBaseAsyncHooksOptions(): AsyncHooksOptions {
+  return { hooks: [], queueSize: 0, workers: 0, durable: false, directory: undefined };
 }
 
-export const InternalListenerOptions = {
-  encode(message: InternalListenerOptions, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.port !== undefined) {
-      ConfigurationVariable.encode(message.port, writer.uint32(10).fork()).ldelim();
+export const AsyncHooksOptions = {
+  encode(message: AsyncHooksOptions, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.hooks) {
+      writer.uint32(10).string(v!);
+    }
+    if (message.queueSize !== 0) {
+      writer.uint32(16).int32(message.queueSize);
+    }
+    if (message.workers !== 0) {
+      writer.uint32(24).int32(message.workers);
+    }
+    if (message.durable === true) {
+      writer.uint32(32).bool(message.durable);
+    }
+    if (message.directory !== undefined) {
+      ConfigurationVariable.encode(message.directory, writer.uint32(42).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): InternalListenerOptions {
+  decode(input: _m0.Reader | Uint8Array, length?: number): AsyncHooksOptions {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseInternalListenerOptions();
+    const message = createBaseAsyncHooksOptions();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -8428,67 +11697,35 @@ export const InternalListenerOptions = {
             break;
           }
 
-          message.port = ConfigurationVariable.decode(reader, reader.uint32());
+          message.hooks.push(reader.string());
           continue;
-      }
-      if ((tag & 7) === 4 || tag === 0) {
-        break;
-      }
-      reader.skipType(tag & 7);
-    }
-    return message;
-  },
-
-  fromJSON(object: any): InternalListenerOptions {
-    return { port: isSet(object.port) ? ConfigurationVariable.fromJSON(object.port) : undefined };
-  },
-
-  toJSON(message: InternalListenerOptions): unknown {
-    const obj: any = {};
-    if (message.port !== undefined) {
-      obj.port = ConfigurationVariable.toJSON(message.port);
-    }
-    return obj;
-  },
-
-  create<I extends Exact<DeepPartial<InternalListenerOptions>, I>>(base?: I): InternalListenerOptions {
-    return InternalListenerOptions.fromPartial(base ?? {});
-  },
-
-  fromPartial<I extends Exact<DeepPartial<InternalListenerOptions>, I>>(object: I): InternalListenerOptions {
-    const message = createBaseInternalListenerOptions();
-    message.port = (object.port !== undefined && object.port !== null)
-      ? ConfigurationVariable.fromPartial(object.port)
-      : undefined;
-    return message;
-  },
-};
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
 
-function createBaseNodeLogging(): NodeLogging {
-  return { level: undefined };
-}
+          message.queueSize = reader.int32();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
 
-export const NodeLogging = {
-  encode(message: NodeLogging, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.level !== undefined) {
-      ConfigurationVariable.encode(message.level, writer.uint32(10).fork()).ldelim();
-    }
-    return writer;
-  },
+          message.workers = reader.int32();
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): NodeLogging {
-    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
-    let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseNodeLogging();
-    while (reader.pos < end) {
-      const tag = reader.uint32();
-      switch (tag >>> 3) {
-        case 1:
-          if (tag !== 10) {
+          message.durable = reader.bool();
+          continue;
+        case 5:
+          if (tag !== 42) {
             break;
           }
 
-          message.level = ConfigurationVariable.decode(reader, reader.uint32());
+          message.directory = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -8499,50 +11736,81 @@ export const NodeLogging = {
     return message;
   },
 
-  fromJSON(object: any): NodeLogging {
-    return { level: isSet(object.level) ? ConfigurationVariable.fromJSON(object.level) : undefined };
+  fromJSON(object: any): AsyncHooksOptions {
+    return {
+      hooks: Array.isArray(object?.hooks) ? object.hooks.map((e: any) => String(e)) : [],
+      queueSize: isSet(object.queueSize) ? Number(object.queueSize) : 0,
+      workers: isSet(object.workers) ? Number(object.workers) : 0,
+      durable: isSet(object.durable) ? Boolean(object.durable) : false,
+      directory: isSet(object.directory) ? ConfigurationVariable.fromJSON(object.directory) : undefined,
+    };
   },
 
-  toJSON(message: NodeLogging): unknown {
+  toJSON(message: AsyncHooksOptions): unknown {
     const obj: any = {};
-    if (message.level !== undefined) {
-      obj.level = ConfigurationVariable.toJSON(message.level);
+    if (message.hooks?.length) {
+      obj.hooks = message.hooks;
+    }
+    if (message.queueSize !== 0) {
+      obj.queueSize = Math.round(message.queueSize);
+    }
+    if (message.workers !== 0) {
+      obj.workers = Math.round(message.workers);
+    }
+    if (message.durable === true) {
+      obj.durable = message.durable;
+    }
+    if (message.directory !== undefined) {
+      obj.directory = ConfigurationVariable.toJSON(message.directory);
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<NodeLogging>, I>>(base?: I): NodeLogging {
-    return NodeLogging.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<AsyncHooksOptions>, I>>(base?: I): AsyncHooksOptions {
+    return AsyncHooksOptions.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<NodeLogging>, I>>(object: I): NodeLogging {
-    const message = createBaseNodeLogging();
-    message.level = (object.level !== undefined && object.level !== null)
-      ? ConfigurationVariable.fromPartial(object.level)
+  fromPartial<I extends Exact<DeepPartial<AsyncHooksOptions>, I>>(object: I): AsyncHooksOptions {
+    const message = createBaseAsyncHooksOptions();
+    message.hooks = object.hooks?.map((e) => e) || [];
+    message.queueSize = object.queueSize ?? 0;
+    message.workers = object.workers ?? 0;
+    message.durable = object.durable ?? false;
+    message.directory = (object.directory !== undefined && object.directory !== null)
+      ? ConfigurationVariable.fromPartial(object.directory)
       : undefined;
     return message;
   },
 };
 
-function createBasePrometheusOptions(): PrometheusOptions {
-  return { enabled: undefined, port: undefined };
+function createBaseHookPolicy(): HookPolicy {
+  return { hook: "", timeoutMs: 0, maxRetries: undefined, failureMode: 0, fallbackResponse: "" };
 }
 
-export const PrometheusOptions = {
-  encode(message: PrometheusOptions, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.enabled !== undefined) {
-      ConfigurationVariable.encode(message.enabled, writer.uint32(10).fork()).ldelim();
+export const HookPolicy = {
+  encode(message: HookPolicy, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.hook !== "") {
+      writer.uint32(10).string(message.hook);
     }
-    if (message.port !== undefined) {
-      ConfigurationVariable.encode(message.port, writer.uint32(18).fork()).ldelim();
+    if (message.timeoutMs !== 0) {
+      writer.uint32(16).int64(message.timeoutMs);
+    }
+    if (message.maxRetries !== undefined) {
+      writer.uint32(24).int32(message.maxRetries);
+    }
+    if (message.failureMode !== 0) {
+      writer.uint32(32).int32(message.failureMode);
+    }
+    if (message.fallbackResponse !== "") {
+      writer.uint32(42).string(message.fallbackResponse);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): PrometheusOptions {
+  decode(input: _m0.Reader | Uint8Array, length?: number): HookPolicy {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBasePrometheusOptions();
+    const message = createBaseHookPolicy();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -8551,14 +11819,35 @@ export const PrometheusOptions = {
             break;
           }
 
-          message.enabled = ConfigurationVariable.decode(reader, reader.uint32());
+          message.hook = reader.string();
           continue;
         case 2:
-          if (tag !== 18) {
+          if (tag !== 16) {
             break;
           }
 
-          message.port = ConfigurationVariable.decode(reader, reader.uint32());
+          message.timeoutMs = longToNumber(reader.int64() as Long);
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.maxRetries = reader.int32();
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.failureMode = reader.int32() as any;
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.fallbackResponse = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -8569,94 +11858,89 @@ export const PrometheusOptions = {
     return message;
   },
 
-  fromJSON(object: any): PrometheusOptions {
+  fromJSON(object: any): HookPolicy {
     return {
-      enabled: isSet(object.enabled) ? ConfigurationVariable.fromJSON(object.enabled) : undefined,
-      port: isSet(object.port) ? ConfigurationVariable.fromJSON(object.port) : undefined,
+      hook: isSet(object.hook) ? String(object.hook) : "",
+      timeoutMs: isSet(object.timeoutMs) ? Number(object.timeoutMs) : 0,
+      maxRetries: isSet(object.maxRetries) ? Number(object.maxRetries) : undefined,
+      failureMode: isSet(object.failureMode) ? hookFailureModeFromJSON(object.failureMode) : 0,
+      fallbackResponse: isSet(object.fallbackResponse) ? String(object.fallbackResponse) : "",
     };
   },
 
-  toJSON(message: PrometheusOptions): unknown {
+  toJSON(message: HookPolicy): unknown {
     const obj: any = {};
-    if (message.enabled !== undefined) {
-      obj.enabled = ConfigurationVariable.toJSON(message.enabled);
-    }
-    if (message.port !== undefined) {
-      obj.port = ConfigurationVariable.toJSON(message.port);
+    if (message.hook !== "") {
+      obj.hook = message.hook;
     }
-    return obj;
-  },
-
-  create<I extends Exact<DeepPartial<PrometheusOptions>, I>>(base?: I): PrometheusOptions {
-    return PrometheusOptions.fromPartial(base ?? {});
-  },
-
-  fromPartial<I extends Exact<DeepPartial<PrometheusOptions>, I>>(object: I): PrometheusOptions {
-    const message = createBasePrometheusOptions();
-    message.enabled = (object.enabled !== undefined && object.enabled !== null)
-      ? ConfigurationVariable.fromPartial(object.enabled)
-      : undefined;
-    message.port = (object.port !== undefined && object.port !== null)
-      ? ConfigurationVariable.fromPartial(object.port)
-      : undefined;
-    return message;
-  },
-};
-
-function createBaseNodeOptions(): NodeOptions {
-  return {
-    nodeUrl: undefined,
-    publicNodeUrl: undefined,
-    listen: undefined,
-    logger: undefined,
-    defaultRequestTimeoutSeconds: 0,
-    listenInternal: undefined,
-    nodeInternalUrl: undefined,
-    defaultHttpProxyUrl: undefined,
-    openTelemetry: undefined,
-    prometheus: undefined,
-  };
-}
-
-export const NodeOptions = {
-  encode(message: NodeOptions, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.nodeUrl !== undefined) {
-      ConfigurationVariable.encode(message.nodeUrl, writer.uint32(10).fork()).ldelim();
+    if (message.timeoutMs !== 0) {
+      obj.timeoutMs = Math.round(message.timeoutMs);
     }
-    if (message.publicNodeUrl !== undefined) {
-      ConfigurationVariable.encode(message.publicNodeUrl, writer.uint32(34).fork()).ldelim();
+    if (message.maxRetries !== undefined) {
+      obj.maxRetries = Math.round(message.maxRetries);
     }
-    if (message.listen !== undefined) {
-      ListenerOptions.encode(message.listen, writer.uint32(18).fork()).ldelim();
+    if (message.failureMode !== 0) {
+      obj.failureMode = hookFailureModeToJSON(message.failureMode);
     }
-    if (message.logger !== undefined) {
-      NodeLogging.encode(message.logger, writer.uint32(26).fork()).ldelim();
+    if (message.fallbackResponse !== "") {
+      obj.fallbackResponse = message.fallbackResponse;
     }
-    if (message.defaultRequestTimeoutSeconds !== 0) {
-      writer.uint32(40).int64(message.defaultRequestTimeoutSeconds);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<HookPolicy>, I>>(base?: I): HookPolicy {
+    return HookPolicy.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<HookPolicy>, I>>(object: I): HookPolicy {
+    const message = createBaseHookPolicy();
+    message.hook = object.hook ?? "";
+    message.timeoutMs = object.timeoutMs ?? 0;
+    message.maxRetries = object.maxRetries ?? undefined;
+    message.failureMode = object.failureMode ?? 0;
+    message.fallbackResponse = object.fallbackResponse ?? "";
+    return message;
+  },
+};
+
+function createBaseHooksServerPoolOptions(): HooksServerPoolOptions {
+  return {
+    serverUrls: [],
+    loadBalancing: 0,
+    healthCheckIntervalSeconds: 0,
+    maxFailures: 0,
+    ejectionSeconds: 0,
+    routes: [],
+  };
+}
+
+export const HooksServerPoolOptions = {
+  encode(message: HooksServerPoolOptions, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.serverUrls) {
+      ConfigurationVariable.encode(v!, writer.uint32(10).fork()).ldelim();
     }
-    if (message.listenInternal !== undefined) {
-      InternalListenerOptions.encode(message.listenInternal, writer.uint32(50).fork()).ldelim();
+    if (message.loadBalancing !== 0) {
+      writer.uint32(16).int32(message.loadBalancing);
     }
-    if (message.nodeInternalUrl !== undefined) {
-      ConfigurationVariable.encode(message.nodeInternalUrl, writer.uint32(58).fork()).ldelim();
+    if (message.healthCheckIntervalSeconds !== 0) {
+      writer.uint32(24).int64(message.healthCheckIntervalSeconds);
     }
-    if (message.defaultHttpProxyUrl !== undefined) {
-      ConfigurationVariable.encode(message.defaultHttpProxyUrl, writer.uint32(66).fork()).ldelim();
+    if (message.maxFailures !== 0) {
+      writer.uint32(32).int32(message.maxFailures);
     }
-    if (message.openTelemetry !== undefined) {
-      TelemetryOptions.encode(message.openTelemetry, writer.uint32(74).fork()).ldelim();
+    if (message.ejectionSeconds !== 0) {
+      writer.uint32(40).int64(message.ejectionSeconds);
     }
-    if (message.prometheus !== undefined) {
-      PrometheusOptions.encode(message.prometheus, writer.uint32(82).fork()).ldelim();
+    for (const v of message.routes) {
+      HooksServerRoute.encode(v!, writer.uint32(50).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): NodeOptions {
+  decode(input: _m0.Reader | Uint8Array, length?: number): HooksServerPoolOptions {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseNodeOptions();
+    const message = createBaseHooksServerPoolOptions();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -8665,70 +11949,42 @@ export const NodeOptions = {
             break;
           }
 
-          message.nodeUrl = ConfigurationVariable.decode(reader, reader.uint32());
+          message.serverUrls.push(ConfigurationVariable.decode(reader, reader.uint32()));
           continue;
-        case 4:
-          if (tag !== 34) {
+        case 2:
+          if (tag !== 16) {
             break;
           }
 
-          message.publicNodeUrl = ConfigurationVariable.decode(reader, reader.uint32());
+          message.loadBalancing = reader.int32() as any;
           continue;
-        case 2:
-          if (tag !== 18) {
+        case 3:
+          if (tag !== 24) {
             break;
           }
 
-          message.listen = ListenerOptions.decode(reader, reader.uint32());
+          message.healthCheckIntervalSeconds = longToNumber(reader.int64() as Long);
           continue;
-        case 3:
-          if (tag !== 26) {
+        case 4:
+          if (tag !== 32) {
             break;
           }
 
-          message.logger = NodeLogging.decode(reader, reader.uint32());
+          message.maxFailures = reader.int32();
           continue;
         case 5:
           if (tag !== 40) {
             break;
           }
 
-          message.defaultRequestTimeoutSeconds = longToNumber(reader.int64() as Long);
+          message.ejectionSeconds = longToNumber(reader.int64() as Long);
           continue;
         case 6:
           if (tag !== 50) {
             break;
           }
 
-          message.listenInternal = InternalListenerOptions.decode(reader, reader.uint32());
-          continue;
-        case 7:
-          if (tag !== 58) {
-            break;
-          }
-
-          message.nodeInternalUrl = ConfigurationVariable.decode(reader, reader.uint32());
-          continue;
-        case 8:
-          if (tag !== 66) {
-            break;
-          }
-
-          message.defaultHttpProxyUrl = ConfigurationVariable.decode(reader, reader.uint32());
-          continue;
-        case 9:
-          if (tag !== 74) {
-            break;
-          }
-
-          message.openTelemetry = TelemetryOptions.decode(reader, reader.uint32());
-          continue;
-        case 10:
-          if (tag !== 82) {
-            break;
-          }
-
-          message.prometheus = PrometheusOptions.decode(reader, reader.uint32());
+          message.routes.push(HooksServerRoute.decode(reader, reader.uint32()));
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -8739,127 +11995,79 @@ export const NodeOptions = {
     return message;
   },
 
-  fromJSON(object: any): NodeOptions {
+  fromJSON(object: any): HooksServerPoolOptions {
     return {
-      nodeUrl: isSet(object.nodeUrl) ? ConfigurationVariable.fromJSON(object.nodeUrl) : undefined,
-      publicNodeUrl: isSet(object.publicNodeUrl) ? ConfigurationVariable.fromJSON(object.publicNodeUrl) : undefined,
-      listen: isSet(object.listen) ? ListenerOptions.fromJSON(object.listen) : undefined,
-      logger: isSet(object.logger) ? NodeLogging.fromJSON(object.logger) : undefined,
-      defaultRequestTimeoutSeconds: isSet(object.defaultRequestTimeoutSeconds)
-        ? Number(object.defaultRequestTimeoutSeconds)
+      serverUrls: Array.isArray(object?.serverUrls)
+        ? object.serverUrls.map((e: any) => ConfigurationVariable.fromJSON(e))
+        : [],
+      loadBalancing: isSet(object.loadBalancing) ? hooksLoadBalancingFromJSON(object.loadBalancing) : 0,
+      healthCheckIntervalSeconds: isSet(object.healthCheckIntervalSeconds)
+        ? Number(object.healthCheckIntervalSeconds)
         : 0,
-      listenInternal: isSet(object.listenInternal)
-        ? InternalListenerOptions.fromJSON(object.listenInternal)
-        : undefined,
-      nodeInternalUrl: isSet(object.nodeInternalUrl)
-        ? ConfigurationVariable.fromJSON(object.nodeInternalUrl)
-        : undefined,
-      defaultHttpProxyUrl: isSet(object.defaultHttpProxyUrl)
-        ? ConfigurationVariable.fromJSON(object.defaultHttpProxyUrl)
-        : undefined,
-      openTelemetry: isSet(object.openTelemetry) ? TelemetryOptions.fromJSON(object.openTelemetry) : undefined,
-      prometheus: isSet(object.prometheus) ? PrometheusOptions.fromJSON(object.prometheus) : undefined,
+      maxFailures: isSet(object.maxFailures) ? Number(object.maxFailures) : 0,
+      ejectionSeconds: isSet(object.ejectionSeconds) ? Number(object.ejectionSeconds) : 0,
+      routes: Array.isArray(object?.routes) ? object.routes.map((e: any) => HooksServerRoute.fromJSON(e)) : [],
     };
   },
 
-  toJSON(message: NodeOptions): unknown {
+  toJSON(message: HooksServerPoolOptions): unknown {
     const obj: any = {};
-    if (message.nodeUrl !== undefined) {
-      obj.nodeUrl = ConfigurationVariable.toJSON(message.nodeUrl);
-    }
-    if (message.publicNodeUrl !== undefined) {
-      obj.publicNodeUrl = ConfigurationVariable.toJSON(message.publicNodeUrl);
-    }
-    if (message.listen !== undefined) {
-      obj.listen = ListenerOptions.toJSON(message.listen);
-    }
-    if (message.logger !== undefined) {
-      obj.logger = NodeLogging.toJSON(message.logger);
-    }
-    if (message.defaultRequestTimeoutSeconds !== 0) {
-      obj.defaultRequestTimeoutSeconds = Math.round(message.defaultRequestTimeoutSeconds);
+    if (message.serverUrls?.length) {
+      obj.serverUrls = message.serverUrls.map((e) => ConfigurationVariable.toJSON(e));
     }
-    if (message.listenInternal !== undefined) {
-      obj.listenInternal = InternalListenerOptions.toJSON(message.listenInternal);
+    if (message.loadBalancing !== 0) {
+      obj.loadBalancing = hooksLoadBalancingToJSON(message.loadBalancing);
     }
-    if (message.nodeInternalUrl !== undefined) {
-      obj.nodeInternalUrl = ConfigurationVariable.toJSON(message.nodeInternalUrl);
+    if (message.healthCheckIntervalSeconds !== 0) {
+      obj.healthCheckIntervalSeconds = Math.round(message.healthCheckIntervalSeconds);
     }
-    if (message.defaultHttpProxyUrl !== undefined) {
-      obj.defaultHttpProxyUrl = ConfigurationVariable.toJSON(message.defaultHttpProxyUrl);
+    if (message.maxFailures !== 0) {
+      obj.maxFailures = Math.round(message.maxFailures);
     }
-    if (message.openTelemetry !== undefined) {
-      obj.openTelemetry = TelemetryOptions.toJSON(message.openTelemetry);
+    if (message.ejectionSeconds !== 0) {
+      obj.ejectionSeconds = Math.round(message.ejectionSeconds);
     }
-    if (message.prometheus !== undefined) {
-      obj.prometheus = PrometheusOptions.toJSON(message.prometheus);
+    if (message.routes?.length) {
+      obj.routes = message.routes.map((e) => HooksServerRoute.toJSON(e));
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<NodeOptions>, I>>(base?: I): NodeOptions {
-    return NodeOptions.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<HooksServerPoolOptions>, I>>(base?: I): HooksServerPoolOptions {
+    return HooksServerPoolOptions.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<NodeOptions>, I>>(object: I): NodeOptions {
-    const message = createBaseNodeOptions();
-    message.nodeUrl = (object.nodeUrl !== undefined && object.nodeUrl !== null)
-      ? ConfigurationVariable.fromPartial(object.nodeUrl)
-      : undefined;
-    message.publicNodeUrl = (object.publicNodeUrl !== undefined && object.publicNodeUrl !== null)
-      ? ConfigurationVariable.fromPartial(object.publicNodeUrl)
-      : undefined;
-    message.listen = (object.listen !== undefined && object.listen !== null)
-      ? ListenerOptions.fromPartial(object.listen)
-      : undefined;
-    message.logger = (object.logger !== undefined && object.logger !== null)
-      ? NodeLogging.fromPartial(object.logger)
-      : undefined;
-    message.defaultRequestTimeoutSeconds = object.defaultRequestTimeoutSeconds ?? 0;
-    message.listenInternal = (object.listenInternal !== undefined && object.listenInternal !== null)
-      ? InternalListenerOptions.fromPartial(object.listenInternal)
-      : undefined;
-    message.nodeInternalUrl = (object.nodeInternalUrl !== undefined && object.nodeInternalUrl !== null)
-      ? ConfigurationVariable.fromPartial(object.nodeInternalUrl)
-      : undefined;
-    message.defaultHttpProxyUrl = (object.defaultHttpProxyUrl !== undefined && object.defaultHttpProxyUrl !== null)
-      ? ConfigurationVariable.fromPartial(object.defaultHttpProxyUrl)
-      : undefined;
-    message.openTelemetry = (object.openTelemetry !== undefined && object.openTelemetry !== null)
-      ? TelemetryOptions.fromPartial(object.openTelemetry)
-      : undefined;
-    message.prometheus = (object.prometheus !== undefined && object.prometheus !== null)
-      ? PrometheusOptions.fromPartial(object.prometheus)
-      : undefined;
+  fromPartial<I extends Exact<DeepPartial<HooksServerPoolOptions>, I>>(object: I): HooksServerPoolOptions {
+    const message = createBaseHooksServerPoolOptions();
+    message.serverUrls = object.serverUrls?.map((e) => ConfigurationVariable.fromPartial(e)) || [];
+    message.loadBalancing = object.loadBalancing ?? 0;
+    message.healthCheckIntervalSeconds = object.healthCheckIntervalSeconds ?? 0;
+    message.maxFailures = object.maxFailures ?? 0;
+    message.ejectionSeconds = object.ejectionSeconds ?? 0;
+    message.routes = object.routes?.map((e) => HooksServerRoute.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseTelemetryOptions(): TelemetryOptions {
-  return { enabled: undefined, exporterHttpEndpoint: undefined, sampler: undefined, authToken: undefined };
+function createBaseHooksServerRoute(): HooksServerRoute {
+  return { hook: "", serverUrls: [] };
 }
 
-export const TelemetryOptions = {
-  encode(message: TelemetryOptions, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.enabled !== undefined) {
-      ConfigurationVariable.encode(message.enabled, writer.uint32(10).fork()).ldelim();
-    }
-    if (message.exporterHttpEndpoint !== undefined) {
-      ConfigurationVariable.encode(message.exporterHttpEndpoint, writer.uint32(18).fork()).ldelim();
-    }
-    if (message.sampler !== undefined) {
-      ConfigurationVariable.encode(message.sampler, writer.uint32(26).fork()).ldelim();
+export const HooksServerRoute = {
+  encode(message: HooksServerRoute, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.hook !== "") {
+      writer.uint32(10).string(message.hook);
     }
-    if (message.authToken !== undefined) {
-      ConfigurationVariable.encode(message.authToken, writer.uint32(34).fork()).ldelim();
+    for (const v of message.serverUrls) {
+      ConfigurationVariable.encode(v!, writer.uint32(18).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): TelemetryOptions {
+  decode(input: _m0.Reader | Uint8Array, length?: number): HooksServerRoute {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseTelemetryOptions();
+    const message = createBaseHooksServerRoute();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -8868,28 +12076,91 @@ export const TelemetryOptions = {
             break;
           }
 
-          message.enabled = ConfigurationVariable.decode(reader, reader.uint32());
+          message.hook = reader.string();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.exporterHttpEndpoint = ConfigurationVariable.decode(reader, reader.uint32());
+          message.serverUrls.push(ConfigurationVariable.decode(reader, reader.uint32()));
           continue;
-        case 3:
-          if (tag !== 26) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): HooksServerRoute {
+    return {
+      hook: isSet(object.hook) ? String(object.hook) : "",
+      serverUrls: Array.isArray(object?.serverUrls)
+        ? object.serverUrls.map((e: any) => ConfigurationVariable.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: HooksServerRoute): unknown {
+    const obj: any = {};
+    if (message.hook !== "") {
+      obj.hook = message.hook;
+    }
+    if (message.serverUrls?.length) {
+      obj.serverUrls = message.serverUrls.map((e) => ConfigurationVariable.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<HooksServerRoute>, I>>(base?: I): HooksServerRoute {
+    return HooksServerRoute.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<HooksServerRoute>, I>>(object: I): HooksServerRoute {
+    const message = createBaseHooksServerRoute();
+    message.hook = object.hook ?? "";
+    message.serverUrls = object.serverUrls?.map((e) => ConfigurationVariable.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseGRPCHooksOptions(): GRPCHooksOptions {
+  return { address: undefined, tls: false };
+}
+
+export const GRPCHooksOptions = {
+  encode(message: GRPCHooksOptions, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.address !== undefined) {
+      ConfigurationVariable.encode(message.address, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.tls === true) {
+      writer.uint32(16).bool(message.tls);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GRPCHooksOptions {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGRPCHooksOptions();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
             break;
           }
 
-          message.sampler = ConfigurationVariable.decode(reader, reader.uint32());
+          message.address = ConfigurationVariable.decode(reader, reader.uint32());
           continue;
-        case 4:
-          if (tag !== 34) {
+        case 2:
+          if (tag !== 16) {
             break;
           }
 
-          message.authToken = ConfigurationVariable.decode(reader, reader.uint32());
+          message.tls = reader.bool();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -8900,72 +12171,63 @@ export const TelemetryOptions = {
     return message;
   },
 
-  fromJSON(object: any): TelemetryOptions {
+  fromJSON(object: any): GRPCHooksOptions {
     return {
-      enabled: isSet(object.enabled) ? ConfigurationVariable.fromJSON(object.enabled) : undefined,
-      exporterHttpEndpoint: isSet(object.exporterHttpEndpoint)
-        ? ConfigurationVariable.fromJSON(object.exporterHttpEndpoint)
-        : undefined,
-      sampler: isSet(object.sampler) ? ConfigurationVariable.fromJSON(object.sampler) : undefined,
-      authToken: isSet(object.authToken) ? ConfigurationVariable.fromJSON(object.authToken) : undefined,
+      address: isSet(object.address) ? ConfigurationVariable.fromJSON(object.address) : undefined,
+      tls: isSet(object.tls) ? Boolean(object.tls) : false,
     };
   },
 
-  toJSON(message: TelemetryOptions): unknown {
+  toJSON(message: GRPCHooksOptions): unknown {
     const obj: any = {};
-    if (message.enabled !== undefined) {
-      obj.enabled = ConfigurationVariable.toJSON(message.enabled);
-    }
-    if (message.exporterHttpEndpoint !== undefined) {
-      obj.exporterHttpEndpoint = ConfigurationVariable.toJSON(message.exporterHttpEndpoint);
+    if (message.address !== undefined) {
+      obj.address = ConfigurationVariable.toJSON(message.address);
     }
-    if (message.sampler !== undefined) {
-      obj.sampler = ConfigurationVariable.toJSON(message.sampler);
-    }
-    if (message.authToken !== undefined) {
-      obj.authToken = ConfigurationVariable.toJSON(message.authToken);
+    if (message.tls === true) {
+      obj.tls = message.tls;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<TelemetryOptions>, I>>(base?: I): TelemetryOptions {
-    return TelemetryOptions.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<GRPCHooksOptions>, I>>(base?: I): GRPCHooksOptions {
+    return GRPCHooksOptions.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<TelemetryOptions>, I>>(object: I): TelemetryOptions {
-    const message = createBaseTelemetryOptions();
-    message.enabled = (object.enabled !== undefined && object.enabled !== null)
-      ? ConfigurationVariable.fromPartial(object.enabled)
-      : undefined;
-    message.exporterHttpEndpoint = (object.exporterHttpEndpoint !== undefined && object.exporterHttpEndpoint !== null)
-      ? ConfigurationVariable.fromPartial(object.exporterHttpEndpoint)
-      : undefined;
-    message.sampler = (object.sampler !== undefined && object.sampler !== null)
-      ? ConfigurationVariable.fromPartial(object.sampler)
-      : undefined;
-    message.authToken = (object.authToken !== undefined && object.authToken !== null)
-      ? ConfigurationVariable.fromPartial(object.authToken)
+  fromPartial<I extends Exact<DeepPartial<GRPCHooksOptions>, I>>(object: I): GRPCHooksOptions {
+    const message = createBaseGRPCHooksOptions();
+    message.address = (object.address !== undefined && object.address !== null)
+      ? ConfigurationVariable.fromPartial(object.address)
       : undefined;
+    message.tls = object.tls ?? false;
     return message;
   },
 };
 
-function createBaseServerLogging(): ServerLogging {
-  return { level: undefined };
+function createBaseWasmHooksOptions(): WasmHooksOptions {
+  return { modulePath: undefined, maxMemoryMb: 0, timeoutMs: 0, hookLimits: [] };
 }
 
-export const ServerLogging = {
-  encode(message: ServerLogging, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.level !== undefined) {
-      ConfigurationVariable.encode(message.level, writer.uint32(10).fork()).ldelim();
+export const WasmHooksOptions = {
+  encode(message: WasmHooksOptions, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.modulePath !== undefined) {
+      ConfigurationVariable.encode(message.modulePath, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.maxMemoryMb !== 0) {
+      writer.uint32(16).int32(message.maxMemoryMb);
+    }
+    if (message.timeoutMs !== 0) {
+      writer.uint32(24).int64(message.timeoutMs);
+    }
+    for (const v of message.hookLimits) {
+      WasmHookLimits.encode(v!, writer.uint32(34).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ServerLogging {
+  decode(input: _m0.Reader | Uint8Array, length?: number): WasmHooksOptions {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseServerLogging();
+    const message = createBaseWasmHooksOptions();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -8974,7 +12236,28 @@ export const ServerLogging = {
             break;
           }
 
-          message.level = ConfigurationVariable.decode(reader, reader.uint32());
+          message.modulePath = ConfigurationVariable.decode(reader, reader.uint32());
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.maxMemoryMb = reader.int32();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.timeoutMs = longToNumber(reader.int64() as Long);
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.hookLimits.push(WasmHookLimits.decode(reader, reader.uint32()));
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -8985,53 +12268,72 @@ export const ServerLogging = {
     return message;
   },
 
-  fromJSON(object: any): ServerLogging {
-    return { level: isSet(object.level) ? ConfigurationVariable.fromJSON(object.level) : undefined };
+  fromJSON(object: any): WasmHooksOptions {
+    return {
+      modulePath: isSet(object.modulePath) ? ConfigurationVariable.fromJSON(object.modulePath) : undefined,
+      maxMemoryMb: isSet(object.maxMemoryMb) ? Number(object.maxMemoryMb) : 0,
+      timeoutMs: isSet(object.timeoutMs) ? Number(object.timeoutMs) : 0,
+      hookLimits: Array.isArray(object?.hookLimits)
+        ? object.hookLimits.map((e: any) => WasmHookLimits.fromJSON(e))
+        : [],
+    };
   },
 
-  toJSON(message: ServerLogging): unknown {
+  toJSON(message: WasmHooksOptions): unknown {
     const obj: any = {};
-    if (message.level !== undefined) {
-      obj.level = ConfigurationVariable.toJSON(message.level);
+    if (message.modulePath !== undefined) {
+      obj.modulePath = ConfigurationVariable.toJSON(message.modulePath);
+    }
+    if (message.maxMemoryMb !== 0) {
+      obj.maxMemoryMb = Math.round(message.maxMemoryMb);
+    }
+    if (message.timeoutMs !== 0) {
+      obj.timeoutMs = Math.round(message.timeoutMs);
+    }
+    if (message.hookLimits?.length) {
+      obj.hookLimits = message.hookLimits.map((e) => WasmHookLimits.toJSON(e));
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<ServerLogging>, I>>(base?: I): ServerLogging {
-    return ServerLogging.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<WasmHooksOptions>, I>>(base?: I): WasmHooksOptions {
+    return WasmHooksOptions.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<ServerLogging>, I>>(object: I): ServerLogging {
-    const message = createBaseServerLogging();
-    message.level = (object.level !== undefined && object.level !== null)
-      ? ConfigurationVariable.fromPartial(object.level)
+  fromPartial<I extends Exact<DeepPartial<WasmHooksOptions>, I>>(object: I): WasmHooksOptions {
+    const message = createBaseWasmHooksOptions();
+    message.modulePath = (object.modulePath !== undefined && object.modulePath !== null)
+      ? ConfigurationVariable.fromPartial(object.modulePath)
       : undefined;
+    message.maxMemoryMb = object.maxMemoryMb ?? 0;
+    message.timeoutMs = object.timeoutMs ?? 0;
+    message.hookLimits = object.hookLimits?.map((e) => WasmHookLimits.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseServerOptions(): ServerOptions {
-  return { serverUrl: undefined, listen: undefined, logger: undefined };
+function createBaseWasmHookLimits(): WasmHookLimits {
+  return { hook: "", maxMemoryMb: 0, timeoutMs: 0 };
 }
 
-export const ServerOptions = {
-  encode(message: ServerOptions, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.serverUrl !== undefined) {
-      ConfigurationVariable.encode(message.serverUrl, writer.uint32(10).fork()).ldelim();
+export const WasmHookLimits = {
+  encode(message: WasmHookLimits, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.hook !== "") {
+      writer.uint32(10).string(message.hook);
     }
-    if (message.listen !== undefined) {
-      ListenerOptions.encode(message.listen, writer.uint32(18).fork()).ldelim();
+    if (message.maxMemoryMb !== 0) {
+      writer.uint32(16).int32(message.maxMemoryMb);
     }
-    if (message.logger !== undefined) {
-      ServerLogging.encode(message.logger, writer.uint32(26).fork()).ldelim();
+    if (message.timeoutMs !== 0) {
+      writer.uint32(24).int64(message.timeoutMs);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ServerOptions {
+  decode(input: _m0.Reader | Uint8Array, length?: number): WasmHookLimits {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseServerOptions();
+    const message = createBaseWasmHookLimits();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -9040,21 +12342,21 @@ export const ServerOptions = {
             break;
           }
 
-          message.serverUrl = ConfigurationVariable.decode(reader, reader.uint32());
+          message.hook = reader.string();
           continue;
         case 2:
-          if (tag !== 18) {
+          if (tag !== 16) {
             break;
           }
 
-          message.listen = ListenerOptions.decode(reader, reader.uint32());
+          message.maxMemoryMb = reader.int32();
           continue;
         case 3:
-          if (tag !== 26) {
+          if (tag !== 24) {
             break;
           }
 
-          message.logger = ServerLogging.decode(reader, reader.uint32());
+          message.timeoutMs = longToNumber(reader.int64() as Long);
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -9065,49 +12367,43 @@ export const ServerOptions = {
     return message;
   },
 
-  fromJSON(object: any): ServerOptions {
+  fromJSON(object: any): WasmHookLimits {
     return {
-      serverUrl: isSet(object.serverUrl) ? ConfigurationVariable.fromJSON(object.serverUrl) : undefined,
-      listen: isSet(object.listen) ? ListenerOptions.fromJSON(object.listen) : undefined,
-      logger: isSet(object.logger) ? ServerLogging.fromJSON(object.logger) : undefined,
+      hook: isSet(object.hook) ? String(object.hook) : "",
+      maxMemoryMb: isSet(object.maxMemoryMb) ? Number(object.maxMemoryMb) : 0,
+      timeoutMs: isSet(object.timeoutMs) ? Number(object.timeoutMs) : 0,
     };
   },
 
-  toJSON(message: ServerOptions): unknown {
+  toJSON(message: WasmHookLimits): unknown {
     const obj: any = {};
-    if (message.serverUrl !== undefined) {
-      obj.serverUrl = ConfigurationVariable.toJSON(message.serverUrl);
+    if (message.hook !== "") {
+      obj.hook = message.hook;
     }
-    if (message.listen !== undefined) {
-      obj.listen = ListenerOptions.toJSON(message.listen);
+    if (message.maxMemoryMb !== 0) {
+      obj.maxMemoryMb = Math.round(message.maxMemoryMb);
     }
-    if (message.logger !== undefined) {
-      obj.logger = ServerLogging.toJSON(message.logger);
+    if (message.timeoutMs !== 0) {
+      obj.timeoutMs = Math.round(message.timeoutMs);
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<ServerOptions>, I>>(base?: I): ServerOptions {
-    return ServerOptions.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<WasmHookLimits>, I>>(base?: I): WasmHookLimits {
+    return WasmHookLimits.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<ServerOptions>, I>>(object: I): ServerOptions {
-    const message = createBaseServerOptions();
-    message.serverUrl = (object.serverUrl !== undefined && object.serverUrl !== null)
-      ? ConfigurationVariable.fromPartial(object.serverUrl)
-      : undefined;
-    message.listen = (object.listen !== undefined && object.listen !== null)
-      ? ListenerOptions.fromPartial(object.listen)
-      : undefined;
-    message.logger = (object.logger !== undefined && object.logger !== null)
-      ? ServerLogging.fromPartial(object.logger)
-      : undefined;
+  fromPartial<I extends Exact<DeepPartial<WasmHookLimits>, I>>(object: I): WasmHookLimits {
+    const message = createBaseWasmHookLimits();
+    message.hook = object.hook ?? "";
+    message.maxMemoryMb = object.maxMemoryMb ?? 0;
+    message.timeoutMs = object.timeoutMs ?? 0;
     return message;
   },
 };
 
 function createBaseWebhookConfiguration(): WebhookConfiguration {
-  return { name: "", filePath: "", verifier: undefined };
+  return { name: "", filePath: "", verifier: undefined, queued: false, maxDeliveryAttempts: 0, publishEvents: false };
 }
 
 export const WebhookConfiguration = {
@@ -9121,6 +12417,15 @@ export const WebhookConfiguration = {
     if (message.verifier !== undefined) {
       WebhookVerifier.encode(message.verifier, writer.uint32(26).fork()).ldelim();
     }
+    if (message.queued === true) {
+      writer.uint32(32).bool(message.queued);
+    }
+    if (message.maxDeliveryAttempts !== 0) {
+      writer.uint32(40).int32(message.maxDeliveryAttempts);
+    }
+    if (message.publishEvents === true) {
+      writer.uint32(48).bool(message.publishEvents);
+    }
     return writer;
   },
 
@@ -9152,6 +12457,27 @@ export const WebhookConfiguration = {
 
           message.verifier = WebhookVerifier.decode(reader, reader.uint32());
           continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.queued = reader.bool();
+          continue;
+        case 5:
+          if (tag !== 40) {
+            break;
+          }
+
+          message.maxDeliveryAttempts = reader.int32();
+          continue;
+        case 6:
+          if (tag !== 48) {
+            break;
+          }
+
+          message.publishEvents = reader.bool();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -9166,6 +12492,9 @@ export const WebhookConfiguration = {
       name: isSet(object.name) ? String(object.name) : "",
       filePath: isSet(object.filePath) ? String(object.filePath) : "",
       verifier: isSet(object.verifier) ? WebhookVerifier.fromJSON(object.verifier) : undefined,
+      queued: isSet(object.queued) ? Boolean(object.queued) : false,
+      maxDeliveryAttempts: isSet(object.maxDeliveryAttempts) ? Number(object.maxDeliveryAttempts) : 0,
+      publishEvents: isSet(object.publishEvents) ? Boolean(object.publishEvents) : false,
     };
   },
 
@@ -9180,6 +12509,15 @@ export const WebhookConfiguration = {
     if (message.verifier !== undefined) {
       obj.verifier = WebhookVerifier.toJSON(message.verifier);
     }
+    if (message.queued === true) {
+      obj.queued = message.queued;
+    }
+    if (message.maxDeliveryAttempts !== 0) {
+      obj.maxDeliveryAttempts = Math.round(message.maxDeliveryAttempts);
+    }
+    if (message.publishEvents === true) {
+      obj.publishEvents = message.publishEvents;
+    }
     return obj;
   },
 
@@ -9194,12 +12532,164 @@ export const WebhookConfiguration = {
     message.verifier = (object.verifier !== undefined && object.verifier !== null)
       ? WebhookVerifier.fromPartial(object.verifier)
       : undefined;
+    message.queued = object.queued ?? false;
+    message.maxDeliveryAttempts = object.maxDeliveryAttempts ?? 0;
+    message.publishEvents = object.publishEvents ?? false;
+    return message;
+  },
+};
+
+function createBaseEventWebhookConfiguration(): EventWebhookConfiguration {
+  return { name: "", url: undefined, secret: undefined, operations: [], responseFilter: "", maxDeliveryAttempts: 0 };
+}
+
+export const EventWebhookConfiguration = {
+  encode(message: EventWebhookConfiguration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.url !== undefined) {
+      ConfigurationVariable.encode(message.url, writer.uint32(18).fork()).ldelim();
+    }
+    if (message.secret !== undefined) {
+      ConfigurationVariable.encode(message.secret, writer.uint32(26).fork()).ldelim();
+    }
+    for (const v of message.operations) {
+      writer.uint32(34).string(v!);
+    }
+    if (message.responseFilter !== "") {
+      writer.uint32(42).string(message.responseFilter);
+    }
+    if (message.maxDeliveryAttempts !== 0) {
+      writer.uint32(48).int32(message.maxDeliveryAttempts);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): EventWebhookConfiguration {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseEventWebhookConfiguration();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.url = ConfigurationVariable.decode(reader, reader.uint32());
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.secret = ConfigurationVariable.decode(reader, reader.uint32());
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.operations.push(reader.string());
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.responseFilter = reader.string();
+          continue;
+        case 6:
+          if (tag !== 48) {
+            break;
+          }
+
+          message.maxDeliveryAttempts = reader.int32();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): EventWebhookConfiguration {
+    return {
+      name: isSet(object.name) ? String(object.name) : "",
+      url: isSet(object.url) ? ConfigurationVariable.fromJSON(object.url) : undefined,
+      secret: isSet(object.secret) ? ConfigurationVariable.fromJSON(object.secret) : undefined,
+      operations: Array.isArray(object?.operations) ? object.operations.map((e: any) => String(e)) : [],
+      responseFilter: isSet(object.responseFilter) ? String(object.responseFilter) : "",
+      maxDeliveryAttempts: isSet(object.maxDeliveryAttempts) ? Number(object.maxDeliveryAttempts) : 0,
+    };
+  },
+
+  toJSON(message: EventWebhookConfiguration): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.url !== undefined) {
+      obj.url = ConfigurationVariable.toJSON(message.url);
+    }
+    if (message.secret !== undefined) {
+      obj.secret = ConfigurationVariable.toJSON(message.secret);
+    }
+    if (message.operations?.length) {
+      obj.operations = message.operations;
+    }
+    if (message.responseFilter !== "") {
+      obj.responseFilter = message.responseFilter;
+    }
+    if (message.maxDeliveryAttempts !== 0) {
+      obj.maxDeliveryAttempts = Math.round(message.maxDeliveryAttempts);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<EventWebhookConfiguration>, I>>(base?: I): EventWebhookConfiguration {
+    return EventWebhookConfiguration.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<EventWebhookConfiguration>, I>>(object: I): EventWebhookConfiguration {
+    const message = createBaseEventWebhookConfiguration();
+    message.name = object.name ?? "";
+    message.url = (object.url !== undefined && object.url !== null)
+      ? ConfigurationVariable.fromPartial(object.url)
+      : undefined;
+    message.secret = (object.secret !== undefined && object.secret !== null)
+      ? ConfigurationVariable.fromPartial(object.secret)
+      : undefined;
+    message.operations = object.operations?.map((e) => e) || [];
+    message.responseFilter = object.responseFilter ?? "";
+    message.maxDeliveryAttempts = object.maxDeliveryAttempts ?? 0;
     return message;
   },
 };
 
 function createBaseWebhookVerifier(): WebhookVerifier {
-  return { kind: 0, secret: undefined, signatureHeader: "", signatureHeaderPrefix: "" };
+  return {
+    kind: 0,
+    secret: undefined,
+    signatureHeader: "",
+    signatureHeaderPrefix: "",
+    additionalSecrets: [],
+    timestampHeader: "",
+    toleranceSeconds: 0,
+    idHeader: "",
+    replayCacheSize: 0,
+  };
 }
 
 export const WebhookVerifier = {
@@ -9216,6 +12706,21 @@ export const WebhookVerifier = {
     if (message.signatureHeaderPrefix !== "") {
       writer.uint32(34).string(message.signatureHeaderPrefix);
     }
+    for (const v of message.additionalSecrets) {
+      ConfigurationVariable.encode(v!, writer.uint32(42).fork()).ldelim();
+    }
+    if (message.timestampHeader !== "") {
+      writer.uint32(50).string(message.timestampHeader);
+    }
+    if (message.toleranceSeconds !== 0) {
+      writer.uint32(56).int64(message.toleranceSeconds);
+    }
+    if (message.idHeader !== "") {
+      writer.uint32(66).string(message.idHeader);
+    }
+    if (message.replayCacheSize !== 0) {
+      writer.uint32(72).int32(message.replayCacheSize);
+    }
     return writer;
   },
 
@@ -9254,6 +12759,41 @@ export const WebhookVerifier = {
 
           message.signatureHeaderPrefix = reader.string();
           continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.additionalSecrets.push(ConfigurationVariable.decode(reader, reader.uint32()));
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.timestampHeader = reader.string();
+          continue;
+        case 7:
+          if (tag !== 56) {
+            break;
+          }
+
+          message.toleranceSeconds = longToNumber(reader.int64() as Long);
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.idHeader = reader.string();
+          continue;
+        case 9:
+          if (tag !== 72) {
+            break;
+          }
+
+          message.replayCacheSize = reader.int32();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -9269,6 +12809,13 @@ export const WebhookVerifier = {
       secret: isSet(object.secret) ? ConfigurationVariable.fromJSON(object.secret) : undefined,
       signatureHeader: isSet(object.signatureHeader) ? String(object.signatureHeader) : "",
       signatureHeaderPrefix: isSet(object.signatureHeaderPrefix) ? String(object.signatureHeaderPrefix) : "",
+      additionalSecrets: Array.isArray(object?.additionalSecrets)
+        ? object.additionalSecrets.map((e: any) => ConfigurationVariable.fromJSON(e))
+        : [],
+      timestampHeader: isSet(object.timestampHeader) ? String(object.timestampHeader) : "",
+      toleranceSeconds: isSet(object.toleranceSeconds) ? Number(object.toleranceSeconds) : 0,
+      idHeader: isSet(object.idHeader) ? String(object.idHeader) : "",
+      replayCacheSize: isSet(object.replayCacheSize) ? Number(object.replayCacheSize) : 0,
     };
   },
 
@@ -9286,6 +12833,21 @@ export const WebhookVerifier = {
     if (message.signatureHeaderPrefix !== "") {
       obj.signatureHeaderPrefix = message.signatureHeaderPrefix;
     }
+    if (message.additionalSecrets?.length) {
+      obj.additionalSecrets = message.additionalSecrets.map((e) => ConfigurationVariable.toJSON(e));
+    }
+    if (message.timestampHeader !== "") {
+      obj.timestampHeader = message.timestampHeader;
+    }
+    if (message.toleranceSeconds !== 0) {
+      obj.toleranceSeconds = Math.round(message.toleranceSeconds);
+    }
+    if (message.idHeader !== "") {
+      obj.idHeader = message.idHeader;
+    }
+    if (message.replayCacheSize !== 0) {
+      obj.replayCacheSize = Math.round(message.replayCacheSize);
+    }
     return obj;
   },
 
@@ -9301,6 +12863,11 @@ export const WebhookVerifier = {
       : undefined;
     message.signatureHeader = object.signatureHeader ?? "";
     message.signatureHeaderPrefix = object.signatureHeaderPrefix ?? "";
+    message.additionalSecrets = object.additionalSecrets?.map((e) => ConfigurationVariable.fromPartial(e)) || [];
+    message.timestampHeader = object.timestampHeader ?? "";
+    message.toleranceSeconds = object.toleranceSeconds ?? 0;
+    message.idHeader = object.idHeader ?? "";
+    message.replayCacheSize = object.replayCacheSize ?? 0;
     return message;
   },
 };
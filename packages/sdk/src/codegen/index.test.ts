@@ -40,6 +40,8 @@ export const RunTemplateTest = async (...templates: Template[]) => {
 			wunderGraphConfig: {
 				sdkVersion: 'unknown',
 				webhooks: [],
+				eventWebhooks: [],
+				originHooks: [],
 				nodeOptions: {
 					nodeUrl: {
 						kind: ConfigurationVariableKind.STATIC_CONFIGURATION_VARIABLE,
@@ -104,6 +106,7 @@ export const RunTemplateTest = async (...templates: Template[]) => {
 						environmentVariableDefaultValue: '',
 						placeholderVariableName: '',
 					},
+					webhookQueue: undefined,
 					prometheus: {
 						enabled: {
 							kind: ConfigurationVariableKind.STATIC_CONFIGURATION_VARIABLE,
@@ -184,6 +187,11 @@ export const RunTemplateTest = async (...templates: Template[]) => {
 							placeholderVariableName: '',
 						},
 					},
+					wasmHooks: undefined,
+					grpcHooks: undefined,
+					serverPool: undefined,
+					hookPolicies: [],
+					asyncHooks: undefined,
 				},
 				application: {
 					Apis: [],
@@ -18,6 +18,12 @@ export class AuthenticationProviderConfiguration implements Template {
 						case AuthProviderKind.AuthProviderOIDC:
 							providerKind = 'OpenID Connect';
 							break;
+						case AuthProviderKind.AuthProviderOAuth2:
+							providerKind = 'OAuth2';
+							break;
+						case AuthProviderKind.AuthProviderSAML:
+							providerKind = 'SAML';
+							break;
 					}
 					return {
 						protocol: 'http',
@@ -8,6 +8,8 @@ import type {
 	PreUploadHookResponse,
 	PostUploadHookRequest,
 	PostUploadHookResponse,
+	PreDownloadHookRequest,
+	PreDownloadHookResponse,
 	QueryHook,
 	QueryHookWithoutInput,
 	MutationHook,
@@ -62,6 +64,7 @@ export interface UploadHooks<TCustomContext = any> {
                 {{@key}}?: {
                     preUpload?: (hook: PreUploadHookRequest<User, TCustomContext>) => PreUploadHookResponse;
                     postUpload?: (hook: PostUploadHookRequest<User, TCustomContext>) => PostUploadHookResponse;
+                    preDownload?: (hook: PreDownloadHookRequest<User, TCustomContext>) => PreDownloadHookResponse;
                 }
             {{/each}}
         }
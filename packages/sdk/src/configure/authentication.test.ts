@@ -0,0 +1,101 @@
+import { AuthProviderKind, RoleMappingRuleKind } from '@wundergraph/protobuf';
+
+import { authProviders } from './authentication';
+import { mapInputVariable } from './variables';
+
+describe('authProviders', () => {
+	it('should resolve an OAuth2 provider', () => {
+		const oauth2 = authProviders.oauth2({
+			id: 'example',
+			clientId: 'client',
+			clientSecret: 'secret',
+			authorizationUrl: 'https://example.com/oauth/authorize',
+			tokenUrl: 'https://example.com/oauth/token',
+			userInfoUrl: 'https://example.com/api/me',
+			scopes: ['profile'],
+			claimMapping: { sub: 'id', name: 'profile.displayName' },
+		});
+		const provider = oauth2.resolve();
+
+		expect(provider.id).toBe('example');
+		expect(provider.kind).toBe(AuthProviderKind.AuthProviderOAuth2);
+		expect(provider.githubConfig).toBeUndefined();
+		expect(provider.oidcConfig).toBeUndefined();
+		expect(provider.oauth2Config).toEqual({
+			clientId: mapInputVariable('client'),
+			clientSecret: mapInputVariable('secret'),
+			authorizationUrl: mapInputVariable('https://example.com/oauth/authorize'),
+			tokenUrl: mapInputVariable('https://example.com/oauth/token'),
+			userInfoUrl: mapInputVariable('https://example.com/api/me'),
+			scopes: ['profile'],
+			queryParameters: [],
+			claimMapping: { sub: 'id', name: 'profile.displayName' },
+		});
+	});
+
+	it('should resolve a SAML provider', () => {
+		const saml = authProviders.saml({
+			id: 'okta',
+			idpMetadataUrl: 'https://example.okta.com/app/metadata',
+			signRequests: true,
+			attributeMapping: { email: 'mail' },
+			rolesAttribute: 'groups',
+		});
+		const provider = saml.resolve();
+
+		expect(provider.kind).toBe(AuthProviderKind.AuthProviderSAML);
+		expect(provider.oauth2Config).toBeUndefined();
+		expect(provider.samlConfig).toEqual({
+			idpMetadataUrl: mapInputVariable('https://example.okta.com/app/metadata'),
+			idpMetadataXml: mapInputVariable(''),
+			entityId: mapInputVariable(''),
+			certificate: mapInputVariable(''),
+			privateKey: mapInputVariable(''),
+			signRequests: true,
+			allowIdpInitiated: false,
+			nameIdFormat: mapInputVariable(''),
+			attributeMapping: { email: 'mail' },
+			rolesAttribute: 'groups',
+		});
+	});
+
+	it('should require the metadata of the SAML identity provider', () => {
+		const saml = authProviders.saml({ id: 'okta' });
+		expect(() => saml.resolve()).toThrow('SAML auth provider okta requires either idpMetadataUrl or idpMetadataXml');
+	});
+
+	it('should leave the OAuth2 and SAML configs of other providers empty', () => {
+		const oidc = authProviders.openIdConnect({
+			id: 'oidc',
+			issuer: 'https://example.com',
+			clientId: 'client',
+			clientSecret: 'secret',
+		});
+		const provider = oidc.resolve();
+		expect(provider.oauth2Config).toBeUndefined();
+		expect(provider.samlConfig).toBeUndefined();
+	});
+
+	it('should resolve role mappings', () => {
+		const github = authProviders.github({
+			id: 'github',
+			clientId: 'client',
+			clientSecret: 'secret',
+			roleMappings: [
+				{ role: 'admin', kind: 'githubTeam', value: 'wundergraph/core' },
+				{ role: 'user', kind: 'claimContains', claim: 'customClaims.groups', value: 'users' },
+			],
+		});
+		const provider = github.resolve();
+
+		expect(provider.roleMappings).toEqual([
+			{ role: 'admin', kind: RoleMappingRuleKind.RoleMappingGithubTeam, claim: '', value: 'wundergraph/core' },
+			{
+				role: 'user',
+				kind: RoleMappingRuleKind.RoleMappingClaimContains,
+				claim: 'customClaims.groups',
+				value: 'users',
+			},
+		]);
+	});
+});
@@ -1,14 +1,61 @@
-import { AuthProvider, AuthProviderKind, OpenIDConnectQueryParameter } from '@wundergraph/protobuf';
+import {
+	AuthProvider,
+	AuthProviderKind,
+	OpenIDConnectQueryParameter,
+	RoleMappingRule as _RoleMappingRule,
+	RoleMappingRuleKind,
+} from '@wundergraph/protobuf';
 import { InputVariable, mapInputVariable } from './variables';
 
 export interface AuthenticationProvider {
 	resolve: () => AuthProvider;
 }
 
+export interface RoleMappingRule {
+	/**
+	 * Role assigned to the users matching the rule
+	 */
+	role: string;
+	/**
+	 * - claimEquals: the claim equals the value
+	 * - claimContains: the claim is a list containing the value, or a string containing it
+	 * - emailDomain: the email of the user belongs to the domain in value
+	 * - githubOrganization: the user is a member of the GitHub organization in value
+	 * - githubTeam: the user is a member of the GitHub team in value, as organization/team-slug
+	 */
+	kind: 'claimEquals' | 'claimContains' | 'emailDomain' | 'githubOrganization' | 'githubTeam';
+	/**
+	 * Claim name or dot separated path to a custom claim, used by claimEquals and claimContains
+	 */
+	claim?: string;
+	value: string;
+}
+
+const roleMappingRuleKinds: Record<RoleMappingRule['kind'], RoleMappingRuleKind> = {
+	claimEquals: RoleMappingRuleKind.RoleMappingClaimEquals,
+	claimContains: RoleMappingRuleKind.RoleMappingClaimContains,
+	emailDomain: RoleMappingRuleKind.RoleMappingEmailDomain,
+	githubOrganization: RoleMappingRuleKind.RoleMappingGithubOrganization,
+	githubTeam: RoleMappingRuleKind.RoleMappingGithubTeam,
+};
+
+export const mapRoleMappings = (rules?: RoleMappingRule[]): _RoleMappingRule[] => {
+	return (rules || []).map((rule) => ({
+		role: rule.role,
+		kind: roleMappingRuleKinds[rule.kind],
+		claim: rule.claim || '',
+		value: rule.value,
+	}));
+};
+
 export interface GithubAuthProviderConfig {
 	id: string;
 	clientId: InputVariable;
 	clientSecret: InputVariable;
+	/**
+	 * Rules assigning roles to the users, without requiring a mutatingPostAuthentication hook
+	 */
+	roleMappings?: RoleMappingRule[];
 }
 
 export class GithubAuthProvider implements AuthenticationProvider {
@@ -26,6 +73,9 @@ export class GithubAuthProvider implements AuthenticationProvider {
 				clientSecret: mapInputVariable(this.config.clientSecret),
 			},
 			oidcConfig: undefined,
+			oauth2Config: undefined,
+			samlConfig: undefined,
+			roleMappings: mapRoleMappings(this.config.roleMappings),
 			id: this.config.id,
 		};
 	}
@@ -42,6 +92,7 @@ export interface OpenIDConnectAuthProviderConfig {
 	clientId: InputVariable;
 	clientSecret: InputVariable;
 	queryParameters?: OpenIDConnectQueryParameterConfig[];
+	roleMappings?: RoleMappingRule[];
 }
 
 export class OpenIDConnectAuthProvider implements AuthenticationProvider {
@@ -68,6 +119,9 @@ export class OpenIDConnectAuthProvider implements AuthenticationProvider {
 				issuer: mapInputVariable(this.config.issuer),
 				queryParameters: queryParameters || [],
 			},
+			oauth2Config: undefined,
+			samlConfig: undefined,
+			roleMappings: mapRoleMappings(this.config.roleMappings),
 			id: this.config.id,
 		};
 	}
@@ -77,6 +131,7 @@ export interface GoogleAuthProviderConfig {
 	id: string;
 	clientId: InputVariable;
 	clientSecret: InputVariable;
+	roleMappings?: RoleMappingRule[];
 }
 
 export class Auth0AuthProvider extends OpenIDConnectAuthProvider {
@@ -88,11 +143,142 @@ export class Auth0AuthProvider extends OpenIDConnectAuthProvider {
 	}
 }
 
+export interface OAuth2AuthProviderConfig {
+	id: string;
+	clientId: InputVariable;
+	clientSecret: InputVariable;
+	authorizationUrl: InputVariable;
+	tokenUrl: InputVariable;
+	/**
+	 * URL returning the user as a JSON object, requested with the access token
+	 */
+	userInfoUrl: InputVariable;
+	scopes?: string[];
+	queryParameters?: OpenIDConnectQueryParameterConfig[];
+	/**
+	 * Maps OpenID Connect claim names (e.g. sub, email, picture) to dot separated
+	 * paths into the object returned by userInfoUrl, e.g. { sub: 'id', name: 'profile.displayName' }
+	 */
+	claimMapping?: Record<string, string>;
+	roleMappings?: RoleMappingRule[];
+}
+
+export class OAuth2AuthProvider implements AuthenticationProvider {
+	private readonly config: OAuth2AuthProviderConfig;
+
+	constructor(config: OAuth2AuthProviderConfig) {
+		this.config = config;
+	}
+
+	resolve(): AuthProvider {
+		const queryParameters = this.config.queryParameters?.map(
+			(param): OpenIDConnectQueryParameter => ({
+				name: mapInputVariable(param.name),
+				value: mapInputVariable(param.value),
+			})
+		);
+
+		return {
+			kind: AuthProviderKind.AuthProviderOAuth2,
+			githubConfig: undefined,
+			oidcConfig: undefined,
+			oauth2Config: {
+				clientId: mapInputVariable(this.config.clientId),
+				clientSecret: mapInputVariable(this.config.clientSecret),
+				authorizationUrl: mapInputVariable(this.config.authorizationUrl),
+				tokenUrl: mapInputVariable(this.config.tokenUrl),
+				userInfoUrl: mapInputVariable(this.config.userInfoUrl),
+				scopes: this.config.scopes || [],
+				queryParameters: queryParameters || [],
+				claimMapping: this.config.claimMapping || {},
+			},
+			samlConfig: undefined,
+			roleMappings: mapRoleMappings(this.config.roleMappings),
+			id: this.config.id,
+		};
+	}
+}
+
+export interface SAMLAuthProviderConfig {
+	id: string;
+	/**
+	 * URL of the identity provider metadata, either idpMetadataUrl or idpMetadataXml must be set
+	 */
+	idpMetadataUrl?: InputVariable;
+	idpMetadataXml?: InputVariable;
+	/**
+	 * @default the URL of the service provider metadata
+	 */
+	entityId?: InputVariable;
+	/**
+	 * PEM encoded certificate and RSA private key of the service provider,
+	 * required for signing requests and decrypting assertions
+	 */
+	certificate?: InputVariable;
+	privateKey?: InputVariable;
+	/**
+	 * @default false
+	 */
+	signRequests?: boolean;
+	/**
+	 * Accept responses not initiated by a login request
+	 *
+	 * @default false
+	 */
+	allowIdpInitiated?: boolean;
+	nameIdFormat?: InputVariable;
+	/**
+	 * Maps OpenID Connect claim names (e.g. email, name) to SAML attribute names or friendly names
+	 */
+	attributeMapping?: Record<string, string>;
+	/**
+	 * SAML attribute used to populate the user roles
+	 */
+	rolesAttribute?: string;
+	roleMappings?: RoleMappingRule[];
+}
+
+export class SAMLAuthProvider implements AuthenticationProvider {
+	private readonly config: SAMLAuthProviderConfig;
+
+	constructor(config: SAMLAuthProviderConfig) {
+		this.config = config;
+	}
+
+	resolve(): AuthProvider {
+		if (!this.config.idpMetadataUrl && !this.config.idpMetadataXml) {
+			throw new Error(`SAML auth provider ${this.config.id} requires either idpMetadataUrl or idpMetadataXml`);
+		}
+		return {
+			kind: AuthProviderKind.AuthProviderSAML,
+			githubConfig: undefined,
+			oidcConfig: undefined,
+			oauth2Config: undefined,
+			samlConfig: {
+				idpMetadataUrl: mapInputVariable(this.config.idpMetadataUrl || ''),
+				idpMetadataXml: mapInputVariable(this.config.idpMetadataXml || ''),
+				entityId: mapInputVariable(this.config.entityId || ''),
+				certificate: mapInputVariable(this.config.certificate || ''),
+				privateKey: mapInputVariable(this.config.privateKey || ''),
+				signRequests: this.config.signRequests ?? false,
+				allowIdpInitiated: this.config.allowIdpInitiated ?? false,
+				nameIdFormat: mapInputVariable(this.config.nameIdFormat || ''),
+				attributeMapping: this.config.attributeMapping || {},
+				rolesAttribute: this.config.rolesAttribute || '',
+			},
+			roleMappings: mapRoleMappings(this.config.roleMappings),
+			id: this.config.id,
+		};
+	}
+}
+
 export const authProviders = {
 	github: (config: GithubAuthProviderConfig) => new GithubAuthProvider(config),
 	demo: () => new GithubAuthProvider({ id: 'github', clientId: 'demo', clientSecret: 'demo' }),
 	openIdConnect: (config: OpenIDConnectAuthProviderConfig) => new OpenIDConnectAuthProvider(config),
 	auth0: (config: OpenIDConnectAuthProviderConfig) => new Auth0AuthProvider(config),
+	oauth2: (config: OAuth2AuthProviderConfig) => new OAuth2AuthProvider(config),
+	saml: (config: SAMLAuthProviderConfig) => new SAMLAuthProvider(config),
 	google: (config: GoogleAuthProviderConfig) =>
 		new OpenIDConnectAuthProvider({
 			...config,
@@ -0,0 +1,46 @@
+import { ExpressionHookAction } from '@wundergraph/protobuf';
+
+import { mapExpressionHooks } from './expressions';
+
+describe('mapExpressionHooks', () => {
+	it('should map the expression hooks', () => {
+		expect(
+			mapExpressionHooks([
+				{
+					action: 'setVariable',
+					condition: 'has(user.customClaims.tenant)',
+					target: 'input.tenant',
+					expression: 'user.customClaims.tenant',
+				},
+				{ action: 'reject', expression: '"forbidden"', statusCode: 401 },
+				{ action: 'skip', condition: 'event.data.message.private' },
+			])
+		).toEqual([
+			{
+				action: ExpressionHookAction.ExpressionHookSetVariable,
+				condition: 'has(user.customClaims.tenant)',
+				target: 'input.tenant',
+				expression: 'user.customClaims.tenant',
+				statusCode: 0,
+			},
+			{
+				action: ExpressionHookAction.ExpressionHookReject,
+				condition: '',
+				target: '',
+				expression: '"forbidden"',
+				statusCode: 401,
+			},
+			{
+				action: ExpressionHookAction.ExpressionHookSkip,
+				condition: 'event.data.message.private',
+				target: '',
+				expression: '',
+				statusCode: 0,
+			},
+		]);
+	});
+
+	it('should map missing expression hooks to an empty list', () => {
+		expect(mapExpressionHooks()).toEqual([]);
+	});
+});
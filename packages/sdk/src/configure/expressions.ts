@@ -0,0 +1,48 @@
+import { ExpressionHook as _ExpressionHook, ExpressionHookAction } from '@wundergraph/protobuf';
+
+/**
+ * ExpressionHook is a CEL expression evaluated by the WunderNode, without
+ * calling the WunderGraph server
+ */
+export interface ExpressionHook {
+	action: 'setHeader' | 'setVariable' | 'reject' | 'skip' | 'setEventField';
+	/**
+	 * Boolean expression, the hook only runs when it evaluates to true
+	 *
+	 * @default Always run the hook
+	 */
+	condition?: string;
+	/**
+	 * Header name for setHeader, or dot separated path of the variable for setVariable
+	 * or of the message field for setEventField
+	 */
+	target?: string;
+	/**
+	 * Value for setHeader, setVariable and setEventField, or the error message for reject
+	 */
+	expression?: string;
+	/**
+	 * Status code used by reject
+	 *
+	 * @default 403
+	 */
+	statusCode?: number;
+}
+
+const expressionHookActions: Record<ExpressionHook['action'], ExpressionHookAction> = {
+	setHeader: ExpressionHookAction.ExpressionHookSetHeader,
+	setVariable: ExpressionHookAction.ExpressionHookSetVariable,
+	reject: ExpressionHookAction.ExpressionHookReject,
+	skip: ExpressionHookAction.ExpressionHookSkip,
+	setEventField: ExpressionHookAction.ExpressionHookSetEventField,
+};
+
+export const mapExpressionHooks = (hooks?: ExpressionHook[]): _ExpressionHook[] => {
+	return (hooks || []).map((hook) => ({
+		action: expressionHookActions[hook.action],
+		condition: hook.condition || '',
+		target: hook.target || '',
+		expression: hook.expression || '',
+		statusCode: hook.statusCode ?? 0,
+	}));
+};
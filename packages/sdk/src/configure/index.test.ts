@@ -1,5 +1,8 @@
+import { HookType, ImageFit, ImageFormat, OperationType, UploadStorageKind } from '@wundergraph/protobuf';
 import { introspect } from '../definition';
 import { assert } from 'chai';
+import { mapUploadProvider, resolveEventWebhooks, ResolvedS3UploadProfile, resolveOriginHooks } from './index';
+import { mapInputVariable } from './variables';
 
 test.skip('introspect federation', async () => {
 	const generator = await introspect.federation({
@@ -14,3 +17,148 @@ test.skip('introspect federation', async () => {
 	const federated = await generator({});
 	assert.notEqual(federated.Schema, '');
 });
+
+describe('mapUploadProvider', () => {
+	const profile: ResolvedS3UploadProfile = {
+		requireAuthentication: true,
+		maxAllowedUploadSizeBytes: 1024,
+		maxAllowedFiles: 1,
+		allowedMimeTypes: ['image/*'],
+		allowedFileExtensions: [],
+		enableFiles: true,
+		downloadRoles: ['admin'],
+		deleteRoles: [],
+		proxyDownloads: true,
+		imageTransformations: [{ name: 'thumb', width: 128, fit: 'cover', format: 'webp' }],
+		meta: null,
+		preUploadHook: false,
+		postUploadHook: true,
+		preDownloadHook: true,
+	};
+
+	it('should map the storage and the files options', () => {
+		const provider = mapUploadProvider({
+			name: 'local',
+			storage: 'local',
+			directory: 'uploads',
+			endpoint: '',
+			accessKeyID: '',
+			secretAccessKey: '',
+			bucketName: '',
+			bucketLocation: '',
+			uploadProfiles: { avatar: profile },
+		});
+		expect(provider.storage).toBe(UploadStorageKind.UploadStorageLocal);
+		expect(provider.directory).toEqual(mapInputVariable('uploads'));
+		expect(provider.uploadProfiles['avatar']).toEqual({
+			requireAuthentication: true,
+			maxAllowedUploadSizeBytes: 1024,
+			maxAllowedFiles: 1,
+			allowedMimeTypes: ['image/*'],
+			allowedFileExtensions: [],
+			metadataJSONSchema: '',
+			hooks: {
+				preUpload: false,
+				postUpload: true,
+				preDownload: true,
+			},
+			downloadRoles: ['admin'],
+			deleteRoles: [],
+			proxyDownloads: true,
+			enableFiles: true,
+			imageTransformations: [
+				{
+					name: 'thumb',
+					width: 128,
+					height: 0,
+					fit: ImageFit.ImageFitCover,
+					format: ImageFormat.ImageFormatWebP,
+					quality: 0,
+				},
+			],
+		});
+	});
+
+	it('should default to S3', () => {
+		const provider = mapUploadProvider({
+			name: 's3',
+			endpoint: 'localhost:9000',
+			accessKeyID: 'key',
+			secretAccessKey: 'secret',
+			bucketName: 'uploads',
+			bucketLocation: 'eu-central-1',
+			uploadProfiles: {},
+		});
+		expect(provider.storage).toBe(UploadStorageKind.UploadStorageS3);
+		expect(provider.directory).toEqual(mapInputVariable(''));
+		expect(provider.credentialsJSON).toEqual(mapInputVariable(''));
+	});
+});
+
+describe('resolveEventWebhooks', () => {
+	it('should resolve the event webhooks', () => {
+		const webhooks = resolveEventWebhooks([
+			{
+				name: 'orders',
+				url: 'https://example.com/webhooks/orders',
+				secret: 'whsec_c2VjcmV0',
+				operations: ['CreateOrder'],
+				responseFilter: 'data.createOrder.paid',
+			},
+		]);
+		expect(webhooks).toEqual([
+			{
+				name: 'orders',
+				url: mapInputVariable('https://example.com/webhooks/orders'),
+				secret: mapInputVariable('whsec_c2VjcmV0'),
+				operations: ['CreateOrder'],
+				responseFilter: 'data.createOrder.paid',
+				maxDeliveryAttempts: 0,
+			},
+		]);
+	});
+});
+
+describe('resolveOriginHooks', () => {
+	it('should restrict the origin hooks to their matchers', () => {
+		const hooks = resolveOriginHooks({
+			onOriginRequest: {
+				hook: async () => 'skip',
+				enableForAllOperations: true,
+				match: {
+					operationType: 'mutation',
+					operationNames: ['payments/**'],
+					methods: ['POST'],
+					headers: [{ name: 'Content-Type', value: 'application/*' }],
+				},
+			},
+			onOriginResponse: {
+				hook: async () => 'skip',
+				enableForAllOperations: true,
+				match: [{ roles: ['admin'] }, { paths: ['/v1/users/*'] }],
+			},
+		});
+		expect(hooks.map((hook) => hook.type)).toEqual([
+			HookType.HTTP_ORIGIN_REQUEST,
+			HookType.HTTP_ORIGIN_RESPONSE,
+			HookType.HTTP_ORIGIN_RESPONSE,
+		]);
+		expect(hooks[0].matcher).toEqual({
+			operationType: OperationType.MUTATION,
+			datasources: [],
+			operationNames: ['payments/**'],
+			methods: ['POST'],
+			paths: [],
+			headers: [{ name: 'Content-Type', value: 'application/*' }],
+			roles: [],
+		});
+		expect(hooks[1].matcher?.roles).toEqual(['admin']);
+		expect(hooks[2].matcher?.paths).toEqual(['/v1/users/*']);
+		expect(hooks[1].id).not.toEqual(hooks[2].id);
+	});
+
+	it('should not add hooks without matchers', () => {
+		expect(resolveOriginHooks({ onOriginRequest: { hook: async () => 'skip' } })).toEqual([]);
+		expect(resolveOriginHooks()).toEqual([]);
+	});
+});
@@ -28,6 +28,7 @@ import {
 	RESTApiCustom,
 	StaticApiCustom,
 	WG_DATA_SOURCE_POLLING_MODE,
+	WebhookEventsApiCustom,
 } from '../definition';
 import { mergeApis } from '../definition/merge';
 import {
@@ -55,13 +56,19 @@ import {
 	CorsConfiguration,
 	DataSourceConfiguration,
 	DataSourceKind,
+	EventWebhookConfiguration as _EventWebhookConfiguration,
 	FieldConfiguration,
+	ImageFit,
+	ImageFormat,
+	ImageTransformation as _ImageTransformation,
 	Operation,
 	OperationExecutionEngine,
 	OperationType,
 	PostResolveTransformationKind,
+	S3UploadConfiguration as _S3UploadConfiguration,
 	S3UploadProfile as _S3UploadProfile,
 	TypeConfiguration,
+	UploadStorageKind,
 	ValueType,
 	WebhookConfiguration,
 	WunderGraphConfiguration,
@@ -69,19 +76,31 @@ import {
 	HookType,
 } from '@wundergraph/protobuf';
 import { SDK_VERSION } from '../version';
-import { AuthenticationProvider } from './authentication';
+import { AuthenticationProvider, mapRoleMappings, RoleMappingRule } from './authentication';
 import { findUp } from './findup';
 import { FieldInfo, LinkConfiguration, LinkDefinition, queryTypeFields } from '../linkbuilder';
 import { LocalCache } from '../localcache';
 import { OpenApiBuilder } from '../openapibuilder';
 import { PostmanBuilder } from '../postman/builder';
-import { CustomizeMutation, CustomizeQuery, CustomizeSubscription, OperationsConfiguration } from './operations';
-import { HooksConfiguration, ResolvedServerOptions, WunderGraphHooksAndServerConfig } from '../server/types';
-import { getWebhooks } from '../webhooks';
+import {
+	BaseOperationConfiguration,
+	CustomizeMutation,
+	CustomizeQuery,
+	CustomizeSubscription,
+	OperationsConfiguration,
+} from './operations';
+import { mapExpressionHooks } from './expressions';
+import {
+	GlobalHooksConfig,
+	HooksConfiguration,
+	ResolvedServerOptions,
+	WunderGraphHooksAndServerConfig,
+} from '../server/types';
+import { getWebhooks, mapWebhookConfiguration } from '../webhooks';
 import { NodeOptions, ResolvedNodeOptions, resolveNodeOptions } from './options';
 import { EnvironmentVariable, InputVariable, mapInputVariable, resolveConfigurationVariable } from './variables';
 import logger, { FatalLogger, Logger } from '../logger';
-import { hookID, resolveServerOptions, serverOptionsWithDefaults } from '../server/util';
+import { hookID, mapHookMatcher, resolveServerOptions, serverOptionsWithDefaults } from '../server/util';
 import { loadNodeJsOperationDefaultModule, NodeJSOperation } from '../operations/operations';
 import zodToJsonSchema from 'zod-to-json-schema';
 import { GenerateConfig, OperationsGenerationConfig } from './codegeneration';
@@ -175,6 +194,11 @@ export interface WunderGraphConfigApplicationConfig<
 
 	security?: SecurityConfig;
 
+	/**
+	 * Webhooks notifying external endpoints after mutations complete successfully
+	 */
+	eventWebhooks?: EventWebhookConfiguration[];
+
 	/**
 	 * OpenAPI generator configuration
 	 */
@@ -227,11 +251,57 @@ export interface AuthenticationConfig {
 	timeoutSeconds?: InputVariable<number>;
 }
 
+export interface EventWebhookConfiguration {
+	/**
+	 * Name of the webhook, used in the delivery status
+	 */
+	name: string;
+	/**
+	 * URL receiving the events as POST requests
+	 */
+	url: InputVariable;
+	/**
+	 * Secret used to sign the events with the Standard Webhooks scheme.
+	 * Secrets with the whsec_ prefix are base64 encoded.
+	 */
+	secret?: InputVariable;
+	/**
+	 * Names of the mutations triggering the webhook
+	 *
+	 * @default All mutations
+	 */
+	operations?: string[];
+	/**
+	 * GJSON path evaluated against the response, events are only sent
+	 * if it matches a value other than null or false
+	 */
+	responseFilter?: string;
+	/**
+	 * @default 10
+	 */
+	maxDeliveryAttempts?: number;
+}
+
+export const resolveEventWebhooks = (webhooks: EventWebhookConfiguration[]): _EventWebhookConfiguration[] => {
+	return webhooks.map((webhook) => ({
+		name: webhook.name,
+		url: mapInputVariable(webhook.url),
+		secret: mapInputVariable(webhook.secret || ''),
+		operations: webhook.operations || [],
+		responseFilter: webhook.responseFilter || '',
+		maxDeliveryAttempts: webhook.maxDeliveryAttempts || 0,
+	}));
+};
+
 export interface TokenAuthProvider {
 	jwksJSON?: InputVariable;
 	jwksURL?: InputVariable;
 	userInfoEndpoint?: InputVariable;
 	userInfoCacheTtlSeconds?: number;
+	/**
+	 * Rules assigning roles to the users, without requiring a mutatingPostAuthentication hook
+	 */
+	roleMappings?: RoleMappingRule[];
 }
 
 export interface CustomClaim {
@@ -321,6 +391,74 @@ export interface S3UploadProfile {
 	 * @default Any extension
 	 */
 	allowedFileExtensions?: string[];
+	/**
+	 * Whether the files uploaded with this profile can be listed, downloaded and
+	 * deleted through the files endpoints of the provider
+	 *
+	 * @default false
+	 */
+	enableFiles?: boolean;
+	/**
+	 * Roles allowed to download and list the files uploaded with this profile.
+	 * Users can always download the files they uploaded.
+	 *
+	 * @default Any user allowed by requireAuthentication
+	 */
+	downloadRoles?: string[];
+	/**
+	 * Roles allowed to delete the files uploaded with this profile.
+	 * Users can always delete the files they uploaded.
+	 *
+	 * @default Only the uploader
+	 */
+	deleteRoles?: string[];
+	/**
+	 * Whether downloads are streamed through the WunderNode instead of
+	 * redirecting to a presigned URL
+	 *
+	 * @default false
+	 */
+	proxyDownloads?: boolean;
+	/**
+	 * Images derived from the uploaded JPEG, PNG, GIF and WebP files, stored
+	 * next to the original. Presigned and resumable uploads are rejected when set.
+	 *
+	 * @default No transformations
+	 */
+	imageTransformations?: ImageTransformation[];
+}
+
+export interface ImageTransformation {
+	/**
+	 * Name of the transformation, appended to the key of the original file
+	 */
+	name: string;
+	/**
+	 * Width in pixels. If only one dimension is set, the other one keeps the aspect ratio.
+	 */
+	width?: number;
+	/**
+	 * Height in pixels. If only one dimension is set, the other one keeps the aspect ratio.
+	 */
+	height?: number;
+	/**
+	 * How the image is scaled to the dimensions
+	 *
+	 * @default 'contain'
+	 */
+	fit?: 'contain' | 'cover' | 'fill';
+	/**
+	 * Format of the transformed image. 'original' converts GIF images to PNG.
+	 *
+	 * @default 'original'
+	 */
+	format?: 'original' | 'jpeg' | 'png' | 'webp';
+	/**
+	 * JPEG quality between 1 and 100
+	 *
+	 * @default 85
+	 */
+	quality?: number;
 }
 
 export type S3UploadProfiles = Record<string, S3UploadProfile>;
@@ -359,15 +497,34 @@ export interface S3UploadConfiguration {
 	 * Upload profiles to restrict uploads to certain file types, sizes, etc.
 	 */
 	uploadProfiles?: S3UploadProfiles;
+	/**
+	 * The storage backend. Azure uses the accessKeyID as the account name,
+	 * the secretAccessKey as the account key and the bucketName as the container.
+	 *
+	 * @default 'S3'
+	 */
+	storage?: UploadStorage;
+	/**
+	 * The directory used by the local storage
+	 */
+	directory?: InputVariable;
+	/**
+	 * The service account key used by the GCS storage. If empty, the credentials
+	 * are retrieved from the metadata server.
+	 */
+	credentialsJSON?: InputVariable;
 }
 
+export type UploadStorage = 'S3' | 'local' | 'gcs' | 'azure';
+
 export interface ResolvedS3UploadProfile extends Omit<Required<S3UploadProfile>, 'meta'> {
 	meta: ZodType | object | null;
 	preUploadHook: boolean;
 	postUploadHook: boolean;
+	preDownloadHook: boolean;
 }
 
-interface ResolvedS3UploadConfiguration extends Omit<S3UploadConfiguration, 'uploadProfiles'> {
+export interface ResolvedS3UploadConfiguration extends Omit<S3UploadConfiguration, 'uploadProfiles'> {
 	uploadProfiles: Record<string, ResolvedS3UploadProfile>;
 }
 
@@ -404,6 +561,9 @@ export interface ResolvedWunderGraphConfig {
 	};
 	interpolateVariableDefinitionAsJSON: string[];
 	webhooks: WebhookConfiguration[];
+	eventWebhooks: _EventWebhookConfiguration[];
+	// Hooks restricting the global onOriginRequest and onOriginResponse hooks
+	originHooks: Hook[];
 	nodeOptions: ResolvedNodeOptions;
 	serverOptions?: ResolvedServerOptions;
 	experimental: {
@@ -588,6 +748,8 @@ const resolveConfig = async (
 		},
 		interpolateVariableDefinitionAsJSON: resolved.EngineConfiguration.interpolateVariableDefinitionAsJSON,
 		webhooks: [],
+		eventWebhooks: resolveEventWebhooks(config.eventWebhooks || []),
+		originHooks: resolveOriginHooks(config.server?.hooks?.global?.httpTransport),
 		nodeOptions: resolvedNodeOptions,
 		serverOptions: resolvedServerOptions,
 		experimental: {
@@ -772,9 +934,15 @@ const resolveUploadConfiguration = (
 				maxAllowedFiles: profile.maxAllowedFiles ?? -1,
 				allowedMimeTypes: profile.allowedMimeTypes ?? [],
 				allowedFileExtensions: profile.allowedFileExtensions ?? [],
+				enableFiles: profile.enableFiles ?? false,
+				downloadRoles: profile.downloadRoles ?? [],
+				deleteRoles: profile.deleteRoles ?? [],
+				proxyDownloads: profile.proxyDownloads ?? false,
+				imageTransformations: profile.imageTransformations ?? [],
 				meta: profile.meta ?? null,
 				preUploadHook: profileHooks?.preUpload !== undefined,
 				postUploadHook: profileHooks?.postUpload !== undefined,
+				preDownloadHook: profileHooks?.preDownload !== undefined,
 			};
 		}
 	}
@@ -912,34 +1080,9 @@ export const configureWunderGraphApplication = <
 			const webhooksDir = path.join('webhooks');
 			if (fs.existsSync(webhooksDir)) {
 				const webhooks = await getWebhooks(path.join('webhooks'));
-				resolved.webhooks = webhooks.map((webhook) => {
-					const webhookConfig: WebhookConfiguration = {
-						name: webhook.name,
-						filePath: webhook.filePath,
-						verifier: undefined,
-					};
-
-					if (config.server?.webhooks) {
-						for (const [key, value] of Object.entries(config.server.webhooks)) {
-							if (key === webhook.name) {
-								webhookConfig.verifier = {
-									kind: value.verifier.kind,
-									signatureHeader: value.verifier.signatureHeader,
-									signatureHeaderPrefix: value.verifier.signatureHeaderPrefix,
-									secret: {
-										kind: ConfigurationVariableKind.ENV_CONFIGURATION_VARIABLE,
-										staticVariableContent: '',
-										placeholderVariableName: '',
-										environmentVariableDefaultValue: value.verifier.secret.defaultValue || '',
-										environmentVariableName: value.verifier.secret.name,
-									},
-								};
-								break;
-							}
-						}
-					}
-					return webhookConfig;
-				});
+				resolved.webhooks = webhooks.map((webhook) =>
+					mapWebhookConfiguration(webhook, config.server?.webhooks?.[webhook.name])
+				);
 			}
 
 			// Count total webhooks
@@ -990,6 +1133,7 @@ export const configureWunderGraphApplication = <
 									...op.AuthenticationConfig,
 									required: op.AuthenticationConfig?.required ?? mutationConfig.authentication.required,
 								},
+								HooksConfiguration: applyExpressionHooks(op, mutationConfig),
 							});
 						case OperationType.QUERY:
 							let queryConfig = cfg.queries(base);
@@ -1004,6 +1148,7 @@ export const configureWunderGraphApplication = <
 									required: op.AuthenticationConfig?.required ?? queryConfig.authentication.required,
 								},
 								LiveQuery: queryConfig.liveQuery,
+								HooksConfiguration: applyExpressionHooks(op, queryConfig),
 							});
 						case OperationType.SUBSCRIPTION:
 							let subscriptionConfig = cfg.subscriptions(base);
@@ -1016,6 +1161,10 @@ export const configureWunderGraphApplication = <
 									...op.AuthenticationConfig,
 									required: op.AuthenticationConfig?.required ?? subscriptionConfig.authentication.required,
 								},
+								HooksConfiguration: {
+									...applyExpressionHooks(op, subscriptionConfig),
+									subscriptionEventExpressions: subscriptionConfig.subscriptionEventExpressions,
+								},
 							});
 						default:
 							return op;
@@ -1144,6 +1293,7 @@ export const configureWunderGraphApplication = <
 					op.HooksConfiguration.mutatingPreResolve =
 						'mutatingPreResolve' in hooks && hooks.mutatingPreResolve !== undefined;
 					op.HooksConfiguration.mutatingPostResolve = hooks.mutatingPostResolve !== undefined;
+					op.HooksConfiguration.onSubscriptionEvent = hooks.onSubscriptionEvent !== undefined;
 				}
 			}
 
@@ -1263,7 +1413,13 @@ const storedWunderGraphConfig = (config: ResolvedWunderGraphConfig, apiCount: nu
 		},
 		authorizationConfig: op.AuthorizationConfig,
 		liveQueryConfig: op.LiveQuery,
-		hooksConfiguration: op.HooksConfiguration,
+		hooksConfiguration: {
+			...op.HooksConfiguration,
+			preResolveExpressions: mapExpressionHooks(op.HooksConfiguration.preResolveExpressions),
+			postResolveExpressions: mapExpressionHooks(op.HooksConfiguration.postResolveExpressions),
+			onSubscriptionEvent: op.HooksConfiguration.onSubscriptionEvent ?? false,
+			subscriptionEventExpressions: mapExpressionHooks(op.HooksConfiguration.subscriptionEventExpressions),
+		},
 		variablesConfiguration: op.VariablesConfiguration,
 		internal: op.Internal,
 		postResolveTransformations:
@@ -1286,11 +1442,6 @@ const storedWunderGraphConfig = (config: ResolvedWunderGraphConfig, apiCount: nu
 	const types: TypeConfiguration[] = config.application.EngineConfiguration.Types;
 
 	const hooks: Hook[] = [];
-	const operationTypes = {
-		query: OperationType.QUERY,
-		mutation: OperationType.MUTATION,
-		subscription: OperationType.SUBSCRIPTION,
-	};
 	const integrations = config.integrations || [];
 	for (const integration of integrations) {
 		const httpTransport = integration.hooks['http:transport'];
@@ -1299,21 +1450,16 @@ const storedWunderGraphConfig = (config: ResolvedWunderGraphConfig, apiCount: nu
 			if (config.match) {
 				const matches = Array.isArray(config.match) ? config.match : [config.match];
 				for (const match of matches) {
-					const id = hookID(match);
 					hooks.push({
-						id,
+						id: hookID(match),
 						type: HookType.HTTP_TRANSPORT,
-						matcher: {
-							operationType: match.operationType
-								? operationTypes[match.operationType as keyof typeof operationTypes]
-								: undefined,
-							datasources: (match as { datasources?: string[] }).datasources ?? [],
-						},
+						matcher: mapHookMatcher(match),
 					});
 				}
 			}
 		}
 	}
+	hooks.push(...config.originHooks);
 
 	const out: WunderGraphConfiguration = {
 		apiId: config.deployment.api.id,
@@ -1330,42 +1476,7 @@ const storedWunderGraphConfig = (config: ResolvedWunderGraphConfig, apiCount: nu
 				typeConfigurations: types,
 				stringStorage,
 			},
-			s3UploadConfiguration: config.application.S3UploadProvider.map((provider) => {
-				let uploadProfiles: { [key: string]: _S3UploadProfile } = {};
-				if (provider.uploadProfiles) {
-					for (const key in provider.uploadProfiles) {
-						const resolved = provider.uploadProfiles[key];
-						let metadataJSONSchema: string;
-						try {
-							metadataJSONSchema = resolved.meta ? JSON.stringify(resolved.meta) : '';
-						} catch (e) {
-							throw new Error(`error serializing JSON schema for upload profile ${provider.name}/${key}: ${e}`);
-						}
-						uploadProfiles[key] = {
-							requireAuthentication: resolved.requireAuthentication,
-							maxAllowedUploadSizeBytes: resolved.maxAllowedUploadSizeBytes,
-							maxAllowedFiles: resolved.maxAllowedFiles,
-							allowedMimeTypes: resolved.allowedMimeTypes,
-							allowedFileExtensions: resolved.allowedFileExtensions,
-							metadataJSONSchema: metadataJSONSchema,
-							hooks: {
-								preUpload: resolved.preUploadHook,
-								postUpload: resolved.postUploadHook,
-							},
-						};
-					}
-				}
-				return {
-					name: provider.name,
-					accessKeyID: mapInputVariable(provider.accessKeyID),
-					bucketLocation: mapInputVariable(provider.bucketLocation),
-					bucketName: mapInputVariable(provider.bucketName),
-					endpoint: mapInputVariable(provider.endpoint),
-					secretAccessKey: mapInputVariable(provider.secretAccessKey),
-					useSSL: provider.useSSL ?? true,
-					uploadProfiles: uploadProfiles,
-				};
-			}),
+			s3UploadConfiguration: config.application.S3UploadProvider.map(mapUploadProvider),
 			corsConfiguration: config.application.CorsConfiguration,
 			experimentalConfig: {
 				orm: config.experimental.orm ?? false,
@@ -1387,12 +1498,14 @@ const storedWunderGraphConfig = (config: ResolvedWunderGraphConfig, apiCount: nu
 						jwksUrl: mapInputVariable(provider.jwksURL || ''),
 						userInfoEndpoint: mapInputVariable(provider.userInfoEndpoint || ''),
 						userInfoCacheTtlSeconds: provider.userInfoCacheTtlSeconds || 60 * 60,
+						roleMappings: mapRoleMappings(provider.roleMappings),
 					})),
 				},
 				publicClaims: config.authentication.publicClaims,
 			},
 			allowedHostNames: config.security.allowedHostNames,
 			webhooks: config.webhooks,
+			eventWebhooks: config.eventWebhooks,
 			nodeOptions: config.nodeOptions,
 			serverOptions: config.serverOptions,
 		},
@@ -1404,6 +1517,108 @@ const storedWunderGraphConfig = (config: ResolvedWunderGraphConfig, apiCount: nu
 	return out;
 };
 
+const uploadStorageKinds: Record<UploadStorage, UploadStorageKind> = {
+	S3: UploadStorageKind.UploadStorageS3,
+	local: UploadStorageKind.UploadStorageLocal,
+	gcs: UploadStorageKind.UploadStorageGCS,
+	azure: UploadStorageKind.UploadStorageAzure,
+};
+
+const imageFits: Record<NonNullable<ImageTransformation['fit']>, ImageFit> = {
+	contain: ImageFit.ImageFitContain,
+	cover: ImageFit.ImageFitCover,
+	fill: ImageFit.ImageFitFill,
+};
+
+const imageFormats: Record<NonNullable<ImageTransformation['format']>, ImageFormat> = {
+	original: ImageFormat.ImageFormatOriginal,
+	jpeg: ImageFormat.ImageFormatJPEG,
+	png: ImageFormat.ImageFormatPNG,
+	webp: ImageFormat.ImageFormatWebP,
+};
+
+const mapImageTransformation = (transformation: ImageTransformation): _ImageTransformation => {
+	return {
+		name: transformation.name,
+		width: transformation.width ?? 0,
+		height: transformation.height ?? 0,
+		fit: imageFits[transformation.fit ?? 'contain'],
+		format: imageFormats[transformation.format ?? 'original'],
+		quality: transformation.quality ?? 0,
+	};
+};
+
+/**
+ * Returns the hooks restricting the global onOriginRequest and onOriginResponse
+ * hooks to the requests matching their matchers
+ */
+export const resolveOriginHooks = (httpTransport?: GlobalHooksConfig['httpTransport']): Hook[] => {
+	const hooks: Hook[] = [];
+	const originHooks = [
+		{ type: HookType.HTTP_ORIGIN_REQUEST, match: httpTransport?.onOriginRequest?.match },
+		{ type: HookType.HTTP_ORIGIN_RESPONSE, match: httpTransport?.onOriginResponse?.match },
+	];
+	for (const { type, match } of originHooks) {
+		if (match) {
+			const matches = Array.isArray(match) ? match : [match];
+			for (const m of matches) {
+				hooks.push({
+					id: hookID(m),
+					type,
+					matcher: mapHookMatcher(m),
+				});
+			}
+		}
+	}
+	return hooks;
+};
+
+export const mapUploadProvider = (provider: ResolvedS3UploadConfiguration): _S3UploadConfiguration => {
+	let uploadProfiles: { [key: string]: _S3UploadProfile } = {};
+	if (provider.uploadProfiles) {
+		for (const key in provider.uploadProfiles) {
+			const resolved = provider.uploadProfiles[key];
+			let metadataJSONSchema: string;
+			try {
+				metadataJSONSchema = resolved.meta ? JSON.stringify(resolved.meta) : '';
+			} catch (e) {
+				throw new Error(`error serializing JSON schema for upload profile ${provider.name}/${key}: ${e}`);
+			}
+			uploadProfiles[key] = {
+				requireAuthentication: resolved.requireAuthentication,
+				maxAllowedUploadSizeBytes: resolved.maxAllowedUploadSizeBytes,
+				maxAllowedFiles: resolved.maxAllowedFiles,
+				allowedMimeTypes: resolved.allowedMimeTypes,
+				allowedFileExtensions: resolved.allowedFileExtensions,
+				metadataJSONSchema: metadataJSONSchema,
+				hooks: {
+					preUpload: resolved.preUploadHook,
+					postUpload: resolved.postUploadHook,
+					preDownload: resolved.preDownloadHook,
+				},
+				downloadRoles: resolved.downloadRoles,
+				deleteRoles: resolved.deleteRoles,
+				proxyDownloads: resolved.proxyDownloads,
+				enableFiles: resolved.enableFiles,
+				imageTransformations: resolved.imageTransformations.map(mapImageTransformation),
+			};
+		}
+	}
+	return {
+		name: provider.name,
+		accessKeyID: mapInputVariable(provider.accessKeyID),
+		bucketLocation: mapInputVariable(provider.bucketLocation),
+		bucketName: mapInputVariable(provider.bucketName),
+		endpoint: mapInputVariable(provider.endpoint),
+		secretAccessKey: mapInputVariable(provider.secretAccessKey),
+		useSSL: provider.useSSL ?? true,
+		uploadProfiles: uploadProfiles,
+		storage: uploadStorageKinds[provider.storage ?? 'S3'],
+		directory: mapInputVariable(provider.directory || ''),
+		credentialsJSON: mapInputVariable(provider.credentialsJSON || ''),
+	};
+};
+
 /**
  * Stores the string s in the given stringStorage, returning a reference to it
  *
@@ -1431,8 +1646,10 @@ const mapDataSource = (stringStorage: Record<string, string>, source: DataSource
 		overrideFieldPathFromAlias: source.Kind === DataSourceKind.GRAPHQL,
 		customDatabase: undefined,
 		customNatsKv: undefined,
+		customWebhookEvents: undefined,
 		directives: source.Directives,
 		requestTimeoutSeconds: source.RequestTimeoutSeconds,
+		originRequestExpressions: mapExpressionHooks(source.OriginRequestExpressions),
 	};
 	switch (source.Kind) {
 		case DataSourceKind.REST:
@@ -1499,6 +1716,12 @@ const mapDataSource = (stringStorage: Record<string, string>, source: DataSource
 				bucketPrefix: natskv.bucketPrefix,
 			};
 			break;
+		case DataSourceKind.WEBHOOK_EVENTS:
+			const webhookEvents = source.Custom as WebhookEventsApiCustom;
+			out.customWebhookEvents = {
+				webhook: webhookEvents.webhook,
+			};
+			break;
 	}
 
 	return out;
@@ -1765,6 +1988,17 @@ const operationFilePath = (wgDirAbs: string, operation: GraphQLOperation) => {
 // loadAndApplyNodeJsOperationOverrides loads the implementation file from the given operation and
 // then calls applyNodeJsOperationOverrides with the implementation. If the operation doesn't use
 // the NODEJS engine, it returns the operation unchanged.
+const applyExpressionHooks = (
+	op: GraphQLOperation,
+	config: BaseOperationConfiguration
+): GraphQLOperation['HooksConfiguration'] => {
+	return {
+		...op.HooksConfiguration,
+		preResolveExpressions: config.preResolveExpressions,
+		postResolveExpressions: config.postResolveExpressions,
+	};
+};
+
 const loadAndApplyNodeJsOperationOverrides = async (
 	wgDirAbs: string,
 	operation: TypeScriptOperation
@@ -1,3 +1,5 @@
+import { ExpressionHook } from './expressions';
+
 export interface OperationsConfiguration {
 	defaultConfig: BaseOperationConfiguration;
 	queries: ConfigureQuery;
@@ -10,6 +12,14 @@ export interface BaseOperationConfiguration {
 	authentication: {
 		required: boolean;
 	};
+	/**
+	 * Expressions evaluated by the WunderNode before the preResolve hooks
+	 */
+	preResolveExpressions?: ExpressionHook[];
+	/**
+	 * Expressions evaluated by the WunderNode before the postResolve hooks
+	 */
+	postResolveExpressions?: ExpressionHook[];
 }
 
 export interface QueryCacheConfiguration {
@@ -58,7 +68,13 @@ export interface QueryConfiguration extends BaseOperationConfiguration {
 
 export interface MutationConfiguration extends BaseOperationConfiguration {}
 
-export interface SubscriptionConfiguration extends BaseOperationConfiguration {}
+export interface SubscriptionConfiguration extends BaseOperationConfiguration {
+	/**
+	 * Expressions evaluated by the WunderNode for each message sent to a client,
+	 * before the onSubscriptionEvent hook
+	 */
+	subscriptionEventExpressions?: ExpressionHook[];
+}
 
 type OperationConfiguration = QueryConfiguration | MutationConfiguration | SubscriptionConfiguration;
 
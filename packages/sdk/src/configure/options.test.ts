@@ -0,0 +1,25 @@
+import { WebhookQueueKind } from '@wundergraph/protobuf';
+
+import { resolveNodeOptions } from './options';
+import { mapInputVariable } from './variables';
+
+describe('resolveNodeOptions', () => {
+	it('should resolve the webhook queue', () => {
+		const options = resolveNodeOptions({
+			webhookQueue: {
+				kind: 'nats',
+				natsUrl: 'nats://localhost:4222',
+			},
+		});
+		expect(options.webhookQueue).toEqual({
+			kind: WebhookQueueKind.WebhookQueueNats,
+			directory: mapInputVariable(''),
+			natsUrl: mapInputVariable('nats://localhost:4222'),
+			natsToken: mapInputVariable(''),
+		});
+	});
+
+	it('should leave the webhook queue empty by default', () => {
+		expect(resolveNodeOptions().webhookQueue).toBeUndefined();
+	});
+});
@@ -1,4 +1,8 @@
-import { ConfigurationVariable } from '@wundergraph/protobuf';
+import {
+	ConfigurationVariable,
+	WebhookQueueKind,
+	WebhookQueueOptions as _WebhookQueueOptions,
+} from '@wundergraph/protobuf';
 import { EnvironmentVariable, InputVariable, mapInputVariable, resolveVariable } from './variables';
 
 export const isCloud = process.env.WG_CLOUD === 'true';
@@ -98,6 +102,29 @@ export interface ResolvedTelemetryOptions {
 	authToken: ConfigurationVariable;
 }
 
+export interface WebhookQueueOptions {
+	/**
+	 * Store the queued webhooks in a directory or in NATS JetStream, which
+	 * allows sharing the queue between several nodes.
+	 *
+	 * @default 'file'
+	 */
+	kind?: 'file' | 'nats';
+	/**
+	 * Directory used by the file queue.
+	 *
+	 * @default generated/webhook-queue inside the WunderGraph directory
+	 */
+	directory?: InputVariable;
+	/**
+	 * NATS server used by the JetStream queue.
+	 *
+	 * @default The embedded NATS server
+	 */
+	natsUrl?: InputVariable;
+	natsToken?: InputVariable;
+}
+
 export interface ListenInternalOptions extends Omit<ListenOptions, 'host'> {}
 
 export interface ResolvedListenOptions {
@@ -145,6 +172,11 @@ export interface NodeOptions {
 		 */
 		port?: InputVariable<number>;
 	};
+
+	/**
+	 * Storage of the webhooks with queued enabled
+	 */
+	webhookQueue?: WebhookQueueOptions;
 }
 
 export interface ResolvedNodeOptions {
@@ -163,6 +195,7 @@ export interface ResolvedNodeOptions {
 		enabled: ConfigurationVariable;
 		port: ConfigurationVariable;
 	};
+	webhookQueue: _WebhookQueueOptions | undefined;
 }
 
 export const fallbackNodeUrl = (listenOptions: ListenOptions | undefined) => {
@@ -179,6 +212,15 @@ export const fallbackNodeInternalUrl = (options?: NodeOptions) => {
 	return `http://${resolveVariable(host)}:${resolveVariable(port)}`;
 };
 
+const resolveWebhookQueueOptions = (options: WebhookQueueOptions): _WebhookQueueOptions => {
+	return {
+		kind: options.kind === 'nats' ? WebhookQueueKind.WebhookQueueNats : WebhookQueueKind.WebhookQueueFile,
+		directory: mapInputVariable(options.directory || ''),
+		natsUrl: mapInputVariable(options.natsUrl || ''),
+		natsToken: mapInputVariable(options.natsToken || ''),
+	};
+};
+
 export const resolveNodeOptions = (options?: NodeOptions): ResolvedNodeOptions => {
 	let nodeOptions = isCloud
 		? DefaultNodeOptions
@@ -239,5 +281,6 @@ export const resolveNodeOptions = (options?: NodeOptions): ResolvedNodeOptions =
 			enabled: mapInputVariable(nodeOptions.prometheus.enabled),
 			port: mapInputVariable(nodeOptions.prometheus.port),
 		},
+		webhookQueue: !isCloud && options?.webhookQueue ? resolveWebhookQueueOptions(options.webhookQueue) : undefined,
 	};
 };
@@ -212,6 +212,7 @@ export const introspectGraphql = async (
 				},
 				Directives: applyNamespaceToDirectiveConfiguration(upstreamSchema, introspection.apiNamespace),
 				RequestTimeoutSeconds: introspection.requestTimeoutSeconds ?? 0,
+				OriginRequestExpressions: introspection.originRequestExpressions,
 			},
 		],
 		applyNameSpaceToFieldConfigurations(Fields, graphQLSchema, skipRenameRootFields, introspection.apiNamespace),
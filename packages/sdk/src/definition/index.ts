@@ -22,6 +22,7 @@ import {
 } from '@wundergraph/protobuf';
 import { applyNameSpaceToGraphQLSchema } from './namespacing';
 import { InputVariable, mapInputVariable } from '../configure/variables';
+import { ExpressionHook } from '../configure/expressions';
 import { introspectGraphqlWithCache } from './graphql-introspection';
 import { introspectFederation } from './federation-introspection';
 import { IHeadersBuilder, IntrospectionHeadersBuilder } from './headers-builder';
@@ -37,6 +38,7 @@ import {
 } from './database-introspection';
 import { introspectSoap } from './soap-introspection';
 import { introspectNatsKV } from './nats-kv-introspection';
+import { introspectWebhookEvents } from './webhook-events-introspection';
 
 export type { OpenAPIIntrospection } from './openapi-introspection';
 
@@ -259,6 +261,12 @@ export interface NatsKvApiCustom {
 	schema: any;
 }
 
+export class WebhookEventsApi extends Api<WebhookEventsApiCustom> {}
+
+export interface WebhookEventsApiCustom {
+	webhook: string;
+}
+
 export interface DataSource<Custom = unknown> {
 	Id?: string;
 	Kind: DataSourceKind;
@@ -267,6 +275,7 @@ export interface DataSource<Custom = unknown> {
 	Custom: Custom;
 	Directives: DirectiveConfiguration[];
 	RequestTimeoutSeconds: number;
+	OriginRequestExpressions?: ExpressionHook[];
 }
 
 interface GraphQLIntrospectionOptions {
@@ -378,6 +387,10 @@ export interface HTTPUpstream extends IntrospectionConfiguration {
 	 * @defaultValue undefined, which uses the global proxy defined in configureWunderGraphApplication()
 	 */
 	httpProxyUrl?: InputVariable | null;
+	/**
+	 * Expressions evaluated by the WunderNode before sending each request to the origin
+	 */
+	originRequestExpressions?: ExpressionHook[];
 }
 
 export type HTTPmTlsConfiguration = {
@@ -506,6 +519,7 @@ export const introspect = {
 	openApiV2: introspectOpenApiV2,
 	soap: introspectSoap,
 	natsKV: introspectNatsKV,
+	webhookEvents: introspectWebhookEvents,
 };
 
 export const buildUpstreamAuthentication = (upstream: HTTPUpstream): UpstreamAuthentication | undefined => {
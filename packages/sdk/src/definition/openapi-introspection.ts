@@ -259,6 +259,7 @@ export const openApiSpecificationToGraphQLApi = async (
 			customFloatScalars: introspection.customFloatScalars,
 			schemaExtension: introspection.schemaExtension,
 			replaceCustomScalarTypeFields: introspection.replaceCustomScalarTypeFields,
+			originRequestExpressions: introspection.originRequestExpressions,
 		},
 		{}
 	);
@@ -0,0 +1,30 @@
+import { DataSourceKind } from '@wundergraph/protobuf';
+import { introspectWebhookEvents } from './webhook-events-introspection';
+
+describe('webhook events', () => {
+	describe('introspection', function () {
+		it('should stream the payloads of the webhook through a subscription field', async function () {
+			const introspection = await introspectWebhookEvents({
+				apiNamespace: 'github',
+				webhook: 'github',
+			});
+			const out = await introspection({});
+			expect(out.Schema).toContain('github_events: JSON');
+			expect(out.DataSources).toHaveLength(1);
+			expect(out.DataSources[0].Kind).toBe(DataSourceKind.WEBHOOK_EVENTS);
+			expect(out.DataSources[0].Custom).toEqual({ webhook: 'github' });
+			expect(out.DataSources[0].RootNodes).toEqual([{ typeName: 'Subscription', fieldNames: ['github_events'] }]);
+			expect(out.Fields).toEqual([
+				{
+					typeName: 'Subscription',
+					fieldName: 'github_events',
+					disableDefaultFieldMapping: true,
+					unescapeResponseJson: false,
+					requiresFields: [],
+					path: [],
+					argumentsConfiguration: [],
+				},
+			]);
+		});
+	});
+});
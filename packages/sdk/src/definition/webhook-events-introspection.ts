@@ -0,0 +1,73 @@
+import { Api, ApiIntrospectionOptions, DataSource, WebhookEventsApi, WebhookEventsApiCustom } from './index';
+import { DataSourceKind, FieldConfiguration } from '@wundergraph/protobuf';
+import {
+	applyNameSpaceToFieldConfigurations,
+	applyNameSpaceToGraphQLSchema,
+	applyNameSpaceToTypeFields,
+} from './namespacing';
+import { buildSchema } from 'graphql';
+
+export interface WebhookEventsIntrospection {
+	apiNamespace: string;
+	/**
+	 * Name of the webhook publishing the events, which must enable publishEvents
+	 */
+	webhook: string;
+	/**
+	 * Name of the subscription field streaming the payloads
+	 *
+	 * @default 'events'
+	 */
+	fieldName?: string;
+}
+
+export const introspectWebhookEvents = async (introspection: WebhookEventsIntrospection) => {
+	return async (options: ApiIntrospectionOptions): Promise<Api<WebhookEventsApiCustom>> => {
+		const fieldName = introspection.fieldName || 'events';
+		const unnamespacedSchema = webhookEventsTemplate(introspection.webhook, fieldName);
+		const graphqlSchema = buildSchema(unnamespacedSchema);
+		const schema = applyNameSpaceToGraphQLSchema(unnamespacedSchema, [], introspection.apiNamespace);
+		const dataSource: DataSource<WebhookEventsApiCustom> = {
+			RootNodes: applyNameSpaceToTypeFields(
+				[{ typeName: 'Subscription', fieldNames: [fieldName] }],
+				graphqlSchema,
+				introspection.apiNamespace
+			),
+			ChildNodes: [],
+			Directives: [],
+			Kind: DataSourceKind.WEBHOOK_EVENTS,
+			Custom: {
+				webhook: introspection.webhook,
+			},
+			RequestTimeoutSeconds: 0,
+		};
+		const fields: FieldConfiguration[] = [
+			{
+				typeName: 'Subscription',
+				fieldName,
+				disableDefaultFieldMapping: true,
+				unescapeResponseJson: false,
+				requiresFields: [],
+				path: [],
+				argumentsConfiguration: [],
+			},
+		];
+		return new WebhookEventsApi(
+			schema,
+			introspection.apiNamespace,
+			[dataSource],
+			applyNameSpaceToFieldConfigurations(fields, graphqlSchema, [], introspection.apiNamespace),
+			[],
+			[]
+		);
+	};
+};
+
+const webhookEventsTemplate = (webhook: string, fieldName: string) => `
+scalar JSON
+
+type Subscription {
+  "Streams the payloads received by the ${webhook} webhook."
+  ${fieldName}: JSON
+}
+`;
@@ -8,10 +8,9 @@
 
 import { defineIntegration } from '../../integrations';
 import { AdvancedHooksTemplate } from './codegen';
+import type { HookMatcher } from '../../server/types';
 
-export interface RouteMatcher {
-	operationType?: 'query' | 'mutation' | 'subscription';
-}
+export interface RouteMatcher extends Omit<HookMatcher, 'datasources'> {}
 
 export interface DynamicTransportOptions {
 	match: RouteMatcher | RouteMatcher[];
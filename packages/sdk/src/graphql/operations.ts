@@ -37,6 +37,7 @@ import path from 'path';
 import { WG_PRETTY_GRAPHQL_VALIDATION_ERRORS } from '../definition';
 import { wunderctl } from '../wunderctlexec';
 import { Logger } from '../logger';
+import { ExpressionHook } from '../configure/expressions';
 import * as fs from 'fs';
 import process from 'node:process';
 import { OperationError } from '../client';
@@ -101,6 +102,10 @@ export interface GraphQLOperation {
 		httpTransportOnRequest: boolean;
 		httpTransportOnResponse: boolean;
 		customResolve: boolean;
+		preResolveExpressions?: ExpressionHook[];
+		postResolveExpressions?: ExpressionHook[];
+		onSubscriptionEvent?: boolean;
+		subscriptionEventExpressions?: ExpressionHook[];
 	};
 	VariablesConfiguration: {
 		injectVariables: VariableInjectionConfiguration[];
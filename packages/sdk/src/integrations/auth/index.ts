@@ -4,6 +4,8 @@ export * from './github';
 export * from './google';
 export * from './oidc';
 export * from './auth0';
+export * from './oauth2';
+export * from './saml';
 
 // tokenbased
 export * from './jwks';
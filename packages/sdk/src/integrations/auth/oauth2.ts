@@ -0,0 +1,19 @@
+import type { OAuth2AuthProviderConfig } from '../../configure/authentication';
+import { defineIntegration } from '../define-integration';
+
+export interface OAuth2IntegrationOptions extends OAuth2AuthProviderConfig {}
+
+/**
+ * OAuth2 authentication provider, for identity providers that don't support OpenID Connect.
+ */
+export const oauth2 = defineIntegration<OAuth2IntegrationOptions>((options) => {
+	return {
+		name: 'oauth2-auth-provider',
+		hooks: {
+			async 'config:setup'(config) {
+				const { authProviders } = await import('../../configure/authentication');
+				config.addAuthProvider('cookieBased', authProviders.oauth2(options));
+			},
+		},
+	};
+});
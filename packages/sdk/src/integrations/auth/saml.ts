@@ -0,0 +1,19 @@
+import type { SAMLAuthProviderConfig } from '../../configure/authentication';
+import { defineIntegration } from '../define-integration';
+
+export interface SAMLIntegrationOptions extends SAMLAuthProviderConfig {}
+
+/**
+ * SAML 2.0 authentication provider.
+ */
+export const saml = defineIntegration<SAMLIntegrationOptions>((options) => {
+	return {
+		name: 'saml-auth-provider',
+		hooks: {
+			async 'config:setup'(config) {
+				const { authProviders } = await import('../../configure/authentication');
+				config.addAuthProvider('cookieBased', authProviders.saml(options));
+			},
+		},
+	};
+});
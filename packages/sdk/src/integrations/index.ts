@@ -19,3 +19,6 @@ export * from './upload-providers/s3';
 export * from './upload-providers/minio';
 export * from './upload-providers/do';
 export * from './upload-providers/r2';
+export * from './upload-providers/local';
+export * from './upload-providers/gcs';
+export * from './upload-providers/azure';
@@ -14,6 +14,7 @@ import {
 	SubscriptionConfiguration,
 } from '../configure/operations';
 import { NodeOptions } from '../configure/options';
+import { HookMatcher } from '../server/types';
 
 export interface ConfigSetupOptions {
 	addApi: (api: AsyncApiIntrospector<any>) => void;
@@ -61,9 +62,7 @@ export interface InternalIntergration {
 	hooks: WunderGraphIntegrationHooks & WunderGraphEnterpriseIntegrationHooks;
 }
 
-export interface InternalMatcher {
-	operationType: 'query' | 'mutation' | 'subscription';
-}
+export interface InternalMatcher extends HookMatcher {}
 
 export interface InternalHookConfig {
 	match: InternalMatcher | InternalMatcher[];
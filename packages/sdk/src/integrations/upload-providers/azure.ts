@@ -0,0 +1,37 @@
+import type { S3UploadConfiguration } from '../../configure';
+import type { InputVariable } from '../../server';
+import { defineIntegration } from '../define-integration';
+
+export interface AzureProviderOptions extends Pick<S3UploadConfiguration, 'name' | 'uploadProfiles'> {
+	accountName: InputVariable;
+	accountKey: InputVariable;
+	container: InputVariable;
+	/**
+	 * Overrides the default Azure Blob Storage endpoint
+	 */
+	endpoint?: InputVariable;
+}
+
+/**
+ * Azure Blob Storage upload provider integration
+ */
+export const azureProvider = defineIntegration<AzureProviderOptions>((options) => {
+	const { name = 'azure', endpoint = '', accountName, accountKey, container, uploadProfiles } = options;
+	return {
+		name: 'azure-provider',
+		hooks: {
+			async 'config:setup'(config) {
+				config.addS3Provider({
+					name,
+					storage: 'azure',
+					endpoint,
+					accessKeyID: accountName,
+					secretAccessKey: accountKey,
+					bucketName: container,
+					bucketLocation: '',
+					uploadProfiles,
+				});
+			},
+		},
+	};
+});
@@ -0,0 +1,34 @@
+import type { S3UploadConfiguration } from '../../configure';
+import type { InputVariable } from '../../server';
+import { defineIntegration } from '../define-integration';
+
+export interface GCSProviderOptions
+	extends Pick<S3UploadConfiguration, 'name' | 'bucketName' | 'credentialsJSON' | 'uploadProfiles'> {
+	/**
+	 * Overrides the default Google Cloud Storage endpoint
+	 */
+	endpoint?: InputVariable;
+}
+
+/**
+ * Google Cloud Storage upload provider integration
+ */
+export const gcsProvider = defineIntegration<GCSProviderOptions>((options) => {
+	const { name = 'gcs', endpoint = '', ...rest } = options;
+	return {
+		name: 'gcs-provider',
+		hooks: {
+			async 'config:setup'(config) {
+				config.addS3Provider({
+					name,
+					storage: 'gcs',
+					endpoint,
+					accessKeyID: '',
+					secretAccessKey: '',
+					bucketLocation: '',
+					...rest,
+				});
+			},
+		},
+	};
+});
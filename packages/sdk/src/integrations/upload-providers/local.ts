@@ -0,0 +1,34 @@
+import type { S3UploadConfiguration } from '../../configure';
+import type { InputVariable } from '../../server';
+import { defineIntegration } from '../define-integration';
+
+export interface LocalUploadProviderOptions extends Pick<S3UploadConfiguration, 'name' | 'uploadProfiles'> {
+	/**
+	 * The directory storing the uploaded files
+	 */
+	directory: InputVariable;
+}
+
+/**
+ * Upload provider integration storing the files in the local filesystem
+ */
+export const localUploadProvider = defineIntegration<LocalUploadProviderOptions>((options) => {
+	const { name = 'local', ...rest } = options;
+	return {
+		name: 'local-upload-provider',
+		hooks: {
+			async 'config:setup'(config) {
+				config.addS3Provider({
+					name,
+					storage: 'local',
+					endpoint: '',
+					accessKeyID: '',
+					secretAccessKey: '',
+					bucketName: '',
+					bucketLocation: '',
+					...rest,
+				});
+			},
+		},
+	};
+});
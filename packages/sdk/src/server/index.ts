@@ -10,6 +10,8 @@ export type {
 	ClientRequestHeaders,
 	PostUploadHookRequest,
 	PostUploadHookResponse,
+	PreDownloadHookRequest,
+	PreDownloadHookResponse,
 	PreUploadHookRequest,
 	PreUploadHookResponse,
 	WsTransportOnConnectionInitResponse,
@@ -24,6 +26,7 @@ export type {
 	MutationHookWithoutInput,
 	SubscriptionHook,
 	SubscriptionHookWithoutInput,
+	SubscriptionEventHookResponse,
 	CustomContext,
 } from './types';
 export type {
@@ -69,7 +72,14 @@ export type { FastifyLoggerInstance } from 'fastify';
 
 export { configureWunderGraphServer } from './server';
 
-export { GithubWebhookVerifier, CreateWebhookVerifier, WebhookVerifierKind } from '../webhooks/verifiers';
+export {
+	GithubWebhookVerifier,
+	StripeWebhookVerifier,
+	SlackWebhookVerifier,
+	SvixWebhookVerifier,
+	CreateWebhookVerifier,
+	WebhookVerifierKind,
+} from '../webhooks/verifiers';
 export { createWebhookFactory } from '../webhooks/factory';
 
 export { EnvironmentVariable } from '../configure/variables';
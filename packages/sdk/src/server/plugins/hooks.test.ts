@@ -39,6 +39,7 @@ export const getFastify = async (serverConfig: WunderGraphHooksAndServerConfig)
 				allowedHostNames: [],
 				enableGraphqlEndpoint: false,
 				webhooks: [],
+				eventWebhooks: [],
 				experimentalConfig: {
 					orm: false,
 				},
@@ -400,6 +401,50 @@ test('subscriptions postResolve hook', async () => {
 	});
 });
 
+test('subscriptions onSubscriptionEvent hook', async () => {
+	const serverConfig: WunderGraphHooksAndServerConfig = {
+		hooks: {
+			subscriptions: {
+				Chat: {
+					onSubscriptionEvent: async (hook: any) => {
+						if (hook.event.data.message === 'secret') {
+							return { skip: true };
+						}
+						return { event: { data: { message: hook.event.data.message.toUpperCase() } } };
+					},
+				},
+			},
+		},
+	};
+	const fastify = await getFastify(serverConfig);
+	const send = (message: string) =>
+		fastify.inject({
+			method: 'POST',
+			url: '/operation/Chat/onSubscriptionEvent',
+			payload: {
+				input: {},
+				response: { data: { message } },
+				__wg: {
+					clientRequest: {},
+				},
+			},
+		});
+	const transformed = await send('hello');
+	expect(transformed.statusCode).toEqual(200);
+	expect(transformed.json()).toEqual({
+		hook: 'onSubscriptionEvent',
+		op: 'Chat',
+		response: { event: { data: { message: 'HELLO' } } },
+	});
+	const skipped = await send('secret');
+	expect(skipped.statusCode).toEqual(200);
+	expect(skipped.json()).toEqual({
+		hook: 'onSubscriptionEvent',
+		op: 'Chat',
+		response: { skip: true },
+	});
+});
+
 test('onWSTransportConnectionInit hook', async () => {
 	const serverConfig: WunderGraphHooksAndServerConfig = {
 		hooks: {
@@ -439,3 +484,51 @@ test('onWSTransportConnectionInit hook', async () => {
 		},
 	});
 });
+
+test('upload preDownload hook', async () => {
+	const serverConfig: WunderGraphHooksAndServerConfig = {
+		hooks: {
+			uploads: {
+				s3: {
+					avatar: {
+						preDownload: async (hook: any) => {
+							if (hook.meta?.private) {
+								return { error: 'private file' };
+							}
+						},
+					},
+				},
+			},
+		},
+	};
+	const fastify = await getFastify(serverConfig);
+	const file = { name: 'avatar.png', size: 1024, type: 'image/png' };
+
+	const allowedResponse = await fastify.inject({
+		method: 'POST',
+		url: '/upload/s3/avatar/preDownload',
+		payload: {
+			__wg: {
+				clientRequest: {},
+			},
+			file,
+			meta: {},
+		},
+	});
+	expect(allowedResponse.statusCode).toEqual(200);
+	expect(allowedResponse.json()).toEqual({});
+
+	const deniedResponse = await fastify.inject({
+		method: 'POST',
+		url: '/upload/s3/avatar/preDownload',
+		payload: {
+			__wg: {
+				clientRequest: {},
+			},
+			file,
+			meta: { private: true },
+		},
+	});
+	expect(deniedResponse.statusCode).toEqual(200);
+	expect(deniedResponse.json()).toEqual({ error: 'private file' });
+});
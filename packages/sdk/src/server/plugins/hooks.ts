@@ -638,6 +638,41 @@ const FastifyHooksPlugin: FastifyPluginAsync<FastifyHooksOptions> = async (fasti
 			}
 		};
 
+	const onSubscriptionEvent =
+		(
+			operationName: string,
+			hookFunction: OperationHookFunction
+		): RouteHandlerMethod<
+			RawServerDefault,
+			RawRequestDefaultExpression,
+			RawReplyDefaultExpression<RawServerDefault>,
+			{ Body: { input: any; response: BodyResponse } }
+		> =>
+		async (request, reply) => {
+			reply.type('application/json').code(200);
+			try {
+				const out = await hookFunction({
+					...requestContext(request),
+					input: request.body.input,
+					event: request.body.response,
+				});
+				return {
+					op: operationName,
+					hook: 'onSubscriptionEvent',
+					response: out || null,
+				};
+			} catch (err) {
+				// Mark the request as errored and attach information about the error
+				if (request.telemetry) {
+					attachErrorToSpan(request.telemetry.parentSpan, err);
+				}
+
+				request.log.error(err);
+				reply.code(500);
+				return { op: operationName, hook: 'onSubscriptionEvent', error: err };
+			}
+		};
+
 	function registerOperationHooks(operations: string[], operationHooks: { [p: string]: OperationHooksConfiguration }) {
 		operations.forEach((operationName) => {
 			const mockResolveOp = operationHooks?.[operationName]?.mockResolve;
@@ -693,6 +728,15 @@ const FastifyHooksPlugin: FastifyPluginAsync<FastifyHooksOptions> = async (fasti
 					customResolve(operationName, customResolveOp)
 				);
 			}
+
+			const onSubscriptionEventOp = operationHooks?.[operationName]?.onSubscriptionEvent;
+			if (onSubscriptionEventOp) {
+				fastify.post<any, HooksRouteConfig>(
+					`/operation/${operationName}/onSubscriptionEvent`,
+					{ config: { operationName, kind: 'hook', hookName: 'onSubscriptionEvent' } },
+					onSubscriptionEvent(operationName, onSubscriptionEventOp)
+				);
+			}
 		});
 	}
 
@@ -744,6 +788,29 @@ const FastifyHooksPlugin: FastifyPluginAsync<FastifyHooksOptions> = async (fasti
 		});
 	};
 
+	const preDownload = (providerName: string, profileName: string, handler: any) => {
+		fastify.post<{
+			Body: {
+				file: WunderGraphFile;
+				meta: any;
+			};
+		}>(`/upload/${providerName}/${profileName}/preDownload`, async (request, reply) => {
+			reply.type('application/json').code(200);
+			try {
+				const result = await handler({
+					...requestContext(request),
+					file: request.body.file,
+					meta: request.body.meta,
+				});
+				return result || {};
+			} catch (err) {
+				request.log.error(err);
+				reply.code(500);
+				return { error: err };
+			}
+		});
+	};
+
 	function registerUploadHooks(hooks: UploadHooks): number {
 		let count = 0;
 		for (const providerName in hooks) {
@@ -758,6 +825,10 @@ const FastifyHooksPlugin: FastifyPluginAsync<FastifyHooksOptions> = async (fasti
 					count++;
 					postUpload(providerName, profileName, profile.postUpload);
 				}
+				if (profile?.preDownload !== undefined) {
+					count++;
+					preDownload(providerName, profileName, profile.preDownload);
+				}
 			}
 		}
 		return count;
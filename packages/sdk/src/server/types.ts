@@ -1,6 +1,14 @@
 import type { fetch } from '@whatwg-node/fetch';
 import type { GraphQLServerConfig } from './plugins/graphql';
-import type { ConfigurationVariable, WunderGraphConfiguration } from '@wundergraph/protobuf';
+import type {
+	AsyncHooksOptions as _AsyncHooksOptions,
+	ConfigurationVariable,
+	GRPCHooksOptions as _GRPCHooksOptions,
+	HookPolicy as _HookPolicy,
+	HooksServerPoolOptions as _HooksServerPoolOptions,
+	WasmHooksOptions as _WasmHooksOptions,
+	WunderGraphConfiguration,
+} from '@wundergraph/protobuf';
 import type { WebhooksConfig } from '../webhooks/types';
 import type { InputVariable } from '../configure/variables';
 import type { ListenOptions, LoggerLevel, ResolvedListenOptions } from '../configure/options';
@@ -22,6 +30,29 @@ export interface ServerOptions {
 	serverUrl?: InputVariable;
 	listen?: ListenOptions;
 	logger?: ServerLogger;
+	/**
+	 * Run the hooks implemented by a WebAssembly module inside the WunderNode,
+	 * without calling the WunderGraph server
+	 */
+	wasmHooks?: WasmHooksOptions;
+	/**
+	 * Send the hooks to a gRPC server implementing the Hooks service instead of using HTTP
+	 */
+	grpcHooks?: GRPCHooksOptions;
+	/**
+	 * Load balance the hooks between several servers
+	 */
+	serverPool?: HooksServerPoolOptions;
+	/**
+	 * Timeout, retries and failure handling for the hooks matching each policy,
+	 * the first matching policy is used
+	 */
+	hookPolicies?: HookPolicy[];
+	/**
+	 * Send postResolve, postAuthentication, postLogout and postUpload hooks in the
+	 * background, without waiting for their response
+	 */
+	asyncHooks?: AsyncHooksOptions;
 }
 
 export interface MandatoryServerOptions {
@@ -33,12 +64,173 @@ export interface MandatoryServerOptions {
 	logger: {
 		level: InputVariable<LoggerLevel>;
 	};
+	wasmHooks?: WasmHooksOptions;
+	grpcHooks?: GRPCHooksOptions;
+	serverPool?: HooksServerPoolOptions;
+	hookPolicies?: HookPolicy[];
+	asyncHooks?: AsyncHooksOptions;
 }
 
 export interface ResolvedServerOptions {
 	serverUrl: ConfigurationVariable;
 	listen: ResolvedListenOptions;
 	logger: ResolvedServerLogger;
+	wasmHooks: _WasmHooksOptions | undefined;
+	grpcHooks: _GRPCHooksOptions | undefined;
+	serverPool: _HooksServerPoolOptions | undefined;
+	hookPolicies: _HookPolicy[];
+	asyncHooks: _AsyncHooksOptions | undefined;
+}
+
+export interface GRPCHooksOptions {
+	/**
+	 * Address of the hooks server, e.g. localhost:9993
+	 */
+	address: InputVariable;
+	/**
+	 * Use TLS for the connection
+	 *
+	 * @default false
+	 */
+	tls?: boolean;
+}
+
+export interface HooksServerPoolOptions {
+	/**
+	 * Additional hooks servers, hooks are load balanced between serverUrl and these ones
+	 */
+	serverUrls?: InputVariable[];
+	/**
+	 * leastOutstanding sends each hook to the server with the fewest requests in flight
+	 *
+	 * @default 'roundRobin'
+	 */
+	loadBalancing?: 'roundRobin' | 'leastOutstanding';
+	/**
+	 * Interval between active health checks, in seconds
+	 *
+	 * @default 10
+	 */
+	healthCheckIntervalSeconds?: number;
+	/**
+	 * Consecutive failed requests before a server is ejected
+	 *
+	 * @default 5
+	 */
+	maxFailures?: number;
+	/**
+	 * Time an ejected server stops receiving hooks, in seconds
+	 *
+	 * @default 30
+	 */
+	ejectionSeconds?: number;
+	/**
+	 * Hooks matching a route are sent to its servers instead, the first matching route is used
+	 */
+	routes?: HooksServerRoute[];
+}
+
+export interface HooksServerRoute {
+	/**
+	 * Pattern matching the hook path, e.g. operation/*\/postResolve or functions/**
+	 */
+	hook: string;
+	serverUrls: InputVariable[];
+}
+
+export interface HookPolicy {
+	/**
+	 * Pattern matching the hook path, e.g. operation/*\/postResolve or operation/Todos/**
+	 */
+	hook: string;
+	/**
+	 * Timeout for the hook including its retries, in milliseconds
+	 *
+	 * @default No timeout besides the 60s limit for each attempt
+	 */
+	timeoutMs?: number;
+	/**
+	 * Retries after a failed attempt, 0 disables them
+	 *
+	 * @default 40
+	 */
+	maxRetries?: number;
+	/**
+	 * What happens when the hook fails. open continues as if the hook didn't modify
+	 * the data, and fallback uses fallbackResponse as the response of the hook.
+	 *
+	 * @default 'closed'
+	 */
+	failureMode?: 'closed' | 'open' | 'fallback';
+	/**
+	 * Response of the hook used by the fallback failure mode, e.g. { response: { data: null } }
+	 */
+	fallbackResponse?: unknown;
+}
+
+export interface AsyncHooksOptions {
+	/**
+	 * Patterns matching the asynchronous hooks, e.g. operation/*\/postResolve or authentication/postLogout
+	 */
+	hooks: string[];
+	/**
+	 * Maximum number of queued hooks, hooks are dropped when the queue is full
+	 *
+	 * @default 1000
+	 */
+	queueSize?: number;
+	/**
+	 * Number of hooks sent concurrently
+	 *
+	 * @default 4
+	 */
+	workers?: number;
+	/**
+	 * Store the queued hooks on disk, so they're sent after a restart
+	 *
+	 * @default false
+	 */
+	durable?: boolean;
+	/**
+	 * Directory used by the durable queue
+	 *
+	 * @default generated/hooks-queue inside the WunderGraph directory
+	 */
+	directory?: InputVariable;
+}
+
+export interface WasmHooksOptions {
+	/**
+	 * Path to the WebAssembly module implementing the hooks, relative to the
+	 * WunderGraph directory. Hooks not implemented by the module are still
+	 * sent to the WunderGraph server.
+	 */
+	modulePath: InputVariable;
+	/**
+	 * Memory limit for each hook execution in megabytes
+	 *
+	 * @default 16
+	 */
+	maxMemoryMb?: number;
+	/**
+	 * Time limit for each hook execution in milliseconds
+	 *
+	 * @default 1000
+	 */
+	timeoutMs?: number;
+	/**
+	 * Limits for specific hooks, overriding maxMemoryMb and timeoutMs
+	 */
+	hookLimits?: WasmHookLimits[];
+}
+
+export interface WasmHookLimits {
+	/**
+	 * Path of the hook, e.g. operation/Weather/preResolve
+	 */
+	hook: string;
+	maxMemoryMb?: number;
+	timeoutMs?: number;
 }
 
 export interface ServerLogger {
@@ -77,6 +269,7 @@ export interface OperationHooksConfiguration<AsyncFn = OperationHookFunction> {
 	mutatingPreResolve?: AsyncFn;
 	mutatingPostResolve?: AsyncFn;
 	customResolve?: AsyncFn;
+	onSubscriptionEvent?: AsyncFn;
 }
 
 export type AuthenticationHookRequest<Context extends BaseRequestContext = BaseRequestContext> = Context &
@@ -159,6 +352,25 @@ export interface PostUploadHookRequest<User extends WunderGraphUser = WunderGrap
 	error: Error;
 }
 
+export interface PreDownloadHookRequest<User extends WunderGraphUser = WunderGraphUser, TCustomContext = any> {
+	/**
+	 * The user that is currently logged in, if any.
+	 */
+	user?: User;
+	/**
+	 * File to be downloaded
+	 */
+	file: WunderGraphFile;
+	/**
+	 * Metadata sent by the client when uploading the file
+	 */
+	meta: any;
+	/**
+	 * Custom handler context
+	 */
+	context: TCustomContext;
+}
+
 export interface ClientRequestHeaders extends Headers {}
 
 export type RequestMethod = 'GET' | 'POST' | 'PUT' | 'DELETE' | 'PATCH' | 'HEAD' | 'OPTIONS' | 'CONNECT' | 'TRACE';
@@ -362,6 +574,11 @@ export type PreUploadHookResponse =
 	| Promise<void>
 	| void;
 export type PostUploadHookResponse = Promise<void> | void;
+export type PreDownloadHookResponse =
+	| UploadHookErrorResponse
+	| Promise<UploadHookErrorResponse>
+	| Promise<void>
+	| void;
 
 export enum HooksConfigurationOperationType {
 	Queries = 'queries',
@@ -469,12 +686,34 @@ export type SubscriptionHook<
 	mutatingPreResolve?: Input extends undefined ? never : (hook: Context & { input: Input }) => Promise<Input>;
 	postResolve?: (hook: WithInput<Input, Context> & { response: Response }) => Promise<void>;
 	mutatingPostResolve?: (hook: WithInput<Input, Context> & { response: Response }) => Promise<Response>;
+	// onSubscriptionEvent is called for each message sent to the client, it can skip,
+	// replace the message or terminate the subscription. Throwing an error terminates
+	// the subscription with the error as the last message.
+	onSubscriptionEvent?: (
+		hook: WithInput<Input, Context> & { event: Response }
+	) => Promise<SubscriptionEventHookResponse<Response> | void>;
 };
 
 export type SubscriptionHookWithoutInput<
 	Response extends HookResponse = HookResponse,
 	Context extends BaseRequestContext = BaseRequestContext
-> = Omit<QueryHook<undefined, Response, Context>, 'mutatingPreResolve'>;
+> = Omit<QueryHook<undefined, Response, Context>, 'mutatingPreResolve'> &
+	Pick<SubscriptionHook<undefined, Response, Context>, 'onSubscriptionEvent'>;
+
+export interface SubscriptionEventHookResponse<Response extends HookResponse = HookResponse> {
+	/**
+	 * Drop the message for this client
+	 */
+	skip?: boolean;
+	/**
+	 * End the subscription after sending the message
+	 */
+	terminate?: boolean;
+	/**
+	 * Message sent instead of the original one
+	 */
+	event?: Response;
+}
 
 export interface QueryHooks<Context extends BaseRequestContext = BaseRequestContext> {
 	[operationName: string]: QueryHook<any, any, Context> | QueryHookWithoutInput<any, any>;
@@ -488,6 +727,39 @@ export interface SubscriptionHooks<Context extends BaseRequestContext = BaseRequ
 	[operationName: string]: SubscriptionHook<any, any, Context> | SubscriptionHookWithoutInput<any, any>;
 }
 
+/**
+ * HookMatcher selects the requests to the origins a hook is called for. Empty
+ * fields match every request, otherwise one of their values must match.
+ */
+export interface HookMatcher<DataSources extends string = string> {
+	operationType?: 'query' | 'mutation' | 'subscription';
+	/**
+	 * IDs of the data sources
+	 */
+	datasources?: DataSources[];
+	/**
+	 * Operation names or patterns, e.g. users/* or admin/**
+	 */
+	operationNames?: string[];
+	/**
+	 * HTTP methods of the request to the origin
+	 */
+	methods?: string[];
+	/**
+	 * Path patterns of the request to the origin, e.g. /v1/users/*
+	 */
+	paths?: string[];
+	/**
+	 * Headers of the request to the origin, all of them must match. The value is a
+	 * pattern where * matches any characters, without a value the header only needs to be present.
+	 */
+	headers?: { name: string; value?: string }[];
+	/**
+	 * Roles of the user, anonymous requests never match
+	 */
+	roles?: string[];
+}
+
 export interface GlobalHooksConfig<
 	Operations = string,
 	DataSources extends string = string,
@@ -505,6 +777,8 @@ export interface GlobalHooksConfig<
 			enableForOperations?: Operations[];
 			// enableForAllOperations will disregard the enableForOperations property and enable the hook for all operations
 			enableForAllOperations?: boolean;
+			// match restricts the hook to the requests matching any of the matchers, so the hook is not called for the others
+			match?: HookMatcher<DataSources> | HookMatcher<DataSources>[];
 		};
 		// onResponse is called right after the response is received from the origin
 		// it can be used to modify the response
@@ -519,6 +793,8 @@ export interface GlobalHooksConfig<
 			enableForOperations?: Operations[];
 			// enableForAllOperations will disregard the enableForOperations property and enable the hook for all operations
 			enableForAllOperations?: boolean;
+			// match restricts the hook to the responses of the requests matching any of the matchers
+			match?: HookMatcher<DataSources> | HookMatcher<DataSources>[];
 		};
 		onOriginTransport?: {
 			hook: (hook: HttpTransportRequest<Operations, Context>) => Promise<Response | null | undefined>;
@@ -0,0 +1,115 @@
+import { HookFailureMode, HooksLoadBalancing } from '@wundergraph/protobuf';
+
+import { resolveServerOptions, serverOptionsWithDefaults } from './util';
+import { mapInputVariable } from '../configure/variables';
+
+describe('resolveServerOptions', () => {
+	it('should resolve the wasm hooks', () => {
+		const options = resolveServerOptions(
+			serverOptionsWithDefaults({
+				wasmHooks: {
+					modulePath: 'hooks.wasm',
+					timeoutMs: 200,
+					hookLimits: [{ hook: 'operation/Weather/preResolve', maxMemoryMb: 64 }],
+				},
+			})
+		);
+		expect(options.wasmHooks).toEqual({
+			modulePath: mapInputVariable('hooks.wasm'),
+			maxMemoryMb: 0,
+			timeoutMs: 200,
+			hookLimits: [{ hook: 'operation/Weather/preResolve', maxMemoryMb: 64, timeoutMs: 0 }],
+		});
+	});
+
+	it('should resolve the gRPC hooks', () => {
+		const options = resolveServerOptions(serverOptionsWithDefaults({ grpcHooks: { address: 'localhost:9993' } }));
+		expect(options.grpcHooks).toEqual({
+			address: mapInputVariable('localhost:9993'),
+			tls: false,
+		});
+	});
+
+	it('should resolve the server pool', () => {
+		const options = resolveServerOptions(
+			serverOptionsWithDefaults({
+				serverPool: {
+					serverUrls: ['http://localhost:9993'],
+					loadBalancing: 'leastOutstanding',
+					routes: [{ hook: 'functions/**', serverUrls: ['http://localhost:9994'] }],
+				},
+			})
+		);
+		expect(options.serverPool).toEqual({
+			serverUrls: [mapInputVariable('http://localhost:9993')],
+			loadBalancing: HooksLoadBalancing.HooksLoadBalancingLeastOutstanding,
+			healthCheckIntervalSeconds: 0,
+			maxFailures: 0,
+			ejectionSeconds: 0,
+			routes: [{ hook: 'functions/**', serverUrls: [mapInputVariable('http://localhost:9994')] }],
+		});
+	});
+
+	it('should resolve the hook policies', () => {
+		const options = resolveServerOptions(
+			serverOptionsWithDefaults({
+				hookPolicies: [
+					{ hook: 'operation/*/postResolve', timeoutMs: 500, maxRetries: 0, failureMode: 'open' },
+					{ hook: 'operation/Todos/**', failureMode: 'fallback', fallbackResponse: { response: { data: null } } },
+				],
+			})
+		);
+		expect(options.hookPolicies).toEqual([
+			{
+				hook: 'operation/*/postResolve',
+				timeoutMs: 500,
+				maxRetries: 0,
+				failureMode: HookFailureMode.HookFailOpen,
+				fallbackResponse: '',
+			},
+			{
+				hook: 'operation/Todos/**',
+				timeoutMs: 0,
+				maxRetries: undefined,
+				failureMode: HookFailureMode.HookFailFallback,
+				fallbackResponse: '{"response":{"data":null}}',
+			},
+		]);
+	});
+
+	it('should require a fallback response for the fallback failure mode', () => {
+		expect(() =>
+			resolveServerOptions(
+				serverOptionsWithDefaults({ hookPolicies: [{ hook: 'operation/Todos/**', failureMode: 'fallback' }] })
+			)
+		).toThrow('hook policy operation/Todos/** requires a fallbackResponse');
+	});
+
+	it('should resolve the async hooks', () => {
+		const options = resolveServerOptions(
+			serverOptionsWithDefaults({
+				asyncHooks: {
+					hooks: ['operation/*/postResolve', 'authentication/postLogout'],
+					workers: 8,
+					durable: true,
+				},
+			})
+		);
+		expect(options.asyncHooks).toEqual({
+			hooks: ['operation/*/postResolve', 'authentication/postLogout'],
+			queueSize: 0,
+			workers: 8,
+			durable: true,
+			directory: mapInputVariable(''),
+		});
+	});
+
+	it('should leave the hooks options empty by default', () => {
+		const options = resolveServerOptions(serverOptionsWithDefaults());
+		expect(options.wasmHooks).toBeUndefined();
+		expect(options.grpcHooks).toBeUndefined();
+		expect(options.serverPool).toBeUndefined();
+		expect(options.hookPolicies).toEqual([]);
+		expect(options.asyncHooks).toBeUndefined();
+	});
+});
@@ -1,6 +1,27 @@
 import { EnvironmentVariable, mapInputVariable, resolveVariable } from '../configure/variables';
 import { defaultHost, defaultServerPort, isCloud, ListenOptions, LoggerLevel, WgEnv } from '../configure/options';
-import { ResolvedServerOptions, ServerOptions, MandatoryServerOptions } from './types';
+import {
+	AsyncHooksOptions as _AsyncHooksOptions,
+	GRPCHooksOptions as _GRPCHooksOptions,
+	HookFailureMode,
+	HookMatcher as _HookMatcher,
+	HookPolicy as _HookPolicy,
+	HooksLoadBalancing,
+	HooksServerPoolOptions as _HooksServerPoolOptions,
+	OperationType,
+	WasmHooksOptions as _WasmHooksOptions,
+} from '@wundergraph/protobuf';
+import {
+	AsyncHooksOptions,
+	GRPCHooksOptions,
+	HookMatcher,
+	HookPolicy,
+	HooksServerPoolOptions,
+	ResolvedServerOptions,
+	ServerOptions,
+	MandatoryServerOptions,
+	WasmHooksOptions,
+} from './types';
 import objectHash from 'object-hash';
 
 export const customGqlServerMountPath = (name: string): string => {
@@ -37,9 +58,80 @@ export const serverOptionsWithDefaults = (options?: ServerOptions): MandatorySer
 				logger: {
 					level: options?.logger?.level || DefaultServerOptions.logger.level,
 				},
+				wasmHooks: options?.wasmHooks,
+				grpcHooks: options?.grpcHooks,
+				serverPool: options?.serverPool,
+				hookPolicies: options?.hookPolicies,
+				asyncHooks: options?.asyncHooks,
 		  };
 };
 
+const resolveWasmHooksOptions = (options: WasmHooksOptions): _WasmHooksOptions => {
+	return {
+		modulePath: mapInputVariable(options.modulePath),
+		maxMemoryMb: options.maxMemoryMb ?? 0,
+		timeoutMs: options.timeoutMs ?? 0,
+		hookLimits: (options.hookLimits || []).map((limits) => ({
+			hook: limits.hook,
+			maxMemoryMb: limits.maxMemoryMb ?? 0,
+			timeoutMs: limits.timeoutMs ?? 0,
+		})),
+	};
+};
+
+const resolveGRPCHooksOptions = (options: GRPCHooksOptions): _GRPCHooksOptions => {
+	return {
+		address: mapInputVariable(options.address),
+		tls: options.tls ?? false,
+	};
+};
+
+const resolveHooksServerPoolOptions = (options: HooksServerPoolOptions): _HooksServerPoolOptions => {
+	return {
+		serverUrls: (options.serverUrls || []).map((url) => mapInputVariable(url)),
+		loadBalancing:
+			options.loadBalancing === 'leastOutstanding'
+				? HooksLoadBalancing.HooksLoadBalancingLeastOutstanding
+				: HooksLoadBalancing.HooksLoadBalancingRoundRobin,
+		healthCheckIntervalSeconds: options.healthCheckIntervalSeconds ?? 0,
+		maxFailures: options.maxFailures ?? 0,
+		ejectionSeconds: options.ejectionSeconds ?? 0,
+		routes: (options.routes || []).map((route) => ({
+			hook: route.hook,
+			serverUrls: route.serverUrls.map((url) => mapInputVariable(url)),
+		})),
+	};
+};
+
+const hookFailureModes: Record<NonNullable<HookPolicy['failureMode']>, HookFailureMode> = {
+	closed: HookFailureMode.HookFailClosed,
+	open: HookFailureMode.HookFailOpen,
+	fallback: HookFailureMode.HookFailFallback,
+};
+
+const resolveHookPolicy = (policy: HookPolicy): _HookPolicy => {
+	if (policy.failureMode === 'fallback' && policy.fallbackResponse === undefined) {
+		throw new Error(`hook policy ${policy.hook} requires a fallbackResponse`);
+	}
+	return {
+		hook: policy.hook,
+		timeoutMs: policy.timeoutMs ?? 0,
+		maxRetries: policy.maxRetries,
+		failureMode: hookFailureModes[policy.failureMode ?? 'closed'],
+		fallbackResponse: policy.fallbackResponse !== undefined ? JSON.stringify(policy.fallbackResponse) : '',
+	};
+};
+
+const resolveAsyncHooksOptions = (options: AsyncHooksOptions): _AsyncHooksOptions => {
+	return {
+		hooks: options.hooks,
+		queueSize: options.queueSize ?? 0,
+		workers: options.workers ?? 0,
+		durable: options.durable ?? false,
+		directory: mapInputVariable(options.directory || ''),
+	};
+};
+
 export const resolveServerOptions = (options: MandatoryServerOptions): ResolvedServerOptions => {
 	return {
 		serverUrl: mapInputVariable(options.serverUrl),
@@ -50,18 +142,41 @@ export const resolveServerOptions = (options: MandatoryServerOptions): ResolvedS
 		logger: {
 			level: mapInputVariable(options.logger.level),
 		},
+		wasmHooks: options.wasmHooks ? resolveWasmHooksOptions(options.wasmHooks) : undefined,
+		grpcHooks: options.grpcHooks ? resolveGRPCHooksOptions(options.grpcHooks) : undefined,
+		serverPool: options.serverPool ? resolveHooksServerPoolOptions(options.serverPool) : undefined,
+		hookPolicies: (options.hookPolicies || []).map(resolveHookPolicy),
+		asyncHooks: options.asyncHooks ? resolveAsyncHooksOptions(options.asyncHooks) : undefined,
 	};
 };
 
-interface hookMatch {
-	operationType?: string;
-	datasources?: string[];
-}
-
-export const hookID = (match: hookMatch) => {
+export const hookID = (match: HookMatcher) => {
 	const m = {
 		operationType: match.operationType ?? '',
 		datasources: match.datasources ?? [],
+		operationNames: match.operationNames ?? [],
+		methods: match.methods ?? [],
+		paths: match.paths ?? [],
+		headers: match.headers ?? [],
+		roles: match.roles ?? [],
 	};
 	return objectHash(m);
 };
+
+const operationTypes = {
+	query: OperationType.QUERY,
+	mutation: OperationType.MUTATION,
+	subscription: OperationType.SUBSCRIPTION,
+};
+
+export const mapHookMatcher = (match: HookMatcher): _HookMatcher => {
+	return {
+		operationType: match.operationType ? operationTypes[match.operationType] : undefined,
+		datasources: match.datasources ?? [],
+		operationNames: match.operationNames ?? [],
+		methods: match.methods ?? [],
+		paths: match.paths ?? [],
+		headers: (match.headers ?? []).map((header) => ({ name: header.name, value: header.value ?? '' })),
+		roles: match.roles ?? [],
+	};
+};
@@ -226,6 +226,7 @@ class RESTApiBuilder {
 			ChildNodes: [],
 			Directives: [],
 			RequestTimeoutSeconds: this.introspection.requestTimeoutSeconds ?? 0,
+			OriginRequestExpressions: this.introspection.originRequestExpressions,
 		});
 
 		const isJsonResponse = Object.keys(operationObject.responses).length === 0;
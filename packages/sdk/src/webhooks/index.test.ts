@@ -0,0 +1,47 @@
+import { WebhookVerifierKind as _WebhookVerifierKind } from '@wundergraph/protobuf';
+
+import { EnvironmentVariable } from '../configure/variables';
+import { mapWebhookConfiguration } from './index';
+import { GithubWebhookVerifier } from './verifiers';
+
+describe('mapWebhookConfiguration', () => {
+	const webhook = { name: 'github', filePath: 'webhooks/github.cjs' };
+
+	it('should map queued webhooks', () => {
+		const config = mapWebhookConfiguration(webhook, {
+			verifier: GithubWebhookVerifier(new EnvironmentVariable('GITHUB_SECRET')),
+			queued: true,
+			maxDeliveryAttempts: 5,
+		});
+		expect(config.name).toBe('github');
+		expect(config.filePath).toBe('webhooks/github.cjs');
+		expect(config.verifier?.kind).toBe(_WebhookVerifierKind.HMAC_SHA256);
+		expect(config.queued).toBe(true);
+		expect(config.maxDeliveryAttempts).toBe(5);
+	});
+
+	it('should map webhooks without settings', () => {
+		expect(mapWebhookConfiguration(webhook)).toEqual({
+			name: 'github',
+			filePath: 'webhooks/github.cjs',
+			verifier: undefined,
+			queued: false,
+			maxDeliveryAttempts: 0,
+			publishEvents: false,
+		});
+	});
+
+	it('should map webhooks publishing events', () => {
+		const config = mapWebhookConfiguration(webhook, {
+			verifier: GithubWebhookVerifier(new EnvironmentVariable('GITHUB_SECRET')),
+			publishEvents: true,
+		});
+		expect(config.publishEvents).toBe(true);
+	});
+
+	it('should require a verifier to publish events', () => {
+		expect(() => mapWebhookConfiguration(webhook, { publishEvents: true })).toThrow(
+			'webhook github must configure a verifier to publish events'
+		);
+	});
+});
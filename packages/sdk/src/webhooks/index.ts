@@ -1,5 +1,8 @@
 import { Dirent, promises } from 'fs';
 import path from 'path';
+import { WebhookConfiguration as _WebhookConfiguration } from '@wundergraph/protobuf';
+import { WebhookConfiguration } from './types';
+import { mapWebhookVerifier } from './verifiers';
 
 /**
  * Returns the list webhook files in the directory.
@@ -20,3 +23,24 @@ export const getWebhooks = async (dir: string): Promise<{ filePath: string; name
 			};
 		});
 };
+
+/**
+ * Returns the configuration of the webhook file, using the settings of the
+ * webhook in the server config if there are any.
+ */
+export const mapWebhookConfiguration = (
+	webhook: { filePath: string; name: string },
+	config?: WebhookConfiguration
+): _WebhookConfiguration => {
+	if (config?.publishEvents && !config.verifier) {
+		throw new Error(`webhook ${webhook.name} must configure a verifier to publish events`);
+	}
+	return {
+		name: webhook.name,
+		filePath: webhook.filePath,
+		verifier: config?.verifier ? mapWebhookVerifier(config.verifier) : undefined,
+		queued: config?.queued ?? false,
+		maxDeliveryAttempts: config?.maxDeliveryAttempts ?? 0,
+		publishEvents: config?.publishEvents ?? false,
+	};
+};
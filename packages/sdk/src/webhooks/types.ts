@@ -1,7 +1,6 @@
 import { ClientRequest, OperationsClient, RequestLogger } from '../server';
 import { RequestMethod } from '../server/types';
-import { WebhookVerifierKind } from './verifiers';
-import { EnvironmentVariable } from '../configure/variables';
+import { WebhookVerifier } from './verifiers';
 
 export interface Webhook<
 	Event extends WebhookHttpEvent = WebhookHttpEvent,
@@ -55,12 +54,27 @@ export interface WebhookHttpEvent<
 }
 
 export interface WebhookConfiguration {
-	verifier: {
-		kind: WebhookVerifierKind;
-		secret: EnvironmentVariable;
-		signatureHeader: string;
-		signatureHeaderPrefix: string;
-	};
+	verifier?: WebhookVerifier;
+	/**
+	 * Acknowledge verified POST requests immediately and deliver them to the
+	 * hooks server from a durable queue, retrying failed deliveries
+	 *
+	 * @default false
+	 */
+	queued?: boolean;
+	/**
+	 * Maximum number of delivery attempts before moving a queued webhook to the dead letters
+	 *
+	 * @default 10
+	 */
+	maxDeliveryAttempts?: number;
+	/**
+	 * Publish the verified payloads to the subscriptions using introspect.webhookEvents,
+	 * besides forwarding them to the hooks server. Requires a verifier.
+	 *
+	 * @default false
+	 */
+	publishEvents?: boolean;
 }
 
 export interface WebhooksConfig {
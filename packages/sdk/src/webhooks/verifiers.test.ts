@@ -0,0 +1,38 @@
+import { WebhookVerifierKind as _WebhookVerifierKind } from '@wundergraph/protobuf';
+
+import { EnvironmentVariable, mapInputVariable } from '../configure/variables';
+import { CreateWebhookVerifier, mapWebhookVerifier, StripeWebhookVerifier, WebhookVerifierKind } from './verifiers';
+
+describe('mapWebhookVerifier', () => {
+	it('should map the rotation and replay options', () => {
+		const verifier = CreateWebhookVerifier({
+			kind: WebhookVerifierKind.ED25519,
+			secret: new EnvironmentVariable('WEBHOOK_PUBLIC_KEY'),
+			additionalSecrets: [new EnvironmentVariable('WEBHOOK_PREVIOUS_PUBLIC_KEY')],
+			signatureHeader: 'X-Signature-Ed25519',
+			signatureHeaderPrefix: '',
+			timestampHeader: 'X-Signature-Timestamp',
+			toleranceSeconds: 60,
+			replayCacheSize: 1000,
+		});
+		expect(mapWebhookVerifier(verifier)).toEqual({
+			kind: _WebhookVerifierKind.ED25519,
+			secret: mapInputVariable(new EnvironmentVariable('WEBHOOK_PUBLIC_KEY')),
+			additionalSecrets: [mapInputVariable(new EnvironmentVariable('WEBHOOK_PREVIOUS_PUBLIC_KEY'))],
+			signatureHeader: 'X-Signature-Ed25519',
+			signatureHeaderPrefix: '',
+			timestampHeader: 'X-Signature-Timestamp',
+			toleranceSeconds: 60,
+			idHeader: '',
+			replayCacheSize: 1000,
+		});
+	});
+
+	it('should default the optional fields', () => {
+		const verifier = mapWebhookVerifier(StripeWebhookVerifier(new EnvironmentVariable('STRIPE_WEBHOOK_SECRET')));
+		expect(verifier.kind).toBe(_WebhookVerifierKind.STRIPE);
+		expect(verifier.additionalSecrets).toEqual([]);
+		expect(verifier.toleranceSeconds).toBe(0);
+		expect(verifier.replayCacheSize).toBe(0);
+	});
+});
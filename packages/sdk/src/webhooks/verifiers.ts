@@ -1,30 +1,57 @@
-import { webhookVerifierKindFromJSON } from '@wundergraph/protobuf';
-import { EnvironmentVariable } from '../configure/variables';
+import { WebhookVerifier as _WebhookVerifier, webhookVerifierKindFromJSON } from '@wundergraph/protobuf';
+import { EnvironmentVariable, mapInputVariable } from '../configure/variables';
 
 export enum WebhookVerifierKind {
 	HMAC_SHA256 = 0,
+	STRIPE = 1,
+	SLACK = 2,
+	SVIX = 3,
+	ED25519 = 4,
+	RSA_SHA256 = 5,
 }
 
 export interface WebhookVerifier {
 	kind: WebhookVerifierKind;
+	/**
+	 * Secret used to verify the signatures. For ED25519 and RSA_SHA256 it contains
+	 * the public key, either PEM or hex/base64 encoded.
+	 */
 	secret: EnvironmentVariable;
 	signatureHeader: string;
 	signatureHeaderPrefix: string;
+	/**
+	 * Secrets also accepted while rotating them
+	 */
+	additionalSecrets?: EnvironmentVariable[];
+	/**
+	 * Header with the unix timestamp included in the signature, only used by ED25519 and RSA_SHA256
+	 */
+	timestampHeader?: string;
+	/**
+	 * Maximum difference between the signature timestamp and the current time in seconds,
+	 * negative disables checking it
+	 *
+	 * @default 300
+	 */
+	toleranceSeconds?: number;
+	/**
+	 * Header with a unique ID for each message, used for detecting replays instead of the signature
+	 */
+	idHeader?: string;
+	/**
+	 * Number of recently verified messages remembered to reject replays
+	 *
+	 * @default 0 (disabled)
+	 */
+	replayCacheSize?: number;
 }
 
-export interface WebhookVerifierConfiguration {
-	kind: WebhookVerifierKind;
-	signatureHeader: string;
-	signatureHeaderPrefix: string;
-	secret: EnvironmentVariable;
-}
+export interface WebhookVerifierConfiguration extends WebhookVerifier {}
 
 export const CreateWebhookVerifier = (config: WebhookVerifierConfiguration): WebhookVerifier => {
 	return {
+		...config,
 		kind: webhookVerifierKindFromJSON(config.kind),
-		signatureHeader: config.signatureHeader,
-		signatureHeaderPrefix: config.signatureHeaderPrefix,
-		secret: config.secret,
 	};
 };
 
@@ -36,3 +63,47 @@ export const GithubWebhookVerifier = (secret: EnvironmentVariable): WebhookVerif
 		secret,
 	};
 };
+
+export const StripeWebhookVerifier = (secret: EnvironmentVariable): WebhookVerifier => {
+	return {
+		kind: webhookVerifierKindFromJSON(WebhookVerifierKind.STRIPE),
+		signatureHeader: '',
+		signatureHeaderPrefix: '',
+		secret,
+	};
+};
+
+export const SlackWebhookVerifier = (secret: EnvironmentVariable): WebhookVerifier => {
+	return {
+		kind: webhookVerifierKindFromJSON(WebhookVerifierKind.SLACK),
+		signatureHeader: '',
+		signatureHeaderPrefix: '',
+		secret,
+	};
+};
+
+/**
+ * Verifies Svix and Standard Webhooks signatures, the secret uses the whsec_ format
+ */
+export const SvixWebhookVerifier = (secret: EnvironmentVariable): WebhookVerifier => {
+	return {
+		kind: webhookVerifierKindFromJSON(WebhookVerifierKind.SVIX),
+		signatureHeader: '',
+		signatureHeaderPrefix: '',
+		secret,
+	};
+};
+
+export const mapWebhookVerifier = (verifier: WebhookVerifier): _WebhookVerifier => {
+	return {
+		kind: verifier.kind,
+		signatureHeader: verifier.signatureHeader,
+		signatureHeaderPrefix: verifier.signatureHeaderPrefix,
+		secret: mapInputVariable(verifier.secret),
+		additionalSecrets: (verifier.additionalSecrets || []).map((secret) => mapInputVariable(secret)),
+		timestampHeader: verifier.timestampHeader || '',
+		toleranceSeconds: verifier.toleranceSeconds || 0,
+		idHeader: verifier.idHeader || '',
+		replayCacheSize: verifier.replayCacheSize || 0,
+	};
+};
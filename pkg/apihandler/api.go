@@ -46,6 +46,53 @@ type PrometheusOptions struct {
 	Port    int
 }
 
+type WebhookQueueOptions struct {
+	Kind wgpb.WebhookQueueKind
+	// Directory for the file queue
+	Directory string
+	// NATS server for the JetStream queue
+	NatsURL   string
+	NatsToken string
+}
+
+type WasmHooksOptions struct {
+	// ModulePath is empty if the hooks don't use a WebAssembly module
+	ModulePath string
+	Limits     hooks.WasmLimits
+	HookLimits map[string]hooks.WasmLimits
+}
+
+type GRPCHooksOptions struct {
+	// Address is empty if the hooks use the HTTP transport
+	Address string
+	TLS     bool
+}
+
+type HooksServerRoute struct {
+	Hook       string
+	ServerURLs []string
+}
+
+type HooksServerPoolOptions struct {
+	// ServerURLs contains the additional servers, it's empty if all the
+	// hooks go to ServerUrl
+	ServerURLs          []string
+	LoadBalancing       wgpb.HooksLoadBalancing
+	HealthCheckInterval time.Duration
+	MaxFailures         int
+	EjectionDuration    time.Duration
+	Routes              []HooksServerRoute
+}
+
+type AsyncHooksOptions struct {
+	// Hooks is empty if all the hooks are sent synchronously
+	Hooks     []string
+	QueueSize int
+	Workers   int
+	Durable   bool
+	Directory string
+}
+
 type Options struct {
 	ServerUrl           string
 	PublicNodeUrl       string
@@ -57,6 +104,12 @@ type Options struct {
 	Subscriptions       SubscriptionOptions
 	Prometheus          PrometheusOptions
 	OpenTelemetry       OpenTelemetry
+	WebhookQueue        WebhookQueueOptions
+	WasmHooks           WasmHooksOptions
+	GRPCHooks           GRPCHooksOptions
+	HooksServerPool     HooksServerPoolOptions
+	HookPolicies        []hooks.HookPolicy
+	AsyncHooks          AsyncHooksOptions
 }
 
 type CookieBasedSecrets struct {
@@ -146,9 +199,12 @@ type Api struct {
 	AuthenticationConfig  *wgpb.ApiAuthenticationConfig
 	S3UploadConfiguration []*wgpb.S3UploadConfiguration
 	Webhooks              []*wgpb.WebhookConfiguration
+	EventWebhooks         []*wgpb.EventWebhookConfiguration
 	Options               *Options
 	CookieBasedSecrets    *CookieBasedSecrets
 	Hooks                 []*hooks.Hook
+	// OriginRequestExpressions are indexed by datasource ID
+	OriginRequestExpressions map[string]*hooks.ExpressionHooks
 }
 
 func (api *Api) HasCookieAuthEnabled() bool {
@@ -40,6 +40,8 @@ import (
 	"github.com/wundergraph/wundergraph/pkg/authentication"
 	"github.com/wundergraph/wundergraph/pkg/cacheheaders"
 	"github.com/wundergraph/wundergraph/pkg/engineconfigloader"
+	"github.com/wundergraph/wundergraph/pkg/eventbus"
+	"github.com/wundergraph/wundergraph/pkg/eventwebhooks"
 	"github.com/wundergraph/wundergraph/pkg/hooks"
 	"github.com/wundergraph/wundergraph/pkg/inputvariables"
 	"github.com/wundergraph/wundergraph/pkg/interpolate"
@@ -134,6 +136,11 @@ type Builder struct {
 	githubAuthDemoClientSecret string
 
 	metrics metrics.Metrics
+
+	webhookQueue    *webhookhandler.Queue
+	eventDispatcher *eventwebhooks.Dispatcher
+	eventBus        *eventbus.Bus
+	hooksServerPool *hooks.ServerPool
 }
 
 type BuilderConfig struct {
@@ -145,6 +152,14 @@ type BuilderConfig struct {
 	GitHubAuthDemoClientSecret string
 	DevMode                    bool
 	Metrics                    metrics.Metrics
+	// WebhookQueue is used by queued webhooks
+	WebhookQueue *webhookhandler.Queue
+	// EventDispatcher sends the event webhooks after mutations
+	EventDispatcher *eventwebhooks.Dispatcher
+	// EventBus receives the payloads of the webhooks publishing them
+	EventBus *eventbus.Bus
+	// HooksServerPool sends the webhooks to the hooks servers, if any
+	HooksServerPool *hooks.ServerPool
 }
 
 func NewBuilder(pool *pool.Pool,
@@ -165,6 +180,10 @@ func NewBuilder(pool *pool.Pool,
 		githubAuthDemoClientSecret: config.GitHubAuthDemoClientSecret,
 		devMode:                    config.DevMode,
 		metrics:                    config.Metrics,
+		webhookQueue:               config.WebhookQueue,
+		eventDispatcher:            config.EventDispatcher,
+		eventBus:                   config.EventBus,
+		hooksServerPool:            config.HooksServerPool,
 	}
 }
 
@@ -267,11 +286,27 @@ func (r *Builder) BuildAndMountApiHandler(ctx context.Context, router *mux.Route
 				MetadataJSONSchema:    profile.MetadataJSONSchema,
 				UsePreUploadHook:      profile.Hooks.PreUpload,
 				UsePostUploadHook:     profile.Hooks.PostUpload,
+				UsePreDownloadHook:    profile.Hooks.PreDownload,
+				DownloadRoles:         append([]string(nil), profile.DownloadRoles...),
+				DeleteRoles:           append([]string(nil), profile.DeleteRoles...),
+				ProxyDownloads:        profile.ProxyDownloads,
+				ImageTransformations:  imageTransformations(profile.ImageTransformations),
+				EnableFiles:           profile.EnableFiles,
 			}
 		}
+		storage, err := uploadStorage(s3Provider)
+		if err != nil {
+			r.log.Error("unable to configure upload storage",
+				zap.Error(err),
+				zap.String("provider", s3Provider.Name),
+				zap.String("storage", s3Provider.Storage.String()),
+			)
+			continue
+		}
 		s3, err := s3uploadclient.NewS3UploadClient(loadvariable.String(s3Provider.Endpoint),
 			s3uploadclient.Options{
 				Logger:          r.log,
+				Storage:         storage,
 				BucketName:      loadvariable.String(s3Provider.BucketName),
 				BucketLocation:  loadvariable.String(s3Provider.BucketLocation),
 				AccessKeyID:     loadvariable.String(s3Provider.AccessKeyID),
@@ -293,6 +328,20 @@ func (r *Builder) BuildAndMountApiHandler(ctx context.Context, router *mux.Route
 			r.router.Handle(s3Path, http.HandlerFunc(s3.UploadFile))
 			r.log.Debug("register S3 provider", zap.String("provider", s3Provider.Name))
 			r.log.Debug("register S3 endpoint", zap.String("path", s3Path))
+			presignPath := fmt.Sprintf("/s3/%s/presign", s3Provider.Name)
+			r.router.Handle(presignPath, http.HandlerFunc(s3.PresignUpload)).Methods(http.MethodPost)
+			r.log.Debug("register S3 endpoint", zap.String("path", presignPath))
+			completePath := fmt.Sprintf("/s3/%s/complete", s3Provider.Name)
+			r.router.Handle(completePath, http.HandlerFunc(s3.CompleteUpload)).Methods(http.MethodPost)
+			r.log.Debug("register S3 endpoint", zap.String("path", completePath))
+			tusPath := fmt.Sprintf("/s3/%s/tus", s3Provider.Name)
+			r.router.PathPrefix(tusPath).Handler(s3.TusHandler(tusPath))
+			r.log.Debug("register S3 endpoint", zap.String("path", tusPath))
+			if s3.FilesEnabled() {
+				filesPath := fmt.Sprintf("/s3/%s/files", s3Provider.Name)
+				r.router.PathPrefix(filesPath).Handler(s3.FilesHandler(filesPath))
+				r.log.Debug("register S3 endpoint", zap.String("path", filesPath))
+			}
 		}
 	}
 
@@ -365,7 +414,13 @@ func (r *Builder) createSubRouter(router *mux.Router) *mux.Router {
 }
 
 func (r *Builder) registerWebhook(config *wgpb.WebhookConfiguration) error {
-	handler, err := webhookhandler.New(config, r.api.Options.ServerUrl, r.log)
+	serverURL := r.api.Options.ServerUrl
+	var transport http.RoundTripper
+	if r.hooksServerPool != nil {
+		serverURL = r.hooksServerPool.URL()
+		transport = r.hooksServerPool.Transport(http.DefaultTransport)
+	}
+	handler, err := webhookhandler.New(config, serverURL, transport, r.webhookQueue, r.eventBus, r.log)
 	if err != nil {
 		return err
 	}
@@ -451,11 +506,17 @@ func (r *Builder) registerOperation(operation *wgpb.Operation) error {
 
 	postResolveTransformer := postresolvetransform.NewTransformer(operation.PostResolveTransformations)
 
+	expressions, err := hooks.NewOperationExpressions(operation)
+	if err != nil {
+		return fmt.Errorf("operation %s: %w", operation.Name, err)
+	}
+
 	hooksPipelineCommonConfig := hooks.PipelineConfig{
 		Client:      r.middlewareClient,
 		Operation:   operation,
 		Transformer: postResolveTransformer,
 		Logger:      r.log,
+		Expressions: expressions,
 	}
 
 	var operationHandler http.Handler
@@ -539,6 +600,9 @@ func (r *Builder) registerOperation(operation *wgpb.Operation) error {
 			hooksPipeline:          hooksPipeline,
 			errorHandler:           newErrorHandler(operation, r.devMode),
 		}
+		if r.eventDispatcher.HasWebhooks(operation.Name) {
+			handler.eventDispatcher = r.eventDispatcher
+		}
 		copy(handler.extractedVariables, shared.Doc.Input.Variables)
 		route = r.router.Methods(http.MethodPost, http.MethodOptions).Path(apiPath)
 		operationHandler = handler
@@ -1130,6 +1194,7 @@ type MutationHandler struct {
 	renameTypeNames        []resolve.RenameTypeName
 	hooksPipeline          *hooks.SynchronousOperationPipeline
 	errorHandler           *errorHandler
+	eventDispatcher        *eventwebhooks.Dispatcher
 }
 
 func (h *MutationHandler) parseFormVariables(r *http.Request) []byte {
@@ -1229,6 +1294,10 @@ func (h *MutationHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if h.eventDispatcher != nil {
+		h.eventDispatcher.Dispatch(h.operation.Name, ctx.Variables, resp.Data)
+	}
+
 	if h.cacheHeaders != nil {
 		h.cacheHeaders.Set(r, w, resp.Data)
 	}
@@ -1419,6 +1488,7 @@ type httpFlushWriter struct {
 	deduplicate            bool
 	close                  func()
 	closed                 atomic.Bool
+	terminated             bool
 	buf                    bytes.Buffer
 	lastMessage            bytes.Buffer
 	ping                   *httpFlushWriterPing
@@ -1552,6 +1622,26 @@ func (f *httpFlushWriter) runPostResolveHook(resp []byte) ([]byte, error) {
 	return resp, nil
 }
 
+// runSubscriptionEventHooks runs the onSubscriptionEvent hooks for the message.
+// If they fail, the subscription is terminated with an error message instead.
+// An error is only returned if the client canceled the request.
+func (f *httpFlushWriter) runSubscriptionEventHooks(resp []byte) (*hooks.SubscriptionEvent, error) {
+	if f.hooksPipeline == nil || !f.hooksPipeline.HandlesSubscriptionEvents() {
+		return &hooks.SubscriptionEvent{Data: resp}, nil
+	}
+	event, err := f.hooksPipeline.OnSubscriptionEvent(f.resolveContext, f.request, resp)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return nil, err
+		}
+		if f.logger != nil {
+			f.logger.Error("subscription onSubscriptionEvent hooks", zap.Error(err))
+		}
+		return hooks.TerminatedSubscriptionEvent("onSubscriptionEvent hook failed")
+	}
+	return event, nil
+}
+
 var errNoJSONPatch = errors.New("not using JSON patch")
 
 func (f *httpFlushWriter) prepareJSONPatch(resp []byte) ([]byte, error) {
@@ -1582,6 +1672,11 @@ func (f *httpFlushWriter) Flush() {
 	resp := f.buf.Bytes()
 	f.buf.Reset()
 
+	if f.terminated {
+		// Waiting for the resolver to stop after the subscription was terminated
+		return
+	}
+
 	resp, err := f.runPostResolveHook(resp)
 	if err != nil {
 		if errors.Is(err, context.Canceled) {
@@ -1589,6 +1684,19 @@ func (f *httpFlushWriter) Flush() {
 		}
 	}
 
+	event, err := f.runSubscriptionEventHooks(resp)
+	if err != nil {
+		f.terminate()
+		return
+	}
+	if event.Skip {
+		if event.Terminate {
+			f.terminate()
+		}
+		return
+	}
+	resp = event.Data
+
 	patchData, err := f.prepareJSONPatch(resp)
 	if err != nil && err != errNoJSONPatch {
 		if f.logger != nil {
@@ -1624,6 +1732,17 @@ func (f *httpFlushWriter) Flush() {
 			f.logger.Error("writing separator", zap.Error(err))
 		}
 	}
+	if event.Terminate {
+		f.terminate()
+	}
+}
+
+// terminate stops the subscription, ignoring any further messages
+func (f *httpFlushWriter) terminate() {
+	f.terminated = true
+	if f.close != nil {
+		f.close()
+	}
 }
 
 // MergeJsonRightIntoLeft merges the right JSON into the left JSON while overriding the left side
@@ -1646,6 +1765,51 @@ func (r *Builder) authenticationHooks() authentication.Hooks {
 	return authenticationHooks(r.api, r.middlewareClient, r.log)
 }
 
+// uploadStorage returns the storage for the provider, or nil for S3 since
+// the client creates it from the bucket options
+func uploadStorage(config *wgpb.S3UploadConfiguration) (s3uploadclient.Storage, error) {
+	switch config.Storage {
+	case wgpb.UploadStorageKind_UploadStorageS3:
+		return nil, nil
+	case wgpb.UploadStorageKind_UploadStorageLocal:
+		return s3uploadclient.NewLocalStorage(loadvariable.String(config.Directory))
+	case wgpb.UploadStorageKind_UploadStorageGCS:
+		return s3uploadclient.NewGCSStorage(s3uploadclient.GCSOptions{
+			Endpoint:        loadvariable.String(config.Endpoint),
+			BucketName:      loadvariable.String(config.BucketName),
+			BucketLocation:  loadvariable.String(config.BucketLocation),
+			CredentialsJSON: loadvariable.String(config.CredentialsJSON),
+		})
+	case wgpb.UploadStorageKind_UploadStorageAzure:
+		return s3uploadclient.NewAzureStorage(s3uploadclient.AzureOptions{
+			Endpoint:      loadvariable.String(config.Endpoint),
+			AccountName:   loadvariable.String(config.AccessKeyID),
+			AccountKey:    loadvariable.String(config.SecretAccessKey),
+			ContainerName: loadvariable.String(config.BucketName),
+		})
+	}
+	return nil, fmt.Errorf("unknown upload storage %s", config.Storage)
+}
+
+// imageTransformations converts the image transformations of an upload profile
+func imageTransformations(transformations []*wgpb.ImageTransformation) []s3uploadclient.ImageTransformation {
+	if len(transformations) == 0 {
+		return nil
+	}
+	result := make([]s3uploadclient.ImageTransformation, len(transformations))
+	for ii, t := range transformations {
+		result[ii] = s3uploadclient.ImageTransformation{
+			Name:    t.Name,
+			Width:   int(t.Width),
+			Height:  int(t.Height),
+			Fit:     s3uploadclient.ImageFit(t.Fit),
+			Format:  s3uploadclient.ImageFormat(t.Format),
+			Quality: int(t.Quality),
+		}
+	}
+	return result
+}
+
 func (r *Builder) registerAuth() error {
 
 	config, err := loadUserConfiguration(r.api, r.middlewareClient, r.insecureCookies, r.log)
@@ -1681,23 +1845,35 @@ func (r *Builder) registerAuth() error {
 
 	cookieBasedAuth.Path("/csrf").Methods(http.MethodGet, http.MethodOptions).Handler(&authentication.CSRFTokenHandler{})
 
-	return r.registerCookieAuthHandlers(cookieBasedAuth, config.Cookie, config.Hooks)
+	return r.registerCookieAuthHandlers(cookieBasedAuth, config)
 }
 
-func (r *Builder) registerCookieAuthHandlers(router *mux.Router, cookie *securecookie.SecureCookie, authHooks authentication.Hooks) error {
+func (r *Builder) registerCookieAuthHandlers(router *mux.Router, config authentication.LoadUserConfig) error {
 
 	oidcProviders, err := r.configureOpenIDConnectProviders()
 	if err != nil {
 		return fmt.Errorf("error configuring OIDC providers: %w", err)
 	}
 
+	// SAML providers are added by configureCookieProvider, since they share the provider configuration
+	samlProviders := &authentication.SAMLProviderSet{}
+
 	router.Path("/user/logout").Methods(http.MethodGet, http.MethodOptions).Handler(&authentication.UserLogoutHandler{
 		InsecureCookies: r.insecureCookies,
 		OpenIDProviders: oidcProviders,
-		Hooks:           authHooks,
+		SAMLProviders:   samlProviders,
+		Hooks:           config.Hooks,
 		Log:             r.log,
 	})
 
+	oidcLogoutHandler := &authentication.OpenIDConnectLogoutHandler{
+		InsecureCookies: r.insecureCookies,
+		OpenIDProviders: oidcProviders,
+		Revocations:     config.SessionRevocations,
+		Log:             r.log,
+	}
+	oidcLogoutHandler.Register(router)
+
 	if r.api.AuthenticationConfig == nil || r.api.AuthenticationConfig.CookieBased == nil {
 		return nil
 	}
@@ -1714,7 +1890,7 @@ func (r *Builder) registerCookieAuthHandlers(router *mux.Router, cookie *securec
 	authTimeout := time.Second * time.Duration(timeoutSeconds)
 
 	for _, provider := range r.api.AuthenticationConfig.CookieBased.Providers {
-		r.configureCookieProvider(router, provider, cookie, authTimeout)
+		r.configureCookieProvider(router, provider, config.Cookie, authTimeout, samlProviders, config.TokenRefreshers)
 	}
 
 	return nil
@@ -1760,7 +1936,7 @@ func (r *Builder) configureOpenIDConnectProviders() (*authentication.OpenIDConne
 	return &providers, nil
 }
 
-func (r *Builder) configureCookieProvider(router *mux.Router, provider *wgpb.AuthProvider, cookie *securecookie.SecureCookie, authTimeout time.Duration) {
+func (r *Builder) configureCookieProvider(router *mux.Router, provider *wgpb.AuthProvider, cookie *securecookie.SecureCookie, authTimeout time.Duration, samlProviders *authentication.SAMLProviderSet, tokenRefreshers *authentication.TokenRefresherSet) {
 
 	authorizedRedirectUris := loadvariable.Strings(r.api.AuthenticationConfig.CookieBased.AuthorizedRedirectUris)
 	authorizedRedirectUriRegexes := loadvariable.Strings(r.api.AuthenticationConfig.CookieBased.AuthorizedRedirectUriRegexes)
@@ -1779,6 +1955,14 @@ func (r *Builder) configureCookieProvider(router *mux.Router, provider *wgpb.Aut
 		AuthTimeout:        authTimeout,
 	}
 
+	// role mappings are validated when loading the configuration
+	roleMapper, err := authentication.NewRoleMapper(authentication.RoleMappingsFromConfig(provider.RoleMappings))
+	if err != nil {
+		r.log.Error("invalid role mappings, ignoring them", zap.String("provider", provider.Id), zap.Error(err))
+	} else {
+		providerConfig.RoleMapper = roleMapper
+	}
+
 	switch provider.Kind {
 	case wgpb.AuthProviderKind_AuthProviderGithub:
 		if provider.GithubConfig == nil {
@@ -1800,6 +1984,9 @@ func (r *Builder) configureCookieProvider(router *mux.Router, provider *wgpb.Aut
 			ClientSecret: loadvariable.String(provider.GithubConfig.ClientSecret),
 		}, r.authenticationHooks(), r.log)
 		github.Register(authorizeRouter, callbackRouter)
+		if err := tokenRefreshers.Add(provider.Id, github); err != nil {
+			r.log.Error("registering github token refresher", zap.Error(err))
+		}
 		r.log.Debug("api.configureCookieProvider",
 			zap.String("provider", "github"),
 			zap.String("providerId", provider.Id),
@@ -1832,12 +2019,91 @@ func (r *Builder) configureCookieProvider(router *mux.Router, provider *wgpb.Aut
 			break
 		}
 		openID.Register(authorizeRouter, callbackRouter)
+		if err := tokenRefreshers.Add(provider.Id, openID); err != nil {
+			r.log.Error("registering OIDC token refresher", zap.Error(err))
+		}
 		r.log.Debug("api.configureCookieProvider",
 			zap.String("provider", "oidc"),
 			zap.String("providerId", provider.Id),
 			zap.String("issuer", loadvariable.String(provider.OidcConfig.Issuer)),
 			zap.String("clientID", loadvariable.String(provider.OidcConfig.ClientId)),
 		)
+	case wgpb.AuthProviderKind_AuthProviderOAuth2:
+		if provider.Oauth2Config == nil {
+			return
+		}
+
+		queryParameters := make([]authentication.QueryParameter, 0, len(provider.Oauth2Config.QueryParameters))
+		for _, p := range provider.Oauth2Config.QueryParameters {
+			queryParameters = append(queryParameters, authentication.QueryParameter{
+				Name:  loadvariable.String(p.Name),
+				Value: loadvariable.String(p.Value),
+			})
+		}
+
+		oauth2, err := authentication.NewGenericOAuth2CookieHandler(authentication.GenericOAuth2Config{
+			Provider:         providerConfig,
+			ClientID:         loadvariable.String(provider.Oauth2Config.ClientId),
+			ClientSecret:     loadvariable.String(provider.Oauth2Config.ClientSecret),
+			AuthorizationURL: loadvariable.String(provider.Oauth2Config.AuthorizationUrl),
+			TokenURL:         loadvariable.String(provider.Oauth2Config.TokenUrl),
+			UserInfoURL:      loadvariable.String(provider.Oauth2Config.UserInfoUrl),
+			Scopes:           provider.Oauth2Config.Scopes,
+			QueryParameters:  queryParameters,
+			ClaimMapping:     provider.Oauth2Config.ClaimMapping,
+		}, r.authenticationHooks(), r.log)
+		if err != nil {
+			r.log.Error("creating OAuth2 auth provider", zap.Error(err))
+			break
+		}
+		oauth2.Register(authorizeRouter, callbackRouter)
+		if err := tokenRefreshers.Add(provider.Id, oauth2); err != nil {
+			r.log.Error("registering OAuth2 token refresher", zap.Error(err))
+		}
+		r.log.Debug("api.configureCookieProvider",
+			zap.String("provider", "oauth2"),
+			zap.String("providerId", provider.Id),
+			zap.String("authorizationURL", loadvariable.String(provider.Oauth2Config.AuthorizationUrl)),
+			zap.String("clientID", loadvariable.String(provider.Oauth2Config.ClientId)),
+		)
+	case wgpb.AuthProviderKind_AuthProviderSAML:
+		if provider.SamlConfig == nil {
+			return
+		}
+
+		saml, err := authentication.NewSAMLCookieHandler(authentication.SAMLConfig{
+			Provider:          providerConfig,
+			BaseURL:           r.api.Options.PublicNodeUrl,
+			EntityID:          loadvariable.String(provider.SamlConfig.EntityId),
+			IDPMetadataURL:    loadvariable.String(provider.SamlConfig.IdpMetadataUrl),
+			IDPMetadataXML:    loadvariable.String(provider.SamlConfig.IdpMetadataXml),
+			Certificate:       loadvariable.String(provider.SamlConfig.Certificate),
+			PrivateKey:        loadvariable.String(provider.SamlConfig.PrivateKey),
+			SignRequests:      provider.SamlConfig.SignRequests,
+			AllowIDPInitiated: provider.SamlConfig.AllowIdpInitiated,
+			NameIDFormat:      loadvariable.String(provider.SamlConfig.NameIdFormat),
+			AttributeMapping:  provider.SamlConfig.AttributeMapping,
+			RolesAttribute:    provider.SamlConfig.RolesAttribute,
+			RedirectValidator: authentication.NewRedirectValidator(authorizedRedirectUris, authorizedRedirectUriRegexes),
+			HTTPClient: &http.Client{
+				Timeout: r.api.Options.DefaultTimeout,
+			},
+		}, r.authenticationHooks(), r.log)
+		if err != nil {
+			r.log.Error("creating SAML auth provider", zap.Error(err))
+			break
+		}
+		if err := samlProviders.Add(provider.Id, saml); err != nil {
+			r.log.Error("registering SAML auth provider", zap.Error(err))
+			break
+		}
+		saml.Register(authorizeRouter, callbackRouter)
+		saml.RegisterServiceProvider(router)
+		r.log.Debug("api.configureCookieProvider",
+			zap.String("provider", "saml"),
+			zap.String("providerId", provider.Id),
+			zap.String("idpMetadataURL", loadvariable.String(provider.SamlConfig.IdpMetadataUrl)),
+		)
 	default:
 		panic("unreachable")
 	}
@@ -2184,6 +2450,13 @@ func getHooksFlushWriter(ctx *resolve.Context, r *http.Request, w http.ResponseW
 		return nil, nil, false
 	}
 
+	if flushWriter.close == nil && pipeline != nil && pipeline.HandlesSubscriptionEvents() {
+		// The onSubscriptionEvent hooks can terminate the subscription
+		var cancellableCtx context.Context
+		cancellableCtx, flushWriter.close = context.WithCancel(ctx.Context())
+		ctx = ctx.WithContext(cancellableCtx)
+	}
+
 	flushWriter.resolveContext = ctx
 	flushWriter.request = r
 	flushWriter.hooksPipeline = pipeline
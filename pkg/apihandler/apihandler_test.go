@@ -519,6 +519,120 @@ func TestQueryHandler_Subscription(t *testing.T) {
 	assert.Equal(t, "{\"data\":{\"me\":{\"name\":\"Jens\",\"bio\":\"Founder & CEO of WunderGraph\",\"counter\":0}}}\n\n{\"data\":{\"me\":{\"name\":\"Jens\",\"bio\":\"Founder & CEO of WunderGraph\",\"counter\":1}}}\n\n{\"data\":{\"me\":{\"name\":\"Jens\",\"bio\":\"Founder & CEO of WunderGraph\",\"counter\":2}}}\n\n", string(data))
 }
 
+func TestQueryHandler_SubscriptionEventHooks(t *testing.T) {
+
+	interpolateNothing, err := interpolate.NewStringInterpolator(`{}`)
+	assert.NoError(t, err)
+
+	validateNothing, err := inputvariables.NewValidator(`{"type":"object","properties":{"id":{"type":"number"}}}`, true)
+	assert.NoError(t, err)
+
+	resolver := &FakeSubscriptionResolver{
+		resolve: func(ctx *resolve.Context, subscription *resolve.GraphQLSubscription, writer resolve.FlushWriter) (err error) {
+			for i := 0; i < 5; i++ {
+				if err := ctx.Context().Err(); err != nil {
+					return err
+				}
+				_, err = writer.Write([]byte(`{"data":{"me":{"name":"Jens","counter":` + strconv.Itoa(i) + `}}}`))
+				if err != nil {
+					return err
+				}
+				writer.Flush()
+			}
+			return nil
+		},
+	}
+
+	subscribe := func(hook http.HandlerFunc) string {
+		hooksServer := httptest.NewServer(hook)
+		defer hooksServer.Close()
+
+		operation := &wgpb.Operation{
+			Name:          "test",
+			OperationType: wgpb.OperationType_SUBSCRIPTION,
+			HooksConfiguration: &wgpb.OperationHooksConfiguration{
+				OnSubscriptionEvent: true,
+				SubscriptionEventExpressions: []*wgpb.ExpressionHook{
+					{Action: wgpb.ExpressionHookAction_ExpressionHookSkip, Condition: `event.data.me.counter == 1`},
+					{Action: wgpb.ExpressionHookAction_ExpressionHookSetEventField, Target: "data.me.name", Expression: `"Stefan"`},
+				},
+			},
+		}
+		expressions, err := hooks.NewOperationExpressions(operation)
+		assert.NoError(t, err)
+		hooksClient := hooks.NewClient(&hooks.ClientOptions{
+			ServerURL: hooksServer.URL,
+			Logger:    zap.NewNop(),
+		})
+		hooksPipeline := hooks.NewSubscriptionOperationPipeline(hooks.SubscriptionOperationPipelineConfig{
+			PipelineConfig: hooks.PipelineConfig{
+				Client:      hooksClient,
+				Operation:   operation,
+				Logger:      zap.NewNop(),
+				Expressions: expressions,
+			},
+			Resolver: resolver,
+			Plan:     &plan.SubscriptionResponsePlan{},
+		})
+		handler := &SubscriptionHandler{
+			resolver:               resolver,
+			log:                    zap.NewNop(),
+			preparedPlan:           &plan.SubscriptionResponsePlan{},
+			pool:                   pool.New(),
+			operation:              operation,
+			rbacEnforcer:           &authentication.RBACEnforcer{},
+			stringInterpolator:     interpolateNothing,
+			jsonStringInterpolator: interpolateNothing,
+			variablesValidator:     validateNothing,
+			postResolveTransformer: &postresolvetransform.Transformer{},
+			queryParamsAllowList:   []string{"id"},
+			hooksPipeline:          hooksPipeline,
+		}
+
+		srv := httptest.NewServer(handler)
+		defer srv.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		defer cancel()
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"?id=123", nil)
+		assert.NoError(t, err)
+
+		res, err := srv.Client().Do(req)
+		assert.NoError(t, err)
+		defer res.Body.Close()
+
+		data, err := io.ReadAll(res.Body)
+		assert.NoError(t, err)
+		return string(data)
+	}
+
+	data := subscribe(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/operation/test/onSubscriptionEvent", r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		switch {
+		case strings.Contains(string(body), `"counter":2`):
+			_, _ = w.Write([]byte(`{"response":{"event":{"data":{"rewritten":true}}}}`))
+		case strings.Contains(string(body), `"counter":3`):
+			_, _ = w.Write([]byte(`{"response":{"terminate":true}}`))
+		default:
+			_, _ = w.Write([]byte(`{"response":{}}`))
+		}
+	})
+	assert.Equal(t, "{\"data\":{\"me\":{\"name\":\"Stefan\",\"counter\":0}}}\n\n{\"data\":{\"rewritten\":true}}\n\n{\"data\":{\"me\":{\"name\":\"Stefan\",\"counter\":3}}}\n\n", data)
+
+	// Failing hooks terminate the subscription with an error
+	data = subscribe(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if strings.Contains(string(body), `"counter":2`) {
+			_, _ = w.Write([]byte(`invalid`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"response":{}}`))
+	})
+	assert.Equal(t, "{\"data\":{\"me\":{\"name\":\"Stefan\",\"counter\":0}}}\n\n{\"errors\":[{\"message\":\"onSubscriptionEvent hook failed\"}]}\n\n", data)
+}
+
 func TestFunctionsHandler_Default(t *testing.T) {
 
 	interpolateNothing, err := interpolate.NewStringInterpolator(`{}`)
@@ -70,6 +70,9 @@ type ApiTransport struct {
 	requestCounter             *outgoingRequestCounter
 	dataSourceID               string
 	hooks                      []hooks.Executor
+	onRequestMatchers          []hooks.Executor
+	onResponseMatchers         []hooks.Executor
+	expressions                *hooks.ExpressionHooks
 }
 
 func NewApiTransportFactory(opts ApiTransportOptions) engineconfigloader.ApiTransportFactory {
@@ -90,11 +93,16 @@ func NewApiTransport(httpTransport *http.Transport, roundTripperOpts engineconfi
 
 	api := transportOpts.API
 
-	var hookExecutors []hooks.Executor
+	var hookExecutors, onRequestMatchers, onResponseMatchers []hooks.Executor
 
 	for _, hook := range api.Hooks {
-		if hook.Type == wgpb.HookType_HTTP_TRANSPORT {
+		switch hook.Type {
+		case wgpb.HookType_HTTP_TRANSPORT:
 			hookExecutors = append(hookExecutors, hook.Executor())
+		case wgpb.HookType_HTTP_ORIGIN_REQUEST:
+			onRequestMatchers = append(onRequestMatchers, hook.Executor())
+		case wgpb.HookType_HTTP_ORIGIN_RESPONSE:
+			onResponseMatchers = append(onResponseMatchers, hook.Executor())
 		}
 	}
 
@@ -109,6 +117,9 @@ func NewApiTransport(httpTransport *http.Transport, roundTripperOpts engineconfi
 		requestCounter:             newOutgoingRequestCounter(transportOpts.Metrics),
 		dataSourceID:               roundTripperOpts.DataSourceID,
 		hooks:                      hookExecutors,
+		onRequestMatchers:          onRequestMatchers,
+		onResponseMatchers:         onResponseMatchers,
+		expressions:                api.OriginRequestExpressions[roundTripperOpts.DataSourceID],
 	}
 
 	if dataSourceConfigurations := api.EngineConfiguration.GetDatasourceConfigurations(); dataSourceConfigurations != nil {
@@ -184,7 +195,19 @@ func (t *ApiTransport) roundTrip(request *http.Request, buf *bytes.Buffer) (res
 		operationHooks = t.operationHooks[metaData.OperationName]
 	}
 
-	if operationHooks.OnRequest {
+	// Expressions run first, so the onOriginRequest hook and the request
+	// dump see the headers they set
+	if metaData != nil && t.expressions != nil {
+		if err := t.evaluateExpressions(request, metaData); err != nil {
+			var rejected *hooks.ExpressionRejectedError
+			if errors.As(err, &rejected) {
+				return rejectedResponse(request, rejected)
+			}
+			return nil, err
+		}
+	}
+
+	if operationHooks.OnRequest && matchesGlobalHook(t.onRequestMatchers, t.hookCheck(request, metaData)) {
 		request, err = t.handleOnRequestHook(request, metaData, buf)
 		if err != nil {
 			return nil, err
@@ -247,7 +270,7 @@ func (t *ApiTransport) roundTrip(request *http.Request, buf *bytes.Buffer) (res
 		)
 	}
 
-	if operationHooks.OnResponse {
+	if operationHooks.OnResponse && matchesGlobalHook(t.onResponseMatchers, t.hookCheck(request, metaData)) {
 		return t.handleOnResponseHook(res, metaData, buf)
 	}
 
@@ -461,12 +484,9 @@ func (t *ApiTransport) handleOnResponseHook(resp *http.Response, metaData *opera
 }
 
 func (t *ApiTransport) runHooks(r *http.Request, metaData *operation.Metadata, buf *bytes.Buffer) (*http.Response, error) {
-	check := hooks.HookCheck{
-		OperationType: metaData.OperationType,
-		DataSourceID:  t.dataSourceID,
-	}
+	check := t.hookCheck(r, metaData)
 	for _, hook := range t.hooks {
-		if hook.Matches(&check) {
+		if hook.Matches(check) {
 			var hookResponse hooks.OnResponseHookResponse
 			if err := t.sendRequestHook(r, metaData, buf, hooks.HttpTransportOnTransport, hook.HookID(), &hookResponse); err != nil {
 				return nil, err
@@ -477,6 +497,77 @@ func (t *ApiTransport) runHooks(r *http.Request, metaData *operation.Metadata, b
 	return nil, nil
 }
 
+// hookCheck returns the attributes of the request to the origin matched by
+// the hooks
+func (t *ApiTransport) hookCheck(r *http.Request, metaData *operation.Metadata) *hooks.HookCheck {
+	return &hooks.HookCheck{
+		OperationType: metaData.OperationType,
+		OperationName: metaData.OperationName,
+		DataSourceID:  t.dataSourceID,
+		Request:       r,
+		User:          authentication.UserFromContext(r.Context()),
+	}
+}
+
+// matchesGlobalHook returns whether a global hook runs for the request, which
+// is always the case unless it's restricted by matchers
+func matchesGlobalHook(matchers []hooks.Executor, check *hooks.HookCheck) bool {
+	if len(matchers) == 0 {
+		return true
+	}
+	for _, matcher := range matchers {
+		if matcher.Matches(check) {
+			return true
+		}
+	}
+	return false
+}
+
+// evaluateExpressions runs the onOriginRequest expression hooks of the
+// datasource, which modify the request headers in place
+func (t *ApiTransport) evaluateExpressions(r *http.Request, metaData *operation.Metadata) error {
+	var body []byte
+	if r.Body != nil {
+		var err error
+		body, err = io.ReadAll(r.Body)
+		if err != nil {
+			return err
+		}
+		r.Body = io.NopCloser(bytes.NewBuffer(body))
+	}
+	return t.expressions.Evaluate(r.Context(), &hooks.ExpressionInput{
+		OperationName: metaData.OperationName,
+		OperationType: metaData.OperationType.String(),
+		Headers:       r.Header,
+		User:          authentication.UserFromContext(r.Context()),
+		Body:          body,
+	})
+}
+
+// rejectedResponse answers a request rejected by an onOriginRequest expression
+// hook in place of the origin, so the status code and message reach the
+// client through the same path as errors returned by the origin
+func rejectedResponse(r *http.Request, rejected *hooks.ExpressionRejectedError) (*http.Response, error) {
+	body, err := json.Marshal(map[string]interface{}{
+		"errors": []map[string]string{{"message": rejected.Message}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &http.Response{
+		Status:        http.StatusText(rejected.StatusCode),
+		StatusCode:    rejected.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Close:         true,
+		Request:       r,
+	}, nil
+}
+
 func (t *ApiTransport) handleUpstreamAuthentication(request *http.Request, auth *wgpb.UpstreamAuthentication) error {
 
 	user := authentication.UserFromContext(request.Context())
@@ -0,0 +1,157 @@
+package apihandler
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/buger/jsonparser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/wundergraph/wundergraph/pkg/engineconfigloader"
+	"github.com/wundergraph/wundergraph/pkg/hooks"
+	"github.com/wundergraph/wundergraph/pkg/metrics"
+	"github.com/wundergraph/wundergraph/pkg/operation"
+	"github.com/wundergraph/wundergraph/pkg/wgpb"
+)
+
+func TestApiTransport_OriginHookMatchers(t *testing.T) {
+	origin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer origin.Close()
+
+	var mu sync.Mutex
+	var sent []string
+	hooksServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		sent = append(sent, r.URL.Path)
+		mu.Unlock()
+		_, _ = w.Write([]byte(`{"response":{"skip":true}}`))
+	}))
+	defer hooksServer.Close()
+
+	api := &Api{
+		Operations: []*wgpb.Operation{
+			{Name: "users/get", HooksConfiguration: &wgpb.OperationHooksConfiguration{HttpTransportOnRequest: true, HttpTransportOnResponse: true}},
+			{Name: "todos/get", HooksConfiguration: &wgpb.OperationHooksConfiguration{HttpTransportOnRequest: true, HttpTransportOnResponse: true}},
+		},
+		Hooks: []*hooks.Hook{
+			{Type: wgpb.HookType_HTTP_ORIGIN_REQUEST, Matcher: hooks.HookMatcher{OperationNames: []string{"users/*"}, Methods: []string{http.MethodPost}}},
+			{Type: wgpb.HookType_HTTP_ORIGIN_RESPONSE, Matcher: hooks.HookMatcher{Headers: []hooks.HeaderMatcher{{Name: "X-Trace"}}}},
+		},
+	}
+	transport := NewApiTransport(http.DefaultTransport.(*http.Transport).Clone(), engineconfigloader.ApiTransportFactoryRoundTripperOptions{}, ApiTransportOptions{
+		API:         api,
+		HooksClient: hooks.NewClient(&hooks.ClientOptions{ServerURL: hooksServer.URL, Logger: zap.NewNop()}),
+		Metrics:     metrics.NewNone(),
+	})
+
+	send := func(operationName string, method string, header http.Header) {
+		req, err := http.NewRequest(method, origin.URL, nil)
+		require.NoError(t, err)
+		if header != nil {
+			req.Header = header
+		}
+		req = operation.RequestWithMetadata(req, &operation.Metadata{OperationName: operationName, OperationType: operation.TypeQuery})
+		resp, err := transport.RoundTrip(req)
+		require.NoError(t, err)
+		_, _ = io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+	}
+
+	send("users/get", http.MethodPost, nil)
+	send("users/get", http.MethodGet, nil)
+	send("todos/get", http.MethodPost, http.Header{"X-Trace": []string{"1"}})
+
+	assert.Equal(t, []string{
+		"/global/httpTransport/onOriginRequest",
+		"/global/httpTransport/onOriginResponse",
+	}, sent)
+}
+
+func TestApiTransport_OriginRequestExpressionReject(t *testing.T) {
+	var called bool
+	origin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer origin.Close()
+
+	expressions, err := hooks.NewExpressionHooks([]*wgpb.ExpressionHook{
+		{
+			Action:     wgpb.ExpressionHookAction_ExpressionHookReject,
+			Condition:  `!("x-tenant" in headers)`,
+			Expression: `"missing tenant"`,
+			StatusCode: http.StatusBadRequest,
+		},
+	}, hooks.ExpressionStageOriginRequest)
+	require.NoError(t, err)
+
+	transport := NewApiTransport(http.DefaultTransport.(*http.Transport).Clone(), engineconfigloader.ApiTransportFactoryRoundTripperOptions{DataSourceID: "0"}, ApiTransportOptions{
+		API:     &Api{OriginRequestExpressions: map[string]*hooks.ExpressionHooks{"0": expressions}},
+		Metrics: metrics.NewNone(),
+	})
+
+	req, err := http.NewRequest(http.MethodPost, origin.URL, nil)
+	require.NoError(t, err)
+	req = operation.RequestWithMetadata(req, &operation.Metadata{OperationName: "todos/get", OperationType: operation.TypeQuery})
+	resp, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	assert.False(t, called)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.JSONEq(t, `{"errors":[{"message":"missing tenant"}]}`, string(body))
+}
+
+func TestApiTransport_OriginRequestExpressionsBeforeHook(t *testing.T) {
+	origin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer origin.Close()
+
+	var tenant string
+	hooksServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		tenant, _ = jsonparser.GetString(body, "request", "headers", "X-Tenant")
+		_, _ = w.Write([]byte(`{"response":{"skip":true}}`))
+	}))
+	defer hooksServer.Close()
+
+	expressions, err := hooks.NewExpressionHooks([]*wgpb.ExpressionHook{
+		{
+			Action:     wgpb.ExpressionHookAction_ExpressionHookSetHeader,
+			Target:     "X-Tenant",
+			Expression: `"acme"`,
+		},
+	}, hooks.ExpressionStageOriginRequest)
+	require.NoError(t, err)
+
+	transport := NewApiTransport(http.DefaultTransport.(*http.Transport).Clone(), engineconfigloader.ApiTransportFactoryRoundTripperOptions{DataSourceID: "0"}, ApiTransportOptions{
+		API: &Api{
+			Operations: []*wgpb.Operation{
+				{Name: "todos/get", HooksConfiguration: &wgpb.OperationHooksConfiguration{HttpTransportOnRequest: true}},
+			},
+			OriginRequestExpressions: map[string]*hooks.ExpressionHooks{"0": expressions},
+		},
+		HooksClient: hooks.NewClient(&hooks.ClientOptions{ServerURL: hooksServer.URL, Logger: zap.NewNop()}),
+		Metrics:     metrics.NewNone(),
+	})
+
+	req, err := http.NewRequest(http.MethodPost, origin.URL, nil)
+	require.NoError(t, err)
+	req = operation.RequestWithMetadata(req, &operation.Metadata{OperationName: "todos/get", OperationType: operation.TypeQuery})
+	resp, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+
+	// The onOriginRequest hook sees the headers set by the expressions
+	assert.Equal(t, "acme", tenant)
+}
@@ -56,11 +56,13 @@ func loadUserConfiguration(api *Api, client *hooks.Client, insecureCookies bool,
 	authHooks := authenticationHooks(api, client, log)
 
 	return authentication.LoadUserConfig{
-		Log:             log,
-		Cookie:          cookie,
-		InsecureCookies: insecureCookies,
-		CSRFSecret:      csrfSecret,
-		JwksProviders:   jwksProviders,
-		Hooks:           authHooks,
+		Log:                log,
+		Cookie:             cookie,
+		InsecureCookies:    insecureCookies,
+		CSRFSecret:         csrfSecret,
+		JwksProviders:      jwksProviders,
+		Hooks:              authHooks,
+		TokenRefreshers:    &authentication.TokenRefresherSet{},
+		SessionRevocations: authentication.NewSessionRevocations(),
 	}, nil
 }
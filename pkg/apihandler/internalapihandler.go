@@ -207,11 +207,17 @@ func (i *InternalBuilder) registerOperation(operation *wgpb.Operation) error {
 	shared.Postprocess.Process(preparedPlan)
 
 	postResolveTransformer := postresolvetransform.NewTransformer(operation.PostResolveTransformations)
+	expressions, err := hooks.NewOperationExpressions(operation)
+	if err != nil {
+		return fmt.Errorf("operation %s: %w", operation.Name, err)
+	}
+
 	hooksPipelineCommonConfig := hooks.PipelineConfig{
 		Client:      i.middlewareClient,
 		Operation:   operation,
 		Transformer: postResolveTransformer,
 		Logger:      i.log,
+		Expressions: expressions,
 	}
 
 	var handler http.Handler
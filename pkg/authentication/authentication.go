@@ -22,6 +22,7 @@ import (
 	"github.com/gorilla/csrf"
 	"github.com/gorilla/securecookie"
 	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
 
 	"github.com/wundergraph/wundergraph/pkg/customhttpclient"
 	"github.com/wundergraph/wundergraph/pkg/jsonpath"
@@ -30,8 +31,9 @@ import (
 )
 
 const (
-	userCookieName = "user"
-	idCookieName   = "id"
+	userCookieName   = "user"
+	idCookieName     = "id"
+	accessCookieName = "access"
 
 	authenticationCookieMaxAge = int((time.Hour * 24 * 365 * 10) / time.Second)
 )
@@ -50,6 +52,9 @@ type UserLoader struct {
 	client          *http.Client
 	userLoadConfigs []*UserLoadConfig
 	hooks           Hooks
+	tokenRefreshers *TokenRefresherSet
+	refreshGroup    singleflight.Group
+	revocations     *SessionRevocations
 }
 
 type UserLoadConfig struct {
@@ -57,6 +62,7 @@ type UserLoadConfig struct {
 	userInfoEndpoint string
 	cacheTtlSeconds  int
 	issuer           string
+	roleMapper       *RoleMapper
 }
 
 // Keyfunc returns a function for retrieving a token key from the
@@ -74,6 +80,12 @@ func (u *UserLoader) parseClaims(r io.Reader) (*Claims, error) {
 	if err != nil {
 		return nil, err
 	}
+	return decodeClaims(data)
+}
+
+// decodeClaims parses the JSON encoded claims in data, normalizing the
+// email_verified claim and keeping the raw claims around
+func decodeClaims(data []byte) (*Claims, error) {
 	// Deserialize twice to obtain raw claims
 	var claims Claims
 	if err := json.Unmarshal(data, &claims); err != nil {
@@ -156,6 +168,7 @@ func (u *UserLoader) userFromToken(token *jwt.Token, cfg *UserLoadConfig, user *
 	tempUser.ProviderName = "token"
 	tempUser.ProviderID = issuer
 	tempUser.AccessToken = tryParseJWT(token.Raw)
+	cfg.roleMapper.Apply(tempUser)
 	if err := u.hooks.PostAuthentication(ctx, tempUser); err != nil {
 		return err
 	}
@@ -217,6 +230,15 @@ type User struct {
 	IdToken        json.RawMessage `json:"idToken,omitempty"`
 	RefreshToken   string          `json:"refreshToken,omitempty"`
 	RawIDToken     string          `json:"rawIdToken,omitempty"`
+	// NameID, NameIDFormat and SessionIndex identify the user session at
+	// the SAML identity provider, required for single logout
+	NameID       string `json:"-"`
+	NameIDFormat string `json:"-"`
+	SessionIndex string `json:"-"`
+	// SessionID is the session identifier at the OpenID Connect provider (sid claim)
+	// and AuthenticatedAt the time the user logged in, used to revoke sessions
+	SessionID       string    `json:"-"`
+	AuthenticatedAt time.Time `json:"-"`
 }
 
 // ToPublic returns a copy of the User with fields non intended for public consumption erased. If publicClaims
@@ -302,6 +324,7 @@ func (u *User) HasExpired() bool {
 func (u *User) Save(s *securecookie.SecureCookie, w http.ResponseWriter, r *http.Request, insecureCookies bool) error {
 
 	rawIdToken := u.RawIDToken
+	rawAccessToken := u.RawAccessToken
 
 	// we remove these from the cookie to save space
 	u.IdToken = nil
@@ -355,6 +378,26 @@ func (u *User) Save(s *securecookie.SecureCookie, w http.ResponseWriter, r *http
 
 	http.SetCookie(w, cookie)
 
+	// The access token is stored separately, so it can be forwarded
+	// upstream and refreshed when it expires
+	encoded, err = s.Encode(accessCookieName, rawAccessToken)
+	if err != nil {
+		return err
+	}
+
+	cookie = &http.Cookie{
+		Name:     accessCookieName,
+		Value:    encoded,
+		Path:     "/",
+		Domain:   cookieDomain,
+		MaxAge:   authenticationCookieMaxAge,
+		Secure:   !insecureCookies,
+		HttpOnly: true,
+		SameSite: http.SameSiteStrictMode,
+	}
+
+	http.SetCookie(w, cookie)
+
 	return nil
 }
 
@@ -362,6 +405,18 @@ func (u *User) Load(loader *UserLoader, w http.ResponseWriter, r *http.Request)
 	if err := u.loadUser(loader, r); err != nil {
 		return err
 	}
+	if u.FromCookie && loader.revocations.IsRevoked(u) {
+		resetUserCookies(w, r, !loader.insecureCookies)
+		return errors.New("user session has been revoked")
+	}
+	if u.needsTokenRefresh() {
+		if err := loader.refreshUser(w, r, u); err != nil {
+			loader.log.Warn("refreshing access token", zap.String("provider", u.ProviderID), zap.Error(err))
+			if !u.ExpiresAt.After(time.Now()) {
+				return fmt.Errorf("access token expired and could not be refreshed: %w", err)
+			}
+		}
+	}
 	if u.HasExpired() {
 		loader.log.Debug("user has expired, revalidating")
 		revalidated, err := loader.hooks.RevalidateAuthentication(r.Context(), u)
@@ -436,7 +491,17 @@ func (u *User) loadUser(loader *UserLoader, r *http.Request) error {
 	}
 	err = loader.cookie.Decode(idCookieName, cookie.Value, &u.RawIDToken)
 	u.IdToken = tryParseJWT(u.RawIDToken)
-	return err
+	if err != nil {
+		return err
+	}
+	// Sessions created by older versions have no access token cookie
+	if cookie, err := r.Cookie(accessCookieName); err == nil {
+		if err := loader.cookie.Decode(accessCookieName, cookie.Value, &u.RawAccessToken); err != nil {
+			return err
+		}
+		u.AccessToken = tryParseJWT(u.RawAccessToken)
+	}
+	return nil
 }
 
 func tryParseJWT(token string) []byte {
@@ -669,6 +734,12 @@ type LoadUserConfig struct {
 	CSRFSecret      []byte
 	JwksProviders   []*wgpb.JwksAuthProvider
 	Hooks           Hooks
+	// TokenRefreshers are used to refresh expiring access tokens of
+	// cookie based users
+	TokenRefreshers *TokenRefresherSet
+	// SessionRevocations contains the cookie sessions terminated
+	// by their identity provider
+	SessionRevocations *SessionRevocations
 }
 
 func NewLoadUserMw(config LoadUserConfig) func(handler http.Handler) http.Handler {
@@ -679,6 +750,14 @@ func NewLoadUserMw(config LoadUserConfig) func(handler http.Handler) http.Handle
 
 	if config.JwksProviders != nil {
 		for _, provider := range config.JwksProviders {
+			// role mappings are validated when loading the configuration
+			roleMapper, err := NewRoleMapper(RoleMappingsFromConfig(provider.RoleMappings))
+			if err != nil {
+				config.Log.Error("invalid role mappings, ignoring them",
+					zap.String("jwksUrl", loadvariable.String(provider.JwksUrl)),
+					zap.Error(err),
+				)
+			}
 			userInfoEndpoint := loadvariable.String(provider.UserInfoEndpoint)
 			userInfoURL, err := url.Parse(userInfoEndpoint)
 			if err != nil {
@@ -706,6 +785,7 @@ func NewLoadUserMw(config LoadUserConfig) func(handler http.Handler) http.Handle
 					userInfoEndpoint: userInfoEndpoint,
 					cacheTtlSeconds:  int(provider.UserInfoCacheTtlSeconds),
 					issuer:           userInfoURL.Host,
+					roleMapper:       roleMapper,
 				})
 				continue
 			}
@@ -723,6 +803,7 @@ func NewLoadUserMw(config LoadUserConfig) func(handler http.Handler) http.Handle
 					userInfoEndpoint: userInfoEndpoint,
 					cacheTtlSeconds:  int(provider.UserInfoCacheTtlSeconds),
 					issuer:           userInfoURL.Host,
+					roleMapper:       roleMapper,
 				})
 				continue
 			}
@@ -731,6 +812,7 @@ func NewLoadUserMw(config LoadUserConfig) func(handler http.Handler) http.Handle
 				userInfoEndpoint: userInfoEndpoint,
 				cacheTtlSeconds:  int(provider.UserInfoCacheTtlSeconds),
 				issuer:           userInfoURL.Host,
+				roleMapper:       roleMapper,
 			})
 		}
 	}
@@ -756,7 +838,9 @@ func NewLoadUserMw(config LoadUserConfig) func(handler http.Handler) http.Handle
 		client: &http.Client{
 			Timeout: time.Second * 10,
 		},
-		hooks: config.Hooks,
+		hooks:           config.Hooks,
+		tokenRefreshers: config.TokenRefreshers,
+		revocations:     config.SessionRevocations,
 	}
 
 	return func(handler http.Handler) http.Handler {
@@ -847,6 +931,7 @@ func (*CSRFTokenHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 type UserLogoutHandler struct {
 	InsecureCookies bool
 	OpenIDProviders *OpenIDConnectProviderSet
+	SAMLProviders   *SAMLProviderSet
 	Hooks           Hooks
 	Log             *zap.Logger
 }
@@ -878,15 +963,25 @@ func (u *UserLogoutHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 const ForwardedQueryParamsKey = "forwarded_query_params"
 
 func (u *UserLogoutHandler) logoutFromProvider(w http.ResponseWriter, r *http.Request, user *User) error {
-	if user.ProviderName != "oidc" {
-		return fmt.Errorf("user provider %q is not OpenIDConnect", user.ProviderName)
-	}
 	if user.ProviderID == "" {
 		return errors.New("user has no provider ID")
 	}
-	provider, err := u.OpenIDProviders.ByID(user.ProviderID)
-	if err != nil {
-		return err
+	var disconnect func(ctx context.Context, user *User) (*OpenIDDisconnectResult, error)
+	switch user.ProviderName {
+	case "oidc":
+		provider, err := u.OpenIDProviders.ByID(user.ProviderID)
+		if err != nil {
+			return err
+		}
+		disconnect = provider.Disconnect
+	case "saml":
+		provider, err := u.SAMLProviders.ByID(user.ProviderID)
+		if err != nil {
+			return err
+		}
+		disconnect = provider.Disconnect
+	default:
+		return fmt.Errorf("user provider %q does not support logout", user.ProviderName)
 	}
 
 	params := r.URL.Query()
@@ -898,7 +993,7 @@ func (u *UserLogoutHandler) logoutFromProvider(w http.ResponseWriter, r *http.Re
 		ctx = context.WithValue(ctx, ForwardedQueryParamsKey, params)
 	}
 
-	result, err := provider.Disconnect(ctx, user)
+	result, err := disconnect(ctx, user)
 	if err != nil {
 		return err
 	}
@@ -976,7 +1071,7 @@ func RequiresAuthentication(handler http.Handler) http.Handler {
 }
 
 func resetUserCookies(w http.ResponseWriter, r *http.Request, secure bool) {
-	for _, name := range []string{"user", idCookieName} {
+	for _, name := range []string{"user", idCookieName, accessCookieName} {
 		userCookie := &http.Cookie{
 			Name:     name,
 			Value:    "",
@@ -995,7 +1090,7 @@ func postAuthenticationHooks(ctx context.Context, r *http.Request, hooks Hooks,
 	if err := hooks.PostAuthentication(ctx, user); err != nil {
 		return nil, err
 	}
-	user, err := hooks.MutatingPostAuthentication(r.Context(), user)
+	user, err := hooks.MutatingPostAuthentication(ctx, user)
 	if err != nil {
 		return nil, err
 	}
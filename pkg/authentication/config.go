@@ -19,6 +19,8 @@ type ProviderConfig struct {
 	ForceRedirectHttps bool
 	Cookie             *securecookie.SecureCookie
 	AuthTimeout        time.Duration
+	// RoleMapper assigns roles to the users authenticated with this provider
+	RoleMapper *RoleMapper
 }
 
 // RedirectProtocol returns the protocol that should be used for a redirect to
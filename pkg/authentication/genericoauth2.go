@@ -0,0 +1,171 @@
+package authentication
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+
+	"github.com/wundergraph/wundergraph/pkg/jsonpath"
+)
+
+// GenericOAuth2CookieHandler implements cookie based authentication against any
+// OAuth2 provider, without requiring OpenID Connect discovery. Users are retrieved
+// from the configured user info endpoint and its response is turned into claims
+// using GenericOAuth2Config.ClaimMapping.
+type GenericOAuth2CookieHandler struct {
+	auth2        *OAuth2AuthenticationHandler
+	userInfoURL  string
+	claimMapping map[string][]string
+	client       *http.Client
+}
+
+type GenericOAuth2Config struct {
+	Provider         ProviderConfig
+	ClientID         string
+	ClientSecret     string
+	AuthorizationURL string
+	TokenURL         string
+	UserInfoURL      string
+	Scopes           []string
+	QueryParameters  []QueryParameter
+	// ClaimMapping maps claim names (e.g. sub, email, picture) to dot separated
+	// paths into the object returned by UserInfoURL. Claims not present in the
+	// mapping are taken verbatim from the user info response.
+	ClaimMapping map[string]string
+}
+
+func NewGenericOAuth2CookieHandler(config GenericOAuth2Config, hooks Hooks, log *zap.Logger) (*GenericOAuth2CookieHandler, error) {
+	if config.AuthorizationURL == "" {
+		return nil, errors.New("OAuth2 authorization URL must not be empty")
+	}
+	if config.TokenURL == "" {
+		return nil, errors.New("OAuth2 token URL must not be empty")
+	}
+	if config.UserInfoURL == "" {
+		return nil, errors.New("OAuth2 user info URL must not be empty")
+	}
+	claimMapping := make(map[string][]string, len(config.ClaimMapping))
+	for claim, path := range config.ClaimMapping {
+		if path == "" {
+			return nil, fmt.Errorf("empty path for claim %q", claim)
+		}
+		claimMapping[claim] = strings.Split(path, ".")
+	}
+	handler := &GenericOAuth2CookieHandler{
+		userInfoURL:  config.UserInfoURL,
+		claimMapping: claimMapping,
+		client: &http.Client{
+			Timeout: time.Second * 5,
+		},
+	}
+	handler.auth2 = NewOAuth2AuthenticationHandler(OAuth2AuthenticationConfig{
+		Provider:     config.Provider,
+		ClientID:     config.ClientID,
+		ClientSecret: config.ClientSecret,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  config.AuthorizationURL,
+			TokenURL: config.TokenURL,
+		},
+		Scopes:          config.Scopes,
+		QueryParameters: config.QueryParameters,
+		Hooks:           hooks,
+		Log:             log,
+	}, handler)
+	return handler, nil
+}
+
+func (h *GenericOAuth2CookieHandler) Register(authorizeRouter, callbackRouter *mux.Router) {
+	authorizeRouter.Path(fmt.Sprintf("/%s", h.auth2.config.Provider.ID)).Methods(http.MethodGet).HandlerFunc(h.auth2.Authorize)
+	callbackRouter.Path(fmt.Sprintf("/%s", h.auth2.config.Provider.ID)).Methods(http.MethodGet).HandlerFunc(h.auth2.Callback)
+}
+
+func (h *GenericOAuth2CookieHandler) RefreshUser(ctx context.Context, user *User) (*User, error) {
+	return h.auth2.RefreshUser(ctx, user)
+}
+
+func (h *GenericOAuth2CookieHandler) User(ctx context.Context, log *zap.Logger, token *oauth2.Token) (*User, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, h.userInfoURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating OAuth2 user info request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token.AccessToken))
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving OAuth2 user info: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("invalid response code retrieving OAuth2 user info: %d", resp.StatusCode)
+	}
+
+	var userInfo map[string]interface{}
+	dec := json.NewDecoder(resp.Body)
+	dec.UseNumber()
+	if err := dec.Decode(&userInfo); err != nil {
+		return nil, fmt.Errorf("decoding OAuth2 user info: %w", err)
+	}
+
+	data, err := json.Marshal(h.mapClaims(userInfo))
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := decodeClaims(data)
+	if err != nil {
+		log.Error("decoding OAuth2 claims", zap.Error(err))
+		return nil, err
+	}
+
+	if claims.Subject == "" {
+		return nil, errors.New("OAuth2 user info has no subject, check the sub claim mapping")
+	}
+
+	var idToken string
+	accessToken := token.AccessToken
+	if maybeIdToken := token.Extra("id_token"); maybeIdToken != nil {
+		idToken, _ = maybeIdToken.(string)
+	}
+
+	user := claims.ToUser()
+	user.ProviderName = "oauth2"
+	user.ExpiresAt = token.Expiry
+	user.AccessToken = tryParseJWT(accessToken)
+	user.RawAccessToken = accessToken
+	user.IdToken = tryParseJWT(idToken)
+	user.RawIDToken = idToken
+	user.RefreshToken = token.RefreshToken
+
+	return user, nil
+}
+
+// mapClaims returns the claims for the given user info, applying the
+// claim mapping on top of the original values
+func (h *GenericOAuth2CookieHandler) mapClaims(userInfo map[string]interface{}) map[string]interface{} {
+	claims := make(map[string]interface{}, len(userInfo)+len(h.claimMapping))
+	for k, v := range userInfo {
+		claims[k] = v
+	}
+	for claim, keys := range h.claimMapping {
+		if value := jsonpath.GetKeys(userInfo, keys...); value != nil {
+			claims[claim] = value
+		}
+	}
+	// Well known claims are strings, but some providers use numeric identifiers
+	for k, v := range claims {
+		if n, ok := v.(json.Number); ok && !isCustomClaim(k) {
+			claims[k] = n.String()
+		}
+	}
+	return claims
+}
@@ -0,0 +1,191 @@
+package authentication
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/gorilla/securecookie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+)
+
+type noopHooks struct{}
+
+func (noopHooks) PostAuthentication(ctx context.Context, user *User) error { return nil }
+func (noopHooks) MutatingPostAuthentication(ctx context.Context, user *User) (*User, error) {
+	return user, nil
+}
+func (noopHooks) PostLogout(ctx context.Context, user *User) error { return nil }
+func (noopHooks) RevalidateAuthentication(ctx context.Context, user *User) (*User, error) {
+	return user, nil
+}
+
+func newTestOAuth2Provider(t *testing.T, userInfo map[string]interface{}) *httptest.Server {
+	challenges := make(map[string]string)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/authorize", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		assert.Equal(t, "S256", q.Get("code_challenge_method"))
+		code := "code-" + q.Get("state")
+		challenges[code] = q.Get("code_challenge")
+		redirect, _ := url.Parse(q.Get("redirect_uri"))
+		rq := redirect.Query()
+		rq.Set("code", code)
+		rq.Set("state", q.Get("state"))
+		redirect.RawQuery = rq.Encode()
+		http.Redirect(w, r, redirect.String(), http.StatusFound)
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		if r.Form.Get("grant_type") == "refresh_token" {
+			if r.Form.Get("refresh_token") != "refresh" {
+				http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"access_token":"access-2","refresh_token":"refresh-2","token_type":"bearer","expires_in":3600}`))
+			return
+		}
+		challenge, ok := challenges[r.Form.Get("code")]
+		if !ok || codeChallengeS256(r.Form.Get("code_verifier")) != challenge {
+			http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"access","refresh_token":"refresh","token_type":"bearer","expires_in":3600}`))
+	})
+	mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		if auth := r.Header.Get("Authorization"); auth != "Bearer access" && auth != "Bearer access-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(userInfo)
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestGenericOAuth2CookieHandler(t *testing.T) {
+	provider := newTestOAuth2Provider(t, map[string]interface{}{
+		"uuid":         "{7a1b}",
+		"account_id":   12345,
+		"display_name": "Jane Doe",
+		"links": map[string]interface{}{
+			"avatar": map[string]interface{}{
+				"href": "https://example.com/avatar.png",
+			},
+		},
+	})
+
+	cookie := securecookie.New(securecookie.GenerateRandomKey(32), securecookie.GenerateRandomKey(32))
+	handler, err := NewGenericOAuth2CookieHandler(GenericOAuth2Config{
+		Provider: ProviderConfig{
+			ID:              "bitbucket",
+			InsecureCookies: true,
+			Cookie:          cookie,
+			AuthTimeout:     time.Minute,
+		},
+		ClientID:         "client",
+		ClientSecret:     "secret",
+		AuthorizationURL: provider.URL + "/authorize",
+		TokenURL:         provider.URL + "/token",
+		UserInfoURL:      provider.URL + "/userinfo",
+		ClaimMapping: map[string]string{
+			"sub":     "uuid",
+			"name":    "display_name",
+			"picture": "links.avatar.href",
+		},
+	}, noopHooks{}, zap.NewNop())
+	require.NoError(t, err)
+
+	router := mux.NewRouter()
+	authorizeRouter := router.PathPrefix("/auth/cookie/" + AuthorizePath).Subrouter()
+	callbackRouter := router.PathPrefix("/auth/cookie/" + CallbackPath).Subrouter()
+	handler.Register(authorizeRouter, callbackRouter)
+	node := httptest.NewServer(router)
+	defer node.Close()
+
+	jar := newTestCookieJar()
+	client := &http.Client{Jar: jar}
+	resp, err := client.Get(node.URL + "/auth/cookie/authorize/bitbucket?redirect_uri=" + url.QueryEscape(node.URL+"/done"))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var user User
+	userCookie := jar.cookie(userCookieName)
+	require.NotNil(t, userCookie, "user cookie should be set")
+	require.NoError(t, cookie.Decode(userCookieName, userCookie.Value, &user))
+	assert.Equal(t, "oauth2", user.ProviderName)
+	assert.Equal(t, "bitbucket", user.ProviderID)
+	assert.Equal(t, "{7a1b}", user.UserID)
+	assert.Equal(t, "Jane Doe", user.Name)
+	assert.Equal(t, "https://example.com/avatar.png", user.Picture)
+	assert.Equal(t, "refresh", user.RefreshToken)
+	assert.Equal(t, float64(12345), user.CustomClaims["account_id"])
+}
+
+func TestGenericOAuth2CookieHandlerRequiresSubject(t *testing.T) {
+	provider := newTestOAuth2Provider(t, map[string]interface{}{
+		"id": 42,
+	})
+	handler, err := NewGenericOAuth2CookieHandler(GenericOAuth2Config{
+		AuthorizationURL: provider.URL + "/authorize",
+		TokenURL:         provider.URL + "/token",
+		UserInfoURL:      provider.URL + "/userinfo",
+	}, noopHooks{}, zap.NewNop())
+	require.NoError(t, err)
+	_, err = handler.User(context.Background(), zap.NewNop(), &oauth2.Token{AccessToken: "access"})
+	assert.Error(t, err)
+
+	handler.claimMapping = map[string][]string{"sub": {"id"}}
+	user, err := handler.User(context.Background(), zap.NewNop(), &oauth2.Token{AccessToken: "access"})
+	require.NoError(t, err)
+	assert.Equal(t, "42", user.UserID)
+}
+
+func TestCodeChallengeS256(t *testing.T) {
+	// Example from https://www.rfc-editor.org/rfc/rfc7636#appendix-B
+	assert.Equal(t, "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM", codeChallengeS256("dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"))
+
+	verifier, err := generateCodeVerifier()
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, len(verifier), 43)
+}
+
+// testCookieJar ignores cookie paths and domains, since the node sets cookies
+// for the host without port, which net/http/cookiejar would reject for 127.0.0.1
+type testCookieJar struct {
+	cookies map[string]*http.Cookie
+}
+
+func newTestCookieJar() *testCookieJar {
+	return &testCookieJar{cookies: make(map[string]*http.Cookie)}
+}
+
+func (j *testCookieJar) SetCookies(u *url.URL, cookies []*http.Cookie) {
+	for _, c := range cookies {
+		j.cookies[c.Name] = c
+	}
+}
+
+func (j *testCookieJar) Cookies(u *url.URL) []*http.Cookie {
+	cookies := make([]*http.Cookie, 0, len(j.cookies))
+	for _, c := range j.cookies {
+		cookies = append(cookies, c)
+	}
+	return cookies
+}
+
+func (j *testCookieJar) cookie(name string) *http.Cookie {
+	return j.cookies[name]
+}
@@ -15,12 +15,25 @@ import (
 	"golang.org/x/oauth2"
 )
 
+const githubAPIURL = "https://api.github.com"
+
 type GithubCookieHandler struct {
-	auth2 *OAuth2AuthenticationHandler
+	auth2  *OAuth2AuthenticationHandler
+	apiURL string
+	// fetchMemberships indicates whether the organizations and teams of the
+	// user are required by the role mappings
+	fetchMemberships bool
 }
 
 func NewGithubCookieHandler(config GithubConfig, hooks Hooks, log *zap.Logger) *GithubCookieHandler {
-	handler := &GithubCookieHandler{}
+	handler := &GithubCookieHandler{
+		apiURL:           githubAPIURL,
+		fetchMemberships: config.Provider.RoleMapper.usesGithub(),
+	}
+	scopes := []string{oidc.ScopeOpenID, "profile", "user:email"}
+	if handler.fetchMemberships {
+		scopes = append(scopes, "read:org")
+	}
 	handler.auth2 = NewOAuth2AuthenticationHandler(OAuth2AuthenticationConfig{
 		Provider:     config.Provider,
 		ClientID:     config.ClientID,
@@ -29,7 +42,7 @@ func NewGithubCookieHandler(config GithubConfig, hooks Hooks, log *zap.Logger) *
 			AuthURL:  "https://github.com/login/oauth/authorize",
 			TokenURL: "https://github.com/login/oauth/access_token",
 		},
-		Scopes: []string{oidc.ScopeOpenID, "profile", "user:email"},
+		Scopes: scopes,
 		Hooks:  hooks,
 		Log:    log,
 	}, handler)
@@ -65,8 +78,12 @@ func (h *GithubCookieHandler) Register(authorizeRouter, callbackRouter *mux.Rout
 	callbackRouter.Path(fmt.Sprintf("/%s", h.auth2.config.Provider.ID)).Methods(http.MethodGet).HandlerFunc(h.auth2.Callback)
 }
 
-func (*GithubCookieHandler) User(ctx context.Context, log *zap.Logger, token *oauth2.Token) (*User, error) {
-	profileReq, err := http.NewRequest(http.MethodGet, "https://api.github.com/user", nil)
+func (h *GithubCookieHandler) RefreshUser(ctx context.Context, user *User) (*User, error) {
+	return h.auth2.RefreshUser(ctx, user)
+}
+
+func (h *GithubCookieHandler) User(ctx context.Context, log *zap.Logger, token *oauth2.Token) (*User, error) {
+	profileReq, err := http.NewRequest(http.MethodGet, h.apiURL+"/user", nil)
 	if err != nil {
 		return nil, fmt.Errorf("creating GitHub user profile request: %w", err)
 	}
@@ -92,7 +109,7 @@ func (*GithubCookieHandler) User(ctx context.Context, log *zap.Logger, token *oa
 		return nil, fmt.Errorf("decoding GitHub user: %w", err)
 	}
 
-	emailsReq, err := http.NewRequest(http.MethodGet, "https://api.github.com/user/emails", nil)
+	emailsReq, err := http.NewRequest(http.MethodGet, h.apiURL+"/user/emails", nil)
 	if err != nil {
 		return nil, fmt.Errorf("creating GitHub user emails request: %w", err)
 	}
@@ -132,6 +149,14 @@ func (*GithubCookieHandler) User(ctx context.Context, log *zap.Logger, token *oa
 		idToken = maybeIdToken.(string)
 	}
 
+	var customClaims map[string]interface{}
+	if h.fetchMemberships {
+		customClaims, err = h.memberships(&client, token.AccessToken)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &User{
 		ProviderName:   "github",
 		Email:          email.Email,
@@ -147,5 +172,62 @@ func (*GithubCookieHandler) User(ctx context.Context, log *zap.Logger, token *oa
 		IdToken:        tryParseJWT(idToken),
 		RawIDToken:     idToken,
 		RefreshToken:   token.RefreshToken,
+		CustomClaims:   customClaims,
+	}, nil
+}
+
+type githubOrganization struct {
+	Login string `json:"login"`
+}
+
+type githubTeam struct {
+	Slug         string             `json:"slug"`
+	Organization githubOrganization `json:"organization"`
+}
+
+func (h *GithubCookieHandler) get(client *http.Client, accessToken string, path string, v interface{}) error {
+	req, err := http.NewRequest(http.MethodGet, h.apiURL+path, nil)
+	if err != nil {
+		return fmt.Errorf("creating GitHub %s request: %w", path, err)
+	}
+	req.Header.Set("Accept", "application/vnd.github.v3+json")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", accessToken))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("retrieving GitHub %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("invalid response code retrieving GitHub %s: %d", path, resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("decoding GitHub %s: %w", path, err)
+	}
+	return nil
+}
+
+// memberships returns the custom claims with the organizations and teams of the user
+func (h *GithubCookieHandler) memberships(client *http.Client, accessToken string) (map[string]interface{}, error) {
+	var organizations []githubOrganization
+	if err := h.get(client, accessToken, "/user/orgs?per_page=100", &organizations); err != nil {
+		return nil, err
+	}
+	var teams []githubTeam
+	if err := h.get(client, accessToken, "/user/teams?per_page=100", &teams); err != nil {
+		return nil, err
+	}
+	organizationLogins := make([]interface{}, len(organizations))
+	for i, org := range organizations {
+		organizationLogins[i] = org.Login
+	}
+	teamSlugs := make([]interface{}, len(teams))
+	for i, team := range teams {
+		teamSlugs[i] = team.Organization.Login + "/" + team.Slug
+	}
+	return map[string]interface{}{
+		githubOrganizationsClaim: organizationLogins,
+		githubTeamsClaim:         teamSlugs,
 	}, nil
 }
@@ -2,6 +2,7 @@ package authentication
 
 import (
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
 	"io"
 )
@@ -11,6 +12,8 @@ const (
 	AuthorizePath = "authorize"
 	// CallbackPath indicates the name for the path component used for callback handlers
 	CallbackPath = "callback"
+	// SAMLPath indicates the name for the path component used for SAML service provider handlers
+	SAMLPath = "saml"
 )
 
 func generateState() (string, error) {
@@ -20,3 +23,19 @@ func generateState() (string, error) {
 	}
 	return base64.RawURLEncoding.EncodeToString(b), nil
 }
+
+// generateCodeVerifier returns a random PKCE code verifier, as defined
+// in https://www.rfc-editor.org/rfc/rfc7636#section-4.1
+func generateCodeVerifier() (string, error) {
+	b := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// codeChallengeS256 returns the S256 PKCE code challenge for the given verifier
+func codeChallengeS256(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
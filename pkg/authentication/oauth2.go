@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"go.uber.org/zap"
 	"golang.org/x/oauth2"
@@ -36,6 +37,9 @@ const (
 	// provider (which will receive it as redirect_uri, NOT TO BE CONFUSED WITH OUT OWN
 	// redirect_Uri)
 	oauth2CallbackURICookieName = "callback_uri"
+	// oauth2CodeVerifierCookieName stores the PKCE code verifier, which is sent
+	// to the provider while exchanging the authorization code
+	oauth2CodeVerifierCookieName = "code_verifier"
 )
 
 type AuthError interface {
@@ -115,6 +119,15 @@ func (h *OAuth2AuthenticationHandler) Authorize(w http.ResponseWriter, r *http.R
 		return
 	}
 
+	codeVerifier, err := generateCodeVerifier()
+	if err != nil {
+		h.config.Log.Error("could not generate code verifier",
+			zap.Error(err),
+		)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
 	cookiePath := fmt.Sprintf("/auth/cookie/%s/%s", CallbackPath, h.config.Provider.ID)
 	cookieDomain := sanitizeDomain(r.Host)
 
@@ -135,16 +148,24 @@ func (h *OAuth2AuthenticationHandler) Authorize(w http.ResponseWriter, r *http.R
 	cookie.Value = callbackURI
 	http.SetCookie(w, cookie)
 
+	cookie.Name = oauth2CodeVerifierCookieName
+	cookie.Value = codeVerifier
+	http.SetCookie(w, cookie)
+
 	if redirectURI != "" {
 		cookie.Name = oauth2RedirectURICookieName
 		cookie.Value = redirectURI
 		http.SetCookie(w, cookie)
 	}
 
-	opts := make([]oauth2.AuthCodeOption, len(h.config.QueryParameters))
+	opts := make([]oauth2.AuthCodeOption, len(h.config.QueryParameters), len(h.config.QueryParameters)+2)
 	for i, p := range h.config.QueryParameters {
 		opts[i] = oauth2.SetAuthURLParam(p.Name, p.Value)
 	}
+	opts = append(opts,
+		oauth2.SetAuthURLParam("code_challenge", codeChallengeS256(codeVerifier)),
+		oauth2.SetAuthURLParam("code_challenge_method", "S256"),
+	)
 WithNext:
 	for k := range r.URL.Query() {
 		if k == "redirect_uri" {
@@ -179,18 +200,7 @@ func (h *OAuth2AuthenticationHandler) Callback(w http.ResponseWriter, r *http.Re
 	if err != nil {
 		// Error is already logged by authenticate()
 		if redirectURI != "" {
-			if redirURL, _ := url.Parse(redirectURI); redirURL != nil {
-				qs := redirURL.Query()
-				errorCode := string(authErrorCodeUnknown)
-				errorMessage := err.Error()
-				if authErr, ok := err.(AuthError); ok && authErr.ErrorCode() != "" {
-					errorCode = authErr.ErrorCode()
-				}
-				qs.Add("_wg.auth.error.code", errorCode)
-				qs.Add("_wg.auth.error.message", errorMessage)
-				redirURL.RawQuery = qs.Encode()
-				redirectURI = redirURL.String()
-			}
+			redirectURI = authErrorRedirectURI(redirectURI, err)
 			http.Redirect(w, r, redirectURI, http.StatusFound)
 		} else {
 			w.WriteHeader(http.StatusBadRequest)
@@ -205,6 +215,25 @@ func (h *OAuth2AuthenticationHandler) Callback(w http.ResponseWriter, r *http.Re
 	_, _ = fmt.Fprintf(w, "<html><head><script>window.location.replace('%s');</script></head></html>", redirectURI)
 }
 
+// authErrorRedirectURI returns redirectURI with the error code and message from
+// err added as query parameters, so the client can display them
+func authErrorRedirectURI(redirectURI string, err error) string {
+	redirURL, _ := url.Parse(redirectURI)
+	if redirURL == nil {
+		return redirectURI
+	}
+	qs := redirURL.Query()
+	errorCode := string(authErrorCodeUnknown)
+	errorMessage := err.Error()
+	if authErr, ok := err.(AuthError); ok && authErr.ErrorCode() != "" {
+		errorCode = authErr.ErrorCode()
+	}
+	qs.Add("_wg.auth.error.code", errorCode)
+	qs.Add("_wg.auth.error.message", errorMessage)
+	redirURL.RawQuery = qs.Encode()
+	return redirURL.String()
+}
+
 func (h *OAuth2AuthenticationHandler) authenticate(w http.ResponseWriter, r *http.Request, redirectURI string) (*User, error) {
 	errorCode := r.URL.Query().Get("error")
 	errorDescription := r.URL.Query().Get("error_description")
@@ -246,6 +275,17 @@ func (h *OAuth2AuthenticationHandler) authenticate(w http.ResponseWriter, r *htt
 		}
 	}
 
+	codeVerifierCookie, err := r.Cookie(oauth2CodeVerifierCookieName)
+	if err != nil {
+		h.config.Log.Warn("reading code_verifier cookie",
+			zap.Error(err),
+		)
+		return nil, &authError{
+			Code: authErrorCodeBadCookie,
+			Err:  fmt.Errorf("could not read code_verifier cookie: %w", err),
+		}
+	}
+
 	oauth2Config := oauth2.Config{
 		ClientID:     h.config.ClientID,
 		ClientSecret: h.config.ClientSecret,
@@ -254,7 +294,7 @@ func (h *OAuth2AuthenticationHandler) authenticate(w http.ResponseWriter, r *htt
 		Scopes:       h.config.Scopes,
 	}
 
-	oauth2Token, err := oauth2Config.Exchange(r.Context(), r.URL.Query().Get("code"))
+	oauth2Token, err := oauth2Config.Exchange(r.Context(), r.URL.Query().Get("code"), oauth2.SetAuthURLParam("code_verifier", codeVerifierCookie.Value))
 	if err != nil {
 		h.config.Log.Error("exchanging oauth2 token",
 			zap.Error(err),
@@ -277,6 +317,8 @@ func (h *OAuth2AuthenticationHandler) authenticate(w http.ResponseWriter, r *htt
 	}
 	// Fill in remaining fields
 	user.ProviderID = h.config.Provider.ID
+	user.AuthenticatedAt = time.Now()
+	h.config.Provider.RoleMapper.Apply(user)
 
 	user, err = postAuthenticationHooks(r.Context(), r, h.config.Hooks, user)
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 
 	"github.com/coreos/go-oidc/v3/oidc"
 	"github.com/gorilla/mux"
@@ -176,6 +177,10 @@ func (h *OpenIDConnectCookieHandler) Register(authorizeRouter, callbackRouter *m
 	callbackRouter.Path(fmt.Sprintf("/%s", h.auth2.config.Provider.ID)).Methods(http.MethodGet).HandlerFunc(h.auth2.Callback)
 }
 
+func (h *OpenIDConnectCookieHandler) RefreshUser(ctx context.Context, user *User) (*User, error) {
+	return h.auth2.RefreshUser(ctx, user)
+}
+
 func (h *OpenIDConnectCookieHandler) User(ctx context.Context, log *zap.Logger, token *oauth2.Token) (*User, error) {
 	var idToken string
 
@@ -212,10 +217,23 @@ func (h *OpenIDConnectCookieHandler) User(ctx context.Context, log *zap.Logger,
 	user.RawIDToken = idToken
 	user.IdToken = idTokenJSON
 	user.RefreshToken = token.RefreshToken
+	user.SessionID = idTokenSessionID(idTokenJSON)
 
 	return user, nil
 }
 
+// idTokenSessionID returns the sid claim from the given ID token payload, used
+// to identify the session in back-channel and front-channel logout
+func idTokenSessionID(payload []byte) string {
+	var claims struct {
+		SessionID string `json:"sid"`
+	}
+	if len(payload) == 0 || json.Unmarshal(payload, &claims) != nil {
+		return ""
+	}
+	return claims.SessionID
+}
+
 type openIDConnectConfiguration struct {
 	Issuer                string `json:"issuer"`
 	AuthorizationEndpoint string `json:"authorization_endpoint"`
@@ -293,6 +311,8 @@ type OpenIDConnectProvider struct {
 	clientSecret string
 	opts         *OpenIDConnectProviderOptions
 	config       *openIDConnectConfiguration
+	verifierOnce sync.Once
+	verifier     *oidc.IDTokenVerifier
 }
 
 func NewOpenIDConnectProvider(issuer string, clientID string, clientSecret string, opts *OpenIDConnectProviderOptions) (*OpenIDConnectProvider, error) {
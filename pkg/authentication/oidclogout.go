@@ -0,0 +1,320 @@
+package authentication
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+const (
+	// BackChannelLogoutPath indicates the name for the path component used for OIDC back-channel logout handlers
+	BackChannelLogoutPath = "backchannel-logout"
+	// FrontChannelLogoutPath indicates the name for the path component used for OIDC front-channel logout handlers
+	FrontChannelLogoutPath = "frontchannel-logout"
+
+	backChannelLogoutEvent = "http://schemas.openid.net/event/backchannel-logout"
+)
+
+const (
+	// sessionRevocationTTL is how long revocations are kept. Sessions without a
+	// refresh token aren't invalidated otherwise, so they're kept as long as the
+	// user cookies are valid.
+	sessionRevocationTTL = time.Duration(authenticationCookieMaxAge) * time.Second
+	// maxSessionRevocations limits the number of revoked sessions and subjects
+	// kept in memory, the oldest ones are forgotten first
+	maxSessionRevocations = 100000
+)
+
+// revocation is an entry in the expiration queue of SessionRevocations
+type revocation struct {
+	subject    bool
+	providerID string
+	key        string
+	expiresAt  time.Time
+}
+
+// SessionRevocations keeps track of the sessions that have been terminated at their
+// identity provider. Since sessions are stored in the user cookie, they can't be
+// deleted from the node and we must remember them instead. Revocations are kept
+// in memory, so they're neither persisted across restarts nor shared between nodes.
+// They expire after sessionRevocationTTL and at most maxSessionRevocations are kept.
+type SessionRevocations struct {
+	mu         sync.RWMutex
+	ttl        time.Duration
+	maxEntries int
+	now        func() time.Time
+	// sessions contains the expiration of the revoked session IDs, indexed
+	// by provider ID
+	sessions map[string]map[string]time.Time
+	// subjects contains the time a user was logged out from all its sessions,
+	// indexed by provider ID and subject
+	subjects map[string]map[string]subjectRevocation
+	// queue contains the revocations in the order they expire
+	queue []revocation
+}
+
+type subjectRevocation struct {
+	before    time.Time
+	expiresAt time.Time
+}
+
+func NewSessionRevocations() *SessionRevocations {
+	return &SessionRevocations{
+		ttl:        sessionRevocationTTL,
+		maxEntries: maxSessionRevocations,
+		now:        time.Now,
+		sessions:   make(map[string]map[string]time.Time),
+		subjects:   make(map[string]map[string]subjectRevocation),
+	}
+}
+
+// push adds a revocation to the queue, removing the expired ones and the oldest
+// ones if there are too many. It must be called with the lock held.
+func (s *SessionRevocations) push(r revocation) {
+	now := s.now()
+	drop := 0
+	for drop < len(s.queue) && (!s.queue[drop].expiresAt.After(now) || len(s.queue)-drop >= s.maxEntries) {
+		s.remove(s.queue[drop])
+		drop++
+	}
+	s.queue = append(s.queue[drop:], r)
+}
+
+// remove deletes the revocation unless it has been renewed since it was queued
+func (s *SessionRevocations) remove(r revocation) {
+	if r.subject {
+		if entry, found := s.subjects[r.providerID][r.key]; found && entry.expiresAt.Equal(r.expiresAt) {
+			delete(s.subjects[r.providerID], r.key)
+		}
+		return
+	}
+	if expiresAt, found := s.sessions[r.providerID][r.key]; found && expiresAt.Equal(r.expiresAt) {
+		delete(s.sessions[r.providerID], r.key)
+	}
+}
+
+// RevokeSession revokes the session with the given ID, as indicated by
+// the sid claim from the provider
+func (s *SessionRevocations) RevokeSession(providerID string, sessionID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sessions := s.sessions[providerID]
+	if sessions == nil {
+		sessions = make(map[string]time.Time)
+		s.sessions[providerID] = sessions
+	}
+	expiresAt := s.now().Add(s.ttl)
+	sessions[sessionID] = expiresAt
+	s.push(revocation{providerID: providerID, key: sessionID, expiresAt: expiresAt})
+}
+
+// RevokeSubject revokes all the sessions for the given subject started before
+func (s *SessionRevocations) RevokeSubject(providerID string, subject string, before time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	subjects := s.subjects[providerID]
+	if subjects == nil {
+		subjects = make(map[string]subjectRevocation)
+		s.subjects[providerID] = subjects
+	}
+	entry := subjects[subject]
+	if before.After(entry.before) {
+		entry.before = before
+	}
+	entry.expiresAt = s.now().Add(s.ttl)
+	subjects[subject] = entry
+	s.push(revocation{subject: true, providerID: providerID, key: subject, expiresAt: entry.expiresAt})
+}
+
+// IsRevoked returns true iff the session of the given user has been revoked
+func (s *SessionRevocations) IsRevoked(user *User) bool {
+	if s == nil || user.ProviderID == "" {
+		return false
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	now := s.now()
+	if user.SessionID != "" {
+		if expiresAt, found := s.sessions[user.ProviderID][user.SessionID]; found && expiresAt.After(now) {
+			return true
+		}
+	}
+	if entry, found := s.subjects[user.ProviderID][user.UserID]; found && entry.expiresAt.After(now) {
+		return user.AuthenticatedAt.Before(entry.before)
+	}
+	return false
+}
+
+// logoutTokenClaims contains the claims in a logout token, see
+// https://openid.net/specs/openid-connect-backchannel-1_0.html#LogoutToken
+type logoutTokenClaims struct {
+	Subject   string                     `json:"sub"`
+	SessionID string                     `json:"sid"`
+	JTI       string                     `json:"jti"`
+	Events    map[string]json.RawMessage `json:"events"`
+	Nonce     *string                    `json:"nonce"`
+}
+
+// verifyLogoutToken validates a logout token sent by the provider via back-channel
+// logout, returning its claims and expiration time
+func (p *OpenIDConnectProvider) verifyLogoutToken(ctx context.Context, rawToken string) (*logoutTokenClaims, time.Time, error) {
+	p.verifierOnce.Do(func() {
+		if p.config.JwksUri == "" {
+			return
+		}
+		keySet := oidc.NewRemoteKeySet(oidc.ClientContext(context.Background(), p.opts.httpClient()), p.config.JwksUri)
+		p.verifier = oidc.NewVerifier(p.config.Issuer, keySet, &oidc.Config{
+			ClientID: p.clientID,
+		})
+	})
+	if p.verifier == nil {
+		return nil, time.Time{}, errors.New("provider has no jwks_uri, logout tokens can't be verified")
+	}
+	token, err := p.verifier.Verify(ctx, rawToken)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	var claims logoutTokenClaims
+	if err := token.Claims(&claims); err != nil {
+		return nil, time.Time{}, err
+	}
+	if _, found := claims.Events[backChannelLogoutEvent]; !found {
+		return nil, time.Time{}, fmt.Errorf("logout token has no %s event", backChannelLogoutEvent)
+	}
+	if claims.Subject == "" && claims.SessionID == "" {
+		return nil, time.Time{}, errors.New("logout token has neither sub nor sid")
+	}
+	if claims.Nonce != nil {
+		// Prevents ID tokens from being used as logout tokens
+		return nil, time.Time{}, errors.New("logout token must not contain a nonce")
+	}
+	return &claims, token.Expiry, nil
+}
+
+// OpenIDConnectLogoutHandler implements OIDC back-channel and front-channel logout
+// for all the configured OpenID Connect providers, revoking the matching sessions
+type OpenIDConnectLogoutHandler struct {
+	InsecureCookies bool
+	OpenIDProviders *OpenIDConnectProviderSet
+	Revocations     *SessionRevocations
+	Log             *zap.Logger
+
+	mu sync.Mutex
+	// usedTokens contains the jti of already processed logout tokens,
+	// with their expiration, to prevent replays
+	usedTokens map[string]time.Time
+}
+
+// Register adds the logout handlers to the given router, which must be mounted at /auth/cookie
+func (h *OpenIDConnectLogoutHandler) Register(router *mux.Router) {
+	router.Path(fmt.Sprintf("/%s/{provider}", BackChannelLogoutPath)).Methods(http.MethodPost).HandlerFunc(h.BackChannelLogout)
+	router.Path(fmt.Sprintf("/%s/{provider}", FrontChannelLogoutPath)).Methods(http.MethodGet).HandlerFunc(h.FrontChannelLogout)
+}
+
+func (h *OpenIDConnectLogoutHandler) backChannelError(w http.ResponseWriter, description string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(w).Encode(map[string]string{
+		"error":             "invalid_request",
+		"error_description": description,
+	})
+}
+
+// markTokenUsed returns false if the token with the given jti has been already used
+func (h *OpenIDConnectLogoutHandler) markTokenUsed(jti string, expiresAt time.Time) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	now := time.Now()
+	if h.usedTokens == nil {
+		h.usedTokens = make(map[string]time.Time)
+	}
+	for k, exp := range h.usedTokens {
+		if exp.Before(now) {
+			delete(h.usedTokens, k)
+		}
+	}
+	if _, found := h.usedTokens[jti]; found {
+		return false
+	}
+	h.usedTokens[jti] = expiresAt
+	return true
+}
+
+func (h *OpenIDConnectLogoutHandler) BackChannelLogout(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", "no-store")
+	providerID := mux.Vars(r)["provider"]
+	provider, err := h.OpenIDProviders.ByID(providerID)
+	if err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		h.backChannelError(w, "invalid form")
+		return
+	}
+	rawToken := r.PostForm.Get("logout_token")
+	if rawToken == "" {
+		h.backChannelError(w, "missing logout_token")
+		return
+	}
+	claims, expiresAt, err := provider.verifyLogoutToken(r.Context(), rawToken)
+	if err != nil {
+		h.Log.Warn("invalid logout token", zap.String("provider", providerID), zap.Error(err))
+		h.backChannelError(w, "invalid logout_token")
+		return
+	}
+	if claims.JTI != "" && !h.markTokenUsed(claims.JTI, expiresAt) {
+		h.backChannelError(w, "logout_token has already been used")
+		return
+	}
+	if claims.SessionID != "" {
+		h.Revocations.RevokeSession(providerID, claims.SessionID)
+	} else {
+		h.Revocations.RevokeSubject(providerID, claims.Subject, time.Now())
+	}
+	h.Log.Debug("back-channel logout",
+		zap.String("provider", providerID),
+		zap.String("sub", claims.Subject),
+		zap.String("sid", claims.SessionID),
+	)
+	w.WriteHeader(http.StatusOK)
+}
+
+// FrontChannelLogout is loaded by the provider in an iframe. The cookies aren't sent
+// with this cross-site request, so the session is revoked using the sid and iss
+// parameters sent by the provider, as in back-channel logout.
+func (h *OpenIDConnectLogoutHandler) FrontChannelLogout(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", "no-cache, no-store")
+	w.Header().Set("Pragma", "no-cache")
+	providerID := mux.Vars(r)["provider"]
+	provider, err := h.OpenIDProviders.ByID(providerID)
+	if err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	query := r.URL.Query()
+	iss, sid := query.Get("iss"), query.Get("sid")
+	if sid == "" {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	if iss != provider.config.Issuer {
+		h.Log.Warn("front-channel logout issuer mismatch", zap.String("provider", providerID), zap.String("iss", iss))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	h.Revocations.RevokeSession(providerID, sid)
+	h.Log.Debug("front-channel logout",
+		zap.String("provider", providerID),
+		zap.String("sid", sid),
+	)
+	w.WriteHeader(http.StatusOK)
+}
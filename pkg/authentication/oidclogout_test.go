@@ -0,0 +1,248 @@
+package authentication
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/gorilla/mux"
+	"github.com/gorilla/securecookie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type testOIDCIssuer struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+}
+
+func newTestOIDCIssuer(t *testing.T) *testOIDCIssuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	issuer := &testOIDCIssuer{key: key}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 issuer.server.URL,
+			"authorization_endpoint": issuer.server.URL + "/authorize",
+			"token_endpoint":         issuer.server.URL + "/token",
+			"userinfo_endpoint":      issuer.server.URL + "/userinfo",
+			"jwks_uri":               issuer.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{
+				{
+					"kty": "RSA",
+					"kid": "test",
+					"alg": "RS256",
+					"use": "sig",
+					"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+					"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+				},
+			},
+		})
+	})
+	issuer.server = httptest.NewServer(mux)
+	t.Cleanup(issuer.server.Close)
+	return issuer
+}
+
+func (i *testOIDCIssuer) logoutToken(t *testing.T, claims jwt.MapClaims) string {
+	defaults := jwt.MapClaims{
+		"iss":    i.server.URL,
+		"aud":    "client",
+		"iat":    time.Now().Unix(),
+		"exp":    time.Now().Add(time.Minute).Unix(),
+		"events": map[string]interface{}{backChannelLogoutEvent: map[string]interface{}{}},
+	}
+	for k, v := range claims {
+		if v == nil {
+			delete(defaults, k)
+		} else {
+			defaults[k] = v
+		}
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, defaults)
+	token.Header["kid"] = "test"
+	signed, err := token.SignedString(i.key)
+	require.NoError(t, err)
+	return signed
+}
+
+func newTestOIDCLogoutHandler(t *testing.T, issuer *testOIDCIssuer) (*OpenIDConnectLogoutHandler, *httptest.Server) {
+	provider, err := NewOpenIDConnectProvider(issuer.server.URL, "client", "secret", nil)
+	require.NoError(t, err)
+	var providers OpenIDConnectProviderSet
+	require.NoError(t, providers.Add("oidc", provider))
+
+	handler := &OpenIDConnectLogoutHandler{
+		InsecureCookies: true,
+		OpenIDProviders: &providers,
+		Revocations:     NewSessionRevocations(),
+		Log:             zap.NewNop(),
+	}
+	router := mux.NewRouter()
+	handler.Register(router.PathPrefix("/auth/cookie").Subrouter())
+	node := httptest.NewServer(router)
+	t.Cleanup(node.Close)
+	return handler, node
+}
+
+func postLogoutToken(t *testing.T, node *httptest.Server, token string) int {
+	resp, err := http.PostForm(node.URL+"/auth/cookie/backchannel-logout/oidc", url.Values{"logout_token": {token}})
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	return resp.StatusCode
+}
+
+func TestOIDCBackChannelLogout(t *testing.T) {
+	issuer := newTestOIDCIssuer(t)
+	handler, node := newTestOIDCLogoutHandler(t, issuer)
+
+	session := &User{ProviderID: "oidc", UserID: "jane", SessionID: "s1", AuthenticatedAt: time.Now()}
+	otherSession := &User{ProviderID: "oidc", UserID: "jane", SessionID: "s2", AuthenticatedAt: time.Now()}
+
+	token := issuer.logoutToken(t, jwt.MapClaims{"sid": "s1", "jti": "1"})
+	assert.Equal(t, http.StatusOK, postLogoutToken(t, node, token))
+	assert.True(t, handler.Revocations.IsRevoked(session))
+	assert.False(t, handler.Revocations.IsRevoked(otherSession))
+
+	// Replays are rejected
+	assert.Equal(t, http.StatusBadRequest, postLogoutToken(t, node, token))
+
+	// Logging out the subject revokes all the sessions started before
+	assert.Equal(t, http.StatusOK, postLogoutToken(t, node, issuer.logoutToken(t, jwt.MapClaims{"sub": "jane", "jti": "2"})))
+	assert.True(t, handler.Revocations.IsRevoked(otherSession))
+	assert.False(t, handler.Revocations.IsRevoked(&User{ProviderID: "oidc", UserID: "jane", AuthenticatedAt: time.Now().Add(time.Second)}))
+	assert.False(t, handler.Revocations.IsRevoked(&User{ProviderID: "oidc", UserID: "john"}))
+}
+
+func TestOIDCBackChannelLogoutInvalidTokens(t *testing.T) {
+	issuer := newTestOIDCIssuer(t)
+	_, node := newTestOIDCLogoutHandler(t, issuer)
+
+	testCases := []struct {
+		name   string
+		claims jwt.MapClaims
+	}{
+		{"no event", jwt.MapClaims{"sid": "s1", "events": nil}},
+		{"no sub nor sid", jwt.MapClaims{}},
+		{"nonce", jwt.MapClaims{"sid": "s1", "nonce": "n"}},
+		{"wrong audience", jwt.MapClaims{"sid": "s1", "aud": "other"}},
+		{"expired", jwt.MapClaims{"sid": "s1", "exp": time.Now().Add(-time.Minute).Unix()}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, http.StatusBadRequest, postLogoutToken(t, node, issuer.logoutToken(t, tc.claims)))
+		})
+	}
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	forged := &testOIDCIssuer{server: issuer.server, key: otherKey}
+	assert.Equal(t, http.StatusBadRequest, postLogoutToken(t, node, forged.logoutToken(t, jwt.MapClaims{"sid": "s1"})))
+}
+
+func TestOIDCFrontChannelLogout(t *testing.T) {
+	issuer := newTestOIDCIssuer(t)
+	handler, node := newTestOIDCLogoutHandler(t, issuer)
+
+	// Browsers don't send the SameSite=Strict user cookies from the provider's
+	// iframe, so the request carries no session
+	frontChannelLogout := func(iss string, sid string) *http.Response {
+		resp, err := http.Get(node.URL + "/auth/cookie/frontchannel-logout/oidc?iss=" + url.QueryEscape(iss) + "&sid=" + sid)
+		require.NoError(t, err)
+		return resp
+	}
+
+	resp := frontChannelLogout(issuer.server.URL, "s1")
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.True(t, strings.Contains(resp.Header.Get("Cache-Control"), "no-store"))
+	assert.True(t, handler.Revocations.IsRevoked(&User{ProviderID: "oidc", SessionID: "s1"}))
+	assert.False(t, handler.Revocations.IsRevoked(&User{ProviderID: "oidc", SessionID: "s2"}))
+
+	resp = frontChannelLogout("https://evil.example.com", "s2")
+	resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.False(t, handler.Revocations.IsRevoked(&User{ProviderID: "oidc", SessionID: "s2"}))
+}
+
+func TestSessionRevocationsExpiration(t *testing.T) {
+	now := time.Now()
+	revocations := NewSessionRevocations()
+	revocations.maxEntries = 2
+	revocations.now = func() time.Time { return now }
+
+	revocations.RevokeSession("oidc", "s1")
+	revocations.RevokeSubject("oidc", "jane", now)
+	assert.True(t, revocations.IsRevoked(&User{ProviderID: "oidc", SessionID: "s1"}))
+	assert.True(t, revocations.IsRevoked(&User{ProviderID: "oidc", UserID: "jane", AuthenticatedAt: now.Add(-time.Second)}))
+
+	// The oldest revocation is forgotten once the limit is reached
+	revocations.RevokeSession("oidc", "s2")
+	assert.False(t, revocations.IsRevoked(&User{ProviderID: "oidc", SessionID: "s1"}))
+	assert.True(t, revocations.IsRevoked(&User{ProviderID: "oidc", SessionID: "s2"}))
+	assert.Len(t, revocations.queue, 2)
+
+	now = now.Add(sessionRevocationTTL)
+	assert.False(t, revocations.IsRevoked(&User{ProviderID: "oidc", SessionID: "s2"}))
+	assert.False(t, revocations.IsRevoked(&User{ProviderID: "oidc", UserID: "jane", AuthenticatedAt: now.Add(-sessionRevocationTTL - time.Second)}))
+	revocations.RevokeSession("oidc", "s3")
+	assert.Len(t, revocations.queue, 1)
+	assert.Empty(t, revocations.subjects["oidc"])
+	assert.Len(t, revocations.sessions["oidc"], 1)
+}
+
+func TestUserLoadRevokedSession(t *testing.T) {
+	cookie := securecookie.New(securecookie.GenerateRandomKey(32), securecookie.GenerateRandomKey(32))
+	revocations := NewSessionRevocations()
+	mw := NewLoadUserMw(LoadUserConfig{
+		Log:                zap.NewNop(),
+		Cookie:             cookie,
+		InsecureCookies:    true,
+		Hooks:              noopHooks{},
+		SessionRevocations: revocations,
+	})
+	cookies := savedUserCookies(t, cookie, &User{ProviderID: "oidc", UserID: "jane", SessionID: "s1"})
+
+	load := func() (*User, *http.Response) {
+		var loaded *User
+		handler := mw(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			loaded = UserFromContext(r.Context())
+		}))
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		for _, c := range cookies {
+			req.AddCookie(c)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return loaded, rec.Result()
+	}
+
+	loaded, _ := load()
+	require.NotNil(t, loaded)
+
+	revocations.RevokeSession("oidc", "s1")
+	loaded, resp := load()
+	assert.Nil(t, loaded)
+	var deleted bool
+	for _, c := range resp.Cookies() {
+		if c.Name == userCookieName && c.MaxAge < 0 {
+			deleted = true
+		}
+	}
+	assert.True(t, deleted, "user cookie should be deleted")
+}
@@ -0,0 +1,133 @@
+package authentication
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+
+	"github.com/wundergraph/wundergraph/internal/detached"
+)
+
+const (
+	// accessTokenRefreshWindow indicates how long before their expiration
+	// access tokens are refreshed
+	accessTokenRefreshWindow = time.Minute
+	// tokenRefreshTimeout limits how long a refresh shared by concurrent
+	// requests can take
+	tokenRefreshTimeout = 30 * time.Second
+)
+
+// TokenRefresher is implemented by cookie providers that can obtain a new
+// access token for a user using its refresh token
+type TokenRefresher interface {
+	RefreshUser(ctx context.Context, user *User) (*User, error)
+}
+
+type TokenRefresherSet struct {
+	refreshers map[string]TokenRefresher
+}
+
+func (s *TokenRefresherSet) Add(id string, r TokenRefresher) error {
+	if s.refreshers == nil {
+		s.refreshers = make(map[string]TokenRefresher)
+	}
+
+	if s.refreshers[id] != nil {
+		return fmt.Errorf("duplicate token refresher for provider ID %q", id)
+	}
+	s.refreshers[id] = r
+	return nil
+}
+
+func (s *TokenRefresherSet) ByID(id string) (TokenRefresher, error) {
+	var refresher TokenRefresher
+	if s != nil {
+		refresher = s.refreshers[id]
+	}
+	if refresher == nil {
+		return nil, fmt.Errorf("authentication provider with ID %q does not support refreshing tokens", id)
+	}
+	return refresher, nil
+}
+
+// RefreshUser exchanges the refresh token of the user for a new access token
+// and retrieves the user again using it
+func (h *OAuth2AuthenticationHandler) RefreshUser(ctx context.Context, user *User) (*User, error) {
+	oauth2Config := oauth2.Config{
+		ClientID:     h.config.ClientID,
+		ClientSecret: h.config.ClientSecret,
+		Endpoint:     h.config.Endpoint,
+		Scopes:       h.config.Scopes,
+	}
+	token, err := oauth2Config.TokenSource(ctx, &oauth2.Token{RefreshToken: user.RefreshToken}).Token()
+	if err != nil {
+		return nil, fmt.Errorf("could not refresh token: %w", err)
+	}
+	refreshed, err := h.retriever.User(ctx, h.config.Log, token)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve user: %w", err)
+	}
+	refreshed.ProviderID = h.config.Provider.ID
+	refreshed.AuthenticatedAt = user.AuthenticatedAt
+	h.config.Provider.RoleMapper.Apply(refreshed)
+	if refreshed.SessionID == "" {
+		refreshed.SessionID = user.SessionID
+	}
+	// Providers are not required to issue a new ID token when refreshing
+	if refreshed.RawIDToken == "" {
+		refreshed.RawIDToken = user.RawIDToken
+		refreshed.IdToken = user.IdToken
+	}
+	return refreshed, nil
+}
+
+// needsTokenRefresh returns true iff the user was loaded from the cookie and
+// its access token is about to expire, but can be refreshed
+func (u *User) needsTokenRefresh() bool {
+	return u.FromCookie && u.RefreshToken != "" && !u.ExpiresAt.IsZero() && time.Until(u.ExpiresAt) < accessTokenRefreshWindow
+}
+
+// refreshUser replaces user with a refreshed version, running the post authentication
+// hooks again and saving the updated session. Concurrent requests from the same
+// session share the refresh, since some providers allow using a refresh token just once.
+func (u *UserLoader) refreshUser(w http.ResponseWriter, r *http.Request, user *User) error {
+	refresher, err := u.tokenRefreshers.ByID(user.ProviderID)
+	if err != nil {
+		return err
+	}
+	key := user.ProviderID + "\x00" + user.RefreshToken
+	v, err, _ := u.refreshGroup.Do(key, func() (interface{}, error) {
+		// The refresh is shared with concurrent requests and it might use up the
+		// refresh token, so it must not fail if this client disconnects
+		ctx, cancel := detached.WithTimeout(r.Context(), tokenRefreshTimeout)
+		defer cancel()
+		refreshed, err := refresher.RefreshUser(ctx, user)
+		if err != nil {
+			return nil, err
+		}
+		return postAuthenticationHooks(ctx, r, u.hooks, refreshed)
+	})
+	if err != nil {
+		return err
+	}
+	// The result is shared with concurrent requests, make a copy
+	refreshed := *(v.(*User))
+	// Save() removes the tokens from the user, but we want to
+	// keep them around while serving this request
+	saved := refreshed
+	if err := saved.Save(u.cookie, w, r, u.insecureCookies); err != nil {
+		return err
+	}
+	refreshed.ETag = saved.ETag
+	refreshed.FromCookie = true
+	*user = refreshed
+	u.log.Debug("refreshed access token",
+		zap.String("provider", user.ProviderID),
+		zap.Time("expiresAt", user.ExpiresAt),
+	)
+	return nil
+}
@@ -0,0 +1,167 @@
+package authentication
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/securecookie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type countingHooks struct {
+	noopHooks
+	postAuthentication int
+}
+
+func (h *countingHooks) PostAuthentication(ctx context.Context, user *User) error {
+	h.postAuthentication++
+	return nil
+}
+
+func newTestRefreshEnvironment(t *testing.T, hooks Hooks) (*securecookie.SecureCookie, func(handler http.Handler) http.Handler) {
+	provider := newTestOAuth2Provider(t, map[string]interface{}{
+		"sub":  "1",
+		"name": "Jane",
+	})
+	handler, err := NewGenericOAuth2CookieHandler(GenericOAuth2Config{
+		Provider: ProviderConfig{
+			ID: "oauth2",
+		},
+		AuthorizationURL: provider.URL + "/authorize",
+		TokenURL:         provider.URL + "/token",
+		UserInfoURL:      provider.URL + "/userinfo",
+	}, hooks, zap.NewNop())
+	require.NoError(t, err)
+
+	var refreshers TokenRefresherSet
+	require.NoError(t, refreshers.Add("oauth2", handler))
+
+	cookie := securecookie.New(securecookie.GenerateRandomKey(32), securecookie.GenerateRandomKey(32))
+	mw := NewLoadUserMw(LoadUserConfig{
+		Log:             zap.NewNop(),
+		Cookie:          cookie,
+		InsecureCookies: true,
+		Hooks:           hooks,
+		TokenRefreshers: &refreshers,
+	})
+	return cookie, mw
+}
+
+func savedUserCookies(t *testing.T, cookie *securecookie.SecureCookie, user *User) []*http.Cookie {
+	rec := httptest.NewRecorder()
+	require.NoError(t, user.Save(cookie, rec, httptest.NewRequest(http.MethodGet, "/", nil), true))
+	return rec.Result().Cookies()
+}
+
+func TestUserLoadRefreshesAccessToken(t *testing.T) {
+	hooks := &countingHooks{}
+	cookie, mw := newTestRefreshEnvironment(t, hooks)
+
+	cookies := savedUserCookies(t, cookie, &User{
+		ProviderName:   "oauth2",
+		ProviderID:     "oauth2",
+		UserID:         "1",
+		RawAccessToken: "access",
+		RefreshToken:   "refresh",
+		ExpiresAt:      time.Now().Add(10 * time.Second),
+	})
+
+	var loaded *User
+	handler := mw(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		loaded = UserFromContext(r.Context())
+	}))
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	for _, c := range cookies {
+		req.AddCookie(c)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	require.NotNil(t, loaded)
+	assert.Equal(t, "access-2", loaded.RawAccessToken)
+	assert.Equal(t, "refresh-2", loaded.RefreshToken)
+	assert.Equal(t, "Jane", loaded.Name)
+	assert.True(t, time.Until(loaded.ExpiresAt) > accessTokenRefreshWindow)
+	assert.Equal(t, 1, hooks.postAuthentication)
+
+	// The rotated tokens must be persisted
+	var saved User
+	var accessToken string
+	for _, c := range rec.Result().Cookies() {
+		switch c.Name {
+		case userCookieName:
+			require.NoError(t, cookie.Decode(userCookieName, c.Value, &saved))
+		case accessCookieName:
+			require.NoError(t, cookie.Decode(accessCookieName, c.Value, &accessToken))
+		}
+	}
+	assert.Equal(t, "refresh-2", saved.RefreshToken)
+	assert.Equal(t, "access-2", accessToken)
+}
+
+func TestUserLoadRefreshOutlivesRequest(t *testing.T) {
+	cookie, mw := newTestRefreshEnvironment(t, noopHooks{})
+
+	var loaded *User
+	handler := mw(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		loaded = UserFromContext(r.Context())
+	}))
+	// The refresh is shared with other requests, so it must complete even if
+	// the client that started it goes away
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req := httptest.NewRequest(http.MethodGet, "/", nil).WithContext(ctx)
+	for _, c := range savedUserCookies(t, cookie, &User{
+		ProviderID:     "oauth2",
+		RawAccessToken: "access",
+		RefreshToken:   "refresh",
+		ExpiresAt:      time.Now().Add(10 * time.Second),
+	}) {
+		req.AddCookie(c)
+	}
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	require.NotNil(t, loaded)
+	assert.Equal(t, "access-2", loaded.RawAccessToken)
+}
+
+func TestUserLoadFailedRefresh(t *testing.T) {
+	cookie, mw := newTestRefreshEnvironment(t, noopHooks{})
+
+	load := func(user *User) *User {
+		var loaded *User
+		handler := mw(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			loaded = UserFromContext(r.Context())
+		}))
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		for _, c := range savedUserCookies(t, cookie, user) {
+			req.AddCookie(c)
+		}
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+		return loaded
+	}
+
+	// Token is about to expire, keep the session
+	loaded := load(&User{
+		ProviderID:     "oauth2",
+		RawAccessToken: "access",
+		RefreshToken:   "revoked",
+		ExpiresAt:      time.Now().Add(10 * time.Second),
+	})
+	require.NotNil(t, loaded)
+	assert.Equal(t, "access", loaded.RawAccessToken)
+
+	// Token has expired, the user can't be authenticated anymore
+	loaded = load(&User{
+		ProviderID:     "oauth2",
+		RawAccessToken: "access",
+		RefreshToken:   "revoked",
+		ExpiresAt:      time.Now().Add(-time.Second),
+	})
+	assert.Nil(t, loaded)
+}
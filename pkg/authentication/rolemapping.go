@@ -0,0 +1,241 @@
+package authentication
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/wundergraph/wundergraph/pkg/jsonpath"
+	"github.com/wundergraph/wundergraph/pkg/loadvariable"
+	"github.com/wundergraph/wundergraph/pkg/wgpb"
+)
+
+const (
+	// githubOrganizationsClaim and githubTeamsClaim are the custom claims used
+	// to store the GitHub organizations and teams (as org/team-slug) of a user.
+	// They're only retrieved when role mapping rules require them.
+	githubOrganizationsClaim = "github_organizations"
+	githubTeamsClaim         = "github_teams"
+)
+
+type RoleMappingKind int
+
+const (
+	// RoleMappingClaimEquals matches when the claim is equal to the value
+	RoleMappingClaimEquals RoleMappingKind = iota
+	// RoleMappingClaimContains matches when the claim is an array containing
+	// the value or a space separated string (e.g. scope) including it
+	RoleMappingClaimContains
+	// RoleMappingEmailDomain matches users with a verified email in the domain
+	RoleMappingEmailDomain
+	// RoleMappingGithubOrganization matches GitHub users in the organization
+	RoleMappingGithubOrganization
+	// RoleMappingGithubTeam matches GitHub users in the organization/team-slug team
+	RoleMappingGithubTeam
+)
+
+// RoleMapping assigns Role to the users matching the rule
+type RoleMapping struct {
+	Role  string
+	Kind  RoleMappingKind
+	Claim string
+	Value string
+}
+
+// RoleMapper derives user roles from their claims using a set of rules
+type RoleMapper struct {
+	rules []RoleMapping
+}
+
+func NewRoleMapper(rules []RoleMapping) (*RoleMapper, error) {
+	for _, rule := range rules {
+		if rule.Role == "" {
+			return nil, errors.New("role mapping rule has no role")
+		}
+		switch rule.Kind {
+		case RoleMappingClaimEquals, RoleMappingClaimContains:
+			if rule.Claim == "" {
+				return nil, fmt.Errorf("role mapping rule for %q has no claim", rule.Role)
+			}
+		case RoleMappingEmailDomain, RoleMappingGithubOrganization:
+		case RoleMappingGithubTeam:
+			if !strings.Contains(rule.Value, "/") {
+				return nil, fmt.Errorf("role mapping rule for %q must use organization/team-slug as the team", rule.Role)
+			}
+		default:
+			return nil, fmt.Errorf("invalid role mapping kind %d", rule.Kind)
+		}
+		if rule.Value == "" {
+			return nil, fmt.Errorf("role mapping rule for %q has no value", rule.Role)
+		}
+	}
+	return &RoleMapper{
+		rules: rules,
+	}, nil
+}
+
+// RoleMappingsFromConfig converts the role mapping rules from the configuration
+func RoleMappingsFromConfig(rules []*wgpb.RoleMappingRule) []RoleMapping {
+	mappings := make([]RoleMapping, 0, len(rules))
+	for _, rule := range rules {
+		var kind RoleMappingKind
+		switch rule.Kind {
+		case wgpb.RoleMappingRuleKind_RoleMappingClaimEquals:
+			kind = RoleMappingClaimEquals
+		case wgpb.RoleMappingRuleKind_RoleMappingClaimContains:
+			kind = RoleMappingClaimContains
+		case wgpb.RoleMappingRuleKind_RoleMappingEmailDomain:
+			kind = RoleMappingEmailDomain
+		case wgpb.RoleMappingRuleKind_RoleMappingGithubOrganization:
+			kind = RoleMappingGithubOrganization
+		case wgpb.RoleMappingRuleKind_RoleMappingGithubTeam:
+			kind = RoleMappingGithubTeam
+		default:
+			kind = RoleMappingKind(rule.Kind)
+		}
+		mappings = append(mappings, RoleMapping{
+			Role:  rule.Role,
+			Kind:  kind,
+			Claim: rule.Claim,
+			Value: rule.Value,
+		})
+	}
+	return mappings
+}
+
+// ValidateRoleMappings checks the role mapping rules of every authentication
+// provider in the configuration, naming the provider with invalid rules
+func ValidateRoleMappings(config *wgpb.ApiAuthenticationConfig) error {
+	for _, provider := range config.GetCookieBased().GetProviders() {
+		if _, err := NewRoleMapper(RoleMappingsFromConfig(provider.GetRoleMappings())); err != nil {
+			return fmt.Errorf("auth provider %s: %w", provider.GetId(), err)
+		}
+	}
+	for i, provider := range config.GetJwksBased().GetProviders() {
+		if _, err := NewRoleMapper(RoleMappingsFromConfig(provider.GetRoleMappings())); err != nil {
+			name := loadvariable.String(provider.GetJwksUrl())
+			if name == "" {
+				name = fmt.Sprintf("#%d", i)
+			}
+			return fmt.Errorf("jwks provider %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// usesGithub returns true iff the rules require the GitHub organizations or teams
+func (m *RoleMapper) usesGithub() bool {
+	if m == nil {
+		return false
+	}
+	for _, rule := range m.rules {
+		if rule.Kind == RoleMappingGithubOrganization || rule.Kind == RoleMappingGithubTeam {
+			return true
+		}
+	}
+	return false
+}
+
+// Apply adds the roles from all the matching rules to the user
+func (m *RoleMapper) Apply(user *User) {
+	if m == nil {
+		return
+	}
+	for _, rule := range m.rules {
+		if rule.matches(user) && !containsString(user.Roles, rule.Role) {
+			user.Roles = append(user.Roles, rule.Role)
+		}
+	}
+}
+
+func (r *RoleMapping) matches(user *User) bool {
+	switch r.Kind {
+	case RoleMappingClaimEquals:
+		value := user.claimValue(r.Claim)
+		switch value.(type) {
+		case nil, []interface{}, map[string]interface{}:
+			return false
+		}
+		return fmt.Sprint(value) == r.Value
+	case RoleMappingClaimContains:
+		return claimContains(user.claimValue(r.Claim), r.Value)
+	case RoleMappingEmailDomain:
+		_, domain, found := strings.Cut(user.Email, "@")
+		return found && user.EmailVerified && strings.EqualFold(domain, r.Value)
+	case RoleMappingGithubOrganization:
+		return user.ProviderName == "github" && claimContains(user.CustomClaims[githubOrganizationsClaim], r.Value)
+	case RoleMappingGithubTeam:
+		return user.ProviderName == "github" && claimContains(user.CustomClaims[githubTeamsClaim], r.Value)
+	}
+	return false
+}
+
+func claimContains(claim interface{}, value string) bool {
+	switch x := claim.(type) {
+	case []interface{}:
+		for _, item := range x {
+			if s, ok := item.(string); ok && s == value {
+				return true
+			}
+		}
+	case []string:
+		return containsString(x, value)
+	case string:
+		return containsString(strings.Fields(x), value)
+	}
+	return false
+}
+
+func containsString(slice []string, s string) bool {
+	for _, item := range slice {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// claimValue returns the value of the given claim, which might be either a
+// well known claim name (e.g. sub, email) or a path to a custom claim
+func (u *User) claimValue(claim string) interface{} {
+	// XXX: Keep in sync with Claims
+	switch claim {
+	case "iss":
+		return u.ProviderID
+	case "sub":
+		return u.UserID
+	case "name":
+		return u.Name
+	case "given_name":
+		return u.FirstName
+	case "family_name":
+		return u.LastName
+	case "middle_name":
+		return u.MiddleName
+	case "nickname":
+		return u.NickName
+	case "preferred_username":
+		return u.PreferredUsername
+	case "profile":
+		return u.Profile
+	case "picture":
+		return u.Picture
+	case "website":
+		return u.Website
+	case "email":
+		return u.Email
+	case "email_verified":
+		return u.EmailVerified
+	case "gender":
+		return u.Gender
+	case "birthdate":
+		return u.BirthDate
+	case "zoneinfo":
+		return u.ZoneInfo
+	case "locale":
+		return u.Locale
+	case "location":
+		return u.Location
+	}
+	return jsonpath.GetKeys(u.CustomClaims, strings.Split(claim, ".")...)
+}
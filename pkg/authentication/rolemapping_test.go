@@ -0,0 +1,180 @@
+package authentication
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+
+	"github.com/wundergraph/wundergraph/pkg/wgpb"
+)
+
+func TestNewRoleMapperValidation(t *testing.T) {
+	testCases := []struct {
+		name string
+		rule RoleMapping
+	}{
+		{"no role", RoleMapping{Kind: RoleMappingEmailDomain, Value: "example.com"}},
+		{"no claim", RoleMapping{Role: "admin", Kind: RoleMappingClaimEquals, Value: "x"}},
+		{"no value", RoleMapping{Role: "admin", Kind: RoleMappingGithubOrganization}},
+		{"team without org", RoleMapping{Role: "admin", Kind: RoleMappingGithubTeam, Value: "team"}},
+		{"invalid kind", RoleMapping{Role: "admin", Kind: RoleMappingKind(42), Value: "x"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewRoleMapper([]RoleMapping{tc.rule})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestValidateRoleMappings(t *testing.T) {
+	valid := []*wgpb.RoleMappingRule{{Role: "staff", Kind: wgpb.RoleMappingRuleKind_RoleMappingEmailDomain, Value: "example.com"}}
+	invalid := []*wgpb.RoleMappingRule{{Role: "admin", Kind: wgpb.RoleMappingRuleKind_RoleMappingClaimEquals, Value: "x"}}
+
+	assert.NoError(t, ValidateRoleMappings(nil))
+	assert.NoError(t, ValidateRoleMappings(&wgpb.ApiAuthenticationConfig{
+		CookieBased: &wgpb.CookieBasedAuthentication{Providers: []*wgpb.AuthProvider{{Id: "github", RoleMappings: valid}}},
+		JwksBased:   &wgpb.JwksBasedAuthentication{Providers: []*wgpb.JwksAuthProvider{{RoleMappings: valid}}},
+	}))
+
+	err := ValidateRoleMappings(&wgpb.ApiAuthenticationConfig{
+		CookieBased: &wgpb.CookieBasedAuthentication{Providers: []*wgpb.AuthProvider{{Id: "github", RoleMappings: invalid}}},
+	})
+	assert.EqualError(t, err, `auth provider github: role mapping rule for "admin" has no claim`)
+
+	err = ValidateRoleMappings(&wgpb.ApiAuthenticationConfig{
+		JwksBased: &wgpb.JwksBasedAuthentication{Providers: []*wgpb.JwksAuthProvider{
+			{RoleMappings: valid},
+			{RoleMappings: invalid},
+			{
+				JwksUrl:      &wgpb.ConfigurationVariable{Kind: wgpb.ConfigurationVariableKind_STATIC_CONFIGURATION_VARIABLE, StaticVariableContent: "https://example.com/jwks.json"},
+				RoleMappings: invalid,
+			},
+		}},
+	})
+	assert.EqualError(t, err, `jwks provider #1: role mapping rule for "admin" has no claim`)
+
+	err = ValidateRoleMappings(&wgpb.ApiAuthenticationConfig{
+		JwksBased: &wgpb.JwksBasedAuthentication{Providers: []*wgpb.JwksAuthProvider{{
+			JwksUrl:      &wgpb.ConfigurationVariable{Kind: wgpb.ConfigurationVariableKind_STATIC_CONFIGURATION_VARIABLE, StaticVariableContent: "https://example.com/jwks.json"},
+			RoleMappings: invalid,
+		}}},
+	})
+	assert.EqualError(t, err, `jwks provider https://example.com/jwks.json: role mapping rule for "admin" has no claim`)
+}
+
+func TestRoleMapperApply(t *testing.T) {
+	mapper, err := NewRoleMapper(RoleMappingsFromConfig([]*wgpb.RoleMappingRule{
+		{Role: "admin", Kind: wgpb.RoleMappingRuleKind_RoleMappingClaimContains, Claim: "groups", Value: "admins"},
+		{Role: "staff", Kind: wgpb.RoleMappingRuleKind_RoleMappingEmailDomain, Value: "example.com"},
+		{Role: "billing", Kind: wgpb.RoleMappingRuleKind_RoleMappingClaimEquals, Claim: "org.plan", Value: "enterprise"},
+		{Role: "writer", Kind: wgpb.RoleMappingRuleKind_RoleMappingClaimContains, Claim: "scope", Value: "write"},
+		{Role: "member", Kind: wgpb.RoleMappingRuleKind_RoleMappingGithubOrganization, Value: "wundergraph"},
+		{Role: "core", Kind: wgpb.RoleMappingRuleKind_RoleMappingGithubTeam, Value: "wundergraph/core"},
+	}))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name  string
+		user  *User
+		roles []string
+	}{
+		{
+			name: "groups and domain",
+			user: &User{
+				Email:         "jane@example.com",
+				EmailVerified: true,
+				CustomClaims:  map[string]interface{}{"groups": []interface{}{"users", "admins"}},
+			},
+			roles: []string{"admin", "staff"},
+		},
+		{
+			name:  "unverified email",
+			user:  &User{Email: "jane@example.com"},
+			roles: nil,
+		},
+		{
+			name: "nested claim and scope",
+			user: &User{
+				CustomClaims: map[string]interface{}{
+					"org":   map[string]interface{}{"plan": "enterprise"},
+					"scope": "read write",
+				},
+			},
+			roles: []string{"billing", "writer"},
+		},
+		{
+			name: "existing roles are kept",
+			user: &User{
+				Roles:        []string{"admin"},
+				CustomClaims: map[string]interface{}{"groups": []interface{}{"admins"}},
+			},
+			roles: []string{"admin"},
+		},
+		{
+			name: "github memberships",
+			user: &User{
+				ProviderName: "github",
+				CustomClaims: map[string]interface{}{
+					githubOrganizationsClaim: []interface{}{"wundergraph"},
+					githubTeamsClaim:         []interface{}{"wundergraph/core"},
+				},
+			},
+			roles: []string{"member", "core"},
+		},
+		{
+			name: "github claims from other providers are ignored",
+			user: &User{
+				ProviderName: "oidc",
+				CustomClaims: map[string]interface{}{githubOrganizationsClaim: []interface{}{"wundergraph"}},
+			},
+			roles: nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mapper.Apply(tc.user)
+			assert.Equal(t, tc.roles, tc.user.Roles)
+		})
+	}
+}
+
+func TestGithubUserMemberships(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"id": 1, "login": "jane", "name": "Jane"})
+	})
+	mux.HandleFunc("/user/emails", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode([]map[string]interface{}{{"email": "jane@example.com", "primary": true, "verified": true}})
+	})
+	mux.HandleFunc("/user/orgs", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode([]map[string]interface{}{{"login": "wundergraph"}})
+	})
+	mux.HandleFunc("/user/teams", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode([]map[string]interface{}{{"slug": "core", "organization": map[string]interface{}{"login": "wundergraph"}}})
+	})
+	api := httptest.NewServer(mux)
+	t.Cleanup(api.Close)
+
+	mapper, err := NewRoleMapper([]RoleMapping{{Role: "core", Kind: RoleMappingGithubTeam, Value: "wundergraph/core"}})
+	require.NoError(t, err)
+	handler := NewGithubCookieHandler(GithubConfig{
+		Provider: ProviderConfig{ID: "github", RoleMapper: mapper},
+	}, noopHooks{}, zap.NewNop())
+	handler.apiURL = api.URL
+	assert.Contains(t, handler.auth2.config.Scopes, "read:org")
+
+	user, err := handler.User(context.Background(), zap.NewNop(), &oauth2.Token{AccessToken: "token"})
+	require.NoError(t, err)
+	mapper.Apply(user)
+	assert.Equal(t, []interface{}{"wundergraph"}, user.CustomClaims[githubOrganizationsClaim])
+	assert.Equal(t, []string{"core"}, user.Roles)
+}
@@ -0,0 +1,927 @@
+package authentication
+
+import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/beevik/etree"
+	"github.com/crewjam/saml"
+	"github.com/crewjam/saml/samlsp"
+	"github.com/gorilla/mux"
+	xrv "github.com/mattermost/xml-roundtrip-validator"
+	dsig "github.com/russellhaering/goxmldsig"
+	"go.uber.org/zap"
+)
+
+const (
+	// samlRequestIDCookieName stores the ID of the AuthnRequest sent to the identity
+	// provider, which must match the InResponseTo attribute of the response
+	samlRequestIDCookieName = "saml_request_id"
+
+	samlRequestParameter  = "SAMLRequest"
+	samlResponseParameter = "SAMLResponse"
+	samlRelayStateParam   = "RelayState"
+
+	samlMaxMessageSize = 1024 * 1024
+)
+
+const (
+	authErrorCodeInvalidAssertion = authErrorCode("invalid_assertion")
+)
+
+type SAMLConfig struct {
+	Provider ProviderConfig
+	// BaseURL is the public URL of the node, used to build the URLs of
+	// the service provider registered at the identity provider
+	BaseURL string
+	// EntityID defaults to the URL of the service provider metadata
+	EntityID string
+	// Either IDPMetadataURL or IDPMetadataXML must be provided
+	IDPMetadataURL string
+	IDPMetadataXML string
+	// Certificate and PrivateKey are PEM encoded. They're required to
+	// sign requests and to decrypt encrypted assertions
+	Certificate       string
+	PrivateKey        string
+	SignRequests      bool
+	AllowIDPInitiated bool
+	NameIDFormat      string
+	// AttributeMapping maps claim names (e.g. email, name) to SAML attribute
+	// names or friendly names. The NameID is always used as the subject
+	AttributeMapping map[string]string
+	// RolesAttribute names the attribute used to populate User.Roles
+	RolesAttribute string
+	// RedirectValidator validates the RelayState used to redirect the user
+	// back into the application after single logout
+	RedirectValidator *RedirectURIValidator
+	HTTPClient        *http.Client
+}
+
+// SAMLCookieHandler implements a SAML 2.0 service provider, authenticating
+// users via an identity provider and storing them in the user cookie
+type SAMLCookieHandler struct {
+	config SAMLConfig
+	sp     *saml.ServiceProvider
+	hooks  Hooks
+	log    *zap.Logger
+}
+
+func NewSAMLCookieHandler(config SAMLConfig, hooks Hooks, log *zap.Logger) (*SAMLCookieHandler, error) {
+	if config.BaseURL == "" {
+		return nil, errors.New("SAML requires the public node URL to be set")
+	}
+	baseURL, err := url.Parse(strings.TrimSuffix(config.BaseURL, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid public node URL: %w", err)
+	}
+	if baseURL.Path == "" {
+		baseURL.Path = "/"
+	}
+	httpClient := config.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{
+			Timeout: time.Second * 10,
+		}
+	}
+	idpMetadata, err := loadSAMLIDPMetadata(httpClient, config)
+	if err != nil {
+		return nil, err
+	}
+
+	nameIDFormat := saml.NameIDFormat(config.NameIDFormat)
+	if nameIDFormat == "" {
+		nameIDFormat = saml.UnspecifiedNameIDFormat
+	}
+
+	providerPath := fmt.Sprintf("/auth/cookie/%s/%s", SAMLPath, config.Provider.ID)
+	sp := &saml.ServiceProvider{
+		EntityID:          config.EntityID,
+		MetadataURL:       *baseURL.JoinPath(providerPath, "metadata"),
+		AcsURL:            *baseURL.JoinPath("/auth/cookie", CallbackPath, config.Provider.ID),
+		SloURL:            *baseURL.JoinPath(providerPath, "logout"),
+		IDPMetadata:       idpMetadata,
+		AuthnNameIDFormat: nameIDFormat,
+		AllowIDPInitiated: config.AllowIDPInitiated,
+		HTTPClient:        httpClient,
+		LogoutBindings:    []string{saml.HTTPRedirectBinding, saml.HTTPPostBinding},
+	}
+
+	if config.Certificate != "" || config.PrivateKey != "" {
+		cert, key, err := parseSAMLKeyPair(config.Certificate, config.PrivateKey)
+		if err != nil {
+			return nil, err
+		}
+		sp.Certificate = cert
+		sp.Key = key
+	}
+
+	if config.SignRequests {
+		if sp.Key == nil {
+			return nil, errors.New("signing SAML requests requires a certificate and a private key")
+		}
+		sp.SignatureMethod = dsig.RSASHA256SignatureMethod
+	}
+
+	return &SAMLCookieHandler{
+		config: config,
+		sp:     sp,
+		hooks:  hooks,
+		log:    log.With(zap.String("provider", config.Provider.ID)),
+	}, nil
+}
+
+func loadSAMLIDPMetadata(httpClient *http.Client, config SAMLConfig) (*saml.EntityDescriptor, error) {
+	if config.IDPMetadataXML != "" {
+		metadata, err := samlsp.ParseMetadata([]byte(config.IDPMetadataXML))
+		if err != nil {
+			return nil, fmt.Errorf("parsing SAML identity provider metadata: %w", err)
+		}
+		return metadata, nil
+	}
+	if config.IDPMetadataURL == "" {
+		return nil, errors.New("SAML identity provider metadata URL or XML must be provided")
+	}
+	metadataURL, err := url.Parse(config.IDPMetadataURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SAML identity provider metadata URL: %w", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	metadata, err := samlsp.FetchMetadata(ctx, httpClient, *metadataURL)
+	if err != nil {
+		return nil, fmt.Errorf("fetching SAML identity provider metadata: %w", err)
+	}
+	return metadata, nil
+}
+
+func parseSAMLKeyPair(certificatePEM, privateKeyPEM string) (*x509.Certificate, *rsa.PrivateKey, error) {
+	certBlock, _ := pem.Decode([]byte(certificatePEM))
+	if certBlock == nil {
+		return nil, nil, errors.New("SAML certificate is not PEM encoded")
+	}
+	cert, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing SAML certificate: %w", err)
+	}
+	keyBlock, _ := pem.Decode([]byte(privateKeyPEM))
+	if keyBlock == nil {
+		return nil, nil, errors.New("SAML private key is not PEM encoded")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(keyBlock.Bytes); err == nil {
+		return cert, key, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(keyBlock.Bytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing SAML private key: %w", err)
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, nil, errors.New("SAML private key must be an RSA key")
+	}
+	return cert, key, nil
+}
+
+// Register adds the handlers for starting the authentication and for the assertion
+// consumer service, which receives the responses from the identity provider
+func (h *SAMLCookieHandler) Register(authorizeRouter, callbackRouter *mux.Router) {
+	authorizeRouter.Path(fmt.Sprintf("/%s", h.config.Provider.ID)).Methods(http.MethodGet).HandlerFunc(h.Authorize)
+	callbackRouter.Path(fmt.Sprintf("/%s", h.config.Provider.ID)).Methods(http.MethodPost).HandlerFunc(h.Callback)
+}
+
+// RegisterServiceProvider adds the handlers for the service provider metadata and
+// single logout to the given router, which must be mounted at /auth/cookie
+func (h *SAMLCookieHandler) RegisterServiceProvider(router *mux.Router) {
+	providerPath := fmt.Sprintf("/%s/%s", SAMLPath, h.config.Provider.ID)
+	router.Path(providerPath + "/metadata").Methods(http.MethodGet).HandlerFunc(h.Metadata)
+	router.Path(providerPath+"/logout").Methods(http.MethodGet, http.MethodPost).HandlerFunc(h.SingleLogout)
+}
+
+func (h *SAMLCookieHandler) Metadata(w http.ResponseWriter, r *http.Request) {
+	data, err := xml.MarshalIndent(h.sp.Metadata(), "", "  ")
+	if err != nil {
+		h.log.Error("encoding SAML metadata", zap.Error(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/samlmetadata+xml")
+	_, _ = w.Write(data)
+}
+
+// requestCookie returns the template for the cookies used while authenticating. Since
+// the identity provider POSTs the response, they must use SameSite=None unless we're
+// running with insecure cookies (i.e. during development).
+func (h *SAMLCookieHandler) requestCookie(r *http.Request) *http.Cookie {
+	cookie := &http.Cookie{
+		MaxAge:   int(h.config.Provider.AuthTimeout.Seconds()),
+		Secure:   true,
+		HttpOnly: true,
+		Path:     fmt.Sprintf("/auth/cookie/%s/%s", CallbackPath, h.config.Provider.ID),
+		Domain:   sanitizeDomain(r.Host),
+		SameSite: http.SameSiteNoneMode,
+	}
+	if h.config.Provider.InsecureCookies {
+		cookie.Secure = false
+		cookie.SameSite = http.SameSiteLaxMode
+	}
+	return cookie
+}
+
+func (h *SAMLCookieHandler) Authorize(w http.ResponseWriter, r *http.Request) {
+	redirectURI := r.URL.Query().Get("redirect_uri")
+
+	ssoURL := h.sp.GetSSOBindingLocation(saml.HTTPRedirectBinding)
+	if ssoURL == "" {
+		h.log.Error("SAML identity provider has no HTTP-Redirect single sign on service")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	authnRequest, err := h.sp.MakeAuthenticationRequest(ssoURL, saml.HTTPRedirectBinding, saml.HTTPPostBinding)
+	if err != nil {
+		h.log.Error("could not create SAML authentication request", zap.Error(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	state, err := generateState()
+	if err != nil {
+		h.log.Error("could not generate state",
+			zap.Error(err),
+		)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	redirectToProvider, err := authnRequest.Redirect(state, h.sp)
+	if err != nil {
+		h.log.Error("could not encode SAML authentication request", zap.Error(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	cookie := h.requestCookie(r)
+
+	cookie.Name = oauth2StateCookieName
+	cookie.Value = state
+	http.SetCookie(w, cookie)
+
+	cookie.Name = samlRequestIDCookieName
+	cookie.Value = authnRequest.ID
+	http.SetCookie(w, cookie)
+
+	if redirectURI != "" {
+		cookie.Name = oauth2RedirectURICookieName
+		cookie.Value = redirectURI
+		http.SetCookie(w, cookie)
+	}
+
+	h.log.Debug("redirecting to SAML identity provider", zap.String("url", redirectToProvider.String()))
+	http.Redirect(w, r, redirectToProvider.String(), http.StatusFound)
+}
+
+func (h *SAMLCookieHandler) Callback(w http.ResponseWriter, r *http.Request) {
+	var redirectURI string
+	if redirectURICookie, err := r.Cookie(oauth2RedirectURICookieName); err == nil {
+		redirectURI = redirectURICookie.Value
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, samlMaxMessageSize)
+	if err := r.ParseForm(); err != nil {
+		h.log.Warn("parsing SAML response form", zap.Error(err))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	// Responses to IdP initiated logins have no redirect_uri cookie, but they
+	// might indicate where to go in the RelayState
+	if redirectURI == "" && h.config.AllowIDPInitiated && h.config.RedirectValidator != nil {
+		if relayState := r.PostForm.Get(samlRelayStateParam); h.config.RedirectValidator.IsValid(relayState) {
+			redirectURI = relayState
+		}
+	}
+
+	if _, err := h.authenticate(w, r); err != nil {
+		// Error is already logged by authenticate()
+		if redirectURI != "" {
+			http.Redirect(w, r, authErrorRedirectURI(redirectURI, err), http.StatusFound)
+		} else {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+		return
+	}
+
+	if redirectURI == "" {
+		redirectURI = "/auth/cookie/user"
+	}
+
+	_, _ = fmt.Fprintf(w, "<html><head><script>window.location.replace('%s');</script></head></html>", template.JSEscapeString(redirectURI))
+}
+
+func (h *SAMLCookieHandler) authenticate(w http.ResponseWriter, r *http.Request) (*User, error) {
+	var possibleRequestIDs []string
+
+	stateCookie, err := r.Cookie(oauth2StateCookieName)
+	if err == nil {
+		if state := r.PostForm.Get(samlRelayStateParam); state != stateCookie.Value {
+			h.log.Warn("state mismatch", zap.String("expected", stateCookie.Value), zap.String("got", state))
+			return nil, &authError{
+				Code: authErrorCodeBadState,
+				Err:  errors.New("state mismatch"),
+			}
+		}
+		requestIDCookie, err := r.Cookie(samlRequestIDCookieName)
+		if err != nil {
+			h.log.Warn("reading SAML request ID cookie", zap.Error(err))
+			return nil, &authError{
+				Code: authErrorCodeBadCookie,
+				Err:  fmt.Errorf("could not read SAML request ID cookie: %w", err),
+			}
+		}
+		possibleRequestIDs = []string{requestIDCookie.Value}
+	} else if !h.config.AllowIDPInitiated {
+		h.log.Warn("reading state cookie",
+			zap.Error(err),
+		)
+		return nil, &authError{
+			Code: authErrorCodeBadCookie,
+			Err:  fmt.Errorf("could not read state cookie: %w", err),
+		}
+	}
+
+	assertion, err := h.sp.ParseResponse(r, possibleRequestIDs)
+	if err != nil {
+		fields := []zap.Field{zap.Error(err)}
+		var invalidResponse *saml.InvalidResponseError
+		if errors.As(err, &invalidResponse) {
+			fields = append(fields, zap.NamedError("reason", invalidResponse.PrivateErr))
+		}
+		h.log.Warn("invalid SAML response", fields...)
+		return nil, &authError{
+			Code: authErrorCodeInvalidAssertion,
+			Err:  fmt.Errorf("invalid SAML response: %w", err),
+		}
+	}
+
+	user, err := h.user(assertion)
+	if err != nil {
+		h.log.Error("retrieving SAML user",
+			zap.Error(err),
+		)
+		return nil, &authError{
+			Code: authErrorCodeRetrieveUserFailed,
+			Err:  fmt.Errorf("could not retrieve user: %w", err),
+		}
+	}
+	user.ProviderID = h.config.Provider.ID
+	user.AuthenticatedAt = time.Now()
+	h.config.Provider.RoleMapper.Apply(user)
+
+	user, err = postAuthenticationHooks(r.Context(), r, h.hooks, user)
+	if err != nil {
+		h.log.Error("postAuthentication failed", zap.Error(err))
+		return nil, &authError{
+			Code: authErrorCodePostAuthentication,
+			Err:  err,
+		}
+	}
+
+	if err := user.Save(h.config.Provider.Cookie, w, r, h.config.Provider.InsecureCookies); err != nil {
+		return nil, &authError{
+			Code: authErrorCodeSavedFailed,
+			Err:  fmt.Errorf("could not encode user data: %w", err),
+		}
+	}
+
+	return user, nil
+}
+
+// samlAttributes returns the values of all the attributes in the assertion, indexed
+// by both their name and friendly name
+func samlAttributes(assertion *saml.Assertion) map[string][]string {
+	attributes := make(map[string][]string)
+	for _, statement := range assertion.AttributeStatements {
+		for _, attr := range statement.Attributes {
+			values := make([]string, 0, len(attr.Values))
+			for _, v := range attr.Values {
+				if v.NameID != nil {
+					values = append(values, v.NameID.Value)
+				} else {
+					values = append(values, v.Value)
+				}
+			}
+			if attr.Name != "" {
+				attributes[attr.Name] = append(attributes[attr.Name], values...)
+			}
+			if attr.FriendlyName != "" && attr.FriendlyName != attr.Name {
+				attributes[attr.FriendlyName] = append(attributes[attr.FriendlyName], values...)
+			}
+		}
+	}
+	return attributes
+}
+
+func (h *SAMLCookieHandler) user(assertion *saml.Assertion) (*User, error) {
+	if assertion.Subject == nil || assertion.Subject.NameID == nil || assertion.Subject.NameID.Value == "" {
+		return nil, errors.New("SAML assertion has no NameID")
+	}
+	nameID := assertion.Subject.NameID
+	attributes := samlAttributes(assertion)
+
+	claims := map[string]interface{}{
+		"sub": nameID.Value,
+	}
+	for claim, attribute := range h.config.AttributeMapping {
+		values := attributes[attribute]
+		switch {
+		case len(values) == 0:
+			continue
+		case len(values) == 1 || !isCustomClaim(claim):
+			claims[claim] = values[0]
+		default:
+			items := make([]interface{}, len(values))
+			for i, v := range values {
+				items[i] = v
+			}
+			claims[claim] = items
+		}
+	}
+
+	data, err := json.Marshal(claims)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := decodeClaims(data)
+	if err != nil {
+		return nil, err
+	}
+
+	user := parsed.ToUser()
+	user.ProviderName = "saml"
+	user.NameID = nameID.Value
+	user.NameIDFormat = nameID.Format
+	if h.config.RolesAttribute != "" {
+		user.Roles = attributes[h.config.RolesAttribute]
+	}
+	if len(assertion.AuthnStatements) > 0 {
+		statement := assertion.AuthnStatements[0]
+		user.SessionIndex = statement.SessionIndex
+		if statement.SessionNotOnOrAfter != nil {
+			user.ExpiresAt = *statement.SessionNotOnOrAfter
+		}
+	}
+	return user, nil
+}
+
+// Disconnect starts a service provider initiated single logout, returning the URL
+// of the identity provider the client must be redirected to
+func (h *SAMLCookieHandler) Disconnect(ctx context.Context, user *User) (*OpenIDDisconnectResult, error) {
+	sloURL := h.sp.GetSLOBindingLocation(saml.HTTPRedirectBinding)
+	if sloURL == "" {
+		return nil, errors.New("SAML identity provider has no HTTP-Redirect single logout service")
+	}
+	if user.NameID == "" {
+		return nil, errors.New("user has no SAML NameID")
+	}
+	id, err := samlMessageID()
+	if err != nil {
+		return nil, err
+	}
+	logoutRequest := &saml.LogoutRequest{
+		ID:           id,
+		IssueInstant: saml.TimeNow(),
+		Version:      "2.0",
+		Destination:  sloURL,
+		Issuer:       h.issuer(),
+		NameID: &saml.NameID{
+			Format: user.NameIDFormat,
+			Value:  user.NameID,
+		},
+	}
+	if user.SessionIndex != "" {
+		logoutRequest.SessionIndex = &saml.SessionIndex{
+			Value: user.SessionIndex,
+		}
+	}
+	var relayState string
+	if params, ok := ctx.Value(ForwardedQueryParamsKey).(url.Values); ok && h.config.RedirectValidator != nil {
+		if redirectURI := params.Get("redirect_uri"); h.config.RedirectValidator.IsValid(redirectURI) {
+			relayState = redirectURI
+		}
+	}
+	redirect, err := h.redirectBindingURL(sloURL, samlRequestParameter, logoutRequest.Element(), relayState)
+	if err != nil {
+		return nil, err
+	}
+	return &OpenIDDisconnectResult{
+		Redirect: redirect,
+	}, nil
+}
+
+// SingleLogout handles both logout requests initiated by the identity provider
+// and responses to logout requests initiated by us
+func (h *SAMLCookieHandler) SingleLogout(w http.ResponseWriter, r *http.Request) {
+	msg, err := h.readMessage(w, r)
+	if err != nil {
+		h.log.Warn("invalid SAML logout message", zap.Error(err))
+		http.Error(w, "invalid SAML logout message", http.StatusBadRequest)
+		return
+	}
+	// Whatever the message was, the user must not be considered logged in anymore
+	resetUserCookies(w, r, !h.config.Provider.InsecureCookies)
+
+	switch msg.parameter {
+	case samlRequestParameter:
+		var logoutRequest saml.LogoutRequest
+		if err := xml.Unmarshal(msg.data, &logoutRequest); err != nil {
+			h.log.Warn("decoding SAML logout request", zap.Error(err))
+			http.Error(w, "invalid SAML logout request", http.StatusBadRequest)
+			return
+		}
+		if err := h.validateLogoutRequest(&logoutRequest); err != nil {
+			h.log.Warn("invalid SAML logout request", zap.Error(err))
+			http.Error(w, "invalid SAML logout request", http.StatusBadRequest)
+			return
+		}
+		if user := UserFromContext(r.Context()); user != nil && user.NameID == logoutRequest.NameID.Value {
+			if err := h.hooks.PostLogout(r.Context(), user); err != nil {
+				h.log.Error("running postLogout hook", zap.Error(err))
+			}
+		}
+		redirect, err := h.logoutResponseURL(logoutRequest.ID, msg.relayState)
+		if err != nil {
+			h.log.Error("creating SAML logout response", zap.Error(err))
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		http.Redirect(w, r, redirect, http.StatusFound)
+	case samlResponseParameter:
+		var logoutResponse saml.LogoutResponse
+		if err := xml.Unmarshal(msg.data, &logoutResponse); err != nil {
+			h.log.Warn("decoding SAML logout response", zap.Error(err))
+			http.Error(w, "invalid SAML logout response", http.StatusBadRequest)
+			return
+		}
+		if err := h.validateLogoutResponse(&logoutResponse); err != nil {
+			h.log.Warn("invalid SAML logout response", zap.Error(err))
+			http.Error(w, "invalid SAML logout response", http.StatusBadRequest)
+			return
+		}
+		if h.config.RedirectValidator != nil && h.config.RedirectValidator.IsValid(msg.relayState) {
+			http.Redirect(w, r, msg.relayState, http.StatusFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (h *SAMLCookieHandler) validateLogoutRequest(logoutRequest *saml.LogoutRequest) error {
+	if logoutRequest.Issuer == nil || logoutRequest.Issuer.Value != h.sp.IDPMetadata.EntityID {
+		return fmt.Errorf("issuer does not match the identity provider %q", h.sp.IDPMetadata.EntityID)
+	}
+	if logoutRequest.Destination != "" && logoutRequest.Destination != h.sp.SloURL.String() {
+		return fmt.Errorf("destination does not match %q", h.sp.SloURL.String())
+	}
+	now := saml.TimeNow()
+	if logoutRequest.NotOnOrAfter != nil && !now.Before(logoutRequest.NotOnOrAfter.Add(saml.MaxClockSkew)) {
+		return errors.New("logout request has expired")
+	}
+	if logoutRequest.IssueInstant.Add(saml.MaxIssueDelay).Before(now) {
+		return errors.New("logout request was issued too long ago")
+	}
+	if logoutRequest.NameID == nil {
+		return errors.New("logout request has no NameID")
+	}
+	return nil
+}
+
+func (h *SAMLCookieHandler) validateLogoutResponse(logoutResponse *saml.LogoutResponse) error {
+	if logoutResponse.Issuer == nil || logoutResponse.Issuer.Value != h.sp.IDPMetadata.EntityID {
+		return fmt.Errorf("issuer does not match the identity provider %q", h.sp.IDPMetadata.EntityID)
+	}
+	if logoutResponse.Destination != "" && logoutResponse.Destination != h.sp.SloURL.String() {
+		return fmt.Errorf("destination does not match %q", h.sp.SloURL.String())
+	}
+	if logoutResponse.IssueInstant.Add(saml.MaxIssueDelay).Before(saml.TimeNow()) {
+		return errors.New("logout response was issued too long ago")
+	}
+	if logoutResponse.Status.StatusCode.Value != saml.StatusSuccess {
+		return fmt.Errorf("logout failed with status %q", logoutResponse.Status.StatusCode.Value)
+	}
+	return nil
+}
+
+func (h *SAMLCookieHandler) logoutResponseURL(inResponseTo string, relayState string) (string, error) {
+	var sloURL string
+	for _, descriptor := range h.sp.IDPMetadata.IDPSSODescriptors {
+		for _, service := range descriptor.SingleLogoutServices {
+			if service.Binding == saml.HTTPRedirectBinding {
+				sloURL = service.ResponseLocation
+				if sloURL == "" {
+					sloURL = service.Location
+				}
+				break
+			}
+		}
+	}
+	if sloURL == "" {
+		return "", errors.New("SAML identity provider has no HTTP-Redirect single logout service")
+	}
+	id, err := samlMessageID()
+	if err != nil {
+		return "", err
+	}
+	logoutResponse := &saml.LogoutResponse{
+		ID:           id,
+		InResponseTo: inResponseTo,
+		Version:      "2.0",
+		IssueInstant: saml.TimeNow(),
+		Destination:  sloURL,
+		Issuer:       h.issuer(),
+		Status: saml.Status{
+			StatusCode: saml.StatusCode{
+				Value: saml.StatusSuccess,
+			},
+		},
+	}
+	return h.redirectBindingURL(sloURL, samlResponseParameter, logoutResponse.Element(), relayState)
+}
+
+func (h *SAMLCookieHandler) issuer() *saml.Issuer {
+	return &saml.Issuer{
+		Format: "urn:oasis:names:tc:SAML:2.0:nameid-format:entity",
+		Value:  h.sp.Metadata().EntityID,
+	}
+}
+
+// redirectBindingURL encodes el using the HTTP-Redirect binding, signing the
+// query if the service provider is configured to sign its messages
+func (h *SAMLCookieHandler) redirectBindingURL(destination string, parameter string, el *etree.Element, relayState string) (string, error) {
+	doc := etree.NewDocument()
+	doc.SetRoot(el)
+	var buf bytes.Buffer
+	fw, err := flate.NewWriter(&buf, flate.BestCompression)
+	if err != nil {
+		return "", err
+	}
+	if _, err := doc.WriteTo(fw); err != nil {
+		return "", err
+	}
+	if err := fw.Close(); err != nil {
+		return "", err
+	}
+
+	query := parameter + "=" + url.QueryEscape(base64.StdEncoding.EncodeToString(buf.Bytes()))
+	if relayState != "" {
+		query += "&" + samlRelayStateParam + "=" + url.QueryEscape(relayState)
+	}
+	if h.sp.SignatureMethod != "" {
+		query += "&SigAlg=" + url.QueryEscape(h.sp.SignatureMethod)
+		signingContext, err := saml.GetSigningContext(h.sp)
+		if err != nil {
+			return "", err
+		}
+		signature, err := signingContext.SignString(query)
+		if err != nil {
+			return "", err
+		}
+		query += "&Signature=" + url.QueryEscape(base64.StdEncoding.EncodeToString(signature))
+	}
+
+	u, err := url.Parse(destination)
+	if err != nil {
+		return "", err
+	}
+	if u.RawQuery != "" {
+		query = u.RawQuery + "&" + query
+	}
+	u.RawQuery = query
+	return u.String(), nil
+}
+
+type samlMessage struct {
+	parameter  string
+	data       []byte
+	relayState string
+}
+
+// readMessage decodes a SAML message sent with either the HTTP-Redirect or the
+// HTTP-POST binding and verifies its signature
+func (h *SAMLCookieHandler) readMessage(w http.ResponseWriter, r *http.Request) (*samlMessage, error) {
+	certs, err := samlSigningCertificates(h.sp.IDPMetadata)
+	if err != nil {
+		return nil, err
+	}
+	if r.Method == http.MethodGet {
+		return readSAMLRedirectMessage(r, certs)
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, samlMaxMessageSize)
+	if err := r.ParseForm(); err != nil {
+		return nil, err
+	}
+	return readSAMLPostMessage(r, certs)
+}
+
+func readSAMLRedirectMessage(r *http.Request, certs []*x509.Certificate) (*samlMessage, error) {
+	// Signatures are calculated over the raw query values, in a fixed order
+	rawValues := make(map[string]string)
+	for _, part := range strings.Split(r.URL.RawQuery, "&") {
+		if key, value, found := strings.Cut(part, "="); found {
+			rawValues[key] = value
+		}
+	}
+	msg := &samlMessage{
+		relayState: r.URL.Query().Get(samlRelayStateParam),
+	}
+	for _, parameter := range []string{samlRequestParameter, samlResponseParameter} {
+		if _, found := rawValues[parameter]; found {
+			msg.parameter = parameter
+			break
+		}
+	}
+	if msg.parameter == "" {
+		return nil, errors.New("no SAML message in query")
+	}
+	compressed, err := base64.StdEncoding.DecodeString(r.URL.Query().Get(msg.parameter))
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64: %w", err)
+	}
+	data, err := io.ReadAll(io.LimitReader(flate.NewReader(bytes.NewReader(compressed)), samlMaxMessageSize))
+	if err != nil {
+		return nil, fmt.Errorf("inflating message: %w", err)
+	}
+	msg.data = data
+	if err := xrv.Validate(bytes.NewReader(data)); err != nil {
+		return nil, err
+	}
+
+	signature := r.URL.Query().Get("Signature")
+	if signature == "" {
+		// Some identity providers sign the XML document instead
+		return msg, validateSAMLXMLSignature(data, certs)
+	}
+	signedQuery := msg.parameter + "=" + rawValues[msg.parameter]
+	if relayState, found := rawValues[samlRelayStateParam]; found {
+		signedQuery += "&" + samlRelayStateParam + "=" + relayState
+	}
+	signedQuery += "&SigAlg=" + rawValues["SigAlg"]
+	decodedSignature, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return nil, fmt.Errorf("decoding signature: %w", err)
+	}
+	if err := verifySAMLQuerySignature(r.URL.Query().Get("SigAlg"), []byte(signedQuery), decodedSignature, certs); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+func readSAMLPostMessage(r *http.Request, certs []*x509.Certificate) (*samlMessage, error) {
+	msg := &samlMessage{
+		relayState: r.PostForm.Get(samlRelayStateParam),
+	}
+	var encoded string
+	for _, parameter := range []string{samlRequestParameter, samlResponseParameter} {
+		if encoded = r.PostForm.Get(parameter); encoded != "" {
+			msg.parameter = parameter
+			break
+		}
+	}
+	if msg.parameter == "" {
+		return nil, errors.New("no SAML message in form")
+	}
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64: %w", err)
+	}
+	msg.data = data
+	if err := xrv.Validate(bytes.NewReader(data)); err != nil {
+		return nil, err
+	}
+	return msg, validateSAMLXMLSignature(data, certs)
+}
+
+func validateSAMLXMLSignature(data []byte, certs []*x509.Certificate) error {
+	doc := etree.NewDocument()
+	if err := doc.ReadFromBytes(data); err != nil {
+		return err
+	}
+	if doc.Root() == nil {
+		return errors.New("empty SAML message")
+	}
+	validationContext := dsig.NewDefaultValidationContext(&dsig.MemoryX509CertificateStore{
+		Roots: certs,
+	})
+	validationContext.IdAttribute = "ID"
+	if _, err := validationContext.Validate(doc.Root()); err != nil {
+		return fmt.Errorf("validating signature: %w", err)
+	}
+	return nil
+}
+
+func verifySAMLQuerySignature(sigAlg string, signed []byte, signature []byte, certs []*x509.Certificate) error {
+	var hash crypto.Hash
+	switch sigAlg {
+	case dsig.RSASHA1SignatureMethod:
+		hash = crypto.SHA1
+	case dsig.RSASHA256SignatureMethod:
+		hash = crypto.SHA256
+	case dsig.RSASHA512SignatureMethod:
+		hash = crypto.SHA512
+	default:
+		return fmt.Errorf("unsupported signature algorithm %q", sigAlg)
+	}
+	h := hash.New()
+	h.Write(signed)
+	digest := h.Sum(nil)
+	for _, cert := range certs {
+		if key, ok := cert.PublicKey.(*rsa.PublicKey); ok {
+			if rsa.VerifyPKCS1v15(key, hash, digest, signature) == nil {
+				return nil
+			}
+		}
+	}
+	return errors.New("invalid signature")
+}
+
+var samlWhitespace = regexp.MustCompile(`\s+`)
+
+// samlSigningCertificates returns the certificates used by the identity provider to
+// sign its messages
+func samlSigningCertificates(metadata *saml.EntityDescriptor) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for _, descriptor := range metadata.IDPSSODescriptors {
+		for _, keyDescriptor := range descriptor.KeyDescriptors {
+			if keyDescriptor.Use != "" && keyDescriptor.Use != "signing" {
+				continue
+			}
+			for _, c := range keyDescriptor.KeyInfo.X509Data.X509Certificates {
+				data, err := base64.StdEncoding.DecodeString(samlWhitespace.ReplaceAllString(c.Data, ""))
+				if err != nil {
+					return nil, fmt.Errorf("decoding identity provider certificate: %w", err)
+				}
+				cert, err := x509.ParseCertificate(data)
+				if err != nil {
+					return nil, fmt.Errorf("parsing identity provider certificate: %w", err)
+				}
+				certs = append(certs, cert)
+			}
+		}
+	}
+	if len(certs) == 0 {
+		return nil, errors.New("SAML identity provider metadata has no signing certificates")
+	}
+	return certs, nil
+}
+
+func samlMessageID() (string, error) {
+	b := make([]byte, 20)
+	if _, err := io.ReadFull(rand.Reader, b); err != nil {
+		return "", err
+	}
+	return "id-" + hex.EncodeToString(b), nil
+}
+
+type SAMLProviderSet struct {
+	providers map[string]*SAMLCookieHandler
+}
+
+func (s *SAMLProviderSet) Add(id string, p *SAMLCookieHandler) error {
+	if s.providers == nil {
+		s.providers = make(map[string]*SAMLCookieHandler)
+	}
+
+	if s.providers[id] != nil {
+		return fmt.Errorf("duplicate SAML provider ID %q", id)
+	}
+	s.providers[id] = p
+	return nil
+}
+
+func (s *SAMLProviderSet) ByID(id string) (*SAMLCookieHandler, error) {
+	var provider *SAMLCookieHandler
+	if s != nil {
+		provider = s.providers[id]
+	}
+	if provider == nil {
+		return nil, fmt.Errorf("no SAML authentication provider with ID %q", id)
+	}
+	return provider, nil
+}
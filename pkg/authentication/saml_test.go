@@ -0,0 +1,364 @@
+package authentication
+
+import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"encoding/xml"
+	"html"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/crewjam/saml"
+	"github.com/gorilla/mux"
+	"github.com/gorilla/securecookie"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestKeyPair(t *testing.T, commonName string) (*rsa.PrivateKey, *x509.Certificate) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return key, cert
+}
+
+type testSAMLServiceProviders struct {
+	metadata *saml.EntityDescriptor
+}
+
+func (p *testSAMLServiceProviders) GetServiceProvider(r *http.Request, serviceProviderID string) (*saml.EntityDescriptor, error) {
+	return p.metadata, nil
+}
+
+type testSAMLSessions struct {
+	session *saml.Session
+}
+
+func (p *testSAMLSessions) GetSession(w http.ResponseWriter, r *http.Request, req *saml.IdpAuthnRequest) *saml.Session {
+	return p.session
+}
+
+type testSAMLEnvironment struct {
+	idp      *saml.IdentityProvider
+	handler  *SAMLCookieHandler
+	node     *httptest.Server
+	cookie   *securecookie.SecureCookie
+	spCert   *x509.Certificate
+	idpKey   *rsa.PrivateKey
+	idpCert  *x509.Certificate
+	redirect string
+}
+
+func newTestSAMLEnvironment(t *testing.T, signRequests bool) *testSAMLEnvironment {
+	idpKey, idpCert := newTestKeyPair(t, "idp")
+	spKey, spCert := newTestKeyPair(t, "sp")
+
+	var idpHandler http.Handler
+	idpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		idpHandler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(idpServer.Close)
+
+	serviceProviders := &testSAMLServiceProviders{}
+	idp := &saml.IdentityProvider{
+		Key:                     idpKey,
+		Certificate:             idpCert,
+		Logger:                  nopSAMLLogger{},
+		MetadataURL:             mustParseURL(t, idpServer.URL+"/metadata"),
+		SSOURL:                  mustParseURL(t, idpServer.URL+"/sso"),
+		LogoutURL:               mustParseURL(t, idpServer.URL+"/slo"),
+		ServiceProviderProvider: serviceProviders,
+		SessionProvider: &testSAMLSessions{
+			session: &saml.Session{
+				ID:             "session",
+				CreateTime:     time.Now(),
+				ExpireTime:     time.Now().Add(time.Hour),
+				Index:          "index-1",
+				NameID:         "jane@example.com",
+				NameIDFormat:   string(saml.EmailAddressNameIDFormat),
+				UserEmail:      "jane@example.com",
+				UserCommonName: "Jane Doe",
+				Groups:         []string{"admin", "staff"},
+			},
+		},
+	}
+	idpHandler = idp.Handler()
+
+	var router *mux.Router
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		router.ServeHTTP(w, r)
+	}))
+	t.Cleanup(node.Close)
+
+	cookie := securecookie.New(securecookie.GenerateRandomKey(32), securecookie.GenerateRandomKey(32))
+	handler, err := NewSAMLCookieHandler(SAMLConfig{
+		Provider: ProviderConfig{
+			ID:              "okta",
+			InsecureCookies: true,
+			Cookie:          cookie,
+			AuthTimeout:     time.Minute,
+		},
+		BaseURL:        node.URL,
+		IDPMetadataURL: idpServer.URL + "/metadata",
+		Certificate:    string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: spCert.Raw})),
+		PrivateKey:     string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(spKey)})),
+		SignRequests:   signRequests,
+		AttributeMapping: map[string]string{
+			"email": "eduPersonPrincipalName",
+			"name":  "urn:oid:2.5.4.3",
+		},
+		RolesAttribute:    "eduPersonAffiliation",
+		RedirectValidator: NewRedirectValidator([]string{node.URL + "/done"}, nil),
+	}, noopHooks{}, zap.NewNop())
+	require.NoError(t, err)
+
+	router = mux.NewRouter()
+	cookieRouter := router.PathPrefix("/auth/cookie").Subrouter()
+	handler.Register(cookieRouter.PathPrefix("/"+AuthorizePath).Subrouter(), cookieRouter.PathPrefix("/"+CallbackPath).Subrouter())
+	handler.RegisterServiceProvider(cookieRouter)
+
+	resp, err := http.Get(node.URL + "/auth/cookie/saml/okta/metadata")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	serviceProviders.metadata = &saml.EntityDescriptor{}
+	require.NoError(t, xml.NewDecoder(resp.Body).Decode(serviceProviders.metadata))
+
+	return &testSAMLEnvironment{
+		idp:      idp,
+		handler:  handler,
+		node:     node,
+		cookie:   cookie,
+		spCert:   spCert,
+		idpKey:   idpKey,
+		idpCert:  idpCert,
+		redirect: node.URL + "/done",
+	}
+}
+
+func mustParseURL(t *testing.T, rawURL string) url.URL {
+	u, err := url.Parse(rawURL)
+	require.NoError(t, err)
+	return *u
+}
+
+type nopSAMLLogger struct{}
+
+func (nopSAMLLogger) Printf(format string, v ...interface{}) {}
+func (nopSAMLLogger) Print(v ...interface{})                 {}
+func (nopSAMLLogger) Println(v ...interface{})               {}
+func (nopSAMLLogger) Fatal(v ...interface{})                 {}
+func (nopSAMLLogger) Fatalf(format string, v ...interface{}) {}
+func (nopSAMLLogger) Fatalln(v ...interface{})               {}
+func (nopSAMLLogger) Panic(v ...interface{})                 {}
+func (nopSAMLLogger) Panicf(format string, v ...interface{}) {}
+func (nopSAMLLogger) Panicln(v ...interface{})               {}
+
+var samlFormInput = regexp.MustCompile(`name="(SAMLResponse|RelayState)" value="([^"]*)"`)
+
+// login runs the authentication flow, returning the user cookie and the response
+// from the assertion consumer service
+func (e *testSAMLEnvironment) login(t *testing.T) (*testCookieJar, *http.Response) {
+	jar := newTestCookieJar()
+	client := &http.Client{Jar: jar}
+	resp, err := client.Get(e.node.URL + "/auth/cookie/authorize/okta?redirect_uri=" + url.QueryEscape(e.redirect))
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+
+	form := url.Values{}
+	for _, match := range samlFormInput.FindAllStringSubmatch(string(body), -1) {
+		form.Set(match[1], html.UnescapeString(match[2]))
+	}
+	require.NotEmpty(t, form.Get("SAMLResponse"))
+
+	resp, err = client.PostForm(e.node.URL+"/auth/cookie/callback/okta", form)
+	require.NoError(t, err)
+	t.Cleanup(func() { resp.Body.Close() })
+	return jar, resp
+}
+
+func TestSAMLCookieHandler(t *testing.T) {
+	for _, signRequests := range []bool{false, true} {
+		env := newTestSAMLEnvironment(t, signRequests)
+		jar, resp := env.login(t)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Contains(t, string(body), "window.location.replace")
+
+		var user User
+		userCookie := jar.cookie(userCookieName)
+		require.NotNil(t, userCookie, "user cookie should be set")
+		require.NoError(t, env.cookie.Decode(userCookieName, userCookie.Value, &user))
+		assert.Equal(t, "saml", user.ProviderName)
+		assert.Equal(t, "okta", user.ProviderID)
+		assert.Equal(t, "jane@example.com", user.UserID)
+		assert.Equal(t, "jane@example.com", user.Email)
+		assert.Equal(t, "Jane Doe", user.Name)
+		assert.Equal(t, []string{"admin", "staff"}, user.Roles)
+		assert.Equal(t, "jane@example.com", user.NameID)
+		assert.Equal(t, "index-1", user.SessionIndex)
+	}
+}
+
+func TestSAMLCookieHandlerRequiresRequestCookies(t *testing.T) {
+	env := newTestSAMLEnvironment(t, false)
+	// Without the request ID cookie the response can't be matched to the request
+	jar := newTestCookieJar()
+	client := &http.Client{Jar: jar}
+	resp, err := client.PostForm(env.node.URL+"/auth/cookie/callback/okta", url.Values{
+		"SAMLResponse": {base64.StdEncoding.EncodeToString([]byte("<Response/>"))},
+	})
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Nil(t, jar.cookie(userCookieName))
+}
+
+func inflateSAMLQuery(t *testing.T, u *url.URL, parameter string) []byte {
+	compressed, err := base64.StdEncoding.DecodeString(u.Query().Get(parameter))
+	require.NoError(t, err)
+	data, err := io.ReadAll(flate.NewReader(bytes.NewReader(compressed)))
+	require.NoError(t, err)
+	return data
+}
+
+func TestSAMLServiceProviderInitiatedLogout(t *testing.T) {
+	env := newTestSAMLEnvironment(t, true)
+	user := &User{
+		ProviderName: "saml",
+		ProviderID:   "okta",
+		NameID:       "jane@example.com",
+		NameIDFormat: string(saml.EmailAddressNameIDFormat),
+		SessionIndex: "index-1",
+	}
+	result, err := env.handler.Disconnect(context.Background(), user)
+	require.NoError(t, err)
+	require.True(t, result.RequiresClientCooperation())
+
+	redirect, err := url.Parse(result.Redirect)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(result.Redirect, env.idp.LogoutURL.String()))
+
+	// The query signature must verify with the service provider certificate
+	r := httptest.NewRequest(http.MethodGet, result.Redirect, nil)
+	msg, err := readSAMLRedirectMessage(r, []*x509.Certificate{env.spCert})
+	require.NoError(t, err)
+	assert.Equal(t, samlRequestParameter, msg.parameter)
+	_, err = readSAMLRedirectMessage(r, []*x509.Certificate{env.idpCert})
+	assert.Error(t, err)
+
+	var logoutRequest saml.LogoutRequest
+	require.NoError(t, xml.Unmarshal(inflateSAMLQuery(t, redirect, samlRequestParameter), &logoutRequest))
+	assert.Equal(t, "jane@example.com", logoutRequest.NameID.Value)
+	assert.Equal(t, "index-1", logoutRequest.SessionIndex.Value)
+
+	// The identity provider answers with a signed LogoutResponse
+	idpSigner := &SAMLCookieHandler{sp: &saml.ServiceProvider{
+		Key:             env.idpKey,
+		Certificate:     env.idpCert,
+		SignatureMethod: dsig.RSASHA256SignatureMethod,
+	}}
+	logoutResponse := &saml.LogoutResponse{
+		ID:           "id-response",
+		InResponseTo: logoutRequest.ID,
+		Version:      "2.0",
+		IssueInstant: saml.TimeNow(),
+		Destination:  env.node.URL + "/auth/cookie/saml/okta/logout",
+		Issuer:       &saml.Issuer{Value: env.idp.MetadataURL.String()},
+		Status:       saml.Status{StatusCode: saml.StatusCode{Value: saml.StatusSuccess}},
+	}
+	responseURL, err := idpSigner.redirectBindingURL(env.node.URL+"/auth/cookie/saml/okta/logout", samlResponseParameter, logoutResponse.Element(), env.redirect)
+	require.NoError(t, err)
+
+	client := &http.Client{CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+	resp, err := client.Get(responseURL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusFound, resp.StatusCode)
+	assert.Equal(t, env.redirect, resp.Header.Get("Location"))
+}
+
+func TestSAMLIdentityProviderInitiatedLogout(t *testing.T) {
+	env := newTestSAMLEnvironment(t, false)
+	idpSigner := &SAMLCookieHandler{sp: &saml.ServiceProvider{
+		Key:             env.idpKey,
+		Certificate:     env.idpCert,
+		SignatureMethod: dsig.RSASHA256SignatureMethod,
+	}}
+	logoutURL := env.node.URL + "/auth/cookie/saml/okta/logout"
+	logoutRequest := &saml.LogoutRequest{
+		ID:           "id-logout",
+		Version:      "2.0",
+		IssueInstant: saml.TimeNow(),
+		Destination:  logoutURL,
+		Issuer:       &saml.Issuer{Value: env.idp.MetadataURL.String()},
+		NameID:       &saml.NameID{Value: "jane@example.com"},
+	}
+	requestURL, err := idpSigner.redirectBindingURL(logoutURL, samlRequestParameter, logoutRequest.Element(), "relay")
+	require.NoError(t, err)
+
+	client := &http.Client{CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+	resp, err := client.Get(requestURL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+
+	var resetsUser bool
+	for _, c := range resp.Cookies() {
+		if c.Name == userCookieName && c.MaxAge < 0 {
+			resetsUser = true
+		}
+	}
+	assert.True(t, resetsUser, "user cookie should be deleted")
+
+	location, err := url.Parse(resp.Header.Get("Location"))
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(location.String(), env.idp.LogoutURL.String()))
+	assert.Equal(t, "relay", location.Query().Get("RelayState"))
+	var logoutResponse saml.LogoutResponse
+	require.NoError(t, xml.Unmarshal(inflateSAMLQuery(t, location, samlResponseParameter), &logoutResponse))
+	assert.Equal(t, "id-logout", logoutResponse.InResponseTo)
+	assert.Equal(t, saml.StatusSuccess, logoutResponse.Status.StatusCode.Value)
+
+	// Unsigned requests are rejected
+	unsigned := &SAMLCookieHandler{sp: &saml.ServiceProvider{}}
+	requestURL, err = unsigned.redirectBindingURL(logoutURL, samlRequestParameter, logoutRequest.Element(), "")
+	require.NoError(t, err)
+	resp, err = client.Get(requestURL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
@@ -5,15 +5,26 @@ import (
 	"bytes"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/eiannone/keyboard"
 	"github.com/fatih/color"
 	"github.com/mattn/go-isatty"
+
+	"github.com/wundergraph/wundergraph/pkg/hooks"
 )
 
 var (
 	colorGray      = color.New(color.FgHiBlack)
 	colorBoldWhite = color.New(color.FgHiWhite, color.Bold)
+	colorRed       = color.New(color.FgRed)
+)
+
+const (
+	// maxHookInvocations is the number of hook invocations printed
+	maxHookInvocations = 20
+	// maxHookResponseLength is the maximum length of a printed hook response
+	maxHookResponseLength = 120
 )
 
 type action struct {
@@ -26,6 +37,8 @@ type Handler interface {
 	ClearCache()
 	RebuildAndRestartApp()
 	Restart(debugMode bool)
+	// HookInvocations returns the latest hook invocations, oldest first
+	HookInvocations() []hooks.HookInvocation
 }
 
 type UI struct {
@@ -99,6 +112,9 @@ func (u *UI) printHelp() {
 		{
 			Key: "c", Help: "clear all caches",
 		},
+		{
+			Key: "i", Help: "inspect the latest hook invocations",
+		},
 	}
 	if u.opts.Debug {
 		actions = append(actions, action{
@@ -127,6 +143,42 @@ func (u *UI) printHelp() {
 	fmt.Fprint(os.Stderr, buf.String())
 }
 
+func (u *UI) printHookInvocations() {
+	if !u.enabled {
+		return
+	}
+	invocations := u.handler.HookInvocations()
+	if len(invocations) > maxHookInvocations {
+		invocations = invocations[len(invocations)-maxHookInvocations:]
+	}
+	var buf bytes.Buffer
+	if len(invocations) == 0 {
+		colorGray.Fprintln(&buf, "no hooks invoked yet")
+	}
+	for _, invocation := range invocations {
+		colorGray.Fprintf(&buf, "%s ", invocation.Time.Format("15:04:05.000"))
+		colorBoldWhite.Fprint(&buf, invocation.Path)
+		colorGray.Fprintf(&buf, " %s", invocation.Duration.Round(time.Microsecond))
+		if invocation.Retries > 0 {
+			colorGray.Fprintf(&buf, " (%d retries)", invocation.Retries)
+		}
+		if invocation.Async {
+			colorGray.Fprint(&buf, " async")
+		}
+		buf.WriteByte('\n')
+		if invocation.Error != "" {
+			colorRed.Fprintf(&buf, "  %s\n", invocation.Error)
+		} else if len(invocation.Response) > 0 {
+			response := string(invocation.Response)
+			if len(response) > maxHookResponseLength {
+				response = response[:maxHookResponseLength] + "..."
+			}
+			colorGray.Fprintf(&buf, "  %s\n", response)
+		}
+	}
+	fmt.Fprint(os.Stderr, buf.String())
+}
+
 // Run listens for keypresses and runs each respective actions, indefinitely
 func (u *UI) Run() {
 	for {
@@ -146,6 +198,8 @@ func (u *UI) Run() {
 				u.handler.RebuildAndRestartApp()
 			case 'c':
 				u.handler.ClearCache()
+			case 'i':
+				u.printHookInvocations()
 			case 'd':
 				u.handler.Restart(!u.opts.Debug)
 			case 'q', 'Q':
@@ -0,0 +1,120 @@
+// Package webhookevents implements a data source streaming the payloads
+// received by the webhooks to the subscriptions
+package webhookevents
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+
+	"github.com/wundergraph/graphql-go-tools/pkg/engine/plan"
+	"github.com/wundergraph/graphql-go-tools/pkg/engine/resolve"
+
+	"github.com/wundergraph/wundergraph/pkg/eventbus"
+)
+
+var errSubscriptionOnly = errors.New("webhook events can only be used in subscriptions")
+
+type Configuration struct {
+	Webhook string
+}
+
+func ConfigJson(config Configuration) json.RawMessage {
+	out, _ := json.Marshal(config)
+	return out
+}
+
+type Factory struct {
+	Bus *eventbus.Bus
+}
+
+func (f *Factory) Planner(ctx context.Context) plan.DataSourcePlanner {
+	return &Planner{
+		bus: f.Bus,
+	}
+}
+
+type Planner struct {
+	bus    *eventbus.Bus
+	config Configuration
+}
+
+func (p *Planner) Register(visitor *plan.Visitor, configuration plan.DataSourceConfiguration, isNested bool) error {
+	return json.Unmarshal(configuration.Custom, &p.config)
+}
+
+func (p *Planner) source() *Source {
+	return &Source{
+		bus:   p.bus,
+		topic: eventbus.WebhookTopic(p.config.Webhook),
+	}
+}
+
+func (p *Planner) ConfigureFetch() plan.FetchConfiguration {
+	return plan.FetchConfiguration{
+		Input:                "{}",
+		DataSource:           p.source(),
+		DisallowSingleFlight: true,
+		DisableDataLoader:    true,
+	}
+}
+
+func (p *Planner) ConfigureSubscription() plan.SubscriptionConfiguration {
+	return plan.SubscriptionConfiguration{
+		Input:      "{}",
+		DataSource: p.source(),
+	}
+}
+
+func (p *Planner) DataSourcePlanningBehavior() plan.DataSourcePlanningBehavior {
+	return plan.DataSourcePlanningBehavior{
+		MergeAliasedRootNodes:      false,
+		OverrideFieldPathFromAlias: false,
+		IncludeTypeNameFields:      false,
+	}
+}
+
+func (p *Planner) DownstreamResponseFieldAlias(downstreamFieldRef int) (alias string, exists bool) {
+	return "", false
+}
+
+// Source sends each payload published by the webhook as the value of
+// the subscribed field
+type Source struct {
+	bus   *eventbus.Bus
+	topic string
+}
+
+func (s *Source) Start(ctx context.Context, input []byte, next chan<- []byte) error {
+	if s.bus == nil {
+		return errors.New("webhook events are not available")
+	}
+	events, unsubscribe := s.bus.Subscribe(s.topic)
+	go func() {
+		defer unsubscribe()
+		done := ctx.Done()
+		for {
+			select {
+			case <-done:
+				return
+			case event := <-events:
+				select {
+				case next <- event:
+				case <-done:
+					return
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+func (s *Source) Load(ctx context.Context, input []byte, w io.Writer) error {
+	return errSubscriptionOnly
+}
+
+var (
+	_ resolve.SubscriptionDataSource = (*Source)(nil)
+	_ resolve.DataSource             = (*Source)(nil)
+)
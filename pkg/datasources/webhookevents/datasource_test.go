@@ -0,0 +1,43 @@
+package webhookevents
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wundergraph/graphql-go-tools/pkg/engine/plan"
+
+	"github.com/wundergraph/wundergraph/pkg/eventbus"
+)
+
+func TestSubscription(t *testing.T) {
+	bus := eventbus.New()
+	factory := &Factory{Bus: bus}
+	planner := factory.Planner(context.Background())
+	err := planner.Register(nil, plan.DataSourceConfiguration{
+		Custom: ConfigJson(Configuration{Webhook: "github"}),
+	}, false)
+	require.NoError(t, err)
+	source := planner.ConfigureSubscription().DataSource
+
+	ctx, cancel := context.WithCancel(context.Background())
+	next := make(chan []byte)
+	require.NoError(t, source.Start(ctx, []byte(`{}`), next))
+
+	topic := eventbus.WebhookTopic("github")
+	assert.Equal(t, 0, bus.Publish(eventbus.WebhookTopic("stripe"), []byte(`{}`)))
+	assert.Equal(t, 1, bus.Publish(topic, []byte(`{"action":"opened"}`)))
+	assert.Equal(t, `{"action":"opened"}`, string(<-next))
+
+	// Unsubscribes once the subscription ends
+	cancel()
+	assert.Eventually(t, func() bool {
+		return bus.Publish(topic, []byte(`{}`)) == 0
+	}, time.Second, 10*time.Millisecond)
+
+	err = planner.ConfigureFetch().DataSource.Load(context.Background(), []byte(`{}`), nil)
+	assert.ErrorIs(t, err, errSubscriptionOnly)
+}
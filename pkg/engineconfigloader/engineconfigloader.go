@@ -26,6 +26,8 @@ import (
 	"github.com/wundergraph/wundergraph/pkg/datasources/database"
 	"github.com/wundergraph/wundergraph/pkg/datasources/nats"
 	oas_datasource "github.com/wundergraph/wundergraph/pkg/datasources/oas"
+	"github.com/wundergraph/wundergraph/pkg/datasources/webhookevents"
+	"github.com/wundergraph/wundergraph/pkg/eventbus"
 	"github.com/wundergraph/wundergraph/pkg/hooks"
 	"github.com/wundergraph/wundergraph/pkg/loadvariable"
 	"github.com/wundergraph/wundergraph/pkg/pool"
@@ -62,13 +64,14 @@ type DefaultFactoryResolver struct {
 	rest             *oas_datasource.Factory
 	static           *staticdatasource.Factory
 	natsKv           *nats.Factory
+	webhookEvents    *webhookevents.Factory
 	database         *database.Factory
 	hooksClient      *hooks.Client
 	log              *zap.Logger
 }
 
 func NewDefaultFactoryResolver(transportFactory ApiTransportFactory, baseTransport *http.Transport,
-	log *zap.Logger, hooksClient *hooks.Client) *DefaultFactoryResolver {
+	log *zap.Logger, hooksClient *hooks.Client, eventBus *eventbus.Bus) *DefaultFactoryResolver {
 
 	defaultHttpClient := &http.Client{
 		Timeout: transportFactory.DefaultTransportTimeout(),
@@ -98,9 +101,10 @@ func NewDefaultFactoryResolver(transportFactory ApiTransportFactory, baseTranspo
 			Client: defaultHttpClient,
 			Log:    log,
 		},
-		natsKv:      &nats.Factory{},
-		hooksClient: hooksClient,
-		log:         log,
+		natsKv:        &nats.Factory{},
+		webhookEvents: &webhookevents.Factory{Bus: eventBus},
+		hooksClient:   hooksClient,
+		log:           log,
 	}
 }
 
@@ -304,6 +308,8 @@ func (d *DefaultFactoryResolver) Resolve(ds *wgpb.DataSourceConfiguration) (plan
 		return d.static, nil
 	case wgpb.DataSourceKind_NATSKV:
 		return d.natsKv, nil
+	case wgpb.DataSourceKind_WEBHOOK_EVENTS:
+		return d.webhookEvents, nil
 	case wgpb.DataSourceKind_POSTGRESQL,
 		wgpb.DataSourceKind_MYSQL,
 		wgpb.DataSourceKind_SQLSERVER,
@@ -537,6 +543,10 @@ func (l *EngineConfigLoader) Load(engineConfig *wgpb.EngineConfiguration, wgServ
 				Token:     in.CustomNatsKv.GetToken(),
 			}
 			out.Custom = nats.ConfigJson(config)
+		case wgpb.DataSourceKind_WEBHOOK_EVENTS:
+			out.Custom = webhookevents.ConfigJson(webhookevents.Configuration{
+				Webhook: in.CustomWebhookEvents.GetWebhook(),
+			})
 		case wgpb.DataSourceKind_POSTGRESQL,
 			wgpb.DataSourceKind_MYSQL,
 			wgpb.DataSourceKind_SQLSERVER,
@@ -671,7 +681,8 @@ func dataSourceUsesHTTPProxy(ds *wgpb.DataSourceConfiguration) bool {
 		wgpb.DataSourceKind_SQLSERVER,
 		wgpb.DataSourceKind_MONGODB,
 		wgpb.DataSourceKind_SQLITE,
-		wgpb.DataSourceKind_PRISMA:
+		wgpb.DataSourceKind_PRISMA,
+		wgpb.DataSourceKind_WEBHOOK_EVENTS:
 		return false
 	}
 	panic("unhandled data source kind")
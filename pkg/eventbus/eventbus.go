@@ -0,0 +1,66 @@
+// Package eventbus implements an in-memory publish/subscribe bus, used
+// to forward events between the components of a node
+package eventbus
+
+import (
+	"sync"
+)
+
+// subscriberBufferSize is the number of events buffered for each
+// subscriber. Events published while the buffer is full are dropped.
+const subscriberBufferSize = 64
+
+// WebhookTopic returns the topic where the webhook publishes its payloads
+func WebhookTopic(webhook string) string {
+	return "webhooks." + webhook
+}
+
+type Bus struct {
+	mu          sync.RWMutex
+	subscribers map[string]map[chan []byte]struct{}
+}
+
+func New() *Bus {
+	return &Bus{
+		subscribers: make(map[string]map[chan []byte]struct{}),
+	}
+}
+
+// Publish sends the event to the current subscribers of the topic without
+// blocking, returning how many received it. Subscribers must not modify the event.
+func (b *Bus) Publish(topic string, event []byte) int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	received := 0
+	for ch := range b.subscribers[topic] {
+		select {
+		case ch <- event:
+			received++
+		default:
+		}
+	}
+	return received
+}
+
+// Subscribe returns a channel receiving the events published to the topic
+// until unsubscribe is called
+func (b *Bus) Subscribe(topic string) (events <-chan []byte, unsubscribe func()) {
+	ch := make(chan []byte, subscriberBufferSize)
+	b.mu.Lock()
+	if b.subscribers[topic] == nil {
+		b.subscribers[topic] = make(map[chan []byte]struct{})
+	}
+	b.subscribers[topic][ch] = struct{}{}
+	b.mu.Unlock()
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			delete(b.subscribers[topic], ch)
+			if len(b.subscribers[topic]) == 0 {
+				delete(b.subscribers, topic)
+			}
+		})
+	}
+}
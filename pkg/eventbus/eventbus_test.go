@@ -0,0 +1,42 @@
+package eventbus_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wundergraph/wundergraph/pkg/eventbus"
+)
+
+func TestBus(t *testing.T) {
+	bus := eventbus.New()
+	first, unsubscribeFirst := bus.Subscribe("a")
+	second, unsubscribeSecond := bus.Subscribe("a")
+	defer unsubscribeSecond()
+	other, unsubscribeOther := bus.Subscribe("b")
+	defer unsubscribeOther()
+
+	assert.Equal(t, 2, bus.Publish("a", []byte("1")))
+	assert.Equal(t, []byte("1"), <-first)
+	assert.Equal(t, []byte("1"), <-second)
+	assert.Empty(t, other)
+
+	unsubscribeFirst()
+	// Calling it again is a no-op
+	unsubscribeFirst()
+	assert.Equal(t, 1, bus.Publish("a", []byte("2")))
+	assert.Equal(t, []byte("2"), <-second)
+	assert.Equal(t, 0, bus.Publish("c", []byte("3")))
+}
+
+func TestBusDropsEventsForSlowSubscribers(t *testing.T) {
+	bus := eventbus.New()
+	events, unsubscribe := bus.Subscribe("a")
+	defer unsubscribe()
+	published := 0
+	for ii := 0; ii < 100; ii++ {
+		published += bus.Publish("a", []byte("event"))
+	}
+	assert.Less(t, published, 100)
+	assert.Equal(t, published, len(events))
+}
@@ -0,0 +1,57 @@
+package eventwebhooks
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+// Mount registers the endpoints for querying the deliveries and
+// redelivering them in the router
+func (d *Dispatcher) Mount(router *mux.Router) {
+	router.Path("/events/deliveries").Methods(http.MethodGet).HandlerFunc(d.listDeliveries)
+	router.Path("/events/deliveries/{id}").Methods(http.MethodGet).HandlerFunc(d.getDelivery)
+	router.Path("/events/deliveries/{id}/redeliver").Methods(http.MethodPost).HandlerFunc(d.redeliver)
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, ErrDeliveryNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, ErrDeliveryPending):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, ErrTooManyPending):
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func (d *Dispatcher) listDeliveries(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	writeJSON(w, d.Deliveries(query.Get("webhook"), DeliveryStatus(query.Get("status"))))
+}
+
+func (d *Dispatcher) getDelivery(w http.ResponseWriter, r *http.Request) {
+	delivery, err := d.Delivery(mux.Vars(r)["id"])
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, delivery)
+}
+
+func (d *Dispatcher) redeliver(w http.ResponseWriter, r *http.Request) {
+	if err := d.Redeliver(mux.Vars(r)["id"]); err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
@@ -0,0 +1,522 @@
+// Package eventwebhooks notifies external systems about the mutations
+// executed by the node, using signed webhooks
+package eventwebhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tidwall/gjson"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	otrace "go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/wundergraph/wundergraph/pkg/loadvariable"
+	"github.com/wundergraph/wundergraph/pkg/trace"
+	"github.com/wundergraph/wundergraph/pkg/wgpb"
+)
+
+const (
+	// EventType is the type of the events sent after mutations
+	EventType = "mutation.completed"
+
+	defaultMaxDeliveryAttempts = 10
+	defaultMinBackoff          = time.Second
+	defaultMaxBackoff          = 5 * time.Minute
+	defaultHistorySize         = 1000
+	defaultMaxPending          = 10000
+	deliveryTimeout            = 30 * time.Second
+	// deliveryWorkers is the number of deliveries sent concurrently
+	deliveryWorkers = 16
+)
+
+var (
+	ErrDeliveryNotFound = errors.New("delivery not found")
+	// ErrDeliveryPending is returned when redelivering an event that's
+	// still being delivered
+	ErrDeliveryPending = errors.New("delivery is pending")
+	// ErrTooManyPending is returned when the maximum number of pending
+	// deliveries has been reached
+	ErrTooManyPending = errors.New("too many pending deliveries")
+)
+
+type DeliveryStatus string
+
+const (
+	DeliveryPending   DeliveryStatus = "pending"
+	DeliveryDelivered DeliveryStatus = "delivered"
+	DeliveryFailed    DeliveryStatus = "failed"
+)
+
+type Attempt struct {
+	At         time.Time `json:"at"`
+	StatusCode int       `json:"statusCode,omitempty"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// Delivery is an event sent to a webhook, including all its attempts
+type Delivery struct {
+	ID          string          `json:"id"`
+	Webhook     string          `json:"webhook"`
+	Operation   string          `json:"operation"`
+	Status      DeliveryStatus  `json:"status"`
+	CreatedAt   time.Time       `json:"createdAt"`
+	Attempts    []Attempt       `json:"attempts"`
+	NextAttempt *time.Time      `json:"nextAttempt,omitempty"`
+	Payload     json.RawMessage `json:"payload"`
+	webhook     *webhook
+	// attemptsLeft before failing, reset when the event is redelivered
+	attemptsLeft int
+}
+
+type webhook struct {
+	name        string
+	url         string
+	secret      []byte
+	operations  map[string]bool
+	filter      string
+	maxAttempts int
+}
+
+func (w *webhook) matches(operationName string, response []byte) bool {
+	if len(w.operations) > 0 && !w.operations[operationName] {
+		return false
+	}
+	if w.filter == "" {
+		return true
+	}
+	result := gjson.GetBytes(response, w.filter)
+	return result.Exists() && result.Type != gjson.Null && result.Type != gjson.False
+}
+
+func newWebhook(config *wgpb.EventWebhookConfiguration) (*webhook, error) {
+	if config.Name == "" {
+		return nil, errors.New("event webhook without name")
+	}
+	targetURL := loadvariable.String(config.Url)
+	if u, err := url.Parse(targetURL); err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("invalid URL %q for event webhook %s", targetURL, config.Name)
+	}
+	w := &webhook{
+		name:        config.Name,
+		url:         targetURL,
+		filter:      config.ResponseFilter,
+		maxAttempts: int(config.MaxDeliveryAttempts),
+	}
+	if secret := loadvariable.String(config.Secret); secret != "" {
+		if encoded, ok := strings.CutPrefix(secret, "whsec_"); ok {
+			key, err := base64.StdEncoding.DecodeString(encoded)
+			if err != nil {
+				return nil, fmt.Errorf("invalid secret for event webhook %s: %w", config.Name, err)
+			}
+			w.secret = key
+		} else {
+			w.secret = []byte(secret)
+		}
+	}
+	if len(config.Operations) > 0 {
+		w.operations = make(map[string]bool, len(config.Operations))
+		for _, name := range config.Operations {
+			w.operations[name] = true
+		}
+	}
+	if w.maxAttempts <= 0 {
+		w.maxAttempts = defaultMaxDeliveryAttempts
+	}
+	return w, nil
+}
+
+type Options struct {
+	Webhooks []*wgpb.EventWebhookConfiguration
+	// Backoff between attempts, doubling after each one. Default
+	// to 1 second and 5 minutes.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// Number of finished deliveries kept for querying their status,
+	// defaults to 1000
+	HistorySize int
+	// Maximum number of pending deliveries, including the ones waiting
+	// to be retried. Events exceeding it are dropped. Defaults to 10000.
+	MaxPendingDeliveries int
+	Logger               *zap.Logger
+}
+
+// Dispatcher sends the events to the webhooks in the background, using a
+// fixed number of workers and retrying them with exponential backoff.
+// Deliveries are kept in memory, so pending ones are lost when the node stops.
+type Dispatcher struct {
+	webhooks    []*webhook
+	client      *http.Client
+	minBackoff  time.Duration
+	maxBackoff  time.Duration
+	historySize int
+	maxPending  int
+	log         *zap.Logger
+	ctx         context.Context
+	cancel      context.CancelFunc
+	wg          sync.WaitGroup
+	// queue contains the deliveries ready for their next attempt, it can
+	// hold all the pending ones so sending to it never blocks
+	queue chan *Delivery
+
+	mu         sync.Mutex
+	deliveries map[string]*Delivery
+	// order contains the delivery IDs, oldest first
+	order   []string
+	pending int
+}
+
+// New returns a Dispatcher for the given webhooks
+func New(opts Options) (*Dispatcher, error) {
+	d := &Dispatcher{
+		client: &http.Client{
+			Timeout: deliveryTimeout,
+			Transport: trace.NewTransport(http.DefaultTransport,
+				otelhttp.WithSpanOptions(otrace.WithAttributes(trace.EventWebhookTransportAttribute)),
+			),
+		},
+		minBackoff:  opts.MinBackoff,
+		maxBackoff:  opts.MaxBackoff,
+		historySize: opts.HistorySize,
+		maxPending:  opts.MaxPendingDeliveries,
+		log:         opts.Logger,
+		deliveries:  make(map[string]*Delivery),
+	}
+	names := make(map[string]bool, len(opts.Webhooks))
+	for _, config := range opts.Webhooks {
+		w, err := newWebhook(config)
+		if err != nil {
+			return nil, err
+		}
+		if names[w.name] {
+			return nil, fmt.Errorf("duplicate event webhook %s", w.name)
+		}
+		names[w.name] = true
+		d.webhooks = append(d.webhooks, w)
+	}
+	if d.minBackoff <= 0 {
+		d.minBackoff = defaultMinBackoff
+	}
+	if d.maxBackoff <= 0 {
+		d.maxBackoff = defaultMaxBackoff
+	}
+	if d.historySize <= 0 {
+		d.historySize = defaultHistorySize
+	}
+	if d.maxPending <= 0 {
+		d.maxPending = defaultMaxPending
+	}
+	if d.log == nil {
+		d.log = zap.NewNop()
+	}
+	d.queue = make(chan *Delivery, d.maxPending)
+	d.ctx, d.cancel = context.WithCancel(context.Background())
+	for ii := 0; ii < deliveryWorkers; ii++ {
+		d.wg.Add(1)
+		go d.worker()
+	}
+	return d, nil
+}
+
+// HasWebhooks returns true if any webhook might be triggered by the operation
+func (d *Dispatcher) HasWebhooks(operationName string) bool {
+	if d == nil {
+		return false
+	}
+	for _, w := range d.webhooks {
+		if len(w.operations) == 0 || w.operations[operationName] {
+			return true
+		}
+	}
+	return false
+}
+
+type eventData struct {
+	Operation string          `json:"operation"`
+	Input     json.RawMessage `json:"input"`
+	Result    json.RawMessage `json:"result"`
+}
+
+type event struct {
+	Type      string    `json:"type"`
+	Timestamp time.Time `json:"timestamp"`
+	Data      eventData `json:"data"`
+}
+
+// Dispatch sends an event to the webhooks matching the mutation. Responses
+// with errors are ignored.
+func (d *Dispatcher) Dispatch(operationName string, input []byte, response []byte) {
+	if errs := gjson.GetBytes(response, "errors"); errs.IsArray() && len(errs.Array()) > 0 {
+		return
+	}
+	var payload []byte
+	for _, w := range d.webhooks {
+		if !w.matches(operationName, response) {
+			continue
+		}
+		if payload == nil {
+			var err error
+			payload, err = d.payload(operationName, input, response)
+			if err != nil {
+				d.log.Error("encoding event", zap.String("operation", operationName), zap.Error(err))
+				return
+			}
+		}
+		delivery, err := d.add(w, operationName, payload)
+		if err != nil {
+			d.log.Error("dropping event delivery", zap.String("webhook", w.name), zap.String("operation", operationName), zap.Error(err))
+			continue
+		}
+		d.queue <- delivery
+	}
+}
+
+func (d *Dispatcher) payload(operationName string, input []byte, response []byte) ([]byte, error) {
+	if len(input) == 0 {
+		input = []byte("{}")
+	}
+	result := json.RawMessage("null")
+	if data := gjson.GetBytes(response, "data"); data.Exists() {
+		result = json.RawMessage(data.Raw)
+	}
+	return json.Marshal(event{
+		Type:      EventType,
+		Timestamp: time.Now().UTC(),
+		Data: eventData{
+			Operation: operationName,
+			Input:     input,
+			Result:    result,
+		},
+	})
+}
+
+func (d *Dispatcher) add(w *webhook, operationName string, payload []byte) (*Delivery, error) {
+	var id [16]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return nil, err
+	}
+	delivery := &Delivery{
+		ID:           "msg_" + hex.EncodeToString(id[:]),
+		Webhook:      w.name,
+		Operation:    operationName,
+		Status:       DeliveryPending,
+		CreatedAt:    time.Now(),
+		Payload:      payload,
+		webhook:      w,
+		attemptsLeft: w.maxAttempts,
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.pending >= d.maxPending {
+		return nil, ErrTooManyPending
+	}
+	d.pending++
+	d.deliveries[delivery.ID] = delivery
+	d.order = append(d.order, delivery.ID)
+	d.trimHistory()
+	return delivery, nil
+}
+
+// trimHistory removes the oldest finished deliveries exceeding the history
+// size. Pending ones are kept, their number is limited by d.maxPending.
+// Must be called with d.mu held.
+func (d *Dispatcher) trimHistory() {
+	excess := len(d.order) - d.historySize
+	if excess <= 0 {
+		return
+	}
+	kept := d.order[:0]
+	for _, id := range d.order {
+		if excess > 0 && d.deliveries[id].Status != DeliveryPending {
+			delete(d.deliveries, id)
+			excess--
+			continue
+		}
+		kept = append(kept, id)
+	}
+	d.order = kept
+}
+
+func (d *Dispatcher) worker() {
+	defer d.wg.Done()
+	for {
+		select {
+		case delivery := <-d.queue:
+			d.deliver(delivery)
+		case <-d.ctx.Done():
+			return
+		}
+	}
+}
+
+// deliver runs an attempt of the delivery, scheduling the next one if it
+// fails and can be retried
+func (d *Dispatcher) deliver(delivery *Delivery) {
+	attempt, retryable := d.attempt(delivery)
+	if d.ctx.Err() != nil {
+		return
+	}
+	log := d.log.With(zap.String("webhook", delivery.Webhook), zap.String("delivery", delivery.ID))
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delivery.Attempts = append(delivery.Attempts, attempt)
+	delivery.attemptsLeft--
+	switch {
+	case attempt.Error == "":
+		delivery.Status = DeliveryDelivered
+		delivery.NextAttempt = nil
+	case !retryable || delivery.attemptsLeft <= 0:
+		delivery.Status = DeliveryFailed
+		delivery.NextAttempt = nil
+		log.Error("event delivery failed", zap.Int("attempts", len(delivery.Attempts)), zap.String("error", attempt.Error))
+	default:
+		delay := d.backoff(delivery.webhook.maxAttempts - delivery.attemptsLeft)
+		next := time.Now().Add(delay)
+		delivery.NextAttempt = &next
+		log.Warn("event delivery failed, retrying", zap.Duration("delay", delay), zap.String("error", attempt.Error))
+		time.AfterFunc(delay, func() {
+			if d.ctx.Err() == nil {
+				d.queue <- delivery
+			}
+		})
+		return
+	}
+	d.pending--
+	d.trimHistory()
+}
+
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	delay := float64(d.minBackoff) * math.Pow(2, float64(attempts-1))
+	if delay > float64(d.maxBackoff) {
+		return d.maxBackoff
+	}
+	return time.Duration(delay)
+}
+
+// sign returns the Standard Webhooks signature of the payload
+func sign(secret []byte, id string, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write([]byte(id + "." + timestamp + "."))
+	_, _ = mac.Write(payload)
+	return "v1," + base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// attempt sends the delivery once, returning whether it should be retried
+// if it fails
+func (d *Dispatcher) attempt(delivery *Delivery) (attempt Attempt, retryable bool) {
+	attempt.At = time.Now()
+	req, err := http.NewRequestWithContext(d.ctx, http.MethodPost, delivery.webhook.url, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		attempt.Error = err.Error()
+		return attempt, false
+	}
+	// Signed on each attempt, so the timestamp is always recent
+	timestamp := strconv.FormatInt(attempt.At.Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("webhook-id", delivery.ID)
+	req.Header.Set("webhook-timestamp", timestamp)
+	if len(delivery.webhook.secret) > 0 {
+		req.Header.Set("webhook-signature", sign(delivery.webhook.secret, delivery.ID, timestamp, delivery.Payload))
+	}
+	resp, err := d.client.Do(req)
+	if err != nil {
+		attempt.Error = err.Error()
+		return attempt, true
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	attempt.StatusCode = resp.StatusCode
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return attempt, false
+	}
+	attempt.Error = fmt.Sprintf("endpoint returned status %d", resp.StatusCode)
+	retryable = resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusRequestTimeout
+	return attempt, retryable
+}
+
+// snapshot returns a copy of the delivery that can be used without holding
+// d.mu. Must be called with d.mu held.
+func snapshot(delivery *Delivery) *Delivery {
+	c := *delivery
+	c.Attempts = append([]Attempt(nil), delivery.Attempts...)
+	if delivery.NextAttempt != nil {
+		next := *delivery.NextAttempt
+		c.NextAttempt = &next
+	}
+	return &c
+}
+
+// Deliveries returns the deliveries, oldest first, optionally filtered by
+// webhook name and status
+func (d *Dispatcher) Deliveries(webhook string, status DeliveryStatus) []*Delivery {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	deliveries := make([]*Delivery, 0, len(d.order))
+	for _, id := range d.order {
+		delivery := d.deliveries[id]
+		if (webhook != "" && delivery.Webhook != webhook) || (status != "" && delivery.Status != status) {
+			continue
+		}
+		deliveries = append(deliveries, snapshot(delivery))
+	}
+	return deliveries
+}
+
+func (d *Dispatcher) Delivery(id string) (*Delivery, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delivery, ok := d.deliveries[id]
+	if !ok {
+		return nil, ErrDeliveryNotFound
+	}
+	return snapshot(delivery), nil
+}
+
+// Redeliver sends a finished delivery again, with the same ID and payload,
+// resetting its remaining attempts
+func (d *Dispatcher) Redeliver(id string) error {
+	d.mu.Lock()
+	delivery, ok := d.deliveries[id]
+	if !ok {
+		d.mu.Unlock()
+		return ErrDeliveryNotFound
+	}
+	if delivery.Status == DeliveryPending {
+		d.mu.Unlock()
+		return ErrDeliveryPending
+	}
+	if d.pending >= d.maxPending {
+		d.mu.Unlock()
+		return ErrTooManyPending
+	}
+	d.pending++
+	delivery.Status = DeliveryPending
+	delivery.attemptsLeft = delivery.webhook.maxAttempts
+	d.mu.Unlock()
+	d.queue <- delivery
+	return nil
+}
+
+// Close stops sending the events, abandoning the pending deliveries
+func (d *Dispatcher) Close() error {
+	d.cancel()
+	d.wg.Wait()
+	return nil
+}
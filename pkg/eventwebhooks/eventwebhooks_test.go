@@ -0,0 +1,278 @@
+package eventwebhooks_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/wundergraph/wundergraph/pkg/eventwebhooks"
+	"github.com/wundergraph/wundergraph/pkg/webhookhandler"
+	"github.com/wundergraph/wundergraph/pkg/wgpb"
+)
+
+var testSecret = "whsec_" + base64.StdEncoding.EncodeToString([]byte("0123456789abcdef"))
+
+func staticVariable(value string) *wgpb.ConfigurationVariable {
+	return &wgpb.ConfigurationVariable{
+		Kind:                  wgpb.ConfigurationVariableKind_STATIC_CONFIGURATION_VARIABLE,
+		StaticVariableContent: value,
+	}
+}
+
+// receiver verifies the events with the Svix webhook verifier, responding
+// with the given status once it accepts them
+type receiver struct {
+	mu     sync.Mutex
+	status int
+	events []json.RawMessage
+}
+
+func newReceiver(t *testing.T) (*receiver, string) {
+	rec := &receiver{status: http.StatusOK}
+	hooksServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		rec.mu.Lock()
+		defer rec.mu.Unlock()
+		if rec.status == http.StatusOK {
+			rec.events = append(rec.events, body)
+		}
+		w.WriteHeader(rec.status)
+	}))
+	t.Cleanup(hooksServer.Close)
+	handler, err := webhookhandler.New(&wgpb.WebhookConfiguration{
+		Name: "events",
+		Verifier: &wgpb.WebhookVerifier{
+			Kind:   wgpb.WebhookVerifierKind_SVIX,
+			Secret: staticVariable(testSecret),
+		},
+	}, hooksServer.URL, nil, nil, nil, zap.NewNop())
+	require.NoError(t, err)
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	return rec, srv.URL + "/webhooks/events"
+}
+
+func (r *receiver) setStatus(status int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.status = status
+}
+
+func (r *receiver) received() []json.RawMessage {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]json.RawMessage(nil), r.events...)
+}
+
+func newDispatcher(t *testing.T, webhooks ...*wgpb.EventWebhookConfiguration) *eventwebhooks.Dispatcher {
+	d, err := eventwebhooks.New(eventwebhooks.Options{
+		Webhooks:   webhooks,
+		MinBackoff: 10 * time.Millisecond,
+		MaxBackoff: 20 * time.Millisecond,
+		Logger:     zap.NewNop(),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = d.Close() })
+	return d
+}
+
+func waitForStatus(t *testing.T, d *eventwebhooks.Dispatcher, status eventwebhooks.DeliveryStatus, count int) []*eventwebhooks.Delivery {
+	var deliveries []*eventwebhooks.Delivery
+	require.Eventually(t, func() bool {
+		deliveries = d.Deliveries("", status)
+		return len(deliveries) == count
+	}, 5*time.Second, 10*time.Millisecond)
+	return deliveries
+}
+
+func TestDispatch(t *testing.T) {
+	rec, url := newReceiver(t)
+	d := newDispatcher(t,
+		&wgpb.EventWebhookConfiguration{
+			Name:       "users",
+			Url:        staticVariable(url),
+			Secret:     staticVariable(testSecret),
+			Operations: []string{"CreateUser"},
+		},
+		&wgpb.EventWebhookConfiguration{
+			Name:           "paid",
+			Url:            staticVariable(url),
+			Secret:         staticVariable(testSecret),
+			ResponseFilter: `data.updateOrder.paid`,
+		},
+	)
+	assert.True(t, d.HasWebhooks("CreateUser"))
+	assert.True(t, d.HasWebhooks("UpdateOrder"))
+
+	d.Dispatch("CreateUser", []byte(`{"name":"Jens"}`), []byte(`{"data":{"createUser":{"id":1}}}`))
+	d.Dispatch("UpdateOrder", []byte(`{}`), []byte(`{"data":{"updateOrder":{"paid":false}}}`))
+	d.Dispatch("CreateUser", []byte(`{}`), []byte(`{"errors":[{"message":"failed"}],"data":null}`))
+	d.Dispatch("UpdateOrder", []byte(`{}`), []byte(`{"data":{"updateOrder":{"paid":true}}}`))
+
+	delivered := waitForStatus(t, d, eventwebhooks.DeliveryDelivered, 2)
+	assert.Equal(t, "users", delivered[0].Webhook)
+	assert.Equal(t, "CreateUser", delivered[0].Operation)
+	assert.Equal(t, "paid", delivered[1].Webhook)
+	assert.Len(t, delivered[0].Attempts, 1)
+	assert.Equal(t, http.StatusOK, delivered[0].Attempts[0].StatusCode)
+
+	events := rec.received()
+	require.Len(t, events, 2)
+	var event struct {
+		Type string `json:"type"`
+		Data struct {
+			Operation string          `json:"operation"`
+			Input     json.RawMessage `json:"input"`
+			Result    json.RawMessage `json:"result"`
+		} `json:"data"`
+	}
+	// Deliveries are concurrent, so they can arrive in any order
+	require.NoError(t, json.Unmarshal(events[0], &event))
+	if event.Data.Operation != "CreateUser" {
+		require.NoError(t, json.Unmarshal(events[1], &event))
+	}
+	assert.Equal(t, eventwebhooks.EventType, event.Type)
+	assert.Equal(t, "CreateUser", event.Data.Operation)
+	assert.JSONEq(t, `{"name":"Jens"}`, string(event.Data.Input))
+	assert.JSONEq(t, `{"createUser":{"id":1}}`, string(event.Data.Result))
+}
+
+func TestRetries(t *testing.T) {
+	rec, url := newReceiver(t)
+	rec.setStatus(http.StatusServiceUnavailable)
+	d := newDispatcher(t, &wgpb.EventWebhookConfiguration{
+		Name:                "test",
+		Url:                 staticVariable(url),
+		Secret:              staticVariable(testSecret),
+		MaxDeliveryAttempts: 3,
+	})
+
+	d.Dispatch("CreateUser", nil, []byte(`{"data":{}}`))
+	failed := waitForStatus(t, d, eventwebhooks.DeliveryFailed, 1)
+	require.Len(t, failed[0].Attempts, 3)
+	assert.Equal(t, http.StatusServiceUnavailable, failed[0].Attempts[2].StatusCode)
+	assert.Nil(t, failed[0].NextAttempt)
+
+	// Client errors aren't retried
+	rec.setStatus(http.StatusBadRequest)
+	d.Dispatch("CreateUser", nil, []byte(`{"data":{}}`))
+	failed = waitForStatus(t, d, eventwebhooks.DeliveryFailed, 2)
+	assert.Len(t, failed[1].Attempts, 1)
+
+	// A different secret is rejected by the verifier
+	other := newDispatcher(t, &wgpb.EventWebhookConfiguration{
+		Name:   "test",
+		Url:    staticVariable(url),
+		Secret: staticVariable("whsec_" + base64.StdEncoding.EncodeToString([]byte("other"))),
+	})
+	rec.setStatus(http.StatusOK)
+	other.Dispatch("CreateUser", nil, []byte(`{"data":{}}`))
+	failed = waitForStatus(t, other, eventwebhooks.DeliveryFailed, 1)
+	assert.Equal(t, http.StatusUnauthorized, failed[0].Attempts[0].StatusCode)
+	assert.Empty(t, rec.received())
+}
+
+func TestDeliveryAPI(t *testing.T) {
+	rec, url := newReceiver(t)
+	rec.setStatus(http.StatusBadRequest)
+	d := newDispatcher(t, &wgpb.EventWebhookConfiguration{
+		Name:   "test",
+		Url:    staticVariable(url),
+		Secret: staticVariable(testSecret),
+	})
+	router := mux.NewRouter()
+	d.Mount(router)
+	request := func(method string, path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(method, path, nil))
+		return w
+	}
+
+	d.Dispatch("CreateUser", nil, []byte(`{"data":{}}`))
+	id := waitForStatus(t, d, eventwebhooks.DeliveryFailed, 1)[0].ID
+
+	w := request(http.MethodGet, "/events/deliveries?webhook=test&status=failed")
+	require.Equal(t, http.StatusOK, w.Code)
+	var deliveries []*eventwebhooks.Delivery
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&deliveries))
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, id, deliveries[0].ID)
+
+	rec.setStatus(http.StatusOK)
+	assert.Equal(t, http.StatusAccepted, request(http.MethodPost, "/events/deliveries/"+id+"/redeliver").Code)
+	waitForStatus(t, d, eventwebhooks.DeliveryDelivered, 1)
+	require.Len(t, rec.received(), 1)
+
+	w = request(http.MethodGet, "/events/deliveries/"+id)
+	require.Equal(t, http.StatusOK, w.Code)
+	var delivery eventwebhooks.Delivery
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&delivery))
+	assert.Equal(t, eventwebhooks.DeliveryDelivered, delivery.Status)
+	assert.Len(t, delivery.Attempts, 2)
+
+	assert.Equal(t, http.StatusNotFound, request(http.MethodGet, "/events/deliveries/unknown").Code)
+	assert.Equal(t, http.StatusNotFound, request(http.MethodPost, "/events/deliveries/unknown/redeliver").Code)
+}
+
+func TestHistorySize(t *testing.T) {
+	_, url := newReceiver(t)
+	d, err := eventwebhooks.New(eventwebhooks.Options{
+		Webhooks:    []*wgpb.EventWebhookConfiguration{{Name: "test", Url: staticVariable(url), Secret: staticVariable(testSecret)}},
+		HistorySize: 2,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = d.Close() })
+	for ii := 0; ii < 3; ii++ {
+		d.Dispatch("CreateUser", nil, []byte(`{"data":{}}`))
+		time.Sleep(10 * time.Millisecond)
+	}
+	// The oldest delivery is removed
+	deliveries := waitForStatus(t, d, eventwebhooks.DeliveryDelivered, 2)
+	assert.Len(t, d.Deliveries("", ""), 2)
+	assert.True(t, deliveries[0].CreatedAt.Before(deliveries[1].CreatedAt))
+}
+
+func TestMaxPendingDeliveries(t *testing.T) {
+	rec, url := newReceiver(t)
+	rec.setStatus(http.StatusServiceUnavailable)
+	d, err := eventwebhooks.New(eventwebhooks.Options{
+		Webhooks:             []*wgpb.EventWebhookConfiguration{{Name: "test", Url: staticVariable(url), Secret: staticVariable(testSecret)}},
+		MinBackoff:           time.Hour,
+		MaxPendingDeliveries: 1,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = d.Close() })
+
+	// The first delivery waits to be retried, the second one is dropped
+	d.Dispatch("CreateUser", nil, []byte(`{"data":{}}`))
+	d.Dispatch("CreateUser", nil, []byte(`{"data":{}}`))
+	require.Eventually(t, func() bool {
+		deliveries := d.Deliveries("", eventwebhooks.DeliveryPending)
+		return len(deliveries) == 1 && len(deliveries[0].Attempts) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Len(t, d.Deliveries("", ""), 1)
+}
+
+func TestInvalidWebhooks(t *testing.T) {
+	_, err := eventwebhooks.New(eventwebhooks.Options{
+		Webhooks: []*wgpb.EventWebhookConfiguration{{Name: "test", Url: staticVariable("not a url")}},
+	})
+	assert.Error(t, err)
+	_, err = eventwebhooks.New(eventwebhooks.Options{
+		Webhooks: []*wgpb.EventWebhookConfiguration{
+			{Name: "test", Url: staticVariable("http://localhost")},
+			{Name: "test", Url: staticVariable("http://localhost")},
+		},
+	})
+	assert.Error(t, err)
+}
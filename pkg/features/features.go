@@ -34,6 +34,8 @@ const (
 	SQLServer           = Feature("sql-server")
 	SOAP                = Feature("soap")
 	ClaimInjection      = Feature("claim-injection")
+	OAuth2              = Feature("oauth2")
+	SAML                = Feature("saml")
 )
 
 type featureCheck struct {
@@ -183,6 +185,26 @@ func isFeatureClaimInjectionEnabled(cfg *wgpb.WunderGraphConfiguration) (bool, e
 	return false, nil
 }
 
+func isFeatureOAuth2Enabled(cfg *wgpb.WunderGraphConfiguration) (bool, error) {
+	providers := cfg.GetApi().GetAuthenticationConfig().GetCookieBased().GetProviders()
+	for _, p := range providers {
+		if p.Kind == wgpb.AuthProviderKind_AuthProviderOAuth2 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func isFeatureSAMLEnabled(cfg *wgpb.WunderGraphConfiguration) (bool, error) {
+	providers := cfg.GetApi().GetAuthenticationConfig().GetCookieBased().GetProviders()
+	for _, p := range providers {
+		if p.Kind == wgpb.AuthProviderKind_AuthProviderSAML {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func featureChecks() []*featureCheck {
 	// Same order as Feature declarations
 	return []*featureCheck{
@@ -207,6 +229,8 @@ func featureChecks() []*featureCheck {
 		{SQLServer, isSQLServerEnabled},
 		{SOAP, isSOAPEnabled},
 		{ClaimInjection, isFeatureClaimInjectionEnabled},
+		{OAuth2, isFeatureOAuth2Enabled},
+		{SAML, isFeatureSAMLEnabled},
 	}
 }
 
@@ -0,0 +1,340 @@
+package hooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/buger/jsonparser"
+	"go.uber.org/zap"
+
+	"github.com/wundergraph/wundergraph/pkg/logging"
+	"github.com/wundergraph/wundergraph/pkg/metrics"
+)
+
+const (
+	defaultAsyncQueueSize = 1000
+	defaultAsyncWorkers   = 4
+)
+
+// asyncHookTypes are the hooks that can be sent asynchronously, since
+// their responses are ignored
+var asyncHookTypes = map[MiddlewareHook]bool{
+	PostResolve:                true,
+	PostAuthentication:         true,
+	PostLogout:                 true,
+	MiddlewareHook(PostUpload): true,
+}
+
+type AsyncHooksOptions struct {
+	// Hooks are patterns matching the hooks sent asynchronously, with the
+	// same syntax as ServerRoute.Hook. Only postResolve, postAuthentication,
+	// postLogout and postUpload hooks can be asynchronous.
+	Hooks []string
+	// QueueSize is the maximum number of queued hooks, defaults to 1000
+	QueueSize int
+	// Workers is the number of hooks sent concurrently, defaults to 4
+	Workers int
+	// Directory stores the queued hooks so they're sent after a restart,
+	// hooks are only kept in memory if it's empty
+	Directory string
+	Metrics   metrics.Metrics
+	Logger    *zap.Logger
+}
+
+// asyncHook is a hook waiting to be sent
+type asyncHook struct {
+	Seq       uint64          `json:"seq"`
+	Hook      MiddlewareHook  `json:"hook"`
+	Path      string          `json:"path"`
+	RequestID string          `json:"requestId,omitempty"`
+	Payload   json.RawMessage `json:"payload"`
+}
+
+// AsyncHooks sends hooks in the background, so their latency doesn't
+// affect the responses. Hooks from the same user are sent in order by the
+// same worker, hooks are dropped when the queue of their worker is full.
+type AsyncHooks struct {
+	patterns []string
+	dir      string
+	log      *zap.Logger
+
+	queues  []chan *asyncHook
+	next    uint64
+	seq     uint64
+	pending []*asyncHook
+
+	depth   metrics.GaugeVec
+	dropped metrics.CounterVec
+	failed  metrics.CounterVec
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewAsyncHooks(opts AsyncHooksOptions) (*AsyncHooks, error) {
+	for _, pattern := range opts.Hooks {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid asynchronous hook %q: %w", pattern, err)
+		}
+	}
+	queueSize := opts.QueueSize
+	if queueSize <= 0 {
+		queueSize = defaultAsyncQueueSize
+	}
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = defaultAsyncWorkers
+	}
+	m := opts.Metrics
+	if m == nil {
+		m = metrics.NewNone()
+	}
+	a := &AsyncHooks{
+		patterns: opts.Hooks,
+		dir:      opts.Directory,
+		log:      opts.Logger,
+		depth: m.NewGaugeVec(metrics.MetricOpts{
+			Namespace: "wundernode",
+			Subsystem: "hooks",
+			Name:      "async_queue_depth",
+			Help:      "Asynchronous hooks waiting to be sent",
+		}),
+		dropped: m.NewCounterVec(metrics.MetricOpts{
+			Namespace: "wundernode",
+			Subsystem: "hooks",
+			Name:      "async_dropped_total",
+			Help:      "Asynchronous hooks dropped because the queue was full",
+		}, "hook"),
+		failed: m.NewCounterVec(metrics.MetricOpts{
+			Namespace: "wundernode",
+			Subsystem: "hooks",
+			Name:      "async_failed_total",
+			Help:      "Asynchronous hooks that failed",
+		}, "hook"),
+	}
+	if a.log == nil {
+		a.log = zap.NewNop()
+	}
+	workerQueueSize := queueSize / workers
+	if workerQueueSize == 0 {
+		workerQueueSize = 1
+	}
+	for ii := 0; ii < workers; ii++ {
+		a.queues = append(a.queues, make(chan *asyncHook, workerQueueSize))
+	}
+	if a.dir != "" {
+		if err := a.load(); err != nil {
+			return nil, err
+		}
+	}
+	a.ctx, a.cancel = context.WithCancel(context.Background())
+	return a, nil
+}
+
+// load reads the hooks stored by a previous run
+func (a *AsyncHooks) load() error {
+	if err := os.MkdirAll(a.dir, 0o700); err != nil {
+		return err
+	}
+	entries, err := os.ReadDir(a.dir)
+	if err != nil {
+		return err
+	}
+	// Entries are sorted by name, which is the sequence number
+	for _, entry := range entries {
+		if !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(a.dir, entry.Name()))
+		if err != nil {
+			return err
+		}
+		var h asyncHook
+		if err := json.Unmarshal(data, &h); err != nil {
+			return fmt.Errorf("reading queued hook %s: %w", entry.Name(), err)
+		}
+		a.pending = append(a.pending, &h)
+		a.seq = h.Seq
+	}
+	return nil
+}
+
+func (a *AsyncHooks) filePath(h *asyncHook) string {
+	return filepath.Join(a.dir, fmt.Sprintf("%020d.json", h.Seq))
+}
+
+// storedCredentials are the fields removed from the payloads of the hooks
+// before storing them, so tokens and cookies aren't written to the disk
+var storedCredentials = [][]string{
+	{"__wg", "user", "accessToken"},
+	{"__wg", "user", "rawAccessToken"},
+	{"__wg", "user", "idToken"},
+	{"__wg", "user", "rawIdToken"},
+	{"__wg", "user", "refreshToken"},
+	{"__wg", "clientRequest", "headers", "Authorization"},
+	{"__wg", "clientRequest", "headers", "Proxy-Authorization"},
+	{"__wg", "clientRequest", "headers", "Cookie"},
+}
+
+// store writes the hook to the directory without the credentials in its
+// payload, which hooks sent after a restart don't receive
+func (a *AsyncHooks) store(h *asyncHook) error {
+	payload := append(json.RawMessage(nil), h.Payload...)
+	for _, keys := range storedCredentials {
+		payload = jsonparser.Delete(payload, keys...)
+	}
+	stored := *h
+	stored.Payload = payload
+	data, err := json.Marshal(&stored)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(a.filePath(h), data, 0o600)
+}
+
+func (a *AsyncHooks) remove(h *asyncHook) {
+	if a.dir == "" {
+		return
+	}
+	if err := os.Remove(a.filePath(h)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		a.log.Error("removing asynchronous hook", zap.String("hook", h.Path), zap.Error(err))
+	}
+}
+
+// handles returns whether the given hook is sent asynchronously
+func (a *AsyncHooks) handles(hook MiddlewareHook, hookPath string) bool {
+	if a == nil || !asyncHookTypes[hook] {
+		return false
+	}
+	for _, pattern := range a.patterns {
+		if matchHookPath(pattern, hookPath) {
+			return true
+		}
+	}
+	return false
+}
+
+// enqueue queues the hook, copying its payload
+func (a *AsyncHooks) enqueue(ctx context.Context, hook MiddlewareHook, hookPath string, jsonData []byte) {
+	h := &asyncHook{
+		Seq:       atomic.AddUint64(&a.seq, 1),
+		Hook:      hook,
+		Path:      hookPath,
+		RequestID: logging.RequestIDFromContext(ctx),
+		Payload:   append(json.RawMessage(nil), jsonData...),
+	}
+	if a.dir != "" {
+		// Stored first, since the worker removes it once it's sent
+		if err := a.store(h); err != nil {
+			a.log.Error("storing asynchronous hook", zap.String("hook", hookPath), zap.Error(err))
+		}
+	}
+	if a.ctx.Err() == nil && a.push(h) {
+		return
+	}
+	a.remove(h)
+	a.dropped.Inc(string(hook))
+	a.log.Warn("asynchronous hooks queue is full, dropping hook", zap.String("hook", hookPath))
+}
+
+// push sends the hook to the queue of its worker, returning false if it's full
+func (a *AsyncHooks) push(h *asyncHook) bool {
+	queue := a.queues[a.worker(h)]
+	a.depth.Inc()
+	select {
+	case queue <- h:
+		return true
+	default:
+	}
+	a.depth.Add(-1)
+	return false
+}
+
+// worker returns the worker for the hook. Hooks from the same user always
+// use the same one, anonymous ones are distributed between all of them.
+func (a *AsyncHooks) worker(h *asyncHook) int {
+	userID, _ := jsonparser.GetString(h.Payload, "__wg", "user", "userId")
+	if userID == "" {
+		return int(atomic.AddUint64(&a.next, 1) % uint64(len(a.queues)))
+	}
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(userID))
+	return int(hash.Sum32() % uint32(len(a.queues)))
+}
+
+// start runs the workers sending the hooks with deliver until the queue
+// is closed. Each worker sends its hooks stored by a previous run before
+// the new ones, so hooks from the same user are still sent in order.
+func (a *AsyncHooks) start(deliver func(ctx context.Context, h *asyncHook) error) {
+	pending := make([][]*asyncHook, len(a.queues))
+	for _, h := range a.pending {
+		worker := a.worker(h)
+		pending[worker] = append(pending[worker], h)
+	}
+	a.depth.Add(float64(len(a.pending)))
+	a.pending = nil
+	for ii, queue := range a.queues {
+		queue, stored := queue, pending[ii]
+		a.wg.Add(1)
+		go func() {
+			defer a.wg.Done()
+			for _, h := range stored {
+				if a.ctx.Err() != nil {
+					return
+				}
+				a.depth.Add(-1)
+				a.process(deliver, h)
+			}
+			for {
+				select {
+				case <-a.ctx.Done():
+					return
+				case h := <-queue:
+					a.depth.Add(-1)
+					a.process(deliver, h)
+				}
+			}
+		}()
+	}
+}
+
+func (a *AsyncHooks) process(deliver func(ctx context.Context, h *asyncHook) error, h *asyncHook) {
+	err := deliver(a.ctx, h)
+	if a.ctx.Err() != nil {
+		// Closed while sending the hook, stored hooks are sent again after a restart
+		return
+	}
+	if err != nil {
+		a.failed.Inc(string(h.Hook))
+		a.log.Error("asynchronous hook failed", zap.String("hook", h.Path), zap.Error(err))
+	}
+	a.remove(h)
+}
+
+// Close stops sending hooks. Queued hooks are sent after a restart if the
+// queue is stored in a directory, otherwise they're dropped.
+func (a *AsyncHooks) Close() error {
+	a.cancel()
+	a.wg.Wait()
+	if a.dir != "" {
+		return nil
+	}
+	for _, queue := range a.queues {
+		for len(queue) > 0 {
+			h := <-queue
+			a.depth.Add(-1)
+			a.dropped.Inc(string(h.Hook))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+package hooks_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/buger/jsonparser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/wundergraph/wundergraph/pkg/hooks"
+)
+
+type asyncTestServer struct {
+	*httptest.Server
+	mu       sync.Mutex
+	received []string
+	block    chan struct{}
+}
+
+// newAsyncTestServer records the hooks it receives, blocking each of them
+// until block is closed if it's not nil
+func newAsyncTestServer(t *testing.T, block chan struct{}) *asyncTestServer {
+	s := &asyncTestServer{block: block}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		id, _ := jsonparser.GetString(body, "input", "id")
+		s.mu.Lock()
+		s.received = append(s.received, r.URL.Path+":"+id)
+		s.mu.Unlock()
+		if s.block != nil {
+			<-s.block
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *asyncTestServer) hooks() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.received...)
+}
+
+func newAsyncClient(t *testing.T, serverURL string, opts hooks.AsyncHooksOptions) (*hooks.Client, *hooks.AsyncHooks) {
+	opts.Logger = zap.NewNop()
+	async, err := hooks.NewAsyncHooks(opts)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = async.Close()
+	})
+	return hooks.NewClient(&hooks.ClientOptions{
+		ServerURL: serverURL,
+		Logger:    zap.NewNop(),
+		Async:     async,
+	}), async
+}
+
+func sendPostResolve(t *testing.T, client *hooks.Client, userID string, id string) {
+	payload := []byte(fmt.Sprintf(`{"__wg":{"user":{"userId":%q}},"input":{"id":%q}}`, userID, id))
+	resp, err := client.DoOperationRequest(context.Background(), "Todos", hooks.PostResolve, payload, &bytes.Buffer{})
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+}
+
+func TestAsyncHooks(t *testing.T) {
+	srv := newAsyncTestServer(t, nil)
+	client, _ := newAsyncClient(t, srv.URL, hooks.AsyncHooksOptions{
+		Hooks:   []string{"operation/*/postResolve"},
+		Workers: 4,
+	})
+
+	var expected []string
+	for ii := 0; ii < 20; ii++ {
+		sendPostResolve(t, client, "1", fmt.Sprint(ii))
+		expected = append(expected, fmt.Sprintf("/operation/Todos/postResolve:%d", ii))
+	}
+	// Hooks from the same user are sent in order
+	assert.Eventually(t, func() bool {
+		return assert.ObjectsAreEqual(expected, srv.hooks())
+	}, time.Second, 5*time.Millisecond)
+
+	// Other hooks are still synchronous
+	_, err := client.DoOperationRequest(context.Background(), "Todos", hooks.PreResolve, []byte(`{"input":{"id":"pre"}}`), &bytes.Buffer{})
+	require.NoError(t, err)
+	assert.Contains(t, srv.hooks(), "/operation/Todos/preResolve:pre")
+}
+
+func TestAsyncHooksDrop(t *testing.T) {
+	block := make(chan struct{})
+	srv := newAsyncTestServer(t, block)
+	defer close(block)
+	client, _ := newAsyncClient(t, srv.URL, hooks.AsyncHooksOptions{
+		Hooks:     []string{"operation/**"},
+		QueueSize: 1,
+		Workers:   1,
+	})
+
+	start := time.Now()
+	sendPostResolve(t, client, "1", "1")
+	require.Eventually(t, func() bool {
+		return len(srv.hooks()) == 1
+	}, time.Second, time.Millisecond)
+	// The first hook is in flight, the second one is queued and the third one is dropped
+	sendPostResolve(t, client, "1", "2")
+	sendPostResolve(t, client, "1", "3")
+	assert.Less(t, time.Since(start), time.Second)
+
+	block <- struct{}{}
+	block <- struct{}{}
+	assert.Equal(t, []string{"/operation/Todos/postResolve:1", "/operation/Todos/postResolve:2"}, srv.hooks())
+}
+
+func TestAsyncHooksDurable(t *testing.T) {
+	dir := t.TempDir()
+	block := make(chan struct{})
+	blocked := newAsyncTestServer(t, block)
+	defer close(block)
+	client, async := newAsyncClient(t, blocked.URL, hooks.AsyncHooksOptions{
+		Hooks:     []string{"operation/**"},
+		Workers:   1,
+		Directory: dir,
+	})
+	sendPostResolve(t, client, "1", "1")
+	require.Eventually(t, func() bool {
+		return len(blocked.hooks()) == 1
+	}, time.Second, time.Millisecond)
+	sendPostResolve(t, client, "1", "2")
+	require.NoError(t, async.Close())
+
+	// Hooks not sent before closing the queue are sent after reopening it,
+	// before the new ones from the same user
+	srv := newAsyncTestServer(t, nil)
+	client, _ = newAsyncClient(t, srv.URL, hooks.AsyncHooksOptions{
+		Hooks:     []string{"operation/**"},
+		Directory: dir,
+	})
+	sendPostResolve(t, client, "1", "3")
+	assert.Eventually(t, func() bool {
+		return assert.ObjectsAreEqual([]string{
+			"/operation/Todos/postResolve:1",
+			"/operation/Todos/postResolve:2",
+			"/operation/Todos/postResolve:3",
+		}, srv.hooks())
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestAsyncHooksDurableWithoutCredentials(t *testing.T) {
+	dir := t.TempDir()
+	block := make(chan struct{})
+	srv := newAsyncTestServer(t, block)
+	defer close(block)
+	client, _ := newAsyncClient(t, srv.URL, hooks.AsyncHooksOptions{
+		Hooks:     []string{"operation/**"},
+		Workers:   1,
+		Directory: dir,
+	})
+	payload := []byte(`{"__wg":{"user":{"userId":"1","rawAccessToken":"secret-token","refreshToken":"secret-refresh"},` +
+		`"clientRequest":{"method":"POST","headers":{"Authorization":"Bearer secret-token","Cookie":"user=secret-cookie","X-Request-Id":"1"}}},` +
+		`"input":{"id":"1"}}`)
+	_, err := client.DoOperationRequest(context.Background(), "Todos", hooks.PostResolve, payload, &bytes.Buffer{})
+	require.NoError(t, err)
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	data, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "secret")
+	assert.Contains(t, string(data), `"userId":"1"`)
+	assert.Contains(t, string(data), `"X-Request-Id":"1"`)
+}
@@ -0,0 +1,551 @@
+package hooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/buger/jsonparser"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/common/types/traits"
+	"github.com/google/cel-go/ext"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/wundergraph/wundergraph/pkg/authentication"
+	"github.com/wundergraph/wundergraph/pkg/wgpb"
+)
+
+const (
+	// expressionCostLimit bounds the work done by a single expression, so
+	// expressions iterating over big inputs can't stall requests
+	expressionCostLimit           = 1000000
+	expressionInterruptFrequency  = 100
+	defaultExpressionRejectStatus = http.StatusForbidden
+)
+
+// ExpressionStage indicates where the expressions are evaluated, which
+// determines the variables and actions available to them
+type ExpressionStage int
+
+const (
+	// ExpressionStagePreResolve runs before the preResolve hooks, with access to
+	// headers, user, variables and operation
+	ExpressionStagePreResolve ExpressionStage = iota + 1
+	// ExpressionStagePostResolve runs before the postResolve hooks, with access
+	// to the response too. Only rejecting is supported.
+	ExpressionStagePostResolve
+	// ExpressionStageOriginRequest runs before sending a request to the origin,
+	// with access to its headers and its JSON body as body. Variables can't be
+	// set.
+	ExpressionStageOriginRequest
+	// ExpressionStageSubscriptionEvent runs for each subscription message sent
+	// to a client, with access to the message as event. Messages can be
+	// skipped or have their fields set, and rejecting terminates the
+	// subscription.
+	ExpressionStageSubscriptionEvent
+)
+
+// ErrExpressionSkipped is returned when an ExpressionHookSkip expression
+// drops a subscription message
+var ErrExpressionSkipped = errors.New("skipped by expression hook")
+
+func (s ExpressionStage) String() string {
+	switch s {
+	case ExpressionStagePreResolve:
+		return "preResolve"
+	case ExpressionStagePostResolve:
+		return "postResolve"
+	case ExpressionStageOriginRequest:
+		return "onOriginRequest"
+	case ExpressionStageSubscriptionEvent:
+		return "onSubscriptionEvent"
+	}
+	return fmt.Sprintf("ExpressionStage(%d)", int(s))
+}
+
+func (s ExpressionStage) supports(action wgpb.ExpressionHookAction) bool {
+	switch action {
+	case wgpb.ExpressionHookAction_ExpressionHookReject:
+		return true
+	case wgpb.ExpressionHookAction_ExpressionHookSetHeader:
+		return s == ExpressionStagePreResolve || s == ExpressionStageOriginRequest
+	case wgpb.ExpressionHookAction_ExpressionHookSetVariable:
+		return s == ExpressionStagePreResolve
+	case wgpb.ExpressionHookAction_ExpressionHookSkip, wgpb.ExpressionHookAction_ExpressionHookSetEventField:
+		return s == ExpressionStageSubscriptionEvent
+	}
+	return false
+}
+
+func (s ExpressionStage) env() (*cel.Env, error) {
+	opts := []cel.EnvOption{
+		cel.Variable("headers", cel.MapType(cel.StringType, cel.StringType)),
+		cel.Variable("user", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("variables", cel.DynType),
+		cel.Variable("operation", cel.MapType(cel.StringType, cel.StringType)),
+		cel.CrossTypeNumericComparisons(true),
+		ext.Strings(),
+	}
+	switch s {
+	case ExpressionStagePostResolve:
+		opts = append(opts, cel.Variable("response", cel.DynType))
+	case ExpressionStageOriginRequest:
+		opts = append(opts, cel.Variable("body", cel.DynType))
+	case ExpressionStageSubscriptionEvent:
+		opts = append(opts, cel.Variable("event", cel.DynType))
+	}
+	return cel.NewEnv(opts...)
+}
+
+// ExpressionRejectedError is returned when an ExpressionHookReject expression
+// rejects the request
+type ExpressionRejectedError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *ExpressionRejectedError) Error() string {
+	return fmt.Sprintf("rejected by expression hook: %s", e.Message)
+}
+
+// ExpressionInput contains the data available to the expressions
+type ExpressionInput struct {
+	OperationName string
+	OperationType string
+	// Headers are modified in place by ExpressionHookSetHeader
+	Headers http.Header
+	// User might be nil for anonymous requests, in which case expressions see
+	// an empty map
+	User *authentication.User
+	// Variables are replaced by ExpressionHookSetVariable
+	Variables []byte
+	// Response is only available in ExpressionStagePostResolve
+	Response []byte
+	// Body is only available in ExpressionStageOriginRequest
+	Body []byte
+	// Event is only available in ExpressionStageSubscriptionEvent, its
+	// fields are replaced by ExpressionHookSetEventField
+	Event []byte
+}
+
+type expressionHook struct {
+	action     wgpb.ExpressionHookAction
+	condition  cel.Program
+	target     []string
+	expression cel.Program
+	statusCode int
+}
+
+// ExpressionHooks evaluates CEL expressions in-process, covering hooks that
+// only set a header or a variable or reject a request without calling the
+// hooks server. A nil *ExpressionHooks evaluates nothing.
+type ExpressionHooks struct {
+	stage ExpressionStage
+	hooks []*expressionHook
+}
+
+// NewExpressionHooks compiles the given expressions, returning nil if there
+// are none
+func NewExpressionHooks(configs []*wgpb.ExpressionHook, stage ExpressionStage) (*ExpressionHooks, error) {
+	if len(configs) == 0 {
+		return nil, nil
+	}
+	env, err := stage.env()
+	if err != nil {
+		return nil, err
+	}
+	h := &ExpressionHooks{
+		stage: stage,
+		hooks: make([]*expressionHook, 0, len(configs)),
+	}
+	for ii, config := range configs {
+		hook, err := compileExpressionHook(env, stage, config)
+		if err != nil {
+			return nil, fmt.Errorf("%s expression hook %d: %w", stage, ii, err)
+		}
+		h.hooks = append(h.hooks, hook)
+	}
+	return h, nil
+}
+
+// OperationExpressions contains the expressions evaluated by the pipeline of
+// an operation
+type OperationExpressions struct {
+	PreResolve        *ExpressionHooks
+	PostResolve       *ExpressionHooks
+	SubscriptionEvent *ExpressionHooks
+}
+
+// NewOperationExpressions compiles the expressions in the hooks configuration
+// of the operation
+func NewOperationExpressions(operation *wgpb.Operation) (OperationExpressions, error) {
+	var (
+		expressions OperationExpressions
+		err         error
+	)
+	hooksConfig := operation.GetHooksConfiguration()
+	expressions.PreResolve, err = NewExpressionHooks(hooksConfig.GetPreResolveExpressions(), ExpressionStagePreResolve)
+	if err != nil {
+		return expressions, err
+	}
+	expressions.PostResolve, err = NewExpressionHooks(hooksConfig.GetPostResolveExpressions(), ExpressionStagePostResolve)
+	if err != nil {
+		return expressions, err
+	}
+	expressions.SubscriptionEvent, err = NewExpressionHooks(hooksConfig.GetSubscriptionEventExpressions(), ExpressionStageSubscriptionEvent)
+	return expressions, err
+}
+
+func compileExpressionHook(env *cel.Env, stage ExpressionStage, config *wgpb.ExpressionHook) (*expressionHook, error) {
+	if !stage.supports(config.GetAction()) {
+		return nil, fmt.Errorf("action %s is not supported", config.GetAction())
+	}
+	hook := &expressionHook{
+		action:     config.GetAction(),
+		statusCode: int(config.GetStatusCode()),
+	}
+	switch hook.action {
+	case wgpb.ExpressionHookAction_ExpressionHookSetHeader:
+		if config.GetTarget() == "" {
+			return nil, errors.New("missing header name")
+		}
+		hook.target = []string{config.GetTarget()}
+	case wgpb.ExpressionHookAction_ExpressionHookSetVariable:
+		if config.GetTarget() == "" {
+			return nil, errors.New("missing variable path")
+		}
+		hook.target = strings.Split(config.GetTarget(), ".")
+	case wgpb.ExpressionHookAction_ExpressionHookSetEventField:
+		if config.GetTarget() == "" {
+			return nil, errors.New("missing event field path")
+		}
+		hook.target = strings.Split(config.GetTarget(), ".")
+	case wgpb.ExpressionHookAction_ExpressionHookReject:
+		if hook.statusCode == 0 {
+			hook.statusCode = defaultExpressionRejectStatus
+		}
+		if hook.statusCode < 400 || hook.statusCode > 599 {
+			return nil, fmt.Errorf("invalid status code %d", hook.statusCode)
+		}
+	}
+	var err error
+	if config.GetCondition() != "" {
+		hook.condition, err = compileExpression(env, config.GetCondition(), cel.BoolType)
+		if err != nil {
+			return nil, fmt.Errorf("condition: %w", err)
+		}
+	}
+	if config.GetExpression() != "" {
+		hook.expression, err = compileExpression(env, config.GetExpression(), nil)
+		if err != nil {
+			return nil, fmt.Errorf("expression: %w", err)
+		}
+	} else if hook.action != wgpb.ExpressionHookAction_ExpressionHookReject && hook.action != wgpb.ExpressionHookAction_ExpressionHookSkip {
+		return nil, errors.New("missing expression")
+	}
+	return hook, nil
+}
+
+func compileExpression(env *cel.Env, source string, outputType *cel.Type) (cel.Program, error) {
+	ast, issues := env.Compile(source)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if outputType != nil && !ast.OutputType().IsExactType(outputType) && !ast.OutputType().IsExactType(cel.DynType) {
+		return nil, fmt.Errorf("%q must return %s, not %s", source, outputType, ast.OutputType())
+	}
+	return env.Program(ast,
+		cel.CostLimit(expressionCostLimit),
+		cel.InterruptCheckFrequency(expressionInterruptFrequency),
+	)
+}
+
+// Evaluate runs the expressions in order, so each one sees the changes made
+// by the previous ones. If an expression rejects the request, it returns an
+// *ExpressionRejectedError.
+func (h *ExpressionHooks) Evaluate(ctx context.Context, input *ExpressionInput) error {
+	if h == nil {
+		return nil
+	}
+	activation, err := h.activation(input)
+	if err != nil {
+		return err
+	}
+	for ii, hook := range h.hooks {
+		if err := hook.evaluate(ctx, input, activation); err != nil {
+			var rejected *ExpressionRejectedError
+			if errors.As(err, &rejected) || errors.Is(err, ErrExpressionSkipped) {
+				return err
+			}
+			return fmt.Errorf("%s expression hook %d: %w", h.stage, ii, err)
+		}
+	}
+	return nil
+}
+
+func (h *ExpressionHooks) activation(input *ExpressionInput) (map[string]any, error) {
+	var user any = map[string]any{}
+	if input.User != nil {
+		data, err := json.Marshal(input.User)
+		if err != nil {
+			return nil, err
+		}
+		if user, err = decodeExpressionJSON(data); err != nil {
+			return nil, err
+		}
+	}
+	variables, err := decodeExpressionJSON(input.Variables)
+	if err != nil {
+		return nil, fmt.Errorf("decoding variables: %w", err)
+	}
+	activation := map[string]any{
+		"headers":   expressionHeaders(input.Headers),
+		"user":      user,
+		"variables": variables,
+		"operation": map[string]string{
+			"name": input.OperationName,
+			"type": input.OperationType,
+		},
+	}
+	switch h.stage {
+	case ExpressionStagePostResolve:
+		response, err := decodeExpressionJSON(input.Response)
+		if err != nil {
+			return nil, fmt.Errorf("decoding response: %w", err)
+		}
+		activation["response"] = response
+	case ExpressionStageOriginRequest:
+		// Bodies that aren't JSON are left as null
+		body, _ := decodeExpressionJSON(input.Body)
+		activation["body"] = body
+	case ExpressionStageSubscriptionEvent:
+		event, err := decodeExpressionJSON(input.Event)
+		if err != nil {
+			return nil, fmt.Errorf("decoding event: %w", err)
+		}
+		activation["event"] = event
+	}
+	return activation, nil
+}
+
+// expressionHeaders uses lowercase names, joining repeated headers with
+// commas
+func expressionHeaders(header http.Header) map[string]string {
+	headers := make(map[string]string, len(header))
+	for name, values := range header {
+		headers[strings.ToLower(name)] = strings.Join(values, ", ")
+	}
+	return headers
+}
+
+// decodeExpressionJSON decodes JSON numbers without a fraction or exponent
+// as ints, so they don't lose precision as doubles
+func decodeExpressionJSON(data []byte) (any, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var value any
+	if err := dec.Decode(&value); err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, errors.New("invalid data after top-level value")
+	}
+	return expressionNumbers(value), nil
+}
+
+// expressionNumbers replaces the json.Number values in value with int64,
+// uint64 or float64
+func expressionNumbers(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			v[key] = expressionNumbers(item)
+		}
+	case []any:
+		for ii, item := range v {
+			v[ii] = expressionNumbers(item)
+		}
+	case json.Number:
+		if !strings.ContainsAny(v.String(), ".eE") {
+			if n, err := strconv.ParseInt(v.String(), 10, 64); err == nil {
+				return n
+			}
+			if n, err := strconv.ParseUint(v.String(), 10, 64); err == nil {
+				return n
+			}
+		}
+		n, _ := v.Float64()
+		return n
+	}
+	return value
+}
+
+func (e *expressionHook) evaluate(ctx context.Context, input *ExpressionInput, activation map[string]any) error {
+	if e.condition != nil {
+		value, _, err := e.condition.ContextEval(ctx, activation)
+		if err != nil {
+			return fmt.Errorf("condition: %w", err)
+		}
+		matched, ok := value.Value().(bool)
+		if !ok {
+			return fmt.Errorf("condition returned %s instead of bool", value.Type().TypeName())
+		}
+		if !matched {
+			return nil
+		}
+	}
+	var value ref.Val
+	if e.expression != nil {
+		var err error
+		value, _, err = e.expression.ContextEval(ctx, activation)
+		if err != nil {
+			return fmt.Errorf("expression: %w", err)
+		}
+	}
+	switch e.action {
+	case wgpb.ExpressionHookAction_ExpressionHookSetHeader:
+		headerValue, err := expressionString(value)
+		if err != nil {
+			return err
+		}
+		input.Headers.Set(e.target[0], headerValue)
+		activation["headers"] = expressionHeaders(input.Headers)
+	case wgpb.ExpressionHookAction_ExpressionHookSetVariable:
+		data, err := expressionJSON(value)
+		if err != nil {
+			return err
+		}
+		variables := input.Variables
+		if len(variables) == 0 {
+			variables = []byte(`{}`)
+		}
+		// jsonparser.Set might reuse the input, which belongs to the caller
+		variables, err = jsonparser.Set(append([]byte(nil), variables...), data, e.target...)
+		if err != nil {
+			return fmt.Errorf("setting variable %s: %w", strings.Join(e.target, "."), err)
+		}
+		input.Variables = variables
+		decoded, err := decodeExpressionJSON(variables)
+		if err != nil {
+			return err
+		}
+		activation["variables"] = decoded
+	case wgpb.ExpressionHookAction_ExpressionHookSetEventField:
+		data, err := expressionJSON(value)
+		if err != nil {
+			return err
+		}
+		event := input.Event
+		if len(event) == 0 {
+			event = []byte(`{}`)
+		}
+		event, err = jsonparser.Set(append([]byte(nil), event...), data, e.target...)
+		if err != nil {
+			return fmt.Errorf("setting event field %s: %w", strings.Join(e.target, "."), err)
+		}
+		input.Event = event
+		decoded, err := decodeExpressionJSON(event)
+		if err != nil {
+			return err
+		}
+		activation["event"] = decoded
+	case wgpb.ExpressionHookAction_ExpressionHookSkip:
+		return ErrExpressionSkipped
+	case wgpb.ExpressionHookAction_ExpressionHookReject:
+		message := http.StatusText(e.statusCode)
+		if value != nil {
+			var err error
+			if message, err = expressionString(value); err != nil {
+				return err
+			}
+		}
+		return &ExpressionRejectedError{StatusCode: e.statusCode, Message: message}
+	}
+	return nil
+}
+
+func expressionString(value ref.Val) (string, error) {
+	converted := value.ConvertToType(types.StringType)
+	if types.IsError(converted) {
+		return "", fmt.Errorf("can't convert %s to string", value.Type().TypeName())
+	}
+	return converted.Value().(string), nil
+}
+
+func expressionJSON(value ref.Val) ([]byte, error) {
+	native, err := expressionNative(value)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(native)
+}
+
+// expressionNative converts value to a Go value encoded as JSON, keeping
+// ints as ints instead of converting them to doubles like structpb does
+func expressionNative(value ref.Val) (any, error) {
+	switch v := value.(type) {
+	case types.Null:
+		return nil, nil
+	case types.Bool:
+		return bool(v), nil
+	case types.Int:
+		return int64(v), nil
+	case types.Uint:
+		return uint64(v), nil
+	case types.Double:
+		return float64(v), nil
+	case types.String:
+		return string(v), nil
+	case traits.Lister:
+		var list []any
+		for it := v.Iterator(); it.HasNext() == types.True; {
+			item, err := expressionNative(it.Next())
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, item)
+		}
+		if list == nil {
+			list = []any{}
+		}
+		return list, nil
+	case traits.Mapper:
+		object := map[string]any{}
+		for it := v.Iterator(); it.HasNext() == types.True; {
+			key := it.Next()
+			name, ok := key.(types.String)
+			if !ok {
+				return nil, fmt.Errorf("can't convert %s map keys to JSON", key.Type().TypeName())
+			}
+			item, err := expressionNative(v.Get(key))
+			if err != nil {
+				return nil, err
+			}
+			object[string(name)] = item
+		}
+		return object, nil
+	}
+	// Other types, like timestamps, use their JSON representation
+	native, err := value.ConvertToNative(reflect.TypeOf(&structpb.Value{}))
+	if err != nil {
+		return nil, fmt.Errorf("can't convert %s to JSON: %w", value.Type().TypeName(), err)
+	}
+	data, err := protojson.Marshal(native.(*structpb.Value))
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(data), nil
+}
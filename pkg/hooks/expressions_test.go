@@ -0,0 +1,313 @@
+package hooks_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/wundergraph/graphql-go-tools/pkg/engine/resolve"
+
+	"github.com/wundergraph/wundergraph/pkg/authentication"
+	"github.com/wundergraph/wundergraph/pkg/hooks"
+	"github.com/wundergraph/wundergraph/pkg/wgpb"
+)
+
+func TestExpressionHooks(t *testing.T) {
+	expressions, err := hooks.NewExpressionHooks([]*wgpb.ExpressionHook{
+		{
+			Action:     wgpb.ExpressionHookAction_ExpressionHookSetHeader,
+			Target:     "X-Tenant",
+			Expression: `user.customClaims.tenant`,
+			Condition:  `has(user.customClaims)`,
+		},
+		{
+			Action:     wgpb.ExpressionHookAction_ExpressionHookSetVariable,
+			Target:     "input.userId",
+			Expression: `user.userId`,
+			Condition:  `has(user.userId)`,
+		},
+		{
+			Action:     wgpb.ExpressionHookAction_ExpressionHookSetVariable,
+			Target:     "limit",
+			Expression: `variables.limit > 100 ? 100 : variables.limit`,
+		},
+		{
+			Action:     wgpb.ExpressionHookAction_ExpressionHookReject,
+			Condition:  `!("x-tenant" in headers) && operation.type == "MUTATION"`,
+			Expression: `"anonymous " + operation.name + " not allowed"`,
+			StatusCode: http.StatusUnauthorized,
+		},
+	}, hooks.ExpressionStagePreResolve)
+	require.NoError(t, err)
+
+	input := &hooks.ExpressionInput{
+		OperationName: "CreateTodo",
+		OperationType: "MUTATION",
+		Headers:       http.Header{},
+		User: &authentication.User{
+			UserID:       "1",
+			CustomClaims: map[string]interface{}{"tenant": "acme"},
+		},
+		Variables: []byte(`{"limit":500}`),
+	}
+	require.NoError(t, expressions.Evaluate(context.Background(), input))
+	assert.Equal(t, "acme", input.Headers.Get("X-Tenant"))
+	assert.JSONEq(t, `{"limit":100,"input":{"userId":"1"}}`, string(input.Variables))
+
+	// Anonymous users don't have the header set, so they're rejected
+	input = &hooks.ExpressionInput{
+		OperationName: "CreateTodo",
+		OperationType: "MUTATION",
+		Headers:       http.Header{},
+		Variables:     []byte(`{"limit":5}`),
+	}
+	err = expressions.Evaluate(context.Background(), input)
+	var rejected *hooks.ExpressionRejectedError
+	require.True(t, errors.As(err, &rejected))
+	assert.Equal(t, http.StatusUnauthorized, rejected.StatusCode)
+	assert.Equal(t, "anonymous CreateTodo not allowed", rejected.Message)
+	assert.JSONEq(t, `{"limit":5}`, string(input.Variables))
+
+	// Missing fields without has() fail the evaluation
+	expressions, err = hooks.NewExpressionHooks([]*wgpb.ExpressionHook{
+		{
+			Action:     wgpb.ExpressionHookAction_ExpressionHookSetHeader,
+			Target:     "X-User",
+			Expression: `user.userId`,
+		},
+	}, hooks.ExpressionStageOriginRequest)
+	require.NoError(t, err)
+	err = expressions.Evaluate(context.Background(), &hooks.ExpressionInput{Headers: http.Header{}})
+	assert.ErrorContains(t, err, "onOriginRequest expression hook 0")
+
+	// nil hooks don't evaluate anything
+	var empty *hooks.ExpressionHooks
+	assert.NoError(t, empty.Evaluate(context.Background(), &hooks.ExpressionInput{}))
+}
+
+func TestExpressionHooksStages(t *testing.T) {
+	response := []*wgpb.ExpressionHook{
+		{
+			Action:    wgpb.ExpressionHookAction_ExpressionHookReject,
+			Condition: `size(response.data.todos) == 0`,
+		},
+	}
+	_, err := hooks.NewExpressionHooks(response, hooks.ExpressionStagePreResolve)
+	assert.ErrorContains(t, err, "undeclared reference to 'response'")
+
+	expressions, err := hooks.NewExpressionHooks(response, hooks.ExpressionStagePostResolve)
+	require.NoError(t, err)
+	err = expressions.Evaluate(context.Background(), &hooks.ExpressionInput{Response: []byte(`{"data":{"todos":[]}}`)})
+	var rejected *hooks.ExpressionRejectedError
+	require.True(t, errors.As(err, &rejected))
+	assert.Equal(t, http.StatusForbidden, rejected.StatusCode)
+	assert.Equal(t, "Forbidden", rejected.Message)
+
+	expressions, err = hooks.NewExpressionHooks([]*wgpb.ExpressionHook{
+		{
+			Action:     wgpb.ExpressionHookAction_ExpressionHookSetHeader,
+			Target:     "X-Count",
+			Expression: `size(body.items)`,
+		},
+	}, hooks.ExpressionStageOriginRequest)
+	require.NoError(t, err)
+	headers := http.Header{}
+	require.NoError(t, expressions.Evaluate(context.Background(), &hooks.ExpressionInput{Headers: headers, Body: []byte(`{"items":[1,2,3]}`)}))
+	assert.Equal(t, "3", headers.Get("X-Count"))
+
+	expressions, err = hooks.NewExpressionHooks([]*wgpb.ExpressionHook{
+		{
+			Action:    wgpb.ExpressionHookAction_ExpressionHookSkip,
+			Condition: `event.data.todo.ownerId != user.userId`,
+		},
+		{
+			Action:     wgpb.ExpressionHookAction_ExpressionHookSetEventField,
+			Target:     "data.todo.mine",
+			Expression: `true`,
+		},
+	}, hooks.ExpressionStageSubscriptionEvent)
+	require.NoError(t, err)
+	input := &hooks.ExpressionInput{
+		User:  &authentication.User{UserID: "1"},
+		Event: []byte(`{"data":{"todo":{"ownerId":"1"}}}`),
+	}
+	require.NoError(t, expressions.Evaluate(context.Background(), input))
+	assert.JSONEq(t, `{"data":{"todo":{"ownerId":"1","mine":true}}}`, string(input.Event))
+	input.Event = []byte(`{"data":{"todo":{"ownerId":"2"}}}`)
+	assert.ErrorIs(t, expressions.Evaluate(context.Background(), input), hooks.ErrExpressionSkipped)
+}
+
+func TestExpressionHooksNumbers(t *testing.T) {
+	expressions, err := hooks.NewExpressionHooks([]*wgpb.ExpressionHook{
+		{
+			Action:     wgpb.ExpressionHookAction_ExpressionHookSetVariable,
+			Target:     "input.id",
+			Expression: `variables.id`,
+		},
+		{
+			Action:     wgpb.ExpressionHookAction_ExpressionHookSetVariable,
+			Target:     "input.next",
+			Expression: `variables.id + 1`,
+		},
+		{
+			Action:     wgpb.ExpressionHookAction_ExpressionHookSetVariable,
+			Target:     "input.values",
+			Expression: `{"price": variables.price, "ids": [variables.id]}`,
+		},
+	}, hooks.ExpressionStagePreResolve)
+	require.NoError(t, err)
+
+	// Integers above 2^53 keep their value instead of being rounded as doubles
+	input := &hooks.ExpressionInput{
+		Headers:   http.Header{},
+		Variables: []byte(`{"id":9007199254740993,"price":1.5}`),
+	}
+	require.NoError(t, expressions.Evaluate(context.Background(), input))
+	assert.JSONEq(t, `{"id":9007199254740993,"price":1.5,"input":{"id":9007199254740993,"next":9007199254740994,`+
+		`"values":{"price":1.5,"ids":[9007199254740993]}}}`, string(input.Variables))
+	// JSONEq compares numbers as doubles
+	assert.Contains(t, string(input.Variables), `"input":{"id":9007199254740993,"next":9007199254740994`)
+	assert.Contains(t, string(input.Variables), `"ids":[9007199254740993]`)
+}
+
+func TestInvalidExpressionHooks(t *testing.T) {
+	testCases := []struct {
+		name  string
+		stage hooks.ExpressionStage
+		hook  *wgpb.ExpressionHook
+		err   string
+	}{
+		{
+			name:  "syntax error",
+			stage: hooks.ExpressionStagePreResolve,
+			hook:  &wgpb.ExpressionHook{Action: wgpb.ExpressionHookAction_ExpressionHookReject, Condition: `headers[`},
+			err:   "condition",
+		},
+		{
+			name:  "condition not bool",
+			stage: hooks.ExpressionStagePreResolve,
+			hook:  &wgpb.ExpressionHook{Action: wgpb.ExpressionHookAction_ExpressionHookReject, Condition: `"yes"`},
+			err:   "must return bool",
+		},
+		{
+			name:  "missing header name",
+			stage: hooks.ExpressionStagePreResolve,
+			hook:  &wgpb.ExpressionHook{Action: wgpb.ExpressionHookAction_ExpressionHookSetHeader, Expression: `"a"`},
+			err:   "missing header name",
+		},
+		{
+			name:  "missing expression",
+			stage: hooks.ExpressionStagePreResolve,
+			hook:  &wgpb.ExpressionHook{Action: wgpb.ExpressionHookAction_ExpressionHookSetVariable, Target: "id"},
+			err:   "missing expression",
+		},
+		{
+			name:  "variables at the origin",
+			stage: hooks.ExpressionStageOriginRequest,
+			hook:  &wgpb.ExpressionHook{Action: wgpb.ExpressionHookAction_ExpressionHookSetVariable, Target: "id", Expression: `1`},
+			err:   "not supported",
+		},
+		{
+			name:  "headers after resolving",
+			stage: hooks.ExpressionStagePostResolve,
+			hook:  &wgpb.ExpressionHook{Action: wgpb.ExpressionHookAction_ExpressionHookSetHeader, Target: "X-A", Expression: `"a"`},
+			err:   "not supported",
+		},
+		{
+			name:  "skip outside subscriptions",
+			stage: hooks.ExpressionStagePostResolve,
+			hook:  &wgpb.ExpressionHook{Action: wgpb.ExpressionHookAction_ExpressionHookSkip},
+			err:   "not supported",
+		},
+		{
+			name:  "missing event field path",
+			stage: hooks.ExpressionStageSubscriptionEvent,
+			hook:  &wgpb.ExpressionHook{Action: wgpb.ExpressionHookAction_ExpressionHookSetEventField, Expression: `1`},
+			err:   "missing event field path",
+		},
+		{
+			name:  "invalid status code",
+			stage: hooks.ExpressionStagePreResolve,
+			hook:  &wgpb.ExpressionHook{Action: wgpb.ExpressionHookAction_ExpressionHookReject, StatusCode: 200},
+			err:   "invalid status code 200",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := hooks.NewExpressionHooks([]*wgpb.ExpressionHook{tc.hook}, tc.stage)
+			assert.ErrorContains(t, err, tc.err)
+		})
+	}
+}
+
+func TestPipelineExpressionHooks(t *testing.T) {
+	operation := &wgpb.Operation{
+		Name:          "Todos",
+		OperationType: wgpb.OperationType_QUERY,
+		HooksConfiguration: &wgpb.OperationHooksConfiguration{
+			PreResolveExpressions: []*wgpb.ExpressionHook{
+				{
+					Action:     wgpb.ExpressionHookAction_ExpressionHookReject,
+					Condition:  `!has(headers.authorization)`,
+					Expression: `"missing authorization"`,
+					StatusCode: http.StatusUnauthorized,
+				},
+				{
+					Action:     wgpb.ExpressionHookAction_ExpressionHookSetHeader,
+					Target:     "X-Operation",
+					Expression: `operation.name`,
+				},
+				{
+					Action:     wgpb.ExpressionHookAction_ExpressionHookSetVariable,
+					Target:     "owner",
+					Expression: `headers["x-owner"]`,
+					Condition:  `"x-owner" in headers`,
+				},
+			},
+		},
+	}
+	expressions, err := hooks.NewOperationExpressions(operation)
+	require.NoError(t, err)
+	pipeline := hooks.NewSynchronousOperationPipeline(hooks.SynchronousOperationPipelineConfig{
+		PipelineConfig: hooks.PipelineConfig{
+			Operation:   operation,
+			Logger:      zap.NewNop(),
+			Expressions: expressions,
+		},
+	})
+
+	newContext := func(r *http.Request) *resolve.Context {
+		ctx := resolve.NewContext(r.Context())
+		ctx.Request.Header = r.Header
+		ctx.Variables = []byte(`{"first":10}`)
+		return ctx
+	}
+
+	r := httptest.NewRequest(http.MethodGet, "/operations/Todos", nil)
+	w := httptest.NewRecorder()
+	resp, err := pipeline.PreResolve(newContext(r), w, r)
+	require.NoError(t, err)
+	assert.True(t, resp.Done)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Contains(t, w.Body.String(), "missing authorization")
+
+	r = httptest.NewRequest(http.MethodGet, "/operations/Todos", nil)
+	r.Header.Set("Authorization", "Bearer token")
+	r.Header.Set("X-Owner", "jens")
+	w = httptest.NewRecorder()
+	ctx := newContext(r)
+	resp, err = pipeline.PreResolve(ctx, w, r)
+	require.NoError(t, err)
+	assert.False(t, resp.Done)
+	assert.JSONEq(t, `{"first":10,"owner":"jens"}`, string(resp.Data))
+	assert.Equal(t, "Todos", ctx.Request.Header.Get("X-Operation"))
+	assert.Equal(t, "Bearer token", ctx.Request.Header.Get("Authorization"))
+	// Variables in the context are left untouched
+	assert.JSONEq(t, `{"first":10}`, string(ctx.Variables))
+}
@@ -0,0 +1,204 @@
+package hooks
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/buger/jsonparser"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/wundergraph/wundergraph/pkg/hookspb"
+	"github.com/wundergraph/wundergraph/pkg/logging"
+)
+
+// grpcRequestTimeout matches the timeout used by the HTTP transport
+const grpcRequestTimeout = 60 * time.Second
+
+type GRPCOptions struct {
+	// Address of the hooks server, e.g. localhost:9993
+	Address string
+	// TLS enables transport security using the system root certificates
+	TLS           bool
+	EnableTracing bool
+}
+
+// GRPCTransport sends hooks to the server using the hookspb.Hooks gRPC
+// service instead of JSON over HTTP. All hooks share the same connection,
+// multiplexing the calls over HTTP/2.
+type GRPCTransport struct {
+	conn   *grpc.ClientConn
+	client hookspb.HooksClient
+	health grpc_health_v1.HealthClient
+}
+
+// NewGRPCTransport creates the connection to the hooks server, which is
+// established lazily
+func NewGRPCTransport(opts GRPCOptions) (*GRPCTransport, error) {
+	creds := insecure.NewCredentials()
+	if opts.TLS {
+		creds = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	}
+	dialOptions := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		// Wait for the server to become available instead of failing
+		// immediately, like the retries of the HTTP transport do while the
+		// server is starting
+		grpc.WithDefaultCallOptions(grpc.WaitForReady(true)),
+	}
+	if opts.EnableTracing {
+		dialOptions = append(dialOptions,
+			grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+			grpc.WithStreamInterceptor(otelgrpc.StreamClientInterceptor()),
+		)
+	}
+	conn, err := grpc.Dial(opts.Address, dialOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("connecting to hooks server at %s: %w", opts.Address, err)
+	}
+	return &GRPCTransport{
+		conn:   conn,
+		client: hookspb.NewHooksClient(conn),
+		health: grpc_health_v1.NewHealthClient(conn),
+	}, nil
+}
+
+// newHookRequest converts a JSON hook payload to its protobuf request
+func newHookRequest(ctx context.Context, hookPath string, jsonData []byte) (*hookspb.HookRequest, error) {
+	req := &hookspb.HookRequest{
+		Path:      hookPath,
+		RequestId: logging.RequestIDFromContext(ctx),
+	}
+	// The user contains internal fields that aren't part of the schema
+	unmarshal := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if user, dataType, _, _ := jsonparser.Get(jsonData, "__wg", "user"); dataType == jsonparser.Object {
+		req.User = &hookspb.User{}
+		if err := unmarshal.Unmarshal(user, req.User); err != nil {
+			return nil, fmt.Errorf("encoding user: %w", err)
+		}
+	}
+	if clientRequest, dataType, _, _ := jsonparser.Get(jsonData, "__wg", "clientRequest"); dataType == jsonparser.Object {
+		// The body is sent as JSON, like the payload
+		body, bodyType, _, _ := jsonparser.Get(clientRequest, "body")
+		clientRequest = jsonparser.Delete(append([]byte(nil), clientRequest...), "body")
+		req.ClientRequest = &hookspb.WunderGraphRequest{}
+		if err := unmarshal.Unmarshal(clientRequest, req.ClientRequest); err != nil {
+			return nil, fmt.Errorf("encoding client request: %w", err)
+		}
+		if bodyType != jsonparser.NotExist && bodyType != jsonparser.Null {
+			req.ClientRequest.Body = rawJSONValue(body, bodyType)
+		}
+	}
+	// jsonparser.Delete modifies its input, which belongs to the caller
+	req.Payload = jsonparser.Delete(append([]byte(nil), jsonData...), "__wg")
+	return req, nil
+}
+
+// rawJSONValue returns the JSON encoding of a value returned by jsonparser,
+// which strips the quotes from strings
+func rawJSONValue(value []byte, dataType jsonparser.ValueType) []byte {
+	if dataType == jsonparser.String {
+		return append(append([]byte{'"'}, value...), '"')
+	}
+	return append([]byte(nil), value...)
+}
+
+// hookResponseJSON encodes the response of a hook like the JSON responses
+// returned by the HTTP transport, keeping the JSON fields as they are
+func hookResponseJSON(resp *hookspb.HookResponse) ([]byte, error) {
+	response, input := resp.GetResponse(), resp.GetInput()
+	fields := proto.Clone(resp).(*hookspb.HookResponse)
+	fields.Response, fields.Input = nil, nil
+	data, err := protojson.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range map[string][]byte{"response": response, "input": input} {
+		if len(value) == 0 {
+			continue
+		}
+		if !json.Valid(value) {
+			return nil, fmt.Errorf("hook returned an invalid JSON %s", key)
+		}
+		if data, err = jsonparser.Set(data, value, key); err != nil {
+			return nil, err
+		}
+	}
+	return data, nil
+}
+
+// call runs the hook, returning its response encoded as JSON so it can be
+// decoded like the ones returned by the HTTP transport, and the status code
+// for the client
+func (t *GRPCTransport) call(ctx context.Context, hookPath string, jsonData []byte) ([]byte, int, error) {
+	req, err := newHookRequest(ctx, hookPath, jsonData)
+	if err != nil {
+		return nil, 0, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, grpcRequestTimeout)
+	defer cancel()
+	resp, err := t.client.Call(ctx, req)
+	if err != nil {
+		return nil, 0, err
+	}
+	data, err := hookResponseJSON(resp)
+	if err != nil {
+		return nil, 0, err
+	}
+	statusCode := int(resp.GetStatusCode())
+	if statusCode == 0 {
+		statusCode = http.StatusOK
+	}
+	return data, statusCode, nil
+}
+
+// subscribe runs a function subscription, calling write with each result
+// encoded as JSON
+func (t *GRPCTransport) subscribe(ctx context.Context, hookPath string, jsonData []byte, subscribeOnce bool, write func(data []byte) error) error {
+	req, err := newHookRequest(ctx, hookPath, jsonData)
+	if err != nil {
+		return err
+	}
+	req.SubscribeOnce = subscribeOnce
+	stream, err := t.client.Subscribe(ctx, req)
+	if err != nil {
+		return err
+	}
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		data := msg.GetData()
+		if len(data) == 0 {
+			data = []byte("null")
+		}
+		if err := write(data); err != nil {
+			return err
+		}
+	}
+}
+
+func (t *GRPCTransport) healthy(ctx context.Context) bool {
+	resp, err := t.health.Check(ctx, &grpc_health_v1.HealthCheckRequest{}, grpc.WaitForReady(false))
+	return err == nil && resp.GetStatus() == grpc_health_v1.HealthCheckResponse_SERVING
+}
+
+// Close closes the connection to the hooks server
+func (t *GRPCTransport) Close() error {
+	return t.conn.Close()
+}
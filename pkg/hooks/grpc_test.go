@@ -0,0 +1,177 @@
+package hooks_test
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/buger/jsonparser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/wundergraph/wundergraph/pkg/authentication"
+	"github.com/wundergraph/wundergraph/pkg/hooks"
+	"github.com/wundergraph/wundergraph/pkg/hookspb"
+	"github.com/wundergraph/wundergraph/pkg/pool"
+)
+
+type testHooksServer struct {
+	hookspb.UnimplementedHooksServer
+	requests []*hookspb.HookRequest
+}
+
+func (s *testHooksServer) Call(ctx context.Context, req *hookspb.HookRequest) (*hookspb.HookResponse, error) {
+	s.requests = append(s.requests, req)
+	switch req.GetPath() {
+	case "operation/Todos/mutatingPreResolve":
+		input, _, _, _ := jsonparser.Get(req.GetPayload(), "input")
+		input, _ = jsonparser.Set(input, []byte(strconv.Quote(req.GetUser().GetUserId())), "userId")
+		return &hookspb.HookResponse{
+			Op:                      "Todos",
+			Hook:                    "mutatingPreResolve",
+			Input:                   input,
+			SetClientRequestHeaders: map[string]string{"X-Hook": "grpc"},
+		}, nil
+	case "operation/Todos/postResolve":
+		return &hookspb.HookResponse{
+			Op:    "Todos",
+			Hook:  "postResolve",
+			Error: &hookspb.HookError{Message: "denied"},
+		}, nil
+	case "functions/users/create":
+		return &hookspb.HookResponse{
+			Response:   []byte(strconv.Quote(req.GetClientRequest().GetHeaders()["Authorization"])),
+			StatusCode: http.StatusCreated,
+		}, nil
+	}
+	return &hookspb.HookResponse{}, nil
+}
+
+func (s *testHooksServer) Subscribe(req *hookspb.HookRequest, stream hookspb.Hooks_SubscribeServer) error {
+	count := 3
+	if req.GetSubscribeOnce() {
+		count = 1
+	}
+	for ii := 0; ii < count; ii++ {
+		if err := stream.Send(&hookspb.SubscriptionMessage{Data: []byte(strconv.Itoa(ii))}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newGRPCHooksClient(t *testing.T, server *testHooksServer) *hooks.Client {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := grpc.NewServer()
+	hookspb.RegisterHooksServer(srv, server)
+	grpc_health_v1.RegisterHealthServer(srv, health.NewServer())
+	go func() {
+		_ = srv.Serve(listener)
+	}()
+	t.Cleanup(srv.Stop)
+
+	transport, err := hooks.NewGRPCTransport(hooks.GRPCOptions{Address: listener.Addr().String()})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = transport.Close()
+	})
+	return hooks.NewClient(&hooks.ClientOptions{
+		Logger: zap.NewNop(),
+		GRPC:   transport,
+	})
+}
+
+func TestGRPCHooks(t *testing.T) {
+	server := &testHooksServer{}
+	client := newGRPCHooksClient(t, server)
+	assert.True(t, client.DoHealthCheckRequest(context.Background()))
+
+	clientRequest := httptest.NewRequest(http.MethodGet, "/operations/Todos", nil)
+	clientRequest.Header.Set("Authorization", "Bearer token")
+	ctx := context.WithValue(context.Background(), pool.ClientRequestKey, clientRequest)
+	r := clientRequest.WithContext(context.WithValue(ctx, "user", &authentication.User{
+		UserID:       "1",
+		Roles:        []string{"admin"},
+		CustomClaims: map[string]interface{}{"tenant": "acme"},
+		ETag:         "internal",
+	}))
+
+	buf := &bytes.Buffer{}
+	// Numbers are kept as they are, even if they don't fit in a float64
+	data, err := hooks.EncodeData(r, buf, []byte(`{"first":10,"id":9007199254740993}`), nil)
+	require.NoError(t, err)
+	resp, err := client.DoOperationRequest(r.Context(), "Todos", hooks.MutatingPreResolve, data, buf)
+	require.NoError(t, err)
+	assert.Contains(t, string(resp.Input), `"id":9007199254740993`)
+	assert.JSONEq(t, `{"first":10,"id":9007199254740993,"userId":"1"}`, string(resp.Input))
+	assert.Equal(t, map[string]string{"X-Hook": "grpc"}, resp.SetClientRequestHeaders)
+
+	req := server.requests[0]
+	assert.Equal(t, "operation/Todos/mutatingPreResolve", req.GetPath())
+	assert.Equal(t, []string{"admin"}, req.GetUser().GetRoles())
+	assert.Equal(t, "acme", req.GetUser().GetCustomClaims().GetFields()["tenant"].GetStringValue())
+	assert.Equal(t, "GET", req.GetClientRequest().GetMethod())
+	assert.Equal(t, "Bearer token", req.GetClientRequest().GetHeaders()["Authorization"])
+	cycleCounter, err := jsonparser.GetInt(req.GetPayload(), "cycleCounter")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), cycleCounter)
+	// __wg is sent as user and clientRequest
+	_, dataType, _, _ := jsonparser.Get(req.GetPayload(), "__wg")
+	assert.Equal(t, jsonparser.NotExist, dataType)
+
+	_, err = client.DoOperationRequest(r.Context(), "Todos", hooks.PostResolve, data, buf)
+	assert.EqualError(t, err, "denied")
+
+	out, err := client.DoFunctionRequest(r.Context(), "users/create", []byte(`{"input":{"name":"Jens"}}`), buf)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, out.ClientResponseStatusCode)
+	assert.JSONEq(t, `"Bearer token"`, string(out.Response))
+	name, err := jsonparser.GetString(server.requests[2].GetPayload(), "input", "name")
+	require.NoError(t, err)
+	assert.Equal(t, "Jens", name)
+
+	// The client request body is also sent as JSON
+	_, err = client.DoFunctionRequest(context.Background(), "users/update", []byte(`{"__wg":{"clientRequest":{"method":"POST","requestURI":"/","headers":{},"body":{"id":12345678901234567890}}}}`), buf)
+	require.NoError(t, err)
+	assert.Equal(t, "POST", server.requests[3].GetClientRequest().GetMethod())
+	assert.Equal(t, `{"id":12345678901234567890}`, string(server.requests[3].GetClientRequest().GetBody()))
+}
+
+func TestGRPCFunctionSubscription(t *testing.T) {
+	client := newGRPCHooksClient(t, &testHooksServer{})
+	buf := &bytes.Buffer{}
+
+	w := httptest.NewRecorder()
+	err := client.DoFunctionSubscriptionRequest(context.Background(), "users/subscribe", nil, false, w, buf)
+	require.NoError(t, err)
+	assert.Equal(t, "012", w.Body.String())
+	assert.True(t, w.Flushed)
+
+	w = httptest.NewRecorder()
+	err = client.DoFunctionSubscriptionRequest(context.Background(), "users/subscribe", nil, true, w, buf)
+	require.NoError(t, err)
+	assert.Equal(t, "0", w.Body.String())
+}
+
+func TestGRPCHooksUnavailable(t *testing.T) {
+	transport, err := hooks.NewGRPCTransport(hooks.GRPCOptions{Address: "127.0.0.1:1"})
+	require.NoError(t, err)
+	defer transport.Close()
+	client := hooks.NewClient(&hooks.ClientOptions{Logger: zap.NewNop(), GRPC: transport})
+	assert.False(t, client.DoHealthCheckRequest(context.Background()))
+
+	// Calls wait for the server until the context is done
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = client.DoOperationRequest(ctx, "Todos", hooks.PreResolve, nil, &bytes.Buffer{})
+	assert.Error(t, err)
+}
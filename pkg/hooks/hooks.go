@@ -18,6 +18,7 @@ import (
 	"github.com/buger/jsonparser"
 	"github.com/hashicorp/go-retryablehttp"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/codes"
 	otrace "go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
@@ -119,6 +120,17 @@ type OnResponseHookResponse struct {
 	Response *WunderGraphResponse `json:"response"`
 }
 
+// OnSubscriptionEventHookResponse is the response of the onSubscriptionEvent
+// hook, sent as MiddlewareHookResponse.Response
+type OnSubscriptionEventHookResponse struct {
+	// Skip drops the message for this client
+	Skip bool `json:"skip"`
+	// Terminate ends the subscription after sending the message
+	Terminate bool `json:"terminate"`
+	// Event replaces the message when it's not null
+	Event json.RawMessage `json:"event"`
+}
+
 type MiddlewareHookResponse struct {
 	Error                   *HookResponseError `json:"error,omitempty"`
 	Op                      string             `json:"op"`
@@ -177,6 +189,7 @@ const (
 	CustomResolve              MiddlewareHook = "customResolve"
 	MutatingPreResolve         MiddlewareHook = "mutatingPreResolve"
 	MutatingPostResolve        MiddlewareHook = "mutatingPostResolve"
+	OnSubscriptionEvent        MiddlewareHook = "onSubscriptionEvent"
 	PostAuthentication         MiddlewareHook = "postAuthentication"
 	PostLogout                 MiddlewareHook = "postLogout"
 	MutatingPostAuthentication MiddlewareHook = "mutatingPostAuthentication"
@@ -194,39 +207,93 @@ const (
 type UploadHook string
 
 const (
-	PreUpload  UploadHook = "preUpload"
-	PostUpload UploadHook = "postUpload"
+	PreUpload   UploadHook = "preUpload"
+	PostUpload  UploadHook = "postUpload"
+	PreDownload UploadHook = "preDownload"
 )
 
+// requestTimeout limits each attempt to send a hook
+const requestTimeout = 60 * time.Second
+
 type Client struct {
 	serverUrl           string
 	httpClient          *retryablehttp.Client
 	subscriptionsClient *retryablehttp.Client
 	log                 *zap.Logger
+	wasm                *WasmRuntime
+	grpc                *GRPCTransport
+	pool                *ServerPool
+	policies            *HookPolicies
+	retryClients        map[*HookPolicy]*retryablehttp.Client
+	async               *AsyncHooks
+	inspector           *Inspector
+	tracing             bool
 }
 
 type ClientOptions struct {
 	ServerURL     string
 	EnableTracing bool
 	Logger        *zap.Logger
+	// Wasm runs the hooks implemented by its module in-process, instead
+	// of calling the hooks server
+	Wasm *WasmRuntime
+	// GRPC sends the hooks to the server using gRPC instead of JSON over
+	// HTTP, ServerURL is ignored when it's set
+	GRPC *GRPCTransport
+	// Pool distributes the hooks between several servers, ServerURL is
+	// ignored when it's set. The client runs the health checks of the pool
+	// until it's closed.
+	Pool *ServerPool
+	// Policies configure the timeout, retries and failure handling of the
+	// hooks, subscriptions are not affected
+	Policies *HookPolicies
+	// Async sends the hooks it handles in the background, returning an
+	// empty response. The client runs its workers until it's closed.
+	Async *AsyncHooks
+	// Inspector records the hooks sent by the client when it's not nil
+	Inspector *Inspector
 }
 
 func NewClient(opts *ClientOptions) *Client {
 
-	rt := http.DefaultTransport
+	rt := http.RoundTripper(attemptsTransport{rt: http.DefaultTransport})
 
 	if opts.EnableTracing {
 		rt = trace.NewTransport(rt,
 			otelhttp.WithSpanOptions(otrace.WithAttributes(trace.HooksClientAttribute)),
 		)
 	}
+	serverURL := opts.ServerURL
+	if opts.Pool != nil {
+		// Each attempt is sent to a server picked by the pool
+		rt = opts.Pool.Transport(rt)
+		serverURL = opts.Pool.URL()
+	}
 
-	return &Client{
-		serverUrl:           opts.ServerURL,
-		httpClient:          buildClient(60*time.Second, rt),
+	c := &Client{
+		serverUrl:           serverURL,
+		httpClient:          buildClient(requestTimeout, rt),
 		subscriptionsClient: buildClient(0, rt),
 		log:                 opts.Logger,
+		wasm:                opts.Wasm,
+		grpc:                opts.GRPC,
+		pool:                opts.Pool,
+		policies:            opts.Policies,
+		retryClients:        opts.Policies.retryClients(rt),
+		async:               opts.Async,
+		inspector:           opts.Inspector,
+		tracing:             opts.EnableTracing,
 	}
+	if c.log == nil {
+		c.log = zap.NewNop()
+	}
+	if c.pool != nil {
+		c.pool.runHealthChecks(c.checkServerHealth)
+	}
+	if c.async != nil {
+		c.async.start(c.sendAsync)
+	}
+	return c
 }
 
 func buildClient(requestTimeout time.Duration, rt http.RoundTripper) *retryablehttp.Client {
@@ -264,6 +331,37 @@ func (c *Client) DoOperationRequest(ctx context.Context, operationName string, h
 
 func (c *Client) DoFunctionRequest(ctx context.Context, operationName string, jsonData []byte, buf *bytes.Buffer) (*MiddlewareHookResponse, error) {
 	jsonData = c.setInternalHookData(ctx, jsonData, buf)
+	policy := c.policies.match("functions/" + operationName)
+	ctx, cancel := policy.withTimeout(ctx)
+	defer cancel()
+	hookRes, err := c.sendFunction(ctx, policy, operationName, jsonData)
+	if err != nil {
+		// There's no original data to continue with, functions fail open
+		// only with a fallback response
+		if policy == nil || policy.FailureMode != FailFallback {
+			return nil, err
+		}
+		c.log.Warn("function failed, continuing with its fallback response",
+			zap.String("function", operationName),
+			zap.Error(err),
+		)
+		hookRes = &MiddlewareHookResponse{ClientResponseStatusCode: http.StatusOK}
+		if err := json.Unmarshal(policy.Fallback, hookRes); err != nil {
+			return nil, fmt.Errorf("error decoding function response: %w", err)
+		}
+	}
+
+	if err := functionResponseError(hookRes); err != nil {
+		return nil, err
+	}
+
+	return hookRes, nil
+}
+
+func (c *Client) sendFunction(ctx context.Context, policy *HookPolicy, operationName string, jsonData []byte) (*MiddlewareHookResponse, error) {
+	if c.grpc != nil {
+		return c.doGRPCFunctionRequest(ctx, operationName, jsonData)
+	}
 	r, err := http.NewRequestWithContext(ctx, "POST", c.serverUrl+"/functions/"+operationName, bytes.NewReader(jsonData))
 	if err != nil {
 		return nil, err
@@ -277,7 +375,7 @@ func (c *Client) DoFunctionRequest(ctx context.Context, operationName string, js
 		return nil, err
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.retryClient(policy).Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("error calling function %s: %w", operationName, err)
 	}
@@ -292,22 +390,44 @@ func (c *Client) DoFunctionRequest(ctx context.Context, operationName string, js
 		return nil, fmt.Errorf("error decoding function response: %w", err)
 	}
 
+	hookRes.ClientResponseStatusCode = resp.StatusCode
+
+	return &hookRes, nil
+}
+
+func (c *Client) doGRPCFunctionRequest(ctx context.Context, operationName string, jsonData []byte) (*MiddlewareHookResponse, error) {
+	data, statusCode, err := c.grpc.call(ctx, "functions/"+operationName, jsonData)
+	if err != nil {
+		return nil, fmt.Errorf("error calling function %s: %w", operationName, err)
+	}
+
+	var hookRes MiddlewareHookResponse
+	if err := json.Unmarshal(data, &hookRes); err != nil {
+		return nil, fmt.Errorf("error decoding function response: %w", err)
+	}
+
+	hookRes.ClientResponseStatusCode = statusCode
+
+	return &hookRes, nil
+}
+
+func functionResponseError(hookRes *MiddlewareHookResponse) error {
 	if err := hookRes.ResponseError(); err != nil {
 		if err.Error() == "" {
 			// Hook failed but didn't report a message
-			return nil, fmt.Errorf("hook %s failed for operation %s", hookRes.HookName(), hookRes.OperationName())
+			return fmt.Errorf("hook %s failed for operation %s", hookRes.HookName(), hookRes.OperationName())
 		}
 
-		return nil, err
+		return err
 	}
-
-	hookRes.ClientResponseStatusCode = resp.StatusCode
-
-	return &hookRes, nil
+	return nil
 }
 
 func (c *Client) DoFunctionSubscriptionRequest(ctx context.Context, operationName string, jsonData []byte, subscribeOnce bool, out io.Writer, buf *bytes.Buffer) error {
 	jsonData = c.setInternalHookData(ctx, jsonData, buf)
+	if c.grpc != nil {
+		return c.doGRPCFunctionSubscriptionRequest(ctx, operationName, jsonData, subscribeOnce, out)
+	}
 	r, err := http.NewRequestWithContext(ctx, "POST", c.serverUrl+"/functions/"+operationName, bytes.NewReader(jsonData))
 	if err != nil {
 		return err
@@ -376,6 +496,31 @@ func (c *Client) DoFunctionSubscriptionRequest(ctx context.Context, operationNam
 	}
 }
 
+func (c *Client) doGRPCFunctionSubscriptionRequest(ctx context.Context, operationName string, jsonData []byte, subscribeOnce bool, out io.Writer) error {
+	flusher, ok := out.(http.Flusher)
+	if !ok {
+		return fmt.Errorf("client connection is not flushable")
+	}
+
+	err := c.grpc.subscribe(ctx, "functions/"+operationName, jsonData, subscribeOnce, func(data []byte) error {
+		if _, err := out.Write(data); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return fmt.Errorf("error writing to client: %w", err)
+		}
+		flusher.Flush()
+		return nil
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		return fmt.Errorf("error calling function %s: %w", operationName, err)
+	}
+	return nil
+}
+
 func (c *Client) DoAuthenticationRequest(ctx context.Context, hook MiddlewareHook, jsonData []byte, buf *bytes.Buffer) (*MiddlewareHookResponse, error) {
 	return c.doMiddlewareRequest(ctx, "authentication", hook, "", jsonData, buf)
 }
@@ -408,35 +553,153 @@ func (c *Client) setInternalHookData(ctx context.Context, jsonData []byte, buf *
 
 func (c *Client) doRequest(ctx context.Context, hookResponse HookResponse, action string, hook MiddlewareHook, hookID string, jsonData []byte, buf *bytes.Buffer) error {
 	jsonData = c.setInternalHookData(ctx, jsonData, buf)
-	hookURL := c.serverUrl + "/" + action + "/" + string(hook)
+	hookPath := action + "/" + string(hook)
 	if hookID != "" {
-		hookURL += "/" + hookID
+		hookPath += "/" + hookID
+	}
+	if c.async.handles(hook, hookPath) {
+		c.async.enqueue(ctx, hook, hookPath, jsonData)
+		return decodeHookResponse(hook, []byte(`{}`), hookResponse)
+	}
+	policy := c.policies.match(hookPath)
+	ctx, cancel := policy.withTimeout(ctx)
+	defer cancel()
+	data, err := c.invokeHook(ctx, policy, hook, hookPath, jsonData, false)
+	if err != nil {
+		if data = policy.failureResponse(hook, jsonData); data == nil {
+			return err
+		}
+		c.log.Warn("hook failed, continuing with its failure policy",
+			zap.String("hook", hookPath),
+			zap.Error(err),
+		)
 	}
-	r, err := http.NewRequestWithContext(ctx, "POST", hookURL, bytes.NewReader(jsonData))
+	return decodeHookResponse(hook, data, hookResponse)
+}
+
+// sendAsync sends a hook queued by AsyncHooks
+func (c *Client) sendAsync(ctx context.Context, h *asyncHook) error {
+	ctx = context.WithValue(ctx, logging.RequestIDKey{}, h.RequestID)
+	policy := c.policies.match(h.Path)
+	ctx, cancel := policy.withTimeout(ctx)
+	defer cancel()
+	data, err := c.invokeHook(ctx, policy, h.Hook, h.Path, h.Payload, true)
 	if err != nil {
 		return err
 	}
+	var hookResponse MiddlewareHookResponse
+	return decodeHookResponse(h.Hook, data, &hookResponse)
+}
+
+// invokeHook sends the hook in its own span, recording it in the inspector
+func (c *Client) invokeHook(ctx context.Context, policy *HookPolicy, hook MiddlewareHook, hookPath string, jsonData []byte, async bool) ([]byte, error) {
+	operationName := hookOperationName(ctx, hookPath)
+	span := otrace.SpanFromContext(context.Background())
+	if c.tracing {
+		ctx, span = trace.TracerFromContext(ctx).Start(ctx, "hook "+hookPath, otrace.WithAttributes(
+			trace.HooksClientAttribute,
+			trace.WgHookName.String(string(hook)),
+			trace.WgOperationName.String(operationName),
+		))
+	}
+	defer span.End()
+
+	ctx, attempts := withAttempts(ctx)
+	start := time.Now()
+	data, err := c.sendHook(ctx, policy, hook, hookPath, jsonData)
+	duration := time.Since(start)
+
+	span.SetAttributes(trace.WgHookRetries.Int(retries(attempts)))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	if c.inspector != nil {
+		invocation := HookInvocation{
+			Time:      start,
+			Hook:      string(hook),
+			Path:      hookPath,
+			Operation: operationName,
+			RequestID: logging.RequestIDFromContext(ctx),
+			Payload:   append(json.RawMessage(nil), jsonData...),
+			Duration:  duration,
+			Retries:   retries(attempts),
+			Async:     async,
+		}
+		if err != nil {
+			invocation.Error = err.Error()
+		} else if json.Valid(data) {
+			invocation.Response = append(json.RawMessage(nil), data...)
+		}
+		c.inspector.record(invocation)
+	}
+	return data, err
+}
+
+// sendHook returns the response of the hook. Errors reported by the hook are
+// part of its response, the returned error means the hook failed.
+func (c *Client) sendHook(ctx context.Context, policy *HookPolicy, hook MiddlewareHook, hookPath string, jsonData []byte) ([]byte, error) {
+	if c.wasm.Handles(hookPath) {
+		data, err := c.wasm.Execute(ctx, hookPath, jsonData)
+		if err != nil {
+			return nil, fmt.Errorf("hook %s failed with error: %w", string(hook), err)
+		}
+		return data, nil
+	}
+	if c.grpc != nil {
+		data, _, err := c.grpc.call(ctx, hookPath, jsonData)
+		if err != nil {
+			return nil, fmt.Errorf("hook %s failed with error: %w", string(hook), err)
+		}
+		return data, nil
+	}
+	r, err := http.NewRequestWithContext(ctx, "POST", c.serverUrl+"/"+hookPath, bytes.NewReader(jsonData))
+	if err != nil {
+		return nil, err
+	}
 
 	r.Header.Set("Content-Type", "application/json")
 	r.Header.Set(logging.RequestIDHeader, logging.RequestIDFromContext(ctx))
 
 	req, err := retryablehttp.FromRequest(r)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.retryClient(policy).Do(req)
 	if err != nil {
-		return fmt.Errorf("hook %s failed with error: %w", string(hook), err)
+		return nil, fmt.Errorf("hook %s failed with error: %w", string(hook), err)
 	}
 	defer resp.Body.Close()
 
 	data, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Errorf("hook %s failed with reading body with error: %w", string(hook), err)
+		return nil, fmt.Errorf("hook %s failed with reading body with error: %w", string(hook), err)
+	}
+
+	// Hooks report their errors with a 500, keep the response to propagate the message
+	if resp.StatusCode != http.StatusOK && !hasResponseError(data) {
+		return nil, fmt.Errorf("hook %s failed with invalid status code: %d (%s)", string(hook), resp.StatusCode, string(data))
 	}
 
-	// Decode the hook response first, since we might want to propagate the error message
+	return data, nil
+}
+
+// retryClient returns the HTTP client for hooks using the given policy
+func (c *Client) retryClient(policy *HookPolicy) *retryablehttp.Client {
+	if client := c.retryClients[policy]; client != nil {
+		return client
+	}
+	return c.httpClient
+}
+
+func hasResponseError(data []byte) bool {
+	_, dataType, _, err := jsonparser.Get(data, "error")
+	return err == nil && dataType == jsonparser.Object
+}
+
+func decodeHookResponse(hook MiddlewareHook, data []byte, hookResponse HookResponse) error {
 	if err := json.Unmarshal(data, hookResponse); err != nil {
 		return fmt.Errorf("hook %s response could not be decoded: %w", string(hook), err)
 	}
@@ -449,10 +712,6 @@ func (c *Client) doRequest(ctx context.Context, hookResponse HookResponse, actio
 		return err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("hook %s failed with invalid status code: %d (%s)", string(hook), resp.StatusCode, string(data))
-	}
-
 	return nil
 }
 
@@ -465,6 +724,12 @@ func (c *Client) doMiddlewareRequest(ctx context.Context, action string, hook Mi
 }
 
 func (c *Client) DoHealthCheckRequest(ctx context.Context) (status bool) {
+	if c.grpc != nil {
+		return c.grpc.healthy(ctx)
+	}
+	if c.pool != nil {
+		return c.pool.checkHealth(ctx, c.checkServerHealth)
+	}
 	req, err := retryablehttp.NewRequestWithContext(ctx, "GET", c.serverUrl+"/health", nil)
 	if err != nil {
 		return false
@@ -477,6 +742,21 @@ func (c *Client) DoHealthCheckRequest(ctx context.Context) (status bool) {
 	return resp.StatusCode == 200
 }
 
+// checkServerHealth checks a server of the pool without retrying, so
+// failing servers are detected quickly
+func (c *Client) checkServerHealth(ctx context.Context, serverURL string) bool {
+	req, err := http.NewRequestWithContext(ctx, "GET", serverURL+"/health", nil)
+	if err != nil {
+		return false
+	}
+	resp, err := c.httpClient.HTTPClient.Do(req)
+	if err != nil {
+		return false
+	}
+	defer resp.Body.Close()
+	return resp.StatusCode == 200
+}
+
 func encodeData(r *http.Request, w *bytes.Buffer, variables []byte, response []byte) ([]byte, error) {
 	const (
 		wgKey = "__wg"
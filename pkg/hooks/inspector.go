@@ -0,0 +1,137 @@
+package hooks
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/wundergraph/wundergraph/pkg/operation"
+)
+
+const defaultInspectorSize = 100
+
+// HookInvocation is a hook call recorded by an Inspector
+type HookInvocation struct {
+	Time      time.Time       `json:"time"`
+	Hook      string          `json:"hook"`
+	Path      string          `json:"path"`
+	Operation string          `json:"operation,omitempty"`
+	RequestID string          `json:"requestId,omitempty"`
+	Payload   json.RawMessage `json:"payload"`
+	// Response is empty if the hook failed
+	Response json.RawMessage `json:"response,omitempty"`
+	Error    string          `json:"error,omitempty"`
+	Duration time.Duration   `json:"durationNs"`
+	Retries  int             `json:"retries"`
+	Async    bool            `json:"async,omitempty"`
+}
+
+// Inspector keeps the latest hook invocations of a Client, to debug hooks
+// during development
+type Inspector struct {
+	mu          sync.Mutex
+	invocations []HookInvocation
+	next        int
+	full        bool
+}
+
+// NewInspector returns an Inspector keeping the given number of invocations,
+// defaults to 100
+func NewInspector(size int) *Inspector {
+	if size <= 0 {
+		size = defaultInspectorSize
+	}
+	return &Inspector{
+		invocations: make([]HookInvocation, size),
+	}
+}
+
+func (i *Inspector) record(invocation HookInvocation) {
+	if i == nil {
+		return
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.invocations[i.next] = invocation
+	i.next = (i.next + 1) % len(i.invocations)
+	if i.next == 0 {
+		i.full = true
+	}
+}
+
+// Invocations returns the recorded invocations, oldest first
+func (i *Inspector) Invocations() []HookInvocation {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if !i.full {
+		return append([]HookInvocation(nil), i.invocations[:i.next]...)
+	}
+	invocations := make([]HookInvocation, 0, len(i.invocations))
+	invocations = append(invocations, i.invocations[i.next:]...)
+	return append(invocations, i.invocations[:i.next]...)
+}
+
+// Mount adds the endpoint listing the invocations to the router
+func (i *Inspector) Mount(router *mux.Router) {
+	router.Path("/hooks/invocations").Methods(http.MethodGet).HandlerFunc(i.listInvocations)
+}
+
+func (i *Inspector) listInvocations(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	hook, operationName := query.Get("hook"), query.Get("operation")
+	invocations := i.Invocations()
+	filtered := invocations[:0]
+	for _, invocation := range invocations {
+		if (hook == "" || invocation.Hook == hook) && (operationName == "" || invocation.Operation == operationName) {
+			filtered = append(filtered, invocation)
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(filtered)
+}
+
+// hookOperationName returns the operation the hook is called for, if any
+func hookOperationName(ctx context.Context, hookPath string) string {
+	if strings.HasPrefix(hookPath, "operation/") {
+		if idx := strings.LastIndexByte(hookPath, '/'); idx > len("operation/") {
+			return hookPath[len("operation/"):idx]
+		}
+	}
+	if metadata := operation.MetadataFromContext(ctx); metadata != nil {
+		return metadata.OperationName
+	}
+	return ""
+}
+
+type attemptsKey struct{}
+
+// withAttempts returns a context counting the requests sent for a hook by
+// attemptsTransport, including retries
+func withAttempts(ctx context.Context) (context.Context, *int32) {
+	attempts := new(int32)
+	return context.WithValue(ctx, attemptsKey{}, attempts), attempts
+}
+
+func retries(attempts *int32) int {
+	if n := atomic.LoadInt32(attempts); n > 1 {
+		return int(n) - 1
+	}
+	return 0
+}
+
+type attemptsTransport struct {
+	rt http.RoundTripper
+}
+
+func (t attemptsTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if attempts, ok := r.Context().Value(attemptsKey{}).(*int32); ok {
+		atomic.AddInt32(attempts, 1)
+	}
+	return t.rt.RoundTrip(r)
+}
@@ -0,0 +1,107 @@
+package hooks_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	"go.uber.org/zap"
+
+	"github.com/wundergraph/wundergraph/pkg/hooks"
+	"github.com/wundergraph/wundergraph/pkg/trace"
+	"github.com/wundergraph/wundergraph/pkg/trace/tracetest"
+)
+
+func TestInspector(t *testing.T) {
+	var requests int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/operation/Failing/preResolve":
+			w.WriteHeader(http.StatusBadGateway)
+		case "/operation/Flaky/preResolve":
+			// Fails the first attempt
+			if atomic.AddInt64(&requests, 1) == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_, _ = w.Write([]byte(`{"op":"Flaky"}`))
+		default:
+			_, _ = w.Write([]byte(`{}`))
+		}
+	}))
+	defer srv.Close()
+	exporter := tracetest.NewInMemoryExporter(t)
+	noRetries := 0
+	policies, err := hooks.NewHookPolicies([]hooks.HookPolicy{{Hook: "operation/Failing/*", MaxRetries: &noRetries}})
+	require.NoError(t, err)
+	inspector := hooks.NewInspector(3)
+	client := hooks.NewClient(&hooks.ClientOptions{
+		ServerURL:     srv.URL,
+		EnableTracing: true,
+		Logger:        zap.NewNop(),
+		Policies:      policies,
+		Inspector:     inspector,
+	})
+	ctx := context.Background()
+
+	_, err = client.DoOperationRequest(ctx, "Flaky", hooks.PreResolve, []byte(`{"input":{"id":1}}`), &bytes.Buffer{})
+	require.NoError(t, err)
+	_, err = client.DoOperationRequest(ctx, "Failing", hooks.PreResolve, []byte(`{}`), &bytes.Buffer{})
+	require.Error(t, err)
+
+	invocations := inspector.Invocations()
+	require.Len(t, invocations, 2)
+	assert.Equal(t, "operation/Flaky/preResolve", invocations[0].Path)
+	assert.Equal(t, "preResolve", invocations[0].Hook)
+	assert.Equal(t, "Flaky", invocations[0].Operation)
+	assert.JSONEq(t, `{"input":{"id":1}}`, string(invocations[0].Payload))
+	assert.JSONEq(t, `{"op":"Flaky"}`, string(invocations[0].Response))
+	assert.Equal(t, 1, invocations[0].Retries)
+	assert.Empty(t, invocations[0].Error)
+	assert.Greater(t, invocations[0].Duration.Nanoseconds(), int64(0))
+	assert.Equal(t, "Failing", invocations[1].Operation)
+	assert.Empty(t, invocations[1].Response)
+	assert.Contains(t, invocations[1].Error, "giving up after 1 attempt")
+
+	var spans []string
+	for _, span := range exporter.GetSpans() {
+		if span.Name != "hook operation/Failing/preResolve" {
+			spans = append(spans, span.Name)
+			continue
+		}
+		assert.Equal(t, codes.Error, span.Status.Code)
+		assert.Contains(t, span.Attributes, trace.WgHookName.String("preResolve"))
+		assert.Contains(t, span.Attributes, trace.WgOperationName.String("Failing"))
+		assert.Contains(t, span.Attributes, trace.WgHookRetries.Int(0))
+	}
+	assert.Contains(t, spans, "hook operation/Flaky/preResolve")
+
+	// The oldest invocations are replaced once the inspector is full
+	for ii := 0; ii < 3; ii++ {
+		_, err = client.DoOperationRequest(ctx, fmt.Sprintf("Op%d", ii), hooks.PostResolve, []byte(`{}`), &bytes.Buffer{})
+		require.NoError(t, err)
+	}
+	invocations = inspector.Invocations()
+	require.Len(t, invocations, 3)
+	assert.Equal(t, "Op0", invocations[0].Operation)
+	assert.Equal(t, "Op2", invocations[2].Operation)
+
+	router := mux.NewRouter()
+	inspector.Mount(router)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/hooks/invocations?operation=Op1", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var listed []hooks.HookInvocation
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &listed))
+	require.Len(t, listed, 1)
+	assert.Equal(t, "operation/Op1/postResolve", listed[0].Path)
+}
@@ -2,6 +2,8 @@ package hooks
 
 import (
 	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -12,6 +14,7 @@ import (
 	"github.com/wundergraph/graphql-go-tools/pkg/engine/resolve"
 	"github.com/wundergraph/graphql-go-tools/pkg/lexer/literal"
 
+	"github.com/wundergraph/wundergraph/pkg/authentication"
 	"github.com/wundergraph/wundergraph/pkg/pool"
 	"github.com/wundergraph/wundergraph/pkg/postresolvetransform"
 	"github.com/wundergraph/wundergraph/pkg/wgpb"
@@ -55,6 +58,7 @@ type PipelineConfig struct {
 	Operation   *wgpb.Operation
 	Transformer *postresolvetransform.Transformer
 	Logger      *zap.Logger
+	Expressions OperationExpressions
 }
 
 type SynchronousOperationPipelineConfig struct {
@@ -78,6 +82,7 @@ type pipeline struct {
 	logger                 *zap.Logger
 	operation              *wgpb.Operation
 	postResolveTransformer *postresolvetransform.Transformer
+	expressions            OperationExpressions
 	ResolveConfig          ResolveConfiguration
 	PostResolveConfig      PostResolveConfiguration
 }
@@ -90,6 +95,7 @@ func newPipeline(config PipelineConfig) pipeline {
 		logger:                 config.Logger,
 		operation:              config.Operation,
 		postResolveTransformer: config.Transformer,
+		expressions:            config.Expressions,
 		ResolveConfig: ResolveConfiguration{
 			Pre:         hooksConfig.GetPreResolve(),
 			MutatingPre: hooksConfig.GetMutatingPreResolve(),
@@ -115,9 +121,10 @@ func NewSynchronousOperationPipeline(config SynchronousOperationPipelineConfig)
 
 func NewSubscriptionOperationPipeline(config SubscriptionOperationPipelineConfig) *SubscriptionOperationPipeline {
 	return &SubscriptionOperationPipeline{
-		pipeline: newPipeline(config.PipelineConfig),
-		resolver: config.Resolver,
-		plan:     config.Plan,
+		pipeline:            newPipeline(config.PipelineConfig),
+		resolver:            config.Resolver,
+		plan:                config.Plan,
+		onSubscriptionEvent: config.Operation.GetHooksConfiguration().GetOnSubscriptionEvent(),
 	}
 }
 
@@ -129,6 +136,10 @@ func (p *pipeline) updateContextHeaders(ctx *resolve.Context, headers map[string
 	for name := range headers {
 		httpHeader.Set(name, headers[name])
 	}
+	p.setContextHeaders(ctx, httpHeader)
+}
+
+func (p *pipeline) setContextHeaders(ctx *resolve.Context, httpHeader http.Header) {
 	ctx.Request.Header = httpHeader
 	clientRequest := ctx.Context().Value(pool.ClientRequestKey)
 	if clientRequest == nil {
@@ -157,6 +168,27 @@ func (p *pipeline) handleHookResponse(ctx *resolve.Context, w http.ResponseWrite
 	return false
 }
 
+// evaluateExpressions runs the expression hooks for the given stage. If an
+// expression rejects the request, the error is written to w and done is true.
+// Without a writer, the rejection is returned as an error.
+func (p *pipeline) evaluateExpressions(ctx *resolve.Context, w http.ResponseWriter, r *http.Request, expressions *ExpressionHooks, input *ExpressionInput) (done bool, err error) {
+	input.OperationName = p.operation.Name
+	input.OperationType = p.operation.OperationType.String()
+	if r != nil {
+		input.User = authentication.UserFromContext(r.Context())
+	}
+	err = expressions.Evaluate(ctx.Context(), input)
+	var rejected *ExpressionRejectedError
+	if w == nil || !errors.As(err, &rejected) {
+		return false, err
+	}
+	http.Error(w, rejected.Message, rejected.StatusCode)
+	if flusher, ok := w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+	return true, nil
+}
+
 // PreResolve runs the pre-resolution hooks
 func (p *pipeline) PreResolve(ctx *resolve.Context, w http.ResponseWriter, r *http.Request) (*Response, error) {
 	// XXX: Errors returned by Client.DoOperationRequest() already contain the hook name, do not reannotate them!
@@ -168,6 +200,28 @@ func (p *pipeline) PreResolve(ctx *resolve.Context, w http.ResponseWriter, r *ht
 
 	var resp *MiddlewareHookResponse
 	data := ctx.Variables
+	if p.expressions.PreResolve != nil {
+		headers := ctx.Request.Header.Clone()
+		if headers == nil {
+			headers = http.Header{}
+		}
+		input := &ExpressionInput{
+			Headers:   headers,
+			Variables: data,
+		}
+		done, err := p.evaluateExpressions(ctx, w, r, p.expressions.PreResolve, input)
+		if err != nil {
+			return nil, err
+		}
+		if done {
+			return &Response{
+				Done: true,
+			}, nil
+		}
+		p.setContextHeaders(ctx, input.Headers)
+		data = input.Variables
+	}
+
 	if p.ResolveConfig.Pre {
 		hookData, err := EncodeData(r, hookBuf, data, nil)
 		if err != nil {
@@ -262,6 +316,23 @@ func (p *pipeline) PostResolve(ctx *resolve.Context, w http.ResponseWriter, r *h
 	payloadBuf := pool.GetBytesBuffer()
 	defer pool.PutBytesBuffer(payloadBuf)
 
+	if p.expressions.PostResolve != nil {
+		input := &ExpressionInput{
+			Headers:   ctx.Request.Header,
+			Variables: ctx.Variables,
+			Response:  responseData,
+		}
+		done, err := p.evaluateExpressions(ctx, w, r, p.expressions.PostResolve, input)
+		if err != nil {
+			return nil, err
+		}
+		if done {
+			return &Response{
+				Done: true,
+			}, nil
+		}
+	}
+
 	if p.PostResolveConfig.Post {
 		postResolveData, err := EncodeData(r, hookBuf, ctx.Variables, responseData)
 		if err != nil {
@@ -359,8 +430,20 @@ func (p *SynchronousOperationPipeline) Run(ctx *resolve.Context, w http.Response
 
 type SubscriptionOperationPipeline struct {
 	pipeline
-	resolver SubscriptionResolver
-	plan     *plan.SubscriptionResponsePlan
+	resolver            SubscriptionResolver
+	plan                *plan.SubscriptionResponsePlan
+	onSubscriptionEvent bool
+}
+
+// SubscriptionEvent is a subscription message after running the
+// onSubscriptionEvent hooks
+type SubscriptionEvent struct {
+	// Data is the message sent to the client
+	Data []byte
+	// Skip indicates the message must not be sent to this client
+	Skip bool
+	// Terminate ends the subscription after sending Data, unless it's skipped
+	Terminate bool
 }
 
 // RunSubscription runs the pre-resolution hooks and resolves the subscription if needed. The writer is responsible for
@@ -394,3 +477,88 @@ func (p *SubscriptionOperationPipeline) RunSubscription(ctx *resolve.Context, w
 		Done: true,
 	}, nil
 }
+
+// HandlesSubscriptionEvents returns whether OnSubscriptionEvent might skip,
+// modify or terminate the messages
+func (p *SubscriptionOperationPipeline) HandlesSubscriptionEvents() bool {
+	return p.onSubscriptionEvent || p.expressions.SubscriptionEvent != nil
+}
+
+// OnSubscriptionEvent runs the onSubscriptionEvent expressions and hook for a
+// message sent to the client of the request. Rejections and errors reported by
+// the hook terminate the subscription with the error as the last message.
+func (p *SubscriptionOperationPipeline) OnSubscriptionEvent(ctx *resolve.Context, r *http.Request, data []byte) (*SubscriptionEvent, error) {
+	if p.expressions.SubscriptionEvent != nil {
+		input := &ExpressionInput{
+			OperationName: p.operation.Name,
+			OperationType: p.operation.OperationType.String(),
+			Headers:       ctx.Request.Header,
+			Variables:     ctx.Variables,
+			Event:         data,
+		}
+		if r != nil {
+			input.User = authentication.UserFromContext(r.Context())
+		}
+		err := p.expressions.SubscriptionEvent.Evaluate(ctx.Context(), input)
+		var rejected *ExpressionRejectedError
+		switch {
+		case errors.Is(err, ErrExpressionSkipped):
+			return &SubscriptionEvent{Skip: true}, nil
+		case errors.As(err, &rejected):
+			return TerminatedSubscriptionEvent(rejected.Message)
+		case err != nil:
+			return nil, err
+		}
+		data = input.Event
+	}
+
+	if !p.onSubscriptionEvent {
+		return &SubscriptionEvent{Data: data}, nil
+	}
+
+	hookBuf := pool.GetBytesBuffer()
+	defer pool.PutBytesBuffer(hookBuf)
+
+	payloadBuf := pool.GetBytesBuffer()
+	defer pool.PutBytesBuffer(payloadBuf)
+
+	hookData, err := EncodeData(r, hookBuf, ctx.Variables, data)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := p.client.DoOperationRequest(ctx.Context(), p.operation.Name, OnSubscriptionEvent, hookData, payloadBuf)
+	if err != nil {
+		var hookErr *HookResponseError
+		if errors.As(err, &hookErr) {
+			return TerminatedSubscriptionEvent(hookErr.Message)
+		}
+		return nil, err
+	}
+	var hookResponse OnSubscriptionEventHookResponse
+	if len(resp.Response) != 0 {
+		if err := json.Unmarshal(resp.Response, &hookResponse); err != nil {
+			return nil, fmt.Errorf("hook %s response could not be decoded: %w", OnSubscriptionEvent, err)
+		}
+	}
+	event := &SubscriptionEvent{
+		Data:      data,
+		Skip:      hookResponse.Skip,
+		Terminate: hookResponse.Terminate,
+	}
+	if len(hookResponse.Event) != 0 && !bytes.Equal(hookResponse.Event, literal.NULL) {
+		event.Data = hookResponse.Event
+	}
+	return event, nil
+}
+
+// TerminatedSubscriptionEvent returns an event sending the error to the
+// client and terminating the subscription
+func TerminatedSubscriptionEvent(message string) (*SubscriptionEvent, error) {
+	data, err := json.Marshal(map[string]interface{}{
+		"errors": []map[string]string{{"message": message}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &SubscriptionEvent{Data: data, Terminate: true}, nil
+}
@@ -0,0 +1,166 @@
+package hooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/buger/jsonparser"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+type FailureMode int
+
+const (
+	// FailClosed fails the request when the hook fails
+	FailClosed FailureMode = iota
+	// FailOpen continues with the original data, as if the hook didn't
+	// modify it
+	FailOpen
+	// FailFallback uses the fallback response of the policy
+	FailFallback
+)
+
+// HookPolicy configures the hooks matching Hook. A hook fails when it can't
+// be sent, times out or its server responds with an invalid status code.
+// Errors returned by the hook itself, e.g. a denied request, are always
+// propagated.
+type HookPolicy struct {
+	// Hook is matched against the hook path like ServerRoute.Hook, e.g.
+	// operation/*/postResolve or operation/Todos/**
+	Hook string
+	// Timeout for the hook including its retries. Zero means no timeout
+	// besides the 60s limit for each attempt.
+	Timeout time.Duration
+	// MaxRetries overrides the default of 40 retries when it's not nil
+	MaxRetries  *int
+	FailureMode FailureMode
+	// Fallback is the JSON response of the hook used with FailFallback
+	Fallback []byte
+}
+
+// HookPolicies holds the policies of a Client, the first policy matching a
+// hook is used
+type HookPolicies struct {
+	policies []HookPolicy
+}
+
+func NewHookPolicies(policies []HookPolicy) (*HookPolicies, error) {
+	for _, policy := range policies {
+		if _, err := path.Match(policy.Hook, ""); err != nil {
+			return nil, fmt.Errorf("invalid hook policy %q: %w", policy.Hook, err)
+		}
+		if policy.MaxRetries != nil && *policy.MaxRetries < 0 {
+			return nil, fmt.Errorf("hook policy %q: negative maxRetries", policy.Hook)
+		}
+		if policy.FailureMode == FailFallback && !json.Valid(policy.Fallback) {
+			return nil, fmt.Errorf("hook policy %q: fallback response is not valid JSON", policy.Hook)
+		}
+	}
+	return &HookPolicies{policies: policies}, nil
+}
+
+// match returns the policy for the given hook or nil
+func (p *HookPolicies) match(hookPath string) *HookPolicy {
+	if p == nil {
+		return nil
+	}
+	for ii := range p.policies {
+		if matchHookPath(p.policies[ii].Hook, hookPath) {
+			return &p.policies[ii]
+		}
+	}
+	return nil
+}
+
+// retryClients returns the HTTP clients for the policies overriding the
+// number of retries
+func (p *HookPolicies) retryClients(rt http.RoundTripper) map[*HookPolicy]*retryablehttp.Client {
+	if p == nil {
+		return nil
+	}
+	clients := make(map[*HookPolicy]*retryablehttp.Client)
+	for ii := range p.policies {
+		policy := &p.policies[ii]
+		if policy.MaxRetries == nil {
+			continue
+		}
+		client := buildClient(requestTimeout, rt)
+		client.RetryMax = *policy.MaxRetries
+		clients[policy] = client
+	}
+	return clients
+}
+
+// hookContextKey stores the context of a hook call, before the timeout of
+// the http.Client is applied to it
+type hookContextKey struct{}
+
+// withTimeout returns ctx limited by the timeout of the policy
+func (p *HookPolicy) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	cancel := func() {}
+	if p != nil && p.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, p.Timeout)
+	}
+	return context.WithValue(ctx, hookContextKey{}, ctx), cancel
+}
+
+// hookContextDone returns true if the context of the hook call that sent r
+// was canceled or ran out of time, which isn't a failure of the server
+func hookContextDone(r *http.Request) bool {
+	if ctx, ok := r.Context().Value(hookContextKey{}).(context.Context); ok {
+		return ctx.Err() != nil
+	}
+	return false
+}
+
+// failureResponse returns the response used instead of the one from a failed
+// hook, or nil if the hook must fail
+func (p *HookPolicy) failureResponse(hook MiddlewareHook, jsonData []byte) []byte {
+	if p == nil {
+		return nil
+	}
+	switch p.FailureMode {
+	case FailOpen:
+		return failOpenResponse(hook, jsonData)
+	case FailFallback:
+		return p.Fallback
+	}
+	return nil
+}
+
+// failOpenResponse returns a response leaving the data passed to the hook
+// unchanged
+func failOpenResponse(hook MiddlewareHook, jsonData []byte) []byte {
+	switch hook {
+	case MockResolve:
+		// There's no data to continue with
+		return nil
+	case CustomResolve:
+		// A null response continues with the resolver
+		return []byte(`{"response":null}`)
+	case MutatingPreResolve, MutatingPostResolve:
+		// Empty inputs aren't sent to the hook
+		resp := []byte(`{"input":{},"response":null}`)
+		for _, key := range []string{"input", "response"} {
+			if value, dataType, _, err := jsonparser.Get(jsonData, key); err == nil && dataType == jsonparser.Object {
+				resp, _ = jsonparser.Set(resp, value, key)
+			}
+		}
+		return resp
+	case HttpTransportOnRequest, HttpTransportOnResponse, HttpTransportOnTransport:
+		return []byte(`{"response":{"skip":true}}`)
+	case MutatingPostAuthentication, RevalidateAuthentication:
+		resp := []byte(`{"response":{"status":"ok"}}`)
+		if user, dataType, _, err := jsonparser.Get(jsonData, "__wg", "user"); err == nil && dataType == jsonparser.Object {
+			resp, _ = jsonparser.Set(resp, user, "response", "user")
+		}
+		return resp
+	case WsTransportOnConnectionInit:
+		return []byte(`{"response":{"payload":null}}`)
+	}
+	return []byte(`{}`)
+}
@@ -0,0 +1,140 @@
+package hooks_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/wundergraph/wundergraph/pkg/hooks"
+)
+
+func newPolicyClient(t *testing.T, serverURL string, policies ...hooks.HookPolicy) *hooks.Client {
+	hookPolicies, err := hooks.NewHookPolicies(policies)
+	require.NoError(t, err)
+	return hooks.NewClient(&hooks.ClientOptions{
+		ServerURL: serverURL,
+		Logger:    zap.NewNop(),
+		Policies:  hookPolicies,
+	})
+}
+
+func TestHookPolicyTimeout(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/operation/Todos/postResolve" {
+			<-release
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+	defer close(release)
+	client := newPolicyClient(t, srv.URL, hooks.HookPolicy{
+		Hook:        "operation/*/postResolve",
+		Timeout:     50 * time.Millisecond,
+		FailureMode: hooks.FailOpen,
+	})
+
+	start := time.Now()
+	_, err := client.DoOperationRequest(context.Background(), "Todos", hooks.PostResolve, []byte(`{}`), &bytes.Buffer{})
+	assert.NoError(t, err)
+	assert.Less(t, time.Since(start), time.Second)
+
+	_, err = client.DoOperationRequest(context.Background(), "Todos", hooks.PreResolve, []byte(`{}`), &bytes.Buffer{})
+	assert.NoError(t, err)
+}
+
+func TestHookPolicyRetries(t *testing.T) {
+	var requests int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&requests, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+	maxRetries := 2
+	client := newPolicyClient(t, srv.URL, hooks.HookPolicy{
+		Hook:       "operation/Todos/**",
+		MaxRetries: &maxRetries,
+	})
+
+	_, err := client.DoOperationRequest(context.Background(), "Todos", hooks.PreResolve, []byte(`{}`), &bytes.Buffer{})
+	assert.Error(t, err)
+	assert.Equal(t, int64(3), atomic.LoadInt64(&requests))
+}
+
+func TestHookPolicyFailureModes(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/operation/Denied/preResolve" {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"error":{"message":"denied"}}`))
+			return
+		}
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+	noRetries := 0
+	client := newPolicyClient(t, srv.URL,
+		hooks.HookPolicy{Hook: "operation/Closed/*", MaxRetries: &noRetries},
+		hooks.HookPolicy{Hook: "operation/Fallback/*", MaxRetries: &noRetries, FailureMode: hooks.FailFallback, Fallback: []byte(`{"response":{"data":{"id":1}}}`)},
+		hooks.HookPolicy{Hook: "functions/**", MaxRetries: &noRetries, FailureMode: hooks.FailFallback, Fallback: []byte(`{"response":{"data":null}}`)},
+		hooks.HookPolicy{Hook: "operation/Todos/*", MaxRetries: &noRetries, FailureMode: hooks.FailOpen},
+		hooks.HookPolicy{Hook: "global/**", MaxRetries: &noRetries, FailureMode: hooks.FailOpen},
+		hooks.HookPolicy{Hook: "authentication/*", MaxRetries: &noRetries, FailureMode: hooks.FailOpen},
+	)
+	ctx := context.Background()
+	payload := []byte(`{"input":{"id":1},"response":{"data":{"id":2}},"__wg":{"user":{"userId":"1"}}}`)
+
+	_, err := client.DoOperationRequest(ctx, "Closed", hooks.PreResolve, payload, &bytes.Buffer{})
+	assert.ErrorContains(t, err, "giving up after 1 attempt")
+
+	// Errors reported by the hook are not failures
+	_, err = client.DoOperationRequest(ctx, "Denied", hooks.PreResolve, payload, &bytes.Buffer{})
+	assert.EqualError(t, err, "denied")
+
+	resp, err := client.DoOperationRequest(ctx, "Fallback", hooks.MockResolve, payload, &bytes.Buffer{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"data":{"id":1}}`, string(resp.Response))
+
+	resp, err = client.DoFunctionRequest(ctx, "users/get", nil, &bytes.Buffer{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"data":null}`, string(resp.Response))
+	assert.Equal(t, http.StatusOK, resp.ClientResponseStatusCode)
+
+	resp, err = client.DoOperationRequest(ctx, "Todos", hooks.MutatingPreResolve, payload, &bytes.Buffer{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":1}`, string(resp.Input))
+
+	resp, err = client.DoOperationRequest(ctx, "Todos", hooks.MutatingPostResolve, payload, &bytes.Buffer{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"data":{"id":2}}`, string(resp.Response))
+
+	resp, err = client.DoOperationRequest(ctx, "Todos", hooks.CustomResolve, payload, &bytes.Buffer{})
+	require.NoError(t, err)
+	assert.Equal(t, "null", string(resp.Response))
+
+	_, err = client.DoOperationRequest(ctx, "Todos", hooks.MockResolve, payload, &bytes.Buffer{})
+	assert.Error(t, err)
+
+	resp, err = client.DoGlobalRequest(ctx, hooks.HttpTransportOnRequest, "", payload, &bytes.Buffer{})
+	require.NoError(t, err)
+	var onRequest hooks.OnRequestHookResponse
+	require.NoError(t, json.Unmarshal(resp.Response, &onRequest))
+	assert.True(t, onRequest.Skip)
+
+	resp, err = client.DoAuthenticationRequest(ctx, hooks.MutatingPostAuthentication, payload, &bytes.Buffer{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"status":"ok","user":{"userId":"1"}}`, string(resp.Response))
+
+	_, err = hooks.NewHookPolicies([]hooks.HookPolicy{{Hook: "operation/[", FailureMode: hooks.FailOpen}})
+	assert.ErrorContains(t, err, "invalid hook policy")
+	_, err = hooks.NewHookPolicies([]hooks.HookPolicy{{Hook: "operation/**", FailureMode: hooks.FailFallback, Fallback: []byte(`{`)}})
+	assert.ErrorContains(t, err, "not valid JSON")
+}
@@ -0,0 +1,366 @@
+package hooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultHealthCheckInterval = 10 * time.Second
+	defaultMaxFailures         = 5
+	defaultEjectionDuration    = 30 * time.Second
+	healthCheckTimeout         = 5 * time.Second
+	poolHost                   = "hooks-pool"
+	// poolURL is the base URL of the hooks sent through a pool, poolTransport
+	// replaces it with the server picked for each attempt
+	poolURL = "http://" + poolHost
+)
+
+type LoadBalancing int
+
+const (
+	LoadBalancingRoundRobin LoadBalancing = iota
+	// LoadBalancingLeastOutstanding sends each hook to the server with the
+	// fewest requests in flight
+	LoadBalancingLeastOutstanding
+)
+
+// ServerRoute sends the hooks matching Hook to its own servers
+type ServerRoute struct {
+	// Hook is matched against the hook path using path.Match, e.g.
+	// operation/*/postResolve. Patterns ending in /** match any path with
+	// that prefix.
+	Hook       string
+	ServerURLs []string
+}
+
+type ServerPoolOptions struct {
+	ServerURLs    []string
+	LoadBalancing LoadBalancing
+	// HealthCheckInterval defaults to 10s
+	HealthCheckInterval time.Duration
+	// MaxFailures is the number of consecutive failed requests before a
+	// server is ejected, defaults to 5
+	MaxFailures int
+	// EjectionDuration defaults to 30s
+	EjectionDuration time.Duration
+	Routes           []ServerRoute
+	Logger           *zap.Logger
+}
+
+type poolServer struct {
+	url         string
+	target      *url.URL
+	outstanding int64
+
+	mu           sync.Mutex
+	unhealthy    bool
+	failures     int
+	ejectedUntil time.Time
+}
+
+func (s *poolServer) available(now time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return !s.unhealthy && !now.Before(s.ejectedUntil)
+}
+
+type serverGroup struct {
+	servers []*poolServer
+	next    uint64
+}
+
+type serverRoute struct {
+	pattern string
+	group   *serverGroup
+}
+
+func (r *serverRoute) matches(hookPath string) bool {
+	return matchHookPath(r.pattern, hookPath)
+}
+
+// matchHookPath matches hookPath using path.Match, patterns ending in /**
+// match any path with that prefix
+func matchHookPath(pattern string, hookPath string) bool {
+	if prefix := strings.TrimSuffix(pattern, "**"); prefix != pattern && strings.HasSuffix(prefix, "/") {
+		return strings.HasPrefix(hookPath, prefix)
+	}
+	matched, _ := path.Match(pattern, hookPath)
+	return matched
+}
+
+// ServerPool distributes the hooks between several hooks servers. Servers
+// failing their health checks or too many consecutive requests stop
+// receiving hooks until they recover.
+type ServerPool struct {
+	loadBalancing       LoadBalancing
+	healthCheckInterval time.Duration
+	maxFailures         int
+	ejectionDuration    time.Duration
+	log                 *zap.Logger
+
+	group   *serverGroup
+	routes  []*serverRoute
+	servers map[string]*poolServer
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewServerPool(opts ServerPoolOptions) (*ServerPool, error) {
+	p := &ServerPool{
+		loadBalancing:       opts.LoadBalancing,
+		healthCheckInterval: opts.HealthCheckInterval,
+		maxFailures:         opts.MaxFailures,
+		ejectionDuration:    opts.EjectionDuration,
+		log:                 opts.Logger,
+		servers:             make(map[string]*poolServer),
+	}
+	if p.healthCheckInterval <= 0 {
+		p.healthCheckInterval = defaultHealthCheckInterval
+	}
+	if p.maxFailures <= 0 {
+		p.maxFailures = defaultMaxFailures
+	}
+	if p.ejectionDuration <= 0 {
+		p.ejectionDuration = defaultEjectionDuration
+	}
+	if p.log == nil {
+		p.log = zap.NewNop()
+	}
+	var err error
+	if p.group, err = p.newGroup(opts.ServerURLs); err != nil {
+		return nil, err
+	}
+	for _, route := range opts.Routes {
+		if _, err := path.Match(route.Hook, ""); err != nil {
+			return nil, fmt.Errorf("invalid hooks route %q: %w", route.Hook, err)
+		}
+		group, err := p.newGroup(route.ServerURLs)
+		if err != nil {
+			return nil, fmt.Errorf("hooks route %q: %w", route.Hook, err)
+		}
+		p.routes = append(p.routes, &serverRoute{pattern: route.Hook, group: group})
+	}
+	p.ctx, p.cancel = context.WithCancel(context.Background())
+	return p, nil
+}
+
+// newGroup returns the group for the given URLs, servers appearing in
+// several groups share their state
+func (p *ServerPool) newGroup(serverURLs []string) (*serverGroup, error) {
+	if len(serverURLs) == 0 {
+		return nil, errors.New("no hooks server URLs")
+	}
+	group := &serverGroup{}
+	for _, serverURL := range serverURLs {
+		serverURL = strings.TrimSuffix(serverURL, "/")
+		target, err := url.ParseRequestURI(serverURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hooks server URL %q: %w", serverURL, err)
+		}
+		server := p.servers[serverURL]
+		if server == nil {
+			server = &poolServer{url: serverURL, target: target}
+			p.servers[serverURL] = server
+		}
+		group.servers = append(group.servers, server)
+	}
+	return group, nil
+}
+
+// pick returns the server for the given hook, which is outstanding until
+// release is called. If none of its servers are available, all of them are
+// used rather than failing every hook.
+func (p *ServerPool) pick(hookPath string) *poolServer {
+	group := p.group
+	for _, route := range p.routes {
+		if route.matches(hookPath) {
+			group = route.group
+			break
+		}
+	}
+	now := time.Now()
+	start := atomic.AddUint64(&group.next, 1)
+	var picked *poolServer
+	for ii := range group.servers {
+		server := group.servers[(start+uint64(ii))%uint64(len(group.servers))]
+		if !server.available(now) {
+			continue
+		}
+		if p.loadBalancing == LoadBalancingRoundRobin {
+			picked = server
+			break
+		}
+		if picked == nil || atomic.LoadInt64(&server.outstanding) < atomic.LoadInt64(&picked.outstanding) {
+			picked = server
+		}
+	}
+	if picked == nil {
+		picked = group.servers[start%uint64(len(group.servers))]
+	}
+	atomic.AddInt64(&picked.outstanding, 1)
+	return picked
+}
+
+// release ends a request to the server returned by pick
+func (p *ServerPool) release(server *poolServer) {
+	atomic.AddInt64(&server.outstanding, -1)
+}
+
+// done records the result of a request sent to the server returned by pick
+func (p *ServerPool) done(server *poolServer, r *http.Request, resp *http.Response, err error) {
+	if errors.Is(err, context.Canceled) || (err != nil && hookContextDone(r)) {
+		// The request was canceled by the client or ran out of the time its
+		// policy allows, the server might just be slow for this hook
+		return
+	}
+	failed := err != nil
+	if resp != nil {
+		// Hooks report their errors with a 500, which doesn't mean the server is failing
+		switch resp.StatusCode {
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			failed = true
+		}
+	}
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	if !failed {
+		server.failures = 0
+		return
+	}
+	server.failures++
+	if server.failures >= p.maxFailures {
+		server.failures = 0
+		server.ejectedUntil = time.Now().Add(p.ejectionDuration)
+		p.log.Warn("ejecting hooks server",
+			zap.String("serverUrl", server.url),
+			zap.Duration("duration", p.ejectionDuration),
+			zap.Error(err),
+		)
+	}
+}
+
+// poolTransport sends each request for the pool URL to a server picked by
+// the pool, so retries can go to a different server than the failed attempt
+type poolTransport struct {
+	pool *ServerPool
+	rt   http.RoundTripper
+}
+
+func (t poolTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if r.URL.Host != poolHost {
+		return t.rt.RoundTrip(r)
+	}
+	server := t.pool.pick(strings.TrimPrefix(r.URL.Path, "/"))
+	out := r.Clone(r.Context())
+	out.Host = ""
+	out.URL.Scheme = server.target.Scheme
+	out.URL.Host = server.target.Host
+	out.URL.Path = server.target.Path + r.URL.Path
+	out.URL.RawPath = ""
+	resp, err := t.rt.RoundTrip(out)
+	t.pool.done(server, r, resp, err)
+	if err != nil {
+		t.pool.release(server)
+		return nil, err
+	}
+	// The request is outstanding until its body is closed, which for
+	// subscriptions happens once they end
+	resp.Body = &releaseBody{ReadCloser: resp.Body, release: func() {
+		t.pool.release(server)
+	}}
+	return resp, nil
+}
+
+type releaseBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releaseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
+
+// checkHealth runs check for every server, returning whether any of them is healthy
+func (p *ServerPool) checkHealth(ctx context.Context, check func(ctx context.Context, serverURL string) bool) bool {
+	var healthy int32
+	var wg sync.WaitGroup
+	for _, server := range p.servers {
+		server := server
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+			defer cancel()
+			ok := check(ctx, server.url)
+			if ok {
+				atomic.StoreInt32(&healthy, 1)
+			}
+			server.mu.Lock()
+			defer server.mu.Unlock()
+			if server.unhealthy == ok {
+				p.log.Info("hooks server health changed",
+					zap.String("serverUrl", server.url),
+					zap.Bool("healthy", ok),
+				)
+			}
+			server.unhealthy = !ok
+		}()
+	}
+	wg.Wait()
+	return atomic.LoadInt32(&healthy) == 1
+}
+
+// runHealthChecks checks the servers periodically until the pool is closed
+func (p *ServerPool) runHealthChecks(check func(ctx context.Context, serverURL string) bool) {
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		ticker := time.NewTicker(p.healthCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-p.ctx.Done():
+				return
+			case <-ticker.C:
+				p.checkHealth(p.ctx, check)
+			}
+		}
+	}()
+}
+
+// URL returns the base URL of the requests sent through the pool by the
+// RoundTripper returned by Transport
+func (p *ServerPool) URL() string {
+	return poolURL
+}
+
+// Transport returns a RoundTripper sending the requests for URL to the
+// servers of the pool and the rest to rt, for the clients of the hooks server
+// other than Client, like the webhooks
+func (p *ServerPool) Transport(rt http.RoundTripper) http.RoundTripper {
+	return poolTransport{pool: p, rt: rt}
+}
+
+// Close stops the health checks
+func (p *ServerPool) Close() error {
+	p.cancel()
+	p.wg.Wait()
+	return nil
+}
@@ -0,0 +1,237 @@
+package hooks_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/wundergraph/wundergraph/pkg/hooks"
+)
+
+type poolTestServer struct {
+	*httptest.Server
+	hooks   int64
+	healthy int32
+	failing int32
+}
+
+func newPoolTestServer(t *testing.T) *poolTestServer {
+	s := &poolTestServer{healthy: 1}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/health" {
+			if atomic.LoadInt32(&s.healthy) == 0 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}
+			return
+		}
+		if atomic.LoadInt32(&s.failing) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		atomic.AddInt64(&s.hooks, 1)
+		_, _ = w.Write([]byte(`{"op":"Todos","hook":"preResolve"}`))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *poolTestServer) count() int64 {
+	return atomic.LoadInt64(&s.hooks)
+}
+
+func newPoolClient(t *testing.T, opts hooks.ServerPoolOptions) *hooks.Client {
+	opts.Logger = zap.NewNop()
+	pool, err := hooks.NewServerPool(opts)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = pool.Close()
+	})
+	return hooks.NewClient(&hooks.ClientOptions{
+		Logger: zap.NewNop(),
+		Pool:   pool,
+	})
+}
+
+func runHooks(t *testing.T, client *hooks.Client, hook hooks.MiddlewareHook, count int) {
+	for ii := 0; ii < count; ii++ {
+		_, err := client.DoOperationRequest(context.Background(), "Todos", hook, []byte(`{}`), &bytes.Buffer{})
+		require.NoError(t, err)
+	}
+}
+
+func TestServerPoolRoundRobin(t *testing.T) {
+	first, second := newPoolTestServer(t), newPoolTestServer(t)
+	client := newPoolClient(t, hooks.ServerPoolOptions{ServerURLs: []string{first.URL, second.URL}})
+
+	runHooks(t, client, hooks.PreResolve, 4)
+	assert.Equal(t, int64(2), first.count())
+	assert.Equal(t, int64(2), second.count())
+
+	// Unhealthy servers stop receiving hooks
+	atomic.StoreInt32(&first.healthy, 0)
+	assert.True(t, client.DoHealthCheckRequest(context.Background()))
+	runHooks(t, client, hooks.PreResolve, 4)
+	assert.Equal(t, int64(2), first.count())
+	assert.Equal(t, int64(6), second.count())
+
+	// Without healthy servers, hooks are sent to all of them
+	atomic.StoreInt32(&second.healthy, 0)
+	assert.False(t, client.DoHealthCheckRequest(context.Background()))
+	runHooks(t, client, hooks.PreResolve, 4)
+	assert.Equal(t, int64(4), first.count())
+	assert.Equal(t, int64(8), second.count())
+}
+
+func TestServerPoolLeastOutstanding(t *testing.T) {
+	release := make(chan struct{})
+	var slowHooks int64
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&slowHooks, 1)
+		<-release
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer slow.Close()
+	fast := newPoolTestServer(t)
+	client := newPoolClient(t, hooks.ServerPoolOptions{
+		ServerURLs:    []string{slow.URL, fast.URL},
+		LoadBalancing: hooks.LoadBalancingLeastOutstanding,
+	})
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		// Stops once a hook is blocked in the slow server
+		for atomic.LoadInt64(&slowHooks) == 0 {
+			runHooks(t, client, hooks.PreResolve, 1)
+		}
+	}()
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt64(&slowHooks) == 1
+	}, time.Second, time.Millisecond)
+
+	// While a hook is in flight, the other server gets the rest
+	before := fast.count()
+	runHooks(t, client, hooks.PreResolve, 3)
+	assert.Equal(t, before+3, fast.count())
+	assert.Equal(t, int64(1), atomic.LoadInt64(&slowHooks))
+	close(release)
+	wg.Wait()
+}
+
+func TestServerPoolEjection(t *testing.T) {
+	first, second := newPoolTestServer(t), newPoolTestServer(t)
+	client := newPoolClient(t, hooks.ServerPoolOptions{
+		ServerURLs:       []string{first.URL, second.URL},
+		MaxFailures:      1,
+		EjectionDuration: time.Hour,
+	})
+
+	atomic.StoreInt32(&first.failing, 1)
+	for ii := 0; ii < 2; ii++ {
+		// The client retries failed requests, the timeout ends them
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		_, _ = client.DoOperationRequest(ctx, "Todos", hooks.PreResolve, []byte(`{}`), &bytes.Buffer{})
+		cancel()
+	}
+	atomic.StoreInt32(&first.failing, 0)
+	before := second.count()
+	runHooks(t, client, hooks.PreResolve, 4)
+	assert.Equal(t, int64(0), first.count())
+	assert.Equal(t, before+4, second.count())
+}
+
+func TestServerPoolIgnoresPolicyTimeouts(t *testing.T) {
+	release := make(chan struct{})
+	var slowHooks int64
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/operation/Todos/postResolve" {
+			<-release
+		} else {
+			atomic.AddInt64(&slowHooks, 1)
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer slow.Close()
+	defer close(release)
+	fast := newPoolTestServer(t)
+	pool, err := hooks.NewServerPool(hooks.ServerPoolOptions{
+		ServerURLs:       []string{slow.URL, fast.URL},
+		MaxFailures:      1,
+		EjectionDuration: time.Hour,
+		Logger:           zap.NewNop(),
+	})
+	require.NoError(t, err)
+	defer pool.Close()
+	policies, err := hooks.NewHookPolicies([]hooks.HookPolicy{{
+		Hook:        "operation/*/postResolve",
+		Timeout:     50 * time.Millisecond,
+		FailureMode: hooks.FailOpen,
+	}})
+	require.NoError(t, err)
+	client := hooks.NewClient(&hooks.ClientOptions{
+		Logger:   zap.NewNop(),
+		Pool:     pool,
+		Policies: policies,
+	})
+
+	// A hook running out of the time its policy allows doesn't mean the
+	// server is failing, so it keeps receiving the other hooks
+	runHooks(t, client, hooks.PostResolve, 2)
+	before := fast.count()
+	runHooks(t, client, hooks.PreResolve, 4)
+	assert.Equal(t, int64(2), atomic.LoadInt64(&slowHooks))
+	assert.Equal(t, before+2, fast.count())
+}
+
+func TestServerPoolRetriesOtherServers(t *testing.T) {
+	first, second := newPoolTestServer(t), newPoolTestServer(t)
+	client := newPoolClient(t, hooks.ServerPoolOptions{
+		ServerURLs:       []string{first.URL, second.URL},
+		MaxFailures:      1,
+		EjectionDuration: time.Hour,
+	})
+
+	// Each attempt picks a server, so the retry of a failed hook goes to
+	// the other one and the failing server is ejected right away
+	atomic.StoreInt32(&first.failing, 1)
+	runHooks(t, client, hooks.PreResolve, 4)
+	assert.Equal(t, int64(0), first.count())
+	assert.Equal(t, int64(4), second.count())
+}
+
+func TestServerPoolRoutes(t *testing.T) {
+	main, analytics, functions := newPoolTestServer(t), newPoolTestServer(t), newPoolTestServer(t)
+	client := newPoolClient(t, hooks.ServerPoolOptions{
+		ServerURLs: []string{main.URL},
+		Routes: []hooks.ServerRoute{
+			{Hook: "operation/*/postResolve", ServerURLs: []string{analytics.URL}},
+			{Hook: "functions/**", ServerURLs: []string{functions.URL + "/"}},
+		},
+	})
+
+	runHooks(t, client, hooks.PreResolve, 1)
+	runHooks(t, client, hooks.PostResolve, 2)
+	_, err := client.DoFunctionRequest(context.Background(), "users/get", nil, &bytes.Buffer{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), main.count())
+	assert.Equal(t, int64(2), analytics.count())
+	assert.Equal(t, int64(1), functions.count())
+
+	_, err = hooks.NewServerPool(hooks.ServerPoolOptions{
+		ServerURLs: []string{main.URL},
+		Routes:     []hooks.ServerRoute{{Hook: "operation/[", ServerURLs: []string{main.URL}}},
+	})
+	assert.ErrorContains(t, err, "invalid hooks route")
+	_, err = hooks.NewServerPool(hooks.ServerPoolOptions{ServerURLs: []string{"localhost"}})
+	assert.ErrorContains(t, err, "invalid hooks server URL")
+}
@@ -8,13 +8,62 @@
 package hooks
 
 import (
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/wundergraph/wundergraph/pkg/authentication"
 	"github.com/wundergraph/wundergraph/pkg/operation"
 	"github.com/wundergraph/wundergraph/pkg/wgpb"
 )
 
+// HeaderMatcher matches a request header. Value is a pattern for its
+// values, where * matches any sequence of characters and ? a single one.
+// Unlike the patterns of the other matchers, * also matches /, e.g. in
+// application/* or Bearer *. The header only needs to be present if
+// Value is empty.
+type HeaderMatcher struct {
+	Name  string
+	Value string
+}
+
+// HookMatcher selects the requests a hook runs for. Empty fields match
+// everything, otherwise one of their values must match.
 type HookMatcher struct {
 	OperationType operation.Type
 	DataSources   []string
+	// OperationNames are operation names or patterns like ServerRoute.Hook,
+	// e.g. users/* or admin/**
+	OperationNames []string
+	// Methods are the HTTP methods of the request to the origin
+	Methods []string
+	// Paths are path.Match patterns for the path of the request to the
+	// origin, where * doesn't match /
+	Paths []string
+	// Headers must all match the request to the origin
+	Headers []HeaderMatcher
+	// Roles requires the user to have one of them, so anonymous requests
+	// never match
+	Roles []string
+}
+
+// Validate checks the patterns of the matcher
+func (m *HookMatcher) Validate() error {
+	for _, header := range m.Headers {
+		if header.Name == "" {
+			return errors.New("hook matcher: missing header name")
+		}
+	}
+	// Header values use matchGlob, which accepts any pattern
+	patterns := append(append([]string(nil), m.OperationNames...), m.Paths...)
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("hook matcher: invalid pattern %q: %w", pattern, err)
+		}
+	}
+	return nil
 }
 
 func (m *HookMatcher) containsDataSource(dataSourceID string) bool {
@@ -36,7 +85,11 @@ type Hook struct {
 
 type HookCheck struct {
 	OperationType operation.Type
+	OperationName string
 	DataSourceID  string
+	// Request is the request to the origin
+	Request *http.Request
+	User    *authentication.User
 }
 
 func (h *Hook) Executor() Executor {
@@ -58,9 +111,104 @@ func (e *hookExecutor) Matches(check *HookCheck) bool {
 	if len(check.DataSourceID) > 0 && len(e.matcher.DataSources) > 0 && !e.matcher.containsDataSource(check.DataSourceID) {
 		return false
 	}
+	if len(check.OperationName) > 0 && len(e.matcher.OperationNames) > 0 && !matchAny(e.matcher.OperationNames, check.OperationName) {
+		return false
+	}
+	if check.Request != nil && !e.matchesRequest(check.Request) {
+		return false
+	}
+	if len(e.matcher.Roles) > 0 && (check.User == nil || !containsAny(e.matcher.Roles, check.User.Roles)) {
+		return false
+	}
 	return true
 }
 
+func (e *hookExecutor) matchesRequest(r *http.Request) bool {
+	if len(e.matcher.Methods) > 0 && !containsMethod(e.matcher.Methods, r.Method) {
+		return false
+	}
+	if len(e.matcher.Paths) > 0 && !matchAny(e.matcher.Paths, r.URL.Path) {
+		return false
+	}
+	for _, header := range e.matcher.Headers {
+		values := r.Header.Values(header.Name)
+		if len(values) == 0 {
+			return false
+		}
+		if header.Value != "" && !matchAnyValue(header.Value, values) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchAny(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if matchHookPath(pattern, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchAnyValue(pattern string, values []string) bool {
+	for _, value := range values {
+		if matchGlob(pattern, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchGlob matches value against a pattern where * matches any sequence
+// of characters, including /, and ? matches a single character
+func matchGlob(pattern string, value string) bool {
+	p, v := []rune(pattern), []rune(value)
+	pi, vi := 0, 0
+	// Position of the last * and of the value when it was reached, to
+	// backtrack if the rest of the pattern doesn't match
+	star, starValue := -1, 0
+	for vi < len(v) {
+		switch {
+		case pi < len(p) && (p[pi] == '?' || p[pi] == v[vi]):
+			pi++
+			vi++
+		case pi < len(p) && p[pi] == '*':
+			star, starValue = pi, vi
+			pi++
+		case star >= 0:
+			starValue++
+			pi, vi = star+1, starValue
+		default:
+			return false
+		}
+	}
+	for pi < len(p) && p[pi] == '*' {
+		pi++
+	}
+	return pi == len(p)
+}
+
+func containsMethod(methods []string, method string) bool {
+	for _, m := range methods {
+		if strings.EqualFold(m, method) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAny(values []string, candidates []string) bool {
+	for _, value := range values {
+		for _, candidate := range candidates {
+			if value == candidate {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (e *hookExecutor) HookID() string {
 	return e.id
 }
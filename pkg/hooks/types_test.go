@@ -0,0 +1,86 @@
+package hooks_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wundergraph/wundergraph/pkg/authentication"
+	"github.com/wundergraph/wundergraph/pkg/hooks"
+	"github.com/wundergraph/wundergraph/pkg/operation"
+)
+
+func TestHookMatcher(t *testing.T) {
+	request := httptest.NewRequest(http.MethodPost, "https://api.example.com/v1/users/1", nil)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Authorization", "Bearer a/b+c=")
+	request.Header.Set("X-Tags", "[a]/b")
+	check := &hooks.HookCheck{
+		OperationType: operation.TypeQuery,
+		OperationName: "users/get",
+		DataSourceID:  "0",
+		Request:       request,
+		User:          &authentication.User{Roles: []string{"user", "admin"}},
+	}
+
+	testCases := []struct {
+		name    string
+		matcher hooks.HookMatcher
+		matches bool
+	}{
+		{name: "empty", matches: true},
+		{name: "operation type", matcher: hooks.HookMatcher{OperationType: operation.TypeMutation}},
+		{name: "datasource", matcher: hooks.HookMatcher{DataSources: []string{"0"}}, matches: true},
+		{name: "operation name", matcher: hooks.HookMatcher{OperationNames: []string{"users/get"}}, matches: true},
+		{name: "operation glob", matcher: hooks.HookMatcher{OperationNames: []string{"todos/*", "users/*"}}, matches: true},
+		{name: "operation prefix", matcher: hooks.HookMatcher{OperationNames: []string{"users/**"}}, matches: true},
+		{name: "other operation", matcher: hooks.HookMatcher{OperationNames: []string{"todos/**"}}},
+		{name: "method", matcher: hooks.HookMatcher{Methods: []string{"get", "post"}}, matches: true},
+		{name: "other method", matcher: hooks.HookMatcher{Methods: []string{http.MethodGet}}},
+		{name: "path", matcher: hooks.HookMatcher{Paths: []string{"/v1/users/*"}}, matches: true},
+		{name: "other path", matcher: hooks.HookMatcher{Paths: []string{"/v2/**"}}},
+		{name: "header present", matcher: hooks.HookMatcher{Headers: []hooks.HeaderMatcher{{Name: "content-type"}}}, matches: true},
+		{name: "header value", matcher: hooks.HookMatcher{Headers: []hooks.HeaderMatcher{{Name: "Content-Type", Value: "application/*"}}}, matches: true},
+		{name: "header value with slashes", matcher: hooks.HookMatcher{Headers: []hooks.HeaderMatcher{{Name: "Authorization", Value: "Bearer *"}}}, matches: true},
+		{name: "header single character", matcher: hooks.HookMatcher{Headers: []hooks.HeaderMatcher{{Name: "Content-Type", Value: "application/js?n"}}}, matches: true},
+		{name: "header literal brackets", matcher: hooks.HookMatcher{Headers: []hooks.HeaderMatcher{{Name: "X-Tags", Value: "[a]/*"}}}, matches: true},
+		{name: "other header value", matcher: hooks.HookMatcher{Headers: []hooks.HeaderMatcher{{Name: "Content-Type", Value: "text/*"}}}},
+		{name: "missing header", matcher: hooks.HookMatcher{Headers: []hooks.HeaderMatcher{{Name: "Content-Type"}, {Name: "X-Trace"}}}},
+		{name: "role", matcher: hooks.HookMatcher{Roles: []string{"superadmin", "admin"}}, matches: true},
+		{name: "other role", matcher: hooks.HookMatcher{Roles: []string{"superadmin"}}},
+		{
+			name: "all",
+			matcher: hooks.HookMatcher{
+				OperationType:  operation.TypeQuery,
+				OperationNames: []string{"users/*"},
+				Methods:        []string{http.MethodPost},
+				Paths:          []string{"/v1/**"},
+				Roles:          []string{"admin"},
+			},
+			matches: true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			hook := &hooks.Hook{Matcher: tc.matcher}
+			assert.Equal(t, tc.matches, hook.Executor().Matches(check))
+		})
+	}
+
+	t.Run("anonymous", func(t *testing.T) {
+		hook := &hooks.Hook{Matcher: hooks.HookMatcher{Roles: []string{"user"}}}
+		assert.False(t, hook.Executor().Matches(&hooks.HookCheck{Request: request}))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		matcher := hooks.HookMatcher{Paths: []string{"/v1/["}}
+		assert.ErrorContains(t, matcher.Validate(), "invalid pattern")
+		matcher = hooks.HookMatcher{Headers: []hooks.HeaderMatcher{{Value: "*"}}}
+		assert.ErrorContains(t, matcher.Validate(), "missing header name")
+		matcher = hooks.HookMatcher{Headers: []hooks.HeaderMatcher{{Name: "Content-Type", Value: "[a"}}}
+		assert.NoError(t, matcher.Validate())
+	})
+}
@@ -0,0 +1,232 @@
+package hooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+)
+
+const (
+	// WasmAllocExport is the function exported by the module for allocating the
+	// memory used to pass the hook payloads. It receives the size in bytes and
+	// returns a pointer to the allocated memory.
+	WasmAllocExport = "wg_alloc"
+	// WasmMemoryExport is the memory exported by the module
+	WasmMemoryExport = "memory"
+
+	wasmPageSize              = 64 * 1024
+	defaultWasmMaxMemoryBytes = 16 * 1024 * 1024
+	defaultWasmTimeout        = time.Second
+)
+
+// WasmLimits restricts the resources used by each hook execution. Zero values
+// use the defaults.
+type WasmLimits struct {
+	// MaxMemoryBytes is rounded up to 64KB pages, defaults to 16MB
+	MaxMemoryBytes uint64
+	// Timeout defaults to 1s
+	Timeout time.Duration
+}
+
+type WasmOptions struct {
+	// Module contains the WebAssembly binary implementing the hooks
+	Module []byte
+	// Limits used by all hooks
+	Limits WasmLimits
+	// HookLimits overrides Limits for the given hooks, using the same path as
+	// their exported function (e.g. operation/Foo/preResolve)
+	HookLimits map[string]WasmLimits
+}
+
+// wasmEngine compiles the module for a given memory limit, since wazero
+// only supports limiting the memory for the whole runtime
+type wasmEngine struct {
+	runtime  wazero.Runtime
+	compiled wazero.CompiledModule
+}
+
+type wasmHook struct {
+	engine  *wasmEngine
+	timeout time.Duration
+}
+
+// WasmRuntime executes hooks in-process using a WebAssembly module instead of
+// calling the hooks server. The module implements each hook as an exported
+// function named after the hook path in the hooks server, like
+// operation/Foo/preResolve or global/httpTransport/onOriginRequest.
+//
+// Hook functions receive a pointer to the JSON payload allocated with
+// wg_alloc and its length, and return the pointer to the JSON response in the
+// upper 32 bits of an i64 and its length in the lower ones. Payloads and
+// responses are the same ones used by the hooks server. Each execution uses a
+// new instance of the module, so hooks can't share any state.
+type WasmRuntime struct {
+	cache   wazero.CompilationCache
+	engines map[uint32]*wasmEngine
+	hooks   map[string]*wasmHook
+}
+
+// NewWasmRuntime compiles the module and validates its exports
+func NewWasmRuntime(ctx context.Context, opts WasmOptions) (*WasmRuntime, error) {
+	r := &WasmRuntime{
+		cache:   wazero.NewCompilationCache(),
+		engines: make(map[uint32]*wasmEngine),
+		hooks:   make(map[string]*wasmHook),
+	}
+	defaults := opts.Limits
+	if defaults.MaxMemoryBytes == 0 {
+		defaults.MaxMemoryBytes = defaultWasmMaxMemoryBytes
+	}
+	if defaults.Timeout == 0 {
+		defaults.Timeout = defaultWasmTimeout
+	}
+	engine, err := r.engine(ctx, opts.Module, defaults.MaxMemoryBytes)
+	if err != nil {
+		_ = r.Close(ctx)
+		return nil, err
+	}
+	functions := engine.compiled.ExportedFunctions()
+	if fn := functions[WasmAllocExport]; fn == nil || !hasSignature(fn, []api.ValueType{api.ValueTypeI32}, []api.ValueType{api.ValueTypeI32}) {
+		_ = r.Close(ctx)
+		return nil, fmt.Errorf("wasm module must export %s(i32) i32", WasmAllocExport)
+	}
+	if engine.compiled.ExportedMemories()[WasmMemoryExport] == nil {
+		_ = r.Close(ctx)
+		return nil, fmt.Errorf("wasm module must export its %s", WasmMemoryExport)
+	}
+	for name, fn := range functions {
+		if !strings.Contains(name, "/") {
+			continue
+		}
+		if !hasSignature(fn, []api.ValueType{api.ValueTypeI32, api.ValueTypeI32}, []api.ValueType{api.ValueTypeI64}) {
+			_ = r.Close(ctx)
+			return nil, fmt.Errorf("wasm hook %s must have the signature (i32, i32) i64", name)
+		}
+		r.hooks[name] = &wasmHook{engine: engine, timeout: defaults.Timeout}
+	}
+	for name, limits := range opts.HookLimits {
+		hook := r.hooks[name]
+		if hook == nil {
+			_ = r.Close(ctx)
+			return nil, fmt.Errorf("wasm module doesn't implement hook %s", name)
+		}
+		if limits.Timeout != 0 {
+			hook.timeout = limits.Timeout
+		}
+		if limits.MaxMemoryBytes != 0 {
+			hook.engine, err = r.engine(ctx, opts.Module, limits.MaxMemoryBytes)
+			if err != nil {
+				_ = r.Close(ctx)
+				return nil, err
+			}
+		}
+	}
+	return r, nil
+}
+
+func hasSignature(fn api.FunctionDefinition, params []api.ValueType, results []api.ValueType) bool {
+	return string(fn.ParamTypes()) == string(params) && string(fn.ResultTypes()) == string(results)
+}
+
+func (r *WasmRuntime) engine(ctx context.Context, module []byte, maxMemoryBytes uint64) (*wasmEngine, error) {
+	pages := uint32((maxMemoryBytes + wasmPageSize - 1) / wasmPageSize)
+	if engine := r.engines[pages]; engine != nil {
+		return engine, nil
+	}
+	runtime := wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfig().
+		WithCompilationCache(r.cache).
+		WithMemoryLimitPages(pages).
+		WithCloseOnContextDone(true),
+	)
+	// Modules built for WASI can use it, without access to the filesystem
+	// or the network
+	if _, err := wasi_snapshot_preview1.Instantiate(ctx, runtime); err != nil {
+		_ = runtime.Close(ctx)
+		return nil, err
+	}
+	compiled, err := runtime.CompileModule(ctx, module)
+	if err != nil {
+		_ = runtime.Close(ctx)
+		return nil, fmt.Errorf("compiling wasm module: %w", err)
+	}
+	if memory := compiled.ExportedMemories()[WasmMemoryExport]; memory != nil && memory.Min() > pages {
+		_ = runtime.Close(ctx)
+		return nil, fmt.Errorf("wasm module requires %d bytes of memory, more than the %d limit", uint64(memory.Min())*wasmPageSize, maxMemoryBytes)
+	}
+	engine := &wasmEngine{runtime: runtime, compiled: compiled}
+	r.engines[pages] = engine
+	return engine, nil
+}
+
+// Handles returns true if the module implements the hook with the given path
+func (r *WasmRuntime) Handles(hookPath string) bool {
+	return r != nil && r.hooks[hookPath] != nil
+}
+
+// Execute runs the hook with the given payload, returning its response
+func (r *WasmRuntime) Execute(ctx context.Context, hookPath string, payload []byte) ([]byte, error) {
+	hook := r.hooks[hookPath]
+	if hook == nil {
+		return nil, fmt.Errorf("wasm module doesn't implement hook %s", hookPath)
+	}
+	ctx, cancel := context.WithTimeout(ctx, hook.timeout)
+	defer cancel()
+	data, err := hook.execute(ctx, hookPath, payload)
+	if err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, fmt.Errorf("wasm hook %s exceeded its %s timeout", hookPath, hook.timeout)
+		}
+		return nil, fmt.Errorf("wasm hook %s: %w", hookPath, err)
+	}
+	return data, nil
+}
+
+func (h *wasmHook) execute(ctx context.Context, hookPath string, payload []byte) ([]byte, error) {
+	// Modules built as reactors export _initialize, which must run first
+	config := wazero.NewModuleConfig().WithName("").WithStartFunctions("_initialize")
+	mod, err := h.engine.runtime.InstantiateModule(ctx, h.engine.compiled, config)
+	if err != nil {
+		return nil, err
+	}
+	defer mod.Close(context.Background())
+
+	results, err := mod.ExportedFunction(WasmAllocExport).Call(ctx, uint64(len(payload)))
+	if err != nil {
+		return nil, err
+	}
+	ptr := uint32(results[0])
+	if !mod.Memory().Write(ptr, payload) {
+		return nil, fmt.Errorf("%s returned an invalid pointer", WasmAllocExport)
+	}
+	results, err = mod.ExportedFunction(hookPath).Call(ctx, uint64(ptr), uint64(len(payload)))
+	if err != nil {
+		return nil, err
+	}
+	responsePtr, responseSize := uint32(results[0]>>32), uint32(results[0])
+	response, ok := mod.Memory().Read(responsePtr, responseSize)
+	if !ok {
+		return nil, errors.New("response is out of bounds")
+	}
+	// The memory is released when the module is closed
+	return append([]byte(nil), response...), nil
+}
+
+// Close releases the compiled module
+func (r *WasmRuntime) Close(ctx context.Context) error {
+	var errs []error
+	for _, engine := range r.engines {
+		if err := engine.runtime.Close(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := r.cache.Close(ctx); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
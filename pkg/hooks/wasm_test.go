@@ -0,0 +1,245 @@
+package hooks_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/wundergraph/wundergraph/pkg/hooks"
+)
+
+const (
+	staticResponse = `{"op":"Static","hook":"mutatingPreResolve","input":{"id":2}}`
+	errorResponse  = `{"op":"Fail","hook":"postResolve","error":{"message":"denied"}}`
+	// Offsets of the responses in the module memory
+	staticResponseOffset = 0
+	errorResponseOffset  = 256
+)
+
+func uleb128(v uint64) []byte {
+	var out []byte
+	for {
+		b := byte(v & 0x7f)
+		v >>= 7
+		if v != 0 {
+			b |= 0x80
+		}
+		out = append(out, b)
+		if v == 0 {
+			return out
+		}
+	}
+}
+
+func sleb128(v int64) []byte {
+	var out []byte
+	for {
+		b := byte(v & 0x7f)
+		v >>= 7
+		if (v == 0 && b&0x40 == 0) || (v == -1 && b&0x40 != 0) {
+			return append(out, b)
+		}
+		out = append(out, b|0x80)
+	}
+}
+
+func wasmName(name string) []byte {
+	return append(uleb128(uint64(len(name))), name...)
+}
+
+func wasmVector(items ...[]byte) []byte {
+	out := uleb128(uint64(len(items)))
+	for _, item := range items {
+		out = append(out, item...)
+	}
+	return out
+}
+
+func wasmSection(id byte, contents []byte) []byte {
+	return append(append([]byte{id}, uleb128(uint64(len(contents)))...), contents...)
+}
+
+func wasmBody(locals []byte, code ...byte) []byte {
+	body := append(locals, code...)
+	return append(uleb128(uint64(len(body))), body...)
+}
+
+// returnResponse returns the packed pointer and length of a response
+func returnResponse(offset int, response string) []byte {
+	return append(append([]byte{0x42}, sleb128(int64(offset)<<32|int64(len(response)))...), 0x0b)
+}
+
+// testWasmModule builds a module implementing hooks for each test case,
+// since there's no WebAssembly toolchain available in the tests
+func testWasmModule() []byte {
+	const (
+		allocType = 0
+		hookType  = 1
+	)
+	types := wasmVector(
+		[]byte{0x60, 0x01, 0x7f, 0x01, 0x7f},
+		[]byte{0x60, 0x02, 0x7f, 0x7f, 0x01, 0x7e},
+	)
+	noLocals := []byte{0x00}
+	bodies := [][]byte{
+		// wg_alloc: bump allocator growing the memory when needed
+		wasmBody([]byte{0x01, 0x01, 0x7f},
+			0x23, 0x00, 0x21, 0x01, // ptr = heap
+			0x23, 0x00, 0x20, 0x00, 0x6a, 0x24, 0x00, // heap += size
+			0x02, 0x40,
+			0x23, 0x00, 0x41, 0xff, 0xff, 0x03, 0x6a, 0x41, 0x10, 0x76, // (heap + 65535) >> 16
+			0x3f, 0x00, 0x6b, 0x22, 0x00, // - memory.size
+			0x41, 0x00, 0x4c, 0x0d, 0x00, // break if <= 0
+			0x20, 0x00, 0x40, 0x00, 0x41, 0x7f, 0x46, 0x04, 0x40, 0x00, 0x0b, // grow or trap
+			0x0b,
+			0x20, 0x01, 0x0b,
+		),
+		// Echoes the payload
+		wasmBody(noLocals, 0x20, 0x00, 0xad, 0x42, 0x20, 0x86, 0x20, 0x01, 0xad, 0x84, 0x0b),
+		wasmBody(noLocals, returnResponse(staticResponseOffset, staticResponse)...),
+		wasmBody(noLocals, returnResponse(errorResponseOffset, errorResponse)...),
+		// Loops forever
+		wasmBody(noLocals, 0x03, 0x40, 0x0c, 0x00, 0x0b, 0x42, 0x00, 0x0b),
+		// Grows the memory by 100 pages, trapping if it fails
+		wasmBody(noLocals, append([]byte{0x41, 0xe4, 0x00, 0x40, 0x00, 0x41, 0x7f, 0x46, 0x04, 0x40, 0x00, 0x0b},
+			returnResponse(staticResponseOffset, staticResponse)...)...),
+		// Traps
+		wasmBody(noLocals, 0x00, 0x0b),
+	}
+	functions := wasmVector([]byte{allocType}, []byte{hookType}, []byte{hookType}, []byte{hookType}, []byte{hookType}, []byte{hookType}, []byte{hookType})
+	export := func(name string, kind byte, index byte) []byte {
+		return append(wasmName(name), kind, index)
+	}
+	exports := wasmVector(
+		export("memory", 0x02, 0),
+		export(hooks.WasmAllocExport, 0x00, 0),
+		export("operation/Echo/preResolve", 0x00, 1),
+		export("global/httpTransport/onOriginRequest", 0x00, 1),
+		export("operation/Static/mutatingPreResolve", 0x00, 2),
+		export("operation/Fail/postResolve", 0x00, 3),
+		export("operation/Loop/preResolve", 0x00, 4),
+		export("operation/Grow/preResolve", 0x00, 5),
+		export("operation/Trap/preResolve", 0x00, 6),
+	)
+	segment := func(offset int, data string) []byte {
+		out := append([]byte{0x00, 0x41}, sleb128(int64(offset))...)
+		return append(append(out, 0x0b), wasmName(data)...)
+	}
+
+	var module bytes.Buffer
+	module.Write([]byte("\x00asm\x01\x00\x00\x00"))
+	module.Write(wasmSection(1, types))
+	module.Write(wasmSection(3, functions))
+	module.Write(wasmSection(5, wasmVector([]byte{0x00, 0x01})))
+	// Heap starts after the responses
+	module.Write(wasmSection(6, wasmVector(append([]byte{0x7f, 0x01, 0x41}, append(sleb128(1024), 0x0b)...))))
+	module.Write(wasmSection(7, exports))
+	module.Write(wasmSection(10, wasmVector(bodies...)))
+	module.Write(wasmSection(11, wasmVector(segment(staticResponseOffset, staticResponse), segment(errorResponseOffset, errorResponse))))
+	return module.Bytes()
+}
+
+func newWasmRuntime(t *testing.T, opts hooks.WasmOptions) *hooks.WasmRuntime {
+	opts.Module = testWasmModule()
+	runtime, err := hooks.NewWasmRuntime(context.Background(), opts)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = runtime.Close(context.Background())
+	})
+	return runtime
+}
+
+func TestWasmHooks(t *testing.T) {
+	var serverHooks []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		serverHooks = append(serverHooks, r.URL.Path)
+		_, _ = w.Write([]byte(`{"op":"Other","hook":"preResolve"}`))
+	}))
+	defer srv.Close()
+
+	client := hooks.NewClient(&hooks.ClientOptions{
+		ServerURL: srv.URL,
+		Logger:    zap.NewNop(),
+		Wasm: newWasmRuntime(t, hooks.WasmOptions{
+			Limits: hooks.WasmLimits{Timeout: 100 * time.Millisecond},
+			HookLimits: map[string]hooks.WasmLimits{
+				"operation/Grow/preResolve": {MaxMemoryBytes: 1024 * 1024},
+			},
+		}),
+	})
+	ctx := context.Background()
+	buf := &bytes.Buffer{}
+
+	resp, err := client.DoOperationRequest(ctx, "Echo", hooks.PreResolve, []byte(`{"input":{"id":1}}`), buf)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":1}`, string(resp.Input))
+
+	resp, err = client.DoGlobalRequest(ctx, hooks.HttpTransportOnRequest, "", []byte(`{"request":{"method":"GET"}}`), buf)
+	require.NoError(t, err)
+	assert.NotNil(t, resp)
+
+	resp, err = client.DoOperationRequest(ctx, "Static", hooks.MutatingPreResolve, nil, buf)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":2}`, string(resp.Input))
+
+	_, err = client.DoOperationRequest(ctx, "Fail", hooks.PostResolve, nil, buf)
+	assert.EqualError(t, err, "denied")
+
+	start := time.Now()
+	_, err = client.DoOperationRequest(ctx, "Loop", hooks.PreResolve, nil, buf)
+	assert.ErrorContains(t, err, "exceeded its 100ms timeout")
+	assert.Less(t, time.Since(start), 5*time.Second)
+
+	// The hook limits its memory to 16 pages, so it can't grow it by 100
+	_, err = client.DoOperationRequest(ctx, "Grow", hooks.PreResolve, nil, buf)
+	assert.Error(t, err)
+
+	_, err = client.DoOperationRequest(ctx, "Trap", hooks.PreResolve, nil, buf)
+	assert.Error(t, err)
+
+	// Hooks not implemented by the module use the hooks server
+	assert.Empty(t, serverHooks)
+	_, err = client.DoOperationRequest(ctx, "Other", hooks.PreResolve, nil, buf)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/operation/Other/preResolve"}, serverHooks)
+}
+
+func TestWasmHooksDefaultLimits(t *testing.T) {
+	runtime := newWasmRuntime(t, hooks.WasmOptions{})
+	assert.True(t, runtime.Handles("operation/Grow/preResolve"))
+	assert.False(t, runtime.Handles("operation/Other/preResolve"))
+
+	// Executions don't share their memory, so growing it always succeeds
+	for ii := 0; ii < 3; ii++ {
+		data, err := runtime.Execute(context.Background(), "operation/Grow/preResolve", []byte(`{}`))
+		require.NoError(t, err)
+		var resp hooks.MiddlewareHookResponse
+		require.NoError(t, json.Unmarshal(data, &resp))
+		assert.Equal(t, "Static", resp.Op)
+	}
+
+	// Payloads bigger than the initial memory are allocated
+	payload := []byte(`{"input":"` + string(bytes.Repeat([]byte("a"), 200*1024)) + `"}`)
+	data, err := runtime.Execute(context.Background(), "operation/Echo/preResolve", payload)
+	require.NoError(t, err)
+	assert.Equal(t, payload, data)
+}
+
+func TestInvalidWasmModule(t *testing.T) {
+	_, err := hooks.NewWasmRuntime(context.Background(), hooks.WasmOptions{Module: []byte("invalid")})
+	assert.Error(t, err)
+
+	_, err = hooks.NewWasmRuntime(context.Background(), hooks.WasmOptions{
+		Module:     testWasmModule(),
+		HookLimits: map[string]hooks.WasmLimits{"operation/Other/preResolve": {Timeout: time.Second}},
+	})
+	assert.ErrorContains(t, err, "doesn't implement hook operation/Other/preResolve")
+}
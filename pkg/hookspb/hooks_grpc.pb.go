@@ -0,0 +1,178 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// source: hooks.proto
+
+package hookspb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Hooks_Call_FullMethodName      = "/hookspb.Hooks/Call"
+	Hooks_Subscribe_FullMethodName = "/hookspb.Hooks/Subscribe"
+)
+
+// HooksClient is the client API for Hooks service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type HooksClient interface {
+	// Call runs a hook or a function, returning its response
+	Call(ctx context.Context, in *HookRequest, opts ...grpc.CallOption) (*HookResponse, error)
+	// Subscribe runs a function subscription, streaming its results until the
+	// function ends or the WunderNode cancels the call
+	Subscribe(ctx context.Context, in *HookRequest, opts ...grpc.CallOption) (Hooks_SubscribeClient, error)
+}
+
+type hooksClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHooksClient(cc grpc.ClientConnInterface) HooksClient {
+	return &hooksClient{cc}
+}
+
+func (c *hooksClient) Call(ctx context.Context, in *HookRequest, opts ...grpc.CallOption) (*HookResponse, error) {
+	out := new(HookResponse)
+	err := c.cc.Invoke(ctx, Hooks_Call_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hooksClient) Subscribe(ctx context.Context, in *HookRequest, opts ...grpc.CallOption) (Hooks_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Hooks_ServiceDesc.Streams[0], Hooks_Subscribe_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &hooksSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Hooks_SubscribeClient interface {
+	Recv() (*SubscriptionMessage, error)
+	grpc.ClientStream
+}
+
+type hooksSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *hooksSubscribeClient) Recv() (*SubscriptionMessage, error) {
+	m := new(SubscriptionMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// HooksServer is the server API for Hooks service.
+// All implementations must embed UnimplementedHooksServer
+// for forward compatibility
+type HooksServer interface {
+	// Call runs a hook or a function, returning its response
+	Call(context.Context, *HookRequest) (*HookResponse, error)
+	// Subscribe runs a function subscription, streaming its results until the
+	// function ends or the WunderNode cancels the call
+	Subscribe(*HookRequest, Hooks_SubscribeServer) error
+	mustEmbedUnimplementedHooksServer()
+}
+
+// UnimplementedHooksServer must be embedded to have forward compatible implementations.
+type UnimplementedHooksServer struct {
+}
+
+func (UnimplementedHooksServer) Call(context.Context, *HookRequest) (*HookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Call not implemented")
+}
+func (UnimplementedHooksServer) Subscribe(*HookRequest, Hooks_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedHooksServer) mustEmbedUnimplementedHooksServer() {}
+
+// UnsafeHooksServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HooksServer will
+// result in compilation errors.
+type UnsafeHooksServer interface {
+	mustEmbedUnimplementedHooksServer()
+}
+
+func RegisterHooksServer(s grpc.ServiceRegistrar, srv HooksServer) {
+	s.RegisterService(&Hooks_ServiceDesc, srv)
+}
+
+func _Hooks_Call_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HooksServer).Call(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Hooks_Call_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HooksServer).Call(ctx, req.(*HookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hooks_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(HookRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HooksServer).Subscribe(m, &hooksSubscribeServer{stream})
+}
+
+type Hooks_SubscribeServer interface {
+	Send(*SubscriptionMessage) error
+	grpc.ServerStream
+}
+
+type hooksSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *hooksSubscribeServer) Send(m *SubscriptionMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Hooks_ServiceDesc is the grpc.ServiceDesc for Hooks service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Hooks_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hookspb.Hooks",
+	HandlerType: (*HooksServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Call",
+			Handler:    _Hooks_Call_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Hooks_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "hooks.proto",
+}
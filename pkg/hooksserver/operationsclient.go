@@ -0,0 +1,214 @@
+package hooksserver
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/wundergraph/wundergraph/pkg/hooks"
+	"github.com/wundergraph/wundergraph/pkg/logging"
+)
+
+const defaultNodeURL = "http://localhost:9993"
+
+// forwardedHeaders are sent from the client request to the WunderNode, like
+// the TypeScript operations client does
+var forwardedHeaders = []string{"Authorization", logging.RequestIDHeader}
+
+// OperationError is an error returned by an operation. Functions can return
+// it to set the code and status code sent to the client.
+type OperationError struct {
+	Code       string        `json:"code,omitempty"`
+	Message    string        `json:"message"`
+	StatusCode int           `json:"statusCode,omitempty"`
+	Path       []interface{} `json:"path,omitempty"`
+}
+
+func (e *OperationError) Error() string {
+	return e.Message
+}
+
+type OperationResponse struct {
+	Data   json.RawMessage   `json:"data,omitempty"`
+	Errors []*OperationError `json:"errors,omitempty"`
+}
+
+// OperationsClient calls operations using the internal API of the WunderNode.
+// Clients passed to hooks and functions send the client request along, so
+// the operations run with the same headers and their own hooks see it.
+type OperationsClient struct {
+	nodeURL       string
+	httpClient    *http.Client
+	clientRequest *http.Request
+	cycleCounter  int
+}
+
+// NewOperationsClient returns a client for the WunderNode at nodeURL, which
+// defaults to http://localhost:9993. If httpClient is nil,
+// http.DefaultClient is used.
+func NewOperationsClient(nodeURL string, httpClient *http.Client) *OperationsClient {
+	if nodeURL == "" {
+		nodeURL = defaultNodeURL
+	}
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &OperationsClient{
+		nodeURL:    strings.TrimSuffix(nodeURL, "/"),
+		httpClient: httpClient,
+	}
+}
+
+func (c *OperationsClient) forRequest(clientRequest *http.Request, cycleCounter int) *OperationsClient {
+	return &OperationsClient{
+		nodeURL:       c.nodeURL,
+		httpClient:    c.httpClient,
+		clientRequest: clientRequest,
+		cycleCounter:  cycleCounter,
+	}
+}
+
+// Query runs the query with the given input, which is encoded as JSON. Errors
+// returned by the operation are available in the response, err is only
+// non-nil when the operation couldn't be run.
+func (c *OperationsClient) Query(ctx context.Context, operationName string, input interface{}) (*OperationResponse, error) {
+	resp, err := c.do(ctx, operationName, input, false)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	var out OperationResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("operation %s failed with status code %d", operationName, resp.StatusCode)
+		}
+		return nil, fmt.Errorf("decoding operation %s response: %w", operationName, err)
+	}
+	if resp.StatusCode != http.StatusOK && len(out.Errors) == 0 {
+		return nil, fmt.Errorf("operation %s failed with status code %d", operationName, resp.StatusCode)
+	}
+	return &out, nil
+}
+
+// Mutate runs the mutation with the given input, see Query
+func (c *OperationsClient) Mutate(ctx context.Context, operationName string, input interface{}) (*OperationResponse, error) {
+	return c.Query(ctx, operationName, input)
+}
+
+// Subscribe runs the subscription with the given input, calling fn with each
+// result until the subscription ends, ctx is done or fn returns an error
+func (c *OperationsClient) Subscribe(ctx context.Context, operationName string, input interface{}, fn func(resp *OperationResponse) error) error {
+	resp, err := c.do(ctx, operationName, input, false)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("subscription %s failed with status code %d", operationName, resp.StatusCode)
+	}
+	reader := bufio.NewReader(resp.Body)
+	for {
+		line, err := reader.ReadBytes('\n')
+		// Results are separated by empty lines
+		if line = bytes.TrimSpace(line); len(line) != 0 {
+			var out OperationResponse
+			if err := json.Unmarshal(line, &out); err != nil {
+				return fmt.Errorf("decoding subscription %s result: %w", operationName, err)
+			}
+			if err := fn(&out); err != nil {
+				return err
+			}
+		}
+		if err != nil {
+			if errors.Is(err, io.EOF) || ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+// SubscribeOnce runs the subscription, returning its first result
+func (c *OperationsClient) SubscribeOnce(ctx context.Context, operationName string, input interface{}) (*OperationResponse, error) {
+	resp, err := c.do(ctx, operationName, input, true)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("subscription %s failed with status code %d", operationName, resp.StatusCode)
+	}
+	var out OperationResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, fmt.Errorf("decoding subscription %s result: %w", operationName, err)
+	}
+	return &out, nil
+}
+
+func (c *OperationsClient) do(ctx context.Context, operationName string, input interface{}, subscribeOnce bool) (*http.Response, error) {
+	body, err := c.requestBody(input)
+	if err != nil {
+		return nil, err
+	}
+	url := c.nodeURL + "/operations/" + operationName
+	if subscribeOnce {
+		url += "?wg_subscribe_once"
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.clientRequest != nil {
+		for _, name := range forwardedHeaders {
+			if value := c.clientRequest.Header.Get(name); value != "" {
+				req.Header.Set(name, value)
+			}
+		}
+	}
+	if requestID := logging.RequestIDFromContext(ctx); requestID != "" {
+		req.Header.Set(logging.RequestIDHeader, requestID)
+	}
+	if c.cycleCounter > 0 {
+		// Lets the WunderNode detect operations calling each other endlessly
+		req.Header.Set("Wg-Cycle-Counter", strconv.Itoa(c.cycleCounter))
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("calling operation %s: %w", operationName, err)
+	}
+	return resp, nil
+}
+
+func (c *OperationsClient) requestBody(input interface{}) ([]byte, error) {
+	type wgData struct {
+		ClientRequest *hooks.WunderGraphRequest `json:"clientRequest"`
+	}
+	payload := struct {
+		Input interface{} `json:"input,omitempty"`
+		WG    wgData      `json:"__wg"`
+	}{
+		Input: input,
+		// The WunderNode requires a client request for internal operations
+		WG: wgData{ClientRequest: &hooks.WunderGraphRequest{
+			Method:     http.MethodGet,
+			RequestURI: "/",
+			Headers:    map[string]string{},
+		}},
+	}
+	if c.clientRequest != nil {
+		payload.WG.ClientRequest = &hooks.WunderGraphRequest{
+			Method:     c.clientRequest.Method,
+			RequestURI: c.clientRequest.URL.String(),
+			Headers:    hooks.HeaderSliceToCSV(c.clientRequest.Header),
+		}
+	}
+	return json.Marshal(payload)
+}
@@ -0,0 +1,103 @@
+package hooksserver_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/buger/jsonparser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wundergraph/wundergraph/pkg/hooks"
+	"github.com/wundergraph/wundergraph/pkg/hooksserver"
+	"github.com/wundergraph/wundergraph/pkg/logging"
+)
+
+func TestOperationsClient(t *testing.T) {
+	var requests []*http.Request
+	var bodies [][]byte
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, r)
+		bodies = append(bodies, body)
+		switch r.URL.Path {
+		case "/operations/Todos":
+			_, _ = w.Write([]byte(`{"data":{"todos":[{"id":1}]}}`))
+		case "/operations/Forbidden":
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"errors":[{"message":"unauthorized"}]}`))
+		case "/operations/Counter":
+			if r.URL.Query().Has("wg_subscribe_once") {
+				_, _ = w.Write([]byte(`{"data":{"count":0}}`))
+				return
+			}
+			_, _ = w.Write([]byte("{\"data\":{\"count\":0}}\n\n{\"data\":{\"count\":1}}\n\n"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer node.Close()
+
+	// Hooks get a client that forwards the client request to the node
+	var operations *hooksserver.OperationsClient
+	server := hooksserver.New(hooksserver.Options{NodeURL: node.URL + "/"})
+	server.Function("todos", func(r *hooksserver.Request, input json.RawMessage) (interface{}, error) {
+		operations = r.Operations
+		return nil, nil
+	})
+	hooksServer := httptest.NewServer(server)
+	defer hooksServer.Close()
+	req, err := http.NewRequest(http.MethodPost, hooksServer.URL+"/functions/todos", strings.NewReader(`{"cycleCounter":2,"__wg":{"clientRequest":{"method":"GET","requestURI":"/operations/todos","headers":{"Authorization":"Bearer token","Cookie":"a=b"}}}}`))
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.NotNil(t, operations)
+
+	ctx := context.WithValue(context.Background(), logging.RequestIDKey{}, "abc")
+	out, err := operations.Query(ctx, "Todos", map[string]int{"first": 10})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"todos":[{"id":1}]}`, string(out.Data))
+	assert.Equal(t, "Bearer token", requests[0].Header.Get("Authorization"))
+	assert.Equal(t, "abc", requests[0].Header.Get(logging.RequestIDHeader))
+	assert.Equal(t, "2", requests[0].Header.Get("Wg-Cycle-Counter"))
+	input, _, _, _ := jsonparser.Get(bodies[0], "input")
+	assert.JSONEq(t, `{"first":10}`, string(input))
+	var clientRequest hooks.WunderGraphRequest
+	data, _, _, _ := jsonparser.Get(bodies[0], "__wg", "clientRequest")
+	require.NoError(t, json.Unmarshal(data, &clientRequest))
+	assert.Equal(t, "/operations/todos", clientRequest.RequestURI)
+	assert.Equal(t, "a=b", clientRequest.Headers["Cookie"])
+
+	out, err = operations.Mutate(ctx, "Forbidden", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "unauthorized", out.Errors[0].Message)
+
+	_, err = operations.Query(ctx, "Missing", nil)
+	assert.EqualError(t, err, "operation Missing failed with status code 404")
+
+	var counts []string
+	err = operations.Subscribe(ctx, "Counter", nil, func(resp *hooksserver.OperationResponse) error {
+		counts = append(counts, string(resp.Data))
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{`{"count":0}`, `{"count":1}`}, counts)
+
+	out, err = operations.SubscribeOnce(ctx, "Counter", nil)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"count":0}`, string(out.Data))
+
+	// Clients created directly send an empty client request
+	_, err = hooksserver.NewOperationsClient(node.URL, nil).Query(context.Background(), "Todos", nil)
+	require.NoError(t, err)
+	last := requests[len(requests)-1]
+	assert.Empty(t, last.Header.Get("Authorization"))
+	method, _ := jsonparser.GetString(bodies[len(bodies)-1], "__wg", "clientRequest", "method")
+	assert.Equal(t, http.MethodGet, method)
+}
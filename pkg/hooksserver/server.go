@@ -0,0 +1,683 @@
+// Package hooksserver implements the hooks protocol spoken by the WunderNode,
+// allowing hooks and functions to be written in Go instead of using the
+// TypeScript server
+package hooksserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/buger/jsonparser"
+	"go.uber.org/zap"
+
+	"github.com/wundergraph/wundergraph/pkg/authentication"
+	"github.com/wundergraph/wundergraph/pkg/hooks"
+	"github.com/wundergraph/wundergraph/pkg/logging"
+)
+
+const (
+	// maximumRecursionLimit is the maximum number of nested operations
+	// started from hooks, it matches the one used by the TypeScript server
+	maximumRecursionLimit = 16
+
+	functionsPrefix = "functions/"
+)
+
+type Options struct {
+	// NodeURL is the internal URL of the WunderNode, used for calling
+	// operations from hooks and functions. Defaults to http://localhost:9993
+	NodeURL string
+	// HTTPClient is used for calling operations, defaults to http.DefaultClient
+	HTTPClient *http.Client
+	Logger     *zap.Logger
+}
+
+// Server implements the hooks server as an http.Handler. Hooks and functions
+// must be registered before it starts serving requests.
+type Server struct {
+	log        *zap.Logger
+	operations *OperationsClient
+	hooks      map[string]*hookRoute
+	functions  map[string]*function
+}
+
+func New(opts Options) *Server {
+	logger := opts.Logger
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &Server{
+		log:        logger,
+		operations: NewOperationsClient(opts.NodeURL, opts.HTTPClient),
+		hooks:      make(map[string]*hookRoute),
+		functions:  make(map[string]*function),
+	}
+}
+
+// Request contains the data sent by the WunderNode with every hook and function
+type Request struct {
+	// User is the authenticated user, nil for anonymous requests
+	User *authentication.User
+	// ClientRequest is the request sent by the client to the WunderNode. Its
+	// headers are sent back by operation and authentication hooks, so
+	// handlers can modify them to change the headers used by the WunderNode.
+	ClientRequest *http.Request
+	// Operations calls operations on the WunderNode on behalf of the client
+	Operations *OperationsClient
+	// Log includes the ID of the request
+	Log *zap.Logger
+
+	ctx context.Context
+}
+
+// Context returns the context of the request, which is canceled when the
+// WunderNode cancels the hook
+func (r *Request) Context() context.Context {
+	return r.ctx
+}
+
+type requestPayload struct {
+	WG struct {
+		User          *authentication.User      `json:"user"`
+		ClientRequest *hooks.WunderGraphRequest `json:"clientRequest"`
+	} `json:"__wg"`
+	CycleCounter int `json:"cycleCounter"`
+}
+
+func (s *Server) newRequest(r *http.Request, body []byte) (*Request, error) {
+	var payload requestPayload
+	if len(body) != 0 {
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return nil, fmt.Errorf("decoding payload: %w", err)
+		}
+	}
+	if payload.CycleCounter > maximumRecursionLimit {
+		return nil, fmt.Errorf("maximum recursion limit reached (%d)", maximumRecursionLimit)
+	}
+	requestID := r.Header.Get(logging.RequestIDHeader)
+	ctx := context.WithValue(r.Context(), logging.RequestIDKey{}, requestID)
+	clientRequest, err := newClientRequest(ctx, payload.WG.ClientRequest)
+	if err != nil {
+		return nil, fmt.Errorf("decoding client request: %w", err)
+	}
+	return &Request{
+		User:          payload.WG.User,
+		ClientRequest: clientRequest,
+		Operations:    s.operations.forRequest(clientRequest, payload.CycleCounter),
+		Log:           s.log.With(logging.WithRequestID(requestID)),
+		ctx:           ctx,
+	}, nil
+}
+
+// newClientRequest decodes the client request sent in __wg.clientRequest.
+// Hooks triggered without a client request get an empty one, so handlers
+// don't need to check it.
+func newClientRequest(ctx context.Context, wgRequest *hooks.WunderGraphRequest) (*http.Request, error) {
+	if wgRequest == nil {
+		wgRequest = &hooks.WunderGraphRequest{Method: http.MethodGet, RequestURI: "/"}
+	}
+	var body io.Reader
+	if len(wgRequest.Body) != 0 {
+		body = bytes.NewReader(wgRequest.Body)
+	}
+	r, err := http.NewRequestWithContext(ctx, wgRequest.Method, wgRequest.RequestURI, body)
+	if err != nil {
+		return nil, err
+	}
+	for name, value := range wgRequest.Headers {
+		r.Header.Set(name, value)
+	}
+	return r, nil
+}
+
+func (r *Request) clientRequestHeaders() map[string]string {
+	return hooks.HeaderSliceToCSV(r.ClientRequest.Header)
+}
+
+// hookResponse is the union of the responses expected by the WunderNode,
+// see hooks.MiddlewareHookResponse and hooks.UploadHookResponse
+type hookResponse struct {
+	Error                   *hooks.HookResponseError `json:"error,omitempty"`
+	Op                      string                   `json:"op,omitempty"`
+	Hook                    string                   `json:"hook,omitempty"`
+	Response                json.RawMessage          `json:"response,omitempty"`
+	Input                   json.RawMessage          `json:"input,omitempty"`
+	SetClientRequestHeaders map[string]string        `json:"setClientRequestHeaders,omitempty"`
+	FileKey                 string                   `json:"fileKey,omitempty"`
+}
+
+type hookRoute struct {
+	op     string
+	hook   string
+	handle func(r *Request, body []byte) (*hookResponse, error)
+}
+
+func (s *Server) handle(action string, hook hooks.MiddlewareHook, hookID string, op string, handle func(r *Request, body []byte) (*hookResponse, error)) {
+	hookPath := action + "/" + string(hook)
+	if hookID != "" {
+		hookPath += "/" + hookID
+	}
+	s.hooks[hookPath] = &hookRoute{
+		op:     op,
+		hook:   string(hook),
+		handle: handle,
+	}
+}
+
+// OperationHookPayload contains the data sent to operation hooks
+type OperationHookPayload struct {
+	// Input contains the variables of the operation
+	Input json.RawMessage `json:"input"`
+	// Response contains the response of the operation, only sent to
+	// postResolve and mutatingPostResolve, or the subscription message
+	// for onSubscriptionEvent
+	Response json.RawMessage `json:"response"`
+}
+
+// OperationHooks contains the hooks for an operation, nil hooks are not
+// registered. Their configuration in the WunderNode must match the
+// registered ones.
+type OperationHooks struct {
+	// MockResolve returns the response used instead of resolving the operation
+	MockResolve func(r *Request, payload *OperationHookPayload) (json.RawMessage, error)
+	PreResolve  func(r *Request, payload *OperationHookPayload) error
+	PostResolve func(r *Request, payload *OperationHookPayload) error
+	// MutatingPreResolve returns the input used for resolving the operation
+	MutatingPreResolve func(r *Request, payload *OperationHookPayload) (json.RawMessage, error)
+	// MutatingPostResolve returns the response sent to the client
+	MutatingPostResolve func(r *Request, payload *OperationHookPayload) (json.RawMessage, error)
+	// CustomResolve returns the response used instead of resolving the
+	// operation. Returning a nil response resolves the operation normally.
+	CustomResolve func(r *Request, payload *OperationHookPayload) (json.RawMessage, error)
+	// OnSubscriptionEvent runs for each message of a subscription, received
+	// in payload.Response, and returns whether to skip, replace or terminate
+	// it. Returning a nil response sends the message unchanged, while errors
+	// terminate the subscription.
+	OnSubscriptionEvent func(r *Request, payload *OperationHookPayload) (*hooks.OnSubscriptionEventHookResponse, error)
+}
+
+// Operation registers the hooks for the given operation
+func (s *Server) Operation(operationName string, h *OperationHooks) {
+	if h.MockResolve != nil {
+		s.operationHook(operationName, hooks.MockResolve, func(r *Request, payload *OperationHookPayload) (*hookResponse, error) {
+			response, err := h.MockResolve(r, payload)
+			return &hookResponse{Response: response}, err
+		})
+	}
+	if h.PreResolve != nil {
+		s.operationHook(operationName, hooks.PreResolve, func(r *Request, payload *OperationHookPayload) (*hookResponse, error) {
+			return &hookResponse{}, h.PreResolve(r, payload)
+		})
+	}
+	if h.PostResolve != nil {
+		s.operationHook(operationName, hooks.PostResolve, func(r *Request, payload *OperationHookPayload) (*hookResponse, error) {
+			return &hookResponse{}, h.PostResolve(r, payload)
+		})
+	}
+	if h.MutatingPreResolve != nil {
+		s.operationHook(operationName, hooks.MutatingPreResolve, func(r *Request, payload *OperationHookPayload) (*hookResponse, error) {
+			input, err := h.MutatingPreResolve(r, payload)
+			return &hookResponse{Input: input}, err
+		})
+	}
+	if h.MutatingPostResolve != nil {
+		s.operationHook(operationName, hooks.MutatingPostResolve, func(r *Request, payload *OperationHookPayload) (*hookResponse, error) {
+			response, err := h.MutatingPostResolve(r, payload)
+			return &hookResponse{Response: response}, err
+		})
+	}
+	if h.CustomResolve != nil {
+		s.operationHook(operationName, hooks.CustomResolve, func(r *Request, payload *OperationHookPayload) (*hookResponse, error) {
+			response, err := h.CustomResolve(r, payload)
+			if response == nil {
+				// The WunderNode resolves the operation when the response is null
+				response = json.RawMessage("null")
+			}
+			return &hookResponse{Response: response}, err
+		})
+	}
+	if h.OnSubscriptionEvent != nil {
+		s.operationHook(operationName, hooks.OnSubscriptionEvent, func(r *Request, payload *OperationHookPayload) (*hookResponse, error) {
+			event, err := h.OnSubscriptionEvent(r, payload)
+			if err != nil || event == nil {
+				return &hookResponse{}, err
+			}
+			response, err := json.Marshal(event)
+			return &hookResponse{Response: response}, err
+		})
+	}
+}
+
+func (s *Server) operationHook(operationName string, hook hooks.MiddlewareHook, handle func(r *Request, payload *OperationHookPayload) (*hookResponse, error)) {
+	s.handle("operation/"+operationName, hook, "", operationName, func(r *Request, body []byte) (*hookResponse, error) {
+		var payload OperationHookPayload
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return nil, err
+		}
+		resp, err := handle(r, &payload)
+		if err != nil {
+			return nil, err
+		}
+		resp.SetClientRequestHeaders = r.clientRequestHeaders()
+		return resp, nil
+	})
+}
+
+// AuthenticationHooks contains the hooks run by the WunderNode when users
+// log in and out. Returning an error from any of them denies the user.
+type AuthenticationHooks struct {
+	PostAuthentication func(r *Request) error
+	// MutatingPostAuthentication returns the user stored by the WunderNode
+	MutatingPostAuthentication func(r *Request) (*authentication.User, error)
+	// RevalidateAuthentication returns the updated user
+	RevalidateAuthentication func(r *Request) (*authentication.User, error)
+	PostLogout               func(r *Request) error
+}
+
+// Authentication registers the authentication hooks
+func (s *Server) Authentication(h *AuthenticationHooks) {
+	if h.PostAuthentication != nil {
+		s.authenticationHook(hooks.PostAuthentication, func(r *Request) (*authentication.User, error) {
+			return nil, h.PostAuthentication(r)
+		})
+	}
+	if h.MutatingPostAuthentication != nil {
+		s.authenticationHook(hooks.MutatingPostAuthentication, h.MutatingPostAuthentication)
+	}
+	if h.RevalidateAuthentication != nil {
+		s.authenticationHook(hooks.RevalidateAuthentication, h.RevalidateAuthentication)
+	}
+	if h.PostLogout != nil {
+		s.authenticationHook(hooks.PostLogout, func(r *Request) (*authentication.User, error) {
+			return nil, h.PostLogout(r)
+		})
+	}
+}
+
+func (s *Server) authenticationHook(hook hooks.MiddlewareHook, handle func(r *Request) (*authentication.User, error)) {
+	s.handle("authentication", hook, "", "", func(r *Request, body []byte) (*hookResponse, error) {
+		user, err := handle(r)
+		if err != nil {
+			return nil, err
+		}
+		resp := &hookResponse{SetClientRequestHeaders: r.clientRequestHeaders()}
+		if user != nil {
+			response, err := json.Marshal(map[string]interface{}{
+				"status": "ok",
+				"user":   user,
+			})
+			if err != nil {
+				return nil, err
+			}
+			resp.Response = response
+		}
+		return resp, nil
+	})
+}
+
+// GlobalHooks contains the hooks run for the requests sent by the WunderNode
+// to the data sources
+type GlobalHooks struct {
+	// OnOriginRequest can modify, skip or cancel the request to the origin.
+	// Returning a nil response skips the hook.
+	OnOriginRequest func(r *Request, payload *hooks.OnRequestHookPayload) (*hooks.OnRequestHookResponse, error)
+	// OnOriginResponse can modify, skip or cancel the response from the
+	// origin. Returning a nil response skips the hook.
+	OnOriginResponse func(r *Request, payload *hooks.OnResponseHookPayload) (*hooks.OnResponseHookResponse, error)
+	// OnOriginTransport contains the hooks replacing the request to the
+	// origin, indexed by their ID
+	OnOriginTransport map[string]func(r *Request, payload *hooks.OnRequestHookPayload) (*hooks.OnResponseHookResponse, error)
+	// OnWsConnectionInit returns the payload sent by the WunderNode when
+	// initializing WebSocket connections to the data source
+	OnWsConnectionInit func(r *Request, payload *hooks.OnWsConnectionInitHookPayload) (json.RawMessage, error)
+}
+
+// Global registers the global hooks
+func (s *Server) Global(h *GlobalHooks) {
+	if h.OnOriginRequest != nil {
+		s.handle("global/httpTransport", hooks.HttpTransportOnRequest, "", "", func(r *Request, body []byte) (*hookResponse, error) {
+			var payload hooks.OnRequestHookPayload
+			if err := json.Unmarshal(body, &payload); err != nil {
+				return nil, err
+			}
+			result, err := h.OnOriginRequest(r, &payload)
+			if err != nil {
+				return nil, err
+			}
+			if result == nil {
+				result = &hooks.OnRequestHookResponse{Skip: true}
+			}
+			return newTransportHookResponse(result)
+		})
+	}
+	if h.OnOriginResponse != nil {
+		s.handle("global/httpTransport", hooks.HttpTransportOnResponse, "", "", func(r *Request, body []byte) (*hookResponse, error) {
+			var payload hooks.OnResponseHookPayload
+			if err := json.Unmarshal(body, &payload); err != nil {
+				return nil, err
+			}
+			result, err := h.OnOriginResponse(r, &payload)
+			if err != nil {
+				return nil, err
+			}
+			if result == nil {
+				result = &hooks.OnResponseHookResponse{Skip: true}
+			}
+			return newTransportHookResponse(result)
+		})
+	}
+	for hookID, handler := range h.OnOriginTransport {
+		handler := handler
+		s.handle("global/httpTransport", hooks.HttpTransportOnTransport, hookID, "", func(r *Request, body []byte) (*hookResponse, error) {
+			var payload hooks.OnRequestHookPayload
+			if err := json.Unmarshal(body, &payload); err != nil {
+				return nil, err
+			}
+			result, err := handler(r, &payload)
+			if err != nil {
+				return nil, err
+			}
+			if result == nil {
+				result = &hooks.OnResponseHookResponse{Skip: true}
+			}
+			return newTransportHookResponse(result)
+		})
+	}
+	if h.OnWsConnectionInit != nil {
+		s.handle("global/wsTransport", hooks.WsTransportOnConnectionInit, "", "", func(r *Request, body []byte) (*hookResponse, error) {
+			var payload hooks.OnWsConnectionInitHookPayload
+			if err := json.Unmarshal(body, &payload); err != nil {
+				return nil, err
+			}
+			initPayload, err := h.OnWsConnectionInit(r, &payload)
+			if err != nil {
+				return nil, err
+			}
+			return newTransportHookResponse(map[string]json.RawMessage{"payload": initPayload})
+		})
+	}
+}
+
+func newTransportHookResponse(result interface{}) (*hookResponse, error) {
+	response, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	return &hookResponse{Response: response}, nil
+}
+
+type UploadFile struct {
+	// Key is empty in preUpload, since the file hasn't been stored yet
+	Key  string `json:"key"`
+	Name string `json:"name"`
+	Size int64  `json:"size"`
+	Type string `json:"type"`
+}
+
+type UploadError struct {
+	Name    string `json:"name"`
+	Message string `json:"message"`
+}
+
+// UploadHookPayload contains the data sent to upload hooks
+type UploadHookPayload struct {
+	File UploadFile `json:"file"`
+	// Meta contains the metadata sent by the client with the upload
+	Meta json.RawMessage `json:"meta"`
+	// Error is set in postUpload when the upload failed
+	Error *UploadError `json:"error"`
+}
+
+// UploadHooks contains the hooks for an upload profile. Returning an error
+// from PreUpload or PreDownload denies the operation.
+type UploadHooks struct {
+	// PreUpload returns the key used for storing the file, if empty the
+	// WunderNode generates one
+	PreUpload   func(r *Request, payload *UploadHookPayload) (string, error)
+	PostUpload  func(r *Request, payload *UploadHookPayload) error
+	PreDownload func(r *Request, payload *UploadHookPayload) error
+}
+
+// Upload registers the hooks for the given upload provider and profile
+func (s *Server) Upload(providerName string, profileName string, h *UploadHooks) {
+	action := "upload/" + providerName + "/" + profileName
+	register := func(hook hooks.UploadHook, handle func(r *Request, payload *UploadHookPayload) (*hookResponse, error)) {
+		s.handle(action, hooks.MiddlewareHook(hook), "", "", func(r *Request, body []byte) (*hookResponse, error) {
+			var payload UploadHookPayload
+			if err := json.Unmarshal(body, &payload); err != nil {
+				return nil, err
+			}
+			return handle(r, &payload)
+		})
+	}
+	if h.PreUpload != nil {
+		register(hooks.PreUpload, func(r *Request, payload *UploadHookPayload) (*hookResponse, error) {
+			fileKey, err := h.PreUpload(r, payload)
+			return &hookResponse{FileKey: fileKey}, err
+		})
+	}
+	if h.PostUpload != nil {
+		register(hooks.PostUpload, func(r *Request, payload *UploadHookPayload) (*hookResponse, error) {
+			return &hookResponse{}, h.PostUpload(r, payload)
+		})
+	}
+	if h.PreDownload != nil {
+		register(hooks.PreDownload, func(r *Request, payload *UploadHookPayload) (*hookResponse, error) {
+			return &hookResponse{}, h.PreDownload(r, payload)
+		})
+	}
+}
+
+// FunctionHandler implements a query or mutation function. The returned
+// value is encoded as JSON and sent to the client as the data of the operation.
+type FunctionHandler func(r *Request, input json.RawMessage) (interface{}, error)
+
+// SubscriptionHandler implements a subscription function, calling send with
+// each result. Once send returns an error the subscription is over and the
+// handler must return.
+type SubscriptionHandler func(r *Request, input json.RawMessage, send func(data interface{}) error) error
+
+type function struct {
+	handler      FunctionHandler
+	subscription SubscriptionHandler
+}
+
+// Function registers a query or mutation function. The path is the one used
+// by the WunderNode for calling it, e.g. users/get for the users/get.ts
+// operation in TypeScript.
+func (s *Server) Function(path string, handler FunctionHandler) {
+	s.functions[path] = &function{handler: handler}
+}
+
+// Subscription registers a subscription function, see Function
+func (s *Server) Subscription(path string, handler SubscriptionHandler) {
+	s.functions[path] = &function{subscription: handler}
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	hookPath := strings.TrimPrefix(r.URL.Path, "/")
+	if hookPath == "health" {
+		writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+		return
+	}
+	if r.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	if strings.HasPrefix(hookPath, functionsPrefix) {
+		if fn := s.functions[strings.TrimPrefix(hookPath, functionsPrefix)]; fn != nil {
+			s.serveFunction(w, r, fn)
+			return
+		}
+	} else if route := s.hooks[hookPath]; route != nil {
+		s.serveHook(w, r, route)
+		return
+	}
+	http.NotFound(w, r)
+}
+
+func (s *Server) serveHook(w http.ResponseWriter, r *http.Request, route *hookRoute) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+	req, err := s.newRequest(r, body)
+	if err == nil {
+		var resp *hookResponse
+		if resp, err = route.handle(req, body); err == nil {
+			resp.Op = route.op
+			resp.Hook = route.hook
+			writeJSON(w, http.StatusOK, resp)
+			return
+		}
+	}
+	s.log.Error("hook failed",
+		zap.String("hook", route.hook),
+		zap.String("operationName", route.op),
+		zap.String("requestID", r.Header.Get(logging.RequestIDHeader)),
+		zap.Error(err),
+	)
+	writeJSON(w, http.StatusInternalServerError, &hookResponse{
+		Op:    route.op,
+		Hook:  route.hook,
+		Error: newHookResponseError(err),
+	})
+}
+
+// newHookResponseError returns the error reported to the WunderNode, handlers
+// can return a *hooks.HookResponseError to set all its fields
+func newHookResponseError(err error) *hooks.HookResponseError {
+	var hookErr *hooks.HookResponseError
+	if errors.As(err, &hookErr) {
+		return hookErr
+	}
+	return &hooks.HookResponseError{Message: err.Error()}
+}
+
+type functionResponse struct {
+	Data   interface{}       `json:"data,omitempty"`
+	Errors []*OperationError `json:"errors,omitempty"`
+}
+
+func (s *Server) serveFunction(w http.ResponseWriter, r *http.Request, fn *function) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+	req, err := s.newRequest(r, body)
+	if err != nil {
+		s.writeFunctionError(w, r, err)
+		return
+	}
+	input, _, _, _ := jsonparser.Get(body, "input")
+	if len(input) == 0 {
+		input = []byte(`{}`)
+	}
+	if fn.subscription != nil {
+		s.serveSubscription(w, r, req, fn.subscription, input)
+		return
+	}
+	data, err := fn.handler(req, input)
+	if err != nil {
+		s.writeFunctionError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"response": &functionResponse{Data: data},
+	})
+}
+
+// newOperationError returns the error sent to the client, handlers can
+// return an *OperationError to set its code and status code
+func newOperationError(err error) *OperationError {
+	var opErr *OperationError
+	if errors.As(err, &opErr) {
+		return opErr
+	}
+	return &OperationError{Code: "InternalError", Message: err.Error()}
+}
+
+func (s *Server) writeFunctionError(w http.ResponseWriter, r *http.Request, err error) {
+	s.log.Error("function failed",
+		zap.String("path", r.URL.Path),
+		zap.String("requestID", r.Header.Get(logging.RequestIDHeader)),
+		zap.Error(err),
+	)
+	opErr := newOperationError(err)
+	statusCode := opErr.StatusCode
+	if statusCode == 0 {
+		statusCode = http.StatusInternalServerError
+	}
+	writeJSON(w, statusCode, map[string]interface{}{
+		"response": &functionResponse{Errors: []*OperationError{opErr}},
+	})
+}
+
+var errSubscribeOnce = errors.New("subscribe once: result already sent")
+
+func (s *Server) serveSubscription(w http.ResponseWriter, r *http.Request, req *Request, handler SubscriptionHandler, input []byte) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "connection not flushable", http.StatusInternalServerError)
+		return
+	}
+	subscribeOnce := r.Header.Get("X-WG-Subscribe-Once") == "true"
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	sent := false
+	send := func(data interface{}) error {
+		if subscribeOnce && sent {
+			return errSubscribeOnce
+		}
+		if err := req.ctx.Err(); err != nil {
+			return err
+		}
+		if err := writeChunk(w, &functionResponse{Data: data}); err != nil {
+			return err
+		}
+		flusher.Flush()
+		sent = true
+		if subscribeOnce {
+			return errSubscribeOnce
+		}
+		return nil
+	}
+	err := handler(req, input, send)
+	if err == nil || errors.Is(err, errSubscribeOnce) || req.ctx.Err() != nil {
+		return
+	}
+	s.log.Error("subscription failed",
+		zap.String("path", r.URL.Path),
+		zap.String("requestID", r.Header.Get(logging.RequestIDHeader)),
+		zap.Error(err),
+	)
+	// The status has already been sent, report the error as the last result
+	if err := writeChunk(w, &functionResponse{Errors: []*OperationError{newOperationError(err)}}); err == nil {
+		flusher.Flush()
+	}
+}
+
+// writeChunk writes a subscription result followed by the separator expected
+// by hooks.Client.DoFunctionSubscriptionRequest
+func writeChunk(w io.Writer, chunk interface{}) error {
+	data, err := json.Marshal(chunk)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(data, '\n', '\n'))
+	return err
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(v)
+}
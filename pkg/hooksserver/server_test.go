@@ -0,0 +1,292 @@
+package hooksserver_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/wundergraph/wundergraph/pkg/authentication"
+	"github.com/wundergraph/wundergraph/pkg/hooks"
+	"github.com/wundergraph/wundergraph/pkg/hooksserver"
+	"github.com/wundergraph/wundergraph/pkg/pool"
+)
+
+func newTestClient(t *testing.T, server *hooksserver.Server) *hooks.Client {
+	srv := httptest.NewServer(server)
+	t.Cleanup(srv.Close)
+	return hooks.NewClient(&hooks.ClientOptions{
+		ServerURL: srv.URL,
+		Logger:    zap.NewNop(),
+	})
+}
+
+func newClientRequest() *http.Request {
+	clientRequest := httptest.NewRequest(http.MethodGet, "/operations/Todos", nil)
+	clientRequest.Header.Set("Authorization", "Bearer token")
+	ctx := context.WithValue(context.Background(), pool.ClientRequestKey, clientRequest)
+	return clientRequest.WithContext(context.WithValue(ctx, "user", &authentication.User{
+		UserID: "1",
+		Roles:  []string{"admin"},
+	}))
+}
+
+func TestOperationHooks(t *testing.T) {
+	server := hooksserver.New(hooksserver.Options{})
+	server.Operation("Todos", &hooksserver.OperationHooks{
+		PreResolve: func(r *hooksserver.Request, payload *hooksserver.OperationHookPayload) error {
+			if r.User == nil {
+				return errors.New("anonymous")
+			}
+			r.ClientRequest.Header.Set("X-User", r.User.UserID)
+			return nil
+		},
+		MutatingPreResolve: func(r *hooksserver.Request, payload *hooksserver.OperationHookPayload) (json.RawMessage, error) {
+			var input map[string]interface{}
+			if err := json.Unmarshal(payload.Input, &input); err != nil {
+				return nil, err
+			}
+			input["owner"] = r.User.UserID
+			return json.Marshal(input)
+		},
+		MutatingPostResolve: func(r *hooksserver.Request, payload *hooksserver.OperationHookPayload) (json.RawMessage, error) {
+			return json.RawMessage(`{"data":{"todos":[]}}`), nil
+		},
+		CustomResolve: func(r *hooksserver.Request, payload *hooksserver.OperationHookPayload) (json.RawMessage, error) {
+			return nil, nil
+		},
+		PostResolve: func(r *hooksserver.Request, payload *hooksserver.OperationHookPayload) error {
+			return &hooks.HookResponseError{Code: "Forbidden", Message: "denied"}
+		},
+		OnSubscriptionEvent: func(r *hooksserver.Request, payload *hooksserver.OperationHookPayload) (*hooks.OnSubscriptionEventHookResponse, error) {
+			return &hooks.OnSubscriptionEventHookResponse{
+				Terminate: true,
+				Event:     json.RawMessage(`{"data":{"todos":` + string(payload.Response) + `}}`),
+			}, nil
+		},
+	})
+	client := newTestClient(t, server)
+	assert.True(t, client.DoHealthCheckRequest(context.Background()))
+
+	r := newClientRequest()
+	buf := &bytes.Buffer{}
+	data, err := hooks.EncodeData(r, buf, []byte(`{"first":10}`), []byte(`{"data":{"todos":[{"id":1}]}}`))
+	require.NoError(t, err)
+
+	resp, err := client.DoOperationRequest(r.Context(), "Todos", hooks.PreResolve, data, buf)
+	require.NoError(t, err)
+	assert.Equal(t, "Todos", resp.Op)
+	assert.Equal(t, "preResolve", resp.Hook)
+	assert.Equal(t, "1", resp.SetClientRequestHeaders["X-User"])
+	assert.Equal(t, "Bearer token", resp.SetClientRequestHeaders["Authorization"])
+
+	resp, err = client.DoOperationRequest(r.Context(), "Todos", hooks.MutatingPreResolve, data, buf)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"first":10,"owner":"1"}`, string(resp.Input))
+
+	resp, err = client.DoOperationRequest(r.Context(), "Todos", hooks.MutatingPostResolve, data, buf)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"data":{"todos":[]}}`, string(resp.Response))
+
+	resp, err = client.DoOperationRequest(r.Context(), "Todos", hooks.CustomResolve, data, buf)
+	require.NoError(t, err)
+	assert.Equal(t, "null", string(resp.Response))
+
+	_, err = client.DoOperationRequest(r.Context(), "Todos", hooks.PostResolve, data, buf)
+	assert.EqualError(t, err, "denied")
+
+	resp, err = client.DoOperationRequest(r.Context(), "Todos", hooks.OnSubscriptionEvent, data, buf)
+	require.NoError(t, err)
+	var event hooks.OnSubscriptionEventHookResponse
+	require.NoError(t, json.Unmarshal(resp.Response, &event))
+	assert.True(t, event.Terminate)
+	assert.False(t, event.Skip)
+	assert.JSONEq(t, `{"data":{"todos":{"data":{"todos":[{"id":1}]}}}}`, string(event.Event))
+
+	// Unregistered hooks aren't found
+	_, err = client.DoOperationRequest(r.Context(), "Todos", hooks.MockResolve, data, buf)
+	assert.Error(t, err)
+
+	// Anonymous requests
+	data, err = hooks.EncodeData(httptest.NewRequest(http.MethodGet, "/", nil), &bytes.Buffer{}, nil, nil)
+	require.NoError(t, err)
+	_, err = client.DoOperationRequest(context.Background(), "Todos", hooks.PreResolve, data, buf)
+	assert.EqualError(t, err, "anonymous")
+}
+
+func TestRecursionLimit(t *testing.T) {
+	server := hooksserver.New(hooksserver.Options{})
+	server.Operation("Todos", &hooksserver.OperationHooks{
+		PreResolve: func(r *hooksserver.Request, payload *hooksserver.OperationHookPayload) error {
+			return nil
+		},
+	})
+	client := newTestClient(t, server)
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Wg-Cycle-Counter", "16")
+	buf := &bytes.Buffer{}
+	data, err := hooks.EncodeData(r, buf, nil, nil)
+	require.NoError(t, err)
+	_, err = client.DoOperationRequest(context.Background(), "Todos", hooks.PreResolve, data, buf)
+	assert.EqualError(t, err, "maximum recursion limit reached (16)")
+}
+
+func TestAuthenticationHooks(t *testing.T) {
+	var loggedOut string
+	server := hooksserver.New(hooksserver.Options{})
+	server.Authentication(&hooksserver.AuthenticationHooks{
+		MutatingPostAuthentication: func(r *hooksserver.Request) (*authentication.User, error) {
+			if r.User.Email == "" {
+				return nil, errors.New("missing email")
+			}
+			user := *r.User
+			user.Roles = []string{"user"}
+			return &user, nil
+		},
+		PostLogout: func(r *hooksserver.Request) error {
+			loggedOut = r.User.UserID
+			return nil
+		},
+	})
+	authHooks := hooks.NewAuthenticationHooks(hooks.AuthenticationConfig{
+		Client:                     newTestClient(t, server),
+		Log:                        zap.NewNop(),
+		MutatingPostAuthentication: true,
+		PostLogout:                 true,
+	})
+
+	user, err := authHooks.MutatingPostAuthentication(context.Background(), &authentication.User{UserID: "1", Email: "jens@wundergraph.com"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"user"}, user.Roles)
+
+	_, err = authHooks.MutatingPostAuthentication(context.Background(), &authentication.User{UserID: "1"})
+	assert.EqualError(t, err, "missing email")
+
+	require.NoError(t, authHooks.PostLogout(context.Background(), &authentication.User{UserID: "2"}))
+	assert.Equal(t, "2", loggedOut)
+}
+
+func TestGlobalHooks(t *testing.T) {
+	server := hooksserver.New(hooksserver.Options{})
+	server.Global(&hooksserver.GlobalHooks{
+		OnOriginRequest: func(r *hooksserver.Request, payload *hooks.OnRequestHookPayload) (*hooks.OnRequestHookResponse, error) {
+			if payload.OperationName != "Todos" {
+				return nil, nil
+			}
+			request := payload.Request
+			request.Headers["X-Operation"] = payload.OperationName
+			return &hooks.OnRequestHookResponse{Request: &request}, nil
+		},
+		OnOriginTransport: map[string]func(r *hooksserver.Request, payload *hooks.OnRequestHookPayload) (*hooks.OnResponseHookResponse, error){
+			"cache": func(r *hooksserver.Request, payload *hooks.OnRequestHookPayload) (*hooks.OnResponseHookResponse, error) {
+				return &hooks.OnResponseHookResponse{Response: &hooks.WunderGraphResponse{StatusCode: http.StatusOK, Body: json.RawMessage(`{"cached":true}`)}}, nil
+			},
+		},
+		OnWsConnectionInit: func(r *hooksserver.Request, payload *hooks.OnWsConnectionInitHookPayload) (json.RawMessage, error) {
+			return json.Marshal(map[string]string{"dataSource": payload.DataSourceID})
+		},
+	})
+	client := newTestClient(t, server)
+	buf := &bytes.Buffer{}
+
+	for _, operationName := range []string{"Todos", "Users"} {
+		payload, err := json.Marshal(hooks.OnRequestHookPayload{
+			Request:       hooks.WunderGraphRequest{Method: http.MethodPost, RequestURI: "http://localhost/graphql", Headers: map[string]string{}},
+			OperationName: operationName,
+		})
+		require.NoError(t, err)
+		resp, err := client.DoGlobalRequest(context.Background(), hooks.HttpTransportOnRequest, "", payload, buf)
+		require.NoError(t, err)
+		var result hooks.OnRequestHookResponse
+		require.NoError(t, json.Unmarshal(resp.Response, &result))
+		if operationName == "Todos" {
+			assert.Equal(t, "Todos", result.Request.Headers["X-Operation"])
+		} else {
+			assert.True(t, result.Skip)
+		}
+	}
+
+	resp, err := client.DoGlobalRequest(context.Background(), hooks.HttpTransportOnTransport, "cache", []byte(`{}`), buf)
+	require.NoError(t, err)
+	var result hooks.OnResponseHookResponse
+	require.NoError(t, json.Unmarshal(resp.Response, &result))
+	assert.JSONEq(t, `{"cached":true}`, string(result.Response.Body))
+
+	resp, err = client.DoWsTransportRequest(context.Background(), hooks.WsTransportOnConnectionInit, []byte(`{"dataSourceId":"countries"}`), buf)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"payload":{"dataSource":"countries"}}`, string(resp.Response))
+}
+
+func TestUploadHooks(t *testing.T) {
+	server := hooksserver.New(hooksserver.Options{})
+	server.Upload("s3", "avatar", &hooksserver.UploadHooks{
+		PreUpload: func(r *hooksserver.Request, payload *hooksserver.UploadHookPayload) (string, error) {
+			if payload.File.Size > 1024 {
+				return "", errors.New("too big")
+			}
+			return "avatars/" + r.User.UserID + "/" + payload.File.Name, nil
+		},
+	})
+	client := newTestClient(t, server)
+	buf := &bytes.Buffer{}
+
+	resp, err := client.DoUploadRequest(context.Background(), "s3", "avatar", hooks.PreUpload, []byte(`{"__wg":{"user":{"userId":"1"}},"file":{"name":"me.png","size":10,"type":"image/png"}}`), buf)
+	require.NoError(t, err)
+	assert.Equal(t, "avatars/1/me.png", resp.FileKey)
+
+	_, err = client.DoUploadRequest(context.Background(), "s3", "avatar", hooks.PreUpload, []byte(`{"file":{"name":"me.png","size":2048}}`), buf)
+	assert.EqualError(t, err, "too big")
+}
+
+func TestFunctions(t *testing.T) {
+	server := hooksserver.New(hooksserver.Options{})
+	server.Function("users/get", func(r *hooksserver.Request, input json.RawMessage) (interface{}, error) {
+		var args struct {
+			ID int `json:"id"`
+		}
+		if err := json.Unmarshal(input, &args); err != nil {
+			return nil, err
+		}
+		if args.ID == 0 {
+			return nil, &hooksserver.OperationError{Code: "NotFound", Message: "user not found", StatusCode: http.StatusNotFound}
+		}
+		return map[string]interface{}{"id": args.ID, "auth": r.ClientRequest.Header.Get("Authorization")}, nil
+	})
+	server.Subscription("users/count", func(r *hooksserver.Request, input json.RawMessage, send func(data interface{}) error) error {
+		for ii := 0; ii < 3; ii++ {
+			if err := send(ii); err != nil {
+				return err
+			}
+		}
+		return errors.New("done counting")
+	})
+	client := newTestClient(t, server)
+	r := newClientRequest()
+	buf := &bytes.Buffer{}
+
+	resp, err := client.DoFunctionRequest(r.Context(), "users/get", []byte(`{"input":{"id":1}}`), buf)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.ClientResponseStatusCode)
+	assert.JSONEq(t, `{"data":{"id":1,"auth":"Bearer token"}}`, string(resp.Response))
+
+	resp, err = client.DoFunctionRequest(r.Context(), "users/get", []byte(`{"input":{}}`), buf)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.ClientResponseStatusCode)
+	assert.JSONEq(t, `{"errors":[{"code":"NotFound","message":"user not found","statusCode":404}]}`, string(resp.Response))
+
+	w := httptest.NewRecorder()
+	require.NoError(t, client.DoFunctionSubscriptionRequest(r.Context(), "users/count", nil, false, w, buf))
+	assert.Equal(t, `{"data":0}{"data":1}{"data":2}{"errors":[{"code":"InternalError","message":"done counting"}]}`, w.Body.String())
+
+	w = httptest.NewRecorder()
+	require.NoError(t, client.DoFunctionSubscriptionRequest(r.Context(), "users/count", nil, true, w, buf))
+	assert.Equal(t, `{"data":0}`, w.Body.String())
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/wundergraph/wundergraph/pkg/apihandler"
+	"github.com/wundergraph/wundergraph/pkg/authentication"
 	"github.com/wundergraph/wundergraph/pkg/hooks"
 	"github.com/wundergraph/wundergraph/pkg/loadvariable"
 	"github.com/wundergraph/wundergraph/pkg/logging"
@@ -127,19 +128,96 @@ func CreateConfig(graphConfig *wgpb.WunderGraphConfiguration) (*WunderNodeConfig
 		return nil, err
 	}
 
+	webhookQueueOptions := graphConfig.Api.GetNodeOptions().GetWebhookQueue()
+
+	wasmHooksOptions := graphConfig.Api.GetServerOptions().GetWasmHooks()
+	var wasmHookLimits map[string]hooks.WasmLimits
+	for _, limits := range wasmHooksOptions.GetHookLimits() {
+		if wasmHookLimits == nil {
+			wasmHookLimits = make(map[string]hooks.WasmLimits)
+		}
+		wasmHookLimits[limits.GetHook()] = wasmLimits(limits.GetMaxMemoryMb(), limits.GetTimeoutMs())
+	}
+
+	grpcHooksOptions := graphConfig.Api.GetServerOptions().GetGrpcHooks()
+
+	serverPoolOptions := graphConfig.Api.GetServerOptions().GetServerPool()
+	var serverPoolRoutes []apihandler.HooksServerRoute
+	for _, route := range serverPoolOptions.GetRoutes() {
+		serverPoolRoutes = append(serverPoolRoutes, apihandler.HooksServerRoute{
+			Hook:       route.GetHook(),
+			ServerURLs: loadvariable.Strings(route.GetServerUrls()),
+		})
+	}
+
+	asyncHooksOptions := graphConfig.Api.GetServerOptions().GetAsyncHooks()
+
+	var hookPolicies []hooks.HookPolicy
+	for _, policy := range graphConfig.Api.GetServerOptions().GetHookPolicies() {
+		hookPolicy := hooks.HookPolicy{
+			Hook:     policy.GetHook(),
+			Timeout:  time.Duration(policy.GetTimeoutMs()) * time.Millisecond,
+			Fallback: []byte(policy.GetFallbackResponse()),
+		}
+		if policy.MaxRetries != nil {
+			maxRetries := int(policy.GetMaxRetries())
+			hookPolicy.MaxRetries = &maxRetries
+		}
+		switch policy.GetFailureMode() {
+		case wgpb.HookFailureMode_HookFailOpen:
+			hookPolicy.FailureMode = hooks.FailOpen
+		case wgpb.HookFailureMode_HookFailFallback:
+			hookPolicy.FailureMode = hooks.FailFallback
+		}
+		hookPolicies = append(hookPolicies, hookPolicy)
+	}
+
 	var apiHooks []*hooks.Hook
 	for _, hook := range graphConfig.GetHooks() {
 		matcher := hook.GetMatcher()
+		hookMatcher := hooks.HookMatcher{
+			OperationType:  operation.TypeFromOperationType(matcher.GetOperationType()),
+			DataSources:    matcher.GetDatasources(),
+			OperationNames: matcher.GetOperationNames(),
+			Methods:        matcher.GetMethods(),
+			Paths:          matcher.GetPaths(),
+			Roles:          matcher.GetRoles(),
+		}
+		for _, header := range matcher.GetHeaders() {
+			hookMatcher.Headers = append(hookMatcher.Headers, hooks.HeaderMatcher{
+				Name:  header.GetName(),
+				Value: header.GetValue(),
+			})
+		}
+		if err := hookMatcher.Validate(); err != nil {
+			return nil, fmt.Errorf("hook %s: %w", hook.GetId(), err)
+		}
 		apiHooks = append(apiHooks, &hooks.Hook{
-			ID:   hook.GetId(),
-			Type: hook.Type,
-			Matcher: hooks.HookMatcher{
-				OperationType: operation.TypeFromOperationType(matcher.GetOperationType()),
-				DataSources:   matcher.GetDatasources(),
-			},
+			ID:      hook.GetId(),
+			Type:    hook.Type,
+			Matcher: hookMatcher,
 		})
 	}
 
+	if err := authentication.ValidateRoleMappings(graphConfig.GetApi().GetAuthenticationConfig()); err != nil {
+		return nil, err
+	}
+
+	var originRequestExpressions map[string]*hooks.ExpressionHooks
+	for _, ds := range graphConfig.GetApi().GetEngineConfiguration().GetDatasourceConfigurations() {
+		expressions, err := hooks.NewExpressionHooks(ds.GetOriginRequestExpressions(), hooks.ExpressionStageOriginRequest)
+		if err != nil {
+			return nil, fmt.Errorf("datasource %s: %w", ds.GetId(), err)
+		}
+		if expressions == nil {
+			continue
+		}
+		if originRequestExpressions == nil {
+			originRequestExpressions = make(map[string]*hooks.ExpressionHooks)
+		}
+		originRequestExpressions[ds.GetId()] = expressions
+	}
+
 	config := WunderNodeConfig{
 		Api: &apihandler.Api{
 			PrimaryHost:           fmt.Sprintf("%s:%d", listener.Host, listener.Port),
@@ -154,6 +232,7 @@ func CreateConfig(graphConfig *wgpb.WunderGraphConfiguration) (*WunderNodeConfig
 			S3UploadConfiguration: graphConfig.Api.S3UploadConfiguration,
 			AuthenticationConfig:  graphConfig.Api.AuthenticationConfig,
 			Webhooks:              graphConfig.Api.Webhooks,
+			EventWebhooks:         graphConfig.Api.EventWebhooks,
 			Options: &apihandler.Options{
 				ServerUrl:        strings.TrimSuffix(loadvariable.String(graphConfig.Api.ServerOptions.ServerUrl), "/"),
 				PublicNodeUrl:    loadvariable.String(graphConfig.Api.NodeOptions.PublicNodeUrl),
@@ -177,8 +256,40 @@ func CreateConfig(graphConfig *wgpb.WunderGraphConfiguration) (*WunderNodeConfig
 					ExporterHTTPEndpoint: loadvariable.String(openTelemetryOptions.GetExporterHttpEndpoint()),
 					Sampler:              otelSampler,
 				},
+				WebhookQueue: apihandler.WebhookQueueOptions{
+					Kind:      webhookQueueOptions.GetKind(),
+					Directory: loadvariable.String(webhookQueueOptions.GetDirectory()),
+					NatsURL:   loadvariable.String(webhookQueueOptions.GetNatsUrl()),
+					NatsToken: loadvariable.String(webhookQueueOptions.GetNatsToken()),
+				},
+				WasmHooks: apihandler.WasmHooksOptions{
+					ModulePath: loadvariable.String(wasmHooksOptions.GetModulePath()),
+					Limits:     wasmLimits(wasmHooksOptions.GetMaxMemoryMb(), wasmHooksOptions.GetTimeoutMs()),
+					HookLimits: wasmHookLimits,
+				},
+				GRPCHooks: apihandler.GRPCHooksOptions{
+					Address: loadvariable.String(grpcHooksOptions.GetAddress()),
+					TLS:     grpcHooksOptions.GetTls(),
+				},
+				HooksServerPool: apihandler.HooksServerPoolOptions{
+					ServerURLs:          loadvariable.Strings(serverPoolOptions.GetServerUrls()),
+					LoadBalancing:       serverPoolOptions.GetLoadBalancing(),
+					HealthCheckInterval: time.Duration(serverPoolOptions.GetHealthCheckIntervalSeconds()) * time.Second,
+					MaxFailures:         int(serverPoolOptions.GetMaxFailures()),
+					EjectionDuration:    time.Duration(serverPoolOptions.GetEjectionSeconds()) * time.Second,
+					Routes:              serverPoolRoutes,
+				},
+				HookPolicies: hookPolicies,
+				AsyncHooks: apihandler.AsyncHooksOptions{
+					Hooks:     asyncHooksOptions.GetHooks(),
+					QueueSize: int(asyncHooksOptions.GetQueueSize()),
+					Workers:   int(asyncHooksOptions.GetWorkers()),
+					Durable:   asyncHooksOptions.GetDurable(),
+					Directory: loadvariable.String(asyncHooksOptions.GetDirectory()),
+				},
 			},
-			Hooks: apiHooks,
+			Hooks:                    apiHooks,
+			OriginRequestExpressions: originRequestExpressions,
 		},
 		Server: &Server{
 			GracefulShutdownTimeout: 0,
@@ -191,3 +302,10 @@ func CreateConfig(graphConfig *wgpb.WunderGraphConfiguration) (*WunderNodeConfig
 
 	return &config, nil
 }
+
+func wasmLimits(maxMemoryMb int32, timeoutMs int64) hooks.WasmLimits {
+	return hooks.WasmLimits{
+		MaxMemoryBytes: uint64(maxMemoryMb) * 1024 * 1024,
+		Timeout:        time.Duration(timeoutMs) * time.Millisecond,
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"net/http/httputil"
 	"net/url"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -29,6 +30,8 @@ import (
 
 	"github.com/wundergraph/wundergraph/pkg/apihandler"
 	"github.com/wundergraph/wundergraph/pkg/engineconfigloader"
+	"github.com/wundergraph/wundergraph/pkg/eventbus"
+	"github.com/wundergraph/wundergraph/pkg/eventwebhooks"
 	"github.com/wundergraph/wundergraph/pkg/hooks"
 	"github.com/wundergraph/wundergraph/pkg/httpidletimeout"
 	"github.com/wundergraph/wundergraph/pkg/loadvariable"
@@ -37,6 +40,7 @@ import (
 	"github.com/wundergraph/wundergraph/pkg/pool"
 	"github.com/wundergraph/wundergraph/pkg/trace"
 	"github.com/wundergraph/wundergraph/pkg/validate"
+	"github.com/wundergraph/wundergraph/pkg/webhookhandler"
 	"github.com/wundergraph/wundergraph/pkg/wgpb"
 )
 
@@ -79,6 +83,12 @@ type Node struct {
 	options        options
 	WundergraphDir string
 	tracer         *sdktrace.TracerProvider
+	webhookQueue   *webhookhandler.Queue
+	events         *eventwebhooks.Dispatcher
+	wasmHooks      *hooks.WasmRuntime
+	grpcHooks      *hooks.GRPCTransport
+	hooksPool      *hooks.ServerPool
+	asyncHooks     *hooks.AsyncHooks
 }
 
 type options struct {
@@ -105,6 +115,7 @@ type options struct {
 	onServerError           func(err error)
 	traceBatchTimeout       time.Duration
 	natsDefaultServerURL    string
+	hooksInspector          *hooks.Inspector
 }
 
 type Option func(options *options)
@@ -223,6 +234,14 @@ func WithNATSDefaultServerURL(serverURL string) Option {
 	}
 }
 
+// WithHooksInspector records the hooks sent by the node in the given
+// inspector and lists them in the internal /hooks/invocations endpoint
+func WithHooksInspector(inspector *hooks.Inspector) Option {
+	return func(options *options) {
+		options.hooksInspector = inspector
+	}
+}
+
 func (n *Node) StartBlocking(opts ...Option) error {
 	var options options
 	for i := range opts {
@@ -310,6 +329,48 @@ func (n *Node) Shutdown(ctx context.Context) error {
 		}
 	}
 
+	if n.webhookQueue != nil {
+		if err := n.webhookQueue.Close(); err != nil {
+			return err
+		}
+		n.webhookQueue = nil
+	}
+
+	if n.events != nil {
+		if err := n.events.Close(); err != nil {
+			return err
+		}
+		n.events = nil
+	}
+
+	if n.asyncHooks != nil {
+		if err := n.asyncHooks.Close(); err != nil {
+			return err
+		}
+		n.asyncHooks = nil
+	}
+
+	if n.wasmHooks != nil {
+		if err := n.wasmHooks.Close(ctx); err != nil {
+			return err
+		}
+		n.wasmHooks = nil
+	}
+
+	if n.grpcHooks != nil {
+		if err := n.grpcHooks.Close(); err != nil {
+			return err
+		}
+		n.grpcHooks = nil
+	}
+
+	if n.hooksPool != nil {
+		if err := n.hooksPool.Close(); err != nil {
+			return err
+		}
+		n.hooksPool = nil
+	}
+
 	if n.tracer != nil {
 		if err := n.tracer.ForceFlush(ctx); err != nil {
 			n.log.Error("could not force flush tracer", zap.Error(err))
@@ -350,6 +411,48 @@ func (n *Node) Close() error {
 		n.internalServer = nil
 	}
 
+	if n.webhookQueue != nil {
+		if err := n.webhookQueue.Close(); err != nil {
+			return err
+		}
+		n.webhookQueue = nil
+	}
+
+	if n.events != nil {
+		if err := n.events.Close(); err != nil {
+			return err
+		}
+		n.events = nil
+	}
+
+	if n.asyncHooks != nil {
+		if err := n.asyncHooks.Close(); err != nil {
+			return err
+		}
+		n.asyncHooks = nil
+	}
+
+	if n.wasmHooks != nil {
+		if err := n.wasmHooks.Close(context.Background()); err != nil {
+			return err
+		}
+		n.wasmHooks = nil
+	}
+
+	if n.grpcHooks != nil {
+		if err := n.grpcHooks.Close(); err != nil {
+			return err
+		}
+		n.grpcHooks = nil
+	}
+
+	if n.hooksPool != nil {
+		if err := n.hooksPool.Close(); err != nil {
+			return err
+		}
+		n.hooksPool = nil
+	}
+
 	if n.tracer != nil {
 		if err := n.tracer.Shutdown(context.Background()); err != nil {
 			return err
@@ -359,6 +462,107 @@ func (n *Node) Close() error {
 	return nil
 }
 
+// newWebhookQueue returns the queue used by the queued webhooks,
+// or nil if there are none
+func (n *Node) newWebhookQueue(api *apihandler.Api) (*webhookhandler.Queue, error) {
+	queued := false
+	for _, webhook := range api.Webhooks {
+		queued = queued || webhook.Queued
+	}
+	if !queued {
+		return nil, nil
+	}
+	options := api.Options.WebhookQueue
+	var backend webhookhandler.QueueBackend
+	var err error
+	switch options.Kind {
+	case wgpb.WebhookQueueKind_WebhookQueueFile:
+		dir := options.Directory
+		if dir == "" {
+			dir = filepath.Join(n.WundergraphDir, "generated", "webhook-queue")
+		}
+		backend, err = webhookhandler.NewFileQueueBackend(dir)
+	case wgpb.WebhookQueueKind_WebhookQueueNats:
+		serverURL := options.NatsURL
+		if serverURL == "" {
+			serverURL = n.options.natsDefaultServerURL
+		}
+		backend, err = webhookhandler.NewNATSQueueBackend(serverURL, options.NatsToken)
+	default:
+		err = fmt.Errorf("unknown webhook queue %s", options.Kind)
+	}
+	if err != nil {
+		return nil, err
+	}
+	queueOptions := webhookhandler.QueueOptions{
+		Backend:   backend,
+		ServerURL: api.Options.ServerUrl,
+		Logger:    n.log,
+	}
+	if n.hooksPool != nil {
+		queueOptions.ServerURL = n.hooksPool.URL()
+		queueOptions.Transport = n.hooksPool.Transport(http.DefaultTransport)
+	}
+	return webhookhandler.NewQueue(queueOptions), nil
+}
+
+func (n *Node) newWasmHooks(options apihandler.WasmHooksOptions) (*hooks.WasmRuntime, error) {
+	modulePath := options.ModulePath
+	if !filepath.IsAbs(modulePath) {
+		modulePath = filepath.Join(n.WundergraphDir, modulePath)
+	}
+	module, err := os.ReadFile(modulePath)
+	if err != nil {
+		return nil, err
+	}
+	return hooks.NewWasmRuntime(n.ctx, hooks.WasmOptions{
+		Module:     module,
+		Limits:     options.Limits,
+		HookLimits: options.HookLimits,
+	})
+}
+
+func (n *Node) newHooksServerPool(serverURL string, options apihandler.HooksServerPoolOptions) (*hooks.ServerPool, error) {
+	routes := make([]hooks.ServerRoute, 0, len(options.Routes))
+	for _, route := range options.Routes {
+		routes = append(routes, hooks.ServerRoute{
+			Hook:       route.Hook,
+			ServerURLs: route.ServerURLs,
+		})
+	}
+	loadBalancing := hooks.LoadBalancingRoundRobin
+	if options.LoadBalancing == wgpb.HooksLoadBalancing_HooksLoadBalancingLeastOutstanding {
+		loadBalancing = hooks.LoadBalancingLeastOutstanding
+	}
+	return hooks.NewServerPool(hooks.ServerPoolOptions{
+		ServerURLs:          append([]string{serverURL}, options.ServerURLs...),
+		LoadBalancing:       loadBalancing,
+		HealthCheckInterval: options.HealthCheckInterval,
+		MaxFailures:         options.MaxFailures,
+		EjectionDuration:    options.EjectionDuration,
+		Routes:              routes,
+		Logger:              n.log,
+	})
+}
+
+func (n *Node) newAsyncHooks(options apihandler.AsyncHooksOptions) (*hooks.AsyncHooks, error) {
+	var dir string
+	if options.Durable {
+		dir = options.Directory
+		if dir == "" {
+			dir = filepath.Join(n.WundergraphDir, "generated", "hooks-queue")
+		}
+	}
+	return hooks.NewAsyncHooks(hooks.AsyncHooksOptions{
+		Hooks:     options.Hooks,
+		QueueSize: options.QueueSize,
+		Workers:   options.Workers,
+		Directory: dir,
+		Metrics:   n.metrics,
+		Logger:    n.log,
+	})
+}
+
 func (n *Node) newListeners(configuration *apihandler.Listener) ([]net.Listener, error) {
 	cfg := net.ListenConfig{
 		KeepAlive: 90 * time.Second,
@@ -527,10 +731,57 @@ func (n *Node) startServer(nodeConfig *WunderNodeConfig) error {
 		return errors.New("API config invalid")
 	}
 
+	if modulePath := nodeConfig.Api.Options.WasmHooks.ModulePath; modulePath != "" {
+		wasmHooks, err := n.newWasmHooks(nodeConfig.Api.Options.WasmHooks)
+		if err != nil {
+			return fmt.Errorf("loading wasm hooks from %s: %w", modulePath, err)
+		}
+		n.wasmHooks = wasmHooks
+	}
+
+	if address := nodeConfig.Api.Options.GRPCHooks.Address; address != "" {
+		grpcHooks, err := hooks.NewGRPCTransport(hooks.GRPCOptions{
+			Address:       address,
+			TLS:           nodeConfig.Api.Options.GRPCHooks.TLS,
+			EnableTracing: nodeConfig.Api.Options.OpenTelemetry.Enabled,
+		})
+		if err != nil {
+			return err
+		}
+		n.grpcHooks = grpcHooks
+	}
+
+	if poolOptions := nodeConfig.Api.Options.HooksServerPool; len(poolOptions.ServerURLs) != 0 || len(poolOptions.Routes) != 0 {
+		hooksPool, err := n.newHooksServerPool(nodeConfig.Api.Options.ServerUrl, poolOptions)
+		if err != nil {
+			return err
+		}
+		n.hooksPool = hooksPool
+	}
+
+	hookPolicies, err := hooks.NewHookPolicies(nodeConfig.Api.Options.HookPolicies)
+	if err != nil {
+		return err
+	}
+
+	if asyncOptions := nodeConfig.Api.Options.AsyncHooks; len(asyncOptions.Hooks) != 0 {
+		asyncHooks, err := n.newAsyncHooks(asyncOptions)
+		if err != nil {
+			return err
+		}
+		n.asyncHooks = asyncHooks
+	}
+
 	hooksClient := hooks.NewClient(&hooks.ClientOptions{
 		EnableTracing: nodeConfig.Api.Options.OpenTelemetry.Enabled,
 		ServerURL:     nodeConfig.Api.Options.ServerUrl,
 		Logger:        n.log,
+		Wasm:          n.wasmHooks,
+		GRPC:          n.grpcHooks,
+		Pool:          n.hooksPool,
+		Policies:      hookPolicies,
+		Async:         n.asyncHooks,
+		Inspector:     n.options.hooksInspector,
 	})
 
 	dialer := &net.Dialer{
@@ -571,11 +822,14 @@ func (n *Node) startServer(nodeConfig *WunderNodeConfig) error {
 		WunderGraphDir:       n.WundergraphDir,
 		NATSDefaultServerURL: n.options.natsDefaultServerURL,
 	}
+	// eventBus connects the webhooks publishing events to the subscriptions
+	eventBus := eventbus.New()
 	loader := engineconfigloader.New(loaderOptions, engineconfigloader.NewDefaultFactoryResolver(
 		transportFactory,
 		defaultTransport,
 		n.log,
 		hooksClient,
+		eventBus,
 	))
 
 	builderConfig := apihandler.BuilderConfig{
@@ -587,6 +841,29 @@ func (n *Node) startServer(nodeConfig *WunderNodeConfig) error {
 		GitHubAuthDemoClientSecret: n.options.githubAuthDemo.ClientSecret,
 		DevMode:                    n.options.devMode,
 		Metrics:                    n.metrics,
+		EventBus:                   eventBus,
+		HooksServerPool:            n.hooksPool,
+	}
+
+	webhookQueue, err := n.newWebhookQueue(nodeConfig.Api)
+	if err != nil {
+		n.log.Error("creating webhook queue", zap.Error(err))
+		return err
+	}
+	n.webhookQueue = webhookQueue
+	builderConfig.WebhookQueue = webhookQueue
+
+	if len(nodeConfig.Api.EventWebhooks) > 0 {
+		events, err := eventwebhooks.New(eventwebhooks.Options{
+			Webhooks: nodeConfig.Api.EventWebhooks,
+			Logger:   n.log,
+		})
+		if err != nil {
+			n.log.Error("creating event webhooks", zap.Error(err))
+			return err
+		}
+		n.events = events
+		builderConfig.EventDispatcher = events
 	}
 
 	n.builder = apihandler.NewBuilder(n.pool, n.log, loader, hooksClient, builderConfig)
@@ -629,6 +906,16 @@ func (n *Node) startServer(nodeConfig *WunderNodeConfig) error {
 
 	streamClosers = append(streamClosers, internalClosers...)
 
+	if webhookQueue != nil {
+		webhookQueue.MountDeadLetters(internalRouter)
+	}
+	if n.events != nil {
+		n.events.Mount(internalRouter)
+	}
+	if n.options.hooksInspector != nil {
+		n.options.hooksInspector.Mount(internalRouter)
+	}
+
 	defer func() {
 		for _, closer := range streamClosers {
 			close(closer)
@@ -0,0 +1,368 @@
+package s3uploadclient
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+
+	"github.com/wundergraph/wundergraph/pkg/authentication"
+	"github.com/wundergraph/wundergraph/pkg/hooks"
+	"github.com/wundergraph/wundergraph/pkg/pool"
+)
+
+// DownloadURLExpiration indicates for how long the presigned URLs used
+// to redirect downloads remain valid
+const DownloadURLExpiration = 5 * time.Minute
+
+const (
+	defaultListLimit = 50
+	maxListLimit     = 100
+)
+
+// ListedFile is an entry returned by the files listing
+type ListedFile struct {
+	Key          string    `json:"key"`
+	Name         string    `json:"name,omitempty"`
+	Size         int64     `json:"size"`
+	ContentType  string    `json:"contentType,omitempty"`
+	LastModified time.Time `json:"lastModified"`
+}
+
+// ListFilesResponse contains a page of files. If Cursor is not empty, it must be sent
+// as the cursor query parameter to retrieve the next page.
+type ListFilesResponse struct {
+	Files  []ListedFile `json:"files"`
+	Cursor string       `json:"cursor,omitempty"`
+}
+
+// storedFile contains the information about an uploaded object
+// required to authorize accessing it
+type storedFile struct {
+	info        *ObjectInfo
+	profileName string
+	profile     *preparedProfile
+	uploadedBy  string
+}
+
+func (f *storedFile) metadata(key string) string {
+	value, _ := url.QueryUnescape(f.info.Metadata[key])
+	return value
+}
+
+func (f *storedFile) hookFile() *hookFile {
+	return &hookFile{
+		Key:      f.info.Key,
+		Name:     f.metadata("original-filename"),
+		Size:     f.info.Size,
+		MimeType: f.info.ContentType,
+	}
+}
+
+func (f *storedFile) listed() ListedFile {
+	return ListedFile{
+		Key:          f.info.Key,
+		Name:         f.metadata("original-filename"),
+		Size:         f.info.Size,
+		ContentType:  f.info.ContentType,
+		LastModified: f.info.LastModified,
+	}
+}
+
+// FilesEnabled returns whether any profile enables the FilesHandler
+func (s *S3UploadClient) FilesEnabled() bool {
+	for _, profile := range s.profiles {
+		if profile.EnableFiles {
+			return true
+		}
+	}
+	return false
+}
+
+// FilesHandler returns a handler for listing (GET basePath), downloading (GET basePath/key)
+// and deleting (DELETE basePath/key) uploaded files, which must be mounted at basePath.
+// Access is authorized using the profile the file was uploaded with, which must
+// enable the files endpoints. Files without a profile or an uploader, like the
+// ones uploaded before profiles were recorded, can't be accessed.
+func (s *S3UploadClient) FilesHandler(basePath string) http.Handler {
+	basePath = strings.TrimSuffix(basePath, "/")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, basePath), "/")
+		if key == "" {
+			if r.Method != http.MethodGet {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			s.listFiles(w, r)
+			return
+		}
+		if isInternalKey(key) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet, http.MethodHead:
+			s.downloadFile(w, r, key)
+		case http.MethodDelete:
+			s.deleteFile(w, r, key)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+func (s *S3UploadClient) statFile(ctx context.Context, key string) (*storedFile, error) {
+	info, err := s.storage.Stat(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	file := &storedFile{info: info}
+	file.profileName = file.metadata(uploadProfileMetadata)
+	file.profile = s.profiles[file.profileName]
+	file.uploadedBy = file.metadata(uploadedByMetadata)
+	return file, nil
+}
+
+// authorizeFile checks whether the user can access files from the given profile. When
+// roles are required, users without them can still access the files they uploaded, while
+// only the uploader can delete files if the profile has no delete roles. Files from
+// profiles that don't enable the files endpoints and files without an uploader are never
+// accessible. It returns 0 if access is allowed, or the status code to reply with otherwise.
+func authorizeFile(r *http.Request, profile *preparedProfile, uploadedBy string, deleting bool) int {
+	if profile == nil || !profile.EnableFiles || uploadedBy == "" {
+		return http.StatusForbidden
+	}
+	roles := profile.DownloadRoles
+	if deleting {
+		roles = profile.DeleteRoles
+	}
+	user := authentication.UserFromContext(r.Context())
+	if user == nil {
+		if deleting || profile.RequireAuthentication || len(roles) > 0 {
+			return http.StatusUnauthorized
+		}
+		return 0
+	}
+	if uploadedBy != "" && uploadedBy == uploaderID(r) {
+		return 0
+	}
+	for _, role := range roles {
+		for _, userRole := range user.Roles {
+			if role == userRole {
+				return 0
+			}
+		}
+	}
+	if len(roles) == 0 && !deleting {
+		return 0
+	}
+	return http.StatusForbidden
+}
+
+func (s *S3UploadClient) loadFile(w http.ResponseWriter, r *http.Request, key string, deleting bool) *storedFile {
+	file, err := s.statFile(r.Context(), key)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+			return nil
+		}
+		s.filesError(w, "retrieving file", err)
+		return nil
+	}
+	if status := authorizeFile(r, file.profile, file.uploadedBy, deleting); status != 0 {
+		if s.logger != nil {
+			s.logger.Debug("refusing file access", zap.String("provider", s.name), zap.String("key", key), zap.Int("status", status))
+		}
+		if status == http.StatusForbidden {
+			// Don't reveal whether the file exists
+			status = http.StatusNotFound
+		}
+		w.WriteHeader(status)
+		return nil
+	}
+	return file
+}
+
+func (s *S3UploadClient) filesError(w http.ResponseWriter, msg string, err error) {
+	if s.logger != nil {
+		s.logger.Error(msg, zap.String("provider", s.name), zap.Error(err))
+	}
+	http.Error(w, msg, http.StatusInternalServerError)
+}
+
+func (s *S3UploadClient) preDownload(ctx context.Context, r *http.Request, file *storedFile) error {
+	if file.profile == nil || !file.profile.UsePreDownloadHook {
+		return nil
+	}
+	buf := pool.GetBytesBuffer()
+	defer pool.PutBytesBuffer(buf)
+	data, err := hookData(buf.Bytes(), r, file.hookFile(), file.metadata("metadata"), nil)
+	if err != nil {
+		return fmt.Errorf("error preparing preDownload hook data: %w", err)
+	}
+	payloadBuf := pool.GetBytesBuffer()
+	defer pool.PutBytesBuffer(payloadBuf)
+	if _, err := s.hooksClient.DoUploadRequest(ctx, s.name, file.profileName, hooks.PreDownload, data, payloadBuf); err != nil {
+		return fmt.Errorf("error in preDownload hook: %w", err)
+	}
+	return nil
+}
+
+// downloadFile runs the preDownload hook, which can deny access to the file, and
+// then either redirects to a presigned URL or streams the file, if the profile
+// requires it or the storage doesn't support presigned URLs
+func (s *S3UploadClient) downloadFile(w http.ResponseWriter, r *http.Request, key string) {
+	file := s.loadFile(w, r, key, false)
+	if file == nil {
+		return
+	}
+	if err := s.preDownload(r.Context(), r, file); err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	var disposition string
+	if name := file.metadata("original-filename"); name != "" {
+		disposition = mime.FormatMediaType("inline", map[string]string{"filename": name})
+	}
+
+	w.Header().Set("Cache-Control", "private, no-store")
+	if signer, ok := s.storage.(URLSigner); ok && (file.profile == nil || !file.profile.ProxyDownloads) {
+		u, err := signer.SignedDownloadURL(r.Context(), key, DownloadURLExpiration, disposition)
+		if err == nil {
+			http.Redirect(w, r, u, http.StatusFound)
+			return
+		}
+		if !errors.Is(err, ErrNotSupported) {
+			s.filesError(w, "presigning download", err)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", file.info.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(file.info.Size, 10))
+	w.Header().Set("ETag", `"`+file.info.ETag+`"`)
+	w.Header().Set("Last-Modified", file.info.LastModified.UTC().Format(http.TimeFormat))
+	if disposition != "" {
+		w.Header().Set("Content-Disposition", disposition)
+	}
+	if r.Method == http.MethodHead {
+		return
+	}
+	data, _, err := s.storage.Get(r.Context(), key)
+	if err != nil {
+		s.filesError(w, "retrieving file", err)
+		return
+	}
+	defer data.Close()
+	if _, err := io.Copy(w, data); err != nil && s.logger != nil {
+		s.logger.Debug("streaming file", zap.String("provider", s.name), zap.String("key", key), zap.Error(err))
+	}
+}
+
+func (s *S3UploadClient) deleteFile(w http.ResponseWriter, r *http.Request, key string) {
+	file := s.loadFile(w, r, key, true)
+	if file == nil {
+		return
+	}
+	if err := s.storage.Delete(r.Context(), key); err != nil {
+		s.filesError(w, "deleting file", err)
+		return
+	}
+	s.deleteTransformedImages(r.Context(), file)
+	if err := s.storage.Delete(r.Context(), completedKey(key)); err != nil && s.logger != nil {
+		s.logger.Error("deleting completed upload marker", zap.String("provider", s.name), zap.String("key", key), zap.Error(err))
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// deleteTransformedImages deletes the images derived from the file. Since the
+// original is already gone, errors are only logged.
+func (s *S3UploadClient) deleteTransformedImages(ctx context.Context, file *storedFile) {
+	encoded := file.metadata(imageTransformationsMetadata)
+	if encoded == "" {
+		return
+	}
+	var transformations map[string]string
+	if err := json.Unmarshal([]byte(encoded), &transformations); err != nil {
+		if s.logger != nil {
+			s.logger.Error("decoding image transformations", zap.String("provider", s.name), zap.String("key", file.info.Key), zap.Error(err))
+		}
+		return
+	}
+	for _, key := range transformations {
+		if err := s.storage.Delete(ctx, key); err != nil && s.logger != nil {
+			s.logger.Error("deleting transformed image", zap.String("provider", s.name), zap.String("key", key), zap.Error(err))
+		}
+	}
+}
+
+// listFiles returns the files uploaded with the profile from the X-Upload-Profile
+// header that the user is allowed to download. Since the profile is stored in the
+// object metadata, pages might contain less than limit files.
+func (s *S3UploadClient) listFiles(w http.ResponseWriter, r *http.Request) {
+	profileName, profile, err := s.uploadProfile(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if profile == nil || !profile.EnableFiles {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if profile.RequireAuthentication && authentication.UserFromContext(r.Context()) == nil {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	query := r.URL.Query()
+	limit := defaultListLimit
+	if l := query.Get("limit"); l != "" {
+		limit, err = strconv.Atoi(l)
+		if err != nil || limit <= 0 || limit > maxListLimit {
+			http.Error(w, fmt.Sprintf("limit must be between 1 and %d", maxListLimit), http.StatusBadRequest)
+			return
+		}
+	}
+
+	result, err := s.storage.List(r.Context(), query.Get("prefix"), query.Get("cursor"), limit)
+	if err != nil {
+		s.filesError(w, "listing files", err)
+		return
+	}
+	response := ListFilesResponse{
+		Files:  make([]ListedFile, 0, len(result.Objects)),
+		Cursor: result.Cursor,
+	}
+	for _, obj := range result.Objects {
+		if isInternalKey(obj.Key) {
+			continue
+		}
+		file, err := s.statFile(r.Context(), obj.Key)
+		if err != nil {
+			if errors.Is(err, ErrNotFound) {
+				// Deleted while listing
+				continue
+			}
+			s.filesError(w, "retrieving file", err)
+			return
+		}
+		if file.profileName != profileName || authorizeFile(r, file.profile, file.uploadedBy, false) != 0 {
+			continue
+		}
+		response.Files = append(response.Files, file.listed())
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "private, no-store")
+	_ = json.NewEncoder(w).Encode(response)
+}
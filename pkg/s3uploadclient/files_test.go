@@ -0,0 +1,175 @@
+package s3uploadclient_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/wundergraph/wundergraph/pkg/authentication"
+	"github.com/wundergraph/wundergraph/pkg/hooks"
+	"github.com/wundergraph/wundergraph/pkg/s3uploadclient"
+)
+
+const testFilesPath = "/s3/test/files"
+
+func storeTestFile(storage *fakeS3, key string, uploadedBy string) {
+	header := http.Header{
+		"Content-Type":                 {"image/png"},
+		"X-Amz-Meta-Original-Filename": {"original.png"},
+	}
+	if uploadedBy != "" {
+		header.Set("X-Amz-Meta-Upload-Profile", "avatar")
+		header.Set("X-Amz-Meta-Uploaded-By", url.QueryEscape(uploadedBy))
+	}
+	storage.objects[key] = &fakeS3Object{header: header, data: []byte(key)}
+}
+
+func doFilesRequest(handler http.Handler, method string, path string, user *authentication.User) *http.Response {
+	req := httptest.NewRequest(method, path, nil)
+	req.Header.Set("X-Upload-Profile", "avatar")
+	if user != nil {
+		req = req.WithContext(context.WithValue(req.Context(), "user", user))
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec.Result()
+}
+
+func newPreDownloadHooksServer(t *testing.T, deniedKey string) *hooks.Client {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/upload/test/avatar/preDownload", r.URL.Path)
+		var req struct {
+			File struct {
+				Key string `json:"key"`
+			} `json:"file"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		resp := map[string]interface{}{"hook": "preDownload"}
+		if req.File.Key == deniedKey {
+			resp["error"] = map[string]interface{}{"message": "access denied"}
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	t.Cleanup(srv.Close)
+	return hooks.NewClient(&hooks.ClientOptions{ServerURL: srv.URL, Logger: zap.NewNop()})
+}
+
+func TestFilesAuthorization(t *testing.T) {
+	client, storage := newTestS3Client(t, &s3uploadclient.UploadProfile{
+		RequireAuthentication: true,
+		MaxFileSizeBytes:      -1,
+		DownloadRoles:         []string{"admin"},
+		DeleteRoles:           []string{"admin"},
+		UsePreDownloadHook:    true,
+		EnableFiles:           true,
+	}, newPreDownloadHooksServer(t, "denied.png"))
+	handler := client.FilesHandler(testFilesPath)
+
+	jane := &authentication.User{ProviderID: "github", UserID: "1"}
+	john := &authentication.User{ProviderID: "github", UserID: "2"}
+	admin := &authentication.User{ProviderID: "github", UserID: "3", Roles: []string{"admin"}}
+	storeTestFile(storage, "jane.png", "github:1")
+	storeTestFile(storage, "denied.png", "github:1")
+	storeTestFile(storage, "john.png", "github:2")
+	storeTestFile(storage, "legacy.png", "")
+	storeTestFile(storage, ".tus/upload.info", "github:1")
+
+	resp := doFilesRequest(handler, http.MethodGet, testFilesPath+"/jane.png", nil)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	// Users can download their own files, admins can download any file
+	resp = doFilesRequest(handler, http.MethodGet, testFilesPath+"/jane.png", jane)
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+	location, err := url.Parse(resp.Header.Get("Location"))
+	require.NoError(t, err)
+	assert.Equal(t, "/uploads/jane.png", location.Path)
+	assert.NotEmpty(t, location.Query().Get("X-Amz-Signature"))
+	assert.Equal(t, `inline; filename=original.png`, location.Query().Get("response-content-disposition"))
+
+	resp = doFilesRequest(handler, http.MethodGet, testFilesPath+"/john.png", jane)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	resp = doFilesRequest(handler, http.MethodGet, testFilesPath+"/john.png", admin)
+	assert.Equal(t, http.StatusFound, resp.StatusCode)
+	resp = doFilesRequest(handler, http.MethodGet, testFilesPath+"/.tus/upload.info", jane)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	// The preDownload hook can deny access
+	resp = doFilesRequest(handler, http.MethodGet, testFilesPath+"/denied.png", jane)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	listKeys := func(user *authentication.User) []string {
+		resp := doFilesRequest(handler, http.MethodGet, testFilesPath, user)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var list s3uploadclient.ListFilesResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&list))
+		keys := make([]string, len(list.Files))
+		for i, f := range list.Files {
+			keys[i] = f.Key
+		}
+		return keys
+	}
+	assert.Equal(t, []string{"denied.png", "jane.png"}, listKeys(jane))
+	assert.Equal(t, []string{"denied.png", "jane.png", "john.png"}, listKeys(admin))
+
+	resp = doFilesRequest(handler, http.MethodDelete, testFilesPath+"/john.png", jane)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	resp = doFilesRequest(handler, http.MethodDelete, testFilesPath+"/jane.png", jane)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	resp = doFilesRequest(handler, http.MethodDelete, testFilesPath+"/john.png", admin)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	// Files without a profile or an uploader can't be accessed, even with the required roles
+	resp = doFilesRequest(handler, http.MethodDelete, testFilesPath+"/legacy.png", john)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	resp = doFilesRequest(handler, http.MethodDelete, testFilesPath+"/legacy.png", admin)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	resp = doFilesRequest(handler, http.MethodGet, testFilesPath+"/legacy.png", admin)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	assert.NotContains(t, storage.objects, "jane.png")
+	assert.NotContains(t, storage.objects, "john.png")
+	assert.Contains(t, storage.objects, "legacy.png")
+}
+
+func TestFilesProxyDownload(t *testing.T) {
+	client, storage := newTestS3Client(t, &s3uploadclient.UploadProfile{
+		MaxFileSizeBytes: -1,
+		ProxyDownloads:   true,
+		EnableFiles:      true,
+	}, nil)
+	handler := client.FilesHandler(testFilesPath)
+	storeTestFile(storage, "public.png", "github:1")
+
+	resp := doFilesRequest(handler, http.MethodGet, testFilesPath+"/public.png", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "public.png", string(data))
+	assert.Equal(t, "image/png", resp.Header.Get("Content-Type"))
+	assert.Equal(t, `inline; filename=original.png`, resp.Header.Get("Content-Disposition"))
+
+	// Deleting requires authentication even if downloads don't
+	resp = doFilesRequest(handler, http.MethodDelete, testFilesPath+"/public.png", nil)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestFilesDisabled(t *testing.T) {
+	client, storage := newTestS3Client(t, &s3uploadclient.UploadProfile{
+		MaxFileSizeBytes: -1,
+	}, nil)
+	assert.False(t, client.FilesEnabled())
+	handler := client.FilesHandler(testFilesPath)
+	storeTestFile(storage, "public.png", "github:1")
+	user := &authentication.User{ProviderID: "github", UserID: "1"}
+
+	resp := doFilesRequest(handler, http.MethodGet, testFilesPath+"/public.png", user)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	resp = doFilesRequest(handler, http.MethodGet, testFilesPath, user)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
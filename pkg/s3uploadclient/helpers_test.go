@@ -1,12 +1,23 @@
 package s3uploadclient_test
 
 import (
+	"bufio"
 	"bytes"
+	"encoding/xml"
+	"fmt"
 	"io"
 	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
 
+	"github.com/stretchr/testify/require"
+
+	"github.com/wundergraph/wundergraph/pkg/hooks"
 	"github.com/wundergraph/wundergraph/pkg/s3uploadclient"
 	"github.com/wundergraph/wundergraph/pkg/s3uploadclient/testdata"
 )
@@ -61,3 +72,236 @@ func prepareRequest() (*httptest.ResponseRecorder, *http.Request) {
 
 	return wr, req
 }
+
+type fakeS3Object struct {
+	header http.Header
+	data   []byte
+}
+
+type fakeS3Multipart struct {
+	key   string
+	parts map[int][]byte
+}
+
+// fakeS3 implements the subset of the S3 API used by presigned and resumable
+// uploads, without verifying signatures
+type fakeS3 struct {
+	mu         sync.Mutex
+	objects    map[string]*fakeS3Object
+	multiparts map[string]*fakeS3Multipart
+}
+
+func newFakeS3() *fakeS3 {
+	return &fakeS3{
+		objects:    make(map[string]*fakeS3Object),
+		multiparts: make(map[string]*fakeS3Multipart),
+	}
+}
+
+// readAWSChunked decodes a body sent with a streaming signature
+func readAWSChunked(r io.Reader) ([]byte, error) {
+	var data []byte
+	br := bufio.NewReader(r)
+	for {
+		line, err := br.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		sizeHex, _, _ := strings.Cut(strings.TrimSpace(line), ";")
+		size, err := strconv.ParseInt(sizeHex, 16, 64)
+		if err != nil {
+			return nil, err
+		}
+		chunk := make([]byte, size+2)
+		if _, err := io.ReadFull(br, chunk); err != nil {
+			return nil, err
+		}
+		if size == 0 {
+			return data, nil
+		}
+		data = append(data, chunk[:size]...)
+	}
+}
+
+func (s *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	query := r.URL.Query()
+	if _, ok := query["location"]; ok {
+		_, _ = io.WriteString(w, `<?xml version="1.0" encoding="UTF-8"?><LocationConstraint xmlns="http://s3.amazonaws.com/doc/2006-03-01/">eu-central-1</LocationConstraint>`)
+		return
+	}
+	key := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, "/uploads"), "/")
+	if key == "" {
+		if r.Method == http.MethodGet && query.Get("list-type") == "2" {
+			maxKeys, _ := strconv.Atoi(query.Get("max-keys"))
+			s.listObjects(w, query.Get("prefix"), query.Get("continuation-token"), maxKeys)
+		}
+		// Otherwise, bucket exists
+		return
+	}
+	uploadID := query.Get("uploadId")
+	switch {
+	case r.Method == http.MethodPost && query.Has("uploads"):
+		uploadID = strconv.Itoa(len(s.multiparts) + 1)
+		s.multiparts[uploadID] = &fakeS3Multipart{key: key, parts: make(map[int][]byte)}
+		s.objects[key+"#"+uploadID] = &fakeS3Object{header: objectHeader(r)}
+		fmt.Fprintf(w, "<InitiateMultipartUploadResult><Bucket>uploads</Bucket><Key>%s</Key><UploadId>%s</UploadId></InitiateMultipartUploadResult>", key, uploadID)
+	case uploadID != "":
+		mp := s.multiparts[uploadID]
+		if mp == nil {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintf(w, "<Error><Code>NoSuchUpload</Code></Error>")
+			return
+		}
+		switch r.Method {
+		case http.MethodPut:
+			partNumber, _ := strconv.Atoi(query.Get("partNumber"))
+			data, err := readBody(r)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			mp.parts[partNumber] = data
+			w.Header().Set("ETag", fmt.Sprintf(`"etag-%d"`, partNumber))
+		case http.MethodGet:
+			numbers := make([]int, 0, len(mp.parts))
+			for n := range mp.parts {
+				numbers = append(numbers, n)
+			}
+			sort.Ints(numbers)
+			fmt.Fprintf(w, "<ListPartsResult><Bucket>uploads</Bucket><Key>%s</Key><UploadId>%s</UploadId><IsTruncated>false</IsTruncated>", key, uploadID)
+			for _, n := range numbers {
+				fmt.Fprintf(w, `<Part><PartNumber>%d</PartNumber><ETag>"etag-%d"</ETag><Size>%d</Size></Part>`, n, n, len(mp.parts[n]))
+			}
+			fmt.Fprintf(w, "</ListPartsResult>")
+		case http.MethodPost:
+			var complete struct {
+				Parts []struct {
+					PartNumber int
+				} `xml:"Part"`
+			}
+			if err := xml.NewDecoder(r.Body).Decode(&complete); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			obj := s.objects[key+"#"+uploadID]
+			delete(s.objects, key+"#"+uploadID)
+			for _, part := range complete.Parts {
+				obj.data = append(obj.data, mp.parts[part.PartNumber]...)
+			}
+			s.objects[key] = obj
+			delete(s.multiparts, uploadID)
+			fmt.Fprintf(w, `<CompleteMultipartUploadResult><Bucket>uploads</Bucket><Key>%s</Key><ETag>"etag"</ETag></CompleteMultipartUploadResult>`, key)
+		case http.MethodDelete:
+			delete(s.objects, key+"#"+uploadID)
+			delete(s.multiparts, uploadID)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	case r.Method == http.MethodPut:
+		data, err := readBody(r)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		s.objects[key] = &fakeS3Object{header: objectHeader(r), data: data}
+		w.Header().Set("ETag", `"etag"`)
+	case r.Method == http.MethodHead || r.Method == http.MethodGet:
+		obj := s.objects[key]
+		if obj == nil {
+			w.WriteHeader(http.StatusNotFound)
+			if r.Method == http.MethodGet {
+				fmt.Fprintf(w, "<Error><Code>NoSuchKey</Code></Error>")
+			}
+			return
+		}
+		for k, v := range obj.header {
+			w.Header()[k] = v
+		}
+		w.Header().Set("ETag", `"etag"`)
+		w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+		w.Header().Set("Content-Length", strconv.Itoa(len(obj.data)))
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(obj.data)
+		}
+	case r.Method == http.MethodDelete:
+		delete(s.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// listObjects uses the last returned key as continuation token
+func (s *fakeS3) listObjects(w http.ResponseWriter, prefix string, token string, maxKeys int) {
+	keys := make([]string, 0, len(s.objects))
+	for key := range s.objects {
+		// Skip pending multipart uploads
+		if strings.HasPrefix(key, prefix) && key > token && !strings.Contains(key, "#") {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	truncated := maxKeys > 0 && len(keys) > maxKeys
+	if truncated {
+		keys = keys[:maxKeys]
+	}
+	fmt.Fprintf(w, "<ListBucketResult><Name>uploads</Name><Prefix>%s</Prefix><KeyCount>%d</KeyCount><IsTruncated>%t</IsTruncated>", prefix, len(keys), truncated)
+	if truncated {
+		fmt.Fprintf(w, "<NextContinuationToken>%s</NextContinuationToken>", keys[len(keys)-1])
+	}
+	for _, key := range keys {
+		fmt.Fprintf(w, `<Contents><Key>%s</Key><Size>%d</Size><ETag>"etag"</ETag><LastModified>2006-01-02T15:04:05.000Z</LastModified></Contents>`, key, len(s.objects[key].data))
+	}
+	fmt.Fprintf(w, "</ListBucketResult>")
+}
+
+func readBody(r *http.Request) ([]byte, error) {
+	if r.Header.Get("X-Amz-Content-Sha256") == "STREAMING-AWS4-HMAC-SHA256-PAYLOAD" {
+		return readAWSChunked(r.Body)
+	}
+	return io.ReadAll(r.Body)
+}
+
+func objectHeader(r *http.Request) http.Header {
+	header := make(http.Header)
+	for k, v := range r.Header {
+		if k == "Content-Type" || strings.HasPrefix(k, "X-Amz-Meta-") {
+			header[k] = v
+		}
+	}
+	return header
+}
+
+func newTestS3Storage(t *testing.T) (s3uploadclient.Storage, *fakeS3) {
+	fake := newFakeS3()
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	storage, err := s3uploadclient.NewS3Storage(s3uploadclient.S3Options{
+		Endpoint:        strings.TrimPrefix(srv.URL, "http://"),
+		AccessKeyID:     "test",
+		SecretAccessKey: "12345678",
+		BucketName:      "uploads",
+		BucketLocation:  "eu-central-1",
+	})
+	require.NoError(t, err)
+	return storage, fake
+}
+
+func newTestClient(t *testing.T, storage s3uploadclient.Storage, profile *s3uploadclient.UploadProfile, hooksClient *hooks.Client) *s3uploadclient.S3UploadClient {
+	client, err := s3uploadclient.NewS3UploadClient("", s3uploadclient.Options{
+		Storage: storage,
+		Profiles: map[string]*s3uploadclient.UploadProfile{
+			"avatar": profile,
+		},
+		HooksClient: hooksClient,
+		Name:        "test",
+	})
+	require.NoError(t, err)
+	return client
+}
+
+func newTestS3Client(t *testing.T, profile *s3uploadclient.UploadProfile, hooksClient *hooks.Client) (*s3uploadclient.S3UploadClient, *fakeS3) {
+	storage, fake := newTestS3Storage(t)
+	return newTestClient(t, storage, profile, hooksClient), fake
+}
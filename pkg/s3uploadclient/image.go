@@ -0,0 +1,369 @@
+package s3uploadclient
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"math"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+const (
+	// maxImagePixels limits the size of the images decoded for transforming
+	// them, since a small file can contain a huge image
+	maxImagePixels     = 40_000_000
+	defaultJPEGQuality = 85
+	// imageTransformationsMetadata stores the keys of the transformed images,
+	// so they're deleted along with the original
+	imageTransformationsMetadata = "image-transformations"
+	// sourceKeyMetadata stores the key of the original image in the
+	// transformed ones
+	sourceKeyMetadata = "source-key"
+)
+
+var transformationNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
+
+type ImageFit int32
+
+const (
+	// ImageFitContain scales the image to fit inside the dimensions, keeping
+	// its aspect ratio. Smaller images aren't enlarged.
+	ImageFitContain ImageFit = iota
+	// ImageFitCover scales the image to cover the dimensions, keeping its
+	// aspect ratio, and crops it around the center
+	ImageFitCover
+	// ImageFitFill stretches the image to the dimensions
+	ImageFitFill
+)
+
+type ImageFormat int32
+
+const (
+	// ImageFormatOriginal keeps the format of the uploaded image, except for
+	// GIF images, which are converted to PNG, and WebP images, which are
+	// converted to lossless WebP
+	ImageFormatOriginal ImageFormat = iota
+	ImageFormatJPEG
+	ImageFormatPNG
+	// ImageFormatWebP uses lossless WebP
+	ImageFormatWebP
+)
+
+// ImageTransformation derives a new image from the uploaded ones, which is
+// stored next to the original. Transformed images never include the metadata
+// (e.g. EXIF) of the original, but its orientation is applied to them.
+type ImageTransformation struct {
+	// Name identifies the transformation in the UploadResponse and is
+	// appended to the key of the original file
+	Name string
+	// Dimensions in pixels. If only one of them is set, the other one is
+	// calculated using the aspect ratio of the image. If neither is set, the
+	// image is only converted.
+	Width  int
+	Height int
+	Fit    ImageFit
+	Format ImageFormat
+	// JPEG quality between 1 and 100, defaults to 85
+	Quality int
+}
+
+func (t *ImageTransformation) validate() error {
+	if !transformationNameRegexp.MatchString(t.Name) {
+		return fmt.Errorf("invalid image transformation name %q", t.Name)
+	}
+	if t.Width < 0 || t.Height < 0 {
+		return fmt.Errorf("image transformation %s has negative dimensions", t.Name)
+	}
+	if t.Fit != ImageFitContain && (t.Width == 0 || t.Height == 0) {
+		return fmt.Errorf("image transformation %s requires both dimensions to cover or fill them", t.Name)
+	}
+	if t.Quality < 0 || t.Quality > 100 {
+		return fmt.Errorf("image transformation %s has an invalid quality %d", t.Name, t.Quality)
+	}
+	return nil
+}
+
+// transformedImage is an encoded ImageTransformation result
+type transformedImage struct {
+	name        string
+	data        []byte
+	contentType string
+	extension   string
+}
+
+// transformedImageKey returns the key used for storing the transformed
+// image next to the original
+func transformedImageKey(key string, image *transformedImage) string {
+	return strings.TrimSuffix(key, filepath.Ext(key)) + "-" + image.name + image.extension
+}
+
+// isTransformableImage returns true if images with the given content type
+// can be decoded for transforming them
+func isTransformableImage(contentType string) bool {
+	switch contentType {
+	case "image/jpeg", "image/png", "image/gif", "image/webp":
+		return true
+	}
+	return false
+}
+
+// requireRegularUploads returns an error if the profile transforms images, since
+// the files from presigned and resumable uploads don't go through the node
+func requireRegularUploads(profileName string, profile *preparedProfile) error {
+	if profile != nil && len(profile.ImageTransformations) > 0 {
+		return fmt.Errorf("profile %s transforms images, which requires regular uploads", profileName)
+	}
+	return nil
+}
+
+// transformImage decodes the image in rs, applies its EXIF orientation and
+// returns the encoded results of the transformations
+func transformImage(rs io.ReadSeeker, contentType string, transformations []ImageTransformation) ([]*transformedImage, error) {
+	if _, err := rs.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	config, _, err := image.DecodeConfig(rs)
+	if err != nil {
+		return nil, err
+	}
+	if config.Width*config.Height > maxImagePixels {
+		return nil, fmt.Errorf("image with %dx%d pixels exceeds the %d pixels maximum", config.Width, config.Height, maxImagePixels)
+	}
+	orientation := 1
+	if contentType == "image/jpeg" {
+		if _, err := rs.Seek(0, io.SeekStart); err != nil {
+			return nil, err
+		}
+		orientation = jpegOrientation(rs)
+	}
+	if _, err := rs.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	decoded, _, err := image.Decode(rs)
+	if err != nil {
+		return nil, err
+	}
+	src := orient(toRGBA(decoded), orientation)
+
+	images := make([]*transformedImage, 0, len(transformations))
+	for _, t := range transformations {
+		format := t.Format
+		if format == ImageFormatOriginal {
+			switch contentType {
+			case "image/jpeg":
+				format = ImageFormatJPEG
+			case "image/webp":
+				format = ImageFormatWebP
+			default:
+				format = ImageFormatPNG
+			}
+		}
+		result := &transformedImage{name: t.Name}
+		var buf bytes.Buffer
+		dst := transform(src, t)
+		switch format {
+		case ImageFormatJPEG:
+			quality := t.Quality
+			if quality == 0 {
+				quality = defaultJPEGQuality
+			}
+			err = jpeg.Encode(&buf, flatten(dst), &jpeg.Options{Quality: quality})
+			result.contentType, result.extension = "image/jpeg", ".jpg"
+		case ImageFormatPNG:
+			err = png.Encode(&buf, dst)
+			result.contentType, result.extension = "image/png", ".png"
+		case ImageFormatWebP:
+			err = encodeWebP(&buf, dst)
+			result.contentType, result.extension = "image/webp", ".webp"
+		default:
+			err = fmt.Errorf("unknown image format %d", format)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("image transformation %s: %w", t.Name, err)
+		}
+		result.data = buf.Bytes()
+		images = append(images, result)
+	}
+	return images, nil
+}
+
+func toRGBA(src image.Image) *image.RGBA {
+	if rgba, ok := src.(*image.RGBA); ok && rgba.Rect.Min == (image.Point{}) {
+		return rgba
+	}
+	bounds := src.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(dst, dst.Rect, src, bounds.Min, draw.Src)
+	return dst
+}
+
+// flatten draws the image over a white background, since JPEG doesn't
+// support transparency
+func flatten(src *image.RGBA) *image.RGBA {
+	dst := image.NewRGBA(src.Rect)
+	draw.Draw(dst, dst.Rect, image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(dst, dst.Rect, src, src.Rect.Min, draw.Over)
+	return dst
+}
+
+// transform resizes and crops the image according to t
+func transform(src *image.RGBA, t ImageTransformation) *image.RGBA {
+	sw, sh := src.Rect.Dx(), src.Rect.Dy()
+	width, height := t.Width, t.Height
+	switch {
+	case width == 0 && height == 0:
+		return src
+	case width == 0:
+		width = int(math.Max(1, math.Round(float64(sw)*float64(height)/float64(sh))))
+	case height == 0:
+		height = int(math.Max(1, math.Round(float64(sh)*float64(width)/float64(sw))))
+	}
+	switch t.Fit {
+	case ImageFitContain:
+		scale := math.Min(float64(width)/float64(sw), float64(height)/float64(sh))
+		if scale >= 1 {
+			return src
+		}
+		width = int(math.Max(1, math.Round(float64(sw)*scale)))
+		height = int(math.Max(1, math.Round(float64(sh)*scale)))
+	case ImageFitCover:
+		// Crop the largest centered area with the target aspect ratio
+		scale := math.Max(float64(width)/float64(sw), float64(height)/float64(sh))
+		cw := int(math.Min(float64(sw), math.Round(float64(width)/scale)))
+		ch := int(math.Min(float64(sh), math.Round(float64(height)/scale)))
+		x, y := (sw-cw)/2, (sh-ch)/2
+		src = toRGBA(src.SubImage(image.Rect(x, y, x+cw, y+ch)))
+	}
+	// Since *image.RGBA uses premultiplied alpha, transparent pixels don't bleed
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Rect, src, src.Rect, draw.Src, nil)
+	return dst
+}
+
+// orient rotates and flips the image according to its EXIF orientation,
+// from 1 (unchanged) to 8
+func orient(src *image.RGBA, orientation int) *image.RGBA {
+	if orientation < 2 || orientation > 8 {
+		return src
+	}
+	w, h := src.Rect.Dx(), src.Rect.Dy()
+	dw, dh := w, h
+	if orientation >= 5 {
+		// Rotated by 90 degrees
+		dw, dh = h, w
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		for x := 0; x < dw; x++ {
+			var sx, sy int
+			switch orientation {
+			case 2: // Mirrored horizontally
+				sx, sy = w-1-x, y
+			case 3: // Rotated 180 degrees
+				sx, sy = w-1-x, h-1-y
+			case 4: // Mirrored vertically
+				sx, sy = x, h-1-y
+			case 5: // Transposed
+				sx, sy = y, x
+			case 6: // Rotated 90 degrees clockwise
+				sx, sy = y, h-1-x
+			case 7: // Transversed
+				sx, sy = w-1-y, h-1-x
+			case 8: // Rotated 90 degrees counterclockwise
+				sx, sy = w-1-y, x
+			}
+			copy(dst.Pix[y*dst.Stride+x*4:y*dst.Stride+x*4+4], src.Pix[sy*src.Stride+sx*4:])
+		}
+	}
+	return dst
+}
+
+// jpegOrientation returns the EXIF orientation of the JPEG image in r, or 1
+// if it doesn't have one
+func jpegOrientation(r io.Reader) int {
+	exif, err := jpegExif(r)
+	if err != nil {
+		return 1
+	}
+	orientation, err := exifOrientation(exif)
+	if err != nil {
+		return 1
+	}
+	return orientation
+}
+
+// jpegExif returns the contents of the EXIF segment, after its header
+func jpegExif(r io.Reader) ([]byte, error) {
+	var soi [2]byte
+	if _, err := io.ReadFull(r, soi[:]); err != nil {
+		return nil, err
+	}
+	if soi != [2]byte{0xFF, 0xD8} {
+		return nil, errors.New("not a JPEG image")
+	}
+	for {
+		var marker [4]byte
+		if _, err := io.ReadFull(r, marker[:]); err != nil {
+			return nil, err
+		}
+		if marker[0] != 0xFF || marker[1] == 0xDA {
+			// Image data starts, metadata must come before it
+			return nil, errors.New("no EXIF segment")
+		}
+		size := int(binary.BigEndian.Uint16(marker[2:])) - 2
+		if size < 0 {
+			return nil, errors.New("invalid JPEG segment")
+		}
+		segment := make([]byte, size)
+		if _, err := io.ReadFull(r, segment); err != nil {
+			return nil, err
+		}
+		if marker[1] == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return segment[6:], nil
+		}
+	}
+}
+
+// exifOrientation reads the orientation tag from the first IFD
+func exifOrientation(exif []byte) (int, error) {
+	if len(exif) < 8 {
+		return 0, errors.New("truncated EXIF")
+	}
+	var order binary.ByteOrder
+	switch string(exif[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 0, errors.New("invalid EXIF byte order")
+	}
+	offset := int(order.Uint32(exif[4:]))
+	if offset+2 > len(exif) {
+		return 0, errors.New("truncated EXIF")
+	}
+	entries := int(order.Uint16(exif[offset:]))
+	for ii := 0; ii < entries; ii++ {
+		entry := offset + 2 + ii*12
+		if entry+12 > len(exif) {
+			break
+		}
+		const orientationTag, shortType = 0x0112, 3
+		if order.Uint16(exif[entry:]) == orientationTag && order.Uint16(exif[entry+2:]) == shortType {
+			return int(order.Uint16(exif[entry+8:])), nil
+		}
+	}
+	return 0, errors.New("no orientation")
+}
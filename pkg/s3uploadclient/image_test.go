@@ -0,0 +1,281 @@
+package s3uploadclient_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/image/webp"
+
+	"github.com/wundergraph/wundergraph/pkg/authentication"
+	"github.com/wundergraph/wundergraph/pkg/s3uploadclient"
+)
+
+// halvesImage returns an image with its left half red and its right half
+// blue, with a transparent row at the bottom if transparent is true
+func halvesImage(width int, height int, transparent bool) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			c := color.NRGBA{R: 0xff, A: 0xff}
+			if x >= width/2 {
+				c = color.NRGBA{B: 0xff, A: 0xff}
+			}
+			if transparent && y == height-1 {
+				c = color.NRGBA{}
+			}
+			img.SetNRGBA(x, y, c)
+		}
+	}
+	return img
+}
+
+// withOrientation adds an EXIF segment with the orientation to the JPEG image
+func withOrientation(t *testing.T, data []byte, orientation uint16) []byte {
+	exif := []byte("Exif\x00\x00MM\x00\x2a\x00\x00\x00\x08\x00\x01")
+	exif = binary.BigEndian.AppendUint16(exif, 0x0112)
+	exif = binary.BigEndian.AppendUint16(exif, 3)
+	exif = binary.BigEndian.AppendUint32(exif, 1)
+	exif = binary.BigEndian.AppendUint16(exif, orientation)
+	exif = append(exif, 0, 0, 0, 0, 0, 0)
+	segment := []byte{0xFF, 0xE1}
+	segment = binary.BigEndian.AppendUint16(segment, uint16(len(exif)+2))
+	segment = append(segment, exif...)
+	require.Equal(t, []byte{0xFF, 0xD8}, data[:2])
+	return append(append(append([]byte(nil), data[:2]...), segment...), data[2:]...)
+}
+
+func assertColor(t *testing.T, expected color.NRGBA, actual color.Color) {
+	c := color.NRGBAModel.Convert(actual).(color.NRGBA)
+	for ii, pair := range [][2]uint8{{expected.R, c.R}, {expected.G, c.G}, {expected.B, c.B}, {expected.A, c.A}} {
+		assert.InDelta(t, int(pair[0]), int(pair[1]), 24, "channel %d of %v", ii, c)
+	}
+}
+
+func TestEncodeWebP(t *testing.T) {
+	storage, err := s3uploadclient.NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+	client := newTestClient(t, storage, &s3uploadclient.UploadProfile{
+		MaxFileSizeBytes: -1,
+		ImageTransformations: []s3uploadclient.ImageTransformation{
+			{Name: "webp", Format: s3uploadclient.ImageFormatWebP},
+		},
+	}, nil)
+	user := &authentication.User{ProviderID: "github", UserID: "1"}
+
+	// Noisy images need normal prefix codes, uniform ones use simple codes
+	noisy := halvesImage(300, 200, true)
+	for ii := range noisy.Pix {
+		if ii%4 != 3 && noisy.Pix[ii|3] != 0 {
+			noisy.Pix[ii] = uint8(ii * 7919 % 251)
+		}
+	}
+	for _, src := range []*image.NRGBA{noisy, halvesImage(2, 1, false), halvesImage(1, 1, false)} {
+		var buf bytes.Buffer
+		require.NoError(t, png.Encode(&buf, src))
+		resp := doUploadRequest(client, user, "image.png", "image/png", buf.Bytes())
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var files []s3uploadclient.UploadedFile
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&files))
+		require.Len(t, files, 1)
+
+		body, info, err := storage.Get(context.Background(), files[0].Transformations["webp"])
+		require.NoError(t, err)
+		data, err := io.ReadAll(body)
+		require.NoError(t, err)
+		_ = body.Close()
+		assert.Equal(t, "image/webp", info.ContentType)
+		img, err := webp.Decode(bytes.NewReader(data))
+		require.NoError(t, err)
+		// Lossless images are decoded as NRGBA, with the exact pixels
+		decoded, ok := img.(*image.NRGBA)
+		require.True(t, ok)
+		assert.Equal(t, src.Bounds(), decoded.Bounds())
+		assert.Equal(t, src.Pix, decoded.Pix)
+	}
+}
+
+func TestWebPImageTransformations(t *testing.T) {
+	storage, err := s3uploadclient.NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+	client := newTestClient(t, storage, &s3uploadclient.UploadProfile{
+		MaxFileSizeBytes: -1,
+		ImageTransformations: []s3uploadclient.ImageTransformation{
+			{Name: "webp", Format: s3uploadclient.ImageFormatWebP},
+			{Name: "small", Width: 10},
+		},
+	}, nil)
+	user := &authentication.User{ProviderID: "github", UserID: "1"}
+
+	upload := func(name string, contentType string, data []byte) map[string]string {
+		resp := doUploadRequest(client, user, name, contentType, data)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var files []s3uploadclient.UploadedFile
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&files))
+		require.Len(t, files, 1)
+		return files[0].Transformations
+	}
+	load := func(key string) []byte {
+		body, _, err := storage.Get(context.Background(), key)
+		require.NoError(t, err)
+		defer body.Close()
+		data, err := io.ReadAll(body)
+		require.NoError(t, err)
+		return data
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, halvesImage(40, 20, false)))
+	webpImage := load(upload("image.png", "image/png", buf.Bytes())["webp"])
+
+	// WebP uploads are transformed too, keeping their format
+	transformations := upload("image.webp", "image/webp", webpImage)
+	require.Contains(t, transformations, "small")
+	assert.Equal(t, ".webp", transformations["small"][len(transformations["small"])-len(".webp"):])
+	img, err := webp.Decode(bytes.NewReader(load(transformations["small"])))
+	require.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 10, 5), img.Bounds())
+	assertColor(t, color.NRGBA{R: 0xff, A: 0xff}, img.At(1, 2))
+	assertColor(t, color.NRGBA{B: 0xff, A: 0xff}, img.At(8, 2))
+}
+
+func TestImageTransformations(t *testing.T) {
+	storage, err := s3uploadclient.NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+	client := newTestClient(t, storage, &s3uploadclient.UploadProfile{
+		MaxFileSizeBytes: -1,
+		EnableFiles:      true,
+		ImageTransformations: []s3uploadclient.ImageTransformation{
+			{Name: "oriented", Format: s3uploadclient.ImageFormatPNG},
+			{Name: "thumb", Width: 10, Height: 10, Fit: s3uploadclient.ImageFitCover, Format: s3uploadclient.ImageFormatWebP},
+			{Name: "small", Width: 10},
+			{Name: "large", Width: 1000},
+			{Name: "stretched", Width: 30, Height: 5, Fit: s3uploadclient.ImageFitFill, Format: s3uploadclient.ImageFormatJPEG, Quality: 95},
+		},
+	}, nil)
+	user := &authentication.User{ProviderID: "github", UserID: "1"}
+
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, halvesImage(40, 20, false), &jpeg.Options{Quality: 100}))
+	// Rotated 90 degrees clockwise, so the red half goes to the top
+	resp := doUploadRequest(client, user, "photo.jpg", "image/jpeg", withOrientation(t, buf.Bytes(), 6))
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var files []s3uploadclient.UploadedFile
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&files))
+	require.Len(t, files, 1)
+	key := files[0].Key
+	base := key[:len(key)-len(".jpg")]
+	assert.Equal(t, map[string]string{
+		"oriented":  base + "-oriented.png",
+		"thumb":     base + "-thumb.webp",
+		"small":     base + "-small.jpg",
+		"large":     base + "-large.jpg",
+		"stretched": base + "-stretched.jpg",
+	}, files[0].Transformations)
+
+	load := func(name string) (image.Image, *s3uploadclient.ObjectInfo) {
+		body, info, err := storage.Get(context.Background(), files[0].Transformations[name])
+		require.NoError(t, err)
+		defer body.Close()
+		data, err := io.ReadAll(body)
+		require.NoError(t, err)
+		if info.ContentType == "image/webp" {
+			img, err := webp.Decode(bytes.NewReader(data))
+			require.NoError(t, err)
+			return img, info
+		}
+		img, _, err := image.Decode(bytes.NewReader(data))
+		require.NoError(t, err)
+		// The EXIF segment is stripped
+		assert.NotContains(t, string(data), "Exif")
+		return img, info
+	}
+
+	img, info := load("oriented")
+	assert.Equal(t, "image/png", info.ContentType)
+	assert.Equal(t, key, info.Metadata["source-key"])
+	assert.Equal(t, image.Rect(0, 0, 20, 40), img.Bounds())
+	assertColor(t, color.NRGBA{R: 0xff, A: 0xff}, img.At(10, 5))
+	assertColor(t, color.NRGBA{B: 0xff, A: 0xff}, img.At(10, 35))
+
+	img, _ = load("thumb")
+	assert.Equal(t, image.Rect(0, 0, 10, 10), img.Bounds())
+	assertColor(t, color.NRGBA{R: 0xff, A: 0xff}, img.At(5, 1))
+	assertColor(t, color.NRGBA{B: 0xff, A: 0xff}, img.At(5, 8))
+
+	img, info = load("small")
+	assert.Equal(t, "image/jpeg", info.ContentType)
+	assert.Equal(t, image.Rect(0, 0, 10, 20), img.Bounds())
+
+	// Contain doesn't enlarge images
+	img, _ = load("large")
+	assert.Equal(t, image.Rect(0, 0, 20, 40), img.Bounds())
+
+	img, _ = load("stretched")
+	assert.Equal(t, image.Rect(0, 0, 30, 5), img.Bounds())
+	assertColor(t, color.NRGBA{R: 0xff, A: 0xff}, img.At(15, 0))
+
+	// Deleting the original deletes the transformed images
+	resp = doFilesRequest(client.FilesHandler(testFilesPath), http.MethodDelete, testFilesPath+"/"+key, user)
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+	for _, transformed := range files[0].Transformations {
+		_, err := storage.Stat(context.Background(), transformed)
+		assert.ErrorIs(t, err, s3uploadclient.ErrNotFound)
+	}
+
+	// Invalid images are rejected, other files are stored as is
+	resp = doUploadRequest(client, user, "broken.png", "image/png", testPNG)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	resp = doUploadRequest(client, user, "notes.txt", "text/plain", []byte("notes"))
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var stored []s3uploadclient.UploadedFile
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&stored))
+	require.Len(t, stored, 1)
+	assert.Empty(t, stored[0].Transformations)
+}
+
+func TestInvalidImageTransformations(t *testing.T) {
+	storage, err := s3uploadclient.NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+	for _, transformations := range [][]s3uploadclient.ImageTransformation{
+		{{Name: ""}},
+		{{Name: "a/b"}},
+		{{Name: "thumb"}, {Name: "thumb"}},
+		{{Name: "thumb", Width: -1}},
+		{{Name: "thumb", Width: 10, Fit: s3uploadclient.ImageFitCover}},
+		{{Name: "thumb", Quality: 101}},
+	} {
+		_, err := s3uploadclient.NewS3UploadClient("", s3uploadclient.Options{
+			Storage: storage,
+			Profiles: map[string]*s3uploadclient.UploadProfile{
+				"avatar": {ImageTransformations: transformations},
+			},
+		})
+		assert.Error(t, err)
+	}
+}
+
+func TestImageTransformationsRequireRegularUploads(t *testing.T) {
+	client, _ := newTestS3Client(t, &s3uploadclient.UploadProfile{
+		MaxFileSizeBytes: 1024,
+		ImageTransformations: []s3uploadclient.ImageTransformation{
+			{Name: "thumb", Width: 10},
+		},
+	}, nil)
+
+	resp := doPresignRequest(t, client.PresignUpload, nil, s3uploadclient.PresignRequest{Name: "a.png", Size: 8, Type: "image/png"})
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp = doTusRequest(client.TusHandler(testTusPath), http.MethodPost, testTusPath, nil, map[string]string{"Upload-Length": "8"}, nil)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
@@ -0,0 +1,314 @@
+package s3uploadclient
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/wundergraph/wundergraph/pkg/authentication"
+)
+
+// PresignedUploadExpiration indicates for how long presigned uploads remain valid
+const PresignedUploadExpiration = 15 * time.Minute
+
+// MaxPresignedUploadSize is the maximum size for presigned uploads when
+// the profile doesn't define one, which is the limit for a single PUT in S3
+const MaxPresignedUploadSize = 5 * 1024 * 1024 * 1024 // 5GB
+
+const (
+	PresignedUploadMethodPut  = "PUT"
+	PresignedUploadMethodPost = "POST"
+)
+
+// PresignRequest is sent by clients to request a presigned upload. Metadata
+// and profile are sent in the same headers used for regular uploads.
+type PresignRequest struct {
+	Name string `json:"name"`
+	Size int64  `json:"size"`
+	Type string `json:"type"`
+	// Method is either PUT (default) or POST
+	Method string `json:"method"`
+}
+
+// PresignedUpload contains the details for uploading a file directly to the bucket.
+// For PUT uploads, the request must include all the Headers. For POST uploads,
+// the multipart form must include all the Fields followed by the file.
+type PresignedUpload struct {
+	Key       string            `json:"key"`
+	Method    string            `json:"method"`
+	URL       string            `json:"url"`
+	Headers   map[string]string `json:"headers,omitempty"`
+	Fields    map[string]string `json:"fields,omitempty"`
+	ExpiresAt time.Time         `json:"expiresAt"`
+}
+
+// CompleteUploadRequest is sent by clients once a presigned upload has finished
+type CompleteUploadRequest struct {
+	Key string `json:"key"`
+}
+
+// PresignUpload validates the file described in the request against the profile,
+// runs the preUpload hook and returns a presigned request for uploading the
+// file directly to the bucket, without going through the node.
+func (s *S3UploadClient) PresignUpload(w http.ResponseWriter, r *http.Request) {
+	if !s.hasRequiredAuthentication(w, r) {
+		return
+	}
+
+	var req PresignRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 64*1024)).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("invalid presign request: %s", err), http.StatusBadRequest)
+		return
+	}
+	if req.Name == "" {
+		http.Error(w, "file name is required", http.StatusBadRequest)
+		return
+	}
+	if req.Size <= 0 {
+		http.Error(w, "file size is required", http.StatusBadRequest)
+		return
+	}
+	if req.Type == "" {
+		req.Type = "application/octet-stream"
+	}
+	if req.Method == "" {
+		req.Method = PresignedUploadMethodPut
+	}
+	if !isSafePresignValue(req.Type) {
+		http.Error(w, "invalid file type", http.StatusBadRequest)
+		return
+	}
+
+	profileName, profile, err := s.uploadProfile(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := requireRegularUploads(profileName, profile); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	maxSize := int64(MaxPresignedUploadSize)
+	file := &hookFile{
+		Name:     req.Name,
+		Size:     req.Size,
+		MimeType: req.Type,
+	}
+	var fileKey string
+	if profile != nil {
+		fileKey, err = s.checkUpload(r.Context(), r, profileName, profile, file)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if profile.MaxFileSizeBytes >= 0 && int64(profile.MaxFileSizeBytes) < maxSize {
+			maxSize = int64(profile.MaxFileSizeBytes)
+		}
+	}
+	if req.Size > maxSize {
+		http.Error(w, fmt.Sprintf("file with %d bytes exceeds the %d maximum", req.Size, maxSize), http.StatusBadRequest)
+		return
+	}
+	if fileKey == "" {
+		// We don't have the contents to hash them, use a random key instead
+		fileKey, err = randomFileKey(req.Name)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	if !isSafePresignValue(fileKey) {
+		http.Error(w, fmt.Sprintf("invalid file key %q", fileKey), http.StatusBadRequest)
+		return
+	}
+	// The preUpload hook might return the key of a completed upload, which
+	// must be completed again once it's replaced
+	if err := s.storage.Delete(r.Context(), completedKey(fileKey)); err != nil {
+		if s.logger != nil {
+			s.logger.Error("removing completed upload marker", zap.String("provider", s.name), zap.String("key", fileKey), zap.Error(err))
+		}
+		http.Error(w, "could not presign upload", http.StatusInternalServerError)
+		return
+	}
+
+	metadata := objectMetadata(map[string]string{
+		"metadata":            fileMetadataFromRequest(r),
+		"original-filename":   req.Name,
+		"original-extension":  filepath.Ext(req.Name),
+		"original-size":       strconv.FormatInt(req.Size, 10),
+		uploadProfileMetadata: profileName,
+		uploadedByMetadata:    uploaderID(r),
+	})
+
+	opts := PutOptions{ContentType: req.Type, Metadata: metadata}
+	expiresAt := time.Now().Add(PresignedUploadExpiration)
+	upload := &PresignedUpload{
+		Key:       fileKey,
+		Method:    req.Method,
+		ExpiresAt: expiresAt,
+	}
+	switch req.Method {
+	case PresignedUploadMethodPut:
+		signer, ok := s.storage.(URLSigner)
+		if !ok {
+			err = ErrNotSupported
+			break
+		}
+		upload.URL, upload.Headers, err = signer.SignedUploadURL(r.Context(), fileKey, PresignedUploadExpiration, req.Size, opts)
+	case PresignedUploadMethodPost:
+		signer, ok := s.storage.(PostPolicySigner)
+		if !ok {
+			err = ErrNotSupported
+			break
+		}
+		upload.URL, upload.Fields, err = signer.SignedPostPolicy(r.Context(), fileKey, expiresAt, maxSize, opts)
+	default:
+		http.Error(w, fmt.Sprintf("invalid presigned upload method %q", req.Method), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		if errors.Is(err, ErrNotSupported) {
+			http.Error(w, fmt.Sprintf("presigned %s uploads are not supported by provider %s", req.Method, s.name), http.StatusNotImplemented)
+			return
+		}
+		if s.logger != nil {
+			s.logger.Error("presigning upload", zap.String("provider", s.name), zap.Error(err))
+		}
+		http.Error(w, "could not presign upload", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	_ = json.NewEncoder(w).Encode(upload)
+}
+
+// CompleteUpload must be called by clients after a presigned upload finishes. It
+// checks the uploaded object and runs the postUpload hook. Objects that were not
+// presigned for the same profile and user are rejected, while objects that don't
+// satisfy the profile, including those with contents that don't match their type,
+// are removed from the bucket. Each upload can only be completed once, the following
+// attempts are rejected with 409.
+func (s *S3UploadClient) CompleteUpload(w http.ResponseWriter, r *http.Request) {
+	if !s.hasRequiredAuthentication(w, r) {
+		return
+	}
+
+	var req CompleteUploadRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 64*1024)).Decode(&req); err != nil || req.Key == "" {
+		http.Error(w, "invalid upload completion request", http.StatusBadRequest)
+		return
+	}
+
+	profileName, profile, err := s.uploadProfile(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	stored, err := s.statFile(r.Context(), req.Key)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			http.Error(w, fmt.Sprintf("file %q has not been uploaded", req.Key), http.StatusNotFound)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if stored.profileName != profileName || stored.uploadedBy != uploaderID(r) {
+		if s.logger != nil {
+			s.logger.Debug("refusing to complete upload from another profile or user", zap.String("provider", s.name), zap.String("key", req.Key))
+		}
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	marker := completedKey(req.Key)
+	if _, err := s.storage.Stat(r.Context(), marker); err == nil {
+		http.Error(w, fmt.Sprintf("upload %q has already been completed", req.Key), http.StatusConflict)
+		return
+	} else if !errors.Is(err, ErrNotFound) {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	file := stored.hookFile()
+	var uploadError error
+	if profile != nil {
+		uploadError, err = s.checkStoredFile(r.Context(), profile, req.Key, file)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	if uploadError != nil {
+		if err := s.storage.Delete(r.Context(), req.Key); err != nil && s.logger != nil {
+			s.logger.Error("removing invalid upload", zap.String("provider", s.name), zap.String("key", req.Key), zap.Error(err))
+		}
+	} else if err := s.storage.Put(r.Context(), marker, bytes.NewReader(nil), 0, PutOptions{}); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := s.runPostUploadHook(r.Context(), r, profileName, file, stored.metadata("metadata"), uploadError); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if uploadError != nil {
+		http.Error(w, fmt.Sprintf("error validating file: %s", uploadError), http.StatusBadRequest)
+		return
+	}
+
+	result, err := json.Marshal(UploadedFile{Key: req.Key})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(result)
+}
+
+// completedKey returns the key of the marker for the completed presigned
+// upload with the given key
+func completedKey(key string) string {
+	return completedPrefix + key
+}
+
+// uploaderID returns an identifier for the user performing the request,
+// or an empty string for anonymous users
+func uploaderID(r *http.Request) string {
+	user := authentication.UserFromContext(r.Context())
+	if user == nil || user.UserID == "" {
+		return ""
+	}
+	return user.ProviderID + ":" + user.UserID
+}
+
+// isSafePresignValue returns true iff s can be used unescaped in
+// both request headers and POST policies
+func isSafePresignValue(s string) bool {
+	for _, c := range s {
+		if c < 0x20 || c == 0x7f || c == '"' || c == '\\' {
+			return false
+		}
+	}
+	return true
+}
+
+func randomFileKey(fileName string) (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", errors.New("could not generate file key")
+	}
+	return hex.EncodeToString(b[:]) + filepath.Ext(fileName), nil
+}
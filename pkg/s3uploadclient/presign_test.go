@@ -0,0 +1,144 @@
+package s3uploadclient_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wundergraph/wundergraph/pkg/authentication"
+	"github.com/wundergraph/wundergraph/pkg/s3uploadclient"
+)
+
+func doPresignRequest(t *testing.T, handler http.HandlerFunc, user *authentication.User, body interface{}) *http.Response {
+	data, err := json.Marshal(body)
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPost, "/s3/test/presign", bytes.NewReader(data))
+	req.Header.Set("X-Upload-Profile", "avatar")
+	req.Header.Set("X-Metadata", `{"postId":"1"}`)
+	if user != nil {
+		req = req.WithContext(context.WithValue(req.Context(), "user", user))
+	}
+	rec := httptest.NewRecorder()
+	handler(rec, req)
+	return rec.Result()
+}
+
+func TestPresignedPutUpload(t *testing.T) {
+	client, storage := newTestS3Client(t, &s3uploadclient.UploadProfile{
+		RequireAuthentication: true,
+		MaxFileSizeBytes:      1024,
+		AllowedMimeTypes:      []string{"image/*"},
+	}, nil)
+	user := &authentication.User{ProviderID: "github", UserID: "1"}
+
+	// Profile requires authentication
+	resp := doPresignRequest(t, client.PresignUpload, nil, s3uploadclient.PresignRequest{Name: "a.png", Size: 4, Type: "image/png"})
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	// File is validated against the profile
+	resp = doPresignRequest(t, client.PresignUpload, user, s3uploadclient.PresignRequest{Name: "a.png", Size: 2048, Type: "image/png"})
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	resp = doPresignRequest(t, client.PresignUpload, user, s3uploadclient.PresignRequest{Name: "a.txt", Size: 4, Type: "text/plain"})
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	presign := func(size int) *s3uploadclient.PresignedUpload {
+		resp := doPresignRequest(t, client.PresignUpload, user, s3uploadclient.PresignRequest{Name: "a.png", Size: int64(size), Type: "image/png"})
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var upload s3uploadclient.PresignedUpload
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&upload))
+		return &upload
+	}
+	put := func(upload *s3uploadclient.PresignedUpload, data string) {
+		putReq, err := http.NewRequest(http.MethodPut, upload.URL, strings.NewReader(data))
+		require.NoError(t, err)
+		for k, v := range upload.Headers {
+			if k != "Content-Length" {
+				putReq.Header.Set(k, v)
+			}
+		}
+		putResp, err := http.DefaultClient.Do(putReq)
+		require.NoError(t, err)
+		putResp.Body.Close()
+		require.Equal(t, http.StatusOK, putResp.StatusCode)
+	}
+
+	pngData := "\x89PNG\r\n\x1a\n"
+	upload := presign(len(pngData))
+	assert.Equal(t, s3uploadclient.PresignedUploadMethodPut, upload.Method)
+	assert.True(t, strings.HasSuffix(upload.Key, ".png"))
+	assert.Equal(t, "8", upload.Headers["Content-Length"])
+
+	u, err := url.Parse(upload.URL)
+	require.NoError(t, err)
+	signedHeaders := u.Query().Get("X-Amz-SignedHeaders")
+	assert.Contains(t, signedHeaders, "content-length")
+	assert.Contains(t, signedHeaders, "content-type")
+	assert.Contains(t, signedHeaders, "x-amz-meta-upload-profile")
+
+	// Upload the file directly to the bucket
+	put(upload, pngData)
+
+	// Other users can't complete the upload
+	resp = doPresignRequest(t, client.CompleteUpload, &authentication.User{ProviderID: "github", UserID: "2"}, s3uploadclient.CompleteUploadRequest{Key: upload.Key})
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	resp = doPresignRequest(t, client.CompleteUpload, user, s3uploadclient.CompleteUploadRequest{Key: "missing.png"})
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	resp = doPresignRequest(t, client.CompleteUpload, user, s3uploadclient.CompleteUploadRequest{Key: upload.Key})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var uploaded s3uploadclient.UploadedFile
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&uploaded))
+	assert.Equal(t, upload.Key, uploaded.Key)
+	assert.Contains(t, storage.objects, upload.Key)
+
+	// Completing it again would run the postUpload hook twice
+	resp = doPresignRequest(t, client.CompleteUpload, user, s3uploadclient.CompleteUploadRequest{Key: upload.Key})
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+
+	// The contents are checked like in regular uploads
+	upload = presign(8)
+	put(upload, "textdata")
+	resp = doPresignRequest(t, client.CompleteUpload, user, s3uploadclient.CompleteUploadRequest{Key: upload.Key})
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.NotContains(t, storage.objects, upload.Key)
+}
+
+func TestPresignedPostUpload(t *testing.T) {
+	client, storage := newTestS3Client(t, &s3uploadclient.UploadProfile{
+		MaxFileSizeBytes: 2,
+	}, nil)
+
+	resp := doPresignRequest(t, client.PresignUpload, nil, s3uploadclient.PresignRequest{Name: "a.txt", Size: 1, Type: "text/plain", Method: "POST"})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var upload s3uploadclient.PresignedUpload
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&upload))
+	assert.Equal(t, s3uploadclient.PresignedUploadMethodPost, upload.Method)
+	assert.Equal(t, upload.Key, upload.Fields["key"])
+	assert.Equal(t, "avatar", upload.Fields["x-amz-meta-upload-profile"])
+	assert.Equal(t, url.QueryEscape(`{"postId":"1"}`), upload.Fields["x-amz-meta-metadata"])
+	assert.NotEmpty(t, upload.Fields["policy"])
+
+	// Objects exceeding the profile limits are removed on completion
+	storage.objects[upload.Key] = &fakeS3Object{
+		header: http.Header{
+			"Content-Type":              {"text/plain"},
+			"X-Amz-Meta-Upload-Profile": {"avatar"},
+		},
+		data: []byte("abc"),
+	}
+	resp = doPresignRequest(t, client.CompleteUpload, nil, s3uploadclient.CompleteUploadRequest{Key: upload.Key})
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.NotContains(t, storage.objects, upload.Key)
+
+	resp = doPresignRequest(t, client.PresignUpload, nil, s3uploadclient.PresignRequest{Name: "a.txt", Size: 1, Type: `text/plain"`, Method: "POST"})
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
@@ -1,12 +1,14 @@
 package s3uploadclient
 
 import (
+	"bytes"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
 	"mime/multipart"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -17,8 +19,6 @@ import (
 
 	"github.com/buger/jsonparser"
 	"github.com/cespare/xxhash"
-	"github.com/minio/minio-go/v7"
-	"github.com/minio/minio-go/v7/pkg/credentials"
 	"github.com/santhosh-tekuri/jsonschema/v5"
 	"go.uber.org/zap"
 	"golang.org/x/net/context"
@@ -29,17 +29,26 @@ import (
 )
 
 const MaxUploadSize = 20 * 1024 * 1024 // 20MB
+// uploadPartSize is the size of each part when streaming files to the storage,
+// which is also the biggest file uploaded without a multipart upload
+const uploadPartSize = 5 * 1024 * 1024 // 5MB
 const MaxS3CreationTimeout = time.Duration(time.Second * 30)
 
+const (
+	// uploadProfileMetadata stores the profile used to upload the file
+	uploadProfileMetadata = "upload-profile"
+	// uploadedByMetadata stores the user that uploaded the file
+	uploadedByMetadata = "uploaded-by"
+)
+
 type S3UploadClient struct {
-	client         *minio.Client
-	bucketName     string
-	bucketLocation string
-	profiles       map[string]*preparedProfile
-	hooksClient    *hooks.Client
-	name           string
-	pool           *pool.Pool
-	logger         *zap.Logger
+	storage     Storage
+	profiles    map[string]*preparedProfile
+	hooksClient *hooks.Client
+	name        string
+	pool        *pool.Pool
+	logger      *zap.Logger
+	tusLocks    tusLocks
 }
 
 type preparedProfile struct {
@@ -67,10 +76,27 @@ type UploadProfile struct {
 	UsePreUploadHook bool
 	// Whether to use the PostUpload middleware hook
 	UsePostUploadHook bool
+	// Whether to use the PreDownload middleware hook
+	UsePreDownloadHook bool
+	// Roles allowed to download and list files, any user if empty
+	DownloadRoles []string
+	// Roles allowed to delete files, besides the user that uploaded them
+	DeleteRoles []string
+	// Whether to stream downloads through the node instead of
+	// redirecting to a presigned URL
+	ProxyDownloads bool
+	// Transformations applied to uploaded JPEG, PNG and GIF images
+	ImageTransformations []ImageTransformation
+	// Whether the files uploaded with this profile can be listed, downloaded
+	// and deleted with the FilesHandler
+	EnableFiles bool
 }
 
 type Options struct {
-	Logger          *zap.Logger
+	Logger *zap.Logger
+	// Storage for the uploaded files. If nil, an S3 storage is
+	// created using the endpoint and the bucket options.
+	Storage         Storage
 	BucketName      string
 	BucketLocation  string
 	AccessKeyID     string
@@ -92,15 +118,25 @@ type UploadResponse struct {
 
 type UploadedFile struct {
 	Key string `json:"key"`
+	// Keys of the transformed images, by transformation name
+	Transformations map[string]string `json:"transformations,omitempty"`
 }
 
 func NewS3UploadClient(endpoint string, s3Options Options) (*S3UploadClient, error) {
-	client, err := minio.New(endpoint, &minio.Options{
-		Creds:  credentials.NewStaticV4(s3Options.AccessKeyID, s3Options.SecretAccessKey, ""),
-		Secure: s3Options.UseSSL,
-	})
-	if err != nil {
-		return nil, err
+	storage := s3Options.Storage
+	if storage == nil {
+		var err error
+		storage, err = NewS3Storage(S3Options{
+			Endpoint:        endpoint,
+			AccessKeyID:     s3Options.AccessKeyID,
+			SecretAccessKey: s3Options.SecretAccessKey,
+			BucketName:      s3Options.BucketName,
+			BucketLocation:  s3Options.BucketLocation,
+			UseSSL:          s3Options.UseSSL,
+		})
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// Prepare profiles
@@ -136,9 +172,22 @@ func NewS3UploadClient(endpoint string, s3Options Options) (*S3UploadClient, err
 		// extensions and make search a bit faster
 		sort.Strings(allowedFileExtensions)
 
+		transformations := make(map[string]bool, len(profile.ImageTransformations))
+		for ii := range profile.ImageTransformations {
+			t := &profile.ImageTransformations[ii]
+			if err := t.validate(); err != nil {
+				return nil, fmt.Errorf("profile %s: %w", name, err)
+			}
+			if transformations[t.Name] {
+				return nil, fmt.Errorf("profile %s: duplicate image transformation %s", name, t.Name)
+			}
+			transformations[t.Name] = true
+		}
+
 		var metadataJSONSchema *jsonschema.Schema
 		if profile.MetadataJSONSchema != "" {
 			name := fmt.Sprintf("%s.%s.metadata.schema.json", s3Options.Name, name)
+			var err error
 			metadataJSONSchema, err = jsonschema.CompileString(name, profile.MetadataJSONSchema)
 			if err != nil {
 				return nil, fmt.Errorf("error compiling JSON schema: %w", err)
@@ -156,18 +205,15 @@ func NewS3UploadClient(endpoint string, s3Options Options) (*S3UploadClient, err
 	}
 
 	s := &S3UploadClient{
-		client:         client,
-		bucketName:     s3Options.BucketName,
-		bucketLocation: s3Options.BucketLocation,
-		profiles:       profiles,
-		hooksClient:    s3Options.HooksClient,
-		name:           s3Options.Name,
-		pool:           pool.New(),
-		logger:         s3Options.Logger,
+		storage:     storage,
+		profiles:    profiles,
+		hooksClient: s3Options.HooksClient,
+		name:        s3Options.Name,
+		pool:        pool.New(),
+		logger:      s3Options.Logger,
 	}
 
-	err = s.createBucket()
-	if err != nil {
+	if err := s.createBucket(); err != nil {
 		return nil, err
 	}
 
@@ -177,14 +223,7 @@ func NewS3UploadClient(endpoint string, s3Options Options) (*S3UploadClient, err
 func (s *S3UploadClient) createBucket() error {
 	ctx, cancel := context.WithTimeout(context.Background(), MaxS3CreationTimeout)
 	defer cancel()
-	exists, err := s.client.BucketExists(ctx, s.bucketName)
-	if err != nil {
-		return err
-	}
-	if exists {
-		return nil
-	}
-	return s.client.MakeBucket(ctx, s.bucketName, minio.MakeBucketOptions{Region: s.bucketLocation})
+	return s.storage.Init(ctx)
 }
 
 func (s *S3UploadClient) uploadProfile(r *http.Request) (profileName string, profile *preparedProfile, err error) {
@@ -200,109 +239,238 @@ func (s *S3UploadClient) uploadProfile(r *http.Request) (profileName string, pro
 	return profileName, profile, nil
 }
 
-func (s *S3UploadClient) uploadToS3(ctx context.Context, r *http.Request, part *multipart.Part) (*minio.UploadInfo, error) {
-	extension := filepath.Ext(part.FileName())
+// uploadToStorage validates the file while reading it and stores it. Files bigger
+// than a single part are streamed to the storage using a multipart upload, unless
+// the preUpload hook needs them to be complete, in which case they're copied to
+// a temporary file first. Since streamed files can't be hashed before uploading
+// them, they get a random key instead of one derived from their contents.
+// Images are also complete when the profile transforms them, and the keys of the
+// transformed images are returned by transformation name.
+func (s *S3UploadClient) uploadToStorage(ctx context.Context, r *http.Request, part *multipart.Part) (*ObjectInfo, map[string]string, error) {
+	profileName, profile, err := s.uploadProfile(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	maxSize := int64(-1)
+	if profile != nil && profile.MaxFileSizeBytes >= 0 {
+		maxSize = int64(profile.MaxFileSizeBytes)
+	}
+	data := &maxSizeReader{r: part, max: maxSize}
 
-	// find type based on file header, populated when *multipart.Part created
-	// if empty, contentType will be assigned by FPutObject
-	contentType := contentTypeFromPart(part)
+	// Read the first part, which is used to detect the content type
+	var buf bytes.Buffer
+	n, err := io.CopyN(&buf, data, uploadPartSize)
+	if err != nil && err != io.EOF {
+		return nil, nil, err
+	}
+	complete := n < uploadPartSize
 
-	// creates a temporary unique file in the temp folder of the OS
-	tmp, err := os.CreateTemp("", fmt.Sprintf("wundergraph-upload.*.%s", extension))
-	if err != nil {
-		return nil, err
+	contentType, sniffErr := sniffContentType(buf.Bytes(), part.FileName(), contentTypeFromPart(part))
+	file := &hookFile{Name: part.FileName(), Size: -1, MimeType: contentType}
+	if profile != nil {
+		if sniffErr != nil {
+			return nil, nil, fmt.Errorf("error validating file: %w", sniffErr)
+		}
+		if err := s.validateFile(profile, file); err != nil {
+			return nil, nil, fmt.Errorf("error validating file: %w", err)
+		}
 	}
-	defer func() {
-		_ = os.Remove(tmp.Name())
-	}()
 
-	written, err := io.Copy(tmp, part)
-	if err != nil {
-		return nil, err
+	var contents io.ReadSeeker
+	switch {
+	case complete:
+		file.Size = n
+		contents = bytes.NewReader(buf.Bytes())
+	case profile != nil && (profile.UsePreUploadHook || (len(profile.ImageTransformations) > 0 && isTransformableImage(contentType))):
+		tmp, err := os.CreateTemp("", fmt.Sprintf("wundergraph-upload.*%s", filepath.Ext(part.FileName())))
+		if err != nil {
+			return nil, nil, err
+		}
+		defer func() {
+			_ = tmp.Close()
+			_ = os.Remove(tmp.Name())
+		}()
+		if _, err := buf.WriteTo(tmp); err != nil {
+			return nil, nil, err
+		}
+		written, err := io.Copy(tmp, data)
+		if err != nil {
+			return nil, nil, err
+		}
+		file.Size = n + written
+		contents = tmp
 	}
 
-	filename, err := s.preUpload(ctx, r, part, tmp, written)
-	if err != nil {
-		return nil, err
+	var fileKey string
+	if profile != nil {
+		fileKey, err = s.checkUpload(ctx, r, profileName, profile, file)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	if fileKey == "" {
+		if contents != nil {
+			hexHash, err := hashContents(contents)
+			if err != nil {
+				return nil, nil, err
+			}
+			fileKey = fmt.Sprintf("%s%s", hexHash, filepath.Ext(part.FileName()))
+		} else {
+			fileKey, err = randomFileKey(part.FileName())
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	metadata := map[string]string{
+		"metadata":            fileMetadataFromRequest(r),
+		"original-filename":   part.FileName(),
+		"original-extension":  filepath.Ext(part.FileName()),
+		uploadProfileMetadata: profileName,
+		uploadedByMetadata:    uploaderID(r),
+	}
+	if contents == nil {
+		// The size isn't known until the file has been streamed
+		size, err := s.streamToStorage(ctx, fileKey, &buf, data, PutOptions{ContentType: contentType, Metadata: objectMetadata(metadata)})
+		if err != nil {
+			return nil, nil, err
+		}
+		return &ObjectInfo{Key: fileKey, Size: size, ContentType: contentType}, nil, nil
 	}
 
-	info, err := s.client.FPutObject(ctx, s.bucketName, filename, tmp.Name(), minio.PutObjectOptions{
+	// Transform images before storing them, so invalid images are rejected
+	var images []*transformedImage
+	if profile != nil && len(profile.ImageTransformations) > 0 && isTransformableImage(contentType) {
+		images, err = transformImage(contents, contentType, profile.ImageTransformations)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error transforming image: %w", err)
+		}
+	}
+	var transformations map[string]string
+	if len(images) > 0 {
+		transformations = make(map[string]string, len(images))
+		for _, transformed := range images {
+			transformations[transformed.name] = transformedImageKey(fileKey, transformed)
+		}
+		encoded, err := json.Marshal(transformations)
+		if err != nil {
+			return nil, nil, err
+		}
+		metadata[imageTransformationsMetadata] = string(encoded)
+	}
+
+	if _, err := contents.Seek(0, io.SeekStart); err != nil {
+		return nil, nil, err
+	}
+	metadata["original-size"] = strconv.FormatInt(file.Size, 10)
+	err = s.storage.Put(ctx, fileKey, contents, file.Size, PutOptions{
 		ContentType: contentType,
-		UserMetadata: map[string]string{
-			"metadata":           fileMetadataFromRequest(r),
-			"original-filename":  part.FileName(),
-			"original-extension": extension,
-			"original-size":      strconv.FormatInt(written, 10),
-		},
+		Metadata:    objectMetadata(metadata),
 	})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return &info, nil
-}
-
-func (s *S3UploadClient) preUpload(ctx context.Context, r *http.Request, part *multipart.Part, tempFile *os.File, fileSize int64) (string, error) {
-	profileName, profile, err := s.uploadProfile(r)
-	if err != nil {
-		return "", err
+	for _, transformed := range images {
+		imageMetadata := map[string]string{
+			"metadata":            metadata["metadata"],
+			"original-filename":   metadata["original-filename"],
+			"original-extension":  transformed.extension,
+			uploadProfileMetadata: profileName,
+			uploadedByMetadata:    metadata[uploadedByMetadata],
+			sourceKeyMetadata:     fileKey,
+		}
+		key := transformations[transformed.name]
+		err := s.storage.Put(ctx, key, bytes.NewReader(transformed.data), int64(len(transformed.data)), PutOptions{
+			ContentType: transformed.contentType,
+			Metadata:    objectMetadata(imageMetadata),
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("error storing image transformation %s: %w", transformed.name, err)
+		}
 	}
 
-	var fileKey string
+	return &ObjectInfo{Key: fileKey, Size: file.Size, ContentType: contentType}, transformations, nil
+}
 
-	if profile != nil {
-		if err := s.validateFile(ctx, profile, part, fileSize); err != nil {
-			return "", fmt.Errorf("error validating file: %w", err)
+// streamToStorage uploads the first part in buf followed by the rest of data using
+// a multipart upload, reusing buf for each part. If uploading fails, the multipart
+// upload is aborted.
+func (s *S3UploadClient) streamToStorage(ctx context.Context, key string, buf *bytes.Buffer, data io.Reader, opts PutOptions) (int64, error) {
+	uploadID, err := s.storage.NewMultipartUpload(ctx, key, opts)
+	if err != nil {
+		return 0, err
+	}
+	var parts []Part
+	var size int64
+	for number := 1; buf.Len() > 0; number++ {
+		part, err := s.storage.UploadPart(ctx, key, uploadID, number, bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		if err == nil {
+			parts = append(parts, *part)
+			size += int64(buf.Len())
+			buf.Reset()
+			_, err = io.CopyN(buf, data, uploadPartSize)
 		}
-
-		if profile.metadataJSONSchema != nil {
-			metadata := fileMetadataFromRequest(r)
-			var output interface{}
-			if err := json.Unmarshal([]byte(metadata), &output); err != nil {
-				return "", fmt.Errorf("error decoding metadata: %w", err)
-			}
-			if err := profile.metadataJSONSchema.Validate(output); err != nil {
-				return "", fmt.Errorf("error validating metadata: %w", err)
+		if err != nil && err != io.EOF {
+			if abortErr := s.storage.AbortMultipartUpload(ctx, key, uploadID); abortErr != nil && s.logger != nil {
+				s.logger.Error("aborting multipart upload", zap.String("provider", s.name), zap.String("key", key), zap.Error(abortErr))
 			}
+			return 0, err
 		}
+	}
+	if err := s.storage.CompleteMultipartUpload(ctx, key, uploadID, parts, opts); err != nil {
+		return 0, err
+	}
+	return size, nil
+}
 
-		if profile.UsePreUploadHook {
-			buf := pool.GetBytesBuffer()
-			defer pool.PutBytesBuffer(buf)
-			data, err := hookData(buf.Bytes(), r, part, fileSize, nil)
-			if err != nil {
-				return "", fmt.Errorf("error preparing preUpload hook data: %w", err)
-			}
-			payloadBuf := pool.GetBytesBuffer()
-			defer pool.PutBytesBuffer(payloadBuf)
-			resp, err := s.hooksClient.DoUploadRequest(ctx, s.name, profileName, hooks.PreUpload, data, payloadBuf)
-			if err != nil {
-				return "", fmt.Errorf("error in preUpload hook: %w", err)
-			}
-			// resp.Error is guaranteed to be empty here, since *hooks.Client would
-			// handle it and return err != nil if resp.Error was non-empty.
-			fileKey = resp.FileKey
-		}
+// checkUpload validates the file and its metadata against the profile, then runs
+// the preUpload hook if enabled. It returns the file key provided by the hook, if any.
+func (s *S3UploadClient) checkUpload(ctx context.Context, r *http.Request, profileName string, profile *preparedProfile, file *hookFile) (string, error) {
+	if err := s.validateFile(profile, file); err != nil {
+		return "", fmt.Errorf("error validating file: %w", err)
 	}
 
-	if fileKey == "" {
-		hexHash, err := hashContents(tempFile)
-		if err != nil {
-			return "", err
+	if profile.metadataJSONSchema != nil {
+		metadata := fileMetadataFromRequest(r)
+		var output interface{}
+		if err := json.Unmarshal([]byte(metadata), &output); err != nil {
+			return "", fmt.Errorf("error decoding metadata: %w", err)
 		}
-		fileKey = fmt.Sprintf("%s%s", hexHash, filepath.Ext(part.FileName()))
+		if err := profile.metadataJSONSchema.Validate(output); err != nil {
+			return "", fmt.Errorf("error validating metadata: %w", err)
+		}
+	}
+
+	if !profile.UsePreUploadHook {
+		return "", nil
+	}
+	buf := pool.GetBytesBuffer()
+	defer pool.PutBytesBuffer(buf)
+	data, err := hookData(buf.Bytes(), r, file, fileMetadataFromRequest(r), nil)
+	if err != nil {
+		return "", fmt.Errorf("error preparing preUpload hook data: %w", err)
 	}
-	return fileKey, nil
+	payloadBuf := pool.GetBytesBuffer()
+	defer pool.PutBytesBuffer(payloadBuf)
+	resp, err := s.hooksClient.DoUploadRequest(ctx, s.name, profileName, hooks.PreUpload, data, payloadBuf)
+	if err != nil {
+		return "", fmt.Errorf("error in preUpload hook: %w", err)
+	}
+	// resp.Error is guaranteed to be empty here, since *hooks.Client would
+	// handle it and return err != nil if resp.Error was non-empty.
+	return resp.FileKey, nil
 }
 
-func (s *S3UploadClient) validateFile(ctx context.Context, profile *preparedProfile, part *multipart.Part, fileSize int64) error {
+func (s *S3UploadClient) validateFile(profile *preparedProfile, file *hookFile) error {
 	if profile.MaxFileSizeBytes >= 0 {
-		if fileSize > int64(profile.MaxFileSizeBytes) {
-			return fmt.Errorf("file with %d bytes exceeds the %d maximum", fileSize, profile.MaxFileSizeBytes)
+		if file.Size > int64(profile.MaxFileSizeBytes) {
+			return fmt.Errorf("file with %d bytes exceeds the %d maximum", file.Size, profile.MaxFileSizeBytes)
 		}
 	}
 	if mc := len(profile.AllowedMimeTypes); mc > 0 {
-		contentType := strings.ToLower(contentTypeFromPart(part))
+		contentType := strings.ToLower(file.MimeType)
 		valid := false
 		for ii, mt := range profile.AllowedMimeTypes {
 			// Direct match
@@ -323,7 +491,7 @@ func (s *S3UploadClient) validateFile(ctx context.Context, profile *preparedProf
 		}
 	}
 	if ec := len(profile.AllowedFileExtensions); ec > 0 {
-		ext := filepath.Ext(part.FileName())
+		ext := strings.ToLower(filepath.Ext(file.Name))
 		pos := sort.SearchStrings(profile.AllowedFileExtensions, ext)
 		if pos >= ec || profile.AllowedFileExtensions[pos] != ext {
 			return fmt.Errorf("file with extension %s is not allowed (%s)", ext, strings.Join(profile.AllowedFileExtensions, ", "))
@@ -359,12 +527,12 @@ func (s *S3UploadClient) UploadFile(w http.ResponseWriter, r *http.Request) {
 		if part.FileName() == "" {
 			continue
 		}
-		info, err := s.handlePart(r.Context(), r, part)
+		info, transformations, err := s.handlePart(r.Context(), r, part)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusBadRequest)
 			return
 		}
-		result = append(result, UploadedFile{info.Key})
+		result = append(result, UploadedFile{Key: info.Key, Transformations: transformations})
 	}
 
 	files, err := json.Marshal(result)
@@ -377,20 +545,25 @@ func (s *S3UploadClient) UploadFile(w http.ResponseWriter, r *http.Request) {
 	_, _ = w.Write(files)
 }
 
-func (s *S3UploadClient) postUpload(ctx context.Context, r *http.Request, part *multipart.Part, info *minio.UploadInfo, uploadError error) error {
-	profileName, profile, err := s.uploadProfile(r)
+func (s *S3UploadClient) postUpload(ctx context.Context, r *http.Request, part *multipart.Part, info *ObjectInfo, uploadError error) error {
+	file := hookFileFromPart(part, -1)
+	if info != nil {
+		file.Key = info.Key
+		file.Size = info.Size
+		file.MimeType = info.ContentType
+	}
+	profileName, _, err := s.uploadProfile(r)
 	if err != nil {
 		return err
 	}
+	return s.runPostUploadHook(ctx, r, profileName, file, fileMetadataFromRequest(r), uploadError)
+}
 
-	if profile != nil && profile.UsePostUploadHook {
+func (s *S3UploadClient) runPostUploadHook(ctx context.Context, r *http.Request, profileName string, file *hookFile, metadata string, uploadError error) error {
+	if profile := s.profiles[profileName]; profile != nil && profile.UsePostUploadHook {
 		buf := pool.GetBytesBuffer()
 		defer pool.PutBytesBuffer(buf)
-		fileSize := int64(-1)
-		if info != nil {
-			fileSize = info.Size
-		}
-		data, err := hookData(buf.Bytes(), r, part, fileSize, uploadError)
+		data, err := hookData(buf.Bytes(), r, file, metadata, uploadError)
 		if err != nil {
 			return fmt.Errorf("error preparing postUpload hook data: %w", err)
 		}
@@ -404,21 +577,21 @@ func (s *S3UploadClient) postUpload(ctx context.Context, r *http.Request, part *
 	return nil
 }
 
-func (s *S3UploadClient) handlePart(ctx context.Context, r *http.Request, part *multipart.Part) (*minio.UploadInfo, error) {
+func (s *S3UploadClient) handlePart(ctx context.Context, r *http.Request, part *multipart.Part) (*ObjectInfo, map[string]string, error) {
 	defer part.Close()
 
-	info, err := s.uploadToS3(ctx, r, part)
+	info, transformations, err := s.uploadToStorage(ctx, r, part)
 	// Run PostUpload first, since it should always be ran even if the
 	// upload fails.
 	if err := s.postUpload(ctx, r, part, info, err); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	// Check error from s.uploadToS3()
+	// Check error from s.uploadToStorage()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return info, nil
+	return info, transformations, nil
 }
 
 func (s *S3UploadClient) hasRequiredAuthentication(w http.ResponseWriter, r *http.Request) bool {
@@ -439,11 +612,37 @@ func (s *S3UploadClient) hasRequiredAuthentication(w http.ResponseWriter, r *htt
 }
 
 type hookFile struct {
+	Key      string `json:"key,omitempty"`
 	Name     string `json:"name"`
 	Size     int64  `json:"size"`
 	MimeType string `json:"type"`
 }
 
+func hookFileFromPart(part *multipart.Part, fileSize int64) *hookFile {
+	return &hookFile{
+		Name:     part.FileName(),
+		Size:     fileSize,
+		MimeType: contentTypeFromPart(part),
+	}
+}
+
+// maxSizeReader fails once more than max bytes have been read, so big files
+// are rejected without reading them completely. A negative max disables it.
+type maxSizeReader struct {
+	r    io.Reader
+	max  int64
+	read int64
+}
+
+func (r *maxSizeReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.read += int64(n)
+	if r.max >= 0 && r.read > r.max {
+		return n, fmt.Errorf("error validating file: file exceeds the %d bytes maximum", r.max)
+	}
+	return n, err
+}
+
 func contentTypeFromPart(part *multipart.Part) string {
 	return part.Header.Get("Content-Type")
 }
@@ -452,7 +651,7 @@ func fileMetadataFromRequest(r *http.Request) string {
 	return r.Header.Get("X-Metadata")
 }
 
-func hookData(buf []byte, r *http.Request, part *multipart.Part, fileSize int64, uploadError error) ([]byte, error) {
+func hookData(buf []byte, r *http.Request, file *hookFile, metadata string, uploadError error) ([]byte, error) {
 	buf = buf[:0]
 	buf = append(buf, []byte(`{"__wg":{}}`)...)
 	if user := authentication.UserFromContext(r.Context()); user != nil {
@@ -462,12 +661,8 @@ func hookData(buf []byte, r *http.Request, part *multipart.Part, fileSize int64,
 			}
 		}
 	}
-	if part != nil {
-		fileData, err := json.Marshal(&hookFile{
-			Name:     part.FileName(),
-			Size:     fileSize,
-			MimeType: contentTypeFromPart(part),
-		})
+	if file != nil {
+		fileData, err := json.Marshal(file)
 		if err != nil {
 			return nil, err
 		}
@@ -475,7 +670,7 @@ func hookData(buf []byte, r *http.Request, part *multipart.Part, fileSize int64,
 			return nil, err
 		}
 	}
-	if metadata := fileMetadataFromRequest(r); metadata != "" {
+	if metadata != "" {
 		var err error
 		if buf, err = jsonparser.Set(buf, []byte(metadata), "meta"); err != nil {
 			return nil, err
@@ -500,6 +695,19 @@ func hookData(buf []byte, r *http.Request, part *multipart.Part, fileSize int64,
 	return buf, nil
 }
 
+// objectMetadata returns the user metadata for an uploaded object with its values
+// escaped, since they must be valid both in headers and in POST policies. Empty
+// values are omitted.
+func objectMetadata(metadata map[string]string) map[string]string {
+	escaped := make(map[string]string, len(metadata))
+	for k, v := range metadata {
+		if v != "" {
+			escaped[k] = url.QueryEscape(v)
+		}
+	}
+	return escaped
+}
+
 // hashContents returns the xxhash encoded as an hex string.
 // Before calculating the hash, it seeks to rs's start.
 func hashContents(rs io.ReadSeeker) (string, error) {
@@ -1,16 +1,57 @@
 package s3uploadclient_test
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 
+	"github.com/wundergraph/wundergraph/pkg/authentication"
+	"github.com/wundergraph/wundergraph/pkg/hooks"
 	"github.com/wundergraph/wundergraph/pkg/s3uploadclient"
 )
 
+// testPNG is a valid PNG header, enough for detecting the content type
+var testPNG = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func doUploadRequest(client *s3uploadclient.S3UploadClient, user *authentication.User, fileName string, contentType string, data []byte) *http.Response {
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", `form-data; name="files"; filename="`+fileName+`"`)
+	if contentType != "" {
+		header.Set("Content-Type", contentType)
+	}
+	fw, _ := mw.CreatePart(header)
+	_, _ = fw.Write(data)
+	_ = mw.Close()
+	req := httptest.NewRequest(http.MethodPost, "/s3/test/upload", &body)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	req.Header.Set("X-Upload-Profile", "avatar")
+	req = req.WithContext(context.WithValue(req.Context(), "user", user))
+	rec := httptest.NewRecorder()
+	client.UploadFile(rec, req)
+	return rec.Result()
+}
+
+func uploadedKey(t *testing.T, resp *http.Response) string {
+	var files []s3uploadclient.UploadedFile
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&files))
+	require.Len(t, files, 1)
+	return files[0].Key
+}
+
 func TestS3UploadClient_UploadFile(t *testing.T) {
 	if os.Getenv("INT") != "true" {
 		t.Skip("Skipping testing in local environment")
@@ -31,3 +72,99 @@ func TestS3UploadClient_UploadFile(t *testing.T) {
 	require.NotEmpty(t, resp)
 	assert.Equal(t, "cec8f2d4d95a43d0.json", decoded[0].Key)
 }
+
+func TestUploadContentSniffing(t *testing.T) {
+	storage, err := s3uploadclient.NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+	client := newTestClient(t, storage, &s3uploadclient.UploadProfile{
+		MaxFileSizeBytes: 1024,
+		AllowedMimeTypes: []string{"image/*"},
+	}, nil)
+	user := &authentication.User{ProviderID: "github", UserID: "1"}
+
+	resp := doUploadRequest(client, user, "avatar.png", "image/png", testPNG)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	info, err := storage.Stat(context.Background(), uploadedKey(t, resp))
+	require.NoError(t, err)
+	assert.Equal(t, "image/png", info.ContentType)
+
+	// The detected type takes precedence over the declared one
+	resp = doUploadRequest(client, user, "avatar", "application/octet-stream", testPNG)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	info, err = storage.Stat(context.Background(), uploadedKey(t, resp))
+	require.NoError(t, err)
+	assert.Equal(t, "image/png", info.ContentType)
+
+	// Declared types and extensions must match the contents
+	resp = doUploadRequest(client, user, "avatar.png", "image/png", []byte("#!/bin/sh"))
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	resp = doUploadRequest(client, user, "avatar.png", "", []byte("#!/bin/sh"))
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	resp = doUploadRequest(client, user, "avatar.jpg", "image/jpeg", testPNG)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	// Contents without a signature use the declared type
+	resp = doUploadRequest(client, user, "avatar.svg", "image/svg+xml", []byte(`<svg xmlns="http://www.w3.org/2000/svg"></svg>`))
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp = doUploadRequest(client, user, "notes.txt", "text/plain", []byte("notes"))
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	// Size limits are enforced while reading
+	resp = doUploadRequest(client, user, "big.png", "image/png", append(testPNG, make([]byte, 1024)...))
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	assert.Len(t, listKeys(t, storage, "", 100), 3)
+}
+
+func TestUploadStreaming(t *testing.T) {
+	storage, err := s3uploadclient.NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+	client := newTestClient(t, storage, &s3uploadclient.UploadProfile{MaxFileSizeBytes: -1}, nil)
+	user := &authentication.User{ProviderID: "github", UserID: "1"}
+
+	// Bigger than a part, so it's uploaded as multipart
+	data := bytes.Repeat([]byte("0123456789"), 600*1024)
+	resp := doUploadRequest(client, user, "video.bin", "application/octet-stream", data)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	key := uploadedKey(t, resp)
+	assert.True(t, strings.HasSuffix(key, ".bin"))
+
+	stored, info, err := storage.Get(context.Background(), key)
+	require.NoError(t, err)
+	contents, err := io.ReadAll(stored)
+	require.NoError(t, stored.Close())
+	require.NoError(t, err)
+	assert.Equal(t, data, contents)
+	assert.Equal(t, "application/octet-stream", info.ContentType)
+	assert.Equal(t, []string{key}, listKeys(t, storage, "", 100))
+}
+
+func TestUploadStreamingWithPreUploadHook(t *testing.T) {
+	data := bytes.Repeat([]byte("0123456789"), 600*1024)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/upload/test/avatar/preUpload", r.URL.Path)
+		var req struct {
+			File struct {
+				Size int64 `json:"size"`
+			} `json:"file"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		// The hook receives the complete file
+		assert.Equal(t, int64(len(data)), req.File.Size)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"hook": "preUpload", "fileKey": "videos/1.bin"})
+	}))
+	t.Cleanup(srv.Close)
+	hooksClient := hooks.NewClient(&hooks.ClientOptions{ServerURL: srv.URL, Logger: zap.NewNop()})
+
+	storage, err := s3uploadclient.NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+	client := newTestClient(t, storage, &s3uploadclient.UploadProfile{MaxFileSizeBytes: -1, UsePreUploadHook: true}, hooksClient)
+	resp := doUploadRequest(client, &authentication.User{ProviderID: "github", UserID: "1"}, "video.bin", "", data)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "videos/1.bin", uploadedKey(t, resp))
+
+	info, err := storage.Stat(context.Background(), "videos/1.bin")
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(data)), info.Size)
+	assert.Equal(t, "6144000", info.Metadata["original-size"])
+}
@@ -0,0 +1,119 @@
+package s3uploadclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strings"
+)
+
+// sniffLen is the number of bytes used to detect the content type
+const sniffLen = 512
+
+// sniffedTypes contains the types with signatures reliable enough to reject
+// files claiming them when their contents don't match
+var sniffedTypes = map[string]bool{
+	"application/pdf":              true,
+	"application/wasm":             true,
+	"application/x-gzip":           true,
+	"application/x-rar-compressed": true,
+	"application/zip":              true,
+	"font/woff":                    true,
+	"font/woff2":                   true,
+	"image/bmp":                    true,
+	"image/gif":                    true,
+	"image/jpeg":                   true,
+	"image/png":                    true,
+	"image/webp":                   true,
+}
+
+// genericTypes are detected for contents without a known signature, so the
+// declared type is used instead
+var genericTypes = map[string]bool{
+	"application/octet-stream": true,
+	"text/plain":               true,
+	"text/xml":                 true,
+}
+
+// containerTypes are used by other formats (e.g. docx is a zip file), so a
+// more specific declared type takes precedence
+var containerTypes = map[string]bool{
+	"application/ogg": true,
+	"application/zip": true,
+}
+
+var contentTypeAliases = map[string]string{
+	"application/gzip":         "application/x-gzip",
+	"audio/mp3":                "audio/mpeg",
+	"audio/wav":                "audio/wave",
+	"audio/x-wav":              "audio/wave",
+	"image/jpg":                "image/jpeg",
+	"image/pjpeg":              "image/jpeg",
+	"image/vnd.microsoft.icon": "image/x-icon",
+	"video/x-msvideo":          "video/avi",
+}
+
+// mediaType returns the lowercase content type without parameters
+func mediaType(contentType string) string {
+	contentType, _, _ = strings.Cut(contentType, ";")
+	contentType = strings.ToLower(strings.TrimSpace(contentType))
+	if alias, ok := contentTypeAliases[contentType]; ok {
+		return alias
+	}
+	return contentType
+}
+
+// sniffContentType returns the content type of a file using its first bytes, falling
+// back to the declared type (or the one for its extension) when the contents have no
+// known signature. If the declared type or the extension don't match the contents,
+// it also returns an error, which should be used to reject the file when validating it.
+func sniffContentType(head []byte, fileName string, declaredType string) (string, error) {
+	if len(head) > sniffLen {
+		head = head[:sniffLen]
+	}
+	detected := mediaType(http.DetectContentType(head))
+	declared := mediaType(declaredType)
+	extension := strings.ToLower(filepath.Ext(fileName))
+	extensionType := mediaType(mime.TypeByExtension(extension))
+
+	if declared != "" && sniffedTypes[declared] && declared != detected {
+		return detected, fmt.Errorf("file contents (%s) don't match its type %s", detected, declared)
+	}
+	if extensionType != "" && sniffedTypes[extensionType] && extensionType != detected {
+		return detected, fmt.Errorf("file contents (%s) don't match its extension %s", detected, extension)
+	}
+
+	fallback := declared
+	if fallback == "" {
+		fallback = extensionType
+	}
+	if fallback != "" && (genericTypes[detected] || (containerTypes[detected] && !sniffedTypes[fallback])) {
+		return fallback, nil
+	}
+	return detected, nil
+}
+
+// checkStoredFile validates a file uploaded without going through the node, detecting
+// its content type from the stored contents like regular uploads do. It returns an
+// error only if the file can't be read, while validation errors are returned in
+// uploadError.
+func (s *S3UploadClient) checkStoredFile(ctx context.Context, profile *preparedProfile, key string, file *hookFile) (uploadError error, err error) {
+	data, _, err := s.storage.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer data.Close()
+	head, err := io.ReadAll(io.LimitReader(data, sniffLen))
+	if err != nil {
+		return nil, err
+	}
+	contentType, sniffErr := sniffContentType(head, file.Name, file.MimeType)
+	file.MimeType = contentType
+	if sniffErr != nil {
+		return sniffErr, nil
+	}
+	return s.validateFile(profile, file), nil
+}
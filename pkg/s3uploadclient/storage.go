@@ -0,0 +1,121 @@
+package s3uploadclient
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+var (
+	// ErrNotFound is returned by storages when an object or multipart upload doesn't exist
+	ErrNotFound = errors.New("object not found")
+	// ErrNotSupported is returned by storages when an operation is not supported
+	// with their current configuration
+	ErrNotSupported = errors.New("operation not supported by the storage")
+)
+
+// multipartPrefix is the prefix used by storages without native multipart
+// uploads to store the uploaded parts
+const multipartPrefix = ".multipart/"
+
+// completedPrefix is the prefix for the markers of the presigned uploads
+// that have been completed
+const completedPrefix = ".completed/"
+
+// Storage is the backend used to store uploaded files. Keys use / as separator.
+// Metadata keys are lowercase and their values must be valid in HTTP headers.
+type Storage interface {
+	// Init creates the bucket, container or directory if it doesn't exist
+	Init(ctx context.Context) error
+	// Put stores an object with the given size
+	Put(ctx context.Context, key string, data io.Reader, size int64, opts PutOptions) error
+	// Get returns the contents of an object, which must be closed by the caller
+	Get(ctx context.Context, key string) (io.ReadCloser, *ObjectInfo, error)
+	// Stat returns the information about an object
+	Stat(ctx context.Context, key string) (*ObjectInfo, error)
+	// Delete removes an object. Removing an object that doesn't exist is not an error.
+	Delete(ctx context.Context, key string) error
+	// List returns up to limit objects with the given prefix, sorted by key, starting
+	// after cursor. Metadata might not be populated, use Stat to retrieve it.
+	List(ctx context.Context, prefix string, cursor string, limit int) (*ObjectList, error)
+
+	// NewMultipartUpload starts an upload where the contents are uploaded in several parts
+	NewMultipartUpload(ctx context.Context, key string, opts PutOptions) (uploadID string, err error)
+	// UploadPart uploads a part of a multipart upload. Parts are numbered starting at 1.
+	UploadPart(ctx context.Context, key string, uploadID string, partNumber int, data io.Reader, size int64) (*Part, error)
+	// ListParts returns the parts uploaded so far, sorted by their number
+	ListParts(ctx context.Context, key string, uploadID string) ([]Part, error)
+	// CompleteMultipartUpload stores the object from the given parts. The options must be the
+	// same ones used to start the upload, since some storages can only set them at the end.
+	CompleteMultipartUpload(ctx context.Context, key string, uploadID string, parts []Part, opts PutOptions) error
+	// AbortMultipartUpload removes the parts uploaded so far
+	AbortMultipartUpload(ctx context.Context, key string, uploadID string) error
+}
+
+// URLSigner is implemented by storages that can generate URLs for accessing
+// objects directly, without going through the node
+type URLSigner interface {
+	// SignedDownloadURL returns an URL for downloading the object. If contentDisposition
+	// is not empty, the response uses it as its Content-Disposition header.
+	SignedDownloadURL(ctx context.Context, key string, expires time.Duration, contentDisposition string) (string, error)
+	// SignedUploadURL returns an URL and the headers for uploading an object using PUT
+	SignedUploadURL(ctx context.Context, key string, expires time.Duration, size int64, opts PutOptions) (string, map[string]string, error)
+}
+
+// PostPolicySigner is implemented by storages that support uploading
+// objects directly from HTML forms using POST policies
+type PostPolicySigner interface {
+	// SignedPostPolicy returns the URL and the form fields for uploading
+	// an object with up to maxSize bytes
+	SignedPostPolicy(ctx context.Context, key string, expiresAt time.Time, maxSize int64, opts PutOptions) (string, map[string]string, error)
+}
+
+// PutOptions contains the attributes stored with an object
+type PutOptions struct {
+	ContentType string
+	Metadata    map[string]string
+}
+
+// ObjectInfo contains the attributes of a stored object
+type ObjectInfo struct {
+	Key          string
+	Size         int64
+	ContentType  string
+	ETag         string
+	LastModified time.Time
+	Metadata     map[string]string
+}
+
+// ObjectList is a page of objects returned by Storage.List. If Cursor is
+// not empty, it must be used to retrieve the next page.
+type ObjectList struct {
+	Objects []ObjectInfo
+	Cursor  string
+}
+
+// Part is an uploaded part of a multipart upload
+type Part struct {
+	Number int
+	Size   int64
+	ETag   string
+}
+
+// isInternalKey returns true for keys used to store the state of resumable,
+// multipart and presigned uploads, which are not exposed to clients
+func isInternalKey(key string) bool {
+	return strings.HasPrefix(key, tusPrefix) || strings.HasPrefix(key, multipartPrefix) || strings.HasPrefix(key, completedPrefix)
+}
+
+// randomID returns a random hex encoded identifier
+func randomID() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b[:]), nil
+}
@@ -0,0 +1,469 @@
+package s3uploadclient
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+const (
+	azureVersion        = "2021-08-06"
+	azureMetadataPrefix = "x-ms-meta-"
+	azureSASTimeFormat  = "2006-01-02T15:04:05Z"
+)
+
+// AzureOptions configures a storage backed by an Azure Blob Storage container
+type AzureOptions struct {
+	// Endpoint overrides the default https://<account>.blob.core.windows.net,
+	// e.g. http://127.0.0.1:10000/devstoreaccount1 for Azurite
+	Endpoint      string
+	AccountName   string
+	AccountKey    string
+	ContainerName string
+}
+
+// azureStorage uses the Blob Storage REST API with Shared Key authorization. Multipart
+// uploads are implemented with block blobs: each part is uploaded as an uncommitted block
+// and the blob is created when the block list is committed. Uncommitted blocks are
+// garbage collected by Azure after a week, so aborting an upload is a no-op.
+type azureStorage struct {
+	client        *http.Client
+	endpoint      *url.URL
+	accountName   string
+	accountKey    []byte
+	containerName string
+}
+
+// NewAzureStorage returns a Storage backed by an Azure Blob Storage container
+func NewAzureStorage(opts AzureOptions) (Storage, error) {
+	if opts.AccountName == "" || opts.ContainerName == "" {
+		return nil, errors.New("account and container names are required")
+	}
+	key, err := base64.StdEncoding.DecodeString(opts.AccountKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid account key: %w", err)
+	}
+	endpoint := opts.Endpoint
+	if endpoint == "" {
+		endpoint = "https://" + opts.AccountName + ".blob.core.windows.net"
+	}
+	u, err := url.Parse(strings.TrimSuffix(endpoint, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid endpoint: %w", err)
+	}
+	return &azureStorage{
+		client:        http.DefaultClient,
+		endpoint:      u,
+		accountName:   opts.AccountName,
+		accountKey:    key,
+		containerName: opts.ContainerName,
+	}, nil
+}
+
+// azureMetadataKey converts metadata keys to valid C# identifiers, as required by Azure
+func azureMetadataKey(key string) string {
+	return strings.ReplaceAll(key, "-", "_")
+}
+
+func (s *azureStorage) url(key string, query url.Values) *url.URL {
+	u := *s.endpoint
+	p := "/" + s.containerName
+	if key != "" {
+		p += "/" + key
+	}
+	u.Path += p
+	u.RawPath = s.endpoint.EscapedPath() + "/" + url.PathEscape(s.containerName)
+	if key != "" {
+		u.RawPath += "/" + uriEncode(key, false)
+	}
+	u.RawQuery = query.Encode()
+	return &u
+}
+
+func (s *azureStorage) sign(data string) string {
+	mac := hmac.New(sha256.New, s.accountKey)
+	mac.Write([]byte(data))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// authorize adds the Shared Key authorization header to the request
+// (https://learn.microsoft.com/en-us/rest/api/storageservices/authorize-with-shared-key)
+func (s *azureStorage) authorize(req *http.Request) {
+	req.Header.Set("x-ms-date", time.Now().UTC().Format(http.TimeFormat))
+	req.Header.Set("x-ms-version", azureVersion)
+
+	contentLength := ""
+	if req.ContentLength > 0 {
+		contentLength = strconv.FormatInt(req.ContentLength, 10)
+	}
+	var b strings.Builder
+	b.WriteString(req.Method + "\n")
+	for _, header := range []string{"Content-Encoding", "Content-Language"} {
+		b.WriteString(req.Header.Get(header) + "\n")
+	}
+	b.WriteString(contentLength + "\n")
+	for _, header := range []string{"Content-MD5", "Content-Type", "Date", "If-Modified-Since", "If-Match", "If-None-Match", "If-Unmodified-Since", "Range"} {
+		b.WriteString(req.Header.Get(header) + "\n")
+	}
+	var msHeaders []string
+	for k := range req.Header {
+		if lower := strings.ToLower(k); strings.HasPrefix(lower, "x-ms-") {
+			msHeaders = append(msHeaders, lower)
+		}
+	}
+	sort.Strings(msHeaders)
+	for _, k := range msHeaders {
+		b.WriteString(k + ":" + strings.TrimSpace(req.Header.Get(k)) + "\n")
+	}
+	b.WriteString("/" + s.accountName + req.URL.EscapedPath())
+	query := req.URL.Query()
+	params := make([]string, 0, len(query))
+	for k := range query {
+		params = append(params, k)
+	}
+	sort.Strings(params)
+	for _, k := range params {
+		values := append([]string(nil), query[k]...)
+		sort.Strings(values)
+		b.WriteString("\n" + strings.ToLower(k) + ":" + strings.Join(values, ","))
+	}
+	req.Header.Set("Authorization", "SharedKey "+s.accountName+":"+s.sign(b.String()))
+}
+
+// azureError is returned for failed requests, with the error code sent by Azure
+type azureError struct {
+	status int
+	code   string
+}
+
+func (e *azureError) Error() string {
+	return fmt.Sprintf("azure returned status %d: %s", e.status, e.code)
+}
+
+// do sends an authorized request, returning ErrNotFound for 404 responses and
+// an error with the Azure error code for other failures. The caller must close
+// the response body.
+func (s *azureStorage) do(ctx context.Context, method string, u *url.URL, header http.Header, body []byte) (*http.Response, error) {
+	var reader io.Reader
+	if len(body) > 0 {
+		reader = bytes.NewReader(body)
+	}
+	return s.doReader(ctx, method, u, header, reader, int64(len(body)))
+}
+
+func (s *azureStorage) doReader(ctx context.Context, method string, u *url.URL, header http.Header, body io.Reader, size int64) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = size
+	if size == 0 && body != nil {
+		req.Body = http.NoBody
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	s.authorize(req)
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		_, _ = io.Copy(io.Discard, resp.Body)
+		code := resp.Header.Get("x-ms-error-code")
+		if resp.StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("%w: %s", ErrNotFound, code)
+		}
+		return nil, &azureError{status: resp.StatusCode, code: code}
+	}
+	return resp, nil
+}
+
+func (s *azureStorage) putHeader(opts PutOptions) http.Header {
+	header := make(http.Header)
+	if opts.ContentType != "" {
+		header.Set("x-ms-blob-content-type", opts.ContentType)
+	}
+	for k, v := range opts.Metadata {
+		header.Set(azureMetadataPrefix+azureMetadataKey(k), v)
+	}
+	return header
+}
+
+func (s *azureStorage) objectInfo(key string, header http.Header) *ObjectInfo {
+	size, _ := strconv.ParseInt(header.Get("Content-Length"), 10, 64)
+	lastModified, _ := http.ParseTime(header.Get("Last-Modified"))
+	metadata := make(map[string]string)
+	for k, v := range header {
+		if lower := strings.ToLower(k); strings.HasPrefix(lower, azureMetadataPrefix) && len(v) > 0 {
+			metadata[strings.ReplaceAll(lower[len(azureMetadataPrefix):], "_", "-")] = v[0]
+		}
+	}
+	return &ObjectInfo{
+		Key:          key,
+		Size:         size,
+		ContentType:  header.Get("Content-Type"),
+		ETag:         strings.Trim(header.Get("ETag"), `"`),
+		LastModified: lastModified,
+		Metadata:     metadata,
+	}
+}
+
+func (s *azureStorage) Init(ctx context.Context) error {
+	resp, err := s.do(ctx, http.MethodPut, s.url("", url.Values{"restype": {"container"}}), nil, nil)
+	if err != nil {
+		var azErr *azureError
+		if errors.As(err, &azErr) && azErr.code == "ContainerAlreadyExists" {
+			return nil
+		}
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (s *azureStorage) Put(ctx context.Context, key string, data io.Reader, size int64, opts PutOptions) error {
+	header := s.putHeader(opts)
+	header.Set("x-ms-blob-type", "BlockBlob")
+	resp, err := s.doReader(ctx, http.MethodPut, s.url(key, nil), header, data, size)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (s *azureStorage) Get(ctx context.Context, key string) (io.ReadCloser, *ObjectInfo, error) {
+	resp, err := s.do(ctx, http.MethodGet, s.url(key, nil), nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp.Body, s.objectInfo(key, resp.Header), nil
+}
+
+func (s *azureStorage) Stat(ctx context.Context, key string) (*ObjectInfo, error) {
+	resp, err := s.do(ctx, http.MethodHead, s.url(key, nil), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	return s.objectInfo(key, resp.Header), nil
+}
+
+func (s *azureStorage) Delete(ctx context.Context, key string) error {
+	resp, err := s.do(ctx, http.MethodDelete, s.url(key, nil), nil, nil)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+type azureBlobList struct {
+	Blobs []struct {
+		Name       string `xml:"Name"`
+		Properties struct {
+			ContentLength int64  `xml:"Content-Length"`
+			ContentType   string `xml:"Content-Type"`
+			ETag          string `xml:"Etag"`
+			LastModified  string `xml:"Last-Modified"`
+		} `xml:"Properties"`
+	} `xml:"Blobs>Blob"`
+	NextMarker string `xml:"NextMarker"`
+}
+
+func (s *azureStorage) List(ctx context.Context, prefix string, cursor string, limit int) (*ObjectList, error) {
+	query := url.Values{
+		"restype": {"container"},
+		"comp":    {"list"},
+	}
+	if prefix != "" {
+		query.Set("prefix", prefix)
+	}
+	if cursor != "" {
+		query.Set("marker", cursor)
+	}
+	if limit > 0 {
+		query.Set("maxresults", strconv.Itoa(limit))
+	}
+	resp, err := s.do(ctx, http.MethodGet, s.url("", query), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	var result azureBlobList
+	if err := xml.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	list := &ObjectList{
+		Objects: make([]ObjectInfo, len(result.Blobs)),
+		Cursor:  result.NextMarker,
+	}
+	for ii, blob := range result.Blobs {
+		lastModified, _ := http.ParseTime(blob.Properties.LastModified)
+		list.Objects[ii] = ObjectInfo{
+			Key:          blob.Name,
+			Size:         blob.Properties.ContentLength,
+			ContentType:  blob.Properties.ContentType,
+			ETag:         strings.Trim(blob.Properties.ETag, `"`),
+			LastModified: lastModified,
+		}
+	}
+	return list, nil
+}
+
+// azureBlockID returns the block ID for a part. IDs must have the same length
+// for all the blocks in a blob, so the part number is zero padded.
+func azureBlockID(uploadID string, partNumber int) string {
+	return base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s-%05d", uploadID, partNumber)))
+}
+
+func (s *azureStorage) NewMultipartUpload(ctx context.Context, key string, opts PutOptions) (string, error) {
+	return randomID()
+}
+
+func (s *azureStorage) UploadPart(ctx context.Context, key string, uploadID string, partNumber int, data io.Reader, size int64) (*Part, error) {
+	blockID := azureBlockID(uploadID, partNumber)
+	query := url.Values{
+		"comp":    {"block"},
+		"blockid": {blockID},
+	}
+	resp, err := s.doReader(ctx, http.MethodPut, s.url(key, query), nil, data, size)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	return &Part{Number: partNumber, Size: size, ETag: blockID}, nil
+}
+
+func (s *azureStorage) ListParts(ctx context.Context, key string, uploadID string) ([]Part, error) {
+	query := url.Values{
+		"comp":          {"blocklist"},
+		"blocklisttype": {"uncommitted"},
+	}
+	resp, err := s.do(ctx, http.MethodGet, s.url(key, query), nil, nil)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			// The blob doesn't exist until a block is uploaded
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer resp.Body.Close()
+	var result struct {
+		Blocks []struct {
+			Name string `xml:"Name"`
+			Size int64  `xml:"Size"`
+		} `xml:"UncommittedBlocks>Block"`
+	}
+	if err := xml.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	var parts []Part
+	for _, block := range result.Blocks {
+		decoded, err := base64.StdEncoding.DecodeString(block.Name)
+		if err != nil {
+			continue
+		}
+		id, number, ok := strings.Cut(string(decoded), "-")
+		if !ok || id != uploadID {
+			continue
+		}
+		partNumber, err := strconv.Atoi(number)
+		if err != nil {
+			continue
+		}
+		parts = append(parts, Part{Number: partNumber, Size: block.Size, ETag: block.Name})
+	}
+	sort.Slice(parts, func(i, j int) bool { return parts[i].Number < parts[j].Number })
+	return parts, nil
+}
+
+func (s *azureStorage) CompleteMultipartUpload(ctx context.Context, key string, uploadID string, parts []Part, opts PutOptions) error {
+	var body bytes.Buffer
+	body.WriteString(`<?xml version="1.0" encoding="utf-8"?><BlockList>`)
+	for _, part := range parts {
+		body.WriteString("<Latest>" + azureBlockID(uploadID, part.Number) + "</Latest>")
+	}
+	body.WriteString("</BlockList>")
+	resp, err := s.do(ctx, http.MethodPut, s.url(key, url.Values{"comp": {"blocklist"}}), s.putHeader(opts), body.Bytes())
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (s *azureStorage) AbortMultipartUpload(ctx context.Context, key string, uploadID string) error {
+	return nil
+}
+
+// signedURL returns an URL with a service SAS for the blob
+// (https://learn.microsoft.com/en-us/rest/api/storageservices/create-service-sas)
+func (s *azureStorage) signedURL(key string, permissions string, expires time.Duration, contentDisposition string) string {
+	expiry := time.Now().UTC().Add(expires).Format(azureSASTimeFormat)
+	stringToSign := strings.Join([]string{
+		permissions,
+		"", // start
+		expiry,
+		"/blob/" + s.accountName + "/" + s.containerName + "/" + key,
+		"", // identifier
+		"", // IP
+		"", // protocol
+		azureVersion,
+		"b", // resource
+		"",  // snapshot time
+		"",  // encryption scope
+		"",  // cache control
+		contentDisposition,
+		"", // content encoding
+		"", // content language
+		"", // content type
+	}, "\n")
+	query := url.Values{
+		"sp":  {permissions},
+		"se":  {expiry},
+		"sv":  {azureVersion},
+		"sr":  {"b"},
+		"sig": {s.sign(stringToSign)},
+	}
+	if contentDisposition != "" {
+		query.Set("rscd", contentDisposition)
+	}
+	return s.url(key, query).String()
+}
+
+func (s *azureStorage) SignedDownloadURL(ctx context.Context, key string, expires time.Duration, contentDisposition string) (string, error) {
+	return s.signedURL(key, "r", expires, contentDisposition), nil
+}
+
+// SignedUploadURL returns an URL for uploading the blob with Put Blob. Since SAS
+// can't restrict the headers, the content type and size are not enforced by
+// Azure, so the uploaded file must be validated once the upload completes.
+func (s *azureStorage) SignedUploadURL(ctx context.Context, key string, expires time.Duration, size int64, opts PutOptions) (string, map[string]string, error) {
+	headers := map[string]string{
+		"x-ms-blob-type": "BlockBlob",
+		"Content-Type":   opts.ContentType,
+	}
+	for k, v := range opts.Metadata {
+		headers[azureMetadataPrefix+azureMetadataKey(k)] = v
+	}
+	return s.signedURL(key, "cw", expires, ""), headers, nil
+}
@@ -0,0 +1,214 @@
+package s3uploadclient_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wundergraph/wundergraph/pkg/s3uploadclient"
+)
+
+const testAzureAccount = "devstoreaccount1"
+
+type fakeAzureBlob struct {
+	header http.Header
+	data   []byte
+}
+
+// fakeAzure implements the subset of the Blob Storage REST API used by
+// the storage, only checking that requests are authorized
+type fakeAzure struct {
+	mu        sync.Mutex
+	container bool
+	blobs     map[string]*fakeAzureBlob
+	// uncommitted blocks by blob name and block ID
+	blocks map[string]map[string][]byte
+}
+
+func (s *fakeAzure) error(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("x-ms-error-code", code)
+	w.WriteHeader(status)
+}
+
+func (s *fakeAzure) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "SharedKey "+testAzureAccount+":") || r.Header.Get("x-ms-version") == "" {
+		s.error(w, http.StatusForbidden, "AuthenticationFailed")
+		return
+	}
+	query := r.URL.Query()
+	name, _ := url.PathUnescape(strings.TrimPrefix(r.URL.EscapedPath(), "/"+testAzureAccount+"/uploads"))
+	name = strings.TrimPrefix(name, "/")
+	if name == "" {
+		switch {
+		case r.Method == http.MethodPut && query.Get("restype") == "container":
+			if s.container {
+				s.error(w, http.StatusConflict, "ContainerAlreadyExists")
+				return
+			}
+			s.container = true
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet && query.Get("comp") == "list":
+			s.list(w, query)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+		return
+	}
+	switch {
+	case r.Method == http.MethodPut && query.Get("comp") == "block":
+		data, _ := io.ReadAll(r.Body)
+		if s.blocks[name] == nil {
+			s.blocks[name] = make(map[string][]byte)
+		}
+		s.blocks[name][query.Get("blockid")] = data
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == http.MethodPut && query.Get("comp") == "blocklist":
+		var list struct {
+			Latest []string `xml:"Latest"`
+		}
+		if err := xml.NewDecoder(r.Body).Decode(&list); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		blob := &fakeAzureBlob{header: blobHeader(r)}
+		for _, id := range list.Latest {
+			data, ok := s.blocks[name][id]
+			if !ok {
+				s.error(w, http.StatusBadRequest, "InvalidBlockList")
+				return
+			}
+			blob.data = append(blob.data, data...)
+		}
+		s.blobs[name] = blob
+		delete(s.blocks, name)
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == http.MethodGet && query.Get("comp") == "blocklist":
+		if s.blobs[name] == nil && s.blocks[name] == nil {
+			s.error(w, http.StatusNotFound, "BlobNotFound")
+			return
+		}
+		ids := make([]string, 0, len(s.blocks[name]))
+		for id := range s.blocks[name] {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		_, _ = io.WriteString(w, `<?xml version="1.0" encoding="utf-8"?><BlockList><CommittedBlocks /><UncommittedBlocks>`)
+		for _, id := range ids {
+			fmt.Fprintf(w, "<Block><Name>%s</Name><Size>%d</Size></Block>", id, len(s.blocks[name][id]))
+		}
+		_, _ = io.WriteString(w, "</UncommittedBlocks></BlockList>")
+	case r.Method == http.MethodPut:
+		if r.Header.Get("x-ms-blob-type") != "BlockBlob" || r.Header.Get("Content-Length") == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		data, _ := io.ReadAll(r.Body)
+		s.blobs[name] = &fakeAzureBlob{header: blobHeader(r), data: data}
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == http.MethodGet || r.Method == http.MethodHead:
+		blob := s.blobs[name]
+		if blob == nil {
+			s.error(w, http.StatusNotFound, "BlobNotFound")
+			return
+		}
+		for k, v := range blob.header {
+			w.Header()[k] = v
+		}
+		w.Header().Set("ETag", `"0x8D"`)
+		w.Header().Set("Last-Modified", time.Now().UTC().Format(http.TimeFormat))
+		w.Header().Set("Content-Length", strconv.Itoa(len(blob.data)))
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(blob.data)
+		}
+	case r.Method == http.MethodDelete:
+		if s.blobs[name] == nil {
+			s.error(w, http.StatusNotFound, "BlobNotFound")
+			return
+		}
+		delete(s.blobs, name)
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// list uses the last returned name as marker
+func (s *fakeAzure) list(w http.ResponseWriter, query url.Values) {
+	prefix := query.Get("prefix")
+	marker := query.Get("marker")
+	names := make([]string, 0, len(s.blobs))
+	for name := range s.blobs {
+		if strings.HasPrefix(name, prefix) && name > marker {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	next := ""
+	if max, _ := strconv.Atoi(query.Get("maxresults")); max > 0 && len(names) > max {
+		names = names[:max]
+		next = names[max-1]
+	}
+	_, _ = io.WriteString(w, `<?xml version="1.0" encoding="utf-8"?><EnumerationResults><Blobs>`)
+	for _, name := range names {
+		fmt.Fprintf(w, "<Blob><Name>%s</Name><Properties><Content-Length>%d</Content-Length><Content-Type>%s</Content-Type><Etag>0x8D</Etag></Properties></Blob>",
+			name, len(s.blobs[name].data), s.blobs[name].header.Get("Content-Type"))
+	}
+	fmt.Fprintf(w, "</Blobs><NextMarker>%s</NextMarker></EnumerationResults>", next)
+}
+
+func blobHeader(r *http.Request) http.Header {
+	header := make(http.Header)
+	for k, v := range r.Header {
+		if strings.HasPrefix(strings.ToLower(k), "x-ms-meta-") {
+			header[k] = v
+		}
+	}
+	header.Set("Content-Type", r.Header.Get("x-ms-blob-content-type"))
+	return header
+}
+
+func TestAzureStorage(t *testing.T) {
+	fake := &fakeAzure{
+		blobs:  make(map[string]*fakeAzureBlob),
+		blocks: make(map[string]map[string][]byte),
+	}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	storage, err := s3uploadclient.NewAzureStorage(s3uploadclient.AzureOptions{
+		Endpoint:      srv.URL + "/" + testAzureAccount,
+		AccountName:   testAzureAccount,
+		AccountKey:    base64.StdEncoding.EncodeToString([]byte("secret")),
+		ContainerName: "uploads",
+	})
+	require.NoError(t, err)
+	testStorage(t, storage)
+
+	// Metadata keys must be valid identifiers
+	assert.Contains(t, fake.blobs["files/hello.txt"].header, "X-Ms-Meta-Upload_profile")
+
+	download, err := storage.(s3uploadclient.URLSigner).SignedDownloadURL(context.Background(), "files/hello.txt", time.Minute, "inline")
+	require.NoError(t, err)
+	u, err := url.Parse(download)
+	require.NoError(t, err)
+	assert.Equal(t, "/"+testAzureAccount+"/uploads/files/hello.txt", u.Path)
+	assert.Equal(t, "r", u.Query().Get("sp"))
+	assert.Equal(t, "b", u.Query().Get("sr"))
+	assert.Equal(t, "inline", u.Query().Get("rscd"))
+	assert.NotEmpty(t, u.Query().Get("sig"))
+}
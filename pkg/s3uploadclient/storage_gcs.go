@@ -0,0 +1,574 @@
+package s3uploadclient
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/jwt"
+)
+
+const (
+	gcsDefaultEndpoint = "https://storage.googleapis.com"
+	gcsScope           = "https://www.googleapis.com/auth/devstorage.read_write"
+	gcsMetadataToken   = "http://metadata.google.internal/computeMetadata/v1/instance/service-accounts/default/token"
+	// gcsMaxComposeSources is the maximum number of objects in a compose request
+	gcsMaxComposeSources = 32
+	// gcsMaxSignedURLExpiration is the maximum expiration for V4 signed URLs
+	gcsMaxSignedURLExpiration = 7 * 24 * time.Hour
+)
+
+// GCSOptions configures a storage backed by a Google Cloud Storage bucket
+type GCSOptions struct {
+	// Endpoint overrides the Cloud Storage endpoint, for using emulators.
+	// Requests to custom endpoints are not authenticated unless
+	// CredentialsJSON is set.
+	Endpoint   string
+	BucketName string
+	// BucketLocation is used when creating the bucket
+	BucketLocation string
+	// CredentialsJSON contains a service account key. If empty, credentials
+	// are retrieved from the metadata server when running in Google Cloud,
+	// but signed URLs are not available.
+	CredentialsJSON string
+}
+
+type gcsServiceAccount struct {
+	ProjectID    string `json:"project_id"`
+	PrivateKeyID string `json:"private_key_id"`
+	PrivateKey   string `json:"private_key"`
+	ClientEmail  string `json:"client_email"`
+	TokenURI     string `json:"token_uri"`
+}
+
+// gcsStorage uses the Cloud Storage JSON API. Multipart uploads store each
+// part as an object under .multipart/<uploadID>/ and compose them once
+// the upload completes.
+type gcsStorage struct {
+	client         *http.Client
+	endpoint       string
+	bucketName     string
+	bucketLocation string
+	projectID      string
+	// signing is only available with service account credentials
+	clientEmail string
+	privateKey  *rsa.PrivateKey
+}
+
+// NewGCSStorage returns a Storage backed by a Google Cloud Storage bucket
+func NewGCSStorage(opts GCSOptions) (Storage, error) {
+	if opts.BucketName == "" {
+		return nil, errors.New("bucket name is required")
+	}
+	s := &gcsStorage{
+		client:         http.DefaultClient,
+		endpoint:       strings.TrimSuffix(opts.Endpoint, "/"),
+		bucketName:     opts.BucketName,
+		bucketLocation: opts.BucketLocation,
+	}
+	if s.endpoint == "" {
+		s.endpoint = gcsDefaultEndpoint
+	}
+	ctx := context.Background()
+	switch {
+	case opts.CredentialsJSON != "":
+		var account gcsServiceAccount
+		if err := json.Unmarshal([]byte(opts.CredentialsJSON), &account); err != nil {
+			return nil, fmt.Errorf("error decoding GCS credentials: %w", err)
+		}
+		privateKey, err := parseRSAPrivateKey([]byte(account.PrivateKey))
+		if err != nil {
+			return nil, fmt.Errorf("error decoding GCS private key: %w", err)
+		}
+		config := &jwt.Config{
+			Email:        account.ClientEmail,
+			PrivateKey:   []byte(account.PrivateKey),
+			PrivateKeyID: account.PrivateKeyID,
+			TokenURL:     account.TokenURI,
+			Scopes:       []string{gcsScope},
+		}
+		s.client = oauth2.NewClient(ctx, config.TokenSource(ctx))
+		s.projectID = account.ProjectID
+		s.clientEmail = account.ClientEmail
+		s.privateKey = privateKey
+	case opts.Endpoint == "":
+		s.client = oauth2.NewClient(ctx, oauth2.ReuseTokenSource(nil, gcsMetadataTokenSource{}))
+	}
+	return s, nil
+}
+
+func parseRSAPrivateKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("invalid PEM data")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("private key is not an RSA key")
+	}
+	return key, nil
+}
+
+// gcsMetadataTokenSource retrieves tokens for the default service
+// account from the metadata server
+type gcsMetadataTokenSource struct{}
+
+func (gcsMetadataTokenSource) Token() (*oauth2.Token, error) {
+	req, err := http.NewRequest(http.MethodGet, gcsMetadataToken, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Metadata-Flavor", "Google")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving token from the metadata server: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("metadata server returned status %d", resp.StatusCode)
+	}
+	var token struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+		TokenType   string `json:"token_type"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return nil, err
+	}
+	return &oauth2.Token{
+		AccessToken: token.AccessToken,
+		TokenType:   token.TokenType,
+		Expiry:      time.Now().Add(time.Duration(token.ExpiresIn) * time.Second),
+	}, nil
+}
+
+type gcsObject struct {
+	Name        string            `json:"name"`
+	Size        string            `json:"size"`
+	ContentType string            `json:"contentType"`
+	ETag        string            `json:"etag"`
+	Updated     time.Time         `json:"updated"`
+	Metadata    map[string]string `json:"metadata"`
+}
+
+func (o *gcsObject) info() *ObjectInfo {
+	size, _ := strconv.ParseInt(o.Size, 10, 64)
+	metadata := o.Metadata
+	if metadata == nil {
+		metadata = make(map[string]string)
+	}
+	return &ObjectInfo{
+		Key:          o.Name,
+		Size:         size,
+		ContentType:  o.ContentType,
+		ETag:         o.ETag,
+		LastModified: o.Updated,
+		Metadata:     metadata,
+	}
+}
+
+func (s *gcsStorage) bucketURL() string {
+	return s.endpoint + "/storage/v1/b/" + url.PathEscape(s.bucketName)
+}
+
+func (s *gcsStorage) objectURL(key string) string {
+	return s.bucketURL() + "/o/" + url.PathEscape(key)
+}
+
+// do sends the request and decodes the JSON response into result, if not nil. Responses
+// with a 404 status return ErrNotFound, while other failures return an error with the message
+// from the response.
+func (s *gcsStorage) do(ctx context.Context, method string, u string, contentType string, body io.Reader, result interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		var errorResponse struct {
+			Error struct {
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		_ = json.NewDecoder(resp.Body).Decode(&errorResponse)
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("%w: %s %s", ErrNotFound, method, u)
+		}
+		return fmt.Errorf("GCS returned status %d: %s", resp.StatusCode, errorResponse.Error.Message)
+	}
+	if result != nil {
+		return json.NewDecoder(resp.Body).Decode(result)
+	}
+	return nil
+}
+
+func (s *gcsStorage) doJSON(ctx context.Context, method string, u string, body interface{}, result interface{}) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	return s.do(ctx, method, u, "application/json", bytes.NewReader(data), result)
+}
+
+func (s *gcsStorage) Init(ctx context.Context) error {
+	err := s.do(ctx, http.MethodGet, s.bucketURL(), "", nil, nil)
+	if !errors.Is(err, ErrNotFound) {
+		return err
+	}
+	if s.projectID == "" {
+		return fmt.Errorf("bucket %s does not exist and can't be created without a project ID", s.bucketName)
+	}
+	bucket := map[string]string{
+		"name": s.bucketName,
+	}
+	if s.bucketLocation != "" {
+		bucket["location"] = s.bucketLocation
+	}
+	return s.doJSON(ctx, http.MethodPost, s.endpoint+"/storage/v1/b?project="+url.QueryEscape(s.projectID), bucket, nil)
+}
+
+// Put uses a multipart upload, which sends the object attributes and the
+// contents in the same request
+func (s *gcsStorage) Put(ctx context.Context, key string, data io.Reader, size int64, opts PutOptions) error {
+	attributes, err := json.Marshal(map[string]interface{}{
+		"name":        key,
+		"contentType": opts.ContentType,
+		"metadata":    opts.Metadata,
+	})
+	if err != nil {
+		return err
+	}
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+	go func() {
+		err := func() error {
+			part, err := mw.CreatePart(textproto.MIMEHeader{"Content-Type": {"application/json; charset=UTF-8"}})
+			if err != nil {
+				return err
+			}
+			if _, err := part.Write(attributes); err != nil {
+				return err
+			}
+			contentType := opts.ContentType
+			if contentType == "" {
+				contentType = "application/octet-stream"
+			}
+			part, err = mw.CreatePart(textproto.MIMEHeader{"Content-Type": {contentType}})
+			if err != nil {
+				return err
+			}
+			if _, err := io.Copy(part, data); err != nil {
+				return err
+			}
+			return mw.Close()
+		}()
+		pw.CloseWithError(err)
+	}()
+	u := s.endpoint + "/upload/storage/v1/b/" + url.PathEscape(s.bucketName) + "/o?uploadType=multipart"
+	err = s.do(ctx, http.MethodPost, u, "multipart/related; boundary="+mw.Boundary(), pr, nil)
+	// Make sure the goroutine finishes if the request failed before reading the body
+	_ = pr.CloseWithError(io.ErrClosedPipe)
+	return err
+}
+
+func (s *gcsStorage) Get(ctx context.Context, key string) (io.ReadCloser, *ObjectInfo, error) {
+	info, err := s.Stat(ctx, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.objectURL(key)+"?alt=media", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		if resp.StatusCode == http.StatusNotFound {
+			return nil, nil, fmt.Errorf("%w: %s", ErrNotFound, key)
+		}
+		return nil, nil, fmt.Errorf("GCS returned status %d", resp.StatusCode)
+	}
+	return resp.Body, info, nil
+}
+
+func (s *gcsStorage) Stat(ctx context.Context, key string) (*ObjectInfo, error) {
+	var obj gcsObject
+	if err := s.do(ctx, http.MethodGet, s.objectURL(key), "", nil, &obj); err != nil {
+		return nil, err
+	}
+	return obj.info(), nil
+}
+
+func (s *gcsStorage) Delete(ctx context.Context, key string) error {
+	if err := s.do(ctx, http.MethodDelete, s.objectURL(key), "", nil, nil); err != nil && !errors.Is(err, ErrNotFound) {
+		return err
+	}
+	return nil
+}
+
+func (s *gcsStorage) List(ctx context.Context, prefix string, cursor string, limit int) (*ObjectList, error) {
+	query := url.Values{}
+	if prefix != "" {
+		query.Set("prefix", prefix)
+	}
+	if cursor != "" {
+		query.Set("pageToken", cursor)
+	}
+	if limit > 0 {
+		query.Set("maxResults", strconv.Itoa(limit))
+	}
+	var result struct {
+		Items         []gcsObject `json:"items"`
+		NextPageToken string      `json:"nextPageToken"`
+	}
+	if err := s.do(ctx, http.MethodGet, s.bucketURL()+"/o?"+query.Encode(), "", nil, &result); err != nil {
+		return nil, err
+	}
+	list := &ObjectList{
+		Objects: make([]ObjectInfo, len(result.Items)),
+		Cursor:  result.NextPageToken,
+	}
+	for ii := range result.Items {
+		list.Objects[ii] = *result.Items[ii].info()
+	}
+	return list, nil
+}
+
+func gcsPartPrefix(uploadID string) string {
+	return multipartPrefix + uploadID + "/"
+}
+
+func (s *gcsStorage) NewMultipartUpload(ctx context.Context, key string, opts PutOptions) (string, error) {
+	return randomID()
+}
+
+func (s *gcsStorage) UploadPart(ctx context.Context, key string, uploadID string, partNumber int, data io.Reader, size int64) (*Part, error) {
+	name := fmt.Sprintf("%s%05d", gcsPartPrefix(uploadID), partNumber)
+	if err := s.Put(ctx, name, data, size, PutOptions{}); err != nil {
+		return nil, err
+	}
+	return &Part{Number: partNumber, Size: size, ETag: name}, nil
+}
+
+func (s *gcsStorage) ListParts(ctx context.Context, key string, uploadID string) ([]Part, error) {
+	prefix := gcsPartPrefix(uploadID)
+	var parts []Part
+	cursor := ""
+	for {
+		list, err := s.List(ctx, prefix, cursor, 1000)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range list.Objects {
+			number, err := strconv.Atoi(strings.TrimPrefix(obj.Key, prefix))
+			if err != nil {
+				continue
+			}
+			parts = append(parts, Part{Number: number, Size: obj.Size, ETag: obj.Key})
+		}
+		if list.Cursor == "" {
+			break
+		}
+		cursor = list.Cursor
+	}
+	sort.Slice(parts, func(i, j int) bool { return parts[i].Number < parts[j].Number })
+	return parts, nil
+}
+
+// CompleteMultipartUpload composes the parts into the object. Since each compose
+// request accepts a limited number of sources, the object is built incrementally.
+func (s *gcsStorage) CompleteMultipartUpload(ctx context.Context, key string, uploadID string, parts []Part, opts PutOptions) error {
+	if len(parts) == 0 {
+		return s.Put(ctx, key, bytes.NewReader(nil), 0, opts)
+	}
+	type source struct {
+		Name string `json:"name"`
+	}
+	prefix := gcsPartPrefix(uploadID)
+	var composed []source
+	for len(parts) > 0 {
+		n := gcsMaxComposeSources - len(composed)
+		if n > len(parts) {
+			n = len(parts)
+		}
+		sources := composed
+		for _, part := range parts[:n] {
+			sources = append(sources, source{Name: fmt.Sprintf("%s%05d", prefix, part.Number)})
+		}
+		parts = parts[n:]
+		request := map[string]interface{}{
+			"sourceObjects": sources,
+			"destination": map[string]interface{}{
+				"contentType": opts.ContentType,
+				"metadata":    opts.Metadata,
+			},
+		}
+		if err := s.doJSON(ctx, http.MethodPost, s.objectURL(key)+"/compose", request, nil); err != nil {
+			return err
+		}
+		composed = []source{{Name: key}}
+	}
+	return s.AbortMultipartUpload(ctx, key, uploadID)
+}
+
+func (s *gcsStorage) AbortMultipartUpload(ctx context.Context, key string, uploadID string) error {
+	parts, err := s.ListParts(ctx, key, uploadID)
+	if err != nil {
+		return err
+	}
+	for _, part := range parts {
+		if err := s.Delete(ctx, part.ETag); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *gcsStorage) SignedDownloadURL(ctx context.Context, key string, expires time.Duration, contentDisposition string) (string, error) {
+	query := url.Values{}
+	if contentDisposition != "" {
+		query.Set("response-content-disposition", contentDisposition)
+	}
+	return s.signURL(http.MethodGet, key, expires, query, nil)
+}
+
+// SignedUploadURL returns a signed PUT request. The signature covers the content type and
+// metadata headers, while x-goog-content-length-range restricts the size of the object.
+func (s *gcsStorage) SignedUploadURL(ctx context.Context, key string, expires time.Duration, size int64, opts PutOptions) (string, map[string]string, error) {
+	headers := map[string]string{
+		"Content-Type":                opts.ContentType,
+		"x-goog-content-length-range": fmt.Sprintf("%d,%d", size, size),
+	}
+	for k, v := range opts.Metadata {
+		headers["x-goog-meta-"+k] = v
+	}
+	u, err := s.signURL(http.MethodPut, key, expires, url.Values{}, headers)
+	if err != nil {
+		return "", nil, err
+	}
+	return u, headers, nil
+}
+
+// signURL implements V4 signing for the XML API, which is used for
+// the signed URLs (https://cloud.google.com/storage/docs/access-control/signing-urls-manually)
+func (s *gcsStorage) signURL(method string, key string, expires time.Duration, query url.Values, headers map[string]string) (string, error) {
+	if s.privateKey == nil {
+		return "", ErrNotSupported
+	}
+	if expires > gcsMaxSignedURLExpiration {
+		expires = gcsMaxSignedURLExpiration
+	}
+	endpoint, err := url.Parse(s.endpoint)
+	if err != nil {
+		return "", err
+	}
+	now := time.Now().UTC()
+	timestamp := now.Format("20060102T150405Z")
+	scope := now.Format("20060102") + "/auto/storage/goog4_request"
+
+	canonicalHeaders := map[string]string{"host": endpoint.Host}
+	for k, v := range headers {
+		canonicalHeaders[strings.ToLower(k)] = strings.TrimSpace(v)
+	}
+	headerNames := make([]string, 0, len(canonicalHeaders))
+	for k := range canonicalHeaders {
+		headerNames = append(headerNames, k)
+	}
+	sort.Strings(headerNames)
+	signedHeaders := strings.Join(headerNames, ";")
+
+	query.Set("X-Goog-Algorithm", "GOOG4-RSA-SHA256")
+	query.Set("X-Goog-Credential", s.clientEmail+"/"+scope)
+	query.Set("X-Goog-Date", timestamp)
+	query.Set("X-Goog-Expires", strconv.FormatInt(int64(expires/time.Second), 10))
+	query.Set("X-Goog-SignedHeaders", signedHeaders)
+
+	canonicalPath := "/" + uriEncode(s.bucketName, true) + "/" + uriEncode(key, false)
+	var canonicalRequest strings.Builder
+	canonicalRequest.WriteString(method + "\n")
+	canonicalRequest.WriteString(canonicalPath + "\n")
+	canonicalRequest.WriteString(canonicalQuery(query) + "\n")
+	for _, name := range headerNames {
+		canonicalRequest.WriteString(name + ":" + canonicalHeaders[name] + "\n")
+	}
+	canonicalRequest.WriteString("\n" + signedHeaders + "\nUNSIGNED-PAYLOAD")
+
+	requestHash := sha256.Sum256([]byte(canonicalRequest.String()))
+	stringToSign := "GOOG4-RSA-SHA256\n" + timestamp + "\n" + scope + "\n" + hex.EncodeToString(requestHash[:])
+	digest := sha256.Sum256([]byte(stringToSign))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, s.privateKey, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", err
+	}
+	return endpoint.Scheme + "://" + endpoint.Host + canonicalPath + "?" + canonicalQuery(query) + "&X-Goog-Signature=" + hex.EncodeToString(signature), nil
+}
+
+// uriEncode percent encodes every byte except the unreserved characters,
+// optionally also encoding the / separator
+func uriEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') ||
+			c == '-' || c == '.' || c == '_' || c == '~' || (c == '/' && !encodeSlash) {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+// canonicalQuery returns the query string sorted by key, with the keys and values encoded
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var pairs []string
+	for _, k := range keys {
+		values := append([]string(nil), query[k]...)
+		sort.Strings(values)
+		for _, v := range values {
+			pairs = append(pairs, uriEncode(k, true)+"="+uriEncode(v, true))
+		}
+	}
+	return strings.Join(pairs, "&")
+}
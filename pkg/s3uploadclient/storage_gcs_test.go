@@ -0,0 +1,220 @@
+package s3uploadclient_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wundergraph/wundergraph/pkg/s3uploadclient"
+)
+
+type fakeGCSObject struct {
+	Name        string            `json:"name"`
+	Size        string            `json:"size"`
+	ContentType string            `json:"contentType"`
+	ETag        string            `json:"etag"`
+	Updated     time.Time         `json:"updated"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+	data        []byte
+}
+
+// fakeGCS implements the subset of the Cloud Storage JSON API used by the storage
+type fakeGCS struct {
+	mu      sync.Mutex
+	objects map[string]*fakeGCSObject
+}
+
+func (s *fakeGCS) put(obj *fakeGCSObject, data []byte) {
+	obj.data = data
+	obj.Size = strconv.Itoa(len(data))
+	obj.ETag = fmt.Sprintf("etag-%d", len(s.objects))
+	obj.Updated = time.Now()
+	s.objects[obj.Name] = obj
+}
+
+func (s *fakeGCS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p := r.URL.EscapedPath()
+	switch {
+	case p == "/storage/v1/b/uploads":
+		_, _ = io.WriteString(w, `{"name":"uploads"}`)
+	case p == "/upload/storage/v1/b/uploads/o" && r.Method == http.MethodPost:
+		_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		mr := multipart.NewReader(r.Body, params["boundary"])
+		part, err := mr.NextPart()
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var obj fakeGCSObject
+		if err := json.NewDecoder(part).Decode(&obj); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if part, err = mr.NextPart(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		data, _ := io.ReadAll(part)
+		s.put(&obj, data)
+		_ = json.NewEncoder(w).Encode(obj)
+	case p == "/storage/v1/b/uploads/o":
+		s.list(w, r.URL.Query())
+	case strings.HasPrefix(p, "/storage/v1/b/uploads/o/"):
+		escaped := strings.TrimPrefix(p, "/storage/v1/b/uploads/o/")
+		escaped, compose := strings.CutSuffix(escaped, "/compose")
+		name, _ := url.PathUnescape(escaped)
+		if compose {
+			var req struct {
+				SourceObjects []struct {
+					Name string `json:"name"`
+				} `json:"sourceObjects"`
+				Destination fakeGCSObject `json:"destination"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil || len(req.SourceObjects) > 32 {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			var data []byte
+			for _, source := range req.SourceObjects {
+				obj := s.objects[source.Name]
+				if obj == nil {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				data = append(data, obj.data...)
+			}
+			req.Destination.Name = name
+			s.put(&req.Destination, data)
+			_ = json.NewEncoder(w).Encode(req.Destination)
+			return
+		}
+		obj := s.objects[name]
+		if obj == nil {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = io.WriteString(w, `{"error":{"code":404,"message":"No such object"}}`)
+			return
+		}
+		switch {
+		case r.Method == http.MethodDelete:
+			delete(s.objects, name)
+			w.WriteHeader(http.StatusNoContent)
+		case r.URL.Query().Get("alt") == "media":
+			w.Header().Set("Content-Type", obj.ContentType)
+			_, _ = w.Write(obj.data)
+		default:
+			_ = json.NewEncoder(w).Encode(obj)
+		}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// list uses the last returned name as page token
+func (s *fakeGCS) list(w http.ResponseWriter, query url.Values) {
+	prefix := query.Get("prefix")
+	token := query.Get("pageToken")
+	names := make([]string, 0, len(s.objects))
+	for name := range s.objects {
+		if strings.HasPrefix(name, prefix) && name > token {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	var result struct {
+		Items         []*fakeGCSObject `json:"items,omitempty"`
+		NextPageToken string           `json:"nextPageToken,omitempty"`
+	}
+	if max, _ := strconv.Atoi(query.Get("maxResults")); max > 0 && len(names) > max {
+		names = names[:max]
+		result.NextPageToken = names[max-1]
+	}
+	for _, name := range names {
+		result.Items = append(result.Items, s.objects[name])
+	}
+	_ = json.NewEncoder(w).Encode(result)
+}
+
+func TestGCSStorage(t *testing.T) {
+	fake := &fakeGCS{objects: make(map[string]*fakeGCSObject)}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	storage, err := s3uploadclient.NewGCSStorage(s3uploadclient.GCSOptions{
+		Endpoint:   srv.URL,
+		BucketName: "uploads",
+	})
+	require.NoError(t, err)
+	testStorage(t, storage)
+
+	// Signed URLs require service account credentials
+	_, err = storage.(s3uploadclient.URLSigner).SignedDownloadURL(context.Background(), "video.mp4", time.Minute, "")
+	assert.ErrorIs(t, err, s3uploadclient.ErrNotSupported)
+}
+
+func TestGCSSignedURLs(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	credentials, err := json.Marshal(map[string]string{
+		"type":         "service_account",
+		"project_id":   "test",
+		"private_key":  string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})),
+		"client_email": "uploads@test.iam.gserviceaccount.com",
+		"token_uri":    "https://oauth2.googleapis.com/token",
+	})
+	require.NoError(t, err)
+	storage, err := s3uploadclient.NewGCSStorage(s3uploadclient.GCSOptions{
+		BucketName:      "uploads",
+		CredentialsJSON: string(credentials),
+	})
+	require.NoError(t, err)
+	signer := storage.(s3uploadclient.URLSigner)
+
+	download, err := signer.SignedDownloadURL(context.Background(), "a b.png", time.Minute, `inline; filename="a b.png"`)
+	require.NoError(t, err)
+	u, err := url.Parse(download)
+	require.NoError(t, err)
+	assert.Equal(t, "storage.googleapis.com", u.Host)
+	assert.Equal(t, "/uploads/a%20b.png", u.EscapedPath())
+	query := u.Query()
+	assert.Equal(t, "GOOG4-RSA-SHA256", query.Get("X-Goog-Algorithm"))
+	assert.True(t, strings.HasPrefix(query.Get("X-Goog-Credential"), "uploads@test.iam.gserviceaccount.com/"))
+	assert.Equal(t, "60", query.Get("X-Goog-Expires"))
+	assert.Equal(t, "host", query.Get("X-Goog-SignedHeaders"))
+	assert.Equal(t, `inline; filename="a b.png"`, query.Get("response-content-disposition"))
+	assert.Len(t, query.Get("X-Goog-Signature"), 512)
+
+	upload, headers, err := signer.SignedUploadURL(context.Background(), "a.png", time.Minute, 10, s3uploadclient.PutOptions{
+		ContentType: "image/png",
+		Metadata:    map[string]string{"upload-profile": "avatar"},
+	})
+	require.NoError(t, err)
+	u, err = url.Parse(upload)
+	require.NoError(t, err)
+	assert.Equal(t, "content-type;host;x-goog-content-length-range;x-goog-meta-upload-profile", u.Query().Get("X-Goog-SignedHeaders"))
+	assert.Equal(t, "10,10", headers["x-goog-content-length-range"])
+	assert.Equal(t, "avatar", headers["x-goog-meta-upload-profile"])
+}
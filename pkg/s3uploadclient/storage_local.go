@@ -0,0 +1,362 @@
+package s3uploadclient
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/context"
+)
+
+const (
+	// localMetadataDir stores the attributes of each object as JSON
+	localMetadataDir = ".metadata"
+	// localTempDir stores files being written, before they're renamed
+	localTempDir = ".tmp"
+)
+
+// localStorage stores objects as files in a directory, intended for development
+// and testing. Object attributes are stored in a separate tree under .metadata/,
+// while the parts of multipart uploads are kept under .multipart/<uploadID>/
+// until the upload is completed.
+type localStorage struct {
+	dir string
+}
+
+type localAttributes struct {
+	ContentType string            `json:"contentType,omitempty"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+}
+
+// NewLocalStorage returns a Storage that keeps the objects in the given directory
+func NewLocalStorage(dir string) (Storage, error) {
+	if dir == "" {
+		return nil, errors.New("storage directory is required")
+	}
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	return &localStorage{dir: abs}, nil
+}
+
+// path returns the path for the given key, which can't point outside
+// the directory nor to the directories used internally by the storage
+func (s *localStorage) path(root string, key string) (string, error) {
+	cleaned := path.Clean("/" + key)
+	if cleaned == "/" || strings.HasSuffix(key, "/") || strings.IndexByte(key, 0) >= 0 {
+		return "", fmt.Errorf("invalid key %q", key)
+	}
+	if root == "" {
+		first, _, _ := strings.Cut(cleaned[1:], "/")
+		if first == localMetadataDir || first == localTempDir || first+"/" == multipartPrefix {
+			return "", fmt.Errorf("invalid key %q", key)
+		}
+	}
+	return filepath.Join(s.dir, root, filepath.FromSlash(cleaned)), nil
+}
+
+func (s *localStorage) attributesPath(key string) (string, error) {
+	p, err := s.path(localMetadataDir, key)
+	if err != nil {
+		return "", err
+	}
+	return p + ".json", nil
+}
+
+func (s *localStorage) uploadPath(uploadID string) (string, error) {
+	if uploadID == "" || strings.ContainsAny(uploadID, `/\.`) {
+		return "", fmt.Errorf("%w: invalid upload ID %q", ErrNotFound, uploadID)
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(multipartPrefix), uploadID), nil
+}
+
+// writeFile writes the file atomically, by writing to a temporary
+// file first and then renaming it
+func (s *localStorage) writeFile(p string, data io.Reader, size int64) error {
+	tmp, err := os.CreateTemp(filepath.Join(s.dir, localTempDir), "upload-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+	}()
+	written, err := io.Copy(tmp, data)
+	if err != nil {
+		return err
+	}
+	if size >= 0 && written != size {
+		return fmt.Errorf("expecting %d bytes, got %d", size, written)
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), p)
+}
+
+func (s *localStorage) writeAttributes(key string, opts PutOptions) error {
+	p, err := s.attributesPath(key)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(localAttributes{ContentType: opts.ContentType, Metadata: opts.Metadata})
+	if err != nil {
+		return err
+	}
+	return s.writeFile(p, strings.NewReader(string(data)), int64(len(data)))
+}
+
+func (s *localStorage) Init(ctx context.Context) error {
+	return os.MkdirAll(filepath.Join(s.dir, localTempDir), 0o755)
+}
+
+func (s *localStorage) Put(ctx context.Context, key string, data io.Reader, size int64, opts PutOptions) error {
+	p, err := s.path("", key)
+	if err != nil {
+		return err
+	}
+	if err := s.writeFile(p, data, size); err != nil {
+		return err
+	}
+	return s.writeAttributes(key, opts)
+}
+
+func (s *localStorage) Get(ctx context.Context, key string) (io.ReadCloser, *ObjectInfo, error) {
+	info, err := s.Stat(ctx, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	p, _ := s.path("", key)
+	f, err := os.Open(p)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil, fmt.Errorf("%w: %s", ErrNotFound, key)
+		}
+		return nil, nil, err
+	}
+	return f, info, nil
+}
+
+func (s *localStorage) Stat(ctx context.Context, key string) (*ObjectInfo, error) {
+	p, err := s.path("", key)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, err)
+	}
+	st, err := os.Stat(p)
+	if err != nil || st.IsDir() {
+		if err == nil || errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %s", ErrNotFound, key)
+		}
+		return nil, err
+	}
+	info := s.objectInfo(key, st)
+	attributesPath, _ := s.attributesPath(key)
+	data, err := os.ReadFile(attributesPath)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	if len(data) > 0 {
+		var attributes localAttributes
+		if err := json.Unmarshal(data, &attributes); err != nil {
+			return nil, fmt.Errorf("error decoding attributes for %s: %w", key, err)
+		}
+		if attributes.ContentType != "" {
+			info.ContentType = attributes.ContentType
+		}
+		info.Metadata = attributes.Metadata
+	}
+	if info.Metadata == nil {
+		info.Metadata = make(map[string]string)
+	}
+	return info, nil
+}
+
+func (s *localStorage) objectInfo(key string, st fs.FileInfo) *ObjectInfo {
+	contentType := mime.TypeByExtension(path.Ext(key))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	return &ObjectInfo{
+		Key:          key,
+		Size:         st.Size(),
+		ContentType:  contentType,
+		ETag:         strconv.FormatInt(st.ModTime().UnixNano(), 16) + "-" + strconv.FormatInt(st.Size(), 16),
+		LastModified: st.ModTime(),
+	}
+}
+
+func (s *localStorage) Delete(ctx context.Context, key string) error {
+	p, err := s.path("", key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(p); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	attributesPath, _ := s.attributesPath(key)
+	if err := os.Remove(attributesPath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// List walks the directory containing the prefix, since the files
+// must be sorted by their key rather than by directory
+func (s *localStorage) List(ctx context.Context, prefix string, cursor string, limit int) (*ObjectList, error) {
+	var objects []ObjectInfo
+	root := filepath.Join(s.dir, filepath.FromSlash(path.Dir("/"+prefix)))
+	err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		rel, err := filepath.Rel(s.dir, p)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if d.IsDir() {
+			if key == localMetadataDir || key == localTempDir || key+"/" == multipartPrefix {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasPrefix(key, prefix) || key <= cursor {
+			return nil
+		}
+		st, err := d.Info()
+		if err != nil {
+			return err
+		}
+		objects = append(objects, *s.objectInfo(key, st))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(objects, func(i, j int) bool { return objects[i].Key < objects[j].Key })
+	list := &ObjectList{Objects: objects}
+	if limit > 0 && len(objects) > limit {
+		list.Objects = objects[:limit]
+		list.Cursor = objects[limit-1].Key
+	}
+	return list, nil
+}
+
+func (s *localStorage) NewMultipartUpload(ctx context.Context, key string, opts PutOptions) (string, error) {
+	if _, err := s.path("", key); err != nil {
+		return "", err
+	}
+	uploadID, err := randomID()
+	if err != nil {
+		return "", err
+	}
+	uploadPath, _ := s.uploadPath(uploadID)
+	if err := os.MkdirAll(uploadPath, 0o755); err != nil {
+		return "", err
+	}
+	return uploadID, nil
+}
+
+func (s *localStorage) partPath(uploadID string, partNumber int) (string, error) {
+	uploadPath, err := s.uploadPath(uploadID)
+	if err != nil {
+		return "", err
+	}
+	if _, err := os.Stat(uploadPath); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return "", fmt.Errorf("%w: upload %s", ErrNotFound, uploadID)
+		}
+		return "", err
+	}
+	return filepath.Join(uploadPath, fmt.Sprintf("%05d", partNumber)), nil
+}
+
+func (s *localStorage) UploadPart(ctx context.Context, key string, uploadID string, partNumber int, data io.Reader, size int64) (*Part, error) {
+	p, err := s.partPath(uploadID, partNumber)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.writeFile(p, data, size); err != nil {
+		return nil, err
+	}
+	return &Part{Number: partNumber, Size: size, ETag: strconv.Itoa(partNumber)}, nil
+}
+
+func (s *localStorage) ListParts(ctx context.Context, key string, uploadID string) ([]Part, error) {
+	uploadPath, err := s.uploadPath(uploadID)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(uploadPath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("%w: upload %s", ErrNotFound, uploadID)
+		}
+		return nil, err
+	}
+	parts := make([]Part, 0, len(entries))
+	for _, entry := range entries {
+		number, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+		st, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, Part{Number: number, Size: st.Size(), ETag: strconv.Itoa(number)})
+	}
+	// ReadDir returns the entries sorted by name, which are zero padded
+	return parts, nil
+}
+
+func (s *localStorage) CompleteMultipartUpload(ctx context.Context, key string, uploadID string, parts []Part, opts PutOptions) error {
+	p, err := s.path("", key)
+	if err != nil {
+		return err
+	}
+	files := make([]io.Reader, len(parts))
+	for ii, part := range parts {
+		partPath, err := s.partPath(uploadID, part.Number)
+		if err != nil {
+			return err
+		}
+		f, err := os.Open(partPath)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		files[ii] = f
+	}
+	if err := s.writeFile(p, io.MultiReader(files...), -1); err != nil {
+		return err
+	}
+	if err := s.writeAttributes(key, opts); err != nil {
+		return err
+	}
+	return s.AbortMultipartUpload(ctx, key, uploadID)
+}
+
+func (s *localStorage) AbortMultipartUpload(ctx context.Context, key string, uploadID string) error {
+	uploadPath, err := s.uploadPath(uploadID)
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(uploadPath)
+}
@@ -0,0 +1,272 @@
+package s3uploadclient
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/signer"
+	"golang.org/x/net/context"
+)
+
+const s3MetadataPrefix = "X-Amz-Meta-"
+
+// S3Options configures a storage backed by S3 or any S3 compatible service
+type S3Options struct {
+	Endpoint        string
+	AccessKeyID     string
+	SecretAccessKey string
+	BucketName      string
+	BucketLocation  string
+	UseSSL          bool
+}
+
+type s3Storage struct {
+	client         *minio.Client
+	core           minio.Core
+	credentials    *credentials.Credentials
+	bucketName     string
+	bucketLocation string
+}
+
+// NewS3Storage returns a Storage backed by an S3 bucket
+func NewS3Storage(opts S3Options) (Storage, error) {
+	creds := credentials.NewStaticV4(opts.AccessKeyID, opts.SecretAccessKey, "")
+	client, err := minio.New(opts.Endpoint, &minio.Options{
+		Creds:  creds,
+		Secure: opts.UseSSL,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &s3Storage{
+		client:         client,
+		core:           minio.Core{Client: client},
+		credentials:    creds,
+		bucketName:     opts.BucketName,
+		bucketLocation: opts.BucketLocation,
+	}, nil
+}
+
+// s3Error converts the errors for missing objects and uploads to ErrNotFound
+func s3Error(err error) error {
+	switch minio.ToErrorResponse(err).Code {
+	case "NoSuchKey", "NoSuchUpload":
+		return fmt.Errorf("%w: %s", ErrNotFound, err)
+	}
+	return err
+}
+
+func s3PutOptions(opts PutOptions) minio.PutObjectOptions {
+	return minio.PutObjectOptions{
+		ContentType:  opts.ContentType,
+		UserMetadata: opts.Metadata,
+	}
+}
+
+func s3ObjectInfo(info minio.ObjectInfo) *ObjectInfo {
+	metadata := make(map[string]string)
+	for k, v := range info.Metadata {
+		if len(v) > 0 && strings.HasPrefix(k, s3MetadataPrefix) {
+			metadata[strings.ToLower(k[len(s3MetadataPrefix):])] = v[0]
+		}
+	}
+	return &ObjectInfo{
+		Key:          info.Key,
+		Size:         info.Size,
+		ContentType:  info.ContentType,
+		ETag:         info.ETag,
+		LastModified: info.LastModified,
+		Metadata:     metadata,
+	}
+}
+
+func (s *s3Storage) Init(ctx context.Context) error {
+	exists, err := s.client.BucketExists(ctx, s.bucketName)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return nil
+	}
+	return s.client.MakeBucket(ctx, s.bucketName, minio.MakeBucketOptions{Region: s.bucketLocation})
+}
+
+func (s *s3Storage) Put(ctx context.Context, key string, data io.Reader, size int64, opts PutOptions) error {
+	_, err := s.client.PutObject(ctx, s.bucketName, key, data, size, s3PutOptions(opts))
+	return err
+}
+
+func (s *s3Storage) Get(ctx context.Context, key string) (io.ReadCloser, *ObjectInfo, error) {
+	data, info, _, err := s.core.GetObject(ctx, s.bucketName, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, nil, s3Error(err)
+	}
+	info.Key = key
+	return data, s3ObjectInfo(info), nil
+}
+
+func (s *s3Storage) Stat(ctx context.Context, key string) (*ObjectInfo, error) {
+	info, err := s.client.StatObject(ctx, s.bucketName, key, minio.StatObjectOptions{})
+	if err != nil {
+		return nil, s3Error(err)
+	}
+	return s3ObjectInfo(info), nil
+}
+
+func (s *s3Storage) Delete(ctx context.Context, key string) error {
+	return s.client.RemoveObject(ctx, s.bucketName, key, minio.RemoveObjectOptions{})
+}
+
+func (s *s3Storage) List(ctx context.Context, prefix string, cursor string, limit int) (*ObjectList, error) {
+	result, err := s.core.ListObjectsV2(s.bucketName, prefix, "", cursor, "", limit)
+	if err != nil {
+		return nil, err
+	}
+	list := &ObjectList{
+		Objects: make([]ObjectInfo, len(result.Contents)),
+	}
+	for ii, obj := range result.Contents {
+		list.Objects[ii] = *s3ObjectInfo(obj)
+	}
+	if result.IsTruncated {
+		list.Cursor = result.NextContinuationToken
+	}
+	return list, nil
+}
+
+func (s *s3Storage) NewMultipartUpload(ctx context.Context, key string, opts PutOptions) (string, error) {
+	return s.core.NewMultipartUpload(ctx, s.bucketName, key, s3PutOptions(opts))
+}
+
+func (s *s3Storage) UploadPart(ctx context.Context, key string, uploadID string, partNumber int, data io.Reader, size int64) (*Part, error) {
+	part, err := s.core.PutObjectPart(ctx, s.bucketName, key, uploadID, partNumber, data, size, "", "", nil)
+	if err != nil {
+		return nil, s3Error(err)
+	}
+	return &Part{Number: part.PartNumber, Size: part.Size, ETag: part.ETag}, nil
+}
+
+func (s *s3Storage) ListParts(ctx context.Context, key string, uploadID string) ([]Part, error) {
+	var parts []Part
+	marker := 0
+	for {
+		result, err := s.core.ListObjectParts(ctx, s.bucketName, key, uploadID, marker, 1000)
+		if err != nil {
+			return nil, s3Error(err)
+		}
+		for _, part := range result.ObjectParts {
+			parts = append(parts, Part{Number: part.PartNumber, Size: part.Size, ETag: part.ETag})
+		}
+		if !result.IsTruncated {
+			break
+		}
+		marker = result.NextPartNumberMarker
+	}
+	sort.Slice(parts, func(i, j int) bool { return parts[i].Number < parts[j].Number })
+	return parts, nil
+}
+
+func (s *s3Storage) CompleteMultipartUpload(ctx context.Context, key string, uploadID string, parts []Part, opts PutOptions) error {
+	completeParts := make([]minio.CompletePart, len(parts))
+	for ii, part := range parts {
+		completeParts[ii] = minio.CompletePart{PartNumber: part.Number, ETag: part.ETag}
+	}
+	// Options were already set when the upload was created
+	_, err := s.core.CompleteMultipartUpload(ctx, s.bucketName, key, uploadID, completeParts, minio.PutObjectOptions{})
+	return s3Error(err)
+}
+
+func (s *s3Storage) AbortMultipartUpload(ctx context.Context, key string, uploadID string) error {
+	if err := s.core.AbortMultipartUpload(ctx, s.bucketName, key, uploadID); err != nil && minio.ToErrorResponse(err).Code != "NoSuchUpload" {
+		return err
+	}
+	return nil
+}
+
+func (s *s3Storage) SignedDownloadURL(ctx context.Context, key string, expires time.Duration, contentDisposition string) (string, error) {
+	reqParams := make(url.Values)
+	if contentDisposition != "" {
+		reqParams.Set("response-content-disposition", contentDisposition)
+	}
+	u, err := s.client.PresignedGetObject(ctx, s.bucketName, key, expires, reqParams)
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
+
+// SignedUploadURL returns a presigned PUT request. Besides the URL, the signature covers
+// the Content-Type, Content-Length and metadata headers, so the request is rejected
+// by S3 if any of them don't match.
+func (s *s3Storage) SignedUploadURL(ctx context.Context, key string, expires time.Duration, size int64, opts PutOptions) (string, map[string]string, error) {
+	u, err := s.client.PresignedPutObject(ctx, s.bucketName, key, expires)
+	if err != nil {
+		return "", nil, err
+	}
+	location, err := s.client.GetBucketLocation(ctx, s.bucketName)
+	if err != nil {
+		return "", nil, err
+	}
+	creds, err := s.credentials.Get()
+	if err != nil {
+		return "", nil, err
+	}
+	// Sign the request again, including the headers
+	u.RawQuery = ""
+	headers := map[string]string{
+		"Content-Type":   opts.ContentType,
+		"Content-Length": strconv.FormatInt(size, 10),
+	}
+	for k, v := range opts.Metadata {
+		headers[s3MetadataPrefix+k] = v
+	}
+	signReq := http.Request{
+		Method: http.MethodPut,
+		URL:    u,
+		Host:   u.Host,
+		Header: make(http.Header, len(headers)),
+	}
+	for k, v := range headers {
+		signReq.Header.Set(k, v)
+	}
+	signed := signer.PreSignV4(signReq, creds.AccessKeyID, creds.SecretAccessKey, creds.SessionToken, location, int64(expires/time.Second))
+	return signed.URL.String(), headers, nil
+}
+
+// SignedPostPolicy returns a presigned POST policy, restricting the key, content type and size
+func (s *s3Storage) SignedPostPolicy(ctx context.Context, key string, expiresAt time.Time, maxSize int64, opts PutOptions) (string, map[string]string, error) {
+	policy := minio.NewPostPolicy()
+	if err := policy.SetBucket(s.bucketName); err != nil {
+		return "", nil, err
+	}
+	if err := policy.SetKey(key); err != nil {
+		return "", nil, err
+	}
+	if err := policy.SetExpires(expiresAt.UTC()); err != nil {
+		return "", nil, err
+	}
+	if err := policy.SetContentType(opts.ContentType); err != nil {
+		return "", nil, err
+	}
+	if err := policy.SetContentLengthRange(1, maxSize); err != nil {
+		return "", nil, err
+	}
+	for k, v := range opts.Metadata {
+		if err := policy.SetUserMetadata(k, v); err != nil {
+			return "", nil, err
+		}
+	}
+	u, fields, err := s.client.PresignedPostPolicy(ctx, policy)
+	if err != nil {
+		return "", nil, err
+	}
+	return u.String(), fields, nil
+}
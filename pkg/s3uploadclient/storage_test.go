@@ -0,0 +1,179 @@
+package s3uploadclient_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wundergraph/wundergraph/pkg/authentication"
+	"github.com/wundergraph/wundergraph/pkg/s3uploadclient"
+)
+
+func listKeys(t *testing.T, storage s3uploadclient.Storage, prefix string, limit int) []string {
+	var keys []string
+	cursor := ""
+	for {
+		list, err := storage.List(context.Background(), prefix, cursor, limit)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, len(list.Objects), limit)
+		for _, obj := range list.Objects {
+			keys = append(keys, obj.Key)
+		}
+		if list.Cursor == "" {
+			return keys
+		}
+		cursor = list.Cursor
+	}
+}
+
+// testStorage verifies the behavior shared by all the storages
+func testStorage(t *testing.T, storage s3uploadclient.Storage) {
+	ctx := context.Background()
+	require.NoError(t, storage.Init(ctx))
+	// Must be idempotent
+	require.NoError(t, storage.Init(ctx))
+
+	_, err := storage.Stat(ctx, "missing.txt")
+	assert.True(t, errors.Is(err, s3uploadclient.ErrNotFound), "unexpected error %v", err)
+	_, _, err = storage.Get(ctx, "missing.txt")
+	assert.True(t, errors.Is(err, s3uploadclient.ErrNotFound), "unexpected error %v", err)
+
+	opts := s3uploadclient.PutOptions{
+		ContentType: "text/plain",
+		Metadata: map[string]string{
+			"original-filename": "hello%20world.txt",
+			"upload-profile":    "avatar",
+		},
+	}
+	require.NoError(t, storage.Put(ctx, "files/hello.txt", strings.NewReader("hello"), 5, opts))
+	info, err := storage.Stat(ctx, "files/hello.txt")
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), info.Size)
+	assert.Equal(t, "text/plain", info.ContentType)
+	assert.Equal(t, opts.Metadata, info.Metadata)
+	assert.NotEmpty(t, info.ETag)
+
+	data, info, err := storage.Get(ctx, "files/hello.txt")
+	require.NoError(t, err)
+	contents, err := io.ReadAll(data)
+	require.NoError(t, data.Close())
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(contents))
+	assert.Equal(t, "avatar", info.Metadata["upload-profile"])
+
+	require.NoError(t, storage.Put(ctx, "empty.txt", bytes.NewReader(nil), 0, s3uploadclient.PutOptions{ContentType: "text/plain"}))
+	for _, key := range []string{"files/a.txt", "files/b.txt", "files/c.txt"} {
+		require.NoError(t, storage.Put(ctx, key, strings.NewReader(key), int64(len(key)), s3uploadclient.PutOptions{}))
+	}
+	assert.Equal(t, []string{"files/a.txt", "files/b.txt", "files/c.txt", "files/hello.txt"}, listKeys(t, storage, "files/", 3))
+	assert.Equal(t, []string{"empty.txt", "files/a.txt", "files/b.txt", "files/c.txt", "files/hello.txt"}, listKeys(t, storage, "", 2))
+
+	require.NoError(t, storage.Delete(ctx, "files/b.txt"))
+	require.NoError(t, storage.Delete(ctx, "files/b.txt"))
+	_, err = storage.Stat(ctx, "files/b.txt")
+	assert.True(t, errors.Is(err, s3uploadclient.ErrNotFound), "unexpected error %v", err)
+
+	// Multipart uploads
+	uploadID, err := storage.NewMultipartUpload(ctx, "video.mp4", s3uploadclient.PutOptions{
+		ContentType: "video/mp4",
+		Metadata:    map[string]string{"uploaded-by": "github%3A1"},
+	})
+	require.NoError(t, err)
+	parts, err := storage.ListParts(ctx, "video.mp4", uploadID)
+	require.NoError(t, err)
+	assert.Empty(t, parts)
+	for ii, chunk := range []string{"first-", "second-", "third"} {
+		part, err := storage.UploadPart(ctx, "video.mp4", uploadID, ii+1, strings.NewReader(chunk), int64(len(chunk)))
+		require.NoError(t, err)
+		assert.Equal(t, ii+1, part.Number)
+	}
+	parts, err = storage.ListParts(ctx, "video.mp4", uploadID)
+	require.NoError(t, err)
+	require.Len(t, parts, 3)
+	assert.Equal(t, []int64{6, 7, 5}, []int64{parts[0].Size, parts[1].Size, parts[2].Size})
+	_, err = storage.Stat(ctx, "video.mp4")
+	assert.True(t, errors.Is(err, s3uploadclient.ErrNotFound), "unexpected error %v", err)
+
+	require.NoError(t, storage.CompleteMultipartUpload(ctx, "video.mp4", uploadID, parts, s3uploadclient.PutOptions{
+		ContentType: "video/mp4",
+		Metadata:    map[string]string{"uploaded-by": "github%3A1"},
+	}))
+	data, info, err = storage.Get(ctx, "video.mp4")
+	require.NoError(t, err)
+	contents, err = io.ReadAll(data)
+	require.NoError(t, data.Close())
+	require.NoError(t, err)
+	assert.Equal(t, "first-second-third", string(contents))
+	assert.Equal(t, "video/mp4", info.ContentType)
+	assert.Equal(t, "github%3A1", info.Metadata["uploaded-by"])
+
+	// Parts must not be visible once the upload completes
+	for _, key := range listKeys(t, storage, "", 100) {
+		assert.False(t, strings.HasPrefix(key, ".multipart/"), "unexpected key %s", key)
+	}
+
+	uploadID, err = storage.NewMultipartUpload(ctx, "aborted.bin", s3uploadclient.PutOptions{})
+	require.NoError(t, err)
+	_, err = storage.UploadPart(ctx, "aborted.bin", uploadID, 1, strings.NewReader("data"), 4)
+	require.NoError(t, err)
+	require.NoError(t, storage.AbortMultipartUpload(ctx, "aborted.bin", uploadID))
+	_, err = storage.Stat(ctx, "aborted.bin")
+	assert.True(t, errors.Is(err, s3uploadclient.ErrNotFound), "unexpected error %v", err)
+}
+
+func TestLocalStorage(t *testing.T) {
+	storage, err := s3uploadclient.NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+	testStorage(t, storage)
+
+	// Keys can't escape the directory nor overwrite the attributes
+	ctx := context.Background()
+	assert.Error(t, storage.Put(ctx, ".metadata/files/hello.txt.json", strings.NewReader("{}"), 2, s3uploadclient.PutOptions{}))
+	require.NoError(t, storage.Put(ctx, "../../outside.txt", strings.NewReader("data"), 4, s3uploadclient.PutOptions{}))
+	assert.Contains(t, listKeys(t, storage, "", 100), "outside.txt")
+}
+
+func TestS3Storage(t *testing.T) {
+	storage, _ := newTestS3Storage(t)
+	testStorage(t, storage)
+}
+
+func TestUploadsWithLocalStorage(t *testing.T) {
+	storage, err := s3uploadclient.NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+	client := newTestClient(t, storage, &s3uploadclient.UploadProfile{MaxFileSizeBytes: 1024, EnableFiles: true}, nil)
+	user := &authentication.User{ProviderID: "github", UserID: "1"}
+
+	tusHandler := client.TusHandler(testTusPath)
+	location := createTusUpload(t, tusHandler, user, 5)
+	resp := patchTusUpload(tusHandler, location, user, 0, []byte("video"))
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	list, err := storage.List(context.Background(), "", "", 10)
+	require.NoError(t, err)
+	var key string
+	for _, obj := range list.Objects {
+		if !strings.HasPrefix(obj.Key, ".tus/") {
+			key = obj.Key
+		}
+	}
+	require.NotEmpty(t, key)
+
+	// Downloads are proxied, since local storage can't presign URLs
+	resp = doFilesRequest(client.FilesHandler(testFilesPath), http.MethodGet, testFilesPath+"/"+key, user)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "video", string(data))
+	assert.Equal(t, "video/mp4", resp.Header.Get("Content-Type"))
+
+	resp = doPresignRequest(t, client.PresignUpload, user, s3uploadclient.PresignRequest{Name: "a.png", Size: 4, Type: "image/png"})
+	assert.Equal(t, http.StatusNotImplemented, resp.StatusCode)
+}
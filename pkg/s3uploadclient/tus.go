@@ -0,0 +1,559 @@
+package s3uploadclient
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+
+	"github.com/wundergraph/wundergraph/internal/detached"
+)
+
+// Resumable uploads implement the tus 1.0 protocol (https://tus.io/protocols/resumable-upload)
+// with the creation and termination extensions, using the storage multipart uploads. The state
+// of each upload is stored in the bucket under tusPrefix, so uploads can be resumed after the
+// node restarts: <id>.info contains the upload information while <id>.part holds the bytes
+// received after the last complete part, since S3 parts must be at least 5MB.
+
+const (
+	TusVersion    = "1.0.0"
+	tusExtensions = "creation,termination"
+	// tusPrefix is the prefix for the objects used to track resumable uploads
+	tusPrefix = ".tus/"
+	// tusMinPartSize is the minimum size of S3 multipart parts, except for the last one
+	tusMinPartSize = 5 * 1024 * 1024 // 5MB
+	// tusMaxParts is the maximum number of parts in an S3 multipart upload
+	tusMaxParts = 10000
+	// tusMaxPartSize is the maximum size of the parts, which are buffered in memory
+	tusMaxPartSize = 64 * 1024 * 1024 // 64MB
+	// MaxTusUploadSize is the maximum size for resumable uploads, given by the
+	// maximum part size and number of parts
+	MaxTusUploadSize = tusMaxParts * tusMaxPartSize // 625GB
+
+	// tusStoreTimeout limits how long storing the data received in a request
+	// can take once the client is gone
+	tusStoreTimeout = 5 * time.Minute
+
+	tusOffsetContentType = "application/offset+octet-stream"
+)
+
+// tusUpload contains the information about a resumable upload
+type tusUpload struct {
+	ID                string `json:"id"`
+	Key               string `json:"key"`
+	MultipartUploadID string `json:"multipartUploadId"`
+	Size              int64  `json:"size"`
+	PartSize          int64  `json:"partSize"`
+	FileName          string `json:"fileName"`
+	FileType          string `json:"fileType"`
+	Profile           string `json:"profile"`
+	Metadata          string `json:"metadata"`
+	UploadedBy        string `json:"uploadedBy"`
+	Completed         bool   `json:"completed"`
+}
+
+func (u *tusUpload) infoObject() string {
+	return tusPrefix + u.ID + ".info"
+}
+
+func (u *tusUpload) partObject() string {
+	return tusPrefix + u.ID + ".part"
+}
+
+func (u *tusUpload) putOptions() PutOptions {
+	return PutOptions{
+		ContentType: u.FileType,
+		Metadata: objectMetadata(map[string]string{
+			"metadata":            u.Metadata,
+			"original-filename":   u.FileName,
+			"original-extension":  filepath.Ext(u.FileName),
+			"original-size":       strconv.FormatInt(u.Size, 10),
+			uploadProfileMetadata: u.Profile,
+			uploadedByMetadata:    u.UploadedBy,
+		}),
+	}
+}
+
+func (u *tusUpload) hookFile() *hookFile {
+	return &hookFile{
+		Name:     u.FileName,
+		Size:     u.Size,
+		MimeType: u.FileType,
+	}
+}
+
+// tusLocks serializes the requests for the same upload within this node
+type tusLocks struct {
+	mu    sync.Mutex
+	locks map[string]*tusLock
+}
+
+type tusLock struct {
+	sync.Mutex
+	refs int
+}
+
+func (l *tusLocks) lock(id string) func() {
+	l.mu.Lock()
+	if l.locks == nil {
+		l.locks = make(map[string]*tusLock)
+	}
+	lock := l.locks[id]
+	if lock == nil {
+		lock = &tusLock{}
+		l.locks[id] = lock
+	}
+	lock.refs++
+	l.mu.Unlock()
+
+	lock.Lock()
+	return func() {
+		lock.Unlock()
+		l.mu.Lock()
+		lock.refs--
+		if lock.refs == 0 {
+			delete(l.locks, id)
+		}
+		l.mu.Unlock()
+	}
+}
+
+// TusHandler returns a handler implementing resumable uploads, which
+// must be mounted at basePath
+func (s *S3UploadClient) TusHandler(basePath string) http.Handler {
+	basePath = strings.TrimSuffix(basePath, "/")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Tus-Resumable", TusVersion)
+		method := r.Method
+		if override := r.Header.Get("X-HTTP-Method-Override"); override != "" && method == http.MethodPost {
+			method = override
+		}
+		if method == http.MethodOptions {
+			s.tusOptions(w, r)
+			return
+		}
+		if r.Header.Get("Tus-Resumable") != TusVersion {
+			w.Header().Set("Tus-Version", TusVersion)
+			w.WriteHeader(http.StatusPreconditionFailed)
+			return
+		}
+		id := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, basePath), "/")
+		if id == "" {
+			if method != http.MethodPost {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			s.tusCreate(w, r)
+			return
+		}
+		if strings.Contains(id, "/") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		unlock := s.tusLocks.lock(id)
+		defer unlock()
+		upload, err := s.loadTusUpload(r.Context(), id)
+		if err != nil {
+			if errors.Is(err, ErrNotFound) {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			s.tusError(w, "loading upload", err)
+			return
+		}
+		if upload.UploadedBy != uploaderID(r) {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		switch method {
+		case http.MethodHead:
+			s.tusHead(w, r, upload)
+		case http.MethodPatch:
+			s.tusPatch(w, r, upload)
+		case http.MethodDelete:
+			s.tusDelete(w, r, upload)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+func (s *S3UploadClient) tusError(w http.ResponseWriter, msg string, err error) {
+	if s.logger != nil {
+		s.logger.Error(msg, zap.String("provider", s.name), zap.Error(err))
+	}
+	http.Error(w, msg, http.StatusInternalServerError)
+}
+
+// tusMaxSize returns the maximum size for resumable uploads with the given profile
+// or -1 if it doesn't define one, in which case resumable uploads are rejected
+func (s *S3UploadClient) tusMaxSize(profile *preparedProfile) int64 {
+	if profile == nil || profile.MaxFileSizeBytes < 0 {
+		return -1
+	}
+	if int64(profile.MaxFileSizeBytes) > MaxTusUploadSize {
+		return MaxTusUploadSize
+	}
+	return int64(profile.MaxFileSizeBytes)
+}
+
+func (s *S3UploadClient) tusOptions(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Tus-Version", TusVersion)
+	w.Header().Set("Tus-Extension", tusExtensions)
+	_, profile, _ := s.uploadProfile(r)
+	if maxSize := s.tusMaxSize(profile); maxSize >= 0 {
+		w.Header().Set("Tus-Max-Size", strconv.FormatInt(maxSize, 10))
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// tusCreate implements the creation extension. The file is validated against the upload
+// profile and the preUpload hook is run before the multipart upload is started.
+func (s *S3UploadClient) tusCreate(w http.ResponseWriter, r *http.Request) {
+	if !s.hasRequiredAuthentication(w, r) {
+		return
+	}
+	if r.Header.Get("Upload-Defer-Length") != "" {
+		http.Error(w, "Upload-Defer-Length is not supported", http.StatusBadRequest)
+		return
+	}
+	size, err := strconv.ParseInt(r.Header.Get("Upload-Length"), 10, 64)
+	if err != nil || size < 0 {
+		http.Error(w, "invalid Upload-Length", http.StatusBadRequest)
+		return
+	}
+	uploadMetadata, err := parseTusMetadata(r.Header.Get("Upload-Metadata"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	profileName, profile, err := s.uploadProfile(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := requireRegularUploads(profileName, profile); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	maxSize := s.tusMaxSize(profile)
+	if maxSize < 0 {
+		http.Error(w, "resumable uploads require a profile with a maximum file size", http.StatusBadRequest)
+		return
+	}
+	if size > maxSize {
+		http.Error(w, fmt.Sprintf("file with %d bytes exceeds the %d maximum", size, maxSize), http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	upload := &tusUpload{
+		Size:       size,
+		PartSize:   tusPartSize(size),
+		FileName:   uploadMetadata["filename"],
+		FileType:   uploadMetadata["filetype"],
+		Profile:    profileName,
+		Metadata:   fileMetadataFromRequest(r),
+		UploadedBy: uploaderID(r),
+	}
+	if upload.FileType == "" {
+		upload.FileType = "application/octet-stream"
+	}
+	if profile != nil {
+		upload.Key, err = s.checkUpload(r.Context(), r, profileName, profile, upload.hookFile())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	if upload.Key == "" {
+		upload.Key, err = randomFileKey(upload.FileName)
+		if err != nil {
+			s.tusError(w, "generating file key", err)
+			return
+		}
+	}
+	upload.ID, err = randomID()
+	if err != nil {
+		s.tusError(w, "generating upload ID", err)
+		return
+	}
+
+	if upload.Size == 0 {
+		// S3 multipart uploads can't be empty, so there's nothing to resume
+		uploadError := s.storage.Put(r.Context(), upload.Key, bytes.NewReader(nil), 0, upload.putOptions())
+		if uploadError == nil {
+			upload.Completed = true
+			uploadError = s.saveTusUpload(r.Context(), upload)
+		}
+		if err := s.runPostUploadHook(r.Context(), r, profileName, upload.hookFile(), upload.Metadata, uploadError); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if uploadError != nil {
+			s.tusError(w, "uploading empty file", uploadError)
+			return
+		}
+	} else {
+		upload.MultipartUploadID, err = s.storage.NewMultipartUpload(r.Context(), upload.Key, upload.putOptions())
+		if err != nil {
+			s.tusError(w, "creating multipart upload", err)
+			return
+		}
+		if err := s.saveTusUpload(r.Context(), upload); err != nil {
+			s.tusError(w, "saving upload", err)
+			return
+		}
+	}
+
+	w.Header().Set("Location", path.Join(r.URL.Path, upload.ID))
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (s *S3UploadClient) tusHead(w http.ResponseWriter, r *http.Request, upload *tusUpload) {
+	offset, _, _, err := s.tusOffset(r.Context(), upload)
+	if err != nil {
+		s.tusError(w, "retrieving upload offset", err)
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Upload-Offset", strconv.FormatInt(offset, 10))
+	w.Header().Set("Upload-Length", strconv.FormatInt(upload.Size, 10))
+	w.WriteHeader(http.StatusOK)
+}
+
+// tusPatch appends the request body to the upload. Whole parts are uploaded to the storage
+// and the remainder is stored until the next request. Bytes received before the
+// connection is interrupted are kept, so the client can resume from there.
+func (s *S3UploadClient) tusPatch(w http.ResponseWriter, r *http.Request, upload *tusUpload) {
+	if r.Header.Get("Content-Type") != tusOffsetContentType {
+		w.WriteHeader(http.StatusUnsupportedMediaType)
+		return
+	}
+	requestOffset, err := strconv.ParseInt(r.Header.Get("Upload-Offset"), 10, 64)
+	if err != nil || requestOffset < 0 {
+		http.Error(w, "invalid Upload-Offset", http.StatusBadRequest)
+		return
+	}
+	offset, parts, pending, err := s.tusOffset(r.Context(), upload)
+	if err != nil {
+		s.tusError(w, "retrieving upload offset", err)
+		return
+	}
+	if requestOffset != offset || upload.Completed {
+		w.WriteHeader(http.StatusConflict)
+		return
+	}
+	if r.ContentLength > upload.Size-offset {
+		http.Error(w, "request exceeds the upload length", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	// The request context is canceled when the client disconnects, but the data
+	// received until then must still be stored so the upload can be resumed
+	ctx, cancel := detached.WithTimeout(r.Context(), tusStoreTimeout)
+	defer cancel()
+	body := io.LimitReader(r.Body, upload.Size-offset)
+	var data io.Reader = body
+	if pending > 0 {
+		pendingData, _, err := s.storage.Get(r.Context(), upload.partObject())
+		if err != nil {
+			s.tusError(w, "retrieving pending part", err)
+			return
+		}
+		defer pendingData.Close()
+		data = io.MultiReader(pendingData, body)
+	}
+
+	uploaded := offset - pending
+	buf := make([]byte, upload.PartSize)
+	for {
+		n, readErr := io.ReadFull(data, buf)
+		if n == 0 {
+			break
+		}
+		complete := uploaded+int64(n) == upload.Size
+		if int64(n) < upload.PartSize && !complete {
+			// Not enough data for a part, keep it until the next request
+			if err := s.storage.Put(ctx, upload.partObject(), bytes.NewReader(buf[:n]), int64(n), PutOptions{}); err != nil {
+				s.tusError(w, "storing pending part", err)
+				return
+			}
+			pending = int64(n)
+			uploaded += int64(n)
+			break
+		}
+		part, err := s.storage.UploadPart(ctx, upload.Key, upload.MultipartUploadID, len(parts)+1, bytes.NewReader(buf[:n]), int64(n))
+		if err != nil {
+			s.tusError(w, "uploading part", err)
+			return
+		}
+		parts = append(parts, *part)
+		uploaded += int64(n)
+		if pending > 0 {
+			// The pending data is now part of the upload
+			if err := s.storage.Delete(ctx, upload.partObject()); err != nil {
+				s.tusError(w, "removing pending part", err)
+				return
+			}
+			pending = 0
+		}
+		if readErr != nil {
+			break
+		}
+	}
+
+	if uploaded == upload.Size {
+		if err := s.completeTusUpload(ctx, r, upload, parts); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	w.Header().Set("Upload-Offset", strconv.FormatInt(uploaded, 10))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *S3UploadClient) completeTusUpload(ctx context.Context, r *http.Request, upload *tusUpload, parts []Part) error {
+	file := upload.hookFile()
+	uploadError := s.storage.CompleteMultipartUpload(ctx, upload.Key, upload.MultipartUploadID, parts, upload.putOptions())
+	if profile := s.profiles[upload.Profile]; uploadError == nil && profile != nil {
+		var err error
+		uploadError, err = s.checkStoredFile(ctx, profile, upload.Key, file)
+		if err == nil && uploadError != nil {
+			// The contents don't match the profile, so the upload can't be resumed
+			err = s.storage.Delete(ctx, upload.Key)
+			if err == nil {
+				err = s.removeTusUpload(ctx, upload)
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if uploadError == nil {
+		upload.Completed = true
+		uploadError = s.saveTusUpload(ctx, upload)
+	}
+	if err := s.runPostUploadHook(ctx, r, upload.Profile, file, upload.Metadata, uploadError); err != nil {
+		return err
+	}
+	if uploadError != nil {
+		return fmt.Errorf("error completing upload: %w", uploadError)
+	}
+	return nil
+}
+
+// tusDelete implements the termination extension
+func (s *S3UploadClient) tusDelete(w http.ResponseWriter, r *http.Request, upload *tusUpload) {
+	ctx := r.Context()
+	if !upload.Completed {
+		if err := s.storage.AbortMultipartUpload(ctx, upload.Key, upload.MultipartUploadID); err != nil {
+			s.tusError(w, "aborting multipart upload", err)
+			return
+		}
+	}
+	if err := s.removeTusUpload(ctx, upload); err != nil {
+		s.tusError(w, "removing upload", err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// removeTusUpload deletes the objects used to track the upload
+func (s *S3UploadClient) removeTusUpload(ctx context.Context, upload *tusUpload) error {
+	for _, object := range []string{upload.partObject(), upload.infoObject()} {
+		if err := s.storage.Delete(ctx, object); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// tusOffset returns the number of bytes received for the upload, as well as the
+// parts already uploaded to the storage and the size of the pending data
+func (s *S3UploadClient) tusOffset(ctx context.Context, upload *tusUpload) (offset int64, parts []Part, pending int64, err error) {
+	if upload.Completed {
+		return upload.Size, nil, 0, nil
+	}
+	parts, err = s.storage.ListParts(ctx, upload.Key, upload.MultipartUploadID)
+	if err != nil {
+		return 0, nil, 0, err
+	}
+	for _, part := range parts {
+		offset += part.Size
+	}
+	info, err := s.storage.Stat(ctx, upload.partObject())
+	if err != nil {
+		if !errors.Is(err, ErrNotFound) {
+			return 0, nil, 0, err
+		}
+	} else {
+		pending = info.Size
+	}
+	return offset + pending, parts, pending, nil
+}
+
+func (s *S3UploadClient) loadTusUpload(ctx context.Context, id string) (*tusUpload, error) {
+	upload := &tusUpload{ID: id}
+	data, _, err := s.storage.Get(ctx, upload.infoObject())
+	if err != nil {
+		return nil, err
+	}
+	defer data.Close()
+	if err := json.NewDecoder(data).Decode(upload); err != nil {
+		return nil, err
+	}
+	return upload, nil
+}
+
+func (s *S3UploadClient) saveTusUpload(ctx context.Context, upload *tusUpload) error {
+	data, err := json.Marshal(upload)
+	if err != nil {
+		return err
+	}
+	return s.storage.Put(ctx, upload.infoObject(), bytes.NewReader(data), int64(len(data)), PutOptions{
+		ContentType: "application/json",
+	})
+}
+
+// tusPartSize returns the part size for an upload, making sure
+// it fits within the maximum number of parts
+func tusPartSize(size int64) int64 {
+	partSize := int64(tusMinPartSize)
+	if minSize := (size + tusMaxParts - 1) / tusMaxParts; minSize > partSize {
+		partSize = minSize
+	}
+	return partSize
+}
+
+// parseTusMetadata decodes the Upload-Metadata header, which contains comma
+// separated key value pairs with base64 encoded values
+func parseTusMetadata(header string) (map[string]string, error) {
+	metadata := make(map[string]string)
+	if header == "" {
+		return metadata, nil
+	}
+	for _, pair := range strings.Split(header, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(pair), " ")
+		if key == "" {
+			return nil, errors.New("invalid Upload-Metadata")
+		}
+		decoded, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Upload-Metadata value for %s", key)
+		}
+		metadata[key] = string(decoded)
+	}
+	return metadata, nil
+}
@@ -0,0 +1,223 @@
+package s3uploadclient_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wundergraph/wundergraph/pkg/authentication"
+	"github.com/wundergraph/wundergraph/pkg/s3uploadclient"
+)
+
+const testTusPath = "/s3/test/tus"
+
+func doTusRequest(handler http.Handler, method string, path string, user *authentication.User, headers map[string]string, body io.Reader) *http.Response {
+	req := httptest.NewRequest(method, path, body)
+	req.Header.Set("Tus-Resumable", s3uploadclient.TusVersion)
+	req.Header.Set("X-Upload-Profile", "avatar")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	if user != nil {
+		req = req.WithContext(context.WithValue(req.Context(), "user", user))
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec.Result()
+}
+
+func createTusUpload(t *testing.T, handler http.Handler, user *authentication.User, size int) string {
+	resp := doTusRequest(handler, http.MethodPost, testTusPath, user, map[string]string{
+		"Upload-Length":   strconv.Itoa(size),
+		"Upload-Metadata": "filename " + base64.StdEncoding.EncodeToString([]byte("video.mp4")) + ",filetype " + base64.StdEncoding.EncodeToString([]byte("video/mp4")),
+	}, nil)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	location := resp.Header.Get("Location")
+	require.NotEmpty(t, location)
+	return location
+}
+
+func patchTusUpload(handler http.Handler, location string, user *authentication.User, offset int, data []byte) *http.Response {
+	return doTusRequest(handler, http.MethodPatch, location, user, map[string]string{
+		"Content-Type":  "application/offset+octet-stream",
+		"Upload-Offset": strconv.Itoa(offset),
+	}, bytes.NewReader(data))
+}
+
+func TestTusResumableUpload(t *testing.T) {
+	client, storage := newTestS3Client(t, &s3uploadclient.UploadProfile{
+		MaxFileSizeBytes: 16 * 1024 * 1024,
+		AllowedMimeTypes: []string{"video/*"},
+	}, nil)
+	handler := client.TusHandler(testTusPath)
+
+	resp := doTusRequest(handler, http.MethodOptions, testTusPath, nil, nil, nil)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Equal(t, "creation,termination", resp.Header.Get("Tus-Extension"))
+	assert.Equal(t, strconv.Itoa(16*1024*1024), resp.Header.Get("Tus-Max-Size"))
+
+	resp = doTusRequest(handler, http.MethodPost, testTusPath, nil, map[string]string{"Tus-Resumable": "0.2.2", "Upload-Length": "1"}, nil)
+	assert.Equal(t, http.StatusPreconditionFailed, resp.StatusCode)
+
+	resp = doTusRequest(handler, http.MethodPost, testTusPath, nil, map[string]string{"Upload-Length": strconv.Itoa(32 * 1024 * 1024)}, nil)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, resp.StatusCode)
+
+	data := make([]byte, 6*1024*1024+100)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	user := &authentication.User{ProviderID: "github", UserID: "1"}
+	location := createTusUpload(t, handler, user, len(data))
+
+	// Less than a part, must be stored until the next request
+	first := 3 * 1024 * 1024
+	resp = patchTusUpload(handler, location, user, 0, data[:first])
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Equal(t, strconv.Itoa(first), resp.Header.Get("Upload-Offset"))
+
+	resp = doTusRequest(handler, http.MethodHead, location, user, nil, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, strconv.Itoa(first), resp.Header.Get("Upload-Offset"))
+	assert.Equal(t, strconv.Itoa(len(data)), resp.Header.Get("Upload-Length"))
+
+	resp = doTusRequest(handler, http.MethodHead, location, &authentication.User{ProviderID: "github", UserID: "2"}, nil, nil)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	resp = patchTusUpload(handler, location, user, 0, data)
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+
+	resp = patchTusUpload(handler, location, user, first, data[first:])
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Equal(t, strconv.Itoa(len(data)), resp.Header.Get("Upload-Offset"))
+
+	var uploaded *fakeS3Object
+	for key, obj := range storage.objects {
+		if key != "" && bytes.Equal(obj.data, data) {
+			uploaded = obj
+		}
+	}
+	require.NotNil(t, uploaded, "uploaded file not found")
+	assert.Equal(t, "video/mp4", uploaded.header.Get("Content-Type"))
+	assert.Equal(t, "video.mp4", uploaded.header.Get("X-Amz-Meta-Original-Filename"))
+
+	resp = doTusRequest(handler, http.MethodHead, location, user, nil, nil)
+	assert.Equal(t, strconv.Itoa(len(data)), resp.Header.Get("Upload-Offset"))
+}
+
+// interruptedReader returns its data and then fails, canceling the request
+// like net/http does when the client disconnects
+type interruptedReader struct {
+	data   *bytes.Reader
+	cancel context.CancelFunc
+}
+
+func (r *interruptedReader) Read(p []byte) (int, error) {
+	if r.data.Len() == 0 {
+		r.cancel()
+		return 0, io.ErrUnexpectedEOF
+	}
+	return r.data.Read(p)
+}
+
+func TestTusInterruptedPatch(t *testing.T) {
+	client, _ := newTestS3Client(t, &s3uploadclient.UploadProfile{
+		MaxFileSizeBytes: 16 * 1024 * 1024,
+	}, nil)
+	handler := client.TusHandler(testTusPath)
+
+	data := make([]byte, 6*1024*1024)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	user := &authentication.User{ProviderID: "github", UserID: "1"}
+	location := createTusUpload(t, handler, user, len(data))
+
+	// The connection drops in the middle of the first part
+	received := 2 * 1024 * 1024
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), "user", user))
+	defer cancel()
+	req := httptest.NewRequest(http.MethodPatch, location, &interruptedReader{data: bytes.NewReader(data[:received]), cancel: cancel}).WithContext(ctx)
+	req.Header.Set("Tus-Resumable", s3uploadclient.TusVersion)
+	req.Header.Set("Content-Type", "application/offset+octet-stream")
+	req.Header.Set("Upload-Offset", "0")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	resp := doTusRequest(handler, http.MethodHead, location, user, nil, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, strconv.Itoa(received), resp.Header.Get("Upload-Offset"))
+
+	resp = patchTusUpload(handler, location, user, received, data[received:])
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Equal(t, strconv.Itoa(len(data)), resp.Header.Get("Upload-Offset"))
+}
+
+func TestTusChecksContents(t *testing.T) {
+	client, storage := newTestS3Client(t, &s3uploadclient.UploadProfile{
+		MaxFileSizeBytes: 1024,
+		AllowedMimeTypes: []string{"image/*"},
+	}, nil)
+	handler := client.TusHandler(testTusPath)
+	user := &authentication.User{ProviderID: "github", UserID: "1"}
+
+	data := []byte("not an image")
+	resp := doTusRequest(handler, http.MethodPost, testTusPath, user, map[string]string{
+		"Upload-Length":   strconv.Itoa(len(data)),
+		"Upload-Metadata": "filename " + base64.StdEncoding.EncodeToString([]byte("image.png")) + ",filetype " + base64.StdEncoding.EncodeToString([]byte("image/png")),
+	}, nil)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	location := resp.Header.Get("Location")
+
+	resp = patchTusUpload(handler, location, user, 0, data)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	for key := range storage.objects {
+		assert.Empty(t, key, "upload should be removed")
+	}
+	resp = doTusRequest(handler, http.MethodHead, location, user, nil, nil)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestTusRequiresMaxFileSize(t *testing.T) {
+	client, _ := newTestS3Client(t, &s3uploadclient.UploadProfile{
+		MaxFileSizeBytes: -1,
+	}, nil)
+	handler := client.TusHandler(testTusPath)
+
+	resp := doTusRequest(handler, http.MethodOptions, testTusPath, nil, nil, nil)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Empty(t, resp.Header.Get("Tus-Max-Size"))
+
+	resp = doTusRequest(handler, http.MethodPost, testTusPath, nil, map[string]string{"Upload-Length": "10"}, nil)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestTusTermination(t *testing.T) {
+	client, storage := newTestS3Client(t, &s3uploadclient.UploadProfile{
+		MaxFileSizeBytes: 1024,
+	}, nil)
+	handler := client.TusHandler(testTusPath)
+
+	resp := doTusRequest(handler, http.MethodPost, testTusPath, nil, map[string]string{
+		"Upload-Length":   "10",
+		"Upload-Metadata": "filename " + base64.StdEncoding.EncodeToString([]byte("notes.txt")) + ",filetype " + base64.StdEncoding.EncodeToString([]byte("application/octet-stream")),
+	}, nil)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	location := resp.Header.Get("Location")
+	resp = patchTusUpload(handler, location, nil, 0, []byte("12345"))
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	resp = doTusRequest(handler, http.MethodDelete, location, nil, nil, nil)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Empty(t, storage.multiparts)
+	assert.Empty(t, storage.objects)
+
+	resp = doTusRequest(handler, http.MethodHead, location, nil, nil, nil)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
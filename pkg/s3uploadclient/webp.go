@@ -0,0 +1,342 @@
+package s3uploadclient
+
+import (
+	"container/heap"
+	"encoding/binary"
+	"fmt"
+	"image"
+	"io"
+)
+
+// This file implements a lossless WebP (VP8L) encoder. It only uses the
+// subtract green transform and prefix coded literals, without backward
+// references or color caches, which keeps it simple while still producing
+// images every WebP decoder supports.
+
+const (
+	vp8lSignature = 0x2f
+	// vp8lMaxDimension is the maximum width and height, stored in 14 bits
+	vp8lMaxDimension = 1 << 14
+	// vp8lSubtractGreenTransform identifies the transform in the bitstream
+	vp8lSubtractGreenTransform = 2
+	// vp8lGreenAlphabetSize includes the 24 length prefix codes, which
+	// aren't used since there are no backward references
+	vp8lGreenAlphabetSize    = 256 + 24
+	vp8lColorAlphabetSize    = 256
+	vp8lDistanceAlphabetSize = 40
+	vp8lMaxCodeLength        = 15
+	vp8lMaxCodeLengthCodes   = 19
+	vp8lMaxCodeLengthLength  = 7
+)
+
+// vp8lCodeLengthCodeOrder is the order used for writing the lengths of the
+// code length code
+var vp8lCodeLengthCodeOrder = [vp8lMaxCodeLengthCodes]int{17, 18, 0, 1, 2, 3, 4, 5, 16, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+
+// bitWriter writes values starting from their least significant bit, as
+// required by VP8L
+type bitWriter struct {
+	buf   []byte
+	acc   uint64
+	nbits uint
+}
+
+func (w *bitWriter) writeBits(value uint32, n uint) {
+	w.acc |= uint64(value) << w.nbits
+	w.nbits += n
+	for w.nbits >= 8 {
+		w.buf = append(w.buf, byte(w.acc))
+		w.acc >>= 8
+		w.nbits -= 8
+	}
+}
+
+// writeCode writes a prefix code starting from its most significant bit
+func (w *bitWriter) writeCode(code uint32, length int) {
+	for ii := length - 1; ii >= 0; ii-- {
+		w.writeBits((code>>uint(ii))&1, 1)
+	}
+}
+
+func (w *bitWriter) bytes() []byte {
+	if w.nbits > 0 {
+		w.buf = append(w.buf, byte(w.acc))
+		w.acc, w.nbits = 0, 0
+	}
+	return w.buf
+}
+
+// prefixCode is a canonical prefix code, symbols with a zero length are
+// unused
+type prefixCode struct {
+	lengths []int
+	codes   []uint32
+	// used is the number of symbols with a non-zero length
+	used int
+}
+
+func newPrefixCode(freqs []int, maxLength int) *prefixCode {
+	lengths := huffmanLengths(freqs, maxLength)
+	c := &prefixCode{
+		lengths: lengths,
+		codes:   make([]uint32, len(lengths)),
+	}
+	var count [vp8lMaxCodeLength + 1]int
+	for _, l := range lengths {
+		if l > 0 {
+			count[l]++
+			c.used++
+		}
+	}
+	var next [vp8lMaxCodeLength + 2]uint32
+	code := uint32(0)
+	for l := 1; l <= vp8lMaxCodeLength; l++ {
+		code = (code + uint32(count[l-1])) << 1
+		next[l] = code
+	}
+	for symbol, l := range lengths {
+		if l > 0 {
+			c.codes[symbol] = next[l]
+			next[l]++
+		}
+	}
+	return c
+}
+
+// write writes the code for symbol. Codes with a single symbol use no bits.
+func (c *prefixCode) write(w *bitWriter, symbol int) {
+	if c.used > 1 {
+		w.writeCode(c.codes[symbol], c.lengths[symbol])
+	}
+}
+
+type huffmanNode struct {
+	freq   int
+	parent int
+}
+
+type huffmanHeap struct {
+	nodes []huffmanNode
+	items []int
+}
+
+func (h *huffmanHeap) Len() int { return len(h.items) }
+func (h *huffmanHeap) Less(i, j int) bool {
+	a, b := h.nodes[h.items[i]].freq, h.nodes[h.items[j]].freq
+	if a != b {
+		return a < b
+	}
+	return h.items[i] < h.items[j]
+}
+func (h *huffmanHeap) Swap(i, j int) { h.items[i], h.items[j] = h.items[j], h.items[i] }
+func (h *huffmanHeap) Push(x any)    { h.items = append(h.items, x.(int)) }
+func (h *huffmanHeap) Pop() any {
+	item := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	return item
+}
+
+// huffmanLengths returns the code lengths of an optimal prefix code for
+// freqs, limited to maxLength by flattening the frequencies until it fits.
+// A single used symbol gets a length of 1.
+func huffmanLengths(freqs []int, maxLength int) []int {
+	freqs = append([]int(nil), freqs...)
+	for {
+		lengths, longest := buildHuffmanLengths(freqs)
+		if longest <= maxLength {
+			return lengths
+		}
+		for ii, f := range freqs {
+			if f > 0 {
+				freqs[ii] = (f + 1) / 2
+			}
+		}
+	}
+}
+
+func buildHuffmanLengths(freqs []int) ([]int, int) {
+	lengths := make([]int, len(freqs))
+	h := &huffmanHeap{}
+	for _, f := range freqs {
+		if f > 0 {
+			h.items = append(h.items, len(h.nodes))
+		}
+		h.nodes = append(h.nodes, huffmanNode{freq: f, parent: -1})
+	}
+	switch len(h.items) {
+	case 0:
+		return lengths, 0
+	case 1:
+		lengths[h.items[0]] = 1
+		return lengths, 1
+	}
+	heap.Init(h)
+	for h.Len() > 1 {
+		a := heap.Pop(h).(int)
+		b := heap.Pop(h).(int)
+		parent := len(h.nodes)
+		h.nodes = append(h.nodes, huffmanNode{freq: h.nodes[a].freq + h.nodes[b].freq, parent: -1})
+		h.nodes[a].parent = parent
+		h.nodes[b].parent = parent
+		heap.Push(h, parent)
+	}
+	longest := 0
+	for ii, f := range freqs {
+		if f == 0 {
+			continue
+		}
+		depth := 0
+		for node := ii; h.nodes[node].parent >= 0; node = h.nodes[node].parent {
+			depth++
+		}
+		lengths[ii] = depth
+		if depth > longest {
+			longest = depth
+		}
+	}
+	return lengths, longest
+}
+
+// writePrefixCode writes the code to the bitstream, using the simple format
+// for codes with one symbol below 256 or no symbols at all
+func writePrefixCode(w *bitWriter, c *prefixCode) {
+	if c.used <= 1 {
+		symbol := 0
+		for ii, l := range c.lengths {
+			if l > 0 {
+				symbol = ii
+			}
+		}
+		if symbol < 256 {
+			// Simple code with a single symbol
+			w.writeBits(1, 1)
+			w.writeBits(0, 1)
+			if symbol < 2 {
+				w.writeBits(0, 1)
+				w.writeBits(uint32(symbol), 1)
+			} else {
+				w.writeBits(1, 1)
+				w.writeBits(uint32(symbol), 8)
+			}
+			return
+		}
+	}
+	// Normal code, with its lengths encoded using the code length code
+	w.writeBits(0, 1)
+	var freqs [vp8lMaxCodeLengthCodes]int
+	for _, l := range c.lengths {
+		freqs[l]++
+	}
+	lengthCode := newPrefixCode(freqs[:], vp8lMaxCodeLengthLength)
+	count := 4
+	for ii, symbol := range vp8lCodeLengthCodeOrder {
+		if lengthCode.lengths[symbol] > 0 && ii+1 > count {
+			count = ii + 1
+		}
+	}
+	w.writeBits(uint32(count-4), 4)
+	for _, symbol := range vp8lCodeLengthCodeOrder[:count] {
+		w.writeBits(uint32(lengthCode.lengths[symbol]), 3)
+	}
+	// Lengths are written for the whole alphabet
+	w.writeBits(0, 1)
+	for _, l := range c.lengths {
+		lengthCode.write(w, l)
+	}
+}
+
+// encodeWebP writes img as a lossless WebP image
+func encodeWebP(out io.Writer, img *image.RGBA) error {
+	width, height := img.Rect.Dx(), img.Rect.Dy()
+	if width < 1 || height < 1 || width > vp8lMaxDimension || height > vp8lMaxDimension {
+		return fmt.Errorf("invalid WebP image dimensions %dx%d", width, height)
+	}
+
+	// VP8L uses non-premultiplied alpha, stored as green, red - green,
+	// blue - green and alpha after the subtract green transform
+	pixels := make([][4]uint8, 0, width*height)
+	alphaUsed := false
+	for y := 0; y < height; y++ {
+		row := img.Pix[img.PixOffset(img.Rect.Min.X, img.Rect.Min.Y+y):]
+		for x := 0; x < width; x++ {
+			p := row[x*4 : x*4+4]
+			r, g, b, a := p[0], p[1], p[2], p[3]
+			if a != 0xff {
+				alphaUsed = true
+				if a == 0 {
+					r, g, b = 0, 0, 0
+				} else {
+					r = uint8((uint32(r)*0xff + uint32(a)/2) / uint32(a))
+					g = uint8((uint32(g)*0xff + uint32(a)/2) / uint32(a))
+					b = uint8((uint32(b)*0xff + uint32(a)/2) / uint32(a))
+				}
+			}
+			pixels = append(pixels, [4]uint8{g, r - g, b - g, a})
+		}
+	}
+
+	var freqs [4][]int
+	freqs[0] = make([]int, vp8lGreenAlphabetSize)
+	for ii := 1; ii < 4; ii++ {
+		freqs[ii] = make([]int, vp8lColorAlphabetSize)
+	}
+	for _, p := range pixels {
+		for ii, v := range p {
+			freqs[ii][v]++
+		}
+	}
+	var codes [4]*prefixCode
+	for ii := range codes {
+		codes[ii] = newPrefixCode(freqs[ii], vp8lMaxCodeLength)
+	}
+	distance := newPrefixCode(make([]int, vp8lDistanceAlphabetSize), vp8lMaxCodeLength)
+
+	w := &bitWriter{}
+	w.writeBits(vp8lSignature, 8)
+	w.writeBits(uint32(width-1), 14)
+	w.writeBits(uint32(height-1), 14)
+	if alphaUsed {
+		w.writeBits(1, 1)
+	} else {
+		w.writeBits(0, 1)
+	}
+	// Version
+	w.writeBits(0, 3)
+	// Subtract green transform, followed by the end of the transforms
+	w.writeBits(1, 1)
+	w.writeBits(vp8lSubtractGreenTransform, 2)
+	w.writeBits(0, 1)
+	// No color cache and no meta prefix codes
+	w.writeBits(0, 1)
+	w.writeBits(0, 1)
+	for _, c := range codes {
+		writePrefixCode(w, c)
+	}
+	writePrefixCode(w, distance)
+	for _, p := range pixels {
+		for ii, v := range p {
+			codes[ii].write(w, int(v))
+		}
+	}
+	data := w.bytes()
+
+	size := len(data)
+	padding := size & 1
+	header := make([]byte, 20)
+	copy(header, "RIFF")
+	binary.LittleEndian.PutUint32(header[4:], uint32(4+8+size+padding))
+	copy(header[8:], "WEBPVP8L")
+	binary.LittleEndian.PutUint32(header[16:], uint32(size))
+	if _, err := out.Write(header); err != nil {
+		return err
+	}
+	if _, err := out.Write(data); err != nil {
+		return err
+	}
+	if padding != 0 {
+		if _, err := out.Write([]byte{0}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -6,12 +6,15 @@ const (
 	WgOperationName = attribute.Key("wg.operation.name")
 	WgOperationType = attribute.Key("wg.operation.type")
 	WgComponentName = attribute.Key("wg.component.name")
+	WgHookName      = attribute.Key("wg.hook.name")
+	WgHookRetries   = attribute.Key("wg.hook.retries")
 )
 
 var (
-	PublicServerAttribute     = WgComponentName.String("public-server")
-	InternalServerAttribute   = WgComponentName.String("internal-server")
-	ApiTransportAttribute     = WgComponentName.String("api-transport")
-	HooksClientAttribute      = WgComponentName.String("hooks-client")
-	WebhookTransportAttribute = WgComponentName.String("webhook-transport")
+	PublicServerAttribute          = WgComponentName.String("public-server")
+	InternalServerAttribute        = WgComponentName.String("internal-server")
+	ApiTransportAttribute          = WgComponentName.String("api-transport")
+	HooksClientAttribute           = WgComponentName.String("hooks-client")
+	WebhookTransportAttribute      = WgComponentName.String("webhook-transport")
+	EventWebhookTransportAttribute = WgComponentName.String("event-webhook-transport")
 )
@@ -0,0 +1,339 @@
+package webhookhandler
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	otrace "go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/wundergraph/wundergraph/pkg/trace"
+)
+
+const (
+	defaultMaxDeliveryAttempts = 10
+	defaultQueueWorkers        = 4
+	defaultMinBackoff          = time.Second
+	defaultMaxBackoff          = 5 * time.Minute
+	deliveryTimeout            = 30 * time.Second
+)
+
+// ErrDeliveryNotFound is returned by the QueueBackend when a dead letter doesn't exist
+var ErrDeliveryNotFound = errors.New("delivery not found")
+
+// hopHeaders are removed from the queued requests, like httputil.ReverseProxy does
+var hopHeaders = []string{
+	"Connection",
+	"Keep-Alive",
+	"Proxy-Authenticate",
+	"Proxy-Authorization",
+	"Proxy-Connection",
+	"Te",
+	"Trailer",
+	"Transfer-Encoding",
+	"Upgrade",
+}
+
+// Delivery is a webhook request waiting to be delivered to the hooks server
+type Delivery struct {
+	ID      string `json:"id"`
+	Webhook string `json:"webhook"`
+	Method  string `json:"method"`
+	// Path contains the path and the query of the original request
+	Path        string      `json:"path"`
+	Header      http.Header `json:"header"`
+	Body        []byte      `json:"body"`
+	ReceivedAt  time.Time   `json:"receivedAt"`
+	MaxAttempts int         `json:"maxAttempts"`
+	// Attempts is the number of failed deliveries
+	Attempts    int       `json:"attempts"`
+	NextAttempt time.Time `json:"nextAttempt,omitempty"`
+	LastError   string    `json:"lastError,omitempty"`
+	// handle is used by the backend to track the delivery while it's in flight
+	handle interface{}
+}
+
+// QueueBackend persists the deliveries until the hooks server accepts them
+type QueueBackend interface {
+	Enqueue(ctx context.Context, d *Delivery) error
+	// Next blocks until a delivery is ready to be attempted or ctx is done.
+	// The returned delivery isn't returned again until it's retried.
+	Next(ctx context.Context) (*Delivery, error)
+	// Retry schedules the delivery for another attempt after the delay
+	Retry(ctx context.Context, d *Delivery, delay time.Duration) error
+	// Ack removes a delivery after it succeeds
+	Ack(ctx context.Context, d *Delivery) error
+	// Dead moves the delivery to the dead letters
+	Dead(ctx context.Context, d *Delivery) error
+	DeadLetters(ctx context.Context) ([]*Delivery, error)
+	// Requeue moves a dead letter back to the queue, resetting its attempts
+	Requeue(ctx context.Context, id string) error
+	DeleteDeadLetter(ctx context.Context, id string) error
+	Close() error
+}
+
+type QueueOptions struct {
+	Backend QueueBackend
+	// ServerURL is the URL of the hooks server
+	ServerURL string
+	// Transport sends the webhooks, defaults to http.DefaultTransport
+	Transport http.RoundTripper
+	// Number of concurrent deliveries, defaults to 4
+	Workers int
+	// Backoff between attempts, doubling after each one. Default
+	// to 1 second and 5 minutes.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	Logger     *zap.Logger
+}
+
+// Queue delivers the webhooks stored in its backend to the hooks server,
+// retrying them with exponential backoff
+type Queue struct {
+	backend    QueueBackend
+	serverURL  string
+	client     *http.Client
+	minBackoff time.Duration
+	maxBackoff time.Duration
+	log        *zap.Logger
+	cancel     context.CancelFunc
+	wg         sync.WaitGroup
+}
+
+// NewQueue returns a Queue and starts delivering the stored webhooks
+func NewQueue(opts QueueOptions) *Queue {
+	transport := opts.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	q := &Queue{
+		backend:   opts.Backend,
+		serverURL: strings.TrimSuffix(opts.ServerURL, "/"),
+		client: &http.Client{
+			Timeout: deliveryTimeout,
+			Transport: trace.NewTransport(transport,
+				otelhttp.WithSpanOptions(otrace.WithAttributes(trace.WebhookTransportAttribute)),
+			),
+		},
+		minBackoff: opts.MinBackoff,
+		maxBackoff: opts.MaxBackoff,
+		log:        opts.Logger,
+	}
+	if q.minBackoff <= 0 {
+		q.minBackoff = defaultMinBackoff
+	}
+	if q.maxBackoff <= 0 {
+		q.maxBackoff = defaultMaxBackoff
+	}
+	if q.log == nil {
+		q.log = zap.NewNop()
+	}
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = defaultQueueWorkers
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	q.cancel = cancel
+	for ii := 0; ii < workers; ii++ {
+		q.wg.Add(1)
+		go q.work(ctx)
+	}
+	return q
+}
+
+// Enqueue persists the request so it's delivered later
+func (q *Queue) Enqueue(ctx context.Context, webhook string, r *http.Request, body []byte, maxAttempts int) (*Delivery, error) {
+	var id [16]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return nil, err
+	}
+	if maxAttempts <= 0 {
+		maxAttempts = defaultMaxDeliveryAttempts
+	}
+	header := r.Header.Clone()
+	for _, h := range hopHeaders {
+		header.Del(h)
+	}
+	d := &Delivery{
+		ID:          hex.EncodeToString(id[:]),
+		Webhook:     webhook,
+		Method:      r.Method,
+		Path:        r.URL.RequestURI(),
+		Header:      header,
+		Body:        body,
+		ReceivedAt:  time.Now(),
+		MaxAttempts: maxAttempts,
+	}
+	if err := q.backend.Enqueue(ctx, d); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Close stops delivering webhooks and closes the backend. Deliveries in
+// flight are canceled and attempted again once the queue is reopened.
+func (q *Queue) Close() error {
+	q.cancel()
+	q.wg.Wait()
+	return q.backend.Close()
+}
+
+func (q *Queue) work(ctx context.Context) {
+	defer q.wg.Done()
+	for {
+		d, err := q.backend.Next(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			q.log.Error("reading webhook queue", zap.Error(err))
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(q.minBackoff):
+			}
+			continue
+		}
+		q.process(ctx, d)
+	}
+}
+
+func (q *Queue) process(ctx context.Context, d *Delivery) {
+	log := q.log.With(zap.String("webhook", d.Webhook), zap.String("delivery", d.ID))
+	retryable, err := q.deliver(ctx, d)
+	if ctx.Err() != nil {
+		// Shutting down, doesn't count as an attempt
+		if err := q.backend.Retry(context.Background(), d, 0); err != nil {
+			log.Error("releasing webhook delivery", zap.Error(err))
+		}
+		return
+	}
+	if err == nil {
+		if err := q.backend.Ack(ctx, d); err != nil {
+			log.Error("acknowledging webhook delivery", zap.Error(err))
+		}
+		return
+	}
+	d.Attempts++
+	d.LastError = err.Error()
+	if !retryable || d.Attempts >= d.MaxAttempts {
+		log.Error("webhook delivery failed, moving it to the dead letters", zap.Int("attempts", d.Attempts), zap.Error(err))
+		if err := q.backend.Dead(ctx, d); err != nil {
+			log.Error("moving webhook delivery to the dead letters", zap.Error(err))
+		}
+		return
+	}
+	delay := q.backoff(d.Attempts)
+	log.Warn("webhook delivery failed, retrying", zap.Int("attempts", d.Attempts), zap.Duration("delay", delay), zap.Error(err))
+	if err := q.backend.Retry(ctx, d, delay); err != nil {
+		log.Error("scheduling webhook delivery", zap.Error(err))
+	}
+}
+
+func (q *Queue) backoff(attempts int) time.Duration {
+	delay := float64(q.minBackoff) * math.Pow(2, float64(attempts-1))
+	if delay > float64(q.maxBackoff) {
+		return q.maxBackoff
+	}
+	return time.Duration(delay)
+}
+
+// deliver sends the request to the hooks server, returning whether
+// it should be retried if it fails
+func (q *Queue) deliver(ctx context.Context, d *Delivery) (retryable bool, err error) {
+	req, err := http.NewRequestWithContext(ctx, d.Method, q.serverURL+d.Path, bytes.NewReader(d.Body))
+	if err != nil {
+		return false, err
+	}
+	req.Header = d.Header.Clone()
+	resp, err := q.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	retryable = resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusRequestTimeout
+	return retryable, fmt.Errorf("hooks server returned status %d", resp.StatusCode)
+}
+
+// MountDeadLetters registers the endpoints for listing, retrying and
+// deleting dead letters in the router
+func (q *Queue) MountDeadLetters(router *mux.Router) {
+	router.Path("/webhooks/deadletters").Methods(http.MethodGet).HandlerFunc(q.listDeadLetters)
+	router.Path("/webhooks/deadletters/{id}/retry").Methods(http.MethodPost).HandlerFunc(q.retryDeadLetter)
+	router.Path("/webhooks/deadletters/{id}").Methods(http.MethodDelete).HandlerFunc(q.deleteDeadLetter)
+}
+
+func (q *Queue) listDeadLetters(w http.ResponseWriter, r *http.Request) {
+	deliveries, err := q.backend.DeadLetters(r.Context())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if webhook := r.URL.Query().Get("webhook"); webhook != "" {
+		filtered := deliveries[:0]
+		for _, d := range deliveries {
+			if d.Webhook == webhook {
+				filtered = append(filtered, d)
+			}
+		}
+		deliveries = filtered
+	}
+	if deliveries == nil {
+		deliveries = []*Delivery{}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(deliveries)
+}
+
+func (q *Queue) retryDeadLetter(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+	if !isDeliveryID(id) {
+		q.deadLetterResult(w, ErrDeliveryNotFound)
+		return
+	}
+	q.deadLetterResult(w, q.backend.Requeue(r.Context(), id))
+}
+
+func (q *Queue) deleteDeadLetter(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+	if !isDeliveryID(id) {
+		q.deadLetterResult(w, ErrDeliveryNotFound)
+		return
+	}
+	q.deadLetterResult(w, q.backend.DeleteDeadLetter(r.Context(), id))
+}
+
+// isDeliveryID checks that the ID could have been generated by Enqueue, since
+// backends use it for file names and keys
+func isDeliveryID(id string) bool {
+	decoded, err := hex.DecodeString(id)
+	return err == nil && len(decoded) == 16
+}
+
+func (q *Queue) deadLetterResult(w http.ResponseWriter, err error) {
+	switch {
+	case err == nil:
+		w.WriteHeader(http.StatusNoContent)
+	case errors.Is(err, ErrDeliveryNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,269 @@
+package webhookhandler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	filePendingDir = "pending"
+	fileDeadDir    = "dead"
+	// fileIdleWait is how long Next waits when the queue is empty,
+	// before checking it again
+	fileIdleWait = time.Minute
+)
+
+// fileQueueBackend stores each delivery as a JSON file, keeping an index
+// of the pending ones in memory
+type fileQueueBackend struct {
+	dir      string
+	mu       sync.Mutex
+	pending  map[string]time.Time
+	inFlight map[string]bool
+	wake     chan struct{}
+}
+
+// NewFileQueueBackend returns a QueueBackend storing the deliveries in dir,
+// resuming the ones pending from previous runs
+func NewFileQueueBackend(dir string) (QueueBackend, error) {
+	b := &fileQueueBackend{
+		dir:      dir,
+		pending:  make(map[string]time.Time),
+		inFlight: make(map[string]bool),
+		wake:     make(chan struct{}, 1),
+	}
+	for _, subdir := range []string{filePendingDir, fileDeadDir} {
+		if err := os.MkdirAll(filepath.Join(dir, subdir), 0o700); err != nil {
+			return nil, err
+		}
+	}
+	entries, err := os.ReadDir(filepath.Join(dir, filePendingDir))
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		id, ok := strings.CutSuffix(entry.Name(), ".json")
+		if !ok {
+			continue
+		}
+		d, err := b.read(filePendingDir, id)
+		if err != nil {
+			return nil, fmt.Errorf("reading queued webhook %s: %w", id, err)
+		}
+		b.pending[id] = d.NextAttempt
+	}
+	return b, nil
+}
+
+func (b *fileQueueBackend) path(subdir string, id string) string {
+	return filepath.Join(b.dir, subdir, id+".json")
+}
+
+func (b *fileQueueBackend) read(subdir string, id string) (*Delivery, error) {
+	data, err := os.ReadFile(b.path(subdir, id))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrDeliveryNotFound
+		}
+		return nil, err
+	}
+	var d Delivery
+	if err := json.Unmarshal(data, &d); err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+// write stores the delivery atomically, syncing it before returning
+func (b *fileQueueBackend) write(subdir string, d *Delivery) error {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Join(b.dir, subdir), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), b.path(subdir, d.ID))
+}
+
+func (b *fileQueueBackend) signal() {
+	select {
+	case b.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (b *fileQueueBackend) Enqueue(ctx context.Context, d *Delivery) error {
+	if err := b.write(filePendingDir, d); err != nil {
+		return err
+	}
+	b.mu.Lock()
+	b.pending[d.ID] = d.NextAttempt
+	b.mu.Unlock()
+	b.signal()
+	return nil
+}
+
+// nextReady returns the ID of the earliest pending delivery not in flight,
+// if it's ready, or how long to wait for it otherwise
+func (b *fileQueueBackend) nextReady() (string, time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var nextID string
+	var next time.Time
+	for id, at := range b.pending {
+		if b.inFlight[id] {
+			continue
+		}
+		if nextID == "" || at.Before(next) {
+			nextID, next = id, at
+		}
+	}
+	if nextID == "" {
+		return "", fileIdleWait
+	}
+	if wait := time.Until(next); wait > 0 {
+		return "", wait
+	}
+	b.inFlight[nextID] = true
+	return nextID, 0
+}
+
+func (b *fileQueueBackend) Next(ctx context.Context) (*Delivery, error) {
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		id, wait := b.nextReady()
+		if id != "" {
+			d, err := b.read(filePendingDir, id)
+			if err != nil {
+				b.mu.Lock()
+				delete(b.inFlight, id)
+				if errors.Is(err, ErrDeliveryNotFound) {
+					delete(b.pending, id)
+				}
+				b.mu.Unlock()
+				return nil, err
+			}
+			return d, nil
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-b.wake:
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+func (b *fileQueueBackend) Retry(ctx context.Context, d *Delivery, delay time.Duration) error {
+	d.NextAttempt = time.Now().Add(delay)
+	err := b.write(filePendingDir, d)
+	b.mu.Lock()
+	if err == nil {
+		b.pending[d.ID] = d.NextAttempt
+	}
+	delete(b.inFlight, d.ID)
+	b.mu.Unlock()
+	b.signal()
+	return err
+}
+
+func (b *fileQueueBackend) remove(id string) error {
+	err := os.Remove(b.path(filePendingDir, id))
+	b.mu.Lock()
+	delete(b.pending, id)
+	delete(b.inFlight, id)
+	b.mu.Unlock()
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+func (b *fileQueueBackend) Ack(ctx context.Context, d *Delivery) error {
+	return b.remove(d.ID)
+}
+
+func (b *fileQueueBackend) Dead(ctx context.Context, d *Delivery) error {
+	if err := b.write(fileDeadDir, d); err != nil {
+		return err
+	}
+	return b.remove(d.ID)
+}
+
+func (b *fileQueueBackend) DeadLetters(ctx context.Context) ([]*Delivery, error) {
+	entries, err := os.ReadDir(filepath.Join(b.dir, fileDeadDir))
+	if err != nil {
+		return nil, err
+	}
+	var deliveries []*Delivery
+	for _, entry := range entries {
+		id, ok := strings.CutSuffix(entry.Name(), ".json")
+		if !ok {
+			continue
+		}
+		d, err := b.read(fileDeadDir, id)
+		if err != nil {
+			if errors.Is(err, ErrDeliveryNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		deliveries = append(deliveries, d)
+	}
+	sort.Slice(deliveries, func(i, j int) bool {
+		return deliveries[i].ReceivedAt.Before(deliveries[j].ReceivedAt)
+	})
+	return deliveries, nil
+}
+
+func (b *fileQueueBackend) Requeue(ctx context.Context, id string) error {
+	d, err := b.read(fileDeadDir, id)
+	if err != nil {
+		return err
+	}
+	d.Attempts = 0
+	d.NextAttempt = time.Time{}
+	if err := b.Enqueue(ctx, d); err != nil {
+		return err
+	}
+	return os.Remove(b.path(fileDeadDir, id))
+}
+
+func (b *fileQueueBackend) DeleteDeadLetter(ctx context.Context, id string) error {
+	err := os.Remove(b.path(fileDeadDir, id))
+	if errors.Is(err, os.ErrNotExist) {
+		return ErrDeliveryNotFound
+	}
+	return err
+}
+
+func (b *fileQueueBackend) Close() error {
+	return nil
+}
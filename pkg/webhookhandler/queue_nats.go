@@ -0,0 +1,224 @@
+package webhookhandler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/nats-io/nats.go"
+)
+
+const (
+	natsQueueStream   = "WUNDERGRAPH_WEBHOOKS"
+	natsQueueSubject  = "wundergraph.webhooks.queue"
+	natsQueueConsumer = "wundergraph-webhooks"
+	natsDeadBucket    = "wundergraph_webhooks_dead"
+	// natsFetchWait is how long each Next call waits for messages before
+	// checking if its context is done, delaying the shutdown up to it
+	natsFetchWait = time.Second
+)
+
+// natsQueueBackend stores pending deliveries in a JetStream work queue, so
+// several nodes can share it, and the dead letters in a key value bucket
+type natsQueueBackend struct {
+	conn *nats.Conn
+	js   nats.JetStreamContext
+	sub  *nats.Subscription
+	dead nats.KeyValue
+}
+
+// NewNATSQueueBackend returns a QueueBackend using NATS JetStream
+func NewNATSQueueBackend(serverURL string, token string) (QueueBackend, error) {
+	var opts []nats.Option
+	if token != "" {
+		opts = append(opts, nats.Token(token))
+	}
+	conn, err := nats.Connect(serverURL, opts...)
+	if err != nil {
+		return nil, err
+	}
+	b, err := newNATSQueueBackend(conn)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return b, nil
+}
+
+func newNATSQueueBackend(conn *nats.Conn) (*natsQueueBackend, error) {
+	js, err := conn.JetStream()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := js.StreamInfo(natsQueueStream); err != nil {
+		if !errors.Is(err, nats.ErrStreamNotFound) {
+			return nil, err
+		}
+		_, err = js.AddStream(&nats.StreamConfig{
+			Name:      natsQueueStream,
+			Subjects:  []string{natsQueueSubject},
+			Retention: nats.WorkQueuePolicy,
+			Storage:   nats.FileStorage,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	dead, err := js.KeyValue(natsDeadBucket)
+	if errors.Is(err, nats.ErrBucketNotFound) {
+		dead, err = js.CreateKeyValue(&nats.KeyValueConfig{
+			Bucket:  natsDeadBucket,
+			Storage: nats.FileStorage,
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	sub, err := js.PullSubscribe(natsQueueSubject, natsQueueConsumer,
+		nats.BindStream(natsQueueStream),
+		// Longer than a delivery, so it's not redelivered while in flight
+		nats.AckWait(2*deliveryTimeout),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &natsQueueBackend{
+		conn: conn,
+		js:   js,
+		sub:  sub,
+		dead: dead,
+	}, nil
+}
+
+func (b *natsQueueBackend) Enqueue(ctx context.Context, d *Delivery) error {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+	_, err = b.js.Publish(natsQueueSubject, data, nats.Context(ctx))
+	return err
+}
+
+func (b *natsQueueBackend) Next(ctx context.Context) (*Delivery, error) {
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		// The fetch isn't canceled with ctx, because the server could still
+		// send a message to the canceled pull request, leaving it unacknowledged
+		// until AckWait expires
+		msgs, err := b.sub.Fetch(1, nats.MaxWait(natsFetchWait))
+		if err != nil {
+			if errors.Is(err, nats.ErrTimeout) || errors.Is(err, context.DeadlineExceeded) {
+				continue
+			}
+			return nil, err
+		}
+		if len(msgs) == 0 {
+			continue
+		}
+		msg := msgs[0]
+		if ctx.Err() != nil {
+			_ = msg.Nak()
+			return nil, ctx.Err()
+		}
+		var d Delivery
+		if err := json.Unmarshal(msg.Data, &d); err != nil {
+			// Can't ever be delivered
+			_ = msg.Term()
+			return nil, err
+		}
+		// Failed attempts aren't stored in the message, but JetStream
+		// counts the deliveries
+		if meta, err := msg.Metadata(); err == nil && meta.NumDelivered > 0 {
+			d.Attempts = int(meta.NumDelivered) - 1
+		}
+		d.handle = msg
+		return &d, nil
+	}
+}
+
+func (b *natsQueueBackend) Retry(ctx context.Context, d *Delivery, delay time.Duration) error {
+	return d.handle.(*nats.Msg).NakWithDelay(delay, nats.Context(ctx))
+}
+
+func (b *natsQueueBackend) Ack(ctx context.Context, d *Delivery) error {
+	return d.handle.(*nats.Msg).AckSync(nats.Context(ctx))
+}
+
+func (b *natsQueueBackend) Dead(ctx context.Context, d *Delivery) error {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+	if _, err := b.dead.Put(d.ID, data); err != nil {
+		return err
+	}
+	return b.Ack(ctx, d)
+}
+
+func (b *natsQueueBackend) deadLetter(id string) (*Delivery, error) {
+	entry, err := b.dead.Get(id)
+	if err != nil {
+		if errors.Is(err, nats.ErrKeyNotFound) {
+			return nil, ErrDeliveryNotFound
+		}
+		return nil, err
+	}
+	var d Delivery
+	if err := json.Unmarshal(entry.Value(), &d); err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+func (b *natsQueueBackend) DeadLetters(ctx context.Context) ([]*Delivery, error) {
+	keys, err := b.dead.Keys(nats.Context(ctx))
+	if err != nil {
+		if errors.Is(err, nats.ErrNoKeysFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	deliveries := make([]*Delivery, 0, len(keys))
+	for _, key := range keys {
+		d, err := b.deadLetter(key)
+		if err != nil {
+			if errors.Is(err, ErrDeliveryNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		deliveries = append(deliveries, d)
+	}
+	sort.Slice(deliveries, func(i, j int) bool {
+		return deliveries[i].ReceivedAt.Before(deliveries[j].ReceivedAt)
+	})
+	return deliveries, nil
+}
+
+func (b *natsQueueBackend) Requeue(ctx context.Context, id string) error {
+	d, err := b.deadLetter(id)
+	if err != nil {
+		return err
+	}
+	d.Attempts = 0
+	if err := b.Enqueue(ctx, d); err != nil {
+		return err
+	}
+	return b.dead.Delete(id)
+}
+
+func (b *natsQueueBackend) DeleteDeadLetter(ctx context.Context, id string) error {
+	if _, err := b.deadLetter(id); err != nil {
+		return err
+	}
+	return b.dead.Delete(id)
+}
+
+func (b *natsQueueBackend) Close() error {
+	b.conn.Close()
+	return nil
+}
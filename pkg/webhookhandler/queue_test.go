@@ -0,0 +1,217 @@
+package webhookhandler_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	natsServer "github.com/nats-io/nats-server/v2/server"
+	natsTest "github.com/nats-io/nats-server/v2/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/wundergraph/wundergraph/pkg/webhookhandler"
+	"github.com/wundergraph/wundergraph/pkg/wgpb"
+)
+
+// fakeHooksServer fails requests with the given status until it's cleared
+type fakeHooksServer struct {
+	mu         sync.Mutex
+	failStatus int
+	attempts   int
+	delivered  []*http.Request
+}
+
+func (s *fakeHooksServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.attempts++
+	if s.failStatus != 0 {
+		w.WriteHeader(s.failStatus)
+		return
+	}
+	s.delivered = append(s.delivered, r)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *fakeHooksServer) setFailStatus(status int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failStatus = status
+}
+
+func (s *fakeHooksServer) deliveredCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.delivered)
+}
+
+func newTestQueue(t *testing.T, backend webhookhandler.QueueBackend, serverURL string) *webhookhandler.Queue {
+	return webhookhandler.NewQueue(webhookhandler.QueueOptions{
+		Backend:    backend,
+		ServerURL:  serverURL,
+		MinBackoff: 10 * time.Millisecond,
+		MaxBackoff: 50 * time.Millisecond,
+		Logger:     zap.NewNop(),
+	})
+}
+
+func newQueuedHandler(t *testing.T, queue *webhookhandler.Queue, serverURL string) http.Handler {
+	handler, err := webhookhandler.New(&wgpb.WebhookConfiguration{
+		Name:                "test",
+		Queued:              true,
+		MaxDeliveryAttempts: 3,
+		Verifier: &wgpb.WebhookVerifier{
+			Kind:            wgpb.WebhookVerifierKind_HMAC_SHA256,
+			Secret:          staticVariable("secret"),
+			SignatureHeader: "X-Signature",
+		},
+	}, serverURL, nil, queue, nil, zap.NewNop())
+	require.NoError(t, err)
+	return handler
+}
+
+func signedHeaders() map[string]string {
+	return map[string]string{"X-Signature": hex.EncodeToString(hmacSHA256([]byte("secret"), testBody))}
+}
+
+func deadLetters(t *testing.T, router http.Handler) []*webhookhandler.Delivery {
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/webhooks/deadletters", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var deliveries []*webhookhandler.Delivery
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&deliveries))
+	return deliveries
+}
+
+func deadLetterRequest(router http.Handler, method string, path string) int {
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(method, path, nil))
+	return rec.Code
+}
+
+// testQueueBackend verifies the behavior shared by all the backends. Calling
+// newBackend again must return a backend with the same contents.
+func testQueueBackend(t *testing.T, newBackend func() webhookhandler.QueueBackend) {
+	hooks := &fakeHooksServer{failStatus: http.StatusBadGateway}
+	srv := httptest.NewServer(hooks)
+	t.Cleanup(srv.Close)
+
+	queue := newTestQueue(t, newBackend(), srv.URL)
+	handler := newQueuedHandler(t, queue, srv.URL)
+	router := mux.NewRouter()
+	queue.MountDeadLetters(router)
+
+	// Unverified webhooks are rejected without queueing them
+	assert.Equal(t, http.StatusUnauthorized, sendWebhook(handler, nil))
+
+	// Retried until the hooks server accepts it
+	assert.Equal(t, http.StatusAccepted, sendWebhook(handler, signedHeaders()))
+	require.Eventually(t, func() bool {
+		hooks.mu.Lock()
+		defer hooks.mu.Unlock()
+		return hooks.attempts >= 2
+	}, 5*time.Second, 10*time.Millisecond)
+	hooks.setFailStatus(0)
+	require.Eventually(t, func() bool { return hooks.deliveredCount() == 1 }, 5*time.Second, 10*time.Millisecond)
+	hooks.mu.Lock()
+	delivered := hooks.delivered[0]
+	hooks.mu.Unlock()
+	assert.Equal(t, "/webhooks/test", delivered.URL.Path)
+	assert.Equal(t, signedHeaders()["X-Signature"], delivered.Header.Get("X-Signature"))
+
+	// Client errors aren't retried
+	hooks.setFailStatus(http.StatusBadRequest)
+	assert.Equal(t, http.StatusAccepted, sendWebhook(handler, signedHeaders()))
+	var dead []*webhookhandler.Delivery
+	require.Eventually(t, func() bool {
+		dead = deadLetters(t, router)
+		return len(dead) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, "test", dead[0].Webhook)
+	assert.Equal(t, 1, dead[0].Attempts)
+	assert.Equal(t, "hooks server returned status 400", dead[0].LastError)
+	assert.Equal(t, testBody, string(dead[0].Body))
+
+	hooks.setFailStatus(0)
+	assert.Equal(t, http.StatusNoContent, deadLetterRequest(router, http.MethodPost, "/webhooks/deadletters/"+dead[0].ID+"/retry"))
+	require.Eventually(t, func() bool { return hooks.deliveredCount() == 2 }, 5*time.Second, 10*time.Millisecond)
+	assert.Empty(t, deadLetters(t, router))
+	assert.Equal(t, http.StatusNotFound, deadLetterRequest(router, http.MethodPost, "/webhooks/deadletters/"+dead[0].ID+"/retry"))
+	assert.Equal(t, http.StatusNotFound, deadLetterRequest(router, http.MethodDelete, "/webhooks/deadletters/"+dead[0].ID))
+	assert.Equal(t, http.StatusNotFound, deadLetterRequest(router, http.MethodDelete, "/webhooks/deadletters/invalid"))
+
+	// Server errors are retried up to the maximum attempts
+	hooks.setFailStatus(http.StatusServiceUnavailable)
+	assert.Equal(t, http.StatusAccepted, sendWebhook(handler, signedHeaders()))
+	require.Eventually(t, func() bool {
+		dead = deadLetters(t, router)
+		return len(dead) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, 3, dead[0].Attempts)
+	assert.Equal(t, http.StatusNoContent, deadLetterRequest(router, http.MethodDelete, "/webhooks/deadletters/"+dead[0].ID))
+	assert.Empty(t, deadLetters(t, router))
+
+	// Pending deliveries survive restarts
+	require.NoError(t, queue.Close())
+	down := httptest.NewServer(http.NotFoundHandler())
+	down.Close()
+	queue = newTestQueue(t, newBackend(), down.URL)
+	handler = newQueuedHandler(t, queue, down.URL)
+	assert.Equal(t, http.StatusAccepted, sendWebhook(handler, signedHeaders()))
+	require.NoError(t, queue.Close())
+
+	hooks.setFailStatus(0)
+	queue = newTestQueue(t, newBackend(), srv.URL)
+	t.Cleanup(func() { _ = queue.Close() })
+	require.Eventually(t, func() bool { return hooks.deliveredCount() == 3 }, 10*time.Second, 10*time.Millisecond)
+}
+
+func TestFileQueue(t *testing.T) {
+	dir := t.TempDir()
+	testQueueBackend(t, func() webhookhandler.QueueBackend {
+		backend, err := webhookhandler.NewFileQueueBackend(dir)
+		require.NoError(t, err)
+		return backend
+	})
+}
+
+func TestNATSQueue(t *testing.T) {
+	server := natsTest.RunServer(&natsServer.Options{
+		Port:      -1,
+		JetStream: true,
+		StoreDir:  t.TempDir(),
+	})
+	t.Cleanup(server.Shutdown)
+	testQueueBackend(t, func() webhookhandler.QueueBackend {
+		backend, err := webhookhandler.NewNATSQueueBackend(server.ClientURL(), "")
+		require.NoError(t, err)
+		return backend
+	})
+}
+
+func TestQueuedWebhookProxiesOtherMethods(t *testing.T) {
+	hooks := &fakeHooksServer{}
+	srv := httptest.NewServer(hooks)
+	t.Cleanup(srv.Close)
+	backend, err := webhookhandler.NewFileQueueBackend(t.TempDir())
+	require.NoError(t, err)
+	queue := newTestQueue(t, backend, srv.URL)
+	t.Cleanup(func() { _ = queue.Close() })
+	handler := newQueuedHandler(t, queue, srv.URL)
+
+	// GET requests (e.g. verification challenges) need the hooks server response
+	req := httptest.NewRequest(http.MethodGet, "/webhooks/test?challenge=1", strings.NewReader(testBody))
+	req.Header.Set("X-Signature", signedHeaders()["X-Signature"])
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, 1, hooks.deliveredCount())
+}
@@ -0,0 +1,47 @@
+package webhookhandler
+
+import "sync"
+
+// replayCache remembers the most recently verified messages, evicting
+// the oldest one once it's full
+type replayCache struct {
+	mu sync.Mutex
+	// seen maps the keys to their position in keys
+	seen map[string]int
+	keys []string
+	next int
+}
+
+func newReplayCache(size int) *replayCache {
+	return &replayCache{
+		seen: make(map[string]int, size),
+		keys: make([]string, size),
+	}
+}
+
+// add records the key, returning false if it was already seen
+func (c *replayCache) add(key string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, found := c.seen[key]; found {
+		return false
+	}
+	if evicted := c.keys[c.next]; evicted != "" {
+		delete(c.seen, evicted)
+	}
+	c.keys[c.next] = key
+	c.seen[key] = c.next
+	c.next = (c.next + 1) % len(c.keys)
+	return true
+}
+
+// remove forgets the key, so a message that couldn't be delivered can be
+// received again
+func (c *replayCache) remove(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if i, found := c.seen[key]; found {
+		delete(c.seen, key)
+		c.keys[i] = ""
+	}
+}
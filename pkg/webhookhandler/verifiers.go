@@ -0,0 +1,360 @@
+package webhookhandler
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wundergraph/wundergraph/pkg/loadvariable"
+	"github.com/wundergraph/wundergraph/pkg/wgpb"
+)
+
+const defaultTolerance = 5 * time.Minute
+
+var errInvalidSignature = errors.New("invalid signature")
+
+type verifier interface {
+	Kind() string
+	// Verify checks the request signature, returning a key identifying
+	// the message for detecting replays
+	Verify(r *http.Request, body []byte) (string, error)
+}
+
+func newVerifier(config *wgpb.WebhookVerifier) (verifier, error) {
+	secrets := make([]string, 0, 1+len(config.AdditionalSecrets))
+	for _, secret := range append([]*wgpb.ConfigurationVariable{config.Secret}, config.AdditionalSecrets...) {
+		if value := loadvariable.String(secret); value != "" {
+			secrets = append(secrets, value)
+		}
+	}
+	tolerance := defaultTolerance
+	if config.ToleranceSeconds != 0 {
+		tolerance = time.Duration(config.ToleranceSeconds) * time.Second
+	}
+	timestamps := timestampChecker{tolerance: tolerance}
+
+	switch config.Kind {
+	case wgpb.WebhookVerifierKind_HMAC_SHA256:
+		return &sha256HMACVerifier{
+			secrets:               bytesSecrets(secrets),
+			signatureHeader:       config.SignatureHeader,
+			signatureHeaderPrefix: config.SignatureHeaderPrefix,
+		}, nil
+	case wgpb.WebhookVerifierKind_STRIPE:
+		return &stripeVerifier{
+			secrets:    bytesSecrets(secrets),
+			timestamps: timestamps,
+		}, nil
+	case wgpb.WebhookVerifierKind_SLACK:
+		return &slackVerifier{
+			secrets:    bytesSecrets(secrets),
+			timestamps: timestamps,
+		}, nil
+	case wgpb.WebhookVerifierKind_SVIX:
+		keys := make([][]byte, len(secrets))
+		for ii, secret := range secrets {
+			key, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(secret, "whsec_"))
+			if err != nil {
+				return nil, fmt.Errorf("invalid Svix secret: %w", err)
+			}
+			keys[ii] = key
+		}
+		return &svixVerifier{
+			secrets:    keys,
+			timestamps: timestamps,
+		}, nil
+	case wgpb.WebhookVerifierKind_ED25519, wgpb.WebhookVerifierKind_RSA_SHA256:
+		v := &publicKeyVerifier{
+			kind:                  config.Kind,
+			signatureHeader:       config.SignatureHeader,
+			signatureHeaderPrefix: config.SignatureHeaderPrefix,
+			timestampHeader:       config.TimestampHeader,
+			timestamps:            timestamps,
+		}
+		for _, secret := range secrets {
+			key, err := parsePublicKey(secret)
+			if err != nil {
+				return nil, err
+			}
+			switch key.(type) {
+			case ed25519.PublicKey:
+				if config.Kind != wgpb.WebhookVerifierKind_ED25519 {
+					return nil, fmt.Errorf("expecting a %s public key, got Ed25519", config.Kind)
+				}
+			case *rsa.PublicKey:
+				if config.Kind != wgpb.WebhookVerifierKind_RSA_SHA256 {
+					return nil, fmt.Errorf("expecting a %s public key, got RSA", config.Kind)
+				}
+			default:
+				return nil, fmt.Errorf("unsupported public key type %T", key)
+			}
+			v.keys = append(v.keys, key)
+		}
+		return v, nil
+	}
+	return nil, fmt.Errorf("unknown webhook verifier %s", config.Kind)
+}
+
+func bytesSecrets(secrets []string) [][]byte {
+	result := make([][]byte, len(secrets))
+	for ii, secret := range secrets {
+		result[ii] = []byte(secret)
+	}
+	return result
+}
+
+func hmacSHA256(secret []byte, data ...[]byte) []byte {
+	hash := hmac.New(sha256.New, secret)
+	for _, d := range data {
+		_, _ = hash.Write(d)
+	}
+	return hash.Sum(nil)
+}
+
+// timestampChecker rejects timestamps too far from the current time
+type timestampChecker struct {
+	tolerance time.Duration
+}
+
+func (c timestampChecker) check(timestamp string) error {
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid timestamp %q", timestamp)
+	}
+	if c.tolerance < 0 {
+		return nil
+	}
+	diff := time.Since(time.Unix(seconds, 0))
+	if diff < 0 {
+		diff = -diff
+	}
+	if diff > c.tolerance {
+		return fmt.Errorf("timestamp %s is outside the %s tolerance", timestamp, c.tolerance)
+	}
+	return nil
+}
+
+type sha256HMACVerifier struct {
+	secrets               [][]byte
+	signatureHeader       string
+	signatureHeaderPrefix string
+}
+
+func (v *sha256HMACVerifier) Kind() string {
+	return "HMAC_SHA256"
+}
+
+func (v *sha256HMACVerifier) Verify(r *http.Request, body []byte) (string, error) {
+	signature := r.Header.Get(v.signatureHeader)
+	if v.signatureHeaderPrefix != "" {
+		signature = strings.TrimPrefix(signature, v.signatureHeaderPrefix)
+	}
+	signatureBytes, _ := hex.DecodeString(signature)
+	for _, secret := range v.secrets {
+		if hmac.Equal(hmacSHA256(secret, body), signatureBytes) {
+			return hex.EncodeToString(signatureBytes), nil
+		}
+	}
+	return "", errInvalidSignature
+}
+
+// stripeVerifier implements https://stripe.com/docs/webhooks/signatures
+type stripeVerifier struct {
+	secrets    [][]byte
+	timestamps timestampChecker
+}
+
+func (v *stripeVerifier) Kind() string {
+	return "STRIPE"
+}
+
+func (v *stripeVerifier) Verify(r *http.Request, body []byte) (string, error) {
+	var timestamp string
+	var signatures [][]byte
+	for _, item := range strings.Split(r.Header.Get("Stripe-Signature"), ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(item), "=")
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			if signature, err := hex.DecodeString(value); err == nil {
+				signatures = append(signatures, signature)
+			}
+		}
+	}
+	if err := v.timestamps.check(timestamp); err != nil {
+		return "", err
+	}
+	for _, secret := range v.secrets {
+		expected := hmacSHA256(secret, []byte(timestamp), []byte("."), body)
+		for _, signature := range signatures {
+			if hmac.Equal(expected, signature) {
+				return hex.EncodeToString(signature), nil
+			}
+		}
+	}
+	return "", errInvalidSignature
+}
+
+// slackVerifier implements https://api.slack.com/authentication/verifying-requests-from-slack
+type slackVerifier struct {
+	secrets    [][]byte
+	timestamps timestampChecker
+}
+
+func (v *slackVerifier) Kind() string {
+	return "SLACK"
+}
+
+func (v *slackVerifier) Verify(r *http.Request, body []byte) (string, error) {
+	timestamp := r.Header.Get("X-Slack-Request-Timestamp")
+	if err := v.timestamps.check(timestamp); err != nil {
+		return "", err
+	}
+	signature, _ := hex.DecodeString(strings.TrimPrefix(r.Header.Get("X-Slack-Signature"), "v0="))
+	for _, secret := range v.secrets {
+		if hmac.Equal(hmacSHA256(secret, []byte("v0:"+timestamp+":"), body), signature) {
+			return hex.EncodeToString(signature), nil
+		}
+	}
+	return "", errInvalidSignature
+}
+
+// svixVerifier implements the Standard Webhooks specification, used by Svix. It
+// accepts both the webhook-* and the svix-* headers.
+type svixVerifier struct {
+	secrets    [][]byte
+	timestamps timestampChecker
+}
+
+func (v *svixVerifier) Kind() string {
+	return "SVIX"
+}
+
+func svixHeader(r *http.Request, name string) string {
+	if value := r.Header.Get("webhook-" + name); value != "" {
+		return value
+	}
+	return r.Header.Get("svix-" + name)
+}
+
+func (v *svixVerifier) Verify(r *http.Request, body []byte) (string, error) {
+	id := svixHeader(r, "id")
+	timestamp := svixHeader(r, "timestamp")
+	if id == "" {
+		return "", errors.New("missing message ID")
+	}
+	if err := v.timestamps.check(timestamp); err != nil {
+		return "", err
+	}
+	var signatures [][]byte
+	// Signatures are space separated, each one prefixed by its version
+	for _, item := range strings.Fields(svixHeader(r, "signature")) {
+		version, value, _ := strings.Cut(item, ",")
+		if version != "v1" {
+			continue
+		}
+		if signature, err := base64.StdEncoding.DecodeString(value); err == nil {
+			signatures = append(signatures, signature)
+		}
+	}
+	for _, secret := range v.secrets {
+		expected := hmacSHA256(secret, []byte(id+"."+timestamp+"."), body)
+		for _, signature := range signatures {
+			if hmac.Equal(expected, signature) {
+				return id, nil
+			}
+		}
+	}
+	return "", errInvalidSignature
+}
+
+// publicKeyVerifier verifies Ed25519 and RSA signatures of the body, preceded by
+// the timestamp if the timestampHeader is set (e.g. Discord interactions)
+type publicKeyVerifier struct {
+	kind                  wgpb.WebhookVerifierKind
+	keys                  []crypto.PublicKey
+	signatureHeader       string
+	signatureHeaderPrefix string
+	timestampHeader       string
+	timestamps            timestampChecker
+}
+
+func (v *publicKeyVerifier) Kind() string {
+	return v.kind.String()
+}
+
+func (v *publicKeyVerifier) Verify(r *http.Request, body []byte) (string, error) {
+	message := body
+	if v.timestampHeader != "" {
+		timestamp := r.Header.Get(v.timestampHeader)
+		if err := v.timestamps.check(timestamp); err != nil {
+			return "", err
+		}
+		message = append([]byte(timestamp), body...)
+	}
+	encoded := r.Header.Get(v.signatureHeader)
+	if v.signatureHeaderPrefix != "" {
+		encoded = strings.TrimPrefix(encoded, v.signatureHeaderPrefix)
+	}
+	signature, err := decodeSignature(encoded)
+	if err != nil {
+		return "", errInvalidSignature
+	}
+	digest := sha256.Sum256(message)
+	for _, key := range v.keys {
+		switch key := key.(type) {
+		case ed25519.PublicKey:
+			if ed25519.Verify(key, message, signature) {
+				return hex.EncodeToString(signature), nil
+			}
+		case *rsa.PublicKey:
+			if rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature) == nil {
+				return hex.EncodeToString(signature), nil
+			}
+		}
+	}
+	return "", errInvalidSignature
+}
+
+// decodeSignature accepts hex and base64 encoded signatures
+func decodeSignature(signature string) ([]byte, error) {
+	if decoded, err := hex.DecodeString(signature); err == nil {
+		return decoded, nil
+	}
+	if decoded, err := base64.StdEncoding.DecodeString(signature); err == nil {
+		return decoded, nil
+	}
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(signature, "="))
+}
+
+// parsePublicKey parses PEM encoded PKIX or PKCS #1 keys, as well as
+// hex or base64 encoded raw Ed25519 keys
+func parsePublicKey(key string) (crypto.PublicKey, error) {
+	if block, _ := pem.Decode([]byte(key)); block != nil {
+		switch block.Type {
+		case "PUBLIC KEY":
+			return x509.ParsePKIXPublicKey(block.Bytes)
+		case "RSA PUBLIC KEY":
+			return x509.ParsePKCS1PublicKey(block.Bytes)
+		}
+		return nil, fmt.Errorf("unsupported PEM block %s", block.Type)
+	}
+	raw, err := decodeSignature(strings.TrimSpace(key))
+	if err != nil || len(raw) != ed25519.PublicKeySize {
+		return nil, errors.New("invalid public key")
+	}
+	return ed25519.PublicKey(raw), nil
+}
@@ -2,31 +2,36 @@ package webhookhandler
 
 import (
 	"bytes"
-	"crypto/hmac"
-	"crypto/sha256"
-	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
-	"strings"
 
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	otrace "go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
-	"github.com/wundergraph/wundergraph/pkg/loadvariable"
+	"github.com/wundergraph/wundergraph/pkg/eventbus"
 	"github.com/wundergraph/wundergraph/pkg/trace"
 	"github.com/wundergraph/wundergraph/pkg/wgpb"
 )
 
-func New(config *wgpb.WebhookConfiguration, hooksServerURL string, log *zap.Logger) (http.Handler, error) {
+// New returns a handler proxying the webhook to the hooks server using transport, or
+// http.DefaultTransport if it's nil. If the webhook is queued, queue must not be nil.
+// If it publishes its events, they're sent to bus.
+func New(config *wgpb.WebhookConfiguration, hooksServerURL string, transport http.RoundTripper, queue *Queue, bus *eventbus.Bus, log *zap.Logger) (http.Handler, error) {
 	u, err := url.Parse(hooksServerURL)
 	if err != nil {
 		return nil, err
 	}
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
 	proxy := httputil.NewSingleHostReverseProxy(u)
-	proxy.Transport = trace.NewTransport(http.DefaultTransport,
+	proxy.Transport = trace.NewTransport(transport,
 		otelhttp.WithSpanOptions(otrace.WithAttributes(trace.WebhookTransportAttribute)),
 	)
 	handler := &webhookHandler{
@@ -34,79 +39,172 @@ func New(config *wgpb.WebhookConfiguration, hooksServerURL string, log *zap.Logg
 		log:         log,
 		proxy:       proxy,
 	}
+	if config.Queued {
+		if queue == nil {
+			return nil, fmt.Errorf("webhook %s is queued, but there's no webhook queue", config.Name)
+		}
+		handler.queue = queue
+		handler.maxDeliveryAttempts = int(config.MaxDeliveryAttempts)
+	}
+	if config.PublishEvents {
+		if bus == nil {
+			return nil, fmt.Errorf("webhook %s publishes events, but there's no event bus", config.Name)
+		}
+		if config.Verifier == nil {
+			// Subscribers can't tell who sent the events otherwise
+			return nil, fmt.Errorf("webhook %s publishes events, but it has no verifier", config.Name)
+		}
+		handler.bus = bus
+	}
 	if config.Verifier != nil {
-		switch config.Verifier.GetKind() {
-		case wgpb.WebhookVerifierKind_HMAC_SHA256:
-			handler.verifier = &sha256HMACVerifier{
-				secret:                []byte(loadvariable.String(config.Verifier.Secret)),
-				signatureHeader:       config.Verifier.SignatureHeader,
-				signatureHeaderPrefix: config.Verifier.SignatureHeaderPrefix,
-			}
+		handler.verifier, err = newVerifier(config.Verifier)
+		if err != nil {
+			return nil, fmt.Errorf("webhook %s: %w", config.Name, err)
+		}
+		handler.idHeader = config.Verifier.IdHeader
+		if config.Verifier.ReplayCacheSize > 0 {
+			handler.replays = newReplayCache(int(config.Verifier.ReplayCacheSize))
 		}
 	}
 	return handler, nil
 }
 
-type verifier interface {
-	Kind() string
-	Verify(r *http.Request) bool
-}
-
 type webhookHandler struct {
 	webhookName string
 	log         *zap.Logger
 	proxy       *httputil.ReverseProxy
 	verifier    verifier
+	idHeader    string
+	replays     *replayCache
+	// queue is used for POST requests, if not nil
+	queue               *Queue
+	maxDeliveryAttempts int
+	// bus receives the payloads of POST requests, if not nil
+	bus *eventbus.Bus
 }
 
 func (h *webhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	if r.Body != nil && h.verifier != nil {
-		if !h.verifier.Verify(r) {
+	queued := h.queue != nil && r.Method == http.MethodPost
+	publish := h.bus != nil && r.Method == http.MethodPost
+	if r.Body == nil || (h.verifier == nil && !queued && !publish) {
+		h.proxy.ServeHTTP(w, r)
+		return
+	}
+	body, err := io.ReadAll(r.Body)
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	var replayKey string
+	if h.verifier != nil {
+		replayKey, err = h.verify(r, body)
+		if err != nil {
 			h.log.Error("Webhook verification failed",
 				zap.String("webhook", h.webhookName),
 				zap.String("kind", h.verifier.Kind()),
 				zap.String("path", r.URL.Path),
+				zap.Error(err),
 			)
 			w.WriteHeader(401)
 			return
 		}
 	}
-	h.proxy.ServeHTTP(w, r)
+	if publish {
+		h.publish(body)
+	}
+	if !queued {
+		sw := &statusWriter{ResponseWriter: w}
+		h.proxy.ServeHTTP(sw, r)
+		if sw.statusCode < 200 || sw.statusCode > 299 {
+			// The sender retries failed deliveries with the same message
+			h.forget(replayKey)
+		}
+		return
+	}
+	d, err := h.queue.Enqueue(r.Context(), h.webhookName, r, body, h.maxDeliveryAttempts)
+	if err != nil {
+		h.log.Error("Queueing webhook failed",
+			zap.String("webhook", h.webhookName),
+			zap.Error(err),
+		)
+		// Let the sender retry it
+		h.forget(replayKey)
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	h.log.Debug("Webhook queued",
+		zap.String("webhook", h.webhookName),
+		zap.String("delivery", d.ID),
+	)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// publish sends the payload to the subscriptions, encoding it as a JSON
+// string if it's not valid JSON
+func (h *webhookHandler) publish(body []byte) {
+	event := body
+	if !json.Valid(body) {
+		event, _ = json.Marshal(string(body))
+	}
+	received := h.bus.Publish(eventbus.WebhookTopic(h.webhookName), event)
+	h.log.Debug("Webhook published",
+		zap.String("webhook", h.webhookName),
+		zap.Int("subscribers", received),
+	)
 }
 
-type sha256HMACVerifier struct {
-	secret                []byte
-	signatureHeader       string
-	signatureHeaderPrefix string
+// verify checks the message and records it in the replay cache, returning
+// its key in the cache (if any)
+func (h *webhookHandler) verify(r *http.Request, body []byte) (string, error) {
+	key, err := h.verifier.Verify(r, body)
+	if err != nil {
+		return "", err
+	}
+	if h.replays == nil {
+		return "", nil
+	}
+	if h.idHeader != "" {
+		key = r.Header.Get(h.idHeader)
+		if key == "" {
+			return "", fmt.Errorf("missing %s header", h.idHeader)
+		}
+	}
+	if !h.replays.add(key) {
+		return "", errors.New("message has already been received")
+	}
+	return key, nil
 }
 
-func (v *sha256HMACVerifier) bodySignatureHexString(body []byte) string {
-	hash := hmac.New(sha256.New, v.secret)
-	_, _ = hash.Write(body)
-	return hex.EncodeToString(hash.Sum(nil))
+// forget removes a message that wasn't delivered from the replay cache
+func (h *webhookHandler) forget(key string) {
+	if key != "" {
+		h.replays.remove(key)
+	}
 }
 
-func (v *sha256HMACVerifier) bodySignatureBytes(body []byte) []byte {
-	hash := hmac.New(sha256.New, v.secret)
-	_, _ = hash.Write(body)
-	return hash.Sum(nil)
+// statusWriter records the status code of the response
+type statusWriter struct {
+	http.ResponseWriter
+	statusCode int
 }
 
-func (v *sha256HMACVerifier) Kind() string {
-	return "HMAC_SHA256"
+func (w *statusWriter) WriteHeader(statusCode int) {
+	if w.statusCode == 0 {
+		w.statusCode = statusCode
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
 }
 
-func (v *sha256HMACVerifier) Verify(r *http.Request) bool {
-	body, err := io.ReadAll(r.Body)
-	r.Body = io.NopCloser(bytes.NewReader(body))
-	if err != nil {
-		return false
+func (w *statusWriter) Write(data []byte) (int, error) {
+	if w.statusCode == 0 {
+		w.statusCode = http.StatusOK
 	}
-	computedSignature := v.bodySignatureBytes(body)
-	signature := r.Header.Get(v.signatureHeader)
-	if v.signatureHeaderPrefix != "" {
-		signature = strings.TrimPrefix(signature, v.signatureHeaderPrefix)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *statusWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
 	}
-	signatureBytes, _ := hex.DecodeString(signature)
-	return hmac.Equal(computedSignature, signatureBytes)
 }
@@ -0,0 +1,336 @@
+package webhookhandler_test
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/wundergraph/wundergraph/pkg/eventbus"
+	"github.com/wundergraph/wundergraph/pkg/hooks"
+	"github.com/wundergraph/wundergraph/pkg/webhookhandler"
+	"github.com/wundergraph/wundergraph/pkg/wgpb"
+)
+
+const testBody = `{"event":"created"}`
+
+func staticVariable(value string) *wgpb.ConfigurationVariable {
+	return &wgpb.ConfigurationVariable{
+		Kind:                  wgpb.ConfigurationVariableKind_STATIC_CONFIGURATION_VARIABLE,
+		StaticVariableContent: value,
+	}
+}
+
+func hmacSHA256(secret []byte, data string) []byte {
+	hash := hmac.New(sha256.New, secret)
+	_, _ = hash.Write([]byte(data))
+	return hash.Sum(nil)
+}
+
+func newTestHandler(t *testing.T, verifier *wgpb.WebhookVerifier) http.Handler {
+	hooksServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The body must be forwarded after verifying it
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, testBody, string(body))
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(hooksServer.Close)
+	handler, err := webhookhandler.New(&wgpb.WebhookConfiguration{Name: "test", Verifier: verifier}, hooksServer.URL, nil, nil, nil, zap.NewNop())
+	require.NoError(t, err)
+	return handler
+}
+
+func sendWebhook(handler http.Handler, headers map[string]string) int {
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/test", strings.NewReader(testBody))
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec.Code
+}
+
+func now() string {
+	return strconv.FormatInt(time.Now().Unix(), 10)
+}
+
+func TestHMACVerifier(t *testing.T) {
+	handler := newTestHandler(t, &wgpb.WebhookVerifier{
+		Kind:                  wgpb.WebhookVerifierKind_HMAC_SHA256,
+		Secret:                staticVariable("new"),
+		AdditionalSecrets:     []*wgpb.ConfigurationVariable{staticVariable("old")},
+		SignatureHeader:       "X-Hub-Signature-256",
+		SignatureHeaderPrefix: "sha256=",
+	})
+	for _, secret := range []string{"new", "old"} {
+		signature := "sha256=" + hex.EncodeToString(hmacSHA256([]byte(secret), testBody))
+		assert.Equal(t, http.StatusOK, sendWebhook(handler, map[string]string{"X-Hub-Signature-256": signature}))
+	}
+	signature := "sha256=" + hex.EncodeToString(hmacSHA256([]byte("other"), testBody))
+	assert.Equal(t, http.StatusUnauthorized, sendWebhook(handler, map[string]string{"X-Hub-Signature-256": signature}))
+}
+
+func TestReplayCacheUsesDecodedSignatures(t *testing.T) {
+	handler := newTestHandler(t, &wgpb.WebhookVerifier{
+		Kind:            wgpb.WebhookVerifierKind_HMAC_SHA256,
+		Secret:          staticVariable("secret"),
+		SignatureHeader: "X-Signature",
+		ReplayCacheSize: 10,
+	})
+	signature := hex.EncodeToString(hmacSHA256([]byte("secret"), testBody))
+	assert.Equal(t, http.StatusOK, sendWebhook(handler, map[string]string{"X-Signature": signature}))
+	// The same signature encoded differently is still a replay
+	assert.Equal(t, http.StatusUnauthorized, sendWebhook(handler, map[string]string{"X-Signature": strings.ToUpper(signature)}))
+
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	handler = newTestHandler(t, &wgpb.WebhookVerifier{
+		Kind:            wgpb.WebhookVerifierKind_ED25519,
+		Secret:          staticVariable(hex.EncodeToString(public)),
+		SignatureHeader: "X-Signature",
+		ReplayCacheSize: 10,
+	})
+	signed := ed25519.Sign(private, []byte(testBody))
+	assert.Equal(t, http.StatusOK, sendWebhook(handler, map[string]string{"X-Signature": hex.EncodeToString(signed)}))
+	assert.Equal(t, http.StatusUnauthorized, sendWebhook(handler, map[string]string{"X-Signature": base64.StdEncoding.EncodeToString(signed)}))
+}
+
+func TestReplayCacheAcceptsRetriesOfFailedDeliveries(t *testing.T) {
+	var fail atomic.Bool
+	fail.Store(true)
+	hooksServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if fail.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer hooksServer.Close()
+	handler, err := webhookhandler.New(&wgpb.WebhookConfiguration{Name: "test", Verifier: &wgpb.WebhookVerifier{
+		Kind:            wgpb.WebhookVerifierKind_HMAC_SHA256,
+		Secret:          staticVariable("secret"),
+		SignatureHeader: "X-Signature",
+		ReplayCacheSize: 10,
+	}}, hooksServer.URL, nil, nil, nil, zap.NewNop())
+	require.NoError(t, err)
+
+	headers := map[string]string{"X-Signature": hex.EncodeToString(hmacSHA256([]byte("secret"), testBody))}
+	assert.Equal(t, http.StatusInternalServerError, sendWebhook(handler, headers))
+	// The sender retries the same message, which must not be a replay
+	fail.Store(false)
+	assert.Equal(t, http.StatusOK, sendWebhook(handler, headers))
+	assert.Equal(t, http.StatusUnauthorized, sendWebhook(handler, headers))
+
+	// The hooks server is unreachable
+	hooksServer.Close()
+	headers = map[string]string{"X-Signature": hex.EncodeToString(hmacSHA256([]byte("secret"), `{}`))}
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/test", strings.NewReader(`{}`))
+	req.Header.Set("X-Signature", headers["X-Signature"])
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusBadGateway, rec.Code)
+	req = httptest.NewRequest(http.MethodPost, "/webhooks/test", strings.NewReader(`{}`))
+	req.Header.Set("X-Signature", headers["X-Signature"])
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusBadGateway, rec.Code)
+}
+
+func TestStripeVerifier(t *testing.T) {
+	handler := newTestHandler(t, &wgpb.WebhookVerifier{
+		Kind:   wgpb.WebhookVerifierKind_STRIPE,
+		Secret: staticVariable("whsec_test"),
+	})
+	sign := func(timestamp string) string {
+		return "t=" + timestamp + ",v1=" + hex.EncodeToString(hmacSHA256([]byte("whsec_test"), timestamp+"."+testBody)) + ",v0=ignored"
+	}
+	assert.Equal(t, http.StatusOK, sendWebhook(handler, map[string]string{"Stripe-Signature": sign(now())}))
+
+	old := strconv.FormatInt(time.Now().Add(-10*time.Minute).Unix(), 10)
+	assert.Equal(t, http.StatusUnauthorized, sendWebhook(handler, map[string]string{"Stripe-Signature": sign(old)}))
+	// The timestamp is part of the signature
+	tampered := strings.Replace(sign(old), "t="+old, "t="+now(), 1)
+	assert.Equal(t, http.StatusUnauthorized, sendWebhook(handler, map[string]string{"Stripe-Signature": tampered}))
+}
+
+func TestSlackVerifier(t *testing.T) {
+	handler := newTestHandler(t, &wgpb.WebhookVerifier{
+		Kind:             wgpb.WebhookVerifierKind_SLACK,
+		Secret:           staticVariable("slack"),
+		ToleranceSeconds: -1,
+	})
+	timestamp := "1531420618"
+	assert.Equal(t, http.StatusOK, sendWebhook(handler, map[string]string{
+		"X-Slack-Request-Timestamp": timestamp,
+		"X-Slack-Signature":         "v0=" + hex.EncodeToString(hmacSHA256([]byte("slack"), "v0:"+timestamp+":"+testBody)),
+	}))
+	assert.Equal(t, http.StatusUnauthorized, sendWebhook(handler, map[string]string{
+		"X-Slack-Request-Timestamp": timestamp,
+		"X-Slack-Signature":         "v0=" + hex.EncodeToString(hmacSHA256([]byte("slack"), testBody)),
+	}))
+}
+
+func TestSvixVerifierWithReplayCache(t *testing.T) {
+	key := []byte("0123456789abcdef")
+	handler := newTestHandler(t, &wgpb.WebhookVerifier{
+		Kind:            wgpb.WebhookVerifierKind_SVIX,
+		Secret:          staticVariable("whsec_" + base64.StdEncoding.EncodeToString(key)),
+		ReplayCacheSize: 2,
+	})
+	headers := func(id string) map[string]string {
+		timestamp := now()
+		signature := base64.StdEncoding.EncodeToString(hmacSHA256(key, id+"."+timestamp+"."+testBody))
+		return map[string]string{
+			"webhook-id":        id,
+			"webhook-timestamp": timestamp,
+			"webhook-signature": "v1,invalid v1," + signature,
+		}
+	}
+	assert.Equal(t, http.StatusOK, sendWebhook(handler, headers("msg_1")))
+	assert.Equal(t, http.StatusUnauthorized, sendWebhook(handler, headers("msg_1")))
+	assert.Equal(t, http.StatusOK, sendWebhook(handler, headers("msg_2")))
+	assert.Equal(t, http.StatusOK, sendWebhook(handler, headers("msg_3")))
+	// Evicted once the cache is full
+	assert.Equal(t, http.StatusOK, sendWebhook(handler, headers("msg_1")))
+
+	// Svix specific headers are also accepted
+	svixHeaders := make(map[string]string)
+	for k, v := range headers("msg_4") {
+		svixHeaders[strings.Replace(k, "webhook-", "svix-", 1)] = v
+	}
+	assert.Equal(t, http.StatusOK, sendWebhook(handler, svixHeaders))
+}
+
+func TestEd25519Verifier(t *testing.T) {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	handler := newTestHandler(t, &wgpb.WebhookVerifier{
+		Kind:            wgpb.WebhookVerifierKind_ED25519,
+		Secret:          staticVariable(hex.EncodeToString(public)),
+		SignatureHeader: "X-Signature-Ed25519",
+		TimestampHeader: "X-Signature-Timestamp",
+	})
+	timestamp := now()
+	signature := ed25519.Sign(private, []byte(timestamp+testBody))
+	assert.Equal(t, http.StatusOK, sendWebhook(handler, map[string]string{
+		"X-Signature-Ed25519":   hex.EncodeToString(signature),
+		"X-Signature-Timestamp": timestamp,
+	}))
+	assert.Equal(t, http.StatusUnauthorized, sendWebhook(handler, map[string]string{
+		"X-Signature-Ed25519":   hex.EncodeToString(signature),
+		"X-Signature-Timestamp": strconv.FormatInt(time.Now().Unix()+1, 10),
+	}))
+}
+
+func TestRSAVerifier(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	verifier := &wgpb.WebhookVerifier{
+		Kind:            wgpb.WebhookVerifierKind_RSA_SHA256,
+		Secret:          staticVariable(string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))),
+		SignatureHeader: "X-Signature",
+	}
+	handler := newTestHandler(t, verifier)
+	digest := sha256.Sum256([]byte(testBody))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, sendWebhook(handler, map[string]string{"X-Signature": base64.StdEncoding.EncodeToString(signature)}))
+	assert.Equal(t, http.StatusUnauthorized, sendWebhook(handler, map[string]string{"X-Signature": base64.StdEncoding.EncodeToString(signature[1:])}))
+
+	// Keys must match the verifier kind
+	verifier.Kind = wgpb.WebhookVerifierKind_ED25519
+	_, err = webhookhandler.New(&wgpb.WebhookConfiguration{Name: "test", Verifier: verifier}, "http://localhost", nil, nil, nil, zap.NewNop())
+	assert.Error(t, err)
+}
+
+func TestPublishEvents(t *testing.T) {
+	hooksServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(hooksServer.Close)
+	config := &wgpb.WebhookConfiguration{
+		Name:          "test",
+		PublishEvents: true,
+		Verifier: &wgpb.WebhookVerifier{
+			Kind:            wgpb.WebhookVerifierKind_HMAC_SHA256,
+			Secret:          staticVariable("secret"),
+			SignatureHeader: "X-Signature",
+		},
+	}
+	_, err := webhookhandler.New(config, hooksServer.URL, nil, nil, nil, zap.NewNop())
+	assert.Error(t, err)
+
+	bus := eventbus.New()
+	_, err = webhookhandler.New(&wgpb.WebhookConfiguration{Name: "test", PublishEvents: true}, hooksServer.URL, nil, nil, bus, zap.NewNop())
+	assert.ErrorContains(t, err, "no verifier")
+
+	events, unsubscribe := bus.Subscribe(eventbus.WebhookTopic("test"))
+	defer unsubscribe()
+	handler, err := webhookhandler.New(config, hooksServer.URL, nil, nil, bus, zap.NewNop())
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusUnauthorized, sendWebhook(handler, map[string]string{"X-Signature": "invalid"}))
+	assert.Empty(t, events)
+
+	signature := hex.EncodeToString(hmacSHA256([]byte("secret"), testBody))
+	assert.Equal(t, http.StatusOK, sendWebhook(handler, map[string]string{"X-Signature": signature}))
+	require.Len(t, events, 1)
+	assert.Equal(t, testBody, string(<-events))
+
+	// Other payloads are published as strings
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/test", strings.NewReader("a=b"))
+	req.Header.Set("X-Signature", hex.EncodeToString(hmacSHA256([]byte("secret"), "a=b")))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	require.Len(t, events, 1)
+	assert.Equal(t, `"a=b"`, string(<-events))
+}
+
+func TestWebhookServerPool(t *testing.T) {
+	var mainHooks, webhookHooks int64
+	newServer := func(count *int64) *httptest.Server {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/webhooks/test" {
+				atomic.AddInt64(count, 1)
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		t.Cleanup(srv.Close)
+		return srv
+	}
+	main, webhooks := newServer(&mainHooks), newServer(&webhookHooks)
+	pool, err := hooks.NewServerPool(hooks.ServerPoolOptions{
+		ServerURLs: []string{main.URL},
+		Routes:     []hooks.ServerRoute{{Hook: "webhooks/*", ServerURLs: []string{webhooks.URL}}},
+		Logger:     zap.NewNop(),
+	})
+	require.NoError(t, err)
+	defer pool.Close()
+	handler, err := webhookhandler.New(&wgpb.WebhookConfiguration{Name: "test"}, pool.URL(), pool.Transport(http.DefaultTransport), nil, nil, zap.NewNop())
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, sendWebhook(handler, nil))
+	assert.Equal(t, int64(0), atomic.LoadInt64(&mainHooks))
+	assert.Equal(t, int64(1), atomic.LoadInt64(&webhookHooks))
+}
@@ -79,12 +79,69 @@ func (LogLevel) EnumDescriptor() ([]byte, []int) {
 	return file_wundernode_config_proto_rawDescGZIP(), []int{0}
 }
 
+type RoleMappingRuleKind int32
+
+const (
+	RoleMappingRuleKind_RoleMappingClaimEquals        RoleMappingRuleKind = 0
+	RoleMappingRuleKind_RoleMappingClaimContains      RoleMappingRuleKind = 1
+	RoleMappingRuleKind_RoleMappingEmailDomain        RoleMappingRuleKind = 2
+	RoleMappingRuleKind_RoleMappingGithubOrganization RoleMappingRuleKind = 3
+	RoleMappingRuleKind_RoleMappingGithubTeam         RoleMappingRuleKind = 4
+)
+
+// Enum value maps for RoleMappingRuleKind.
+var (
+	RoleMappingRuleKind_name = map[int32]string{
+		0: "RoleMappingClaimEquals",
+		1: "RoleMappingClaimContains",
+		2: "RoleMappingEmailDomain",
+		3: "RoleMappingGithubOrganization",
+		4: "RoleMappingGithubTeam",
+	}
+	RoleMappingRuleKind_value = map[string]int32{
+		"RoleMappingClaimEquals":        0,
+		"RoleMappingClaimContains":      1,
+		"RoleMappingEmailDomain":        2,
+		"RoleMappingGithubOrganization": 3,
+		"RoleMappingGithubTeam":         4,
+	}
+)
+
+func (x RoleMappingRuleKind) Enum() *RoleMappingRuleKind {
+	p := new(RoleMappingRuleKind)
+	*p = x
+	return p
+}
+
+func (x RoleMappingRuleKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RoleMappingRuleKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_wundernode_config_proto_enumTypes[1].Descriptor()
+}
+
+func (RoleMappingRuleKind) Type() protoreflect.EnumType {
+	return &file_wundernode_config_proto_enumTypes[1]
+}
+
+func (x RoleMappingRuleKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RoleMappingRuleKind.Descriptor instead.
+func (RoleMappingRuleKind) EnumDescriptor() ([]byte, []int) {
+	return file_wundernode_config_proto_rawDescGZIP(), []int{1}
+}
+
 type AuthProviderKind int32
 
 const (
 	AuthProviderKind_AuthProviderGithub AuthProviderKind = 0
 	AuthProviderKind_AuthProviderOIDC   AuthProviderKind = 1
 	AuthProviderKind_AuthProviderAuth0  AuthProviderKind = 2
+	AuthProviderKind_AuthProviderOAuth2 AuthProviderKind = 3
+	AuthProviderKind_AuthProviderSAML   AuthProviderKind = 4
 )
 
 // Enum value maps for AuthProviderKind.
@@ -93,11 +150,15 @@ var (
 		0: "AuthProviderGithub",
 		1: "AuthProviderOIDC",
 		2: "AuthProviderAuth0",
+		3: "AuthProviderOAuth2",
+		4: "AuthProviderSAML",
 	}
 	AuthProviderKind_value = map[string]int32{
 		"AuthProviderGithub": 0,
 		"AuthProviderOIDC":   1,
 		"AuthProviderAuth0":  2,
+		"AuthProviderOAuth2": 3,
+		"AuthProviderSAML":   4,
 	}
 )
 
@@ -112,11 +173,11 @@ func (x AuthProviderKind) String() string {
 }
 
 func (AuthProviderKind) Descriptor() protoreflect.EnumDescriptor {
-	return file_wundernode_config_proto_enumTypes[1].Descriptor()
+	return file_wundernode_config_proto_enumTypes[2].Descriptor()
 }
 
 func (AuthProviderKind) Type() protoreflect.EnumType {
-	return &file_wundernode_config_proto_enumTypes[1]
+	return &file_wundernode_config_proto_enumTypes[2]
 }
 
 func (x AuthProviderKind) Number() protoreflect.EnumNumber {
@@ -125,7 +186,7 @@ func (x AuthProviderKind) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AuthProviderKind.Descriptor instead.
 func (AuthProviderKind) EnumDescriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{1}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{2}
 }
 
 type OperationExecutionEngine int32
@@ -158,11 +219,11 @@ func (x OperationExecutionEngine) String() string {
 }
 
 func (OperationExecutionEngine) Descriptor() protoreflect.EnumDescriptor {
-	return file_wundernode_config_proto_enumTypes[2].Descriptor()
+	return file_wundernode_config_proto_enumTypes[3].Descriptor()
 }
 
 func (OperationExecutionEngine) Type() protoreflect.EnumType {
-	return &file_wundernode_config_proto_enumTypes[2]
+	return &file_wundernode_config_proto_enumTypes[3]
 }
 
 func (x OperationExecutionEngine) Number() protoreflect.EnumNumber {
@@ -171,7 +232,7 @@ func (x OperationExecutionEngine) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use OperationExecutionEngine.Descriptor instead.
 func (OperationExecutionEngine) EnumDescriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{2}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{3}
 }
 
 type PostResolveTransformationKind int32
@@ -201,11 +262,11 @@ func (x PostResolveTransformationKind) String() string {
 }
 
 func (PostResolveTransformationKind) Descriptor() protoreflect.EnumDescriptor {
-	return file_wundernode_config_proto_enumTypes[3].Descriptor()
+	return file_wundernode_config_proto_enumTypes[4].Descriptor()
 }
 
 func (PostResolveTransformationKind) Type() protoreflect.EnumType {
-	return &file_wundernode_config_proto_enumTypes[3]
+	return &file_wundernode_config_proto_enumTypes[4]
 }
 
 func (x PostResolveTransformationKind) Number() protoreflect.EnumNumber {
@@ -214,7 +275,7 @@ func (x PostResolveTransformationKind) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PostResolveTransformationKind.Descriptor instead.
 func (PostResolveTransformationKind) EnumDescriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{3}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{4}
 }
 
 type InjectVariableKind int32
@@ -250,11 +311,11 @@ func (x InjectVariableKind) String() string {
 }
 
 func (InjectVariableKind) Descriptor() protoreflect.EnumDescriptor {
-	return file_wundernode_config_proto_enumTypes[4].Descriptor()
+	return file_wundernode_config_proto_enumTypes[5].Descriptor()
 }
 
 func (InjectVariableKind) Type() protoreflect.EnumType {
-	return &file_wundernode_config_proto_enumTypes[4]
+	return &file_wundernode_config_proto_enumTypes[5]
 }
 
 func (x InjectVariableKind) Number() protoreflect.EnumNumber {
@@ -263,22 +324,30 @@ func (x InjectVariableKind) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use InjectVariableKind.Descriptor instead.
 func (InjectVariableKind) EnumDescriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{4}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{5}
 }
 
 type HookType int32
 
 const (
 	HookType_HTTP_TRANSPORT HookType = 0
+	// Restricts the global onOriginRequest hook to the matching requests
+	HookType_HTTP_ORIGIN_REQUEST HookType = 1
+	// Restricts the global onOriginResponse hook to the matching requests
+	HookType_HTTP_ORIGIN_RESPONSE HookType = 2
 )
 
 // Enum value maps for HookType.
 var (
 	HookType_name = map[int32]string{
 		0: "HTTP_TRANSPORT",
+		1: "HTTP_ORIGIN_REQUEST",
+		2: "HTTP_ORIGIN_RESPONSE",
 	}
 	HookType_value = map[string]int32{
-		"HTTP_TRANSPORT": 0,
+		"HTTP_TRANSPORT":       0,
+		"HTTP_ORIGIN_REQUEST":  1,
+		"HTTP_ORIGIN_RESPONSE": 2,
 	}
 )
 
@@ -293,11 +362,11 @@ func (x HookType) String() string {
 }
 
 func (HookType) Descriptor() protoreflect.EnumDescriptor {
-	return file_wundernode_config_proto_enumTypes[5].Descriptor()
+	return file_wundernode_config_proto_enumTypes[6].Descriptor()
 }
 
 func (HookType) Type() protoreflect.EnumType {
-	return &file_wundernode_config_proto_enumTypes[5]
+	return &file_wundernode_config_proto_enumTypes[6]
 }
 
 func (x HookType) Number() protoreflect.EnumNumber {
@@ -306,7 +375,64 @@ func (x HookType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use HookType.Descriptor instead.
 func (HookType) EnumDescriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{5}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{6}
+}
+
+type ExpressionHookAction int32
+
+const (
+	ExpressionHookAction_ExpressionHookSetHeader   ExpressionHookAction = 0
+	ExpressionHookAction_ExpressionHookSetVariable ExpressionHookAction = 1
+	ExpressionHookAction_ExpressionHookReject      ExpressionHookAction = 2
+	// Drop a subscription message
+	ExpressionHookAction_ExpressionHookSkip ExpressionHookAction = 3
+	// Set a field of a subscription message
+	ExpressionHookAction_ExpressionHookSetEventField ExpressionHookAction = 4
+)
+
+// Enum value maps for ExpressionHookAction.
+var (
+	ExpressionHookAction_name = map[int32]string{
+		0: "ExpressionHookSetHeader",
+		1: "ExpressionHookSetVariable",
+		2: "ExpressionHookReject",
+		3: "ExpressionHookSkip",
+		4: "ExpressionHookSetEventField",
+	}
+	ExpressionHookAction_value = map[string]int32{
+		"ExpressionHookSetHeader":     0,
+		"ExpressionHookSetVariable":   1,
+		"ExpressionHookReject":        2,
+		"ExpressionHookSkip":          3,
+		"ExpressionHookSetEventField": 4,
+	}
+)
+
+func (x ExpressionHookAction) Enum() *ExpressionHookAction {
+	p := new(ExpressionHookAction)
+	*p = x
+	return p
+}
+
+func (x ExpressionHookAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExpressionHookAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_wundernode_config_proto_enumTypes[7].Descriptor()
+}
+
+func (ExpressionHookAction) Type() protoreflect.EnumType {
+	return &file_wundernode_config_proto_enumTypes[7]
+}
+
+func (x ExpressionHookAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExpressionHookAction.Descriptor instead.
+func (ExpressionHookAction) EnumDescriptor() ([]byte, []int) {
+	return file_wundernode_config_proto_rawDescGZIP(), []int{7}
 }
 
 type ClaimType int32
@@ -396,11 +522,11 @@ func (x ClaimType) String() string {
 }
 
 func (ClaimType) Descriptor() protoreflect.EnumDescriptor {
-	return file_wundernode_config_proto_enumTypes[6].Descriptor()
+	return file_wundernode_config_proto_enumTypes[8].Descriptor()
 }
 
 func (ClaimType) Type() protoreflect.EnumType {
-	return &file_wundernode_config_proto_enumTypes[6]
+	return &file_wundernode_config_proto_enumTypes[8]
 }
 
 func (x ClaimType) Number() protoreflect.EnumNumber {
@@ -409,7 +535,7 @@ func (x ClaimType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ClaimType.Descriptor instead.
 func (ClaimType) EnumDescriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{6}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{8}
 }
 
 type ValueType int32
@@ -451,11 +577,11 @@ func (x ValueType) String() string {
 }
 
 func (ValueType) Descriptor() protoreflect.EnumDescriptor {
-	return file_wundernode_config_proto_enumTypes[7].Descriptor()
+	return file_wundernode_config_proto_enumTypes[9].Descriptor()
 }
 
 func (ValueType) Type() protoreflect.EnumType {
-	return &file_wundernode_config_proto_enumTypes[7]
+	return &file_wundernode_config_proto_enumTypes[9]
 }
 
 func (x ValueType) Number() protoreflect.EnumNumber {
@@ -464,7 +590,7 @@ func (x ValueType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ValueType.Descriptor instead.
 func (ValueType) EnumDescriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{7}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{9}
 }
 
 type OperationType int32
@@ -503,11 +629,11 @@ func (x OperationType) String() string {
 }
 
 func (OperationType) Descriptor() protoreflect.EnumDescriptor {
-	return file_wundernode_config_proto_enumTypes[8].Descriptor()
+	return file_wundernode_config_proto_enumTypes[10].Descriptor()
 }
 
 func (OperationType) Type() protoreflect.EnumType {
-	return &file_wundernode_config_proto_enumTypes[8]
+	return &file_wundernode_config_proto_enumTypes[10]
 }
 
 func (x OperationType) Number() protoreflect.EnumNumber {
@@ -516,49 +642,52 @@ func (x OperationType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use OperationType.Descriptor instead.
 func (OperationType) EnumDescriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{8}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{10}
 }
 
 type DataSourceKind int32
 
 const (
-	DataSourceKind_STATIC     DataSourceKind = 0
-	DataSourceKind_REST       DataSourceKind = 1
-	DataSourceKind_GRAPHQL    DataSourceKind = 2
-	DataSourceKind_POSTGRESQL DataSourceKind = 3
-	DataSourceKind_MYSQL      DataSourceKind = 4
-	DataSourceKind_SQLSERVER  DataSourceKind = 5
-	DataSourceKind_MONGODB    DataSourceKind = 6
-	DataSourceKind_SQLITE     DataSourceKind = 7
-	DataSourceKind_PRISMA     DataSourceKind = 8
-	DataSourceKind_NATSKV     DataSourceKind = 9
+	DataSourceKind_STATIC         DataSourceKind = 0
+	DataSourceKind_REST           DataSourceKind = 1
+	DataSourceKind_GRAPHQL        DataSourceKind = 2
+	DataSourceKind_POSTGRESQL     DataSourceKind = 3
+	DataSourceKind_MYSQL          DataSourceKind = 4
+	DataSourceKind_SQLSERVER      DataSourceKind = 5
+	DataSourceKind_MONGODB        DataSourceKind = 6
+	DataSourceKind_SQLITE         DataSourceKind = 7
+	DataSourceKind_PRISMA         DataSourceKind = 8
+	DataSourceKind_NATSKV         DataSourceKind = 9
+	DataSourceKind_WEBHOOK_EVENTS DataSourceKind = 10
 )
 
 // Enum value maps for DataSourceKind.
 var (
 	DataSourceKind_name = map[int32]string{
-		0: "STATIC",
-		1: "REST",
-		2: "GRAPHQL",
-		3: "POSTGRESQL",
-		4: "MYSQL",
-		5: "SQLSERVER",
-		6: "MONGODB",
-		7: "SQLITE",
-		8: "PRISMA",
-		9: "NATSKV",
+		0:  "STATIC",
+		1:  "REST",
+		2:  "GRAPHQL",
+		3:  "POSTGRESQL",
+		4:  "MYSQL",
+		5:  "SQLSERVER",
+		6:  "MONGODB",
+		7:  "SQLITE",
+		8:  "PRISMA",
+		9:  "NATSKV",
+		10: "WEBHOOK_EVENTS",
 	}
 	DataSourceKind_value = map[string]int32{
-		"STATIC":     0,
-		"REST":       1,
-		"GRAPHQL":    2,
-		"POSTGRESQL": 3,
-		"MYSQL":      4,
-		"SQLSERVER":  5,
-		"MONGODB":    6,
-		"SQLITE":     7,
-		"PRISMA":     8,
-		"NATSKV":     9,
+		"STATIC":         0,
+		"REST":           1,
+		"GRAPHQL":        2,
+		"POSTGRESQL":     3,
+		"MYSQL":          4,
+		"SQLSERVER":      5,
+		"MONGODB":        6,
+		"SQLITE":         7,
+		"PRISMA":         8,
+		"NATSKV":         9,
+		"WEBHOOK_EVENTS": 10,
 	}
 )
 
@@ -573,11 +702,11 @@ func (x DataSourceKind) String() string {
 }
 
 func (DataSourceKind) Descriptor() protoreflect.EnumDescriptor {
-	return file_wundernode_config_proto_enumTypes[9].Descriptor()
+	return file_wundernode_config_proto_enumTypes[11].Descriptor()
 }
 
 func (DataSourceKind) Type() protoreflect.EnumType {
-	return &file_wundernode_config_proto_enumTypes[9]
+	return &file_wundernode_config_proto_enumTypes[11]
 }
 
 func (x DataSourceKind) Number() protoreflect.EnumNumber {
@@ -586,7 +715,7 @@ func (x DataSourceKind) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DataSourceKind.Descriptor instead.
 func (DataSourceKind) EnumDescriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{9}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{11}
 }
 
 type NatsKvOperation int32
@@ -646,11 +775,11 @@ func (x NatsKvOperation) String() string {
 }
 
 func (NatsKvOperation) Descriptor() protoreflect.EnumDescriptor {
-	return file_wundernode_config_proto_enumTypes[10].Descriptor()
+	return file_wundernode_config_proto_enumTypes[12].Descriptor()
 }
 
 func (NatsKvOperation) Type() protoreflect.EnumType {
-	return &file_wundernode_config_proto_enumTypes[10]
+	return &file_wundernode_config_proto_enumTypes[12]
 }
 
 func (x NatsKvOperation) Number() protoreflect.EnumNumber {
@@ -659,7 +788,7 @@ func (x NatsKvOperation) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NatsKvOperation.Descriptor instead.
 func (NatsKvOperation) EnumDescriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{10}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{12}
 }
 
 type UpstreamAuthenticationKind int32
@@ -692,11 +821,11 @@ func (x UpstreamAuthenticationKind) String() string {
 }
 
 func (UpstreamAuthenticationKind) Descriptor() protoreflect.EnumDescriptor {
-	return file_wundernode_config_proto_enumTypes[11].Descriptor()
+	return file_wundernode_config_proto_enumTypes[13].Descriptor()
 }
 
 func (UpstreamAuthenticationKind) Type() protoreflect.EnumType {
-	return &file_wundernode_config_proto_enumTypes[11]
+	return &file_wundernode_config_proto_enumTypes[13]
 }
 
 func (x UpstreamAuthenticationKind) Number() protoreflect.EnumNumber {
@@ -705,7 +834,7 @@ func (x UpstreamAuthenticationKind) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UpstreamAuthenticationKind.Descriptor instead.
 func (UpstreamAuthenticationKind) EnumDescriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{11}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{13}
 }
 
 type SigningMethod int32
@@ -735,11 +864,11 @@ func (x SigningMethod) String() string {
 }
 
 func (SigningMethod) Descriptor() protoreflect.EnumDescriptor {
-	return file_wundernode_config_proto_enumTypes[12].Descriptor()
+	return file_wundernode_config_proto_enumTypes[14].Descriptor()
 }
 
 func (SigningMethod) Type() protoreflect.EnumType {
-	return &file_wundernode_config_proto_enumTypes[12]
+	return &file_wundernode_config_proto_enumTypes[14]
 }
 
 func (x SigningMethod) Number() protoreflect.EnumNumber {
@@ -748,7 +877,7 @@ func (x SigningMethod) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SigningMethod.Descriptor instead.
 func (SigningMethod) EnumDescriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{12}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{14}
 }
 
 type HTTPMethod int32
@@ -790,11 +919,11 @@ func (x HTTPMethod) String() string {
 }
 
 func (HTTPMethod) Descriptor() protoreflect.EnumDescriptor {
-	return file_wundernode_config_proto_enumTypes[13].Descriptor()
+	return file_wundernode_config_proto_enumTypes[15].Descriptor()
 }
 
 func (HTTPMethod) Type() protoreflect.EnumType {
-	return &file_wundernode_config_proto_enumTypes[13]
+	return &file_wundernode_config_proto_enumTypes[15]
 }
 
 func (x HTTPMethod) Number() protoreflect.EnumNumber {
@@ -803,7 +932,7 @@ func (x HTTPMethod) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use HTTPMethod.Descriptor instead.
 func (HTTPMethod) EnumDescriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{13}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{15}
 }
 
 type ArgumentSource int32
@@ -836,11 +965,11 @@ func (x ArgumentSource) String() string {
 }
 
 func (ArgumentSource) Descriptor() protoreflect.EnumDescriptor {
-	return file_wundernode_config_proto_enumTypes[14].Descriptor()
+	return file_wundernode_config_proto_enumTypes[16].Descriptor()
 }
 
 func (ArgumentSource) Type() protoreflect.EnumType {
-	return &file_wundernode_config_proto_enumTypes[14]
+	return &file_wundernode_config_proto_enumTypes[16]
 }
 
 func (x ArgumentSource) Number() protoreflect.EnumNumber {
@@ -849,7 +978,7 @@ func (x ArgumentSource) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ArgumentSource.Descriptor instead.
 func (ArgumentSource) EnumDescriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{14}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{16}
 }
 
 type ArgumentRenderConfiguration int32
@@ -885,11 +1014,11 @@ func (x ArgumentRenderConfiguration) String() string {
 }
 
 func (ArgumentRenderConfiguration) Descriptor() protoreflect.EnumDescriptor {
-	return file_wundernode_config_proto_enumTypes[15].Descriptor()
+	return file_wundernode_config_proto_enumTypes[17].Descriptor()
 }
 
 func (ArgumentRenderConfiguration) Type() protoreflect.EnumType {
-	return &file_wundernode_config_proto_enumTypes[15]
+	return &file_wundernode_config_proto_enumTypes[17]
 }
 
 func (x ArgumentRenderConfiguration) Number() protoreflect.EnumNumber {
@@ -898,163 +1027,482 @@ func (x ArgumentRenderConfiguration) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ArgumentRenderConfiguration.Descriptor instead.
 func (ArgumentRenderConfiguration) EnumDescriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{15}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{17}
 }
 
-type WebhookVerifierKind int32
+type ImageFit int32
 
 const (
-	WebhookVerifierKind_HMAC_SHA256 WebhookVerifierKind = 0
+	ImageFit_ImageFitContain ImageFit = 0
+	ImageFit_ImageFitCover   ImageFit = 1
+	ImageFit_ImageFitFill    ImageFit = 2
 )
 
-// Enum value maps for WebhookVerifierKind.
+// Enum value maps for ImageFit.
 var (
-	WebhookVerifierKind_name = map[int32]string{
-		0: "HMAC_SHA256",
+	ImageFit_name = map[int32]string{
+		0: "ImageFitContain",
+		1: "ImageFitCover",
+		2: "ImageFitFill",
 	}
-	WebhookVerifierKind_value = map[string]int32{
-		"HMAC_SHA256": 0,
+	ImageFit_value = map[string]int32{
+		"ImageFitContain": 0,
+		"ImageFitCover":   1,
+		"ImageFitFill":    2,
 	}
 )
 
-func (x WebhookVerifierKind) Enum() *WebhookVerifierKind {
-	p := new(WebhookVerifierKind)
+func (x ImageFit) Enum() *ImageFit {
+	p := new(ImageFit)
 	*p = x
 	return p
 }
 
-func (x WebhookVerifierKind) String() string {
+func (x ImageFit) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (WebhookVerifierKind) Descriptor() protoreflect.EnumDescriptor {
-	return file_wundernode_config_proto_enumTypes[16].Descriptor()
+func (ImageFit) Descriptor() protoreflect.EnumDescriptor {
+	return file_wundernode_config_proto_enumTypes[18].Descriptor()
 }
 
-func (WebhookVerifierKind) Type() protoreflect.EnumType {
-	return &file_wundernode_config_proto_enumTypes[16]
+func (ImageFit) Type() protoreflect.EnumType {
+	return &file_wundernode_config_proto_enumTypes[18]
 }
 
-func (x WebhookVerifierKind) Number() protoreflect.EnumNumber {
+func (x ImageFit) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use WebhookVerifierKind.Descriptor instead.
-func (WebhookVerifierKind) EnumDescriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ImageFit.Descriptor instead.
+func (ImageFit) EnumDescriptor() ([]byte, []int) {
+	return file_wundernode_config_proto_rawDescGZIP(), []int{18}
 }
 
-type ConfigurationVariableKind int32
+type ImageFormat int32
 
 const (
-	ConfigurationVariableKind_STATIC_CONFIGURATION_VARIABLE      ConfigurationVariableKind = 0
-	ConfigurationVariableKind_ENV_CONFIGURATION_VARIABLE         ConfigurationVariableKind = 1
-	ConfigurationVariableKind_PLACEHOLDER_CONFIGURATION_VARIABLE ConfigurationVariableKind = 2
+	ImageFormat_ImageFormatOriginal ImageFormat = 0
+	ImageFormat_ImageFormatJPEG     ImageFormat = 1
+	ImageFormat_ImageFormatPNG      ImageFormat = 2
+	ImageFormat_ImageFormatWebP     ImageFormat = 3
 )
 
-// Enum value maps for ConfigurationVariableKind.
+// Enum value maps for ImageFormat.
 var (
-	ConfigurationVariableKind_name = map[int32]string{
-		0: "STATIC_CONFIGURATION_VARIABLE",
-		1: "ENV_CONFIGURATION_VARIABLE",
-		2: "PLACEHOLDER_CONFIGURATION_VARIABLE",
-	}
-	ConfigurationVariableKind_value = map[string]int32{
-		"STATIC_CONFIGURATION_VARIABLE":      0,
-		"ENV_CONFIGURATION_VARIABLE":         1,
-		"PLACEHOLDER_CONFIGURATION_VARIABLE": 2,
+	ImageFormat_name = map[int32]string{
+		0: "ImageFormatOriginal",
+		1: "ImageFormatJPEG",
+		2: "ImageFormatPNG",
+		3: "ImageFormatWebP",
+	}
+	ImageFormat_value = map[string]int32{
+		"ImageFormatOriginal": 0,
+		"ImageFormatJPEG":     1,
+		"ImageFormatPNG":      2,
+		"ImageFormatWebP":     3,
 	}
 )
 
-func (x ConfigurationVariableKind) Enum() *ConfigurationVariableKind {
-	p := new(ConfigurationVariableKind)
+func (x ImageFormat) Enum() *ImageFormat {
+	p := new(ImageFormat)
 	*p = x
 	return p
 }
 
-func (x ConfigurationVariableKind) String() string {
+func (x ImageFormat) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (ConfigurationVariableKind) Descriptor() protoreflect.EnumDescriptor {
-	return file_wundernode_config_proto_enumTypes[17].Descriptor()
+func (ImageFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_wundernode_config_proto_enumTypes[19].Descriptor()
 }
 
-func (ConfigurationVariableKind) Type() protoreflect.EnumType {
-	return &file_wundernode_config_proto_enumTypes[17]
+func (ImageFormat) Type() protoreflect.EnumType {
+	return &file_wundernode_config_proto_enumTypes[19]
 }
 
-func (x ConfigurationVariableKind) Number() protoreflect.EnumNumber {
+func (x ImageFormat) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use ConfigurationVariableKind.Descriptor instead.
-func (ConfigurationVariableKind) EnumDescriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ImageFormat.Descriptor instead.
+func (ImageFormat) EnumDescriptor() ([]byte, []int) {
+	return file_wundernode_config_proto_rawDescGZIP(), []int{19}
 }
 
-type ApiAuthenticationConfig struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type UploadStorageKind int32
 
-	CookieBased  *CookieBasedAuthentication `protobuf:"bytes,1,opt,name=cookieBased,proto3" json:"cookieBased,omitempty"`
-	Hooks        *ApiAuthenticationHooks    `protobuf:"bytes,2,opt,name=hooks,proto3" json:"hooks,omitempty"`
-	JwksBased    *JwksBasedAuthentication   `protobuf:"bytes,3,opt,name=jwksBased,proto3" json:"jwksBased,omitempty"`
-	PublicClaims []string                   `protobuf:"bytes,4,rep,name=publicClaims,proto3" json:"publicClaims,omitempty"`
-}
+const (
+	UploadStorageKind_UploadStorageS3    UploadStorageKind = 0
+	UploadStorageKind_UploadStorageLocal UploadStorageKind = 1
+	UploadStorageKind_UploadStorageGCS   UploadStorageKind = 2
+	UploadStorageKind_UploadStorageAzure UploadStorageKind = 3
+)
 
-func (x *ApiAuthenticationConfig) Reset() {
-	*x = ApiAuthenticationConfig{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for UploadStorageKind.
+var (
+	UploadStorageKind_name = map[int32]string{
+		0: "UploadStorageS3",
+		1: "UploadStorageLocal",
+		2: "UploadStorageGCS",
+		3: "UploadStorageAzure",
+	}
+	UploadStorageKind_value = map[string]int32{
+		"UploadStorageS3":    0,
+		"UploadStorageLocal": 1,
+		"UploadStorageGCS":   2,
+		"UploadStorageAzure": 3,
 	}
+)
+
+func (x UploadStorageKind) Enum() *UploadStorageKind {
+	p := new(UploadStorageKind)
+	*p = x
+	return p
 }
 
-func (x *ApiAuthenticationConfig) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x UploadStorageKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*ApiAuthenticationConfig) ProtoMessage() {}
+func (UploadStorageKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_wundernode_config_proto_enumTypes[20].Descriptor()
+}
 
-func (x *ApiAuthenticationConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (UploadStorageKind) Type() protoreflect.EnumType {
+	return &file_wundernode_config_proto_enumTypes[20]
 }
 
-// Deprecated: Use ApiAuthenticationConfig.ProtoReflect.Descriptor instead.
-func (*ApiAuthenticationConfig) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{0}
+func (x UploadStorageKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *ApiAuthenticationConfig) GetCookieBased() *CookieBasedAuthentication {
-	if x != nil {
-		return x.CookieBased
-	}
-	return nil
+// Deprecated: Use UploadStorageKind.Descriptor instead.
+func (UploadStorageKind) EnumDescriptor() ([]byte, []int) {
+	return file_wundernode_config_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ApiAuthenticationConfig) GetHooks() *ApiAuthenticationHooks {
-	if x != nil {
-		return x.Hooks
+type WebhookQueueKind int32
+
+const (
+	WebhookQueueKind_WebhookQueueFile WebhookQueueKind = 0
+	WebhookQueueKind_WebhookQueueNats WebhookQueueKind = 1
+)
+
+// Enum value maps for WebhookQueueKind.
+var (
+	WebhookQueueKind_name = map[int32]string{
+		0: "WebhookQueueFile",
+		1: "WebhookQueueNats",
 	}
-	return nil
+	WebhookQueueKind_value = map[string]int32{
+		"WebhookQueueFile": 0,
+		"WebhookQueueNats": 1,
+	}
+)
+
+func (x WebhookQueueKind) Enum() *WebhookQueueKind {
+	p := new(WebhookQueueKind)
+	*p = x
+	return p
 }
 
-func (x *ApiAuthenticationConfig) GetJwksBased() *JwksBasedAuthentication {
-	if x != nil {
-		return x.JwksBased
-	}
-	return nil
+func (x WebhookQueueKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhookQueueKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_wundernode_config_proto_enumTypes[21].Descriptor()
+}
+
+func (WebhookQueueKind) Type() protoreflect.EnumType {
+	return &file_wundernode_config_proto_enumTypes[21]
+}
+
+func (x WebhookQueueKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhookQueueKind.Descriptor instead.
+func (WebhookQueueKind) EnumDescriptor() ([]byte, []int) {
+	return file_wundernode_config_proto_rawDescGZIP(), []int{21}
+}
+
+type HookFailureMode int32
+
+const (
+	// Fail the request when the hook fails
+	HookFailureMode_HookFailClosed HookFailureMode = 0
+	// Continue with the original data, as if the hook didn't modify it
+	HookFailureMode_HookFailOpen HookFailureMode = 1
+	// Use fallbackResponse as the response of the hook
+	HookFailureMode_HookFailFallback HookFailureMode = 2
+)
+
+// Enum value maps for HookFailureMode.
+var (
+	HookFailureMode_name = map[int32]string{
+		0: "HookFailClosed",
+		1: "HookFailOpen",
+		2: "HookFailFallback",
+	}
+	HookFailureMode_value = map[string]int32{
+		"HookFailClosed":   0,
+		"HookFailOpen":     1,
+		"HookFailFallback": 2,
+	}
+)
+
+func (x HookFailureMode) Enum() *HookFailureMode {
+	p := new(HookFailureMode)
+	*p = x
+	return p
+}
+
+func (x HookFailureMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HookFailureMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_wundernode_config_proto_enumTypes[22].Descriptor()
+}
+
+func (HookFailureMode) Type() protoreflect.EnumType {
+	return &file_wundernode_config_proto_enumTypes[22]
+}
+
+func (x HookFailureMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HookFailureMode.Descriptor instead.
+func (HookFailureMode) EnumDescriptor() ([]byte, []int) {
+	return file_wundernode_config_proto_rawDescGZIP(), []int{22}
+}
+
+type HooksLoadBalancing int32
+
+const (
+	HooksLoadBalancing_HooksLoadBalancingRoundRobin HooksLoadBalancing = 0
+	// Send each hook to the server with the fewest requests in flight
+	HooksLoadBalancing_HooksLoadBalancingLeastOutstanding HooksLoadBalancing = 1
+)
+
+// Enum value maps for HooksLoadBalancing.
+var (
+	HooksLoadBalancing_name = map[int32]string{
+		0: "HooksLoadBalancingRoundRobin",
+		1: "HooksLoadBalancingLeastOutstanding",
+	}
+	HooksLoadBalancing_value = map[string]int32{
+		"HooksLoadBalancingRoundRobin":       0,
+		"HooksLoadBalancingLeastOutstanding": 1,
+	}
+)
+
+func (x HooksLoadBalancing) Enum() *HooksLoadBalancing {
+	p := new(HooksLoadBalancing)
+	*p = x
+	return p
+}
+
+func (x HooksLoadBalancing) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HooksLoadBalancing) Descriptor() protoreflect.EnumDescriptor {
+	return file_wundernode_config_proto_enumTypes[23].Descriptor()
+}
+
+func (HooksLoadBalancing) Type() protoreflect.EnumType {
+	return &file_wundernode_config_proto_enumTypes[23]
+}
+
+func (x HooksLoadBalancing) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HooksLoadBalancing.Descriptor instead.
+func (HooksLoadBalancing) EnumDescriptor() ([]byte, []int) {
+	return file_wundernode_config_proto_rawDescGZIP(), []int{23}
+}
+
+type WebhookVerifierKind int32
+
+const (
+	// Hex encoded HMAC-SHA256 of the body
+	WebhookVerifierKind_HMAC_SHA256 WebhookVerifierKind = 0
+	// Stripe-Signature header with the HMAC-SHA256 of timestamp.body
+	WebhookVerifierKind_STRIPE WebhookVerifierKind = 1
+	// X-Slack-Signature header with the HMAC-SHA256 of v0:timestamp:body
+	WebhookVerifierKind_SLACK WebhookVerifierKind = 2
+	// Svix and Standard Webhooks signatures of id.timestamp.body
+	WebhookVerifierKind_SVIX WebhookVerifierKind = 3
+	// Ed25519 signature of the timestamp followed by the body
+	WebhookVerifierKind_ED25519 WebhookVerifierKind = 4
+	// RSASSA-PKCS1-v1_5 SHA-256 signature of the timestamp followed by the body
+	WebhookVerifierKind_RSA_SHA256 WebhookVerifierKind = 5
+)
+
+// Enum value maps for WebhookVerifierKind.
+var (
+	WebhookVerifierKind_name = map[int32]string{
+		0: "HMAC_SHA256",
+		1: "STRIPE",
+		2: "SLACK",
+		3: "SVIX",
+		4: "ED25519",
+		5: "RSA_SHA256",
+	}
+	WebhookVerifierKind_value = map[string]int32{
+		"HMAC_SHA256": 0,
+		"STRIPE":      1,
+		"SLACK":       2,
+		"SVIX":        3,
+		"ED25519":     4,
+		"RSA_SHA256":  5,
+	}
+)
+
+func (x WebhookVerifierKind) Enum() *WebhookVerifierKind {
+	p := new(WebhookVerifierKind)
+	*p = x
+	return p
+}
+
+func (x WebhookVerifierKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhookVerifierKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_wundernode_config_proto_enumTypes[24].Descriptor()
+}
+
+func (WebhookVerifierKind) Type() protoreflect.EnumType {
+	return &file_wundernode_config_proto_enumTypes[24]
+}
+
+func (x WebhookVerifierKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhookVerifierKind.Descriptor instead.
+func (WebhookVerifierKind) EnumDescriptor() ([]byte, []int) {
+	return file_wundernode_config_proto_rawDescGZIP(), []int{24}
+}
+
+type ConfigurationVariableKind int32
+
+const (
+	ConfigurationVariableKind_STATIC_CONFIGURATION_VARIABLE      ConfigurationVariableKind = 0
+	ConfigurationVariableKind_ENV_CONFIGURATION_VARIABLE         ConfigurationVariableKind = 1
+	ConfigurationVariableKind_PLACEHOLDER_CONFIGURATION_VARIABLE ConfigurationVariableKind = 2
+)
+
+// Enum value maps for ConfigurationVariableKind.
+var (
+	ConfigurationVariableKind_name = map[int32]string{
+		0: "STATIC_CONFIGURATION_VARIABLE",
+		1: "ENV_CONFIGURATION_VARIABLE",
+		2: "PLACEHOLDER_CONFIGURATION_VARIABLE",
+	}
+	ConfigurationVariableKind_value = map[string]int32{
+		"STATIC_CONFIGURATION_VARIABLE":      0,
+		"ENV_CONFIGURATION_VARIABLE":         1,
+		"PLACEHOLDER_CONFIGURATION_VARIABLE": 2,
+	}
+)
+
+func (x ConfigurationVariableKind) Enum() *ConfigurationVariableKind {
+	p := new(ConfigurationVariableKind)
+	*p = x
+	return p
+}
+
+func (x ConfigurationVariableKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConfigurationVariableKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_wundernode_config_proto_enumTypes[25].Descriptor()
+}
+
+func (ConfigurationVariableKind) Type() protoreflect.EnumType {
+	return &file_wundernode_config_proto_enumTypes[25]
+}
+
+func (x ConfigurationVariableKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConfigurationVariableKind.Descriptor instead.
+func (ConfigurationVariableKind) EnumDescriptor() ([]byte, []int) {
+	return file_wundernode_config_proto_rawDescGZIP(), []int{25}
+}
+
+type ApiAuthenticationConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CookieBased  *CookieBasedAuthentication `protobuf:"bytes,1,opt,name=cookieBased,proto3" json:"cookieBased,omitempty"`
+	Hooks        *ApiAuthenticationHooks    `protobuf:"bytes,2,opt,name=hooks,proto3" json:"hooks,omitempty"`
+	JwksBased    *JwksBasedAuthentication   `protobuf:"bytes,3,opt,name=jwksBased,proto3" json:"jwksBased,omitempty"`
+	PublicClaims []string                   `protobuf:"bytes,4,rep,name=publicClaims,proto3" json:"publicClaims,omitempty"`
+}
+
+func (x *ApiAuthenticationConfig) Reset() {
+	*x = ApiAuthenticationConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wundernode_config_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApiAuthenticationConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiAuthenticationConfig) ProtoMessage() {}
+
+func (x *ApiAuthenticationConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_wundernode_config_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiAuthenticationConfig.ProtoReflect.Descriptor instead.
+func (*ApiAuthenticationConfig) Descriptor() ([]byte, []int) {
+	return file_wundernode_config_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ApiAuthenticationConfig) GetCookieBased() *CookieBasedAuthentication {
+	if x != nil {
+		return x.CookieBased
+	}
+	return nil
+}
+
+func (x *ApiAuthenticationConfig) GetHooks() *ApiAuthenticationHooks {
+	if x != nil {
+		return x.Hooks
+	}
+	return nil
+}
+
+func (x *ApiAuthenticationConfig) GetJwksBased() *JwksBasedAuthentication {
+	if x != nil {
+		return x.JwksBased
+	}
+	return nil
 }
 
 func (x *ApiAuthenticationConfig) GetPublicClaims() []string {
@@ -1120,6 +1568,7 @@ type JwksAuthProvider struct {
 	JwksJson                *ConfigurationVariable `protobuf:"bytes,2,opt,name=jwksJson,proto3" json:"jwksJson,omitempty"`
 	UserInfoEndpoint        *ConfigurationVariable `protobuf:"bytes,3,opt,name=userInfoEndpoint,proto3" json:"userInfoEndpoint,omitempty"`
 	UserInfoCacheTtlSeconds int64                  `protobuf:"varint,4,opt,name=userInfoCacheTtlSeconds,proto3" json:"userInfoCacheTtlSeconds,omitempty"`
+	RoleMappings            []*RoleMappingRule     `protobuf:"bytes,5,rep,name=roleMappings,proto3" json:"roleMappings,omitempty"`
 }
 
 func (x *JwksAuthProvider) Reset() {
@@ -1182,6 +1631,13 @@ func (x *JwksAuthProvider) GetUserInfoCacheTtlSeconds() int64 {
 	return 0
 }
 
+func (x *JwksAuthProvider) GetRoleMappings() []*RoleMappingRule {
+	if x != nil {
+		return x.RoleMappings
+	}
+	return nil
+}
+
 type ApiAuthenticationHooks struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1357,6 +1813,9 @@ type AuthProvider struct {
 	Kind         AuthProviderKind                 `protobuf:"varint,2,opt,name=kind,proto3,enum=wgpb.AuthProviderKind" json:"kind,omitempty"`
 	GithubConfig *GithubAuthProviderConfig        `protobuf:"bytes,3,opt,name=githubConfig,proto3" json:"githubConfig,omitempty"`
 	OidcConfig   *OpenIDConnectAuthProviderConfig `protobuf:"bytes,4,opt,name=oidcConfig,proto3" json:"oidcConfig,omitempty"`
+	Oauth2Config *OAuth2AuthProviderConfig        `protobuf:"bytes,5,opt,name=oauth2Config,proto3" json:"oauth2Config,omitempty"`
+	SamlConfig   *SAMLAuthProviderConfig          `protobuf:"bytes,6,opt,name=samlConfig,proto3" json:"samlConfig,omitempty"`
+	RoleMappings []*RoleMappingRule               `protobuf:"bytes,7,rep,name=roleMappings,proto3" json:"roleMappings,omitempty"`
 }
 
 func (x *AuthProvider) Reset() {
@@ -1419,17 +1878,46 @@ func (x *AuthProvider) GetOidcConfig() *OpenIDConnectAuthProviderConfig {
 	return nil
 }
 
-type GithubAuthProviderConfig struct {
+func (x *AuthProvider) GetOauth2Config() *OAuth2AuthProviderConfig {
+	if x != nil {
+		return x.Oauth2Config
+	}
+	return nil
+}
+
+func (x *AuthProvider) GetSamlConfig() *SAMLAuthProviderConfig {
+	if x != nil {
+		return x.SamlConfig
+	}
+	return nil
+}
+
+func (x *AuthProvider) GetRoleMappings() []*RoleMappingRule {
+	if x != nil {
+		return x.RoleMappings
+	}
+	return nil
+}
+
+// RoleMappingRule assigns a role to the users matching it, without
+// requiring a mutatingPostAuthentication hook
+type RoleMappingRule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ClientId     *ConfigurationVariable `protobuf:"bytes,1,opt,name=clientId,proto3" json:"clientId,omitempty"`
-	ClientSecret *ConfigurationVariable `protobuf:"bytes,2,opt,name=clientSecret,proto3" json:"clientSecret,omitempty"`
+	Role string              `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Kind RoleMappingRuleKind `protobuf:"varint,2,opt,name=kind,proto3,enum=wgpb.RoleMappingRuleKind" json:"kind,omitempty"`
+	// claim is the claim name or a dot separated path to a custom claim,
+	// used by RoleMappingClaimEquals and RoleMappingClaimContains
+	Claim string `protobuf:"bytes,3,opt,name=claim,proto3" json:"claim,omitempty"`
+	// value is the expected claim value, the email domain, the GitHub organization
+	// login or the GitHub team as organization/team-slug, depending on the kind
+	Value string `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *GithubAuthProviderConfig) Reset() {
-	*x = GithubAuthProviderConfig{}
+func (x *RoleMappingRule) Reset() {
+	*x = RoleMappingRule{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_wundernode_config_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1437,13 +1925,13 @@ func (x *GithubAuthProviderConfig) Reset() {
 	}
 }
 
-func (x *GithubAuthProviderConfig) String() string {
+func (x *RoleMappingRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GithubAuthProviderConfig) ProtoMessage() {}
+func (*RoleMappingRule) ProtoMessage() {}
 
-func (x *GithubAuthProviderConfig) ProtoReflect() protoreflect.Message {
+func (x *RoleMappingRule) ProtoReflect() protoreflect.Message {
 	mi := &file_wundernode_config_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1455,29 +1943,98 @@ func (x *GithubAuthProviderConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GithubAuthProviderConfig.ProtoReflect.Descriptor instead.
-func (*GithubAuthProviderConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use RoleMappingRule.ProtoReflect.Descriptor instead.
+func (*RoleMappingRule) Descriptor() ([]byte, []int) {
 	return file_wundernode_config_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GithubAuthProviderConfig) GetClientId() *ConfigurationVariable {
+func (x *RoleMappingRule) GetRole() string {
 	if x != nil {
-		return x.ClientId
+		return x.Role
 	}
-	return nil
+	return ""
 }
 
-func (x *GithubAuthProviderConfig) GetClientSecret() *ConfigurationVariable {
+func (x *RoleMappingRule) GetKind() RoleMappingRuleKind {
 	if x != nil {
-		return x.ClientSecret
+		return x.Kind
 	}
-	return nil
+	return RoleMappingRuleKind_RoleMappingClaimEquals
 }
 
-type OpenIDConnectQueryParameter struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *RoleMappingRule) GetClaim() string {
+	if x != nil {
+		return x.Claim
+	}
+	return ""
+}
+
+func (x *RoleMappingRule) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type GithubAuthProviderConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientId     *ConfigurationVariable `protobuf:"bytes,1,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	ClientSecret *ConfigurationVariable `protobuf:"bytes,2,opt,name=clientSecret,proto3" json:"clientSecret,omitempty"`
+}
+
+func (x *GithubAuthProviderConfig) Reset() {
+	*x = GithubAuthProviderConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wundernode_config_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GithubAuthProviderConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GithubAuthProviderConfig) ProtoMessage() {}
+
+func (x *GithubAuthProviderConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_wundernode_config_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GithubAuthProviderConfig.ProtoReflect.Descriptor instead.
+func (*GithubAuthProviderConfig) Descriptor() ([]byte, []int) {
+	return file_wundernode_config_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GithubAuthProviderConfig) GetClientId() *ConfigurationVariable {
+	if x != nil {
+		return x.ClientId
+	}
+	return nil
+}
+
+func (x *GithubAuthProviderConfig) GetClientSecret() *ConfigurationVariable {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return nil
+}
+
+type OpenIDConnectQueryParameter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
 	Name  *ConfigurationVariable `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Value *ConfigurationVariable `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
@@ -1486,7 +2043,7 @@ type OpenIDConnectQueryParameter struct {
 func (x *OpenIDConnectQueryParameter) Reset() {
 	*x = OpenIDConnectQueryParameter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[7]
+		mi := &file_wundernode_config_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1499,7 +2056,7 @@ func (x *OpenIDConnectQueryParameter) String() string {
 func (*OpenIDConnectQueryParameter) ProtoMessage() {}
 
 func (x *OpenIDConnectQueryParameter) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[7]
+	mi := &file_wundernode_config_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1512,7 +2069,7 @@ func (x *OpenIDConnectQueryParameter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OpenIDConnectQueryParameter.ProtoReflect.Descriptor instead.
 func (*OpenIDConnectQueryParameter) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{7}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *OpenIDConnectQueryParameter) GetName() *ConfigurationVariable {
@@ -1543,7 +2100,7 @@ type OpenIDConnectAuthProviderConfig struct {
 func (x *OpenIDConnectAuthProviderConfig) Reset() {
 	*x = OpenIDConnectAuthProviderConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[8]
+		mi := &file_wundernode_config_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1556,7 +2113,7 @@ func (x *OpenIDConnectAuthProviderConfig) String() string {
 func (*OpenIDConnectAuthProviderConfig) ProtoMessage() {}
 
 func (x *OpenIDConnectAuthProviderConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[8]
+	mi := &file_wundernode_config_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1569,7 +2126,7 @@ func (x *OpenIDConnectAuthProviderConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OpenIDConnectAuthProviderConfig.ProtoReflect.Descriptor instead.
 func (*OpenIDConnectAuthProviderConfig) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{8}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *OpenIDConnectAuthProviderConfig) GetIssuer() *ConfigurationVariable {
@@ -1600,6 +2157,237 @@ func (x *OpenIDConnectAuthProviderConfig) GetQueryParameters() []*OpenIDConnectQ
 	return nil
 }
 
+type OAuth2AuthProviderConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientId         *ConfigurationVariable         `protobuf:"bytes,1,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	ClientSecret     *ConfigurationVariable         `protobuf:"bytes,2,opt,name=clientSecret,proto3" json:"clientSecret,omitempty"`
+	AuthorizationUrl *ConfigurationVariable         `protobuf:"bytes,3,opt,name=authorizationUrl,proto3" json:"authorizationUrl,omitempty"`
+	TokenUrl         *ConfigurationVariable         `protobuf:"bytes,4,opt,name=tokenUrl,proto3" json:"tokenUrl,omitempty"`
+	UserInfoUrl      *ConfigurationVariable         `protobuf:"bytes,5,opt,name=userInfoUrl,proto3" json:"userInfoUrl,omitempty"`
+	Scopes           []string                       `protobuf:"bytes,6,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	QueryParameters  []*OpenIDConnectQueryParameter `protobuf:"bytes,7,rep,name=queryParameters,proto3" json:"queryParameters,omitempty"`
+	// claimMapping maps OpenID Connect claim names (e.g. sub, email, picture) to
+	// dot separated paths into the JSON object returned by userInfoUrl
+	ClaimMapping map[string]string `protobuf:"bytes,8,rep,name=claimMapping,proto3" json:"claimMapping,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *OAuth2AuthProviderConfig) Reset() {
+	*x = OAuth2AuthProviderConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wundernode_config_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OAuth2AuthProviderConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OAuth2AuthProviderConfig) ProtoMessage() {}
+
+func (x *OAuth2AuthProviderConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_wundernode_config_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OAuth2AuthProviderConfig.ProtoReflect.Descriptor instead.
+func (*OAuth2AuthProviderConfig) Descriptor() ([]byte, []int) {
+	return file_wundernode_config_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *OAuth2AuthProviderConfig) GetClientId() *ConfigurationVariable {
+	if x != nil {
+		return x.ClientId
+	}
+	return nil
+}
+
+func (x *OAuth2AuthProviderConfig) GetClientSecret() *ConfigurationVariable {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return nil
+}
+
+func (x *OAuth2AuthProviderConfig) GetAuthorizationUrl() *ConfigurationVariable {
+	if x != nil {
+		return x.AuthorizationUrl
+	}
+	return nil
+}
+
+func (x *OAuth2AuthProviderConfig) GetTokenUrl() *ConfigurationVariable {
+	if x != nil {
+		return x.TokenUrl
+	}
+	return nil
+}
+
+func (x *OAuth2AuthProviderConfig) GetUserInfoUrl() *ConfigurationVariable {
+	if x != nil {
+		return x.UserInfoUrl
+	}
+	return nil
+}
+
+func (x *OAuth2AuthProviderConfig) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *OAuth2AuthProviderConfig) GetQueryParameters() []*OpenIDConnectQueryParameter {
+	if x != nil {
+		return x.QueryParameters
+	}
+	return nil
+}
+
+func (x *OAuth2AuthProviderConfig) GetClaimMapping() map[string]string {
+	if x != nil {
+		return x.ClaimMapping
+	}
+	return nil
+}
+
+type SAMLAuthProviderConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// either idpMetadataUrl or idpMetadataXml must be set
+	IdpMetadataUrl *ConfigurationVariable `protobuf:"bytes,1,opt,name=idpMetadataUrl,proto3" json:"idpMetadataUrl,omitempty"`
+	IdpMetadataXml *ConfigurationVariable `protobuf:"bytes,2,opt,name=idpMetadataXml,proto3" json:"idpMetadataXml,omitempty"`
+	// entityId defaults to the URL of the service provider metadata
+	EntityId *ConfigurationVariable `protobuf:"bytes,3,opt,name=entityId,proto3" json:"entityId,omitempty"`
+	// PEM encoded certificate and RSA private key of the service provider,
+	// required for signing requests and decrypting assertions
+	Certificate       *ConfigurationVariable `protobuf:"bytes,4,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	PrivateKey        *ConfigurationVariable `protobuf:"bytes,5,opt,name=privateKey,proto3" json:"privateKey,omitempty"`
+	SignRequests      bool                   `protobuf:"varint,6,opt,name=signRequests,proto3" json:"signRequests,omitempty"`
+	AllowIdpInitiated bool                   `protobuf:"varint,7,opt,name=allowIdpInitiated,proto3" json:"allowIdpInitiated,omitempty"`
+	NameIdFormat      *ConfigurationVariable `protobuf:"bytes,8,opt,name=nameIdFormat,proto3" json:"nameIdFormat,omitempty"`
+	// attributeMapping maps OpenID Connect claim names (e.g. email, name) to
+	// SAML attribute names or friendly names
+	AttributeMapping map[string]string `protobuf:"bytes,9,rep,name=attributeMapping,proto3" json:"attributeMapping,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// rolesAttribute names the SAML attribute used to populate the user roles
+	RolesAttribute string `protobuf:"bytes,10,opt,name=rolesAttribute,proto3" json:"rolesAttribute,omitempty"`
+}
+
+func (x *SAMLAuthProviderConfig) Reset() {
+	*x = SAMLAuthProviderConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wundernode_config_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SAMLAuthProviderConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SAMLAuthProviderConfig) ProtoMessage() {}
+
+func (x *SAMLAuthProviderConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_wundernode_config_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SAMLAuthProviderConfig.ProtoReflect.Descriptor instead.
+func (*SAMLAuthProviderConfig) Descriptor() ([]byte, []int) {
+	return file_wundernode_config_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SAMLAuthProviderConfig) GetIdpMetadataUrl() *ConfigurationVariable {
+	if x != nil {
+		return x.IdpMetadataUrl
+	}
+	return nil
+}
+
+func (x *SAMLAuthProviderConfig) GetIdpMetadataXml() *ConfigurationVariable {
+	if x != nil {
+		return x.IdpMetadataXml
+	}
+	return nil
+}
+
+func (x *SAMLAuthProviderConfig) GetEntityId() *ConfigurationVariable {
+	if x != nil {
+		return x.EntityId
+	}
+	return nil
+}
+
+func (x *SAMLAuthProviderConfig) GetCertificate() *ConfigurationVariable {
+	if x != nil {
+		return x.Certificate
+	}
+	return nil
+}
+
+func (x *SAMLAuthProviderConfig) GetPrivateKey() *ConfigurationVariable {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return nil
+}
+
+func (x *SAMLAuthProviderConfig) GetSignRequests() bool {
+	if x != nil {
+		return x.SignRequests
+	}
+	return false
+}
+
+func (x *SAMLAuthProviderConfig) GetAllowIdpInitiated() bool {
+	if x != nil {
+		return x.AllowIdpInitiated
+	}
+	return false
+}
+
+func (x *SAMLAuthProviderConfig) GetNameIdFormat() *ConfigurationVariable {
+	if x != nil {
+		return x.NameIdFormat
+	}
+	return nil
+}
+
+func (x *SAMLAuthProviderConfig) GetAttributeMapping() map[string]string {
+	if x != nil {
+		return x.AttributeMapping
+	}
+	return nil
+}
+
+func (x *SAMLAuthProviderConfig) GetRolesAttribute() string {
+	if x != nil {
+		return x.RolesAttribute
+	}
+	return ""
+}
+
 type Operation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1628,7 +2416,7 @@ type Operation struct {
 func (x *Operation) Reset() {
 	*x = Operation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[9]
+		mi := &file_wundernode_config_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1641,7 +2429,7 @@ func (x *Operation) String() string {
 func (*Operation) ProtoMessage() {}
 
 func (x *Operation) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[9]
+	mi := &file_wundernode_config_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1654,7 +2442,7 @@ func (x *Operation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Operation.ProtoReflect.Descriptor instead.
 func (*Operation) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{9}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Operation) GetName() string {
@@ -1782,7 +2570,7 @@ type PostResolveTransformation struct {
 func (x *PostResolveTransformation) Reset() {
 	*x = PostResolveTransformation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[10]
+		mi := &file_wundernode_config_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1795,7 +2583,7 @@ func (x *PostResolveTransformation) String() string {
 func (*PostResolveTransformation) ProtoMessage() {}
 
 func (x *PostResolveTransformation) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[10]
+	mi := &file_wundernode_config_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1808,7 +2596,7 @@ func (x *PostResolveTransformation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PostResolveTransformation.ProtoReflect.Descriptor instead.
 func (*PostResolveTransformation) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{10}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *PostResolveTransformation) GetKind() PostResolveTransformationKind {
@@ -1844,7 +2632,7 @@ type PostResolveGetTransformation struct {
 func (x *PostResolveGetTransformation) Reset() {
 	*x = PostResolveGetTransformation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[11]
+		mi := &file_wundernode_config_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1857,7 +2645,7 @@ func (x *PostResolveGetTransformation) String() string {
 func (*PostResolveGetTransformation) ProtoMessage() {}
 
 func (x *PostResolveGetTransformation) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[11]
+	mi := &file_wundernode_config_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1870,7 +2658,7 @@ func (x *PostResolveGetTransformation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PostResolveGetTransformation.ProtoReflect.Descriptor instead.
 func (*PostResolveGetTransformation) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{11}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *PostResolveGetTransformation) GetFrom() []string {
@@ -1898,7 +2686,7 @@ type OperationVariablesConfiguration struct {
 func (x *OperationVariablesConfiguration) Reset() {
 	*x = OperationVariablesConfiguration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[12]
+		mi := &file_wundernode_config_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1911,7 +2699,7 @@ func (x *OperationVariablesConfiguration) String() string {
 func (*OperationVariablesConfiguration) ProtoMessage() {}
 
 func (x *OperationVariablesConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[12]
+	mi := &file_wundernode_config_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1924,7 +2712,7 @@ func (x *OperationVariablesConfiguration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OperationVariablesConfiguration.ProtoReflect.Descriptor instead.
 func (*OperationVariablesConfiguration) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{12}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *OperationVariablesConfiguration) GetInjectVariables() []*VariableInjectionConfiguration {
@@ -1948,7 +2736,7 @@ type VariableInjectionConfiguration struct {
 func (x *VariableInjectionConfiguration) Reset() {
 	*x = VariableInjectionConfiguration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[13]
+		mi := &file_wundernode_config_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1961,7 +2749,7 @@ func (x *VariableInjectionConfiguration) String() string {
 func (*VariableInjectionConfiguration) ProtoMessage() {}
 
 func (x *VariableInjectionConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[13]
+	mi := &file_wundernode_config_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1974,7 +2762,7 @@ func (x *VariableInjectionConfiguration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VariableInjectionConfiguration.ProtoReflect.Descriptor instead.
 func (*VariableInjectionConfiguration) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{13}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *VariableInjectionConfiguration) GetVariablePathComponents() []string {
@@ -2016,7 +2804,7 @@ type GraphQLDataSourceHooksConfiguration struct {
 func (x *GraphQLDataSourceHooksConfiguration) Reset() {
 	*x = GraphQLDataSourceHooksConfiguration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[14]
+		mi := &file_wundernode_config_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2029,7 +2817,7 @@ func (x *GraphQLDataSourceHooksConfiguration) String() string {
 func (*GraphQLDataSourceHooksConfiguration) ProtoMessage() {}
 
 func (x *GraphQLDataSourceHooksConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[14]
+	mi := &file_wundernode_config_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2042,7 +2830,7 @@ func (x *GraphQLDataSourceHooksConfiguration) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use GraphQLDataSourceHooksConfiguration.ProtoReflect.Descriptor instead.
 func (*GraphQLDataSourceHooksConfiguration) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{14}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GraphQLDataSourceHooksConfiguration) GetOnWSTransportConnectionInit() bool {
@@ -2052,6 +2840,63 @@ func (x *GraphQLDataSourceHooksConfiguration) GetOnWSTransportConnectionInit() b
 	return false
 }
 
+type HeaderMatcher struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Pattern for the header value, where * matches any characters including /
+	// and ? a single one. The header only needs to be present if it's empty
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *HeaderMatcher) Reset() {
+	*x = HeaderMatcher{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wundernode_config_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeaderMatcher) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeaderMatcher) ProtoMessage() {}
+
+func (x *HeaderMatcher) ProtoReflect() protoreflect.Message {
+	mi := &file_wundernode_config_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeaderMatcher.ProtoReflect.Descriptor instead.
+func (*HeaderMatcher) Descriptor() ([]byte, []int) {
+	return file_wundernode_config_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *HeaderMatcher) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HeaderMatcher) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
 type HookMatcher struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2059,12 +2904,22 @@ type HookMatcher struct {
 
 	OperationType *OperationType `protobuf:"varint,1,opt,name=operationType,proto3,enum=wgpb.OperationType,oneof" json:"operationType,omitempty"`
 	Datasources   []string       `protobuf:"bytes,2,rep,name=datasources,proto3" json:"datasources,omitempty"`
+	// Operation names or patterns, e.g. users/* or admin/**
+	OperationNames []string `protobuf:"bytes,3,rep,name=operationNames,proto3" json:"operationNames,omitempty"`
+	// HTTP methods of the request to the origin
+	Methods []string `protobuf:"bytes,4,rep,name=methods,proto3" json:"methods,omitempty"`
+	// Path patterns of the request to the origin
+	Paths []string `protobuf:"bytes,5,rep,name=paths,proto3" json:"paths,omitempty"`
+	// Headers of the request to the origin, all of them must match
+	Headers []*HeaderMatcher `protobuf:"bytes,6,rep,name=headers,proto3" json:"headers,omitempty"`
+	// The user must have at least one of the roles
+	Roles []string `protobuf:"bytes,7,rep,name=roles,proto3" json:"roles,omitempty"`
 }
 
 func (x *HookMatcher) Reset() {
 	*x = HookMatcher{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[15]
+		mi := &file_wundernode_config_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2077,7 +2932,7 @@ func (x *HookMatcher) String() string {
 func (*HookMatcher) ProtoMessage() {}
 
 func (x *HookMatcher) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[15]
+	mi := &file_wundernode_config_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2090,7 +2945,7 @@ func (x *HookMatcher) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HookMatcher.ProtoReflect.Descriptor instead.
 func (*HookMatcher) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{15}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *HookMatcher) GetOperationType() OperationType {
@@ -2107,6 +2962,41 @@ func (x *HookMatcher) GetDatasources() []string {
 	return nil
 }
 
+func (x *HookMatcher) GetOperationNames() []string {
+	if x != nil {
+		return x.OperationNames
+	}
+	return nil
+}
+
+func (x *HookMatcher) GetMethods() []string {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+func (x *HookMatcher) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *HookMatcher) GetHeaders() []*HeaderMatcher {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *HookMatcher) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
 type Hook struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2120,7 +3010,7 @@ type Hook struct {
 func (x *Hook) Reset() {
 	*x = Hook{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[16]
+		mi := &file_wundernode_config_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2133,7 +3023,7 @@ func (x *Hook) String() string {
 func (*Hook) ProtoMessage() {}
 
 func (x *Hook) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[16]
+	mi := &file_wundernode_config_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2146,7 +3036,7 @@ func (x *Hook) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Hook.ProtoReflect.Descriptor instead.
 func (*Hook) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{16}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *Hook) GetId() string {
@@ -2183,12 +3073,21 @@ type OperationHooksConfiguration struct {
 	HttpTransportOnRequest  bool                          `protobuf:"varint,6,opt,name=httpTransportOnRequest,proto3" json:"httpTransportOnRequest,omitempty"`
 	HttpTransportOnResponse bool                          `protobuf:"varint,7,opt,name=httpTransportOnResponse,proto3" json:"httpTransportOnResponse,omitempty"`
 	CustomResolve           bool                          `protobuf:"varint,8,opt,name=customResolve,proto3" json:"customResolve,omitempty"`
+	// Expressions evaluated by the node before the preResolve hooks
+	PreResolveExpressions []*ExpressionHook `protobuf:"bytes,9,rep,name=preResolveExpressions,proto3" json:"preResolveExpressions,omitempty"`
+	// Expressions evaluated by the node before the postResolve hooks
+	PostResolveExpressions []*ExpressionHook `protobuf:"bytes,10,rep,name=postResolveExpressions,proto3" json:"postResolveExpressions,omitempty"`
+	// Run the onSubscriptionEvent hook for each message sent to a client
+	OnSubscriptionEvent bool `protobuf:"varint,11,opt,name=onSubscriptionEvent,proto3" json:"onSubscriptionEvent,omitempty"`
+	// Expressions evaluated by the node for each subscription message, before
+	// the onSubscriptionEvent hook
+	SubscriptionEventExpressions []*ExpressionHook `protobuf:"bytes,12,rep,name=subscriptionEventExpressions,proto3" json:"subscriptionEventExpressions,omitempty"`
 }
 
 func (x *OperationHooksConfiguration) Reset() {
 	*x = OperationHooksConfiguration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[17]
+		mi := &file_wundernode_config_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2201,7 +3100,7 @@ func (x *OperationHooksConfiguration) String() string {
 func (*OperationHooksConfiguration) ProtoMessage() {}
 
 func (x *OperationHooksConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[17]
+	mi := &file_wundernode_config_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2214,7 +3113,7 @@ func (x *OperationHooksConfiguration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OperationHooksConfiguration.ProtoReflect.Descriptor instead.
 func (*OperationHooksConfiguration) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{17}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *OperationHooksConfiguration) GetPreResolve() bool {
@@ -2273,6 +3172,122 @@ func (x *OperationHooksConfiguration) GetCustomResolve() bool {
 	return false
 }
 
+func (x *OperationHooksConfiguration) GetPreResolveExpressions() []*ExpressionHook {
+	if x != nil {
+		return x.PreResolveExpressions
+	}
+	return nil
+}
+
+func (x *OperationHooksConfiguration) GetPostResolveExpressions() []*ExpressionHook {
+	if x != nil {
+		return x.PostResolveExpressions
+	}
+	return nil
+}
+
+func (x *OperationHooksConfiguration) GetOnSubscriptionEvent() bool {
+	if x != nil {
+		return x.OnSubscriptionEvent
+	}
+	return false
+}
+
+func (x *OperationHooksConfiguration) GetSubscriptionEventExpressions() []*ExpressionHook {
+	if x != nil {
+		return x.SubscriptionEventExpressions
+	}
+	return nil
+}
+
+// ExpressionHook is a CEL expression evaluated in-process by the node
+type ExpressionHook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action ExpressionHookAction `protobuf:"varint,1,opt,name=action,proto3,enum=wgpb.ExpressionHookAction" json:"action,omitempty"`
+	// Optional boolean expression, the hook only runs when it evaluates to true
+	Condition string `protobuf:"bytes,2,opt,name=condition,proto3" json:"condition,omitempty"`
+	// Header name for ExpressionHookSetHeader, or dot separated path of the
+	// variable for ExpressionHookSetVariable or of the message field for
+	// ExpressionHookSetEventField
+	Target string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	// Value for ExpressionHookSetHeader, ExpressionHookSetVariable and
+	// ExpressionHookSetEventField, or the error message for
+	// ExpressionHookReject
+	Expression string `protobuf:"bytes,4,opt,name=expression,proto3" json:"expression,omitempty"`
+	// Status code used by ExpressionHookReject, defaults to 403
+	StatusCode int32 `protobuf:"varint,5,opt,name=statusCode,proto3" json:"statusCode,omitempty"`
+}
+
+func (x *ExpressionHook) Reset() {
+	*x = ExpressionHook{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wundernode_config_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpressionHook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpressionHook) ProtoMessage() {}
+
+func (x *ExpressionHook) ProtoReflect() protoreflect.Message {
+	mi := &file_wundernode_config_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpressionHook.ProtoReflect.Descriptor instead.
+func (*ExpressionHook) Descriptor() ([]byte, []int) {
+	return file_wundernode_config_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ExpressionHook) GetAction() ExpressionHookAction {
+	if x != nil {
+		return x.Action
+	}
+	return ExpressionHookAction_ExpressionHookSetHeader
+}
+
+func (x *ExpressionHook) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *ExpressionHook) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *ExpressionHook) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *ExpressionHook) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
 type MockResolveHookConfiguration struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2285,7 +3300,7 @@ type MockResolveHookConfiguration struct {
 func (x *MockResolveHookConfiguration) Reset() {
 	*x = MockResolveHookConfiguration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[18]
+		mi := &file_wundernode_config_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2298,7 +3313,7 @@ func (x *MockResolveHookConfiguration) String() string {
 func (*MockResolveHookConfiguration) ProtoMessage() {}
 
 func (x *MockResolveHookConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[18]
+	mi := &file_wundernode_config_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2311,7 +3326,7 @@ func (x *MockResolveHookConfiguration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MockResolveHookConfiguration.ProtoReflect.Descriptor instead.
 func (*MockResolveHookConfiguration) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{18}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *MockResolveHookConfiguration) GetEnable() bool {
@@ -2340,7 +3355,7 @@ type OperationAuthorizationConfig struct {
 func (x *OperationAuthorizationConfig) Reset() {
 	*x = OperationAuthorizationConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[19]
+		mi := &file_wundernode_config_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2353,7 +3368,7 @@ func (x *OperationAuthorizationConfig) String() string {
 func (*OperationAuthorizationConfig) ProtoMessage() {}
 
 func (x *OperationAuthorizationConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[19]
+	mi := &file_wundernode_config_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2366,7 +3381,7 @@ func (x *OperationAuthorizationConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OperationAuthorizationConfig.ProtoReflect.Descriptor instead.
 func (*OperationAuthorizationConfig) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{19}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *OperationAuthorizationConfig) GetClaims() []*ClaimConfig {
@@ -2401,7 +3416,7 @@ type OperationRoleConfig struct {
 func (x *OperationRoleConfig) Reset() {
 	*x = OperationRoleConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[20]
+		mi := &file_wundernode_config_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2414,7 +3429,7 @@ func (x *OperationRoleConfig) String() string {
 func (*OperationRoleConfig) ProtoMessage() {}
 
 func (x *OperationRoleConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[20]
+	mi := &file_wundernode_config_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2427,7 +3442,7 @@ func (x *OperationRoleConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OperationRoleConfig.ProtoReflect.Descriptor instead.
 func (*OperationRoleConfig) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{20}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *OperationRoleConfig) GetRequireMatchAll() []string {
@@ -2472,7 +3487,7 @@ type CustomClaim struct {
 func (x *CustomClaim) Reset() {
 	*x = CustomClaim{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[21]
+		mi := &file_wundernode_config_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2485,7 +3500,7 @@ func (x *CustomClaim) String() string {
 func (*CustomClaim) ProtoMessage() {}
 
 func (x *CustomClaim) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[21]
+	mi := &file_wundernode_config_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2498,7 +3513,7 @@ func (x *CustomClaim) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CustomClaim.ProtoReflect.Descriptor instead.
 func (*CustomClaim) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{21}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *CustomClaim) GetName() string {
@@ -2543,7 +3558,7 @@ type ClaimConfig struct {
 func (x *ClaimConfig) Reset() {
 	*x = ClaimConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[22]
+		mi := &file_wundernode_config_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2556,7 +3571,7 @@ func (x *ClaimConfig) String() string {
 func (*ClaimConfig) ProtoMessage() {}
 
 func (x *ClaimConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[22]
+	mi := &file_wundernode_config_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2569,7 +3584,7 @@ func (x *ClaimConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClaimConfig.ProtoReflect.Descriptor instead.
 func (*ClaimConfig) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{22}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ClaimConfig) GetVariablePathComponents() []string {
@@ -2605,7 +3620,7 @@ type OperationLiveQueryConfig struct {
 func (x *OperationLiveQueryConfig) Reset() {
 	*x = OperationLiveQueryConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[23]
+		mi := &file_wundernode_config_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2618,7 +3633,7 @@ func (x *OperationLiveQueryConfig) String() string {
 func (*OperationLiveQueryConfig) ProtoMessage() {}
 
 func (x *OperationLiveQueryConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[23]
+	mi := &file_wundernode_config_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2631,7 +3646,7 @@ func (x *OperationLiveQueryConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OperationLiveQueryConfig.ProtoReflect.Descriptor instead.
 func (*OperationLiveQueryConfig) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{23}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *OperationLiveQueryConfig) GetEnable() bool {
@@ -2659,7 +3674,7 @@ type OperationAuthenticationConfig struct {
 func (x *OperationAuthenticationConfig) Reset() {
 	*x = OperationAuthenticationConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[24]
+		mi := &file_wundernode_config_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2672,7 +3687,7 @@ func (x *OperationAuthenticationConfig) String() string {
 func (*OperationAuthenticationConfig) ProtoMessage() {}
 
 func (x *OperationAuthenticationConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[24]
+	mi := &file_wundernode_config_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2685,7 +3700,7 @@ func (x *OperationAuthenticationConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OperationAuthenticationConfig.ProtoReflect.Descriptor instead.
 func (*OperationAuthenticationConfig) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{24}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *OperationAuthenticationConfig) GetAuthRequired() bool {
@@ -2710,7 +3725,7 @@ type OperationCacheConfig struct {
 func (x *OperationCacheConfig) Reset() {
 	*x = OperationCacheConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[25]
+		mi := &file_wundernode_config_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2723,7 +3738,7 @@ func (x *OperationCacheConfig) String() string {
 func (*OperationCacheConfig) ProtoMessage() {}
 
 func (x *OperationCacheConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[25]
+	mi := &file_wundernode_config_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2736,7 +3751,7 @@ func (x *OperationCacheConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OperationCacheConfig.ProtoReflect.Descriptor instead.
 func (*OperationCacheConfig) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{25}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *OperationCacheConfig) GetEnable() bool {
@@ -2790,7 +3805,7 @@ type EngineConfiguration struct {
 func (x *EngineConfiguration) Reset() {
 	*x = EngineConfiguration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[26]
+		mi := &file_wundernode_config_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2803,7 +3818,7 @@ func (x *EngineConfiguration) String() string {
 func (*EngineConfiguration) ProtoMessage() {}
 
 func (x *EngineConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[26]
+	mi := &file_wundernode_config_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2816,7 +3831,7 @@ func (x *EngineConfiguration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EngineConfiguration.ProtoReflect.Descriptor instead.
 func (*EngineConfiguration) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{26}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *EngineConfiguration) GetDefaultFlushInterval() int64 {
@@ -2873,7 +3888,7 @@ type InternedString struct {
 func (x *InternedString) Reset() {
 	*x = InternedString{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[27]
+		mi := &file_wundernode_config_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2886,7 +3901,7 @@ func (x *InternedString) String() string {
 func (*InternedString) ProtoMessage() {}
 
 func (x *InternedString) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[27]
+	mi := &file_wundernode_config_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2899,7 +3914,7 @@ func (x *InternedString) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InternedString.ProtoReflect.Descriptor instead.
 func (*InternedString) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{27}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *InternedString) GetKey() string {
@@ -2914,24 +3929,27 @@ type DataSourceConfiguration struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Kind                       DataSourceKind             `protobuf:"varint,1,opt,name=kind,proto3,enum=wgpb.DataSourceKind" json:"kind,omitempty"`
-	RootNodes                  []*TypeField               `protobuf:"bytes,2,rep,name=rootNodes,proto3" json:"rootNodes,omitempty"`
-	ChildNodes                 []*TypeField               `protobuf:"bytes,3,rep,name=childNodes,proto3" json:"childNodes,omitempty"`
-	OverrideFieldPathFromAlias bool                       `protobuf:"varint,4,opt,name=overrideFieldPathFromAlias,proto3" json:"overrideFieldPathFromAlias,omitempty"`
-	CustomRest                 *DataSourceCustom_REST     `protobuf:"bytes,5,opt,name=customRest,proto3" json:"customRest,omitempty"`
-	CustomGraphql              *DataSourceCustom_GraphQL  `protobuf:"bytes,6,opt,name=customGraphql,proto3" json:"customGraphql,omitempty"`
-	CustomStatic               *DataSourceCustom_Static   `protobuf:"bytes,7,opt,name=customStatic,proto3" json:"customStatic,omitempty"`
-	CustomDatabase             *DataSourceCustom_Database `protobuf:"bytes,8,opt,name=customDatabase,proto3" json:"customDatabase,omitempty"`
-	Directives                 []*DirectiveConfiguration  `protobuf:"bytes,9,rep,name=directives,proto3" json:"directives,omitempty"`
-	RequestTimeoutSeconds      int64                      `protobuf:"varint,10,opt,name=requestTimeoutSeconds,proto3" json:"requestTimeoutSeconds,omitempty"`
-	Id                         string                     `protobuf:"bytes,11,opt,name=id,proto3" json:"id,omitempty"`
-	CustomNatsKv               *DataSourceCustom_NatsKv   `protobuf:"bytes,12,opt,name=customNatsKv,proto3" json:"customNatsKv,omitempty"`
+	Kind                       DataSourceKind                  `protobuf:"varint,1,opt,name=kind,proto3,enum=wgpb.DataSourceKind" json:"kind,omitempty"`
+	RootNodes                  []*TypeField                    `protobuf:"bytes,2,rep,name=rootNodes,proto3" json:"rootNodes,omitempty"`
+	ChildNodes                 []*TypeField                    `protobuf:"bytes,3,rep,name=childNodes,proto3" json:"childNodes,omitempty"`
+	OverrideFieldPathFromAlias bool                            `protobuf:"varint,4,opt,name=overrideFieldPathFromAlias,proto3" json:"overrideFieldPathFromAlias,omitempty"`
+	CustomRest                 *DataSourceCustom_REST          `protobuf:"bytes,5,opt,name=customRest,proto3" json:"customRest,omitempty"`
+	CustomGraphql              *DataSourceCustom_GraphQL       `protobuf:"bytes,6,opt,name=customGraphql,proto3" json:"customGraphql,omitempty"`
+	CustomStatic               *DataSourceCustom_Static        `protobuf:"bytes,7,opt,name=customStatic,proto3" json:"customStatic,omitempty"`
+	CustomDatabase             *DataSourceCustom_Database      `protobuf:"bytes,8,opt,name=customDatabase,proto3" json:"customDatabase,omitempty"`
+	Directives                 []*DirectiveConfiguration       `protobuf:"bytes,9,rep,name=directives,proto3" json:"directives,omitempty"`
+	RequestTimeoutSeconds      int64                           `protobuf:"varint,10,opt,name=requestTimeoutSeconds,proto3" json:"requestTimeoutSeconds,omitempty"`
+	Id                         string                          `protobuf:"bytes,11,opt,name=id,proto3" json:"id,omitempty"`
+	CustomNatsKv               *DataSourceCustom_NatsKv        `protobuf:"bytes,12,opt,name=customNatsKv,proto3" json:"customNatsKv,omitempty"`
+	CustomWebhookEvents        *DataSourceCustom_WebhookEvents `protobuf:"bytes,13,opt,name=customWebhookEvents,proto3" json:"customWebhookEvents,omitempty"`
+	// Expressions evaluated by the node before sending requests to the origin
+	OriginRequestExpressions []*ExpressionHook `protobuf:"bytes,14,rep,name=originRequestExpressions,proto3" json:"originRequestExpressions,omitempty"`
 }
 
 func (x *DataSourceConfiguration) Reset() {
 	*x = DataSourceConfiguration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[28]
+		mi := &file_wundernode_config_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2944,7 +3962,7 @@ func (x *DataSourceConfiguration) String() string {
 func (*DataSourceConfiguration) ProtoMessage() {}
 
 func (x *DataSourceConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[28]
+	mi := &file_wundernode_config_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2957,7 +3975,7 @@ func (x *DataSourceConfiguration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataSourceConfiguration.ProtoReflect.Descriptor instead.
 func (*DataSourceConfiguration) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{28}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *DataSourceConfiguration) GetKind() DataSourceKind {
@@ -3044,6 +4062,20 @@ func (x *DataSourceConfiguration) GetCustomNatsKv() *DataSourceCustom_NatsKv {
 	return nil
 }
 
+func (x *DataSourceConfiguration) GetCustomWebhookEvents() *DataSourceCustom_WebhookEvents {
+	if x != nil {
+		return x.CustomWebhookEvents
+	}
+	return nil
+}
+
+func (x *DataSourceConfiguration) GetOriginRequestExpressions() []*ExpressionHook {
+	if x != nil {
+		return x.OriginRequestExpressions
+	}
+	return nil
+}
+
 type DirectiveConfiguration struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3056,7 +4088,7 @@ type DirectiveConfiguration struct {
 func (x *DirectiveConfiguration) Reset() {
 	*x = DirectiveConfiguration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[29]
+		mi := &file_wundernode_config_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3069,7 +4101,7 @@ func (x *DirectiveConfiguration) String() string {
 func (*DirectiveConfiguration) ProtoMessage() {}
 
 func (x *DirectiveConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[29]
+	mi := &file_wundernode_config_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3082,7 +4114,7 @@ func (x *DirectiveConfiguration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DirectiveConfiguration.ProtoReflect.Descriptor instead.
 func (*DirectiveConfiguration) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{29}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *DirectiveConfiguration) GetDirectiveName() string {
@@ -3115,7 +4147,7 @@ type DataSourceCustom_NatsKv struct {
 func (x *DataSourceCustom_NatsKv) Reset() {
 	*x = DataSourceCustom_NatsKv{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[30]
+		mi := &file_wundernode_config_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3128,7 +4160,7 @@ func (x *DataSourceCustom_NatsKv) String() string {
 func (*DataSourceCustom_NatsKv) ProtoMessage() {}
 
 func (x *DataSourceCustom_NatsKv) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[30]
+	mi := &file_wundernode_config_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3141,7 +4173,7 @@ func (x *DataSourceCustom_NatsKv) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataSourceCustom_NatsKv.ProtoReflect.Descriptor instead.
 func (*DataSourceCustom_NatsKv) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{30}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *DataSourceCustom_NatsKv) GetServerURL() string {
@@ -3186,6 +4218,56 @@ func (x *DataSourceCustom_NatsKv) GetBucketPrefix() *ConfigurationVariable {
 	return nil
 }
 
+// DataSourceCustom_WebhookEvents streams the payloads received by a
+// webhook with publishEvents enabled to the subscriptions
+type DataSourceCustom_WebhookEvents struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the webhook
+	Webhook string `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+}
+
+func (x *DataSourceCustom_WebhookEvents) Reset() {
+	*x = DataSourceCustom_WebhookEvents{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wundernode_config_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataSourceCustom_WebhookEvents) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataSourceCustom_WebhookEvents) ProtoMessage() {}
+
+func (x *DataSourceCustom_WebhookEvents) ProtoReflect() protoreflect.Message {
+	mi := &file_wundernode_config_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataSourceCustom_WebhookEvents.ProtoReflect.Descriptor instead.
+func (*DataSourceCustom_WebhookEvents) Descriptor() ([]byte, []int) {
+	return file_wundernode_config_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DataSourceCustom_WebhookEvents) GetWebhook() string {
+	if x != nil {
+		return x.Webhook
+	}
+	return ""
+}
+
 type DataSourceCustom_REST struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3200,7 +4282,7 @@ type DataSourceCustom_REST struct {
 func (x *DataSourceCustom_REST) Reset() {
 	*x = DataSourceCustom_REST{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[31]
+		mi := &file_wundernode_config_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3213,7 +4295,7 @@ func (x *DataSourceCustom_REST) String() string {
 func (*DataSourceCustom_REST) ProtoMessage() {}
 
 func (x *DataSourceCustom_REST) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[31]
+	mi := &file_wundernode_config_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3226,7 +4308,7 @@ func (x *DataSourceCustom_REST) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataSourceCustom_REST.ProtoReflect.Descriptor instead.
 func (*DataSourceCustom_REST) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{31}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *DataSourceCustom_REST) GetFetch() *FetchConfiguration {
@@ -3270,7 +4352,7 @@ type StatusCodeTypeMapping struct {
 func (x *StatusCodeTypeMapping) Reset() {
 	*x = StatusCodeTypeMapping{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[32]
+		mi := &file_wundernode_config_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3283,7 +4365,7 @@ func (x *StatusCodeTypeMapping) String() string {
 func (*StatusCodeTypeMapping) ProtoMessage() {}
 
 func (x *StatusCodeTypeMapping) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[32]
+	mi := &file_wundernode_config_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3296,7 +4378,7 @@ func (x *StatusCodeTypeMapping) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatusCodeTypeMapping.ProtoReflect.Descriptor instead.
 func (*StatusCodeTypeMapping) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{32}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *StatusCodeTypeMapping) GetStatusCode() int64 {
@@ -3336,7 +4418,7 @@ type DataSourceCustom_GraphQL struct {
 func (x *DataSourceCustom_GraphQL) Reset() {
 	*x = DataSourceCustom_GraphQL{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[33]
+		mi := &file_wundernode_config_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3349,7 +4431,7 @@ func (x *DataSourceCustom_GraphQL) String() string {
 func (*DataSourceCustom_GraphQL) ProtoMessage() {}
 
 func (x *DataSourceCustom_GraphQL) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[33]
+	mi := &file_wundernode_config_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3362,7 +4444,7 @@ func (x *DataSourceCustom_GraphQL) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataSourceCustom_GraphQL.ProtoReflect.Descriptor instead.
 func (*DataSourceCustom_GraphQL) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{33}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *DataSourceCustom_GraphQL) GetFetch() *FetchConfiguration {
@@ -3424,7 +4506,7 @@ type DataSourceCustom_Database struct {
 func (x *DataSourceCustom_Database) Reset() {
 	*x = DataSourceCustom_Database{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[34]
+		mi := &file_wundernode_config_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3437,7 +4519,7 @@ func (x *DataSourceCustom_Database) String() string {
 func (*DataSourceCustom_Database) ProtoMessage() {}
 
 func (x *DataSourceCustom_Database) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[34]
+	mi := &file_wundernode_config_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3450,7 +4532,7 @@ func (x *DataSourceCustom_Database) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataSourceCustom_Database.ProtoReflect.Descriptor instead.
 func (*DataSourceCustom_Database) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{34}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *DataSourceCustom_Database) GetDatabaseURL() *ConfigurationVariable {
@@ -3507,7 +4589,7 @@ type GraphQLFederationConfiguration struct {
 func (x *GraphQLFederationConfiguration) Reset() {
 	*x = GraphQLFederationConfiguration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[35]
+		mi := &file_wundernode_config_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3520,7 +4602,7 @@ func (x *GraphQLFederationConfiguration) String() string {
 func (*GraphQLFederationConfiguration) ProtoMessage() {}
 
 func (x *GraphQLFederationConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[35]
+	mi := &file_wundernode_config_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3533,7 +4615,7 @@ func (x *GraphQLFederationConfiguration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GraphQLFederationConfiguration.ProtoReflect.Descriptor instead.
 func (*GraphQLFederationConfiguration) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{35}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *GraphQLFederationConfiguration) GetEnabled() bool {
@@ -3561,7 +4643,7 @@ type DataSourceCustom_Static struct {
 func (x *DataSourceCustom_Static) Reset() {
 	*x = DataSourceCustom_Static{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[36]
+		mi := &file_wundernode_config_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3574,7 +4656,7 @@ func (x *DataSourceCustom_Static) String() string {
 func (*DataSourceCustom_Static) ProtoMessage() {}
 
 func (x *DataSourceCustom_Static) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[36]
+	mi := &file_wundernode_config_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3587,7 +4669,7 @@ func (x *DataSourceCustom_Static) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataSourceCustom_Static.ProtoReflect.Descriptor instead.
 func (*DataSourceCustom_Static) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{36}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *DataSourceCustom_Static) GetData() *ConfigurationVariable {
@@ -3610,7 +4692,7 @@ type GraphQLSubscriptionConfiguration struct {
 func (x *GraphQLSubscriptionConfiguration) Reset() {
 	*x = GraphQLSubscriptionConfiguration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[37]
+		mi := &file_wundernode_config_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3623,7 +4705,7 @@ func (x *GraphQLSubscriptionConfiguration) String() string {
 func (*GraphQLSubscriptionConfiguration) ProtoMessage() {}
 
 func (x *GraphQLSubscriptionConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[37]
+	mi := &file_wundernode_config_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3636,7 +4718,7 @@ func (x *GraphQLSubscriptionConfiguration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GraphQLSubscriptionConfiguration.ProtoReflect.Descriptor instead.
 func (*GraphQLSubscriptionConfiguration) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{37}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *GraphQLSubscriptionConfiguration) GetEnabled() bool {
@@ -3689,7 +4771,7 @@ type FetchConfiguration struct {
 func (x *FetchConfiguration) Reset() {
 	*x = FetchConfiguration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[38]
+		mi := &file_wundernode_config_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3702,7 +4784,7 @@ func (x *FetchConfiguration) String() string {
 func (*FetchConfiguration) ProtoMessage() {}
 
 func (x *FetchConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[38]
+	mi := &file_wundernode_config_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3715,7 +4797,7 @@ func (x *FetchConfiguration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchConfiguration.ProtoReflect.Descriptor instead.
 func (*FetchConfiguration) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{38}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *FetchConfiguration) GetUrl() *ConfigurationVariable {
@@ -3808,7 +4890,7 @@ type MTLSConfiguration struct {
 func (x *MTLSConfiguration) Reset() {
 	*x = MTLSConfiguration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[39]
+		mi := &file_wundernode_config_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3821,7 +4903,7 @@ func (x *MTLSConfiguration) String() string {
 func (*MTLSConfiguration) ProtoMessage() {}
 
 func (x *MTLSConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[39]
+	mi := &file_wundernode_config_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3834,7 +4916,7 @@ func (x *MTLSConfiguration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MTLSConfiguration.ProtoReflect.Descriptor instead.
 func (*MTLSConfiguration) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{39}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *MTLSConfiguration) GetKey() *ConfigurationVariable {
@@ -3871,7 +4953,7 @@ type UpstreamAuthentication struct {
 func (x *UpstreamAuthentication) Reset() {
 	*x = UpstreamAuthentication{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[40]
+		mi := &file_wundernode_config_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3884,7 +4966,7 @@ func (x *UpstreamAuthentication) String() string {
 func (*UpstreamAuthentication) ProtoMessage() {}
 
 func (x *UpstreamAuthentication) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[40]
+	mi := &file_wundernode_config_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3897,7 +4979,7 @@ func (x *UpstreamAuthentication) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpstreamAuthentication.ProtoReflect.Descriptor instead.
 func (*UpstreamAuthentication) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{40}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *UpstreamAuthentication) GetKind() UpstreamAuthenticationKind {
@@ -3933,7 +5015,7 @@ type JwtUpstreamAuthenticationConfig struct {
 func (x *JwtUpstreamAuthenticationConfig) Reset() {
 	*x = JwtUpstreamAuthenticationConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[41]
+		mi := &file_wundernode_config_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3946,7 +5028,7 @@ func (x *JwtUpstreamAuthenticationConfig) String() string {
 func (*JwtUpstreamAuthenticationConfig) ProtoMessage() {}
 
 func (x *JwtUpstreamAuthenticationConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[41]
+	mi := &file_wundernode_config_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3959,7 +5041,7 @@ func (x *JwtUpstreamAuthenticationConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JwtUpstreamAuthenticationConfig.ProtoReflect.Descriptor instead.
 func (*JwtUpstreamAuthenticationConfig) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{41}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *JwtUpstreamAuthenticationConfig) GetSecret() *ConfigurationVariable {
@@ -3989,7 +5071,7 @@ type JwtUpstreamAuthenticationWithAccessTokenExchange struct {
 func (x *JwtUpstreamAuthenticationWithAccessTokenExchange) Reset() {
 	*x = JwtUpstreamAuthenticationWithAccessTokenExchange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[42]
+		mi := &file_wundernode_config_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4002,7 +5084,7 @@ func (x *JwtUpstreamAuthenticationWithAccessTokenExchange) String() string {
 func (*JwtUpstreamAuthenticationWithAccessTokenExchange) ProtoMessage() {}
 
 func (x *JwtUpstreamAuthenticationWithAccessTokenExchange) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[42]
+	mi := &file_wundernode_config_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4015,7 +5097,7 @@ func (x *JwtUpstreamAuthenticationWithAccessTokenExchange) ProtoReflect() protor
 
 // Deprecated: Use JwtUpstreamAuthenticationWithAccessTokenExchange.ProtoReflect.Descriptor instead.
 func (*JwtUpstreamAuthenticationWithAccessTokenExchange) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{42}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *JwtUpstreamAuthenticationWithAccessTokenExchange) GetSecret() *ConfigurationVariable {
@@ -4052,7 +5134,7 @@ type RESTSubscriptionConfiguration struct {
 func (x *RESTSubscriptionConfiguration) Reset() {
 	*x = RESTSubscriptionConfiguration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[43]
+		mi := &file_wundernode_config_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4065,7 +5147,7 @@ func (x *RESTSubscriptionConfiguration) String() string {
 func (*RESTSubscriptionConfiguration) ProtoMessage() {}
 
 func (x *RESTSubscriptionConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[43]
+	mi := &file_wundernode_config_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4078,7 +5160,7 @@ func (x *RESTSubscriptionConfiguration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RESTSubscriptionConfiguration.ProtoReflect.Descriptor instead.
 func (*RESTSubscriptionConfiguration) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{43}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *RESTSubscriptionConfiguration) GetEnabled() bool {
@@ -4114,7 +5196,7 @@ type URLQueryConfiguration struct {
 func (x *URLQueryConfiguration) Reset() {
 	*x = URLQueryConfiguration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[44]
+		mi := &file_wundernode_config_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4127,7 +5209,7 @@ func (x *URLQueryConfiguration) String() string {
 func (*URLQueryConfiguration) ProtoMessage() {}
 
 func (x *URLQueryConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[44]
+	mi := &file_wundernode_config_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4140,7 +5222,7 @@ func (x *URLQueryConfiguration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use URLQueryConfiguration.ProtoReflect.Descriptor instead.
 func (*URLQueryConfiguration) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{44}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *URLQueryConfiguration) GetName() string {
@@ -4168,7 +5250,7 @@ type HTTPHeader struct {
 func (x *HTTPHeader) Reset() {
 	*x = HTTPHeader{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[45]
+		mi := &file_wundernode_config_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4181,7 +5263,7 @@ func (x *HTTPHeader) String() string {
 func (*HTTPHeader) ProtoMessage() {}
 
 func (x *HTTPHeader) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[45]
+	mi := &file_wundernode_config_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4194,7 +5276,7 @@ func (x *HTTPHeader) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HTTPHeader.ProtoReflect.Descriptor instead.
 func (*HTTPHeader) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{45}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *HTTPHeader) GetValues() []*ConfigurationVariable {
@@ -4216,7 +5298,7 @@ type TypeConfiguration struct {
 func (x *TypeConfiguration) Reset() {
 	*x = TypeConfiguration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[46]
+		mi := &file_wundernode_config_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4229,7 +5311,7 @@ func (x *TypeConfiguration) String() string {
 func (*TypeConfiguration) ProtoMessage() {}
 
 func (x *TypeConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[46]
+	mi := &file_wundernode_config_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4242,7 +5324,7 @@ func (x *TypeConfiguration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TypeConfiguration.ProtoReflect.Descriptor instead.
 func (*TypeConfiguration) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{46}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *TypeConfiguration) GetTypeName() string {
@@ -4276,7 +5358,7 @@ type FieldConfiguration struct {
 func (x *FieldConfiguration) Reset() {
 	*x = FieldConfiguration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[47]
+		mi := &file_wundernode_config_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4289,7 +5371,7 @@ func (x *FieldConfiguration) String() string {
 func (*FieldConfiguration) ProtoMessage() {}
 
 func (x *FieldConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[47]
+	mi := &file_wundernode_config_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4302,7 +5384,7 @@ func (x *FieldConfiguration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FieldConfiguration.ProtoReflect.Descriptor instead.
 func (*FieldConfiguration) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{47}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *FieldConfiguration) GetTypeName() string {
@@ -4366,7 +5448,7 @@ type TypeField struct {
 func (x *TypeField) Reset() {
 	*x = TypeField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[48]
+		mi := &file_wundernode_config_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4379,7 +5461,7 @@ func (x *TypeField) String() string {
 func (*TypeField) ProtoMessage() {}
 
 func (x *TypeField) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[48]
+	mi := &file_wundernode_config_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4392,7 +5474,7 @@ func (x *TypeField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TypeField.ProtoReflect.Descriptor instead.
 func (*TypeField) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{48}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *TypeField) GetTypeName() string {
@@ -4421,7 +5503,7 @@ type SingleTypeField struct {
 func (x *SingleTypeField) Reset() {
 	*x = SingleTypeField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[49]
+		mi := &file_wundernode_config_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4434,7 +5516,7 @@ func (x *SingleTypeField) String() string {
 func (*SingleTypeField) ProtoMessage() {}
 
 func (x *SingleTypeField) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[49]
+	mi := &file_wundernode_config_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4447,7 +5529,7 @@ func (x *SingleTypeField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SingleTypeField.ProtoReflect.Descriptor instead.
 func (*SingleTypeField) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{49}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *SingleTypeField) GetTypeName() string {
@@ -4479,7 +5561,7 @@ type ArgumentConfiguration struct {
 func (x *ArgumentConfiguration) Reset() {
 	*x = ArgumentConfiguration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[50]
+		mi := &file_wundernode_config_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4492,7 +5574,7 @@ func (x *ArgumentConfiguration) String() string {
 func (*ArgumentConfiguration) ProtoMessage() {}
 
 func (x *ArgumentConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[50]
+	mi := &file_wundernode_config_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4505,7 +5587,7 @@ func (x *ArgumentConfiguration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArgumentConfiguration.ProtoReflect.Descriptor instead.
 func (*ArgumentConfiguration) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{50}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *ArgumentConfiguration) GetName() string {
@@ -4561,7 +5643,7 @@ type WunderGraphConfiguration struct {
 func (x *WunderGraphConfiguration) Reset() {
 	*x = WunderGraphConfiguration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[51]
+		mi := &file_wundernode_config_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4574,7 +5656,7 @@ func (x *WunderGraphConfiguration) String() string {
 func (*WunderGraphConfiguration) ProtoMessage() {}
 
 func (x *WunderGraphConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[51]
+	mi := &file_wundernode_config_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4587,7 +5669,7 @@ func (x *WunderGraphConfiguration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WunderGraphConfiguration.ProtoReflect.Descriptor instead.
 func (*WunderGraphConfiguration) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{51}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *WunderGraphConfiguration) GetApi() *UserDefinedApi {
@@ -4656,7 +5738,7 @@ type EnabledFeatures struct {
 func (x *EnabledFeatures) Reset() {
 	*x = EnabledFeatures{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[52]
+		mi := &file_wundernode_config_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4669,7 +5751,7 @@ func (x *EnabledFeatures) String() string {
 func (*EnabledFeatures) ProtoMessage() {}
 
 func (x *EnabledFeatures) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[52]
+	mi := &file_wundernode_config_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4682,7 +5764,7 @@ func (x *EnabledFeatures) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnabledFeatures.ProtoReflect.Descriptor instead.
 func (*EnabledFeatures) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{52}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *EnabledFeatures) GetApiCount() int32 {
@@ -4725,14 +5807,15 @@ type S3UploadProfileHooksConfiguration struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PreUpload  bool `protobuf:"varint,1,opt,name=preUpload,proto3" json:"preUpload,omitempty"`
-	PostUpload bool `protobuf:"varint,2,opt,name=postUpload,proto3" json:"postUpload,omitempty"`
+	PreUpload   bool `protobuf:"varint,1,opt,name=preUpload,proto3" json:"preUpload,omitempty"`
+	PostUpload  bool `protobuf:"varint,2,opt,name=postUpload,proto3" json:"postUpload,omitempty"`
+	PreDownload bool `protobuf:"varint,3,opt,name=preDownload,proto3" json:"preDownload,omitempty"`
 }
 
 func (x *S3UploadProfileHooksConfiguration) Reset() {
 	*x = S3UploadProfileHooksConfiguration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[53]
+		mi := &file_wundernode_config_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4745,7 +5828,7 @@ func (x *S3UploadProfileHooksConfiguration) String() string {
 func (*S3UploadProfileHooksConfiguration) ProtoMessage() {}
 
 func (x *S3UploadProfileHooksConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[53]
+	mi := &file_wundernode_config_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4758,7 +5841,7 @@ func (x *S3UploadProfileHooksConfiguration) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use S3UploadProfileHooksConfiguration.ProtoReflect.Descriptor instead.
 func (*S3UploadProfileHooksConfiguration) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{53}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *S3UploadProfileHooksConfiguration) GetPreUpload() bool {
@@ -4775,6 +5858,13 @@ func (x *S3UploadProfileHooksConfiguration) GetPostUpload() bool {
 	return false
 }
 
+func (x *S3UploadProfileHooksConfiguration) GetPreDownload() bool {
+	if x != nil {
+		return x.PreDownload
+	}
+	return false
+}
+
 type S3UploadProfile struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -4787,12 +5877,26 @@ type S3UploadProfile struct {
 	AllowedFileExtensions     []string                           `protobuf:"bytes,5,rep,name=allowedFileExtensions,proto3" json:"allowedFileExtensions,omitempty"`
 	MetadataJSONSchema        string                             `protobuf:"bytes,6,opt,name=metadataJSONSchema,proto3" json:"metadataJSONSchema,omitempty"`
 	Hooks                     *S3UploadProfileHooksConfiguration `protobuf:"bytes,7,opt,name=hooks,proto3" json:"hooks,omitempty"`
+	// Roles allowed to download and list files uploaded with this profile. If empty,
+	// any user allowed by requireAuthentication can download them
+	DownloadRoles []string `protobuf:"bytes,8,rep,name=downloadRoles,proto3" json:"downloadRoles,omitempty"`
+	// Roles allowed to delete files uploaded with this profile. Users can always
+	// delete the files they uploaded
+	DeleteRoles []string `protobuf:"bytes,9,rep,name=deleteRoles,proto3" json:"deleteRoles,omitempty"`
+	// Whether downloads are streamed through the node instead of redirecting
+	// to a presigned URL
+	ProxyDownloads bool `protobuf:"varint,10,opt,name=proxyDownloads,proto3" json:"proxyDownloads,omitempty"`
+	// Images derived from the uploaded JPEG, PNG, GIF and WebP files
+	ImageTransformations []*ImageTransformation `protobuf:"bytes,11,rep,name=imageTransformations,proto3" json:"imageTransformations,omitempty"`
+	// Whether the files uploaded with this profile can be listed, downloaded and
+	// deleted through the files endpoints of the provider
+	EnableFiles bool `protobuf:"varint,12,opt,name=enableFiles,proto3" json:"enableFiles,omitempty"`
 }
 
 func (x *S3UploadProfile) Reset() {
 	*x = S3UploadProfile{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[54]
+		mi := &file_wundernode_config_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4805,7 +5909,7 @@ func (x *S3UploadProfile) String() string {
 func (*S3UploadProfile) ProtoMessage() {}
 
 func (x *S3UploadProfile) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[54]
+	mi := &file_wundernode_config_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4818,7 +5922,7 @@ func (x *S3UploadProfile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use S3UploadProfile.ProtoReflect.Descriptor instead.
 func (*S3UploadProfile) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{54}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *S3UploadProfile) GetRequireAuthentication() bool {
@@ -4870,38 +5974,74 @@ func (x *S3UploadProfile) GetHooks() *S3UploadProfileHooksConfiguration {
 	return nil
 }
 
-type S3UploadConfiguration struct {
+func (x *S3UploadProfile) GetDownloadRoles() []string {
+	if x != nil {
+		return x.DownloadRoles
+	}
+	return nil
+}
+
+func (x *S3UploadProfile) GetDeleteRoles() []string {
+	if x != nil {
+		return x.DeleteRoles
+	}
+	return nil
+}
+
+func (x *S3UploadProfile) GetProxyDownloads() bool {
+	if x != nil {
+		return x.ProxyDownloads
+	}
+	return false
+}
+
+func (x *S3UploadProfile) GetImageTransformations() []*ImageTransformation {
+	if x != nil {
+		return x.ImageTransformations
+	}
+	return nil
+}
+
+func (x *S3UploadProfile) GetEnableFiles() bool {
+	if x != nil {
+		return x.EnableFiles
+	}
+	return false
+}
+
+type ImageTransformation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name            string                      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Endpoint        *ConfigurationVariable      `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	AccessKeyID     *ConfigurationVariable      `protobuf:"bytes,3,opt,name=accessKeyID,proto3" json:"accessKeyID,omitempty"`
-	SecretAccessKey *ConfigurationVariable      `protobuf:"bytes,4,opt,name=secretAccessKey,proto3" json:"secretAccessKey,omitempty"`
-	BucketName      *ConfigurationVariable      `protobuf:"bytes,5,opt,name=bucketName,proto3" json:"bucketName,omitempty"`
-	BucketLocation  *ConfigurationVariable      `protobuf:"bytes,6,opt,name=bucketLocation,proto3" json:"bucketLocation,omitempty"`
-	UseSSL          bool                        `protobuf:"varint,7,opt,name=useSSL,proto3" json:"useSSL,omitempty"`
-	UploadProfiles  map[string]*S3UploadProfile `protobuf:"bytes,8,rep,name=uploadProfiles,proto3" json:"uploadProfiles,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Name of the transformation, appended to the key of the original file
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Dimensions in pixels, if only one is set the other one keeps the aspect ratio
+	Width  int32       `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`
+	Height int32       `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Fit    ImageFit    `protobuf:"varint,4,opt,name=fit,proto3,enum=wgpb.ImageFit" json:"fit,omitempty"`
+	Format ImageFormat `protobuf:"varint,5,opt,name=format,proto3,enum=wgpb.ImageFormat" json:"format,omitempty"`
+	// JPEG quality between 1 and 100, 0 uses the default
+	Quality int32 `protobuf:"varint,6,opt,name=quality,proto3" json:"quality,omitempty"`
 }
 
-func (x *S3UploadConfiguration) Reset() {
-	*x = S3UploadConfiguration{}
+func (x *ImageTransformation) Reset() {
+	*x = ImageTransformation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[55]
+		mi := &file_wundernode_config_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *S3UploadConfiguration) String() string {
+func (x *ImageTransformation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*S3UploadConfiguration) ProtoMessage() {}
+func (*ImageTransformation) ProtoMessage() {}
 
-func (x *S3UploadConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[55]
+func (x *ImageTransformation) ProtoReflect() protoreflect.Message {
+	mi := &file_wundernode_config_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4912,56 +6052,155 @@ func (x *S3UploadConfiguration) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use S3UploadConfiguration.ProtoReflect.Descriptor instead.
-func (*S3UploadConfiguration) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use ImageTransformation.ProtoReflect.Descriptor instead.
+func (*ImageTransformation) Descriptor() ([]byte, []int) {
+	return file_wundernode_config_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *S3UploadConfiguration) GetName() string {
+func (x *ImageTransformation) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *S3UploadConfiguration) GetEndpoint() *ConfigurationVariable {
+func (x *ImageTransformation) GetWidth() int32 {
 	if x != nil {
-		return x.Endpoint
+		return x.Width
 	}
-	return nil
+	return 0
 }
 
-func (x *S3UploadConfiguration) GetAccessKeyID() *ConfigurationVariable {
+func (x *ImageTransformation) GetHeight() int32 {
 	if x != nil {
-		return x.AccessKeyID
+		return x.Height
 	}
-	return nil
+	return 0
 }
 
-func (x *S3UploadConfiguration) GetSecretAccessKey() *ConfigurationVariable {
+func (x *ImageTransformation) GetFit() ImageFit {
 	if x != nil {
-		return x.SecretAccessKey
+		return x.Fit
 	}
-	return nil
+	return ImageFit_ImageFitContain
 }
 
-func (x *S3UploadConfiguration) GetBucketName() *ConfigurationVariable {
+func (x *ImageTransformation) GetFormat() ImageFormat {
 	if x != nil {
-		return x.BucketName
+		return x.Format
 	}
-	return nil
+	return ImageFormat_ImageFormatOriginal
 }
 
-func (x *S3UploadConfiguration) GetBucketLocation() *ConfigurationVariable {
+func (x *ImageTransformation) GetQuality() int32 {
 	if x != nil {
-		return x.BucketLocation
+		return x.Quality
 	}
-	return nil
+	return 0
 }
 
-func (x *S3UploadConfiguration) GetUseSSL() bool {
-	if x != nil {
-		return x.UseSSL
+type S3UploadConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional for GCS and Azure, overrides their default endpoints
+	Endpoint *ConfigurationVariable `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// Account name for Azure
+	AccessKeyID *ConfigurationVariable `protobuf:"bytes,3,opt,name=accessKeyID,proto3" json:"accessKeyID,omitempty"`
+	// Account key for Azure
+	SecretAccessKey *ConfigurationVariable `protobuf:"bytes,4,opt,name=secretAccessKey,proto3" json:"secretAccessKey,omitempty"`
+	// Container name for Azure
+	BucketName     *ConfigurationVariable      `protobuf:"bytes,5,opt,name=bucketName,proto3" json:"bucketName,omitempty"`
+	BucketLocation *ConfigurationVariable      `protobuf:"bytes,6,opt,name=bucketLocation,proto3" json:"bucketLocation,omitempty"`
+	UseSSL         bool                        `protobuf:"varint,7,opt,name=useSSL,proto3" json:"useSSL,omitempty"`
+	UploadProfiles map[string]*S3UploadProfile `protobuf:"bytes,8,rep,name=uploadProfiles,proto3" json:"uploadProfiles,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Storage        UploadStorageKind           `protobuf:"varint,9,opt,name=storage,proto3,enum=wgpb.UploadStorageKind" json:"storage,omitempty"`
+	// Directory for the local storage
+	Directory *ConfigurationVariable `protobuf:"bytes,10,opt,name=directory,proto3" json:"directory,omitempty"`
+	// Service account key for GCS, if empty the credentials are retrieved
+	// from the metadata server
+	CredentialsJSON *ConfigurationVariable `protobuf:"bytes,11,opt,name=credentialsJSON,proto3" json:"credentialsJSON,omitempty"`
+}
+
+func (x *S3UploadConfiguration) Reset() {
+	*x = S3UploadConfiguration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wundernode_config_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *S3UploadConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*S3UploadConfiguration) ProtoMessage() {}
+
+func (x *S3UploadConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_wundernode_config_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use S3UploadConfiguration.ProtoReflect.Descriptor instead.
+func (*S3UploadConfiguration) Descriptor() ([]byte, []int) {
+	return file_wundernode_config_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *S3UploadConfiguration) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *S3UploadConfiguration) GetEndpoint() *ConfigurationVariable {
+	if x != nil {
+		return x.Endpoint
+	}
+	return nil
+}
+
+func (x *S3UploadConfiguration) GetAccessKeyID() *ConfigurationVariable {
+	if x != nil {
+		return x.AccessKeyID
+	}
+	return nil
+}
+
+func (x *S3UploadConfiguration) GetSecretAccessKey() *ConfigurationVariable {
+	if x != nil {
+		return x.SecretAccessKey
+	}
+	return nil
+}
+
+func (x *S3UploadConfiguration) GetBucketName() *ConfigurationVariable {
+	if x != nil {
+		return x.BucketName
+	}
+	return nil
+}
+
+func (x *S3UploadConfiguration) GetBucketLocation() *ConfigurationVariable {
+	if x != nil {
+		return x.BucketLocation
+	}
+	return nil
+}
+
+func (x *S3UploadConfiguration) GetUseSSL() bool {
+	if x != nil {
+		return x.UseSSL
 	}
 	return false
 }
@@ -4973,29 +6212,51 @@ func (x *S3UploadConfiguration) GetUploadProfiles() map[string]*S3UploadProfile
 	return nil
 }
 
+func (x *S3UploadConfiguration) GetStorage() UploadStorageKind {
+	if x != nil {
+		return x.Storage
+	}
+	return UploadStorageKind_UploadStorageS3
+}
+
+func (x *S3UploadConfiguration) GetDirectory() *ConfigurationVariable {
+	if x != nil {
+		return x.Directory
+	}
+	return nil
+}
+
+func (x *S3UploadConfiguration) GetCredentialsJSON() *ConfigurationVariable {
+	if x != nil {
+		return x.CredentialsJSON
+	}
+	return nil
+}
+
 type UserDefinedApi struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EngineConfiguration   *EngineConfiguration       `protobuf:"bytes,3,opt,name=engineConfiguration,proto3" json:"engineConfiguration,omitempty"`
-	EnableGraphqlEndpoint bool                       `protobuf:"varint,5,opt,name=enableGraphqlEndpoint,proto3" json:"enableGraphqlEndpoint,omitempty"`
-	Operations            []*Operation               `protobuf:"bytes,6,rep,name=operations,proto3" json:"operations,omitempty"`
-	InvalidOperationNames []string                   `protobuf:"bytes,16,rep,name=invalidOperationNames,proto3" json:"invalidOperationNames,omitempty"`
-	CorsConfiguration     *CorsConfiguration         `protobuf:"bytes,7,opt,name=corsConfiguration,proto3" json:"corsConfiguration,omitempty"`
-	AuthenticationConfig  *ApiAuthenticationConfig   `protobuf:"bytes,8,opt,name=authenticationConfig,proto3" json:"authenticationConfig,omitempty"`
-	S3UploadConfiguration []*S3UploadConfiguration   `protobuf:"bytes,9,rep,name=s3UploadConfiguration,proto3" json:"s3UploadConfiguration,omitempty"`
-	AllowedHostNames      []*ConfigurationVariable   `protobuf:"bytes,11,rep,name=allowedHostNames,proto3" json:"allowedHostNames,omitempty"`
-	Webhooks              []*WebhookConfiguration    `protobuf:"bytes,12,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
-	ServerOptions         *ServerOptions             `protobuf:"bytes,14,opt,name=serverOptions,proto3" json:"serverOptions,omitempty"`
-	NodeOptions           *NodeOptions               `protobuf:"bytes,15,opt,name=nodeOptions,proto3" json:"nodeOptions,omitempty"`
-	ExperimentalConfig    *ExperimentalConfiguration `protobuf:"bytes,17,opt,name=experimentalConfig,proto3" json:"experimentalConfig,omitempty"`
+	EngineConfiguration   *EngineConfiguration         `protobuf:"bytes,3,opt,name=engineConfiguration,proto3" json:"engineConfiguration,omitempty"`
+	EnableGraphqlEndpoint bool                         `protobuf:"varint,5,opt,name=enableGraphqlEndpoint,proto3" json:"enableGraphqlEndpoint,omitempty"`
+	Operations            []*Operation                 `protobuf:"bytes,6,rep,name=operations,proto3" json:"operations,omitempty"`
+	InvalidOperationNames []string                     `protobuf:"bytes,16,rep,name=invalidOperationNames,proto3" json:"invalidOperationNames,omitempty"`
+	CorsConfiguration     *CorsConfiguration           `protobuf:"bytes,7,opt,name=corsConfiguration,proto3" json:"corsConfiguration,omitempty"`
+	AuthenticationConfig  *ApiAuthenticationConfig     `protobuf:"bytes,8,opt,name=authenticationConfig,proto3" json:"authenticationConfig,omitempty"`
+	S3UploadConfiguration []*S3UploadConfiguration     `protobuf:"bytes,9,rep,name=s3UploadConfiguration,proto3" json:"s3UploadConfiguration,omitempty"`
+	AllowedHostNames      []*ConfigurationVariable     `protobuf:"bytes,11,rep,name=allowedHostNames,proto3" json:"allowedHostNames,omitempty"`
+	Webhooks              []*WebhookConfiguration      `protobuf:"bytes,12,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	ServerOptions         *ServerOptions               `protobuf:"bytes,14,opt,name=serverOptions,proto3" json:"serverOptions,omitempty"`
+	NodeOptions           *NodeOptions                 `protobuf:"bytes,15,opt,name=nodeOptions,proto3" json:"nodeOptions,omitempty"`
+	ExperimentalConfig    *ExperimentalConfiguration   `protobuf:"bytes,17,opt,name=experimentalConfig,proto3" json:"experimentalConfig,omitempty"`
+	EventWebhooks         []*EventWebhookConfiguration `protobuf:"bytes,18,rep,name=eventWebhooks,proto3" json:"eventWebhooks,omitempty"`
 }
 
 func (x *UserDefinedApi) Reset() {
 	*x = UserDefinedApi{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[56]
+		mi := &file_wundernode_config_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5008,7 +6269,7 @@ func (x *UserDefinedApi) String() string {
 func (*UserDefinedApi) ProtoMessage() {}
 
 func (x *UserDefinedApi) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[56]
+	mi := &file_wundernode_config_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5021,7 +6282,7 @@ func (x *UserDefinedApi) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserDefinedApi.ProtoReflect.Descriptor instead.
 func (*UserDefinedApi) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{56}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *UserDefinedApi) GetEngineConfiguration() *EngineConfiguration {
@@ -5108,6 +6369,13 @@ func (x *UserDefinedApi) GetExperimentalConfig() *ExperimentalConfiguration {
 	return nil
 }
 
+func (x *UserDefinedApi) GetEventWebhooks() []*EventWebhookConfiguration {
+	if x != nil {
+		return x.EventWebhooks
+	}
+	return nil
+}
+
 type ExperimentalConfiguration struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -5119,7 +6387,7 @@ type ExperimentalConfiguration struct {
 func (x *ExperimentalConfiguration) Reset() {
 	*x = ExperimentalConfiguration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[57]
+		mi := &file_wundernode_config_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5132,7 +6400,7 @@ func (x *ExperimentalConfiguration) String() string {
 func (*ExperimentalConfiguration) ProtoMessage() {}
 
 func (x *ExperimentalConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[57]
+	mi := &file_wundernode_config_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5145,7 +6413,7 @@ func (x *ExperimentalConfiguration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExperimentalConfiguration.ProtoReflect.Descriptor instead.
 func (*ExperimentalConfiguration) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{57}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *ExperimentalConfiguration) GetOrm() bool {
@@ -5167,7 +6435,7 @@ type ListenerOptions struct {
 func (x *ListenerOptions) Reset() {
 	*x = ListenerOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[58]
+		mi := &file_wundernode_config_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5180,7 +6448,7 @@ func (x *ListenerOptions) String() string {
 func (*ListenerOptions) ProtoMessage() {}
 
 func (x *ListenerOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[58]
+	mi := &file_wundernode_config_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5193,7 +6461,7 @@ func (x *ListenerOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListenerOptions.ProtoReflect.Descriptor instead.
 func (*ListenerOptions) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{58}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *ListenerOptions) GetHost() *ConfigurationVariable {
@@ -5221,7 +6489,7 @@ type InternalListenerOptions struct {
 func (x *InternalListenerOptions) Reset() {
 	*x = InternalListenerOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[59]
+		mi := &file_wundernode_config_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5234,7 +6502,7 @@ func (x *InternalListenerOptions) String() string {
 func (*InternalListenerOptions) ProtoMessage() {}
 
 func (x *InternalListenerOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[59]
+	mi := &file_wundernode_config_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5247,7 +6515,7 @@ func (x *InternalListenerOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InternalListenerOptions.ProtoReflect.Descriptor instead.
 func (*InternalListenerOptions) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{59}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *InternalListenerOptions) GetPort() *ConfigurationVariable {
@@ -5268,7 +6536,7 @@ type NodeLogging struct {
 func (x *NodeLogging) Reset() {
 	*x = NodeLogging{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[60]
+		mi := &file_wundernode_config_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5281,7 +6549,7 @@ func (x *NodeLogging) String() string {
 func (*NodeLogging) ProtoMessage() {}
 
 func (x *NodeLogging) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[60]
+	mi := &file_wundernode_config_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5294,7 +6562,7 @@ func (x *NodeLogging) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeLogging.ProtoReflect.Descriptor instead.
 func (*NodeLogging) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{60}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *NodeLogging) GetLevel() *ConfigurationVariable {
@@ -5316,7 +6584,7 @@ type PrometheusOptions struct {
 func (x *PrometheusOptions) Reset() {
 	*x = PrometheusOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[61]
+		mi := &file_wundernode_config_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5329,7 +6597,7 @@ func (x *PrometheusOptions) String() string {
 func (*PrometheusOptions) ProtoMessage() {}
 
 func (x *PrometheusOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[61]
+	mi := &file_wundernode_config_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5342,7 +6610,7 @@ func (x *PrometheusOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrometheusOptions.ProtoReflect.Descriptor instead.
 func (*PrometheusOptions) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{61}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *PrometheusOptions) GetEnabled() *ConfigurationVariable {
@@ -5374,12 +6642,13 @@ type NodeOptions struct {
 	DefaultHttpProxyUrl          *ConfigurationVariable   `protobuf:"bytes,8,opt,name=defaultHttpProxyUrl,proto3" json:"defaultHttpProxyUrl,omitempty"`
 	OpenTelemetry                *TelemetryOptions        `protobuf:"bytes,9,opt,name=openTelemetry,proto3" json:"openTelemetry,omitempty"`
 	Prometheus                   *PrometheusOptions       `protobuf:"bytes,10,opt,name=prometheus,proto3" json:"prometheus,omitempty"`
+	WebhookQueue                 *WebhookQueueOptions     `protobuf:"bytes,11,opt,name=webhookQueue,proto3" json:"webhookQueue,omitempty"`
 }
 
 func (x *NodeOptions) Reset() {
 	*x = NodeOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[62]
+		mi := &file_wundernode_config_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5392,7 +6661,7 @@ func (x *NodeOptions) String() string {
 func (*NodeOptions) ProtoMessage() {}
 
 func (x *NodeOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[62]
+	mi := &file_wundernode_config_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5405,7 +6674,7 @@ func (x *NodeOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeOptions.ProtoReflect.Descriptor instead.
 func (*NodeOptions) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{62}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *NodeOptions) GetNodeUrl() *ConfigurationVariable {
@@ -5478,6 +6747,87 @@ func (x *NodeOptions) GetPrometheus() *PrometheusOptions {
 	return nil
 }
 
+func (x *NodeOptions) GetWebhookQueue() *WebhookQueueOptions {
+	if x != nil {
+		return x.WebhookQueue
+	}
+	return nil
+}
+
+type WebhookQueueOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind WebhookQueueKind `protobuf:"varint,1,opt,name=kind,proto3,enum=wgpb.WebhookQueueKind" json:"kind,omitempty"`
+	// Directory used by the file queue, defaults to generated/webhook-queue
+	// inside the WunderGraph directory
+	Directory *ConfigurationVariable `protobuf:"bytes,2,opt,name=directory,proto3" json:"directory,omitempty"`
+	// NATS server used by the JetStream queue, defaults to the embedded server
+	NatsUrl   *ConfigurationVariable `protobuf:"bytes,3,opt,name=natsUrl,proto3" json:"natsUrl,omitempty"`
+	NatsToken *ConfigurationVariable `protobuf:"bytes,4,opt,name=natsToken,proto3" json:"natsToken,omitempty"`
+}
+
+func (x *WebhookQueueOptions) Reset() {
+	*x = WebhookQueueOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wundernode_config_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookQueueOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookQueueOptions) ProtoMessage() {}
+
+func (x *WebhookQueueOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_wundernode_config_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookQueueOptions.ProtoReflect.Descriptor instead.
+func (*WebhookQueueOptions) Descriptor() ([]byte, []int) {
+	return file_wundernode_config_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *WebhookQueueOptions) GetKind() WebhookQueueKind {
+	if x != nil {
+		return x.Kind
+	}
+	return WebhookQueueKind_WebhookQueueFile
+}
+
+func (x *WebhookQueueOptions) GetDirectory() *ConfigurationVariable {
+	if x != nil {
+		return x.Directory
+	}
+	return nil
+}
+
+func (x *WebhookQueueOptions) GetNatsUrl() *ConfigurationVariable {
+	if x != nil {
+		return x.NatsUrl
+	}
+	return nil
+}
+
+func (x *WebhookQueueOptions) GetNatsToken() *ConfigurationVariable {
+	if x != nil {
+		return x.NatsToken
+	}
+	return nil
+}
+
 type TelemetryOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -5492,7 +6842,7 @@ type TelemetryOptions struct {
 func (x *TelemetryOptions) Reset() {
 	*x = TelemetryOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[63]
+		mi := &file_wundernode_config_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5505,7 +6855,7 @@ func (x *TelemetryOptions) String() string {
 func (*TelemetryOptions) ProtoMessage() {}
 
 func (x *TelemetryOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[63]
+	mi := &file_wundernode_config_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5518,7 +6868,7 @@ func (x *TelemetryOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TelemetryOptions.ProtoReflect.Descriptor instead.
 func (*TelemetryOptions) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{63}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *TelemetryOptions) GetEnabled() *ConfigurationVariable {
@@ -5560,7 +6910,7 @@ type ServerLogging struct {
 func (x *ServerLogging) Reset() {
 	*x = ServerLogging{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[64]
+		mi := &file_wundernode_config_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5573,7 +6923,7 @@ func (x *ServerLogging) String() string {
 func (*ServerLogging) ProtoMessage() {}
 
 func (x *ServerLogging) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[64]
+	mi := &file_wundernode_config_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5586,7 +6936,7 @@ func (x *ServerLogging) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServerLogging.ProtoReflect.Descriptor instead.
 func (*ServerLogging) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{64}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *ServerLogging) GetLevel() *ConfigurationVariable {
@@ -5601,15 +6951,22 @@ type ServerOptions struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ServerUrl *ConfigurationVariable `protobuf:"bytes,1,opt,name=serverUrl,proto3" json:"serverUrl,omitempty"`
-	Listen    *ListenerOptions       `protobuf:"bytes,2,opt,name=listen,proto3" json:"listen,omitempty"`
-	Logger    *ServerLogging         `protobuf:"bytes,3,opt,name=logger,proto3" json:"logger,omitempty"`
+	ServerUrl  *ConfigurationVariable  `protobuf:"bytes,1,opt,name=serverUrl,proto3" json:"serverUrl,omitempty"`
+	Listen     *ListenerOptions        `protobuf:"bytes,2,opt,name=listen,proto3" json:"listen,omitempty"`
+	Logger     *ServerLogging          `protobuf:"bytes,3,opt,name=logger,proto3" json:"logger,omitempty"`
+	WasmHooks  *WasmHooksOptions       `protobuf:"bytes,4,opt,name=wasmHooks,proto3" json:"wasmHooks,omitempty"`
+	GrpcHooks  *GRPCHooksOptions       `protobuf:"bytes,5,opt,name=grpcHooks,proto3" json:"grpcHooks,omitempty"`
+	ServerPool *HooksServerPoolOptions `protobuf:"bytes,6,opt,name=serverPool,proto3" json:"serverPool,omitempty"`
+	// Timeout, retries and failure handling for the hooks matching each
+	// policy, the first matching policy is used
+	HookPolicies []*HookPolicy      `protobuf:"bytes,7,rep,name=hookPolicies,proto3" json:"hookPolicies,omitempty"`
+	AsyncHooks   *AsyncHooksOptions `protobuf:"bytes,8,opt,name=asyncHooks,proto3" json:"asyncHooks,omitempty"`
 }
 
 func (x *ServerOptions) Reset() {
 	*x = ServerOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[65]
+		mi := &file_wundernode_config_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5622,7 +6979,7 @@ func (x *ServerOptions) String() string {
 func (*ServerOptions) ProtoMessage() {}
 
 func (x *ServerOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[65]
+	mi := &file_wundernode_config_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5635,7 +6992,7 @@ func (x *ServerOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServerOptions.ProtoReflect.Descriptor instead.
 func (*ServerOptions) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{65}
+	return file_wundernode_config_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *ServerOptions) GetServerUrl() *ConfigurationVariable {
@@ -5659,36 +7016,80 @@ func (x *ServerOptions) GetLogger() *ServerLogging {
 	return nil
 }
 
-type WebhookConfiguration struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ServerOptions) GetWasmHooks() *WasmHooksOptions {
+	if x != nil {
+		return x.WasmHooks
+	}
+	return nil
+}
 
-	// Name of the webhook.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// The path to the bundled file.
-	// The path is relative to the bundle directory.
-	FilePath string           `protobuf:"bytes,2,opt,name=filePath,proto3" json:"filePath,omitempty"`
-	Verifier *WebhookVerifier `protobuf:"bytes,3,opt,name=verifier,proto3" json:"verifier,omitempty"`
+func (x *ServerOptions) GetGrpcHooks() *GRPCHooksOptions {
+	if x != nil {
+		return x.GrpcHooks
+	}
+	return nil
 }
 
-func (x *WebhookConfiguration) Reset() {
-	*x = WebhookConfiguration{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[66]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ServerOptions) GetServerPool() *HooksServerPoolOptions {
+	if x != nil {
+		return x.ServerPool
 	}
+	return nil
 }
 
-func (x *WebhookConfiguration) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ServerOptions) GetHookPolicies() []*HookPolicy {
+	if x != nil {
+		return x.HookPolicies
+	}
+	return nil
 }
 
-func (*WebhookConfiguration) ProtoMessage() {}
+func (x *ServerOptions) GetAsyncHooks() *AsyncHooksOptions {
+	if x != nil {
+		return x.AsyncHooks
+	}
+	return nil
+}
 
-func (x *WebhookConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[66]
+type AsyncHooksOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Patterns matching the hooks sent in the background without waiting
+	// for their response, e.g. operation/*/postResolve or
+	// authentication/postLogout. Only postResolve, postAuthentication,
+	// postLogout and postUpload hooks can be asynchronous.
+	Hooks []string `protobuf:"bytes,1,rep,name=hooks,proto3" json:"hooks,omitempty"`
+	// Maximum number of queued hooks, hooks are dropped when the queue is
+	// full. Zero means 1000.
+	QueueSize int32 `protobuf:"varint,2,opt,name=queueSize,proto3" json:"queueSize,omitempty"`
+	// Number of hooks sent concurrently. Zero means 4.
+	Workers int32 `protobuf:"varint,3,opt,name=workers,proto3" json:"workers,omitempty"`
+	// Store the queued hooks on disk, so they're sent after a restart
+	Durable bool `protobuf:"varint,4,opt,name=durable,proto3" json:"durable,omitempty"`
+	// Directory used by the durable queue, defaults to generated/hooks-queue
+	// inside the WunderGraph directory
+	Directory *ConfigurationVariable `protobuf:"bytes,5,opt,name=directory,proto3" json:"directory,omitempty"`
+}
+
+func (x *AsyncHooksOptions) Reset() {
+	*x = AsyncHooksOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wundernode_config_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AsyncHooksOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AsyncHooksOptions) ProtoMessage() {}
+
+func (x *AsyncHooksOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_wundernode_config_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5699,60 +7100,82 @@ func (x *WebhookConfiguration) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebhookConfiguration.ProtoReflect.Descriptor instead.
-func (*WebhookConfiguration) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{66}
+// Deprecated: Use AsyncHooksOptions.ProtoReflect.Descriptor instead.
+func (*AsyncHooksOptions) Descriptor() ([]byte, []int) {
+	return file_wundernode_config_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *WebhookConfiguration) GetName() string {
+func (x *AsyncHooksOptions) GetHooks() []string {
 	if x != nil {
-		return x.Name
+		return x.Hooks
 	}
-	return ""
+	return nil
 }
 
-func (x *WebhookConfiguration) GetFilePath() string {
+func (x *AsyncHooksOptions) GetQueueSize() int32 {
 	if x != nil {
-		return x.FilePath
+		return x.QueueSize
 	}
-	return ""
+	return 0
 }
 
-func (x *WebhookConfiguration) GetVerifier() *WebhookVerifier {
+func (x *AsyncHooksOptions) GetWorkers() int32 {
 	if x != nil {
-		return x.Verifier
+		return x.Workers
+	}
+	return 0
+}
+
+func (x *AsyncHooksOptions) GetDurable() bool {
+	if x != nil {
+		return x.Durable
+	}
+	return false
+}
+
+func (x *AsyncHooksOptions) GetDirectory() *ConfigurationVariable {
+	if x != nil {
+		return x.Directory
 	}
 	return nil
 }
 
-type WebhookVerifier struct {
+type HookPolicy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Kind                  WebhookVerifierKind    `protobuf:"varint,1,opt,name=kind,proto3,enum=wgpb.WebhookVerifierKind" json:"kind,omitempty"`
-	Secret                *ConfigurationVariable `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
-	SignatureHeader       string                 `protobuf:"bytes,3,opt,name=signatureHeader,proto3" json:"signatureHeader,omitempty"`
-	SignatureHeaderPrefix string                 `protobuf:"bytes,4,opt,name=signatureHeaderPrefix,proto3" json:"signatureHeaderPrefix,omitempty"`
-}
-
-func (x *WebhookVerifier) Reset() {
-	*x = WebhookVerifier{}
+	// Pattern matching the hook path, e.g. operation/*/postResolve or
+	// operation/Todos/**, with the same syntax as HooksServerRoute
+	Hook string `protobuf:"bytes,1,opt,name=hook,proto3" json:"hook,omitempty"`
+	// Timeout for the hook including its retries in milliseconds. Zero
+	// means no timeout besides the 60s limit for each attempt.
+	TimeoutMs int64 `protobuf:"varint,2,opt,name=timeoutMs,proto3" json:"timeoutMs,omitempty"`
+	// Retries after a failed attempt. Not set means 40.
+	MaxRetries  *int32          `protobuf:"varint,3,opt,name=maxRetries,proto3,oneof" json:"maxRetries,omitempty"`
+	FailureMode HookFailureMode `protobuf:"varint,4,opt,name=failureMode,proto3,enum=wgpb.HookFailureMode" json:"failureMode,omitempty"`
+	// JSON response of the hook used with HookFailFallback, e.g.
+	// {"response":{"data":null}}
+	FallbackResponse string `protobuf:"bytes,5,opt,name=fallbackResponse,proto3" json:"fallbackResponse,omitempty"`
+}
+
+func (x *HookPolicy) Reset() {
+	*x = HookPolicy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[67]
+		mi := &file_wundernode_config_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WebhookVerifier) String() string {
+func (x *HookPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebhookVerifier) ProtoMessage() {}
+func (*HookPolicy) ProtoMessage() {}
 
-func (x *WebhookVerifier) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[67]
+func (x *HookPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_wundernode_config_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5763,87 +7186,83 @@ func (x *WebhookVerifier) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebhookVerifier.ProtoReflect.Descriptor instead.
-func (*WebhookVerifier) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use HookPolicy.ProtoReflect.Descriptor instead.
+func (*HookPolicy) Descriptor() ([]byte, []int) {
+	return file_wundernode_config_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *WebhookVerifier) GetKind() WebhookVerifierKind {
+func (x *HookPolicy) GetHook() string {
 	if x != nil {
-		return x.Kind
+		return x.Hook
 	}
-	return WebhookVerifierKind_HMAC_SHA256
+	return ""
 }
 
-func (x *WebhookVerifier) GetSecret() *ConfigurationVariable {
+func (x *HookPolicy) GetTimeoutMs() int64 {
 	if x != nil {
-		return x.Secret
+		return x.TimeoutMs
 	}
-	return nil
+	return 0
 }
 
-func (x *WebhookVerifier) GetSignatureHeader() string {
+func (x *HookPolicy) GetMaxRetries() int32 {
+	if x != nil && x.MaxRetries != nil {
+		return *x.MaxRetries
+	}
+	return 0
+}
+
+func (x *HookPolicy) GetFailureMode() HookFailureMode {
 	if x != nil {
-		return x.SignatureHeader
+		return x.FailureMode
 	}
-	return ""
+	return HookFailureMode_HookFailClosed
 }
 
-func (x *WebhookVerifier) GetSignatureHeaderPrefix() string {
+func (x *HookPolicy) GetFallbackResponse() string {
 	if x != nil {
-		return x.SignatureHeaderPrefix
+		return x.FallbackResponse
 	}
 	return ""
 }
 
-type CorsConfiguration struct {
+type HooksServerPoolOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// AllowedOrigins is a list of origins a cross-domain request can be executed from.
-	// If the special "*" value is present in the list, all origins will be allowed.
-	// An origin may contain a wildcard (*) to replace 0 or more characters
-	// (i.e.: http://*.domain.com). Usage of wildcards implies a small performance penalty.
-	// Only one wildcard can be used per origin.
-	// Default value is ["*"]
-	AllowedOrigins []*ConfigurationVariable `protobuf:"bytes,1,rep,name=allowedOrigins,proto3" json:"allowedOrigins,omitempty"`
-	// AllowedMethods is a list of methods the client is allowed to use with
-	// cross-domain requests. Default value is simple methods (HEAD, GET and POST).
-	AllowedMethods []string `protobuf:"bytes,2,rep,name=allowedMethods,proto3" json:"allowedMethods,omitempty"`
-	// AllowedHeaders is list of non simple headers the client is allowed to use with
-	// cross-domain requests.
-	// If the special "*" value is present in the list, all headers will be allowed.
-	// Default value is [] but "Origin" is always appended to the list.
-	AllowedHeaders []string `protobuf:"bytes,3,rep,name=allowedHeaders,proto3" json:"allowedHeaders,omitempty"`
-	// ExposedHeaders indicates which headers are safe to expose to the API of a CORS
-	// API specification
-	ExposedHeaders []string `protobuf:"bytes,4,rep,name=exposedHeaders,proto3" json:"exposedHeaders,omitempty"`
-	// MaxAge indicates how long (in seconds) the results of a preflight request
-	// can be cached
-	MaxAge int64 `protobuf:"varint,5,opt,name=maxAge,proto3" json:"maxAge,omitempty"`
-	// AllowCredentials indicates whether the request can include user credentials like
-	// cookies, HTTP authentication or client side SSL certificates.
-	AllowCredentials bool `protobuf:"varint,6,opt,name=allowCredentials,proto3" json:"allowCredentials,omitempty"`
-}
-
-func (x *CorsConfiguration) Reset() {
-	*x = CorsConfiguration{}
+	// Additional hooks servers, hooks are load balanced between serverUrl
+	// and these ones
+	ServerUrls    []*ConfigurationVariable `protobuf:"bytes,1,rep,name=serverUrls,proto3" json:"serverUrls,omitempty"`
+	LoadBalancing HooksLoadBalancing       `protobuf:"varint,2,opt,name=loadBalancing,proto3,enum=wgpb.HooksLoadBalancing" json:"loadBalancing,omitempty"`
+	// Interval between active health checks in seconds. Zero means 10.
+	HealthCheckIntervalSeconds int64 `protobuf:"varint,3,opt,name=healthCheckIntervalSeconds,proto3" json:"healthCheckIntervalSeconds,omitempty"`
+	// Consecutive failed requests before a server is ejected. Zero means 5.
+	MaxFailures int32 `protobuf:"varint,4,opt,name=maxFailures,proto3" json:"maxFailures,omitempty"`
+	// Time an ejected server stops receiving hooks in seconds. Zero means 30.
+	EjectionSeconds int64 `protobuf:"varint,5,opt,name=ejectionSeconds,proto3" json:"ejectionSeconds,omitempty"`
+	// Hooks matching a route are sent to its servers instead, the first
+	// matching route is used
+	Routes []*HooksServerRoute `protobuf:"bytes,6,rep,name=routes,proto3" json:"routes,omitempty"`
+}
+
+func (x *HooksServerPoolOptions) Reset() {
+	*x = HooksServerPoolOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[68]
+		mi := &file_wundernode_config_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CorsConfiguration) String() string {
+func (x *HooksServerPoolOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CorsConfiguration) ProtoMessage() {}
+func (*HooksServerPoolOptions) ProtoMessage() {}
 
-func (x *CorsConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[68]
+func (x *HooksServerPoolOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_wundernode_config_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5854,82 +7273,82 @@ func (x *CorsConfiguration) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CorsConfiguration.ProtoReflect.Descriptor instead.
-func (*CorsConfiguration) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use HooksServerPoolOptions.ProtoReflect.Descriptor instead.
+func (*HooksServerPoolOptions) Descriptor() ([]byte, []int) {
+	return file_wundernode_config_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *CorsConfiguration) GetAllowedOrigins() []*ConfigurationVariable {
+func (x *HooksServerPoolOptions) GetServerUrls() []*ConfigurationVariable {
 	if x != nil {
-		return x.AllowedOrigins
+		return x.ServerUrls
 	}
 	return nil
 }
 
-func (x *CorsConfiguration) GetAllowedMethods() []string {
+func (x *HooksServerPoolOptions) GetLoadBalancing() HooksLoadBalancing {
 	if x != nil {
-		return x.AllowedMethods
+		return x.LoadBalancing
 	}
-	return nil
+	return HooksLoadBalancing_HooksLoadBalancingRoundRobin
 }
 
-func (x *CorsConfiguration) GetAllowedHeaders() []string {
+func (x *HooksServerPoolOptions) GetHealthCheckIntervalSeconds() int64 {
 	if x != nil {
-		return x.AllowedHeaders
+		return x.HealthCheckIntervalSeconds
 	}
-	return nil
+	return 0
 }
 
-func (x *CorsConfiguration) GetExposedHeaders() []string {
+func (x *HooksServerPoolOptions) GetMaxFailures() int32 {
 	if x != nil {
-		return x.ExposedHeaders
+		return x.MaxFailures
 	}
-	return nil
+	return 0
 }
 
-func (x *CorsConfiguration) GetMaxAge() int64 {
+func (x *HooksServerPoolOptions) GetEjectionSeconds() int64 {
 	if x != nil {
-		return x.MaxAge
+		return x.EjectionSeconds
 	}
 	return 0
 }
 
-func (x *CorsConfiguration) GetAllowCredentials() bool {
+func (x *HooksServerPoolOptions) GetRoutes() []*HooksServerRoute {
 	if x != nil {
-		return x.AllowCredentials
+		return x.Routes
 	}
-	return false
+	return nil
 }
 
-type ConfigurationVariable struct {
+type HooksServerRoute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Kind                            ConfigurationVariableKind `protobuf:"varint,1,opt,name=kind,proto3,enum=wgpb.ConfigurationVariableKind" json:"kind,omitempty"`
-	StaticVariableContent           string                    `protobuf:"bytes,2,opt,name=staticVariableContent,proto3" json:"staticVariableContent,omitempty"`
-	EnvironmentVariableName         string                    `protobuf:"bytes,3,opt,name=environmentVariableName,proto3" json:"environmentVariableName,omitempty"`
-	EnvironmentVariableDefaultValue string                    `protobuf:"bytes,4,opt,name=environmentVariableDefaultValue,proto3" json:"environmentVariableDefaultValue,omitempty"`
-	PlaceholderVariableName         string                    `protobuf:"bytes,5,opt,name=placeholderVariableName,proto3" json:"placeholderVariableName,omitempty"`
+	// Pattern matching the hook path, e.g. operation/*/postResolve. A
+	// pattern ending in /** matches any path with that prefix, e.g.
+	// functions/**
+	Hook       string                   `protobuf:"bytes,1,opt,name=hook,proto3" json:"hook,omitempty"`
+	ServerUrls []*ConfigurationVariable `protobuf:"bytes,2,rep,name=serverUrls,proto3" json:"serverUrls,omitempty"`
 }
 
-func (x *ConfigurationVariable) Reset() {
-	*x = ConfigurationVariable{}
+func (x *HooksServerRoute) Reset() {
+	*x = HooksServerRoute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[69]
+		mi := &file_wundernode_config_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ConfigurationVariable) String() string {
+func (x *HooksServerRoute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfigurationVariable) ProtoMessage() {}
+func (*HooksServerRoute) ProtoMessage() {}
 
-func (x *ConfigurationVariable) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[69]
+func (x *HooksServerRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_wundernode_config_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5940,76 +7359,117 @@ func (x *ConfigurationVariable) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfigurationVariable.ProtoReflect.Descriptor instead.
-func (*ConfigurationVariable) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use HooksServerRoute.ProtoReflect.Descriptor instead.
+func (*HooksServerRoute) Descriptor() ([]byte, []int) {
+	return file_wundernode_config_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *ConfigurationVariable) GetKind() ConfigurationVariableKind {
+func (x *HooksServerRoute) GetHook() string {
 	if x != nil {
-		return x.Kind
+		return x.Hook
 	}
-	return ConfigurationVariableKind_STATIC_CONFIGURATION_VARIABLE
+	return ""
 }
 
-func (x *ConfigurationVariable) GetStaticVariableContent() string {
+func (x *HooksServerRoute) GetServerUrls() []*ConfigurationVariable {
 	if x != nil {
-		return x.StaticVariableContent
+		return x.ServerUrls
 	}
-	return ""
+	return nil
 }
 
-func (x *ConfigurationVariable) GetEnvironmentVariableName() string {
-	if x != nil {
-		return x.EnvironmentVariableName
+type GRPCHooksOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Address of the hooks server implementing the hookspb.Hooks service,
+	// e.g. localhost:9993. When set, hooks are sent using gRPC instead of
+	// HTTP.
+	Address *ConfigurationVariable `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Use TLS for the connection
+	Tls bool `protobuf:"varint,2,opt,name=tls,proto3" json:"tls,omitempty"`
+}
+
+func (x *GRPCHooksOptions) Reset() {
+	*x = GRPCHooksOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wundernode_config_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *ConfigurationVariable) GetEnvironmentVariableDefaultValue() string {
+func (x *GRPCHooksOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GRPCHooksOptions) ProtoMessage() {}
+
+func (x *GRPCHooksOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_wundernode_config_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GRPCHooksOptions.ProtoReflect.Descriptor instead.
+func (*GRPCHooksOptions) Descriptor() ([]byte, []int) {
+	return file_wundernode_config_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GRPCHooksOptions) GetAddress() *ConfigurationVariable {
 	if x != nil {
-		return x.EnvironmentVariableDefaultValue
+		return x.Address
 	}
-	return ""
+	return nil
 }
 
-func (x *ConfigurationVariable) GetPlaceholderVariableName() string {
+func (x *GRPCHooksOptions) GetTls() bool {
 	if x != nil {
-		return x.PlaceholderVariableName
+		return x.Tls
 	}
-	return ""
+	return false
 }
 
-type BuildInfo struct {
+type WasmHooksOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success   bool              `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Sdk       *BuildInfoVersion `protobuf:"bytes,2,opt,name=sdk,proto3" json:"sdk,omitempty"`
-	Wunderctl *BuildInfoVersion `protobuf:"bytes,3,opt,name=wunderctl,proto3" json:"wunderctl,omitempty"`
-	Node      *BuildInfoVersion `protobuf:"bytes,4,opt,name=node,proto3" json:"node,omitempty"`
-	Os        *BuildInfoOS      `protobuf:"bytes,5,opt,name=os,proto3" json:"os,omitempty"`
-	Stats     *BuildInfoStats   `protobuf:"bytes,6,opt,name=stats,proto3" json:"stats,omitempty"`
+	// Path to the WebAssembly module implementing the hooks, relative to
+	// the WunderGraph directory. Hooks not implemented by the module are
+	// still sent to the hooks server.
+	ModulePath *ConfigurationVariable `protobuf:"bytes,1,opt,name=modulePath,proto3" json:"modulePath,omitempty"`
+	// Memory limit for each hook execution in megabytes. Zero means 16.
+	MaxMemoryMb int32 `protobuf:"varint,2,opt,name=maxMemoryMb,proto3" json:"maxMemoryMb,omitempty"`
+	// Time limit for each hook execution in milliseconds. Zero means 1000.
+	TimeoutMs  int64             `protobuf:"varint,3,opt,name=timeoutMs,proto3" json:"timeoutMs,omitempty"`
+	HookLimits []*WasmHookLimits `protobuf:"bytes,4,rep,name=hookLimits,proto3" json:"hookLimits,omitempty"`
 }
 
-func (x *BuildInfo) Reset() {
-	*x = BuildInfo{}
+func (x *WasmHooksOptions) Reset() {
+	*x = WasmHooksOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[70]
+		mi := &file_wundernode_config_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BuildInfo) String() string {
+func (x *WasmHooksOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BuildInfo) ProtoMessage() {}
+func (*WasmHooksOptions) ProtoMessage() {}
 
-func (x *BuildInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[70]
+func (x *WasmHooksOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_wundernode_config_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6020,78 +7480,67 @@ func (x *BuildInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BuildInfo.ProtoReflect.Descriptor instead.
-func (*BuildInfo) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{70}
-}
-
-func (x *BuildInfo) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *BuildInfo) GetSdk() *BuildInfoVersion {
-	if x != nil {
-		return x.Sdk
-	}
-	return nil
+// Deprecated: Use WasmHooksOptions.ProtoReflect.Descriptor instead.
+func (*WasmHooksOptions) Descriptor() ([]byte, []int) {
+	return file_wundernode_config_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *BuildInfo) GetWunderctl() *BuildInfoVersion {
+func (x *WasmHooksOptions) GetModulePath() *ConfigurationVariable {
 	if x != nil {
-		return x.Wunderctl
+		return x.ModulePath
 	}
 	return nil
 }
 
-func (x *BuildInfo) GetNode() *BuildInfoVersion {
+func (x *WasmHooksOptions) GetMaxMemoryMb() int32 {
 	if x != nil {
-		return x.Node
+		return x.MaxMemoryMb
 	}
-	return nil
+	return 0
 }
 
-func (x *BuildInfo) GetOs() *BuildInfoOS {
+func (x *WasmHooksOptions) GetTimeoutMs() int64 {
 	if x != nil {
-		return x.Os
+		return x.TimeoutMs
 	}
-	return nil
+	return 0
 }
 
-func (x *BuildInfo) GetStats() *BuildInfoStats {
+func (x *WasmHooksOptions) GetHookLimits() []*WasmHookLimits {
 	if x != nil {
-		return x.Stats
+		return x.HookLimits
 	}
 	return nil
 }
 
-type BuildInfoVersion struct {
+type WasmHookLimits struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Path of the hook, e.g. operation/Foo/preResolve
+	Hook        string `protobuf:"bytes,1,opt,name=hook,proto3" json:"hook,omitempty"`
+	MaxMemoryMb int32  `protobuf:"varint,2,opt,name=maxMemoryMb,proto3" json:"maxMemoryMb,omitempty"`
+	TimeoutMs   int64  `protobuf:"varint,3,opt,name=timeoutMs,proto3" json:"timeoutMs,omitempty"`
 }
 
-func (x *BuildInfoVersion) Reset() {
-	*x = BuildInfoVersion{}
+func (x *WasmHookLimits) Reset() {
+	*x = WasmHookLimits{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[71]
+		mi := &file_wundernode_config_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BuildInfoVersion) String() string {
+func (x *WasmHookLimits) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BuildInfoVersion) ProtoMessage() {}
+func (*WasmHookLimits) ProtoMessage() {}
 
-func (x *BuildInfoVersion) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[71]
+func (x *WasmHookLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_wundernode_config_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6102,47 +7551,71 @@ func (x *BuildInfoVersion) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BuildInfoVersion.ProtoReflect.Descriptor instead.
-func (*BuildInfoVersion) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use WasmHookLimits.ProtoReflect.Descriptor instead.
+func (*WasmHookLimits) Descriptor() ([]byte, []int) {
+	return file_wundernode_config_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *BuildInfoVersion) GetVersion() string {
+func (x *WasmHookLimits) GetHook() string {
 	if x != nil {
-		return x.Version
+		return x.Hook
 	}
 	return ""
 }
 
-type BuildInfoOS struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *WasmHookLimits) GetMaxMemoryMb() int32 {
+	if x != nil {
+		return x.MaxMemoryMb
+	}
+	return 0
+}
 
-	Type     string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Platform string `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`
-	Arch     string `protobuf:"bytes,3,opt,name=arch,proto3" json:"arch,omitempty"`
-	Version  string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
-	Release  string `protobuf:"bytes,5,opt,name=release,proto3" json:"release,omitempty"`
+func (x *WasmHookLimits) GetTimeoutMs() int64 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
 }
 
-func (x *BuildInfoOS) Reset() {
-	*x = BuildInfoOS{}
+type WebhookConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the webhook.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The path to the bundled file.
+	// The path is relative to the bundle directory.
+	FilePath string           `protobuf:"bytes,2,opt,name=filePath,proto3" json:"filePath,omitempty"`
+	Verifier *WebhookVerifier `protobuf:"bytes,3,opt,name=verifier,proto3" json:"verifier,omitempty"`
+	// Acknowledge verified POST requests immediately and deliver them to
+	// the hooks server from a durable queue, retrying failed deliveries
+	Queued bool `protobuf:"varint,4,opt,name=queued,proto3" json:"queued,omitempty"`
+	// Maximum number of delivery attempts before moving a queued webhook
+	// to the dead letters. Zero means 10.
+	MaxDeliveryAttempts int32 `protobuf:"varint,5,opt,name=maxDeliveryAttempts,proto3" json:"maxDeliveryAttempts,omitempty"`
+	// Publish the verified payloads to the subscriptions using the
+	// WEBHOOK_EVENTS data source, besides forwarding them to the hooks server
+	PublishEvents bool `protobuf:"varint,6,opt,name=publishEvents,proto3" json:"publishEvents,omitempty"`
+}
+
+func (x *WebhookConfiguration) Reset() {
+	*x = WebhookConfiguration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[72]
+		mi := &file_wundernode_config_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BuildInfoOS) String() string {
+func (x *WebhookConfiguration) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BuildInfoOS) ProtoMessage() {}
+func (*WebhookConfiguration) ProtoMessage() {}
 
-func (x *BuildInfoOS) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[72]
+func (x *WebhookConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_wundernode_config_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6153,75 +7626,195 @@ func (x *BuildInfoOS) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BuildInfoOS.ProtoReflect.Descriptor instead.
-func (*BuildInfoOS) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use WebhookConfiguration.ProtoReflect.Descriptor instead.
+func (*WebhookConfiguration) Descriptor() ([]byte, []int) {
+	return file_wundernode_config_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *BuildInfoOS) GetType() string {
+func (x *WebhookConfiguration) GetName() string {
 	if x != nil {
-		return x.Type
+		return x.Name
 	}
 	return ""
 }
 
-func (x *BuildInfoOS) GetPlatform() string {
+func (x *WebhookConfiguration) GetFilePath() string {
 	if x != nil {
-		return x.Platform
+		return x.FilePath
 	}
 	return ""
 }
 
-func (x *BuildInfoOS) GetArch() string {
+func (x *WebhookConfiguration) GetVerifier() *WebhookVerifier {
 	if x != nil {
-		return x.Arch
+		return x.Verifier
 	}
-	return ""
+	return nil
 }
 
-func (x *BuildInfoOS) GetVersion() string {
+func (x *WebhookConfiguration) GetQueued() bool {
 	if x != nil {
-		return x.Version
+		return x.Queued
+	}
+	return false
+}
+
+func (x *WebhookConfiguration) GetMaxDeliveryAttempts() int32 {
+	if x != nil {
+		return x.MaxDeliveryAttempts
+	}
+	return 0
+}
+
+func (x *WebhookConfiguration) GetPublishEvents() bool {
+	if x != nil {
+		return x.PublishEvents
+	}
+	return false
+}
+
+// EventWebhookConfiguration notifies an external endpoint after
+// mutations complete successfully
+type EventWebhookConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the webhook, used in the delivery status
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// URL receiving the events as POST requests
+	Url *ConfigurationVariable `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Secret used to sign the events with the Standard Webhooks scheme.
+	// Secrets with the whsec_ prefix are base64 encoded.
+	Secret *ConfigurationVariable `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Names of the mutations triggering the webhook, empty means all of them
+	Operations []string `protobuf:"bytes,4,rep,name=operations,proto3" json:"operations,omitempty"`
+	// Optional JSON path (gjson syntax) evaluated against the response.
+	// Events are only sent if it matches a value other than null or false.
+	ResponseFilter string `protobuf:"bytes,5,opt,name=responseFilter,proto3" json:"responseFilter,omitempty"`
+	// Maximum number of delivery attempts. Zero means 10.
+	MaxDeliveryAttempts int32 `protobuf:"varint,6,opt,name=maxDeliveryAttempts,proto3" json:"maxDeliveryAttempts,omitempty"`
+}
+
+func (x *EventWebhookConfiguration) Reset() {
+	*x = EventWebhookConfiguration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wundernode_config_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventWebhookConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventWebhookConfiguration) ProtoMessage() {}
+
+func (x *EventWebhookConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_wundernode_config_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventWebhookConfiguration.ProtoReflect.Descriptor instead.
+func (*EventWebhookConfiguration) Descriptor() ([]byte, []int) {
+	return file_wundernode_config_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *EventWebhookConfiguration) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *BuildInfoOS) GetRelease() string {
+func (x *EventWebhookConfiguration) GetUrl() *ConfigurationVariable {
 	if x != nil {
-		return x.Release
+		return x.Url
+	}
+	return nil
+}
+
+func (x *EventWebhookConfiguration) GetSecret() *ConfigurationVariable {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *EventWebhookConfiguration) GetOperations() []string {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+func (x *EventWebhookConfiguration) GetResponseFilter() string {
+	if x != nil {
+		return x.ResponseFilter
 	}
 	return ""
 }
 
-type BuildInfoStats struct {
+func (x *EventWebhookConfiguration) GetMaxDeliveryAttempts() int32 {
+	if x != nil {
+		return x.MaxDeliveryAttempts
+	}
+	return 0
+}
+
+type WebhookVerifier struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TotalApis                 int32 `protobuf:"varint,1,opt,name=totalApis,proto3" json:"totalApis,omitempty"`
-	TotalOperations           int32 `protobuf:"varint,2,opt,name=totalOperations,proto3" json:"totalOperations,omitempty"`
-	TotalWebhooks             int32 `protobuf:"varint,3,opt,name=totalWebhooks,proto3" json:"totalWebhooks,omitempty"`
-	HasAuthenticationProvider bool  `protobuf:"varint,4,opt,name=hasAuthenticationProvider,proto3" json:"hasAuthenticationProvider,omitempty"`
-	HasUploadProvider         bool  `protobuf:"varint,5,opt,name=hasUploadProvider,proto3" json:"hasUploadProvider,omitempty"`
+	Kind WebhookVerifierKind `protobuf:"varint,1,opt,name=kind,proto3,enum=wgpb.WebhookVerifierKind" json:"kind,omitempty"`
+	// Secret used to verify the signatures. For ED25519 and RSA_SHA256
+	// it contains the public key, either PEM or hex/base64 encoded.
+	Secret                *ConfigurationVariable `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	SignatureHeader       string                 `protobuf:"bytes,3,opt,name=signatureHeader,proto3" json:"signatureHeader,omitempty"`
+	SignatureHeaderPrefix string                 `protobuf:"bytes,4,opt,name=signatureHeaderPrefix,proto3" json:"signatureHeaderPrefix,omitempty"`
+	// Secrets also accepted while rotating them
+	AdditionalSecrets []*ConfigurationVariable `protobuf:"bytes,5,rep,name=additionalSecrets,proto3" json:"additionalSecrets,omitempty"`
+	// Header with the unix timestamp included in the signature. Only used by
+	// ED25519 and RSA_SHA256, since the other kinds define their own header.
+	TimestampHeader string `protobuf:"bytes,6,opt,name=timestampHeader,proto3" json:"timestampHeader,omitempty"`
+	// Maximum difference between the signature timestamp and the current time
+	// in seconds. Zero means 300 seconds, negative disables checking it.
+	ToleranceSeconds int64 `protobuf:"varint,7,opt,name=toleranceSeconds,proto3" json:"toleranceSeconds,omitempty"`
+	// Header with a unique ID for each message, used for detecting replays
+	// instead of the signature. Unless the kind signs it (e.g. SVIX), anyone
+	// replaying a message can change it.
+	IdHeader string `protobuf:"bytes,8,opt,name=idHeader,proto3" json:"idHeader,omitempty"`
+	// Number of recently verified messages remembered to reject replays,
+	// zero disables replay detection
+	ReplayCacheSize int32 `protobuf:"varint,9,opt,name=replayCacheSize,proto3" json:"replayCacheSize,omitempty"`
 }
 
-func (x *BuildInfoStats) Reset() {
-	*x = BuildInfoStats{}
+func (x *WebhookVerifier) Reset() {
+	*x = WebhookVerifier{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wundernode_config_proto_msgTypes[73]
+		mi := &file_wundernode_config_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BuildInfoStats) String() string {
+func (x *WebhookVerifier) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BuildInfoStats) ProtoMessage() {}
+func (*WebhookVerifier) ProtoMessage() {}
 
-func (x *BuildInfoStats) ProtoReflect() protoreflect.Message {
-	mi := &file_wundernode_config_proto_msgTypes[73]
+func (x *WebhookVerifier) ProtoReflect() protoreflect.Message {
+	mi := &file_wundernode_config_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6232,1242 +7825,2170 @@ func (x *BuildInfoStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BuildInfoStats.ProtoReflect.Descriptor instead.
-func (*BuildInfoStats) Descriptor() ([]byte, []int) {
-	return file_wundernode_config_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use WebhookVerifier.ProtoReflect.Descriptor instead.
+func (*WebhookVerifier) Descriptor() ([]byte, []int) {
+	return file_wundernode_config_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *BuildInfoStats) GetTotalApis() int32 {
+func (x *WebhookVerifier) GetKind() WebhookVerifierKind {
 	if x != nil {
-		return x.TotalApis
+		return x.Kind
 	}
-	return 0
+	return WebhookVerifierKind_HMAC_SHA256
 }
 
-func (x *BuildInfoStats) GetTotalOperations() int32 {
+func (x *WebhookVerifier) GetSecret() *ConfigurationVariable {
 	if x != nil {
-		return x.TotalOperations
+		return x.Secret
 	}
-	return 0
+	return nil
 }
 
-func (x *BuildInfoStats) GetTotalWebhooks() int32 {
+func (x *WebhookVerifier) GetSignatureHeader() string {
 	if x != nil {
-		return x.TotalWebhooks
+		return x.SignatureHeader
+	}
+	return ""
+}
+
+func (x *WebhookVerifier) GetSignatureHeaderPrefix() string {
+	if x != nil {
+		return x.SignatureHeaderPrefix
+	}
+	return ""
+}
+
+func (x *WebhookVerifier) GetAdditionalSecrets() []*ConfigurationVariable {
+	if x != nil {
+		return x.AdditionalSecrets
+	}
+	return nil
+}
+
+func (x *WebhookVerifier) GetTimestampHeader() string {
+	if x != nil {
+		return x.TimestampHeader
+	}
+	return ""
+}
+
+func (x *WebhookVerifier) GetToleranceSeconds() int64 {
+	if x != nil {
+		return x.ToleranceSeconds
 	}
 	return 0
 }
 
-func (x *BuildInfoStats) GetHasAuthenticationProvider() bool {
+func (x *WebhookVerifier) GetIdHeader() string {
 	if x != nil {
-		return x.HasAuthenticationProvider
+		return x.IdHeader
 	}
-	return false
+	return ""
 }
 
-func (x *BuildInfoStats) GetHasUploadProvider() bool {
+func (x *WebhookVerifier) GetReplayCacheSize() int32 {
 	if x != nil {
-		return x.HasUploadProvider
+		return x.ReplayCacheSize
 	}
-	return false
+	return 0
 }
 
-var File_wundernode_config_proto protoreflect.FileDescriptor
+type CorsConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_wundernode_config_proto_rawDesc = []byte{
-	0x0a, 0x17, 0x77, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x77, 0x67, 0x70, 0x62, 0x22,
-	0xf1, 0x01, 0x0a, 0x17, 0x41, 0x70, 0x69, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x41, 0x0a, 0x0b, 0x63,
-	0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x42, 0x61, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1f, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x42, 0x61,
-	0x73, 0x65, 0x64, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x42, 0x61, 0x73, 0x65, 0x64, 0x12, 0x32,
-	0x0a, 0x05, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x77, 0x67, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x69, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x05, 0x68, 0x6f, 0x6f,
-	0x6b, 0x73, 0x12, 0x3b, 0x0a, 0x09, 0x6a, 0x77, 0x6b, 0x73, 0x42, 0x61, 0x73, 0x65, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x4a, 0x77, 0x6b,
-	0x73, 0x42, 0x61, 0x73, 0x65, 0x64, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6a, 0x77, 0x6b, 0x73, 0x42, 0x61, 0x73, 0x65, 0x64, 0x12,
-	0x22, 0x0a, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x43, 0x6c, 0x61,
-	0x69, 0x6d, 0x73, 0x22, 0x4f, 0x0a, 0x17, 0x4a, 0x77, 0x6b, 0x73, 0x42, 0x61, 0x73, 0x65, 0x64,
-	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34,
-	0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x4a, 0x77, 0x6b, 0x73, 0x41, 0x75, 0x74,
-	0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x73, 0x22, 0x85, 0x02, 0x0a, 0x10, 0x4a, 0x77, 0x6b, 0x73, 0x41, 0x75, 0x74,
-	0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x07, 0x6a, 0x77, 0x6b,
-	0x73, 0x55, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70,
-	0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56,
-	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x07, 0x6a, 0x77, 0x6b, 0x73, 0x55, 0x72, 0x6c,
-	0x12, 0x37, 0x0a, 0x08, 0x6a, 0x77, 0x6b, 0x73, 0x4a, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52,
-	0x08, 0x6a, 0x77, 0x6b, 0x73, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x10, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
-	0x52, 0x10, 0x75, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x12, 0x38, 0x0a, 0x17, 0x75, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x43, 0x61,
-	0x63, 0x68, 0x65, 0x54, 0x74, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x17, 0x75, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x43, 0x61, 0x63,
-	0x68, 0x65, 0x54, 0x74, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0xe4, 0x01, 0x0a,
-	0x16, 0x41, 0x70, 0x69, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x70, 0x6f, 0x73, 0x74, 0x41,
-	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x12, 0x70, 0x6f, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x1a, 0x6d, 0x75, 0x74, 0x61, 0x74,
-	0x69, 0x6e, 0x67, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1a, 0x6d, 0x75, 0x74,
-	0x61, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x18, 0x72, 0x65, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x72, 0x65, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6f, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x6f, 0x75,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x6f, 0x73, 0x74, 0x4c, 0x6f, 0x67,
-	0x6f, 0x75, 0x74, 0x22, 0xf5, 0x03, 0x0a, 0x19, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x42, 0x61,
-	0x73, 0x65, 0x64, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x30, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x41, 0x75, 0x74, 0x68,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x73, 0x12, 0x53, 0x0a, 0x16, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65,
-	0x64, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x69, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
-	0x52, 0x16, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x52, 0x65, 0x64, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x69, 0x73, 0x12, 0x5f, 0x0a, 0x1c, 0x61, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72,
-	0x69, 0x52, 0x65, 0x67, 0x65, 0x78, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x1c, 0x61, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55,
-	0x72, 0x69, 0x52, 0x65, 0x67, 0x65, 0x78, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x68, 0x61, 0x73,
-	0x68, 0x4b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70,
-	0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56,
-	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x07, 0x68, 0x61, 0x73, 0x68, 0x4b, 0x65, 0x79,
-	0x12, 0x37, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52,
-	0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x0a, 0x63, 0x73, 0x72,
-	0x66, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x0a, 0x63, 0x73, 0x72, 0x66,
-	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x43, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
-	0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x0e, 0x74, 0x69, 0x6d,
-	0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0xd5, 0x01, 0x0a, 0x0c,
-	0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x04,
-	0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x77, 0x67, 0x70,
-	0x62, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4b, 0x69,
-	0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x42, 0x0a, 0x0c, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
-	0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x41, 0x75, 0x74, 0x68,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0c,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x45, 0x0a, 0x0a,
-	0x6f, 0x69, 0x64, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x25, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x44, 0x43, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x6f, 0x69, 0x64, 0x63, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x22, 0x94, 0x01, 0x0a, 0x18, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x41, 0x75,
-	0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x37, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52,
-	0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x0c, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x0c, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x81, 0x01, 0x0a, 0x1b, 0x4f,
-	0x70, 0x65, 0x6e, 0x49, 0x44, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72,
-	0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70,
-	0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56,
-	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x9d,
-	0x02, 0x0a, 0x1f, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x44, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x33, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52,
-	0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62,
-	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61,
-	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64,
-	0x12, 0x3f, 0x0a, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61,
-	0x62, 0x6c, 0x65, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65,
-	0x74, 0x12, 0x4b, 0x0a, 0x0f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65,
-	0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x77, 0x67, 0x70,
-	0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x44, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x52, 0x0f, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0xd1,
-	0x07, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0d, 0x6f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x13, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0d, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
-	0x65, 0x73, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
-	0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12,
-	0x26, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x41, 0x0a, 0x0b, 0x63, 0x61, 0x63, 0x68, 0x65,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77,
-	0x67, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x63,
-	0x68, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x61, 0x63, 0x68,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x88, 0x01, 0x01, 0x12, 0x57, 0x0a, 0x14, 0x61, 0x75,
-	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e,
-	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x14, 0x61,
-	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x48, 0x0a, 0x0f, 0x6c, 0x69, 0x76, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77,
-	0x67, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x76,
-	0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0f, 0x6c, 0x69,
-	0x76, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x54, 0x0a,
-	0x13, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x77, 0x67, 0x70,
-	0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x13,
-	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x51, 0x0a, 0x12, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x21, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x12, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5d, 0x0a, 0x16, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62,
-	0x6c, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x76,
+	// AllowedOrigins is a list of origins a cross-domain request can be executed from.
+	// If the special "*" value is present in the list, all origins will be allowed.
+	// An origin may contain a wildcard (*) to replace 0 or more characters
+	// (i.e.: http://*.domain.com). Usage of wildcards implies a small performance penalty.
+	// Only one wildcard can be used per origin.
+	// Default value is ["*"]
+	AllowedOrigins []*ConfigurationVariable `protobuf:"bytes,1,rep,name=allowedOrigins,proto3" json:"allowedOrigins,omitempty"`
+	// AllowedMethods is a list of methods the client is allowed to use with
+	// cross-domain requests. Default value is simple methods (HEAD, GET and POST).
+	AllowedMethods []string `protobuf:"bytes,2,rep,name=allowedMethods,proto3" json:"allowedMethods,omitempty"`
+	// AllowedHeaders is list of non simple headers the client is allowed to use with
+	// cross-domain requests.
+	// If the special "*" value is present in the list, all headers will be allowed.
+	// Default value is [] but "Origin" is always appended to the list.
+	AllowedHeaders []string `protobuf:"bytes,3,rep,name=allowedHeaders,proto3" json:"allowedHeaders,omitempty"`
+	// ExposedHeaders indicates which headers are safe to expose to the API of a CORS
+	// API specification
+	ExposedHeaders []string `protobuf:"bytes,4,rep,name=exposedHeaders,proto3" json:"exposedHeaders,omitempty"`
+	// MaxAge indicates how long (in seconds) the results of a preflight request
+	// can be cached
+	MaxAge int64 `protobuf:"varint,5,opt,name=maxAge,proto3" json:"maxAge,omitempty"`
+	// AllowCredentials indicates whether the request can include user credentials like
+	// cookies, HTTP authentication or client side SSL certificates.
+	AllowCredentials bool `protobuf:"varint,6,opt,name=allowCredentials,proto3" json:"allowCredentials,omitempty"`
+}
+
+func (x *CorsConfiguration) Reset() {
+	*x = CorsConfiguration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wundernode_config_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CorsConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CorsConfiguration) ProtoMessage() {}
+
+func (x *CorsConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_wundernode_config_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CorsConfiguration.ProtoReflect.Descriptor instead.
+func (*CorsConfiguration) Descriptor() ([]byte, []int) {
+	return file_wundernode_config_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *CorsConfiguration) GetAllowedOrigins() []*ConfigurationVariable {
+	if x != nil {
+		return x.AllowedOrigins
+	}
+	return nil
+}
+
+func (x *CorsConfiguration) GetAllowedMethods() []string {
+	if x != nil {
+		return x.AllowedMethods
+	}
+	return nil
+}
+
+func (x *CorsConfiguration) GetAllowedHeaders() []string {
+	if x != nil {
+		return x.AllowedHeaders
+	}
+	return nil
+}
+
+func (x *CorsConfiguration) GetExposedHeaders() []string {
+	if x != nil {
+		return x.ExposedHeaders
+	}
+	return nil
+}
+
+func (x *CorsConfiguration) GetMaxAge() int64 {
+	if x != nil {
+		return x.MaxAge
+	}
+	return 0
+}
+
+func (x *CorsConfiguration) GetAllowCredentials() bool {
+	if x != nil {
+		return x.AllowCredentials
+	}
+	return false
+}
+
+type ConfigurationVariable struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind                            ConfigurationVariableKind `protobuf:"varint,1,opt,name=kind,proto3,enum=wgpb.ConfigurationVariableKind" json:"kind,omitempty"`
+	StaticVariableContent           string                    `protobuf:"bytes,2,opt,name=staticVariableContent,proto3" json:"staticVariableContent,omitempty"`
+	EnvironmentVariableName         string                    `protobuf:"bytes,3,opt,name=environmentVariableName,proto3" json:"environmentVariableName,omitempty"`
+	EnvironmentVariableDefaultValue string                    `protobuf:"bytes,4,opt,name=environmentVariableDefaultValue,proto3" json:"environmentVariableDefaultValue,omitempty"`
+	PlaceholderVariableName         string                    `protobuf:"bytes,5,opt,name=placeholderVariableName,proto3" json:"placeholderVariableName,omitempty"`
+}
+
+func (x *ConfigurationVariable) Reset() {
+	*x = ConfigurationVariable{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wundernode_config_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigurationVariable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigurationVariable) ProtoMessage() {}
+
+func (x *ConfigurationVariable) ProtoReflect() protoreflect.Message {
+	mi := &file_wundernode_config_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigurationVariable.ProtoReflect.Descriptor instead.
+func (*ConfigurationVariable) Descriptor() ([]byte, []int) {
+	return file_wundernode_config_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ConfigurationVariable) GetKind() ConfigurationVariableKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ConfigurationVariableKind_STATIC_CONFIGURATION_VARIABLE
+}
+
+func (x *ConfigurationVariable) GetStaticVariableContent() string {
+	if x != nil {
+		return x.StaticVariableContent
+	}
+	return ""
+}
+
+func (x *ConfigurationVariable) GetEnvironmentVariableName() string {
+	if x != nil {
+		return x.EnvironmentVariableName
+	}
+	return ""
+}
+
+func (x *ConfigurationVariable) GetEnvironmentVariableDefaultValue() string {
+	if x != nil {
+		return x.EnvironmentVariableDefaultValue
+	}
+	return ""
+}
+
+func (x *ConfigurationVariable) GetPlaceholderVariableName() string {
+	if x != nil {
+		return x.PlaceholderVariableName
+	}
+	return ""
+}
+
+type BuildInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool              `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Sdk       *BuildInfoVersion `protobuf:"bytes,2,opt,name=sdk,proto3" json:"sdk,omitempty"`
+	Wunderctl *BuildInfoVersion `protobuf:"bytes,3,opt,name=wunderctl,proto3" json:"wunderctl,omitempty"`
+	Node      *BuildInfoVersion `protobuf:"bytes,4,opt,name=node,proto3" json:"node,omitempty"`
+	Os        *BuildInfoOS      `protobuf:"bytes,5,opt,name=os,proto3" json:"os,omitempty"`
+	Stats     *BuildInfoStats   `protobuf:"bytes,6,opt,name=stats,proto3" json:"stats,omitempty"`
+}
+
+func (x *BuildInfo) Reset() {
+	*x = BuildInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wundernode_config_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildInfo) ProtoMessage() {}
+
+func (x *BuildInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_wundernode_config_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildInfo.ProtoReflect.Descriptor instead.
+func (*BuildInfo) Descriptor() ([]byte, []int) {
+	return file_wundernode_config_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *BuildInfo) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BuildInfo) GetSdk() *BuildInfoVersion {
+	if x != nil {
+		return x.Sdk
+	}
+	return nil
+}
+
+func (x *BuildInfo) GetWunderctl() *BuildInfoVersion {
+	if x != nil {
+		return x.Wunderctl
+	}
+	return nil
+}
+
+func (x *BuildInfo) GetNode() *BuildInfoVersion {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *BuildInfo) GetOs() *BuildInfoOS {
+	if x != nil {
+		return x.Os
+	}
+	return nil
+}
+
+func (x *BuildInfo) GetStats() *BuildInfoStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type BuildInfoVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *BuildInfoVersion) Reset() {
+	*x = BuildInfoVersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wundernode_config_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildInfoVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildInfoVersion) ProtoMessage() {}
+
+func (x *BuildInfoVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_wundernode_config_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildInfoVersion.ProtoReflect.Descriptor instead.
+func (*BuildInfoVersion) Descriptor() ([]byte, []int) {
+	return file_wundernode_config_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *BuildInfoVersion) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type BuildInfoOS struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type     string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Platform string `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`
+	Arch     string `protobuf:"bytes,3,opt,name=arch,proto3" json:"arch,omitempty"`
+	Version  string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	Release  string `protobuf:"bytes,5,opt,name=release,proto3" json:"release,omitempty"`
+}
+
+func (x *BuildInfoOS) Reset() {
+	*x = BuildInfoOS{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wundernode_config_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildInfoOS) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildInfoOS) ProtoMessage() {}
+
+func (x *BuildInfoOS) ProtoReflect() protoreflect.Message {
+	mi := &file_wundernode_config_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildInfoOS.ProtoReflect.Descriptor instead.
+func (*BuildInfoOS) Descriptor() ([]byte, []int) {
+	return file_wundernode_config_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *BuildInfoOS) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *BuildInfoOS) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *BuildInfoOS) GetArch() string {
+	if x != nil {
+		return x.Arch
+	}
+	return ""
+}
+
+func (x *BuildInfoOS) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *BuildInfoOS) GetRelease() string {
+	if x != nil {
+		return x.Release
+	}
+	return ""
+}
+
+type BuildInfoStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalApis                 int32 `protobuf:"varint,1,opt,name=totalApis,proto3" json:"totalApis,omitempty"`
+	TotalOperations           int32 `protobuf:"varint,2,opt,name=totalOperations,proto3" json:"totalOperations,omitempty"`
+	TotalWebhooks             int32 `protobuf:"varint,3,opt,name=totalWebhooks,proto3" json:"totalWebhooks,omitempty"`
+	HasAuthenticationProvider bool  `protobuf:"varint,4,opt,name=hasAuthenticationProvider,proto3" json:"hasAuthenticationProvider,omitempty"`
+	HasUploadProvider         bool  `protobuf:"varint,5,opt,name=hasUploadProvider,proto3" json:"hasUploadProvider,omitempty"`
+}
+
+func (x *BuildInfoStats) Reset() {
+	*x = BuildInfoStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wundernode_config_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildInfoStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildInfoStats) ProtoMessage() {}
+
+func (x *BuildInfoStats) ProtoReflect() protoreflect.Message {
+	mi := &file_wundernode_config_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildInfoStats.ProtoReflect.Descriptor instead.
+func (*BuildInfoStats) Descriptor() ([]byte, []int) {
+	return file_wundernode_config_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *BuildInfoStats) GetTotalApis() int32 {
+	if x != nil {
+		return x.TotalApis
+	}
+	return 0
+}
+
+func (x *BuildInfoStats) GetTotalOperations() int32 {
+	if x != nil {
+		return x.TotalOperations
+	}
+	return 0
+}
+
+func (x *BuildInfoStats) GetTotalWebhooks() int32 {
+	if x != nil {
+		return x.TotalWebhooks
+	}
+	return 0
+}
+
+func (x *BuildInfoStats) GetHasAuthenticationProvider() bool {
+	if x != nil {
+		return x.HasAuthenticationProvider
+	}
+	return false
+}
+
+func (x *BuildInfoStats) GetHasUploadProvider() bool {
+	if x != nil {
+		return x.HasUploadProvider
+	}
+	return false
+}
+
+var File_wundernode_config_proto protoreflect.FileDescriptor
+
+var file_wundernode_config_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x77, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x77, 0x67, 0x70, 0x62, 0x22,
+	0xf1, 0x01, 0x0a, 0x17, 0x41, 0x70, 0x69, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x41, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x42, 0x61, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x42, 0x61,
+	0x73, 0x65, 0x64, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x42, 0x61, 0x73, 0x65, 0x64, 0x12, 0x32,
+	0x0a, 0x05, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x77, 0x67, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x69, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x05, 0x68, 0x6f, 0x6f,
+	0x6b, 0x73, 0x12, 0x3b, 0x0a, 0x09, 0x6a, 0x77, 0x6b, 0x73, 0x42, 0x61, 0x73, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x4a, 0x77, 0x6b,
+	0x73, 0x42, 0x61, 0x73, 0x65, 0x64, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6a, 0x77, 0x6b, 0x73, 0x42, 0x61, 0x73, 0x65, 0x64, 0x12,
+	0x22, 0x0a, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x73, 0x22, 0x4f, 0x0a, 0x17, 0x4a, 0x77, 0x6b, 0x73, 0x42, 0x61, 0x73, 0x65, 0x64,
+	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34,
+	0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x4a, 0x77, 0x6b, 0x73, 0x41, 0x75, 0x74,
+	0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x73, 0x22, 0xc0, 0x02, 0x0a, 0x10, 0x4a, 0x77, 0x6b, 0x73, 0x41, 0x75, 0x74,
+	0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x07, 0x6a, 0x77, 0x6b,
+	0x73, 0x55, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70,
+	0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56,
+	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x07, 0x6a, 0x77, 0x6b, 0x73, 0x55, 0x72, 0x6c,
+	0x12, 0x37, 0x0a, 0x08, 0x6a, 0x77, 0x6b, 0x73, 0x4a, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52,
+	0x08, 0x6a, 0x77, 0x6b, 0x73, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x10, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
+	0x52, 0x10, 0x75, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x12, 0x38, 0x0a, 0x17, 0x75, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x54, 0x74, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x17, 0x75, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x54, 0x74, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x39, 0x0a, 0x0c,
+	0x72, 0x6f, 0x6c, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x4d, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0c, 0x72, 0x6f, 0x6c, 0x65, 0x4d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xe4, 0x01, 0x0a, 0x16, 0x41, 0x70, 0x69, 0x41,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x6f,
+	0x6b, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x70, 0x6f, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12,
+	0x70, 0x6f, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x1a, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f,
+	0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1a, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x50, 0x6f, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x18, 0x72, 0x65, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x72, 0x65, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e,
+	0x0a, 0x0a, 0x70, 0x6f, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x70, 0x6f, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x22, 0xf5,
+	0x03, 0x0a, 0x19, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x42, 0x61, 0x73, 0x65, 0x64, 0x41, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x09,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x53,
+	0x0a, 0x16, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x52, 0x65, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x69, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x16, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55,
+	0x72, 0x69, 0x73, 0x12, 0x5f, 0x0a, 0x1c, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65,
+	0x64, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x69, 0x52, 0x65, 0x67, 0x65,
+	0x78, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x1c, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x65, 0x64, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x69, 0x52, 0x65, 0x67,
+	0x65, 0x78, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x68, 0x61, 0x73, 0x68, 0x4b, 0x65, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x52, 0x07, 0x68, 0x61, 0x73, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x37, 0x0a, 0x08, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x4b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x4b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x0a, 0x63, 0x73, 0x72, 0x66, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72,
+	0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x0a, 0x63, 0x73, 0x72, 0x66, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x12, 0x43, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x92, 0x03, 0x0a, 0x0c, 0x41, 0x75, 0x74, 0x68, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x41, 0x75, 0x74,
+	0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x12, 0x42, 0x0a, 0x0c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x67, 0x70, 0x62,
+	0x2e, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0c, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x45, 0x0a, 0x0a, 0x6f, 0x69, 0x64, 0x63, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x77, 0x67,
+	0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x44, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x0a, 0x6f, 0x69, 0x64, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x42,
+	0x0a, 0x0c, 0x6f, 0x61, 0x75, 0x74, 0x68, 0x32, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x4f, 0x41, 0x75, 0x74,
+	0x68, 0x32, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x0c, 0x6f, 0x61, 0x75, 0x74, 0x68, 0x32, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x3c, 0x0a, 0x0a, 0x73, 0x61, 0x6d, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x53, 0x41,
+	0x4d, 0x4c, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x73, 0x61, 0x6d, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x39, 0x0a, 0x0c, 0x72, 0x6f, 0x6c, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x52, 0x6f,
+	0x6c, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0c, 0x72,
+	0x6f, 0x6c, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x80, 0x01, 0x0a, 0x0f,
+	0x52, 0x6f, 0x6c, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72,
+	0x6f, 0x6c, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x19, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x4d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x94,
+	0x01, 0x0a, 0x18, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x37, 0x0a, 0x08, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70,
+	0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56,
+	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x81, 0x01, 0x0a, 0x1b, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x44,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x9d, 0x02, 0x0a, 0x1f, 0x4f, 0x70,
+	0x65, 0x6e, 0x49, 0x44, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x41, 0x75, 0x74, 0x68, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x33, 0x0a,
+	0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x72, 0x12, 0x37, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
+	0x65, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x0c, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x0c,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x4b, 0x0a, 0x0f,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x49, 0x44, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x52, 0x0f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0xd1, 0x04, 0x0a, 0x18, 0x4f, 0x41,
+	0x75, 0x74, 0x68, 0x32, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x37, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72,
+	0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x3f, 0x0a, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x12, 0x47, 0x0a, 0x10, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x55, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70,
+	0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56,
+	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x10, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x37, 0x0a, 0x08, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x55, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67,
+	0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x55,
+	0x72, 0x6c, 0x12, 0x3d, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x55, 0x72,
+	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69,
+	0x61, 0x62, 0x6c, 0x65, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x55, 0x72,
+	0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x4b, 0x0a, 0x0f, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x44,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x52, 0x0f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x54, 0x0a, 0x0c, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x4d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x77,
+	0x67, 0x70, 0x62, 0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x32, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c,
+	0x63, 0x6c, 0x61, 0x69, 0x6d, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x1a, 0x3f, 0x0a, 0x11,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb7, 0x05,
+	0x0a, 0x16, 0x53, 0x41, 0x4d, 0x4c, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x43, 0x0a, 0x0e, 0x69, 0x64, 0x70, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x55, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x0e, 0x69,
+	0x64, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x55, 0x72, 0x6c, 0x12, 0x43, 0x0a,
+	0x0e, 0x69, 0x64, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x58, 0x6d, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x52, 0x0e, 0x69, 0x64, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x58,
+	0x6d, 0x6c, 0x12, 0x37, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
+	0x65, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x0b, 0x63,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x0b, 0x63,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x70, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x0a, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x69, 0x67, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x73,
+	0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x49, 0x64, 0x70, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x49, 0x64, 0x70,
+	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x64, 0x12, 0x3f, 0x0a, 0x0c, 0x6e, 0x61, 0x6d,
+	0x65, 0x49, 0x64, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x0c, 0x6e, 0x61,
+	0x6d, 0x65, 0x49, 0x64, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x5e, 0x0a, 0x10, 0x61, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x53, 0x41, 0x4d, 0x4c,
+	0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x4d, 0x61, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x6f,
+	0x6c, 0x65, 0x73, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x1a, 0x43, 0x0a, 0x15, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x4d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd1, 0x07, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0d, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x77, 0x67, 0x70,
+	0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x0d, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x28,
+	0x0a, 0x0f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
+	0x65, 0x73, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x12, 0x41, 0x0a, 0x0b, 0x63, 0x61, 0x63, 0x68, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x61, 0x63, 0x68, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x88, 0x01, 0x01, 0x12, 0x57, 0x0a, 0x14, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x14, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x48, 0x0a, 0x0f,
+	0x6c, 0x69, 0x76, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x76, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0f, 0x6c, 0x69, 0x76, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x54, 0x0a, 0x13, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x13, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x51, 0x0a, 0x12,
+	0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x68, 0x6f, 0x6f,
+	0x6b, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x5d, 0x0a, 0x16, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
+	0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x42, 0x0a, 0x1c, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x70, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61,
+	0x62, 0x6c, 0x65, 0x73, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x1c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56,
+	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x5f,
+	0x0a, 0x1a, 0x70, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0f, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x1a, 0x70, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x36, 0x0a, 0x06, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1e, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52,
+	0x06, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xa0, 0x01, 0x0a, 0x19,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x12, 0x34, 0x0a, 0x03, 0x67, 0x65, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x67, 0x65, 0x74, 0x22, 0x42,
+	0x0a, 0x1c, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x47, 0x65, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x02,
+	0x74, 0x6f, 0x22, 0x71, 0x0a, 0x1f, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56,
 	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x12, 0x42, 0x0a, 0x1c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x6f, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x53, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x6f,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x53,
-	0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x5f, 0x0a, 0x1a, 0x70, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x6f, 0x6c, 0x76, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x77, 0x67, 0x70, 0x62,
-	0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x1a, 0x70, 0x6f, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x06, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
-	0x18, 0x10, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
-	0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x06, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
-	0x74, 0x68, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x22, 0xa0, 0x01, 0x0a, 0x19, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c,
-	0x76, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x37, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23,
-	0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76,
-	0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4b,
-	0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70,
-	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x12,
-	0x34, 0x0a, 0x03, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x77,
-	0x67, 0x70, 0x62, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x47,
-	0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x03, 0x67, 0x65, 0x74, 0x22, 0x42, 0x0a, 0x1c, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x6f, 0x6c, 0x76, 0x65, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x71, 0x0a, 0x1f, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4e, 0x0a, 0x0f,
-	0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x72,
-	0x69, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x69, 0x6e, 0x6a,
-	0x65, 0x63, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x22, 0xf0, 0x01, 0x0a,
-	0x1e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x36, 0x0a, 0x16, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x43,
-	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x16, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x43, 0x6f, 0x6d,
-	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3c, 0x0a, 0x0c, 0x76, 0x61, 0x72, 0x69, 0x61,
-	0x62, 0x6c, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e,
-	0x77, 0x67, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61,
-	0x62, 0x6c, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x0c, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
-	0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72,
-	0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x65, 0x46,
-	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x38, 0x0a, 0x17, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
-	0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
-	0x65, 0x6e, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22,
-	0x67, 0x0a, 0x23, 0x47, 0x72, 0x61, 0x70, 0x68, 0x51, 0x4c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x1b, 0x6f, 0x6e, 0x57, 0x53, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x6e, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1b, 0x6f, 0x6e, 0x57,
-	0x53, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x69, 0x74, 0x22, 0x81, 0x01, 0x0a, 0x0b, 0x48, 0x6f, 0x6f,
-	0x6b, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x0d, 0x6f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x13, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x54, 0x79, 0x70, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x64,
-	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x22, 0x67, 0x0a, 0x04,
-	0x48, 0x6f, 0x6f, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63,
-	0x68, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x67, 0x70, 0x62,
-	0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x61,
-	0x74, 0x63, 0x68, 0x65, 0x72, 0x22, 0x9f, 0x03, 0x0a, 0x1b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x52, 0x65, 0x73, 0x6f,
-	0x6c, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x52, 0x65,
-	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x6f, 0x6c, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x6d, 0x75, 0x74, 0x61, 0x74,
-	0x69, 0x6e, 0x67, 0x50, 0x72, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x12, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x65,
-	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x6d, 0x75, 0x74, 0x61, 0x74,
-	0x69, 0x6e, 0x67, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f,
-	0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x6d, 0x6f, 0x63,
-	0x6b, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
-	0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76,
-	0x65, 0x48, 0x6f, 0x6f, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x0b, 0x6d, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x12,
-	0x36, 0x0a, 0x16, 0x68, 0x74, 0x74, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74,
-	0x4f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x16, 0x68, 0x74, 0x74, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x17, 0x68, 0x74, 0x74, 0x70, 0x54,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4e, 0x0a, 0x0f, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x56,
+	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e,
+	0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x56, 0x61, 0x72, 0x69,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x22, 0xf0, 0x01, 0x0a, 0x1e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x16, 0x76, 0x61, 0x72, 0x69,
+	0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x16, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x3c, 0x0a, 0x0c, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x4b, 0x69, 0x6e, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x49, 0x6e,
+	0x6a, 0x65, 0x63, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x4b, 0x69, 0x6e, 0x64,
+	0x52, 0x0c, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x38,
+	0x0a, 0x17, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x72,
+	0x69, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x17, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x72, 0x69,
+	0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x67, 0x0a, 0x23, 0x47, 0x72, 0x61, 0x70,
+	0x68, 0x51, 0x4c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x6f, 0x6f,
+	0x6b, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x40, 0x0a, 0x1b, 0x6f, 0x6e, 0x57, 0x53, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x69, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x1b, 0x6f, 0x6e, 0x57, 0x53, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x69,
+	0x74, 0x22, 0x39, 0x0a, 0x0d, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x9e, 0x02, 0x0a,
+	0x0b, 0x48, 0x6f, 0x6f, 0x6b, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x0d,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x26,
+	0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x12, 0x2d, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x52, 0x07, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x42, 0x10, 0x0a, 0x0e, 0x5f,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x22, 0x67, 0x0a,
+	0x04, 0x48, 0x6f, 0x6f, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x67, 0x70,
+	0x62, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x52, 0x07, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x22, 0xc5, 0x05, 0x0a, 0x1b, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x52, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x70, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x6d, 0x75, 0x74, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x72,
+	0x65, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x6d, 0x75, 0x74, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x6d, 0x6f,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x48, 0x6f, 0x6f, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x6d, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x12, 0x36, 0x0a, 0x16, 0x68, 0x74, 0x74, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x4f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x16, 0x68, 0x74, 0x74, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x4f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x17, 0x68, 0x74, 0x74, 0x70,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x68, 0x74, 0x74, 0x70, 0x54,
 	0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x68, 0x74, 0x74, 0x70, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x6c,
-	0x76, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x22, 0x84, 0x01, 0x0a, 0x1c, 0x4d, 0x6f, 0x63, 0x6b,
-	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x48, 0x6f, 0x6f, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x12, 0x4c, 0x0a, 0x21, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x50, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d,
-	0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x21, 0x73, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x22, 0x84,
-	0x01, 0x0a, 0x1c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x29, 0x0a, 0x06, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x52, 0x06, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x6f,
-	0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x6f, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x72, 0x6f, 0x6c, 0x65, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xb1, 0x01, 0x0a, 0x13, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x28, 0x0a,
-	0x0f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x41, 0x6c, 0x6c,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x4d,
-	0x61, 0x74, 0x63, 0x68, 0x41, 0x6c, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x69,
-	0x72, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x41, 0x6e, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x41, 0x6e,
-	0x79, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x6e, 0x79, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x41, 0x6c,
-	0x6c, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x6e, 0x79, 0x4d, 0x61, 0x74,
-	0x63, 0x68, 0x41, 0x6c, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x6e, 0x79, 0x4d, 0x61, 0x74,
-	0x63, 0x68, 0x41, 0x6e, 0x79, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x6e,
-	0x79, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x41, 0x6e, 0x79, 0x22, 0x92, 0x01, 0x0a, 0x0b, 0x43, 0x75,
-	0x73, 0x74, 0x6f, 0x6d, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a,
-	0x12, 0x6a, 0x73, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
-	0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x6a, 0x73, 0x6f, 0x6e, 0x50,
-	0x61, 0x74, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x0a,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x77, 0x67,
-	0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0xaf,
-	0x01, 0x0a, 0x0b, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x36,
-	0x0a, 0x16, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x43, 0x6f,
-	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x16,
-	0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x43, 0x6f, 0x6d, 0x70,
-	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x09, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x54,
-	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x77, 0x67, 0x70, 0x62,
-	0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x69,
-	0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x48, 0x00, 0x52, 0x06, 0x63, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x22, 0x6a, 0x0a, 0x18, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x76,
-	0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x12, 0x4a, 0x0a, 0x15, 0x70, 0x72, 0x65, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x45,
+	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x6f, 0x6b, 0x52, 0x15, 0x70,
+	0x72, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4c, 0x0a, 0x16, 0x70, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x6f, 0x6b, 0x52, 0x16, 0x70, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x6f, 0x6e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x13, 0x6f, 0x6e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x58, 0x0a, 0x1c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x67, 0x70,
+	0x62, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x6f, 0x6b,
+	0x52, 0x1c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xba,
+	0x01, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x6f,
+	0x6b, 0x12, 0x32, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1a, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x6f, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65,
+	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x84, 0x01, 0x0a, 0x1c,
+	0x4d, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x48, 0x6f, 0x6f, 0x6b, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
 	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x12, 0x36, 0x0a, 0x16, 0x70, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x70, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x43, 0x0a, 0x1d,
-	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x22, 0x0a,
-	0x0c, 0x61, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
-	0x64, 0x22, 0xa0, 0x02, 0x0a, 0x14, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
-	0x61, 0x63, 0x68, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1b, 0x0a, 0x06, 0x65, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x06, 0x65, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6d, 0x61, 0x78, 0x41, 0x67,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x41, 0x67,
-	0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x88, 0x01,
-	0x01, 0x12, 0x37, 0x0a, 0x14, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x57, 0x68, 0x69, 0x6c, 0x65, 0x52,
-	0x65, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48,
-	0x03, 0x52, 0x14, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x57, 0x68, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0e, 0x6d, 0x75,
-	0x73, 0x74, 0x52, 0x65, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x48, 0x04, 0x52, 0x0e, 0x6d, 0x75, 0x73, 0x74, 0x52, 0x65, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x65, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x42, 0x09, 0x0a,
-	0x07, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x73, 0x74, 0x61,
-	0x6c, 0x65, 0x57, 0x68, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6d, 0x75, 0x73, 0x74, 0x52, 0x65, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x65, 0x22, 0xf5, 0x03, 0x0a, 0x13, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x14,
+	0x61, 0x62, 0x6c, 0x65, 0x12, 0x4c, 0x0a, 0x21, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x21, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x69, 0x6c, 0x6c,
+	0x69, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x1c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x29, 0x0a, 0x06, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x12, 0x39,
+	0x0a, 0x0a, 0x72, 0x6f, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x72,
+	0x6f, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xb1, 0x01, 0x0a, 0x13, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x41, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x41, 0x6c, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x41, 0x6e, 0x79, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x41, 0x6e, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x6e, 0x79, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x41, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x6e,
+	0x79, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x41, 0x6c, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x6e,
+	0x79, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x41, 0x6e, 0x79, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0c, 0x64, 0x65, 0x6e, 0x79, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x41, 0x6e, 0x79, 0x22, 0x92, 0x01,
+	0x0a, 0x0b, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x2e, 0x0a, 0x12, 0x6a, 0x73, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x43, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x6a,
+	0x73, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x23, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0f, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x22, 0xaf, 0x01, 0x0a, 0x0b, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x36, 0x0a, 0x16, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61,
+	0x74, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x16, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68,
+	0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x09, 0x63, 0x6c,
+	0x61, 0x69, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e,
+	0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09,
+	0x63, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x67, 0x70, 0x62,
+	0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x48, 0x00, 0x52, 0x06,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x63, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x22, 0x6a, 0x0a, 0x18, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x69, 0x76, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x36, 0x0a, 0x16, 0x70, 0x6f, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x70, 0x6f, 0x6c, 0x6c, 0x69, 0x6e,
+	0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x22, 0x43, 0x0a, 0x1d, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0xa0, 0x02, 0x0a, 0x14, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1b,
+	0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00,
+	0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6d,
+	0x61, 0x78, 0x41, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x06, 0x6d,
+	0x61, 0x78, 0x41, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x14, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x57, 0x68,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x48, 0x03, 0x52, 0x14, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x57, 0x68, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2b,
+	0x0a, 0x0e, 0x6d, 0x75, 0x73, 0x74, 0x52, 0x65, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x04, 0x52, 0x0e, 0x6d, 0x75, 0x73, 0x74, 0x52, 0x65,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6d, 0x61, 0x78, 0x41, 0x67,
+	0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x42, 0x17, 0x0a, 0x15,
+	0x5f, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x57, 0x68, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6d, 0x75, 0x73, 0x74, 0x52, 0x65,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x22, 0xf5, 0x03, 0x0a, 0x13, 0x45, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x32, 0x0a, 0x14, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x6c, 0x75, 0x73, 0x68,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14,
 	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x64, 0x65, 0x66, 0x61,
-	0x75, 0x6c, 0x74, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
-	0x12, 0x59, 0x0a, 0x18, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x18, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4a, 0x0a, 0x13, 0x66,
-	0x69, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x13, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x67, 0x72, 0x61, 0x70, 0x68,
-	0x71, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x47, 0x0a,
-	0x12, 0x74, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77, 0x67, 0x70, 0x62,
-	0x2e, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x12, 0x74, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x52, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
-	0x77, 0x67, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x1a, 0x40, 0x0a, 0x12, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x22, 0x0a, 0x0e,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x22, 0xb9, 0x05, 0x0a, 0x17, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x04,
-	0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x77, 0x67, 0x70,
-	0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x69, 0x6e, 0x64,
-	0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x2d, 0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x74, 0x4e, 0x6f,
-	0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x77, 0x67, 0x70, 0x62,
-	0x2e, 0x54, 0x79, 0x70, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x09, 0x72, 0x6f, 0x6f, 0x74,
-	0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x0a, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x4e, 0x6f,
-	0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x77, 0x67, 0x70, 0x62,
-	0x2e, 0x54, 0x79, 0x70, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x0a, 0x63, 0x68, 0x69, 0x6c,
-	0x64, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x1a, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
-	0x64, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x61, 0x74, 0x68, 0x46, 0x72, 0x6f, 0x6d, 0x41,
-	0x6c, 0x69, 0x61, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1a, 0x6f, 0x76, 0x65, 0x72,
-	0x72, 0x69, 0x64, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x61, 0x74, 0x68, 0x46, 0x72, 0x6f,
-	0x6d, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x3b, 0x0a, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x52, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70,
-	0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52,
-	0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x47, 0x72, 0x61,
-	0x70, 0x68, 0x71, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x67, 0x70,
-	0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x5f, 0x47, 0x72, 0x61, 0x70, 0x68, 0x51, 0x4c, 0x52, 0x0d, 0x63, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x47, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x12, 0x41, 0x0a, 0x0c, 0x63, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1d, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x0c,
-	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x12, 0x47, 0x0a, 0x0e,
-	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x44, 0x61, 0x74,
-	0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x0e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x44, 0x61, 0x74,
-	0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x67, 0x70, 0x62,
-	0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x15, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69,
-	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x15, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f,
-	0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x41, 0x0a, 0x0c, 0x63, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x4e, 0x61, 0x74, 0x73, 0x4b, 0x76, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1d, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x4e, 0x61, 0x74, 0x73, 0x4b, 0x76, 0x52, 0x0c,
-	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4e, 0x61, 0x74, 0x73, 0x4b, 0x76, 0x22, 0x5a, 0x0a, 0x16,
-	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x6f, 0x22, 0xfd, 0x01, 0x0a, 0x17, 0x44, 0x61, 0x74,
-	0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x4e, 0x61,
-	0x74, 0x73, 0x4b, 0x76, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x52,
-	0x4c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55,
-	0x52, 0x4c, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x33, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x4e, 0x61, 0x74,
-	0x73, 0x4b, 0x76, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f,
-	0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3f, 0x0a, 0x0c, 0x62, 0x75, 0x63, 0x6b, 0x65,
-	0x74, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x0c, 0x62, 0x75, 0x63, 0x6b,
-	0x65, 0x74, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x8f, 0x02, 0x0a, 0x15, 0x44, 0x61, 0x74,
-	0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x52, 0x45,
-	0x53, 0x54, 0x12, 0x2e, 0x0a, 0x05, 0x66, 0x65, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x66, 0x65, 0x74,
-	0x63, 0x68, 0x12, 0x47, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e,
-	0x52, 0x45, 0x53, 0x54, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x73,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x16, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x4d, 0x61, 0x70,
-	0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67,
-	0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x16, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x43, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73,
-	0x12, 0x28, 0x0a, 0x0f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x79, 0x70, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x8f, 0x01, 0x0a, 0x15, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x4d, 0x61, 0x70,
-	0x70, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f,
-	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x3a, 0x0a, 0x18, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x43, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x74, 0x6f, 0x42, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x18, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x43, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x74, 0x6f, 0x42, 0x6f, 0x64, 0x79, 0x22, 0xc4, 0x03, 0x0a,
-	0x18, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x5f, 0x47, 0x72, 0x61, 0x70, 0x68, 0x51, 0x4c, 0x12, 0x2e, 0x0a, 0x05, 0x66, 0x65, 0x74,
-	0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e,
-	0x46, 0x65, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x05, 0x66, 0x65, 0x74, 0x63, 0x68, 0x12, 0x4a, 0x0a, 0x0c, 0x73, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x26, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x51, 0x4c, 0x53, 0x75,
+	0x72, 0x76, 0x61, 0x6c, 0x12, 0x59, 0x0a, 0x18, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x18, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x4a, 0x0a, 0x13, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77,
+	0x67, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x13, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x12, 0x47, 0x0a, 0x12, 0x74, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x77, 0x67, 0x70, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x74, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x52, 0x0a, 0x0d, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2c, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x1a, 0x40,
+	0x0a, 0x12, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x22, 0x0a, 0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x22, 0xe3, 0x06, 0x0a, 0x17, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x28, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14,
+	0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x2d, 0x0a, 0x09, 0x72, 0x6f,
+	0x6f, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x77, 0x67, 0x70, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x09,
+	0x72, 0x6f, 0x6f, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x0a, 0x63, 0x68, 0x69,
+	0x6c, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x77, 0x67, 0x70, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x0a,
+	0x63, 0x68, 0x69, 0x6c, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x1a, 0x6f, 0x76,
+	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x61, 0x74, 0x68, 0x46,
+	0x72, 0x6f, 0x6d, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1a,
+	0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x61, 0x74,
+	0x68, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x3b, 0x0a, 0x0a, 0x63, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x52, 0x0a, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x47, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x47, 0x72, 0x61, 0x70, 0x68, 0x51, 0x4c, 0x52, 0x0d,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x47, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x12, 0x41, 0x0a,
+	0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x63, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63,
+	0x12, 0x47, 0x0a, 0x0e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e,
+	0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x5f, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x0e, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x77, 0x67, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x15, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x41, 0x0a,
+	0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4e, 0x61, 0x74, 0x73, 0x4b, 0x76, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x4e, 0x61, 0x74, 0x73,
+	0x4b, 0x76, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4e, 0x61, 0x74, 0x73, 0x4b, 0x76,
+	0x12, 0x56, 0x0a, 0x13, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x77, 0x67, 0x70, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x13, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x50, 0x0a, 0x18, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x67, 0x70,
+	0x62, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x6f, 0x6b,
+	0x52, 0x18, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45,
+	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x5a, 0x0a, 0x16, 0x44, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x6e, 0x61, 0x6d, 0x65, 0x54, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65,
+	0x6e, 0x61, 0x6d, 0x65, 0x54, 0x6f, 0x22, 0xfd, 0x01, 0x0a, 0x17, 0x44, 0x61, 0x74, 0x61, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x4e, 0x61, 0x74, 0x73,
+	0x4b, 0x76, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x52, 0x4c,
+	0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x33, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x4e, 0x61, 0x74, 0x73, 0x4b,
+	0x76, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3f, 0x0a, 0x0c, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x50,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67,
+	0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x0c, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x3a, 0x0a, 0x1e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x22, 0x8f, 0x02, 0x0a, 0x15, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x12, 0x2e, 0x0a, 0x05,
+	0x66, 0x65, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x67,
+	0x70, 0x62, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x66, 0x65, 0x74, 0x63, 0x68, 0x12, 0x47, 0x0a, 0x0c,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x52, 0x45, 0x53, 0x54, 0x53, 0x75,
 	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
 	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x0a, 0x66, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x77, 0x67, 0x70, 0x62,
-	0x2e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x51, 0x4c, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x0a, 0x66, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x0e, 0x75,
-	0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x6e, 0x65, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0e, 0x75, 0x70, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x59, 0x0a, 0x12, 0x68, 0x6f, 0x6f,
-	0x6b, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x61,
-	0x70, 0x68, 0x51, 0x4c, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x6f,
-	0x6f, 0x6b, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x12, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x16, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x63,
-	0x61, 0x6c, 0x61, 0x72, 0x54, 0x79, 0x70, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x06,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x6e, 0x67,
-	0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x16, 0x63, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x54, 0x79, 0x70, 0x65, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x73, 0x22, 0xf1, 0x02, 0x0a, 0x19, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
-	0x65, 0x12, 0x3d, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x55, 0x52, 0x4c,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x16, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43,
+	0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x52, 0x16, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x8f, 0x01, 0x0a, 0x15, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43,
+	0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x1e,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x18, 0x69, 0x6e,
+	0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x49, 0x6e,
+	0x74, 0x6f, 0x42, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x69, 0x6e,
+	0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x49, 0x6e,
+	0x74, 0x6f, 0x42, 0x6f, 0x64, 0x79, 0x22, 0xc4, 0x03, 0x0a, 0x18, 0x44, 0x61, 0x74, 0x61, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x47, 0x72, 0x61, 0x70,
+	0x68, 0x51, 0x4c, 0x12, 0x2e, 0x0a, 0x05, 0x66, 0x65, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x66, 0x65,
+	0x74, 0x63, 0x68, 0x12, 0x4a, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x77, 0x67, 0x70, 0x62,
+	0x2e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x51, 0x4c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x44, 0x0a, 0x0a, 0x66, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x61, 0x70, 0x68,
+	0x51, 0x4c, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x66, 0x65, 0x64, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x0e, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x77, 0x67, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x0e, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x12, 0x59, 0x0a, 0x12, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x51, 0x4c, 0x44, 0x61,
+	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x68, 0x6f, 0x6f, 0x6b,
+	0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4d,
+	0x0a, 0x16, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x54, 0x79,
+	0x70, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x16, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x63, 0x61,
+	0x6c, 0x61, 0x72, 0x54, 0x79, 0x70, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0xf1, 0x02,
+	0x0a, 0x19, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x5f, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0b, 0x64,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x0b, 0x64,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x38, 0x0a, 0x0c, 0x70, 0x72,
+	0x69, 0x73, 0x6d, 0x61, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x65, 0x64,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x70, 0x72, 0x69, 0x73, 0x6d, 0x61, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x12, 0x3a, 0x0a, 0x0d, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x67,
+	0x70, 0x62, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x0d, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x12, 0x30, 0x0a, 0x13, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x12, 0x3d, 0x0a, 0x0e, 0x6a, 0x73, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x77, 0x67, 0x70,
+	0x62, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x52, 0x0e, 0x6a, 0x73, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x12, 0x2e, 0x0a, 0x12, 0x6a, 0x73, 0x6f, 0x6e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x56, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x6a,
+	0x73, 0x6f, 0x6e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
+	0x73, 0x22, 0x5a, 0x0a, 0x1e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x51, 0x4c, 0x46, 0x65, 0x64, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1e, 0x0a,
+	0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x64, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x64, 0x6c, 0x22, 0x4a, 0x0a,
+	0x17, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x5f, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x12, 0x2f, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f,
 	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61,
-	0x62, 0x6c, 0x65, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x55, 0x52, 0x4c,
-	0x12, 0x38, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x73, 0x6d, 0x61, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x70, 0x72,
-	0x69, 0x73, 0x6d, 0x61, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x3a, 0x0a, 0x0d, 0x67, 0x72,
-	0x61, 0x70, 0x68, 0x71, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x65,
-	0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c,
-	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x30, 0x0a, 0x13, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x54,
-	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x13, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
-	0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x3d, 0x0a, 0x0e, 0x6a, 0x73, 0x6f, 0x6e,
-	0x54, 0x79, 0x70, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x0e, 0x6a, 0x73, 0x6f, 0x6e, 0x54, 0x79, 0x70,
-	0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x6a, 0x73, 0x6f, 0x6e, 0x49,
-	0x6e, 0x70, 0x75, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x12, 0x6a, 0x73, 0x6f, 0x6e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x56, 0x61,
-	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x22, 0x5a, 0x0a, 0x1e, 0x47, 0x72, 0x61, 0x70, 0x68,
-	0x51, 0x4c, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x64,
-	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x53, 0x64, 0x6c, 0x22, 0x4a, 0x0a, 0x17, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x12, 0x2f,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77,
-	0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
-	0x83, 0x01, 0x0a, 0x20, 0x47, 0x72, 0x61, 0x70, 0x68, 0x51, 0x4c, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2d,
-	0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67,
+	0x62, 0x6c, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x83, 0x01, 0x0a, 0x20, 0x47, 0x72,
+	0x61, 0x70, 0x68, 0x51, 0x4c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x53, 0x53,
+	0x45, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x73, 0x65, 0x53, 0x53, 0x45, 0x22,
+	0xc4, 0x05, 0x0a, 0x12, 0x46, 0x65, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
+	0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x28, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x48, 0x54, 0x54,
+	0x50, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12,
+	0x3c, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2f, 0x0a,
+	0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67,
 	0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a,
-	0x06, 0x75, 0x73, 0x65, 0x53, 0x53, 0x45, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x53, 0x53, 0x45, 0x22, 0xc4, 0x05, 0x0a, 0x12, 0x46, 0x65, 0x74, 0x63, 0x68, 0x43,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x31,
+	0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x77, 0x67, 0x70, 0x62, 0x2e, 0x55, 0x52, 0x4c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x54, 0x0a, 0x16, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x16, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x75, 0x72, 0x6c, 0x45, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x75, 0x72, 0x6c, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x2b, 0x0a,
+	0x04, 0x6d, 0x54, 0x4c, 0x53, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77, 0x67,
+	0x70, 0x62, 0x2e, 0x4d, 0x54, 0x4c, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x6d, 0x54, 0x4c, 0x53, 0x12, 0x35, 0x0a, 0x07, 0x62, 0x61,
+	0x73, 0x65, 0x55, 0x72, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67,
+	0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x07, 0x62, 0x61, 0x73, 0x65, 0x55, 0x72,
+	0x6c, 0x12, 0x2f, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x12, 0x44, 0x0a, 0x0c, 0x68, 0x74, 0x74, 0x70, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x55,
+	0x72, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72,
+	0x69, 0x61, 0x62, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x68, 0x74, 0x74, 0x70, 0x50, 0x72, 0x6f,
+	0x78, 0x79, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x1a, 0x4b, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e,
+	0x48, 0x54, 0x54, 0x50, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x68, 0x74, 0x74, 0x70, 0x50, 0x72,
+	0x6f, 0x78, 0x79, 0x55, 0x72, 0x6c, 0x22, 0xa3, 0x01, 0x0a, 0x11, 0x4d, 0x54, 0x4c, 0x53, 0x43,
 	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x03,
-	0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62,
 	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61,
-	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x28, 0x0a, 0x06, 0x6d,
-	0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x77, 0x67,
-	0x70, 0x62, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x06, 0x6d,
-	0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x3c, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x46, 0x65, 0x74,
-	0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x68, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x04,
-	0x62, 0x6f, 0x64, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x05, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x55, 0x52, 0x4c, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x54, 0x0a, 0x16, 0x75, 0x70, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x55,
-	0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41,
-	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a,
-	0x0d, 0x75, 0x72, 0x6c, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x75, 0x72, 0x6c, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x42,
-	0x6f, 0x64, 0x79, 0x12, 0x2b, 0x0a, 0x04, 0x6d, 0x54, 0x4c, 0x53, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x4d, 0x54, 0x4c, 0x53, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x6d, 0x54, 0x4c, 0x53,
-	0x12, 0x35, 0x0a, 0x07, 0x62, 0x61, 0x73, 0x65, 0x55, 0x72, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x07,
-	0x62, 0x61, 0x73, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x2f, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62,
-	0x6c, 0x65, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x44, 0x0a, 0x0c, 0x68, 0x74, 0x74, 0x70,
-	0x50, 0x72, 0x6f, 0x78, 0x79, 0x55, 0x72, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x68,
-	0x74, 0x74, 0x70, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x1a, 0x4b,
-	0x0a, 0x0b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x26, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
-	0x68, 0x74, 0x74, 0x70, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x55, 0x72, 0x6c, 0x22, 0xa3, 0x01, 0x0a,
-	0x11, 0x4d, 0x54, 0x4c, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x2f, 0x0a, 0x04, 0x63, 0x65, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x04, 0x63, 0x65,
-	0x72, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x53, 0x6b,
-	0x69, 0x70, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x04, 0x63,
+	0x65, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x04, 0x63, 0x65, 0x72, 0x74, 0x12, 0x2e, 0x0a, 0x12,
 	0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x53, 0x6b, 0x69, 0x70, 0x56, 0x65, 0x72, 0x69,
-	0x66, 0x79, 0x22, 0x98, 0x02, 0x0a, 0x16, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41,
-	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a,
-	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x77, 0x67,
-	0x70, 0x62, 0x2e, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x65,
-	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b,
-	0x69, 0x6e, 0x64, 0x12, 0x43, 0x0a, 0x09, 0x6a, 0x77, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x4a, 0x77,
-	0x74, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x6a,
-	0x77, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x82, 0x01, 0x0a, 0x20, 0x6a, 0x77, 0x74,
-	0x57, 0x69, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45,
-	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x4a, 0x77, 0x74, 0x55, 0x70,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x20, 0x6a, 0x77, 0x74,
-	0x57, 0x69, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45,
-	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x91, 0x01,
-	0x0a, 0x1f, 0x4a, 0x77, 0x74, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x75, 0x74,
-	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x06,
-	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x39, 0x0a, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e,
-	0x67, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e,
-	0x77, 0x67, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x68,
-	0x6f, 0x64, 0x52, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x22, 0x81, 0x02, 0x0a, 0x30, 0x4a, 0x77, 0x74, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57,
-	0x69, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x78,
-	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61,
-	0x62, 0x6c, 0x65, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x39, 0x0a, 0x0d, 0x73,
-	0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e,
-	0x67, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67,
-	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x5d, 0x0a, 0x1b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x6e, 0x64,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67,
+	0x66, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x65, 0x53, 0x6b, 0x69, 0x70, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x22, 0x98, 0x02, 0x0a,
+	0x16, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x43, 0x0a,
+	0x09, 0x6a, 0x77, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x4a, 0x77, 0x74, 0x55, 0x70, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x6a, 0x77, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x82, 0x01, 0x0a, 0x20, 0x6a, 0x77, 0x74, 0x57, 0x69, 0x74, 0x68, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e,
+	0x77, 0x67, 0x70, 0x62, 0x2e, 0x4a, 0x77, 0x74, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69,
+	0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x78, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x20, 0x6a, 0x77, 0x74, 0x57, 0x69, 0x74, 0x68, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x91, 0x01, 0x0a, 0x1f, 0x4a, 0x77, 0x74, 0x55,
+	0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x33, 0x0a, 0x06, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67,
 	0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x1b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x6e, 0x64,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0xa9, 0x01, 0x0a, 0x1d, 0x52, 0x45, 0x53, 0x54, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x12, 0x34, 0x0a, 0x15, 0x70, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x76, 0x61, 0x6c, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x15, 0x70, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
-	0x6c, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x38, 0x0a, 0x17, 0x73, 0x6b, 0x69, 0x70, 0x50,
-	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x73, 0x6b, 0x69, 0x70, 0x50, 0x75,
-	0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x41, 0x0a, 0x15, 0x55, 0x52, 0x4c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x22, 0x41, 0x0a, 0x0a, 0x48, 0x54, 0x54, 0x50, 0x48, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x12, 0x33, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52,
-	0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x4b, 0x0a, 0x11, 0x54, 0x79, 0x70, 0x65, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
-	0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6e, 0x61,
-	0x6d, 0x65, 0x54, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x6e, 0x61,
-	0x6d, 0x65, 0x54, 0x6f, 0x22, 0xd3, 0x02, 0x0a, 0x12, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x74,
-	0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
-	0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x1a, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
-	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x70, 0x70,
-	0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1a, 0x64, 0x69, 0x73, 0x61, 0x62,
-	0x6c, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61,
-	0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x53, 0x0a, 0x16, 0x61, 0x72, 0x67,
-	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62,
-	0x2e, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26,
-	0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73,
-	0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x75, 0x6e, 0x65, 0x73, 0x63, 0x61,
-	0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4a, 0x73, 0x6f, 0x6e, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x75, 0x6e, 0x65, 0x73, 0x63, 0x61, 0x70, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4a, 0x73, 0x6f, 0x6e, 0x22, 0x47, 0x0a, 0x09, 0x54, 0x79,
-	0x70, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x79, 0x70, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x79, 0x70, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61,
-	0x6d, 0x65, 0x73, 0x22, 0x4b, 0x0a, 0x0f, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x12, 0x39, 0x0a, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x53,
+	0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x0d, 0x73, 0x69,
+	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x22, 0x81, 0x02, 0x0a, 0x30,
+	0x4a, 0x77, 0x74, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x33, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x06, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x39, 0x0a, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67,
+	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x77,
+	0x67, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x52, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x12, 0x5d, 0x0a, 0x1b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x52, 0x1b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22,
+	0xa9, 0x01, 0x0a, 0x1d, 0x52, 0x45, 0x53, 0x54, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x15, 0x70,
+	0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x69,
+	0x6c, 0x6c, 0x69, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x70, 0x6f, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x69, 0x6c, 0x6c, 0x69,
+	0x73, 0x12, 0x38, 0x0a, 0x17, 0x73, 0x6b, 0x69, 0x70, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x53, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x17, 0x73, 0x6b, 0x69, 0x70, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x41, 0x0a, 0x15, 0x55,
+	0x52, 0x4c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x41,
+	0x0a, 0x0a, 0x48, 0x54, 0x54, 0x50, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x06,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77,
+	0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x22, 0x4b, 0x0a, 0x11, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61,
 	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65,
-	0x22, 0xfa, 0x01, 0x0a, 0x15, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34,
-	0x0a, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x14, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65,
-	0x6e, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x61,
-	0x74, 0x68, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x50, 0x61, 0x74, 0x68, 0x12, 0x53, 0x0a, 0x13, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x21, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x13, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x6e,
-	0x61, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x54, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x54, 0x6f, 0x22, 0xcf, 0x02,
-	0x0a, 0x18, 0x57, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x47, 0x72, 0x61, 0x70, 0x68, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x03, 0x61, 0x70,
-	0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x41, 0x70, 0x69, 0x52, 0x03, 0x61,
-	0x70, 0x69, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x69, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x61, 0x70, 0x69, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x6e, 0x76, 0x69,
-	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x0e, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73,
-	0x12, 0x4a, 0x0a, 0x20, 0x64, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x6f, 0x75, 0x73, 0x6c, 0x79, 0x45,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x70, 0x68, 0x51, 0x4c, 0x45, 0x6e, 0x64, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x20, 0x64, 0x61, 0x6e, 0x67,
-	0x65, 0x72, 0x6f, 0x75, 0x73, 0x6c, 0x79, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x47, 0x72, 0x61,
-	0x70, 0x68, 0x51, 0x4c, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x61, 0x73, 0x68, 0x12, 0x3f, 0x0a, 0x0f,
-	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x64, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x0f, 0x65, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x64, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x20, 0x0a,
-	0x05, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x77,
-	0x67, 0x70, 0x62, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x52, 0x05, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x22,
-	0xe1, 0x01, 0x0a, 0x0f, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x46, 0x65, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x69, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x70, 0x69, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x28, 0x0a, 0x0f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
-	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x11, 0x63, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x4a, 0x53, 0x4f, 0x4e, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4a, 0x53, 0x4f, 0x4e,
-	0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x49, 0x6e, 0x74, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x10, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x49, 0x6e, 0x74, 0x53, 0x63, 0x61, 0x6c,
-	0x61, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x6c, 0x6f,
-	0x61, 0x74, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x6f, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x6f, 0x22, 0xd3,
+	0x02, 0x0a, 0x12, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x3e, 0x0a, 0x1a, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x1a, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x12, 0x53, 0x0a, 0x16, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x67, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x16, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x12, 0x32, 0x0a, 0x14, 0x75, 0x6e, 0x65, 0x73, 0x63, 0x61, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x4a, 0x73, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14,
+	0x75, 0x6e, 0x65, 0x73, 0x63, 0x61, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x4a, 0x73, 0x6f, 0x6e, 0x22, 0x47, 0x0a, 0x09, 0x54, 0x79, 0x70, 0x65, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x4b, 0x0a,
+	0x0f, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xfa, 0x01, 0x0a, 0x15, 0x41,
+	0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x77,
+	0x67, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x53,
+	0x0a, 0x13, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x77, 0x67,
+	0x70, 0x62, 0x2e, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x13,
+	0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x54, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x54, 0x6f, 0x22, 0xcf, 0x02, 0x0a, 0x18, 0x57, 0x75, 0x6e, 0x64,
+	0x65, 0x72, 0x47, 0x72, 0x61, 0x70, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x03, 0x61, 0x70, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x66,
+	0x69, 0x6e, 0x65, 0x64, 0x41, 0x70, 0x69, 0x52, 0x03, 0x61, 0x70, 0x69, 0x12, 0x14, 0x0a, 0x05,
+	0x61, 0x70, 0x69, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x69,
+	0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x6e, 0x76, 0x69,
+	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x4a, 0x0a, 0x20, 0x64, 0x61,
+	0x6e, 0x67, 0x65, 0x72, 0x6f, 0x75, 0x73, 0x6c, 0x79, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x47,
+	0x72, 0x61, 0x70, 0x68, 0x51, 0x4c, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x20, 0x64, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x6f, 0x75, 0x73, 0x6c,
+	0x79, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x70, 0x68, 0x51, 0x4c, 0x45, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x48, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x48, 0x61, 0x73, 0x68, 0x12, 0x3f, 0x0a, 0x0f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x46, 0x65,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x0f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x46,
+	0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x05, 0x68, 0x6f, 0x6f, 0x6b, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x48, 0x6f,
+	0x6f, 0x6b, 0x52, 0x05, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x22, 0xe1, 0x01, 0x0a, 0x0f, 0x45, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x61, 0x70, 0x69, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x61, 0x70, 0x69, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x11, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4a, 0x53, 0x4f,
+	0x4e, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4a, 0x53, 0x4f, 0x4e, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72,
+	0x73, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x49, 0x6e, 0x74, 0x53, 0x63,
+	0x61, 0x6c, 0x61, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x49, 0x6e, 0x74, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x73, 0x12, 0x2e, 0x0a,
 	0x12, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x53, 0x63, 0x61, 0x6c,
-	0x61, 0x72, 0x73, 0x22, 0x61, 0x0a, 0x21, 0x53, 0x33, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x55,
-	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70, 0x72, 0x65,
-	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6f, 0x73, 0x74, 0x55, 0x70,
-	0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x6f, 0x73, 0x74,
-	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x80, 0x03, 0x0a, 0x0f, 0x53, 0x33, 0x55, 0x70, 0x6c,
-	0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x34, 0x0a, 0x15, 0x72, 0x65,
-	0x71, 0x75, 0x69, 0x72, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x61, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x73, 0x22, 0x83, 0x01,
+	0x0a, 0x21, 0x53, 0x33, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70, 0x72, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6f, 0x73, 0x74, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x6f, 0x73, 0x74, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x22, 0xe1, 0x04, 0x0a, 0x0f, 0x53, 0x33, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x34, 0x0a, 0x15, 0x72, 0x65, 0x71, 0x75, 0x69,
 	0x72, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x3c, 0x0a, 0x19, 0x6d, 0x61, 0x78, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x55, 0x70,
-	0x6c, 0x6f, 0x61, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x19, 0x6d, 0x61, 0x78, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x55,
-	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x28,
-	0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x41, 0x6c, 0x6c, 0x6f,
-	0x77, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x6f,
-	0x77, 0x65, 0x64, 0x4d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4d, 0x69, 0x6d, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x15, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x46,
-	0x69, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x15, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65,
-	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x6d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4a, 0x53, 0x4f, 0x4e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x4a, 0x53, 0x4f, 0x4e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x3d, 0x0a, 0x05, 0x68, 0x6f,
-	0x6f, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x67, 0x70, 0x62,
-	0x2e, 0x53, 0x33, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x05, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x22, 0xb7, 0x04, 0x0a, 0x15, 0x53, 0x33,
-	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62,
-	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61,
-	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x12, 0x3d, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x49, 0x44, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62,
-	0x6c, 0x65, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x49, 0x44, 0x12,
-	0x45, 0x0a, 0x0f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b,
-	0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x41,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a,
+	0x19, 0x6d, 0x61, 0x78, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x19, 0x6d, 0x61, 0x78, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x6d,
+	0x61, 0x78, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x4d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x12, 0x34, 0x0a, 0x15, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x15, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x4a, 0x53, 0x4f, 0x4e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x12, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4a, 0x53, 0x4f,
+	0x4e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x3d, 0x0a, 0x05, 0x68, 0x6f, 0x6f, 0x6b, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x53, 0x33,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x48, 0x6f, 0x6f,
+	0x6b, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x05, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x64,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x26,
+	0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x73,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x44, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x12, 0x4d, 0x0a, 0x14, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x14, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x46,
+	0x69, 0x6c, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x22, 0xbe, 0x01, 0x0a, 0x13, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x20, 0x0a, 0x03, 0x66, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e,
+	0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x46, 0x69, 0x74, 0x52, 0x03,
+	0x66, 0x69, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x22, 0xec, 0x05, 0x0a, 0x15, 0x53, 0x33, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e,
 	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72,
-	0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x41, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70,
-	0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56,
-	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x0e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67,
-	0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x0e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x53,
-	0x53, 0x4c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x73, 0x65, 0x53, 0x53, 0x4c,
-	0x12, 0x57, 0x0a, 0x0e, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e,
-	0x53, 0x33, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x75, 0x70, 0x6c, 0x6f, 0x61,
-	0x64, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x1a, 0x58, 0x0a, 0x13, 0x55, 0x70, 0x6c,
-	0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x53, 0x33, 0x55, 0x70, 0x6c, 0x6f, 0x61,
-	0x64, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0xa9, 0x06, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x66, 0x69,
-	0x6e, 0x65, 0x64, 0x41, 0x70, 0x69, 0x12, 0x4b, 0x0a, 0x13, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x13,
-	0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x15, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x47, 0x72, 0x61,
-	0x70, 0x68, 0x71, 0x6c, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x15, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x70, 0x68, 0x71,
-	0x6c, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x0a, 0x6f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
-	0x77, 0x67, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
-	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x15, 0x69, 0x6e,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61,
-	0x6d, 0x65, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x69, 0x6e, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x73,
-	0x12, 0x45, 0x0a, 0x11, 0x63, 0x6f, 0x72, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77, 0x67,
-	0x70, 0x62, 0x2e, 0x43, 0x6f, 0x72, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x63, 0x6f, 0x72, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x51, 0x0a, 0x14, 0x61, 0x75, 0x74, 0x68, 0x65,
-	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x69,
-	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x14, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x51, 0x0a, 0x15, 0x73, 0x33,
-	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62,
-	0x2e, 0x53, 0x33, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x15, 0x73, 0x33, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a,
-	0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43,
+	0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12,
+	0x3d, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x49, 0x44, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
+	0x65, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x49, 0x44, 0x12, 0x45,
+	0x0a, 0x0f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43,
 	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69,
-	0x61, 0x62, 0x6c, 0x65, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x48, 0x6f, 0x73,
-	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x08, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f,
-	0x6b, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e,
-	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x39,
-	0x0a, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x33, 0x0a, 0x0b, 0x6e, 0x6f, 0x64,
-	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4f,
-	0x0a, 0x12, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x77, 0x67, 0x70,
-	0x62, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x65, 0x78, 0x70,
-	0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22,
-	0x2d, 0x0a, 0x19, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03,
-	0x6f, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x6f, 0x72, 0x6d, 0x22, 0x73,
-	0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x2f, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x61, 0x62, 0x6c, 0x65, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x43, 0x0a, 0x0e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70,
+	0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56,
+	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x0e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x53, 0x53,
+	0x4c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x73, 0x65, 0x53, 0x53, 0x4c, 0x12,
+	0x57, 0x0a, 0x0e, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x53,
+	0x33, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x77, 0x67, 0x70, 0x62,
+	0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4b, 0x69,
+	0x6e, 0x64, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x09, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x45, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x04, 0x68, 0x6f,
-	0x73, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x04, 0x70,
-	0x6f, 0x72, 0x74, 0x22, 0x4a, 0x0a, 0x17, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4c,
-	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f,
-	0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77,
-	0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22,
-	0x40, 0x0a, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x31,
-	0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65,
-	0x6c, 0x22, 0x7b, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69,
-	0x61, 0x62, 0x6c, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2f, 0x0a,
+	0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x0f, 0x63, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x1a, 0x58, 0x0a,
+	0x13, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x53, 0x33, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf0, 0x06, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72,
+	0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x41, 0x70, 0x69, 0x12, 0x4b, 0x0a, 0x13, 0x65, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x45,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x13, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x15, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x47, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x47, 0x72,
+	0x61, 0x70, 0x68, 0x71, 0x6c, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x2f, 0x0a,
+	0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x34,
+	0x0a, 0x15, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x69,
+	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x11, 0x63, 0x6f, 0x72, 0x73, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x72, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x63, 0x6f, 0x72, 0x73, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x51, 0x0a, 0x14, 0x61,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x77, 0x67, 0x70, 0x62,
+	0x2e, 0x41, 0x70, 0x69, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x14, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x51,
+	0x0a, 0x15, 0x73, 0x33, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x77, 0x67, 0x70, 0x62, 0x2e, 0x53, 0x33, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x15, 0x73, 0x33, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x47, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x48, 0x6f, 0x73, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67,
+	0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x48, 0x6f, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x08, 0x77, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77,
+	0x67, 0x70, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x73, 0x12, 0x39, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x77, 0x67, 0x70, 0x62,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x33, 0x0a,
+	0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x4f, 0x0a, 0x12, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x12, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x45, 0x0a, 0x0d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x77, 0x67, 0x70,
+	0x62, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x22, 0x2d, 0x0a, 0x19, 0x45, 0x78,
+	0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x72, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x6f, 0x72, 0x6d, 0x22, 0x73, 0x0a, 0x0f, 0x4c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x0a, 0x04,
+	0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70,
+	0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56,
+	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x2f, 0x0a,
 	0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67,
 	0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0xf9,
-	0x04, 0x0a, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x35,
-	0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x55, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x07, 0x6e, 0x6f,
-	0x64, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x41, 0x0a, 0x0d, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4e,
-	0x6f, 0x64, 0x65, 0x55, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77,
-	0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x0d, 0x70, 0x75, 0x62, 0x6c, 0x69,
-	0x63, 0x4e, 0x6f, 0x64, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x2d, 0x0a, 0x06, 0x6c, 0x69, 0x73, 0x74,
-	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x06, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x12, 0x29, 0x0a, 0x06, 0x6c, 0x6f, 0x67, 0x67, 0x65,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x4e,
-	0x6f, 0x64, 0x65, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x6c, 0x6f, 0x67, 0x67,
-	0x65, 0x72, 0x12, 0x42, 0x0a, 0x1c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e,
-	0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53,
-	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x45, 0x0a, 0x0e, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
-	0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4c, 0x69,
-	0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0e, 0x6c,
-	0x69, 0x73, 0x74, 0x65, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x45, 0x0a,
-	0x0f, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x72, 0x6c,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x4a,
+	0x0a, 0x17, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x0a, 0x04, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69,
+	0x61, 0x62, 0x6c, 0x65, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x40, 0x0a, 0x0b, 0x4e, 0x6f,
+	0x64, 0x65, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x31, 0x0a, 0x05, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72,
+	0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x7b, 0x0a, 0x11,
+	0x50, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x35, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52,
+	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f,
 	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61,
-	0x62, 0x6c, 0x65, 0x52, 0x0f, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x55, 0x72, 0x6c, 0x12, 0x4d, 0x0a, 0x13, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x48,
-	0x74, 0x74, 0x70, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x55, 0x72, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x62, 0x6c, 0x65, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0xb8, 0x05, 0x0a, 0x0b, 0x4e, 0x6f,
+	0x64, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x6e, 0x6f, 0x64,
+	0x65, 0x55, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70,
+	0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56,
+	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x55, 0x72, 0x6c,
+	0x12, 0x41, 0x0a, 0x0d, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4e, 0x6f, 0x64, 0x65, 0x55, 0x72,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69,
+	0x61, 0x62, 0x6c, 0x65, 0x52, 0x0d, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4e, 0x6f, 0x64, 0x65,
+	0x55, 0x72, 0x6c, 0x12, 0x2d, 0x0a, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x06, 0x6c, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x12, 0x29, 0x0a, 0x06, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x6f,
+	0x67, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x12, 0x42, 0x0a,
+	0x1c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x1c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x12, 0x45, 0x0a, 0x0e, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x77, 0x67, 0x70, 0x62,
+	0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65,
+	0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0e, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x45, 0x0a, 0x0f, 0x6e, 0x6f, 0x64, 0x65,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x13,
-	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x48, 0x74, 0x74, 0x70, 0x50, 0x72, 0x6f, 0x78, 0x79,
-	0x55, 0x72, 0x6c, 0x12, 0x3c, 0x0a, 0x0d, 0x6f, 0x70, 0x65, 0x6e, 0x54, 0x65, 0x6c, 0x65, 0x6d,
-	0x65, 0x74, 0x72, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x77, 0x67, 0x70,
-	0x62, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x52, 0x0d, 0x6f, 0x70, 0x65, 0x6e, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72,
-	0x79, 0x12, 0x37, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f,
-	0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a,
-	0x70, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x22, 0x8c, 0x02, 0x0a, 0x10, 0x54,
-	0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x35, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x07, 0x65,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x4f, 0x0a, 0x14, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74,
-	0x65, 0x72, 0x48, 0x74, 0x74, 0x70, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x0f,
+	0x6e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x72, 0x6c, 0x12,
+	0x4d, 0x0a, 0x13, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x48, 0x74, 0x74, 0x70, 0x50, 0x72,
+	0x6f, 0x78, 0x79, 0x55, 0x72, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77,
+	0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x13, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x48, 0x74, 0x74, 0x70, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x55, 0x72, 0x6c, 0x12, 0x3c,
+	0x0a, 0x0d, 0x6f, 0x70, 0x65, 0x6e, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x6c,
+	0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d, 0x6f,
+	0x70, 0x65, 0x6e, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x37, 0x0a, 0x0a,
+	0x70, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65,
+	0x75, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x6d, 0x65,
+	0x74, 0x68, 0x65, 0x75, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x67,
+	0x70, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0c, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x22, 0xee, 0x01, 0x0a, 0x13, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x0a, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x77, 0x67, 0x70,
+	0x62, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4b, 0x69,
+	0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x39, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67,
+	0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x35, 0x0a, 0x07, 0x6e, 0x61, 0x74, 0x73, 0x55, 0x72, 0x6c, 0x18, 0x03,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
 	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
-	0x65, 0x52, 0x14, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x48, 0x74, 0x74, 0x70, 0x45,
-	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c,
-	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72,
-	0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x72, 0x12, 0x39,
-	0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x09,
-	0x61, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x42, 0x0a, 0x0d, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x31, 0x0a, 0x05, 0x6c, 0x65,
-	0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62,
-	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61,
-	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0xa6, 0x01,
-	0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x39, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52,
-	0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x2d, 0x0a, 0x06, 0x6c, 0x69,
-	0x73, 0x74, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x77, 0x67, 0x70,
-	0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x12, 0x2b, 0x0a, 0x06, 0x6c, 0x6f, 0x67,
-	0x67, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x77, 0x67, 0x70, 0x62,
-	0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x06,
-	0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x22, 0x79, 0x0a, 0x14, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
-	0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x31,
-	0x0a, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x56,
-	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65,
-	0x72, 0x22, 0xd5, 0x01, 0x0a, 0x0f, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x56, 0x65, 0x72,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f,
-	0x6f, 0x6b, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04,
-	0x6b, 0x69, 0x6e, 0x64, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02,
+	0x65, 0x52, 0x07, 0x6e, 0x61, 0x74, 0x73, 0x55, 0x72, 0x6c, 0x12, 0x39, 0x0a, 0x09, 0x6e, 0x61,
+	0x74, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x09, 0x6e, 0x61, 0x74, 0x73,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x8c, 0x02, 0x0a, 0x10, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65,
+	0x74, 0x72, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67,
+	0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x12, 0x4f, 0x0a, 0x14, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x48, 0x74, 0x74,
+	0x70, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x14, 0x65, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x48, 0x74, 0x74, 0x70, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x12, 0x35, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
+	0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x09, 0x61, 0x75, 0x74,
+	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77,
+	0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x42, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4c, 0x6f,
+	0x67, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x31, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
 	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
-	0x65, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x15, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x15, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x94, 0x02, 0x0a, 0x11, 0x43, 0x6f,
-	0x72, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x43, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69,
-	0x61, 0x62, 0x6c, 0x65, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4f, 0x72, 0x69,
-	0x67, 0x69, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4d,
-	0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x6c,
-	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x26, 0x0a, 0x0e,
-	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x48,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78,
-	0x70, 0x6f, 0x73, 0x65, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06,
-	0x6d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x61,
-	0x78, 0x41, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x72, 0x65,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10,
-	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73,
-	0x22, 0xc0, 0x02, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x6b, 0x69,
-	0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72,
-	0x69, 0x61, 0x62, 0x6c, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12,
-	0x34, 0x0a, 0x15, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
-	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15,
-	0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x17, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
-	0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
-	0x65, 0x6e, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x48, 0x0a, 0x1f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61,
-	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f,
-	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x38, 0x0a, 0x17, 0x70, 0x6c, 0x61,
-	0x63, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x70, 0x6c, 0x61, 0x63,
-	0x65, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x22, 0x80, 0x02, 0x0a, 0x09, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x28, 0x0a, 0x03, 0x73,
-	0x64, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e,
-	0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x03, 0x73, 0x64, 0x6b, 0x12, 0x34, 0x0a, 0x09, 0x77, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x63,
-	0x74, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e,
-	0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x09, 0x77, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x63, 0x74, 0x6c, 0x12, 0x2a, 0x0a, 0x04, 0x6e,
-	0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x77, 0x67, 0x70, 0x62,
-	0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x02, 0x6f, 0x73, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64,
-	0x49, 0x6e, 0x66, 0x6f, 0x4f, 0x53, 0x52, 0x02, 0x6f, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x74,
-	0x61, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x67, 0x70, 0x62,
-	0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
-	0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0x2c, 0x0a, 0x10, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49,
-	0x6e, 0x66, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x85, 0x01, 0x0a, 0x0b, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e,
-	0x66, 0x6f, 0x4f, 0x53, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74,
-	0x66, 0x6f, 0x72, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74,
-	0x66, 0x6f, 0x72, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x22, 0xea, 0x01, 0x0a,
-	0x0e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
-	0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x70, 0x69, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x70, 0x69, 0x73, 0x12, 0x28, 0x0a,
-	0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x3c, 0x0a,
-	0x19, 0x68, 0x61, 0x73, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x19, 0x68, 0x61, 0x73, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x11, 0x68,
-	0x61, 0x73, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x68, 0x61, 0x73, 0x55, 0x70, 0x6c, 0x6f, 0x61,
-	0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2a, 0x58, 0x0a, 0x08, 0x4c, 0x6f, 0x67,
-	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x52, 0x41, 0x43, 0x45, 0x10, 0x00,
-	0x12, 0x09, 0x0a, 0x05, 0x44, 0x45, 0x42, 0x55, 0x47, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x49,
-	0x4e, 0x46, 0x4f, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x03,
-	0x12, 0x0b, 0x0a, 0x07, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x09, 0x0a,
-	0x05, 0x50, 0x41, 0x4e, 0x49, 0x43, 0x10, 0x05, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x41, 0x54, 0x41,
-	0x4c, 0x10, 0x06, 0x2a, 0x57, 0x0a, 0x10, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x65, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0xbf, 0x03, 0x0a, 0x0d, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x09, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x2d, 0x0a, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x06, 0x6c, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x12, 0x2b, 0x0a, 0x06, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x6c, 0x6f, 0x67, 0x67, 0x65,
+	0x72, 0x12, 0x34, 0x0a, 0x09, 0x77, 0x61, 0x73, 0x6d, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x73, 0x6d,
+	0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x09, 0x77, 0x61,
+	0x73, 0x6d, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x34, 0x0a, 0x09, 0x67, 0x72, 0x70, 0x63, 0x48,
+	0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x77, 0x67, 0x70,
+	0x62, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x09, 0x67, 0x72, 0x70, 0x63, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x3c, 0x0a,
+	0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x6f, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x6f, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x34, 0x0a, 0x0c, 0x68,
+	0x6f, 0x6f, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x0c, 0x68, 0x6f, 0x6f, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65,
+	0x73, 0x12, 0x37, 0x0a, 0x0a, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x41, 0x73, 0x79,
+	0x6e, 0x63, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a,
+	0x61, 0x73, 0x79, 0x6e, 0x63, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x22, 0xb6, 0x01, 0x0a, 0x11, 0x41,
+	0x73, 0x79, 0x6e, 0x63, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x64, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x64, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67,
+	0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x22, 0xd7, 0x01, 0x0a, 0x0a, 0x48, 0x6f, 0x6f, 0x6b, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x4d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x4d, 0x73, 0x12, 0x23, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x0b, 0x66, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
+	0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x66, 0x61,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0xd1, 0x02,
+	0x0a, 0x16, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x6f,
+	0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3b, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x55, 0x72, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77,
+	0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x55, 0x72, 0x6c, 0x73, 0x12, 0x3e, 0x0a, 0x0d, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x77,
+	0x67, 0x70, 0x62, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x69, 0x6e, 0x67, 0x12, 0x3e, 0x0a, 0x1a, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1a, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x46, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x46,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0f, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x12, 0x2e, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x73, 0x22, 0x63, 0x0a, 0x10, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x3b, 0x0a, 0x0a, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x55, 0x72, 0x6c, 0x73, 0x22, 0x5b, 0x0a, 0x10, 0x47, 0x52, 0x50, 0x43, 0x48, 0x6f,
+	0x6f, 0x6b, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67,
+	0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03,
+	0x74, 0x6c, 0x73, 0x22, 0xc5, 0x01, 0x0a, 0x10, 0x57, 0x61, 0x73, 0x6d, 0x48, 0x6f, 0x6f, 0x6b,
+	0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3b, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77,
+	0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x4d, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x62, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x4d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x4d, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x68, 0x6f, 0x6f, 0x6b, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x67, 0x70, 0x62,
+	0x2e, 0x57, 0x61, 0x73, 0x6d, 0x48, 0x6f, 0x6f, 0x6b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52,
+	0x0a, 0x68, 0x6f, 0x6f, 0x6b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x22, 0x64, 0x0a, 0x0e, 0x57,
+	0x61, 0x73, 0x6d, 0x48, 0x6f, 0x6f, 0x6b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x6f,
+	0x6b, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x62,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x4d, 0x62, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d,
+	0x73, 0x22, 0xe9, 0x01, 0x0a, 0x14, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x31, 0x0a, 0x08, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x77,
+	0x67, 0x70, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x52, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x41,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x8d, 0x02,
+	0x0a, 0x19, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x2d, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77,
+	0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x33,
+	0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x06, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x13, 0x6d,
+	0x61, 0x78, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x22, 0xbc, 0x03,
+	0x0a, 0x0f, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x12, 0x2d, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x19, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x12, 0x33, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x06, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x34, 0x0a, 0x15, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x50,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x49, 0x0a, 0x11, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x11, 0x61,
+	0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73,
+	0x12, 0x28, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x10, 0x74, 0x6f,
+	0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x72, 0x65, 0x70,
+	0x6c, 0x61, 0x79, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x94, 0x02, 0x0a,
+	0x11, 0x43, 0x6f, 0x72, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x67, 0x70,
+	0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56,
+	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12,
+	0x26, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x6f, 0x73,
+	0x65, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0e, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x6d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x6d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x73, 0x22, 0xc0, 0x02, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x33, 0x0a,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x77, 0x67,
+	0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x12, 0x34, 0x0a, 0x15, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x56, 0x61, 0x72, 0x69,
+	0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x15, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
+	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x17, 0x65, 0x6e, 0x76, 0x69,
+	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x65, 0x6e, 0x76, 0x69, 0x72,
+	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x48, 0x0a, 0x1f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1f, 0x65, 0x6e, 0x76,
+	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x38, 0x0a, 0x17,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x56, 0x61, 0x72, 0x69, 0x61,
+	0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x80, 0x02, 0x0a, 0x09, 0x42, 0x75, 0x69, 0x6c, 0x64,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x28,
+	0x0a, 0x03, 0x73, 0x64, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x77, 0x67,
+	0x70, 0x62, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x03, 0x73, 0x64, 0x6b, 0x12, 0x34, 0x0a, 0x09, 0x77, 0x75, 0x6e, 0x64,
+	0x65, 0x72, 0x63, 0x74, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x77, 0x67,
+	0x70, 0x62, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x77, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x63, 0x74, 0x6c, 0x12, 0x2a,
+	0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x77,
+	0x67, 0x70, 0x62, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x02, 0x6f, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x67, 0x70, 0x62, 0x2e, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x4f, 0x53, 0x52, 0x02, 0x6f, 0x73, 0x12, 0x2a, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77,
+	0x67, 0x70, 0x62, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0x2c, 0x0a, 0x10, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x85, 0x01, 0x0a, 0x0b, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x49, 0x6e, 0x66, 0x6f, 0x4f, 0x53, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x63, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x22,
+	0xea, 0x01, 0x0a, 0x0e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x70, 0x69, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x70, 0x69, 0x73,
+	0x12, 0x28, 0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73,
+	0x12, 0x3c, 0x0a, 0x19, 0x68, 0x61, 0x73, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x19, 0x68, 0x61, 0x73, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x2c,
+	0x0a, 0x11, 0x68, 0x61, 0x73, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x68, 0x61, 0x73, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2a, 0x58, 0x0a, 0x08,
+	0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x52, 0x41, 0x43,
+	0x45, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x45, 0x42, 0x55, 0x47, 0x10, 0x01, 0x12, 0x08,
+	0x0a, 0x04, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x04,
+	0x12, 0x09, 0x0a, 0x05, 0x50, 0x41, 0x4e, 0x49, 0x43, 0x10, 0x05, 0x12, 0x09, 0x0a, 0x05, 0x46,
+	0x41, 0x54, 0x41, 0x4c, 0x10, 0x06, 0x2a, 0xa9, 0x01, 0x0a, 0x13, 0x52, 0x6f, 0x6c, 0x65, 0x4d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1a,
+	0x0a, 0x16, 0x52, 0x6f, 0x6c, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x73, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x52, 0x6f,
+	0x6c, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x6f, 0x6c, 0x65,
+	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x44, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x52, 0x6f, 0x6c, 0x65, 0x4d, 0x61, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x6f, 0x6c, 0x65, 0x4d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x54, 0x65, 0x61, 0x6d,
+	0x10, 0x04, 0x2a, 0x85, 0x01, 0x0a, 0x10, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69,
 	0x64, 0x65, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x75, 0x74, 0x68, 0x50,
 	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x10, 0x00, 0x12,
 	0x14, 0x0a, 0x10, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4f,
 	0x49, 0x44, 0x43, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x30, 0x10, 0x02, 0x2a, 0x41, 0x0a, 0x18,
-	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x45, 0x4e, 0x47, 0x49,
-	0x4e, 0x45, 0x5f, 0x47, 0x52, 0x41, 0x50, 0x48, 0x51, 0x4c, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d,
-	0x45, 0x4e, 0x47, 0x49, 0x4e, 0x45, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x4a, 0x53, 0x10, 0x01, 0x2a,
-	0x44, 0x0a, 0x1d, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64,
-	0x12, 0x23, 0x0a, 0x1f, 0x47, 0x45, 0x54, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x53,
-	0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x10, 0x00, 0x2a, 0x47, 0x0a, 0x12, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x56,
-	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x08, 0x0a, 0x04, 0x55,
-	0x55, 0x49, 0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x49,
-	0x4d, 0x45, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x4e, 0x56, 0x49, 0x52, 0x4f, 0x4e, 0x4d,
-	0x45, 0x4e, 0x54, 0x5f, 0x56, 0x41, 0x52, 0x49, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x02, 0x2a, 0x1e,
-	0x0a, 0x08, 0x48, 0x6f, 0x6f, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x48, 0x54,
-	0x54, 0x50, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x00, 0x2a, 0xbc,
-	0x02, 0x0a, 0x09, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06,
-	0x49, 0x53, 0x53, 0x55, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x52, 0x4f, 0x56,
-	0x49, 0x44, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x42, 0x4a, 0x45, 0x43,
-	0x54, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x53, 0x45, 0x52, 0x49, 0x44, 0x10, 0x01, 0x12,
-	0x08, 0x0a, 0x04, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x47, 0x49, 0x56,
-	0x45, 0x4e, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x41, 0x4d,
-	0x49, 0x4c, 0x59, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x49,
-	0x44, 0x44, 0x4c, 0x45, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x05, 0x12, 0x0c, 0x0a, 0x08, 0x4e,
-	0x49, 0x43, 0x4b, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x06, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x52, 0x45,
-	0x46, 0x45, 0x52, 0x52, 0x45, 0x44, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x10,
-	0x07, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x08, 0x12, 0x0b,
-	0x0a, 0x07, 0x50, 0x49, 0x43, 0x54, 0x55, 0x52, 0x45, 0x10, 0x09, 0x12, 0x0b, 0x0a, 0x07, 0x57,
-	0x45, 0x42, 0x53, 0x49, 0x54, 0x45, 0x10, 0x0a, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x4d, 0x41, 0x49,
-	0x4c, 0x10, 0x0b, 0x12, 0x12, 0x0a, 0x0e, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x5f, 0x56, 0x45, 0x52,
-	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x0c, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x45, 0x4e, 0x44, 0x45,
-	0x52, 0x10, 0x0d, 0x12, 0x0e, 0x0a, 0x0a, 0x42, 0x49, 0x52, 0x54, 0x48, 0x5f, 0x44, 0x41, 0x54,
-	0x45, 0x10, 0x0e, 0x12, 0x0d, 0x0a, 0x09, 0x5a, 0x4f, 0x4e, 0x45, 0x5f, 0x49, 0x4e, 0x46, 0x4f,
-	0x10, 0x0f, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x45, 0x10, 0x10, 0x12, 0x0c,
-	0x0a, 0x08, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x11, 0x12, 0x0b, 0x0a, 0x06,
-	0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x10, 0xe7, 0x07, 0x1a, 0x02, 0x10, 0x01, 0x2a, 0x41, 0x0a,
-	0x09, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54,
-	0x52, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x4e, 0x54, 0x10, 0x01, 0x12,
-	0x09, 0x0a, 0x05, 0x46, 0x4c, 0x4f, 0x41, 0x54, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x42, 0x4f,
-	0x4f, 0x4c, 0x45, 0x41, 0x4e, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4e, 0x59, 0x10, 0x04,
-	0x2a, 0x47, 0x0a, 0x0d, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x00, 0x12, 0x09,
-	0x0a, 0x05, 0x51, 0x55, 0x45, 0x52, 0x59, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x55, 0x54,
-	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x55, 0x42, 0x53, 0x43,
-	0x52, 0x49, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x2a, 0x8e, 0x01, 0x0a, 0x0e, 0x44, 0x61,
-	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x0a, 0x0a, 0x06,
-	0x53, 0x54, 0x41, 0x54, 0x49, 0x43, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x52, 0x45, 0x53, 0x54,
-	0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x47, 0x52, 0x41, 0x50, 0x48, 0x51, 0x4c, 0x10, 0x02, 0x12,
-	0x0e, 0x0a, 0x0a, 0x50, 0x4f, 0x53, 0x54, 0x47, 0x52, 0x45, 0x53, 0x51, 0x4c, 0x10, 0x03, 0x12,
-	0x09, 0x0a, 0x05, 0x4d, 0x59, 0x53, 0x51, 0x4c, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x51,
-	0x4c, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x4f, 0x4e,
-	0x47, 0x4f, 0x44, 0x42, 0x10, 0x06, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x51, 0x4c, 0x49, 0x54, 0x45,
-	0x10, 0x07, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x52, 0x49, 0x53, 0x4d, 0x41, 0x10, 0x08, 0x12, 0x0a,
-	0x0a, 0x06, 0x4e, 0x41, 0x54, 0x53, 0x4b, 0x56, 0x10, 0x09, 0x2a, 0xe0, 0x01, 0x0a, 0x0f, 0x4e,
-	0x61, 0x74, 0x73, 0x4b, 0x76, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e,
-	0x0a, 0x0a, 0x4e, 0x41, 0x54, 0x53, 0x4b, 0x56, 0x5f, 0x47, 0x45, 0x54, 0x10, 0x00, 0x12, 0x16,
-	0x0a, 0x12, 0x4e, 0x41, 0x54, 0x53, 0x4b, 0x56, 0x5f, 0x47, 0x45, 0x54, 0x52, 0x45, 0x56, 0x49,
-	0x53, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x41, 0x54, 0x53, 0x4b, 0x56,
-	0x5f, 0x4b, 0x45, 0x59, 0x53, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x41, 0x54, 0x53, 0x4b,
-	0x56, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x4e,
-	0x41, 0x54, 0x53, 0x4b, 0x56, 0x5f, 0x50, 0x55, 0x54, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x4e,
-	0x41, 0x54, 0x53, 0x4b, 0x56, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x05, 0x12, 0x11,
-	0x0a, 0x0d, 0x4e, 0x41, 0x54, 0x53, 0x4b, 0x56, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10,
-	0x06, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x41, 0x54, 0x53, 0x4b, 0x56, 0x5f, 0x44, 0x45, 0x4c, 0x45,
-	0x54, 0x45, 0x10, 0x07, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x41, 0x54, 0x53, 0x4b, 0x56, 0x5f, 0x50,
-	0x55, 0x52, 0x47, 0x45, 0x10, 0x08, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x41, 0x54, 0x53, 0x4b, 0x56,
-	0x5f, 0x57, 0x41, 0x54, 0x43, 0x48, 0x10, 0x09, 0x12, 0x13, 0x0a, 0x0f, 0x4e, 0x41, 0x54, 0x53,
-	0x4b, 0x56, 0x5f, 0x57, 0x41, 0x54, 0x43, 0x48, 0x41, 0x4c, 0x4c, 0x10, 0x0a, 0x2a, 0x71, 0x0a,
-	0x1a, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x19, 0x55,
-	0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x57, 0x54, 0x10, 0x00, 0x12, 0x34, 0x0a, 0x30, 0x55, 0x70,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x30, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12,
+	0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4f, 0x41, 0x75, 0x74,
+	0x68, 0x32, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x53, 0x41, 0x4d, 0x4c, 0x10, 0x04, 0x2a, 0x41, 0x0a, 0x18, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x45, 0x4e, 0x47, 0x49, 0x4e, 0x45,
+	0x5f, 0x47, 0x52, 0x41, 0x50, 0x48, 0x51, 0x4c, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x4e,
+	0x47, 0x49, 0x4e, 0x45, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x4a, 0x53, 0x10, 0x01, 0x2a, 0x44, 0x0a,
+	0x1d, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x23,
+	0x0a, 0x1f, 0x47, 0x45, 0x54, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x4c,
+	0x56, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x10, 0x00, 0x2a, 0x47, 0x0a, 0x12, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x56, 0x61, 0x72,
+	0x69, 0x61, 0x62, 0x6c, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x55, 0x49,
+	0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45,
+	0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x4e, 0x56, 0x49, 0x52, 0x4f, 0x4e, 0x4d, 0x45, 0x4e,
+	0x54, 0x5f, 0x56, 0x41, 0x52, 0x49, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x02, 0x2a, 0x51, 0x0a, 0x08,
+	0x48, 0x6f, 0x6f, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x48, 0x54, 0x54, 0x50,
+	0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13,
+	0x48, 0x54, 0x54, 0x50, 0x5f, 0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e, 0x5f, 0x52, 0x45, 0x51, 0x55,
+	0x45, 0x53, 0x54, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x48, 0x54, 0x54, 0x50, 0x5f, 0x4f, 0x52,
+	0x49, 0x47, 0x49, 0x4e, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10, 0x02, 0x2a,
+	0xa5, 0x01, 0x0a, 0x14, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x6f,
+	0x6f, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x78, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x6f, 0x6b, 0x53, 0x65, 0x74, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x6f, 0x6b, 0x53, 0x65, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x48, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x10, 0x02, 0x12, 0x16,
+	0x0a, 0x12, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x6f, 0x6b,
+	0x53, 0x6b, 0x69, 0x70, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x6f, 0x6b, 0x53, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x10, 0x04, 0x2a, 0xbc, 0x02, 0x0a, 0x09, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x49, 0x53, 0x53, 0x55, 0x45, 0x52, 0x10,
+	0x00, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x10, 0x00, 0x12,
+	0x0b, 0x0a, 0x07, 0x53, 0x55, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06,
+	0x55, 0x53, 0x45, 0x52, 0x49, 0x44, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x41, 0x4d, 0x45,
+	0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x47, 0x49, 0x56, 0x45, 0x4e, 0x5f, 0x4e, 0x41, 0x4d, 0x45,
+	0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x5f, 0x4e, 0x41, 0x4d,
+	0x45, 0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x49, 0x44, 0x44, 0x4c, 0x45, 0x5f, 0x4e, 0x41,
+	0x4d, 0x45, 0x10, 0x05, 0x12, 0x0c, 0x0a, 0x08, 0x4e, 0x49, 0x43, 0x4b, 0x4e, 0x41, 0x4d, 0x45,
+	0x10, 0x06, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x52, 0x45, 0x46, 0x45, 0x52, 0x52, 0x45, 0x44, 0x5f,
+	0x55, 0x53, 0x45, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x07, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x52,
+	0x4f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x08, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x49, 0x43, 0x54, 0x55,
+	0x52, 0x45, 0x10, 0x09, 0x12, 0x0b, 0x0a, 0x07, 0x57, 0x45, 0x42, 0x53, 0x49, 0x54, 0x45, 0x10,
+	0x0a, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x10, 0x0b, 0x12, 0x12, 0x0a, 0x0e,
+	0x45, 0x4d, 0x41, 0x49, 0x4c, 0x5f, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x0c,
+	0x12, 0x0a, 0x0a, 0x06, 0x47, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x10, 0x0d, 0x12, 0x0e, 0x0a, 0x0a,
+	0x42, 0x49, 0x52, 0x54, 0x48, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x10, 0x0e, 0x12, 0x0d, 0x0a, 0x09,
+	0x5a, 0x4f, 0x4e, 0x45, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x0f, 0x12, 0x0a, 0x0a, 0x06, 0x4c,
+	0x4f, 0x43, 0x41, 0x4c, 0x45, 0x10, 0x10, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x4f, 0x43, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x10, 0x11, 0x12, 0x0b, 0x0a, 0x06, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x10,
+	0xe7, 0x07, 0x1a, 0x02, 0x10, 0x01, 0x2a, 0x41, 0x0a, 0x09, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12,
+	0x07, 0x0a, 0x03, 0x49, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x4c, 0x4f, 0x41,
+	0x54, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x42, 0x4f, 0x4f, 0x4c, 0x45, 0x41, 0x4e, 0x10, 0x03,
+	0x12, 0x07, 0x0a, 0x03, 0x41, 0x4e, 0x59, 0x10, 0x04, 0x2a, 0x47, 0x0a, 0x0d, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x51, 0x55, 0x45, 0x52, 0x59,
+	0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x55, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02,
+	0x12, 0x10, 0x0a, 0x0c, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x49, 0x4f, 0x4e,
+	0x10, 0x03, 0x2a, 0xa2, 0x01, 0x0a, 0x0e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x41, 0x54, 0x49, 0x43, 0x10,
+	0x00, 0x12, 0x08, 0x0a, 0x04, 0x52, 0x45, 0x53, 0x54, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x47,
+	0x52, 0x41, 0x50, 0x48, 0x51, 0x4c, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x4f, 0x53, 0x54,
+	0x47, 0x52, 0x45, 0x53, 0x51, 0x4c, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x59, 0x53, 0x51,
+	0x4c, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x51, 0x4c, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52,
+	0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x4f, 0x4e, 0x47, 0x4f, 0x44, 0x42, 0x10, 0x06, 0x12,
+	0x0a, 0x0a, 0x06, 0x53, 0x51, 0x4c, 0x49, 0x54, 0x45, 0x10, 0x07, 0x12, 0x0a, 0x0a, 0x06, 0x50,
+	0x52, 0x49, 0x53, 0x4d, 0x41, 0x10, 0x08, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x41, 0x54, 0x53, 0x4b,
+	0x56, 0x10, 0x09, 0x12, 0x12, 0x0a, 0x0e, 0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x53, 0x10, 0x0a, 0x2a, 0xe0, 0x01, 0x0a, 0x0f, 0x4e, 0x61, 0x74, 0x73,
+	0x4b, 0x76, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x0a, 0x4e,
+	0x41, 0x54, 0x53, 0x4b, 0x56, 0x5f, 0x47, 0x45, 0x54, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x4e,
+	0x41, 0x54, 0x53, 0x4b, 0x56, 0x5f, 0x47, 0x45, 0x54, 0x52, 0x45, 0x56, 0x49, 0x53, 0x49, 0x4f,
+	0x4e, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x41, 0x54, 0x53, 0x4b, 0x56, 0x5f, 0x4b, 0x45,
+	0x59, 0x53, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x41, 0x54, 0x53, 0x4b, 0x56, 0x5f, 0x48,
+	0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x41, 0x54, 0x53,
+	0x4b, 0x56, 0x5f, 0x50, 0x55, 0x54, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x41, 0x54, 0x53,
+	0x4b, 0x56, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x4e,
+	0x41, 0x54, 0x53, 0x4b, 0x56, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x06, 0x12, 0x11,
+	0x0a, 0x0d, 0x4e, 0x41, 0x54, 0x53, 0x4b, 0x56, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10,
+	0x07, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x41, 0x54, 0x53, 0x4b, 0x56, 0x5f, 0x50, 0x55, 0x52, 0x47,
+	0x45, 0x10, 0x08, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x41, 0x54, 0x53, 0x4b, 0x56, 0x5f, 0x57, 0x41,
+	0x54, 0x43, 0x48, 0x10, 0x09, 0x12, 0x13, 0x0a, 0x0f, 0x4e, 0x41, 0x54, 0x53, 0x4b, 0x56, 0x5f,
+	0x57, 0x41, 0x54, 0x43, 0x48, 0x41, 0x4c, 0x4c, 0x10, 0x0a, 0x2a, 0x71, 0x0a, 0x1a, 0x55, 0x70,
 	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x57, 0x54, 0x57, 0x69, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x10, 0x01,
-	0x2a, 0x27, 0x0a, 0x0d, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x68,
-	0x6f, 0x64, 0x48, 0x53, 0x32, 0x35, 0x36, 0x10, 0x00, 0x2a, 0x41, 0x0a, 0x0a, 0x48, 0x54, 0x54,
-	0x50, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x45, 0x54, 0x10, 0x00,
-	0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f, 0x53, 0x54, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x55,
-	0x54, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x03, 0x12,
-	0x0b, 0x0a, 0x07, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x04, 0x2a, 0x36, 0x0a, 0x0e,
-	0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x10,
-	0x0a, 0x0c, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x10, 0x00,
-	0x12, 0x12, 0x0a, 0x0e, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45,
-	0x4e, 0x54, 0x10, 0x01, 0x2a, 0x82, 0x01, 0x0a, 0x1b, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x41,
-	0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10,
-	0x00, 0x12, 0x24, 0x0a, 0x20, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x41, 0x52, 0x47, 0x55,
-	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x53, 0x5f, 0x47, 0x52, 0x41, 0x50, 0x48, 0x51, 0x4c, 0x5f,
-	0x56, 0x41, 0x4c, 0x55, 0x45, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x52, 0x45, 0x4e, 0x44, 0x45,
-	0x52, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x53, 0x5f, 0x41, 0x52,
-	0x52, 0x41, 0x59, 0x5f, 0x43, 0x53, 0x56, 0x10, 0x02, 0x2a, 0x26, 0x0a, 0x13, 0x57, 0x65, 0x62,
-	0x68, 0x6f, 0x6f, 0x6b, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x4b, 0x69, 0x6e, 0x64,
-	0x12, 0x0f, 0x0a, 0x0b, 0x48, 0x4d, 0x41, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10,
-	0x00, 0x2a, 0x86, 0x01, 0x0a, 0x19, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12,
-	0x21, 0x0a, 0x1d, 0x53, 0x54, 0x41, 0x54, 0x49, 0x43, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47,
-	0x55, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x56, 0x41, 0x52, 0x49, 0x41, 0x42, 0x4c, 0x45,
-	0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x4e, 0x56, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47,
-	0x55, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x56, 0x41, 0x52, 0x49, 0x41, 0x42, 0x4c, 0x45,
-	0x10, 0x01, 0x12, 0x26, 0x0a, 0x22, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x48, 0x4f, 0x4c, 0x44, 0x45,
-	0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x56, 0x41, 0x52, 0x49, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x02, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x67,
-	0x72, 0x61, 0x70, 0x68, 0x2f, 0x77, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x67, 0x72, 0x61, 0x70, 0x68,
-	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x77, 0x67, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x19, 0x55, 0x70, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4a, 0x57, 0x54, 0x10, 0x00, 0x12, 0x34, 0x0a, 0x30, 0x55, 0x70, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4a, 0x57, 0x54, 0x57, 0x69, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x10, 0x01, 0x2a, 0x27, 0x0a,
+	0x0d, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x16,
+	0x0a, 0x12, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x48,
+	0x53, 0x32, 0x35, 0x36, 0x10, 0x00, 0x2a, 0x41, 0x0a, 0x0a, 0x48, 0x54, 0x54, 0x50, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x45, 0x54, 0x10, 0x00, 0x12, 0x08, 0x0a,
+	0x04, 0x50, 0x4f, 0x53, 0x54, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x55, 0x54, 0x10, 0x02,
+	0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07,
+	0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x04, 0x2a, 0x36, 0x0a, 0x0e, 0x41, 0x72, 0x67,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x4f,
+	0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a,
+	0x0e, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x10,
+	0x01, 0x2a, 0x82, 0x01, 0x0a, 0x1b, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x41, 0x52, 0x47, 0x55,
+	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x24,
+	0x0a, 0x20, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e,
+	0x54, 0x5f, 0x41, 0x53, 0x5f, 0x47, 0x52, 0x41, 0x50, 0x48, 0x51, 0x4c, 0x5f, 0x56, 0x41, 0x4c,
+	0x55, 0x45, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x41,
+	0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x53, 0x5f, 0x41, 0x52, 0x52, 0x41, 0x59,
+	0x5f, 0x43, 0x53, 0x56, 0x10, 0x02, 0x2a, 0x44, 0x0a, 0x08, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x46,
+	0x69, 0x74, 0x12, 0x13, 0x0a, 0x0f, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x46, 0x69, 0x74, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x46, 0x69, 0x74, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x46, 0x69, 0x74, 0x46, 0x69, 0x6c, 0x6c, 0x10, 0x02, 0x2a, 0x64, 0x0a, 0x0b,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x17, 0x0a, 0x13, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x61, 0x6c, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x4a, 0x50, 0x45, 0x47, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x50, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x13, 0x0a,
+	0x0f, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x57, 0x65, 0x62, 0x50,
+	0x10, 0x03, 0x2a, 0x6e, 0x0a, 0x11, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x13, 0x0a, 0x0f, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x33, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63,
+	0x61, 0x6c, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x47, 0x43, 0x53, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x41, 0x7a, 0x75, 0x72, 0x65,
+	0x10, 0x03, 0x2a, 0x3e, 0x0a, 0x10, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x10, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4e, 0x61, 0x74, 0x73,
+	0x10, 0x01, 0x2a, 0x4d, 0x0a, 0x0f, 0x48, 0x6f, 0x6f, 0x6b, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x48, 0x6f, 0x6f, 0x6b, 0x46, 0x61, 0x69,
+	0x6c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x48, 0x6f, 0x6f,
+	0x6b, 0x46, 0x61, 0x69, 0x6c, 0x4f, 0x70, 0x65, 0x6e, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x48,
+	0x6f, 0x6f, 0x6b, 0x46, 0x61, 0x69, 0x6c, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x10,
+	0x02, 0x2a, 0x5e, 0x0a, 0x12, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x0a, 0x1c, 0x48, 0x6f, 0x6f, 0x6b, 0x73,
+	0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x52, 0x6f, 0x62, 0x69, 0x6e, 0x10, 0x00, 0x12, 0x26, 0x0a, 0x22, 0x48, 0x6f, 0x6f,
+	0x6b, 0x73, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x4c,
+	0x65, 0x61, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x10,
+	0x01, 0x2a, 0x64, 0x0a, 0x13, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x0f, 0x0a, 0x0b, 0x48, 0x4d, 0x41, 0x43,
+	0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x52,
+	0x49, 0x50, 0x45, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x4c, 0x41, 0x43, 0x4b, 0x10, 0x02,
+	0x12, 0x08, 0x0a, 0x04, 0x53, 0x56, 0x49, 0x58, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x44,
+	0x32, 0x35, 0x35, 0x31, 0x39, 0x10, 0x04, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x53, 0x41, 0x5f, 0x53,
+	0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x05, 0x2a, 0x86, 0x01, 0x0a, 0x19, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
+	0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x54, 0x41, 0x54, 0x49, 0x43, 0x5f,
+	0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x56, 0x41,
+	0x52, 0x49, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x4e, 0x56, 0x5f,
+	0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x56, 0x41,
+	0x52, 0x49, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x26, 0x0a, 0x22, 0x50, 0x4c, 0x41, 0x43,
+	0x45, 0x48, 0x4f, 0x4c, 0x44, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x56, 0x41, 0x52, 0x49, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x02,
+	0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77,
+	0x75, 0x6e, 0x64, 0x65, 0x72, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2f, 0x77, 0x75, 0x6e, 0x64, 0x65,
+	0x72, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x77, 0x67, 0x70, 0x62, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -7482,267 +10003,348 @@ func file_wundernode_config_proto_rawDescGZIP() []byte {
 	return file_wundernode_config_proto_rawDescData
 }
 
-var file_wundernode_config_proto_enumTypes = make([]protoimpl.EnumInfo, 18)
-var file_wundernode_config_proto_msgTypes = make([]protoimpl.MessageInfo, 77)
+var file_wundernode_config_proto_enumTypes = make([]protoimpl.EnumInfo, 26)
+var file_wundernode_config_proto_msgTypes = make([]protoimpl.MessageInfo, 95)
 var file_wundernode_config_proto_goTypes = []interface{}{
 	(LogLevel)(0),                                            // 0: wgpb.LogLevel
-	(AuthProviderKind)(0),                                    // 1: wgpb.AuthProviderKind
-	(OperationExecutionEngine)(0),                            // 2: wgpb.OperationExecutionEngine
-	(PostResolveTransformationKind)(0),                       // 3: wgpb.PostResolveTransformationKind
-	(InjectVariableKind)(0),                                  // 4: wgpb.InjectVariableKind
-	(HookType)(0),                                            // 5: wgpb.HookType
-	(ClaimType)(0),                                           // 6: wgpb.ClaimType
-	(ValueType)(0),                                           // 7: wgpb.ValueType
-	(OperationType)(0),                                       // 8: wgpb.OperationType
-	(DataSourceKind)(0),                                      // 9: wgpb.DataSourceKind
-	(NatsKvOperation)(0),                                     // 10: wgpb.NatsKvOperation
-	(UpstreamAuthenticationKind)(0),                          // 11: wgpb.UpstreamAuthenticationKind
-	(SigningMethod)(0),                                       // 12: wgpb.SigningMethod
-	(HTTPMethod)(0),                                          // 13: wgpb.HTTPMethod
-	(ArgumentSource)(0),                                      // 14: wgpb.ArgumentSource
-	(ArgumentRenderConfiguration)(0),                         // 15: wgpb.ArgumentRenderConfiguration
-	(WebhookVerifierKind)(0),                                 // 16: wgpb.WebhookVerifierKind
-	(ConfigurationVariableKind)(0),                           // 17: wgpb.ConfigurationVariableKind
-	(*ApiAuthenticationConfig)(nil),                          // 18: wgpb.ApiAuthenticationConfig
-	(*JwksBasedAuthentication)(nil),                          // 19: wgpb.JwksBasedAuthentication
-	(*JwksAuthProvider)(nil),                                 // 20: wgpb.JwksAuthProvider
-	(*ApiAuthenticationHooks)(nil),                           // 21: wgpb.ApiAuthenticationHooks
-	(*CookieBasedAuthentication)(nil),                        // 22: wgpb.CookieBasedAuthentication
-	(*AuthProvider)(nil),                                     // 23: wgpb.AuthProvider
-	(*GithubAuthProviderConfig)(nil),                         // 24: wgpb.GithubAuthProviderConfig
-	(*OpenIDConnectQueryParameter)(nil),                      // 25: wgpb.OpenIDConnectQueryParameter
-	(*OpenIDConnectAuthProviderConfig)(nil),                  // 26: wgpb.OpenIDConnectAuthProviderConfig
-	(*Operation)(nil),                                        // 27: wgpb.Operation
-	(*PostResolveTransformation)(nil),                        // 28: wgpb.PostResolveTransformation
-	(*PostResolveGetTransformation)(nil),                     // 29: wgpb.PostResolveGetTransformation
-	(*OperationVariablesConfiguration)(nil),                  // 30: wgpb.OperationVariablesConfiguration
-	(*VariableInjectionConfiguration)(nil),                   // 31: wgpb.VariableInjectionConfiguration
-	(*GraphQLDataSourceHooksConfiguration)(nil),              // 32: wgpb.GraphQLDataSourceHooksConfiguration
-	(*HookMatcher)(nil),                                      // 33: wgpb.HookMatcher
-	(*Hook)(nil),                                             // 34: wgpb.Hook
-	(*OperationHooksConfiguration)(nil),                      // 35: wgpb.OperationHooksConfiguration
-	(*MockResolveHookConfiguration)(nil),                     // 36: wgpb.MockResolveHookConfiguration
-	(*OperationAuthorizationConfig)(nil),                     // 37: wgpb.OperationAuthorizationConfig
-	(*OperationRoleConfig)(nil),                              // 38: wgpb.OperationRoleConfig
-	(*CustomClaim)(nil),                                      // 39: wgpb.CustomClaim
-	(*ClaimConfig)(nil),                                      // 40: wgpb.ClaimConfig
-	(*OperationLiveQueryConfig)(nil),                         // 41: wgpb.OperationLiveQueryConfig
-	(*OperationAuthenticationConfig)(nil),                    // 42: wgpb.OperationAuthenticationConfig
-	(*OperationCacheConfig)(nil),                             // 43: wgpb.OperationCacheConfig
-	(*EngineConfiguration)(nil),                              // 44: wgpb.EngineConfiguration
-	(*InternedString)(nil),                                   // 45: wgpb.InternedString
-	(*DataSourceConfiguration)(nil),                          // 46: wgpb.DataSourceConfiguration
-	(*DirectiveConfiguration)(nil),                           // 47: wgpb.DirectiveConfiguration
-	(*DataSourceCustom_NatsKv)(nil),                          // 48: wgpb.DataSourceCustom_NatsKv
-	(*DataSourceCustom_REST)(nil),                            // 49: wgpb.DataSourceCustom_REST
-	(*StatusCodeTypeMapping)(nil),                            // 50: wgpb.StatusCodeTypeMapping
-	(*DataSourceCustom_GraphQL)(nil),                         // 51: wgpb.DataSourceCustom_GraphQL
-	(*DataSourceCustom_Database)(nil),                        // 52: wgpb.DataSourceCustom_Database
-	(*GraphQLFederationConfiguration)(nil),                   // 53: wgpb.GraphQLFederationConfiguration
-	(*DataSourceCustom_Static)(nil),                          // 54: wgpb.DataSourceCustom_Static
-	(*GraphQLSubscriptionConfiguration)(nil),                 // 55: wgpb.GraphQLSubscriptionConfiguration
-	(*FetchConfiguration)(nil),                               // 56: wgpb.FetchConfiguration
-	(*MTLSConfiguration)(nil),                                // 57: wgpb.MTLSConfiguration
-	(*UpstreamAuthentication)(nil),                           // 58: wgpb.UpstreamAuthentication
-	(*JwtUpstreamAuthenticationConfig)(nil),                  // 59: wgpb.JwtUpstreamAuthenticationConfig
-	(*JwtUpstreamAuthenticationWithAccessTokenExchange)(nil), // 60: wgpb.JwtUpstreamAuthenticationWithAccessTokenExchange
-	(*RESTSubscriptionConfiguration)(nil),                    // 61: wgpb.RESTSubscriptionConfiguration
-	(*URLQueryConfiguration)(nil),                            // 62: wgpb.URLQueryConfiguration
-	(*HTTPHeader)(nil),                                       // 63: wgpb.HTTPHeader
-	(*TypeConfiguration)(nil),                                // 64: wgpb.TypeConfiguration
-	(*FieldConfiguration)(nil),                               // 65: wgpb.FieldConfiguration
-	(*TypeField)(nil),                                        // 66: wgpb.TypeField
-	(*SingleTypeField)(nil),                                  // 67: wgpb.SingleTypeField
-	(*ArgumentConfiguration)(nil),                            // 68: wgpb.ArgumentConfiguration
-	(*WunderGraphConfiguration)(nil),                         // 69: wgpb.WunderGraphConfiguration
-	(*EnabledFeatures)(nil),                                  // 70: wgpb.EnabledFeatures
-	(*S3UploadProfileHooksConfiguration)(nil),                // 71: wgpb.S3UploadProfileHooksConfiguration
-	(*S3UploadProfile)(nil),                                  // 72: wgpb.S3UploadProfile
-	(*S3UploadConfiguration)(nil),                            // 73: wgpb.S3UploadConfiguration
-	(*UserDefinedApi)(nil),                                   // 74: wgpb.UserDefinedApi
-	(*ExperimentalConfiguration)(nil),                        // 75: wgpb.ExperimentalConfiguration
-	(*ListenerOptions)(nil),                                  // 76: wgpb.ListenerOptions
-	(*InternalListenerOptions)(nil),                          // 77: wgpb.InternalListenerOptions
-	(*NodeLogging)(nil),                                      // 78: wgpb.NodeLogging
-	(*PrometheusOptions)(nil),                                // 79: wgpb.PrometheusOptions
-	(*NodeOptions)(nil),                                      // 80: wgpb.NodeOptions
-	(*TelemetryOptions)(nil),                                 // 81: wgpb.TelemetryOptions
-	(*ServerLogging)(nil),                                    // 82: wgpb.ServerLogging
-	(*ServerOptions)(nil),                                    // 83: wgpb.ServerOptions
-	(*WebhookConfiguration)(nil),                             // 84: wgpb.WebhookConfiguration
-	(*WebhookVerifier)(nil),                                  // 85: wgpb.WebhookVerifier
-	(*CorsConfiguration)(nil),                                // 86: wgpb.CorsConfiguration
-	(*ConfigurationVariable)(nil),                            // 87: wgpb.ConfigurationVariable
-	(*BuildInfo)(nil),                                        // 88: wgpb.BuildInfo
-	(*BuildInfoVersion)(nil),                                 // 89: wgpb.BuildInfoVersion
-	(*BuildInfoOS)(nil),                                      // 90: wgpb.BuildInfoOS
-	(*BuildInfoStats)(nil),                                   // 91: wgpb.BuildInfoStats
-	nil,                                                      // 92: wgpb.EngineConfiguration.StringStorageEntry
-	nil,                                                      // 93: wgpb.FetchConfiguration.HeaderEntry
-	nil,                                                      // 94: wgpb.S3UploadConfiguration.UploadProfilesEntry
+	(RoleMappingRuleKind)(0),                                 // 1: wgpb.RoleMappingRuleKind
+	(AuthProviderKind)(0),                                    // 2: wgpb.AuthProviderKind
+	(OperationExecutionEngine)(0),                            // 3: wgpb.OperationExecutionEngine
+	(PostResolveTransformationKind)(0),                       // 4: wgpb.PostResolveTransformationKind
+	(InjectVariableKind)(0),                                  // 5: wgpb.InjectVariableKind
+	(HookType)(0),                                            // 6: wgpb.HookType
+	(ExpressionHookAction)(0),                                // 7: wgpb.ExpressionHookAction
+	(ClaimType)(0),                                           // 8: wgpb.ClaimType
+	(ValueType)(0),                                           // 9: wgpb.ValueType
+	(OperationType)(0),                                       // 10: wgpb.OperationType
+	(DataSourceKind)(0),                                      // 11: wgpb.DataSourceKind
+	(NatsKvOperation)(0),                                     // 12: wgpb.NatsKvOperation
+	(UpstreamAuthenticationKind)(0),                          // 13: wgpb.UpstreamAuthenticationKind
+	(SigningMethod)(0),                                       // 14: wgpb.SigningMethod
+	(HTTPMethod)(0),                                          // 15: wgpb.HTTPMethod
+	(ArgumentSource)(0),                                      // 16: wgpb.ArgumentSource
+	(ArgumentRenderConfiguration)(0),                         // 17: wgpb.ArgumentRenderConfiguration
+	(ImageFit)(0),                                            // 18: wgpb.ImageFit
+	(ImageFormat)(0),                                         // 19: wgpb.ImageFormat
+	(UploadStorageKind)(0),                                   // 20: wgpb.UploadStorageKind
+	(WebhookQueueKind)(0),                                    // 21: wgpb.WebhookQueueKind
+	(HookFailureMode)(0),                                     // 22: wgpb.HookFailureMode
+	(HooksLoadBalancing)(0),                                  // 23: wgpb.HooksLoadBalancing
+	(WebhookVerifierKind)(0),                                 // 24: wgpb.WebhookVerifierKind
+	(ConfigurationVariableKind)(0),                           // 25: wgpb.ConfigurationVariableKind
+	(*ApiAuthenticationConfig)(nil),                          // 26: wgpb.ApiAuthenticationConfig
+	(*JwksBasedAuthentication)(nil),                          // 27: wgpb.JwksBasedAuthentication
+	(*JwksAuthProvider)(nil),                                 // 28: wgpb.JwksAuthProvider
+	(*ApiAuthenticationHooks)(nil),                           // 29: wgpb.ApiAuthenticationHooks
+	(*CookieBasedAuthentication)(nil),                        // 30: wgpb.CookieBasedAuthentication
+	(*AuthProvider)(nil),                                     // 31: wgpb.AuthProvider
+	(*RoleMappingRule)(nil),                                  // 32: wgpb.RoleMappingRule
+	(*GithubAuthProviderConfig)(nil),                         // 33: wgpb.GithubAuthProviderConfig
+	(*OpenIDConnectQueryParameter)(nil),                      // 34: wgpb.OpenIDConnectQueryParameter
+	(*OpenIDConnectAuthProviderConfig)(nil),                  // 35: wgpb.OpenIDConnectAuthProviderConfig
+	(*OAuth2AuthProviderConfig)(nil),                         // 36: wgpb.OAuth2AuthProviderConfig
+	(*SAMLAuthProviderConfig)(nil),                           // 37: wgpb.SAMLAuthProviderConfig
+	(*Operation)(nil),                                        // 38: wgpb.Operation
+	(*PostResolveTransformation)(nil),                        // 39: wgpb.PostResolveTransformation
+	(*PostResolveGetTransformation)(nil),                     // 40: wgpb.PostResolveGetTransformation
+	(*OperationVariablesConfiguration)(nil),                  // 41: wgpb.OperationVariablesConfiguration
+	(*VariableInjectionConfiguration)(nil),                   // 42: wgpb.VariableInjectionConfiguration
+	(*GraphQLDataSourceHooksConfiguration)(nil),              // 43: wgpb.GraphQLDataSourceHooksConfiguration
+	(*HeaderMatcher)(nil),                                    // 44: wgpb.HeaderMatcher
+	(*HookMatcher)(nil),                                      // 45: wgpb.HookMatcher
+	(*Hook)(nil),                                             // 46: wgpb.Hook
+	(*OperationHooksConfiguration)(nil),                      // 47: wgpb.OperationHooksConfiguration
+	(*ExpressionHook)(nil),                                   // 48: wgpb.ExpressionHook
+	(*MockResolveHookConfiguration)(nil),                     // 49: wgpb.MockResolveHookConfiguration
+	(*OperationAuthorizationConfig)(nil),                     // 50: wgpb.OperationAuthorizationConfig
+	(*OperationRoleConfig)(nil),                              // 51: wgpb.OperationRoleConfig
+	(*CustomClaim)(nil),                                      // 52: wgpb.CustomClaim
+	(*ClaimConfig)(nil),                                      // 53: wgpb.ClaimConfig
+	(*OperationLiveQueryConfig)(nil),                         // 54: wgpb.OperationLiveQueryConfig
+	(*OperationAuthenticationConfig)(nil),                    // 55: wgpb.OperationAuthenticationConfig
+	(*OperationCacheConfig)(nil),                             // 56: wgpb.OperationCacheConfig
+	(*EngineConfiguration)(nil),                              // 57: wgpb.EngineConfiguration
+	(*InternedString)(nil),                                   // 58: wgpb.InternedString
+	(*DataSourceConfiguration)(nil),                          // 59: wgpb.DataSourceConfiguration
+	(*DirectiveConfiguration)(nil),                           // 60: wgpb.DirectiveConfiguration
+	(*DataSourceCustom_NatsKv)(nil),                          // 61: wgpb.DataSourceCustom_NatsKv
+	(*DataSourceCustom_WebhookEvents)(nil),                   // 62: wgpb.DataSourceCustom_WebhookEvents
+	(*DataSourceCustom_REST)(nil),                            // 63: wgpb.DataSourceCustom_REST
+	(*StatusCodeTypeMapping)(nil),                            // 64: wgpb.StatusCodeTypeMapping
+	(*DataSourceCustom_GraphQL)(nil),                         // 65: wgpb.DataSourceCustom_GraphQL
+	(*DataSourceCustom_Database)(nil),                        // 66: wgpb.DataSourceCustom_Database
+	(*GraphQLFederationConfiguration)(nil),                   // 67: wgpb.GraphQLFederationConfiguration
+	(*DataSourceCustom_Static)(nil),                          // 68: wgpb.DataSourceCustom_Static
+	(*GraphQLSubscriptionConfiguration)(nil),                 // 69: wgpb.GraphQLSubscriptionConfiguration
+	(*FetchConfiguration)(nil),                               // 70: wgpb.FetchConfiguration
+	(*MTLSConfiguration)(nil),                                // 71: wgpb.MTLSConfiguration
+	(*UpstreamAuthentication)(nil),                           // 72: wgpb.UpstreamAuthentication
+	(*JwtUpstreamAuthenticationConfig)(nil),                  // 73: wgpb.JwtUpstreamAuthenticationConfig
+	(*JwtUpstreamAuthenticationWithAccessTokenExchange)(nil), // 74: wgpb.JwtUpstreamAuthenticationWithAccessTokenExchange
+	(*RESTSubscriptionConfiguration)(nil),                    // 75: wgpb.RESTSubscriptionConfiguration
+	(*URLQueryConfiguration)(nil),                            // 76: wgpb.URLQueryConfiguration
+	(*HTTPHeader)(nil),                                       // 77: wgpb.HTTPHeader
+	(*TypeConfiguration)(nil),                                // 78: wgpb.TypeConfiguration
+	(*FieldConfiguration)(nil),                               // 79: wgpb.FieldConfiguration
+	(*TypeField)(nil),                                        // 80: wgpb.TypeField
+	(*SingleTypeField)(nil),                                  // 81: wgpb.SingleTypeField
+	(*ArgumentConfiguration)(nil),                            // 82: wgpb.ArgumentConfiguration
+	(*WunderGraphConfiguration)(nil),                         // 83: wgpb.WunderGraphConfiguration
+	(*EnabledFeatures)(nil),                                  // 84: wgpb.EnabledFeatures
+	(*S3UploadProfileHooksConfiguration)(nil),                // 85: wgpb.S3UploadProfileHooksConfiguration
+	(*S3UploadProfile)(nil),                                  // 86: wgpb.S3UploadProfile
+	(*ImageTransformation)(nil),                              // 87: wgpb.ImageTransformation
+	(*S3UploadConfiguration)(nil),                            // 88: wgpb.S3UploadConfiguration
+	(*UserDefinedApi)(nil),                                   // 89: wgpb.UserDefinedApi
+	(*ExperimentalConfiguration)(nil),                        // 90: wgpb.ExperimentalConfiguration
+	(*ListenerOptions)(nil),                                  // 91: wgpb.ListenerOptions
+	(*InternalListenerOptions)(nil),                          // 92: wgpb.InternalListenerOptions
+	(*NodeLogging)(nil),                                      // 93: wgpb.NodeLogging
+	(*PrometheusOptions)(nil),                                // 94: wgpb.PrometheusOptions
+	(*NodeOptions)(nil),                                      // 95: wgpb.NodeOptions
+	(*WebhookQueueOptions)(nil),                              // 96: wgpb.WebhookQueueOptions
+	(*TelemetryOptions)(nil),                                 // 97: wgpb.TelemetryOptions
+	(*ServerLogging)(nil),                                    // 98: wgpb.ServerLogging
+	(*ServerOptions)(nil),                                    // 99: wgpb.ServerOptions
+	(*AsyncHooksOptions)(nil),                                // 100: wgpb.AsyncHooksOptions
+	(*HookPolicy)(nil),                                       // 101: wgpb.HookPolicy
+	(*HooksServerPoolOptions)(nil),                           // 102: wgpb.HooksServerPoolOptions
+	(*HooksServerRoute)(nil),                                 // 103: wgpb.HooksServerRoute
+	(*GRPCHooksOptions)(nil),                                 // 104: wgpb.GRPCHooksOptions
+	(*WasmHooksOptions)(nil),                                 // 105: wgpb.WasmHooksOptions
+	(*WasmHookLimits)(nil),                                   // 106: wgpb.WasmHookLimits
+	(*WebhookConfiguration)(nil),                             // 107: wgpb.WebhookConfiguration
+	(*EventWebhookConfiguration)(nil),                        // 108: wgpb.EventWebhookConfiguration
+	(*WebhookVerifier)(nil),                                  // 109: wgpb.WebhookVerifier
+	(*CorsConfiguration)(nil),                                // 110: wgpb.CorsConfiguration
+	(*ConfigurationVariable)(nil),                            // 111: wgpb.ConfigurationVariable
+	(*BuildInfo)(nil),                                        // 112: wgpb.BuildInfo
+	(*BuildInfoVersion)(nil),                                 // 113: wgpb.BuildInfoVersion
+	(*BuildInfoOS)(nil),                                      // 114: wgpb.BuildInfoOS
+	(*BuildInfoStats)(nil),                                   // 115: wgpb.BuildInfoStats
+	nil,                                                      // 116: wgpb.OAuth2AuthProviderConfig.ClaimMappingEntry
+	nil,                                                      // 117: wgpb.SAMLAuthProviderConfig.AttributeMappingEntry
+	nil,                                                      // 118: wgpb.EngineConfiguration.StringStorageEntry
+	nil,                                                      // 119: wgpb.FetchConfiguration.HeaderEntry
+	nil,                                                      // 120: wgpb.S3UploadConfiguration.UploadProfilesEntry
 }
 var file_wundernode_config_proto_depIdxs = []int32{
-	22,  // 0: wgpb.ApiAuthenticationConfig.cookieBased:type_name -> wgpb.CookieBasedAuthentication
-	21,  // 1: wgpb.ApiAuthenticationConfig.hooks:type_name -> wgpb.ApiAuthenticationHooks
-	19,  // 2: wgpb.ApiAuthenticationConfig.jwksBased:type_name -> wgpb.JwksBasedAuthentication
-	20,  // 3: wgpb.JwksBasedAuthentication.providers:type_name -> wgpb.JwksAuthProvider
-	87,  // 4: wgpb.JwksAuthProvider.jwksUrl:type_name -> wgpb.ConfigurationVariable
-	87,  // 5: wgpb.JwksAuthProvider.jwksJson:type_name -> wgpb.ConfigurationVariable
-	87,  // 6: wgpb.JwksAuthProvider.userInfoEndpoint:type_name -> wgpb.ConfigurationVariable
-	23,  // 7: wgpb.CookieBasedAuthentication.providers:type_name -> wgpb.AuthProvider
-	87,  // 8: wgpb.CookieBasedAuthentication.authorizedRedirectUris:type_name -> wgpb.ConfigurationVariable
-	87,  // 9: wgpb.CookieBasedAuthentication.authorizedRedirectUriRegexes:type_name -> wgpb.ConfigurationVariable
-	87,  // 10: wgpb.CookieBasedAuthentication.hashKey:type_name -> wgpb.ConfigurationVariable
-	87,  // 11: wgpb.CookieBasedAuthentication.blockKey:type_name -> wgpb.ConfigurationVariable
-	87,  // 12: wgpb.CookieBasedAuthentication.csrfSecret:type_name -> wgpb.ConfigurationVariable
-	87,  // 13: wgpb.CookieBasedAuthentication.timeoutSeconds:type_name -> wgpb.ConfigurationVariable
-	1,   // 14: wgpb.AuthProvider.kind:type_name -> wgpb.AuthProviderKind
-	24,  // 15: wgpb.AuthProvider.githubConfig:type_name -> wgpb.GithubAuthProviderConfig
-	26,  // 16: wgpb.AuthProvider.oidcConfig:type_name -> wgpb.OpenIDConnectAuthProviderConfig
-	87,  // 17: wgpb.GithubAuthProviderConfig.clientId:type_name -> wgpb.ConfigurationVariable
-	87,  // 18: wgpb.GithubAuthProviderConfig.clientSecret:type_name -> wgpb.ConfigurationVariable
-	87,  // 19: wgpb.OpenIDConnectQueryParameter.name:type_name -> wgpb.ConfigurationVariable
-	87,  // 20: wgpb.OpenIDConnectQueryParameter.value:type_name -> wgpb.ConfigurationVariable
-	87,  // 21: wgpb.OpenIDConnectAuthProviderConfig.issuer:type_name -> wgpb.ConfigurationVariable
-	87,  // 22: wgpb.OpenIDConnectAuthProviderConfig.clientId:type_name -> wgpb.ConfigurationVariable
-	87,  // 23: wgpb.OpenIDConnectAuthProviderConfig.clientSecret:type_name -> wgpb.ConfigurationVariable
-	25,  // 24: wgpb.OpenIDConnectAuthProviderConfig.queryParameters:type_name -> wgpb.OpenIDConnectQueryParameter
-	8,   // 25: wgpb.Operation.operationType:type_name -> wgpb.OperationType
-	43,  // 26: wgpb.Operation.cacheConfig:type_name -> wgpb.OperationCacheConfig
-	42,  // 27: wgpb.Operation.authenticationConfig:type_name -> wgpb.OperationAuthenticationConfig
-	41,  // 28: wgpb.Operation.liveQueryConfig:type_name -> wgpb.OperationLiveQueryConfig
-	37,  // 29: wgpb.Operation.authorizationConfig:type_name -> wgpb.OperationAuthorizationConfig
-	35,  // 30: wgpb.Operation.hooksConfiguration:type_name -> wgpb.OperationHooksConfiguration
-	30,  // 31: wgpb.Operation.variablesConfiguration:type_name -> wgpb.OperationVariablesConfiguration
-	28,  // 32: wgpb.Operation.postResolveTransformations:type_name -> wgpb.PostResolveTransformation
-	2,   // 33: wgpb.Operation.engine:type_name -> wgpb.OperationExecutionEngine
-	3,   // 34: wgpb.PostResolveTransformation.kind:type_name -> wgpb.PostResolveTransformationKind
-	29,  // 35: wgpb.PostResolveTransformation.get:type_name -> wgpb.PostResolveGetTransformation
-	31,  // 36: wgpb.OperationVariablesConfiguration.injectVariables:type_name -> wgpb.VariableInjectionConfiguration
-	4,   // 37: wgpb.VariableInjectionConfiguration.variableKind:type_name -> wgpb.InjectVariableKind
-	8,   // 38: wgpb.HookMatcher.operationType:type_name -> wgpb.OperationType
-	5,   // 39: wgpb.Hook.type:type_name -> wgpb.HookType
-	33,  // 40: wgpb.Hook.matcher:type_name -> wgpb.HookMatcher
-	36,  // 41: wgpb.OperationHooksConfiguration.mockResolve:type_name -> wgpb.MockResolveHookConfiguration
-	40,  // 42: wgpb.OperationAuthorizationConfig.claims:type_name -> wgpb.ClaimConfig
-	38,  // 43: wgpb.OperationAuthorizationConfig.roleConfig:type_name -> wgpb.OperationRoleConfig
-	7,   // 44: wgpb.CustomClaim.type:type_name -> wgpb.ValueType
-	6,   // 45: wgpb.ClaimConfig.claimType:type_name -> wgpb.ClaimType
-	39,  // 46: wgpb.ClaimConfig.custom:type_name -> wgpb.CustomClaim
-	46,  // 47: wgpb.EngineConfiguration.datasourceConfigurations:type_name -> wgpb.DataSourceConfiguration
-	65,  // 48: wgpb.EngineConfiguration.fieldConfigurations:type_name -> wgpb.FieldConfiguration
-	64,  // 49: wgpb.EngineConfiguration.typeConfigurations:type_name -> wgpb.TypeConfiguration
-	92,  // 50: wgpb.EngineConfiguration.stringStorage:type_name -> wgpb.EngineConfiguration.StringStorageEntry
-	9,   // 51: wgpb.DataSourceConfiguration.kind:type_name -> wgpb.DataSourceKind
-	66,  // 52: wgpb.DataSourceConfiguration.rootNodes:type_name -> wgpb.TypeField
-	66,  // 53: wgpb.DataSourceConfiguration.childNodes:type_name -> wgpb.TypeField
-	49,  // 54: wgpb.DataSourceConfiguration.customRest:type_name -> wgpb.DataSourceCustom_REST
-	51,  // 55: wgpb.DataSourceConfiguration.customGraphql:type_name -> wgpb.DataSourceCustom_GraphQL
-	54,  // 56: wgpb.DataSourceConfiguration.customStatic:type_name -> wgpb.DataSourceCustom_Static
-	52,  // 57: wgpb.DataSourceConfiguration.customDatabase:type_name -> wgpb.DataSourceCustom_Database
-	47,  // 58: wgpb.DataSourceConfiguration.directives:type_name -> wgpb.DirectiveConfiguration
-	48,  // 59: wgpb.DataSourceConfiguration.customNatsKv:type_name -> wgpb.DataSourceCustom_NatsKv
-	10,  // 60: wgpb.DataSourceCustom_NatsKv.operation:type_name -> wgpb.NatsKvOperation
-	87,  // 61: wgpb.DataSourceCustom_NatsKv.bucketPrefix:type_name -> wgpb.ConfigurationVariable
-	56,  // 62: wgpb.DataSourceCustom_REST.fetch:type_name -> wgpb.FetchConfiguration
-	61,  // 63: wgpb.DataSourceCustom_REST.subscription:type_name -> wgpb.RESTSubscriptionConfiguration
-	50,  // 64: wgpb.DataSourceCustom_REST.statusCodeTypeMappings:type_name -> wgpb.StatusCodeTypeMapping
-	56,  // 65: wgpb.DataSourceCustom_GraphQL.fetch:type_name -> wgpb.FetchConfiguration
-	55,  // 66: wgpb.DataSourceCustom_GraphQL.subscription:type_name -> wgpb.GraphQLSubscriptionConfiguration
-	53,  // 67: wgpb.DataSourceCustom_GraphQL.federation:type_name -> wgpb.GraphQLFederationConfiguration
-	45,  // 68: wgpb.DataSourceCustom_GraphQL.upstreamSchema:type_name -> wgpb.InternedString
-	32,  // 69: wgpb.DataSourceCustom_GraphQL.hooksConfiguration:type_name -> wgpb.GraphQLDataSourceHooksConfiguration
-	67,  // 70: wgpb.DataSourceCustom_GraphQL.customScalarTypeFields:type_name -> wgpb.SingleTypeField
-	87,  // 71: wgpb.DataSourceCustom_Database.databaseURL:type_name -> wgpb.ConfigurationVariable
-	45,  // 72: wgpb.DataSourceCustom_Database.prismaSchema:type_name -> wgpb.InternedString
-	45,  // 73: wgpb.DataSourceCustom_Database.graphqlSchema:type_name -> wgpb.InternedString
-	67,  // 74: wgpb.DataSourceCustom_Database.jsonTypeFields:type_name -> wgpb.SingleTypeField
-	87,  // 75: wgpb.DataSourceCustom_Static.data:type_name -> wgpb.ConfigurationVariable
-	87,  // 76: wgpb.GraphQLSubscriptionConfiguration.url:type_name -> wgpb.ConfigurationVariable
-	87,  // 77: wgpb.FetchConfiguration.url:type_name -> wgpb.ConfigurationVariable
-	13,  // 78: wgpb.FetchConfiguration.method:type_name -> wgpb.HTTPMethod
-	93,  // 79: wgpb.FetchConfiguration.header:type_name -> wgpb.FetchConfiguration.HeaderEntry
-	87,  // 80: wgpb.FetchConfiguration.body:type_name -> wgpb.ConfigurationVariable
-	62,  // 81: wgpb.FetchConfiguration.query:type_name -> wgpb.URLQueryConfiguration
-	58,  // 82: wgpb.FetchConfiguration.upstreamAuthentication:type_name -> wgpb.UpstreamAuthentication
-	57,  // 83: wgpb.FetchConfiguration.mTLS:type_name -> wgpb.MTLSConfiguration
-	87,  // 84: wgpb.FetchConfiguration.baseUrl:type_name -> wgpb.ConfigurationVariable
-	87,  // 85: wgpb.FetchConfiguration.path:type_name -> wgpb.ConfigurationVariable
-	87,  // 86: wgpb.FetchConfiguration.httpProxyUrl:type_name -> wgpb.ConfigurationVariable
-	87,  // 87: wgpb.MTLSConfiguration.key:type_name -> wgpb.ConfigurationVariable
-	87,  // 88: wgpb.MTLSConfiguration.cert:type_name -> wgpb.ConfigurationVariable
-	11,  // 89: wgpb.UpstreamAuthentication.kind:type_name -> wgpb.UpstreamAuthenticationKind
-	59,  // 90: wgpb.UpstreamAuthentication.jwtConfig:type_name -> wgpb.JwtUpstreamAuthenticationConfig
-	60,  // 91: wgpb.UpstreamAuthentication.jwtWithAccessTokenExchangeConfig:type_name -> wgpb.JwtUpstreamAuthenticationWithAccessTokenExchange
-	87,  // 92: wgpb.JwtUpstreamAuthenticationConfig.secret:type_name -> wgpb.ConfigurationVariable
-	12,  // 93: wgpb.JwtUpstreamAuthenticationConfig.signingMethod:type_name -> wgpb.SigningMethod
-	87,  // 94: wgpb.JwtUpstreamAuthenticationWithAccessTokenExchange.secret:type_name -> wgpb.ConfigurationVariable
-	12,  // 95: wgpb.JwtUpstreamAuthenticationWithAccessTokenExchange.signingMethod:type_name -> wgpb.SigningMethod
-	87,  // 96: wgpb.JwtUpstreamAuthenticationWithAccessTokenExchange.accessTokenExchangeEndpoint:type_name -> wgpb.ConfigurationVariable
-	87,  // 97: wgpb.HTTPHeader.values:type_name -> wgpb.ConfigurationVariable
-	68,  // 98: wgpb.FieldConfiguration.argumentsConfiguration:type_name -> wgpb.ArgumentConfiguration
-	14,  // 99: wgpb.ArgumentConfiguration.sourceType:type_name -> wgpb.ArgumentSource
-	15,  // 100: wgpb.ArgumentConfiguration.renderConfiguration:type_name -> wgpb.ArgumentRenderConfiguration
-	74,  // 101: wgpb.WunderGraphConfiguration.api:type_name -> wgpb.UserDefinedApi
-	70,  // 102: wgpb.WunderGraphConfiguration.enabledFeatures:type_name -> wgpb.EnabledFeatures
-	34,  // 103: wgpb.WunderGraphConfiguration.hooks:type_name -> wgpb.Hook
-	71,  // 104: wgpb.S3UploadProfile.hooks:type_name -> wgpb.S3UploadProfileHooksConfiguration
-	87,  // 105: wgpb.S3UploadConfiguration.endpoint:type_name -> wgpb.ConfigurationVariable
-	87,  // 106: wgpb.S3UploadConfiguration.accessKeyID:type_name -> wgpb.ConfigurationVariable
-	87,  // 107: wgpb.S3UploadConfiguration.secretAccessKey:type_name -> wgpb.ConfigurationVariable
-	87,  // 108: wgpb.S3UploadConfiguration.bucketName:type_name -> wgpb.ConfigurationVariable
-	87,  // 109: wgpb.S3UploadConfiguration.bucketLocation:type_name -> wgpb.ConfigurationVariable
-	94,  // 110: wgpb.S3UploadConfiguration.uploadProfiles:type_name -> wgpb.S3UploadConfiguration.UploadProfilesEntry
-	44,  // 111: wgpb.UserDefinedApi.engineConfiguration:type_name -> wgpb.EngineConfiguration
-	27,  // 112: wgpb.UserDefinedApi.operations:type_name -> wgpb.Operation
-	86,  // 113: wgpb.UserDefinedApi.corsConfiguration:type_name -> wgpb.CorsConfiguration
-	18,  // 114: wgpb.UserDefinedApi.authenticationConfig:type_name -> wgpb.ApiAuthenticationConfig
-	73,  // 115: wgpb.UserDefinedApi.s3UploadConfiguration:type_name -> wgpb.S3UploadConfiguration
-	87,  // 116: wgpb.UserDefinedApi.allowedHostNames:type_name -> wgpb.ConfigurationVariable
-	84,  // 117: wgpb.UserDefinedApi.webhooks:type_name -> wgpb.WebhookConfiguration
-	83,  // 118: wgpb.UserDefinedApi.serverOptions:type_name -> wgpb.ServerOptions
-	80,  // 119: wgpb.UserDefinedApi.nodeOptions:type_name -> wgpb.NodeOptions
-	75,  // 120: wgpb.UserDefinedApi.experimentalConfig:type_name -> wgpb.ExperimentalConfiguration
-	87,  // 121: wgpb.ListenerOptions.host:type_name -> wgpb.ConfigurationVariable
-	87,  // 122: wgpb.ListenerOptions.port:type_name -> wgpb.ConfigurationVariable
-	87,  // 123: wgpb.InternalListenerOptions.port:type_name -> wgpb.ConfigurationVariable
-	87,  // 124: wgpb.NodeLogging.level:type_name -> wgpb.ConfigurationVariable
-	87,  // 125: wgpb.PrometheusOptions.enabled:type_name -> wgpb.ConfigurationVariable
-	87,  // 126: wgpb.PrometheusOptions.port:type_name -> wgpb.ConfigurationVariable
-	87,  // 127: wgpb.NodeOptions.nodeUrl:type_name -> wgpb.ConfigurationVariable
-	87,  // 128: wgpb.NodeOptions.publicNodeUrl:type_name -> wgpb.ConfigurationVariable
-	76,  // 129: wgpb.NodeOptions.listen:type_name -> wgpb.ListenerOptions
-	78,  // 130: wgpb.NodeOptions.logger:type_name -> wgpb.NodeLogging
-	77,  // 131: wgpb.NodeOptions.listenInternal:type_name -> wgpb.InternalListenerOptions
-	87,  // 132: wgpb.NodeOptions.nodeInternalUrl:type_name -> wgpb.ConfigurationVariable
-	87,  // 133: wgpb.NodeOptions.defaultHttpProxyUrl:type_name -> wgpb.ConfigurationVariable
-	81,  // 134: wgpb.NodeOptions.openTelemetry:type_name -> wgpb.TelemetryOptions
-	79,  // 135: wgpb.NodeOptions.prometheus:type_name -> wgpb.PrometheusOptions
-	87,  // 136: wgpb.TelemetryOptions.enabled:type_name -> wgpb.ConfigurationVariable
-	87,  // 137: wgpb.TelemetryOptions.exporterHttpEndpoint:type_name -> wgpb.ConfigurationVariable
-	87,  // 138: wgpb.TelemetryOptions.sampler:type_name -> wgpb.ConfigurationVariable
-	87,  // 139: wgpb.TelemetryOptions.authToken:type_name -> wgpb.ConfigurationVariable
-	87,  // 140: wgpb.ServerLogging.level:type_name -> wgpb.ConfigurationVariable
-	87,  // 141: wgpb.ServerOptions.serverUrl:type_name -> wgpb.ConfigurationVariable
-	76,  // 142: wgpb.ServerOptions.listen:type_name -> wgpb.ListenerOptions
-	82,  // 143: wgpb.ServerOptions.logger:type_name -> wgpb.ServerLogging
-	85,  // 144: wgpb.WebhookConfiguration.verifier:type_name -> wgpb.WebhookVerifier
-	16,  // 145: wgpb.WebhookVerifier.kind:type_name -> wgpb.WebhookVerifierKind
-	87,  // 146: wgpb.WebhookVerifier.secret:type_name -> wgpb.ConfigurationVariable
-	87,  // 147: wgpb.CorsConfiguration.allowedOrigins:type_name -> wgpb.ConfigurationVariable
-	17,  // 148: wgpb.ConfigurationVariable.kind:type_name -> wgpb.ConfigurationVariableKind
-	89,  // 149: wgpb.BuildInfo.sdk:type_name -> wgpb.BuildInfoVersion
-	89,  // 150: wgpb.BuildInfo.wunderctl:type_name -> wgpb.BuildInfoVersion
-	89,  // 151: wgpb.BuildInfo.node:type_name -> wgpb.BuildInfoVersion
-	90,  // 152: wgpb.BuildInfo.os:type_name -> wgpb.BuildInfoOS
-	91,  // 153: wgpb.BuildInfo.stats:type_name -> wgpb.BuildInfoStats
-	63,  // 154: wgpb.FetchConfiguration.HeaderEntry.value:type_name -> wgpb.HTTPHeader
-	72,  // 155: wgpb.S3UploadConfiguration.UploadProfilesEntry.value:type_name -> wgpb.S3UploadProfile
-	156, // [156:156] is the sub-list for method output_type
-	156, // [156:156] is the sub-list for method input_type
-	156, // [156:156] is the sub-list for extension type_name
-	156, // [156:156] is the sub-list for extension extendee
-	0,   // [0:156] is the sub-list for field type_name
+	30,  // 0: wgpb.ApiAuthenticationConfig.cookieBased:type_name -> wgpb.CookieBasedAuthentication
+	29,  // 1: wgpb.ApiAuthenticationConfig.hooks:type_name -> wgpb.ApiAuthenticationHooks
+	27,  // 2: wgpb.ApiAuthenticationConfig.jwksBased:type_name -> wgpb.JwksBasedAuthentication
+	28,  // 3: wgpb.JwksBasedAuthentication.providers:type_name -> wgpb.JwksAuthProvider
+	111, // 4: wgpb.JwksAuthProvider.jwksUrl:type_name -> wgpb.ConfigurationVariable
+	111, // 5: wgpb.JwksAuthProvider.jwksJson:type_name -> wgpb.ConfigurationVariable
+	111, // 6: wgpb.JwksAuthProvider.userInfoEndpoint:type_name -> wgpb.ConfigurationVariable
+	32,  // 7: wgpb.JwksAuthProvider.roleMappings:type_name -> wgpb.RoleMappingRule
+	31,  // 8: wgpb.CookieBasedAuthentication.providers:type_name -> wgpb.AuthProvider
+	111, // 9: wgpb.CookieBasedAuthentication.authorizedRedirectUris:type_name -> wgpb.ConfigurationVariable
+	111, // 10: wgpb.CookieBasedAuthentication.authorizedRedirectUriRegexes:type_name -> wgpb.ConfigurationVariable
+	111, // 11: wgpb.CookieBasedAuthentication.hashKey:type_name -> wgpb.ConfigurationVariable
+	111, // 12: wgpb.CookieBasedAuthentication.blockKey:type_name -> wgpb.ConfigurationVariable
+	111, // 13: wgpb.CookieBasedAuthentication.csrfSecret:type_name -> wgpb.ConfigurationVariable
+	111, // 14: wgpb.CookieBasedAuthentication.timeoutSeconds:type_name -> wgpb.ConfigurationVariable
+	2,   // 15: wgpb.AuthProvider.kind:type_name -> wgpb.AuthProviderKind
+	33,  // 16: wgpb.AuthProvider.githubConfig:type_name -> wgpb.GithubAuthProviderConfig
+	35,  // 17: wgpb.AuthProvider.oidcConfig:type_name -> wgpb.OpenIDConnectAuthProviderConfig
+	36,  // 18: wgpb.AuthProvider.oauth2Config:type_name -> wgpb.OAuth2AuthProviderConfig
+	37,  // 19: wgpb.AuthProvider.samlConfig:type_name -> wgpb.SAMLAuthProviderConfig
+	32,  // 20: wgpb.AuthProvider.roleMappings:type_name -> wgpb.RoleMappingRule
+	1,   // 21: wgpb.RoleMappingRule.kind:type_name -> wgpb.RoleMappingRuleKind
+	111, // 22: wgpb.GithubAuthProviderConfig.clientId:type_name -> wgpb.ConfigurationVariable
+	111, // 23: wgpb.GithubAuthProviderConfig.clientSecret:type_name -> wgpb.ConfigurationVariable
+	111, // 24: wgpb.OpenIDConnectQueryParameter.name:type_name -> wgpb.ConfigurationVariable
+	111, // 25: wgpb.OpenIDConnectQueryParameter.value:type_name -> wgpb.ConfigurationVariable
+	111, // 26: wgpb.OpenIDConnectAuthProviderConfig.issuer:type_name -> wgpb.ConfigurationVariable
+	111, // 27: wgpb.OpenIDConnectAuthProviderConfig.clientId:type_name -> wgpb.ConfigurationVariable
+	111, // 28: wgpb.OpenIDConnectAuthProviderConfig.clientSecret:type_name -> wgpb.ConfigurationVariable
+	34,  // 29: wgpb.OpenIDConnectAuthProviderConfig.queryParameters:type_name -> wgpb.OpenIDConnectQueryParameter
+	111, // 30: wgpb.OAuth2AuthProviderConfig.clientId:type_name -> wgpb.ConfigurationVariable
+	111, // 31: wgpb.OAuth2AuthProviderConfig.clientSecret:type_name -> wgpb.ConfigurationVariable
+	111, // 32: wgpb.OAuth2AuthProviderConfig.authorizationUrl:type_name -> wgpb.ConfigurationVariable
+	111, // 33: wgpb.OAuth2AuthProviderConfig.tokenUrl:type_name -> wgpb.ConfigurationVariable
+	111, // 34: wgpb.OAuth2AuthProviderConfig.userInfoUrl:type_name -> wgpb.ConfigurationVariable
+	34,  // 35: wgpb.OAuth2AuthProviderConfig.queryParameters:type_name -> wgpb.OpenIDConnectQueryParameter
+	116, // 36: wgpb.OAuth2AuthProviderConfig.claimMapping:type_name -> wgpb.OAuth2AuthProviderConfig.ClaimMappingEntry
+	111, // 37: wgpb.SAMLAuthProviderConfig.idpMetadataUrl:type_name -> wgpb.ConfigurationVariable
+	111, // 38: wgpb.SAMLAuthProviderConfig.idpMetadataXml:type_name -> wgpb.ConfigurationVariable
+	111, // 39: wgpb.SAMLAuthProviderConfig.entityId:type_name -> wgpb.ConfigurationVariable
+	111, // 40: wgpb.SAMLAuthProviderConfig.certificate:type_name -> wgpb.ConfigurationVariable
+	111, // 41: wgpb.SAMLAuthProviderConfig.privateKey:type_name -> wgpb.ConfigurationVariable
+	111, // 42: wgpb.SAMLAuthProviderConfig.nameIdFormat:type_name -> wgpb.ConfigurationVariable
+	117, // 43: wgpb.SAMLAuthProviderConfig.attributeMapping:type_name -> wgpb.SAMLAuthProviderConfig.AttributeMappingEntry
+	10,  // 44: wgpb.Operation.operationType:type_name -> wgpb.OperationType
+	56,  // 45: wgpb.Operation.cacheConfig:type_name -> wgpb.OperationCacheConfig
+	55,  // 46: wgpb.Operation.authenticationConfig:type_name -> wgpb.OperationAuthenticationConfig
+	54,  // 47: wgpb.Operation.liveQueryConfig:type_name -> wgpb.OperationLiveQueryConfig
+	50,  // 48: wgpb.Operation.authorizationConfig:type_name -> wgpb.OperationAuthorizationConfig
+	47,  // 49: wgpb.Operation.hooksConfiguration:type_name -> wgpb.OperationHooksConfiguration
+	41,  // 50: wgpb.Operation.variablesConfiguration:type_name -> wgpb.OperationVariablesConfiguration
+	39,  // 51: wgpb.Operation.postResolveTransformations:type_name -> wgpb.PostResolveTransformation
+	3,   // 52: wgpb.Operation.engine:type_name -> wgpb.OperationExecutionEngine
+	4,   // 53: wgpb.PostResolveTransformation.kind:type_name -> wgpb.PostResolveTransformationKind
+	40,  // 54: wgpb.PostResolveTransformation.get:type_name -> wgpb.PostResolveGetTransformation
+	42,  // 55: wgpb.OperationVariablesConfiguration.injectVariables:type_name -> wgpb.VariableInjectionConfiguration
+	5,   // 56: wgpb.VariableInjectionConfiguration.variableKind:type_name -> wgpb.InjectVariableKind
+	10,  // 57: wgpb.HookMatcher.operationType:type_name -> wgpb.OperationType
+	44,  // 58: wgpb.HookMatcher.headers:type_name -> wgpb.HeaderMatcher
+	6,   // 59: wgpb.Hook.type:type_name -> wgpb.HookType
+	45,  // 60: wgpb.Hook.matcher:type_name -> wgpb.HookMatcher
+	49,  // 61: wgpb.OperationHooksConfiguration.mockResolve:type_name -> wgpb.MockResolveHookConfiguration
+	48,  // 62: wgpb.OperationHooksConfiguration.preResolveExpressions:type_name -> wgpb.ExpressionHook
+	48,  // 63: wgpb.OperationHooksConfiguration.postResolveExpressions:type_name -> wgpb.ExpressionHook
+	48,  // 64: wgpb.OperationHooksConfiguration.subscriptionEventExpressions:type_name -> wgpb.ExpressionHook
+	7,   // 65: wgpb.ExpressionHook.action:type_name -> wgpb.ExpressionHookAction
+	53,  // 66: wgpb.OperationAuthorizationConfig.claims:type_name -> wgpb.ClaimConfig
+	51,  // 67: wgpb.OperationAuthorizationConfig.roleConfig:type_name -> wgpb.OperationRoleConfig
+	9,   // 68: wgpb.CustomClaim.type:type_name -> wgpb.ValueType
+	8,   // 69: wgpb.ClaimConfig.claimType:type_name -> wgpb.ClaimType
+	52,  // 70: wgpb.ClaimConfig.custom:type_name -> wgpb.CustomClaim
+	59,  // 71: wgpb.EngineConfiguration.datasourceConfigurations:type_name -> wgpb.DataSourceConfiguration
+	79,  // 72: wgpb.EngineConfiguration.fieldConfigurations:type_name -> wgpb.FieldConfiguration
+	78,  // 73: wgpb.EngineConfiguration.typeConfigurations:type_name -> wgpb.TypeConfiguration
+	118, // 74: wgpb.EngineConfiguration.stringStorage:type_name -> wgpb.EngineConfiguration.StringStorageEntry
+	11,  // 75: wgpb.DataSourceConfiguration.kind:type_name -> wgpb.DataSourceKind
+	80,  // 76: wgpb.DataSourceConfiguration.rootNodes:type_name -> wgpb.TypeField
+	80,  // 77: wgpb.DataSourceConfiguration.childNodes:type_name -> wgpb.TypeField
+	63,  // 78: wgpb.DataSourceConfiguration.customRest:type_name -> wgpb.DataSourceCustom_REST
+	65,  // 79: wgpb.DataSourceConfiguration.customGraphql:type_name -> wgpb.DataSourceCustom_GraphQL
+	68,  // 80: wgpb.DataSourceConfiguration.customStatic:type_name -> wgpb.DataSourceCustom_Static
+	66,  // 81: wgpb.DataSourceConfiguration.customDatabase:type_name -> wgpb.DataSourceCustom_Database
+	60,  // 82: wgpb.DataSourceConfiguration.directives:type_name -> wgpb.DirectiveConfiguration
+	61,  // 83: wgpb.DataSourceConfiguration.customNatsKv:type_name -> wgpb.DataSourceCustom_NatsKv
+	62,  // 84: wgpb.DataSourceConfiguration.customWebhookEvents:type_name -> wgpb.DataSourceCustom_WebhookEvents
+	48,  // 85: wgpb.DataSourceConfiguration.originRequestExpressions:type_name -> wgpb.ExpressionHook
+	12,  // 86: wgpb.DataSourceCustom_NatsKv.operation:type_name -> wgpb.NatsKvOperation
+	111, // 87: wgpb.DataSourceCustom_NatsKv.bucketPrefix:type_name -> wgpb.ConfigurationVariable
+	70,  // 88: wgpb.DataSourceCustom_REST.fetch:type_name -> wgpb.FetchConfiguration
+	75,  // 89: wgpb.DataSourceCustom_REST.subscription:type_name -> wgpb.RESTSubscriptionConfiguration
+	64,  // 90: wgpb.DataSourceCustom_REST.statusCodeTypeMappings:type_name -> wgpb.StatusCodeTypeMapping
+	70,  // 91: wgpb.DataSourceCustom_GraphQL.fetch:type_name -> wgpb.FetchConfiguration
+	69,  // 92: wgpb.DataSourceCustom_GraphQL.subscription:type_name -> wgpb.GraphQLSubscriptionConfiguration
+	67,  // 93: wgpb.DataSourceCustom_GraphQL.federation:type_name -> wgpb.GraphQLFederationConfiguration
+	58,  // 94: wgpb.DataSourceCustom_GraphQL.upstreamSchema:type_name -> wgpb.InternedString
+	43,  // 95: wgpb.DataSourceCustom_GraphQL.hooksConfiguration:type_name -> wgpb.GraphQLDataSourceHooksConfiguration
+	81,  // 96: wgpb.DataSourceCustom_GraphQL.customScalarTypeFields:type_name -> wgpb.SingleTypeField
+	111, // 97: wgpb.DataSourceCustom_Database.databaseURL:type_name -> wgpb.ConfigurationVariable
+	58,  // 98: wgpb.DataSourceCustom_Database.prismaSchema:type_name -> wgpb.InternedString
+	58,  // 99: wgpb.DataSourceCustom_Database.graphqlSchema:type_name -> wgpb.InternedString
+	81,  // 100: wgpb.DataSourceCustom_Database.jsonTypeFields:type_name -> wgpb.SingleTypeField
+	111, // 101: wgpb.DataSourceCustom_Static.data:type_name -> wgpb.ConfigurationVariable
+	111, // 102: wgpb.GraphQLSubscriptionConfiguration.url:type_name -> wgpb.ConfigurationVariable
+	111, // 103: wgpb.FetchConfiguration.url:type_name -> wgpb.ConfigurationVariable
+	15,  // 104: wgpb.FetchConfiguration.method:type_name -> wgpb.HTTPMethod
+	119, // 105: wgpb.FetchConfiguration.header:type_name -> wgpb.FetchConfiguration.HeaderEntry
+	111, // 106: wgpb.FetchConfiguration.body:type_name -> wgpb.ConfigurationVariable
+	76,  // 107: wgpb.FetchConfiguration.query:type_name -> wgpb.URLQueryConfiguration
+	72,  // 108: wgpb.FetchConfiguration.upstreamAuthentication:type_name -> wgpb.UpstreamAuthentication
+	71,  // 109: wgpb.FetchConfiguration.mTLS:type_name -> wgpb.MTLSConfiguration
+	111, // 110: wgpb.FetchConfiguration.baseUrl:type_name -> wgpb.ConfigurationVariable
+	111, // 111: wgpb.FetchConfiguration.path:type_name -> wgpb.ConfigurationVariable
+	111, // 112: wgpb.FetchConfiguration.httpProxyUrl:type_name -> wgpb.ConfigurationVariable
+	111, // 113: wgpb.MTLSConfiguration.key:type_name -> wgpb.ConfigurationVariable
+	111, // 114: wgpb.MTLSConfiguration.cert:type_name -> wgpb.ConfigurationVariable
+	13,  // 115: wgpb.UpstreamAuthentication.kind:type_name -> wgpb.UpstreamAuthenticationKind
+	73,  // 116: wgpb.UpstreamAuthentication.jwtConfig:type_name -> wgpb.JwtUpstreamAuthenticationConfig
+	74,  // 117: wgpb.UpstreamAuthentication.jwtWithAccessTokenExchangeConfig:type_name -> wgpb.JwtUpstreamAuthenticationWithAccessTokenExchange
+	111, // 118: wgpb.JwtUpstreamAuthenticationConfig.secret:type_name -> wgpb.ConfigurationVariable
+	14,  // 119: wgpb.JwtUpstreamAuthenticationConfig.signingMethod:type_name -> wgpb.SigningMethod
+	111, // 120: wgpb.JwtUpstreamAuthenticationWithAccessTokenExchange.secret:type_name -> wgpb.ConfigurationVariable
+	14,  // 121: wgpb.JwtUpstreamAuthenticationWithAccessTokenExchange.signingMethod:type_name -> wgpb.SigningMethod
+	111, // 122: wgpb.JwtUpstreamAuthenticationWithAccessTokenExchange.accessTokenExchangeEndpoint:type_name -> wgpb.ConfigurationVariable
+	111, // 123: wgpb.HTTPHeader.values:type_name -> wgpb.ConfigurationVariable
+	82,  // 124: wgpb.FieldConfiguration.argumentsConfiguration:type_name -> wgpb.ArgumentConfiguration
+	16,  // 125: wgpb.ArgumentConfiguration.sourceType:type_name -> wgpb.ArgumentSource
+	17,  // 126: wgpb.ArgumentConfiguration.renderConfiguration:type_name -> wgpb.ArgumentRenderConfiguration
+	89,  // 127: wgpb.WunderGraphConfiguration.api:type_name -> wgpb.UserDefinedApi
+	84,  // 128: wgpb.WunderGraphConfiguration.enabledFeatures:type_name -> wgpb.EnabledFeatures
+	46,  // 129: wgpb.WunderGraphConfiguration.hooks:type_name -> wgpb.Hook
+	85,  // 130: wgpb.S3UploadProfile.hooks:type_name -> wgpb.S3UploadProfileHooksConfiguration
+	87,  // 131: wgpb.S3UploadProfile.imageTransformations:type_name -> wgpb.ImageTransformation
+	18,  // 132: wgpb.ImageTransformation.fit:type_name -> wgpb.ImageFit
+	19,  // 133: wgpb.ImageTransformation.format:type_name -> wgpb.ImageFormat
+	111, // 134: wgpb.S3UploadConfiguration.endpoint:type_name -> wgpb.ConfigurationVariable
+	111, // 135: wgpb.S3UploadConfiguration.accessKeyID:type_name -> wgpb.ConfigurationVariable
+	111, // 136: wgpb.S3UploadConfiguration.secretAccessKey:type_name -> wgpb.ConfigurationVariable
+	111, // 137: wgpb.S3UploadConfiguration.bucketName:type_name -> wgpb.ConfigurationVariable
+	111, // 138: wgpb.S3UploadConfiguration.bucketLocation:type_name -> wgpb.ConfigurationVariable
+	120, // 139: wgpb.S3UploadConfiguration.uploadProfiles:type_name -> wgpb.S3UploadConfiguration.UploadProfilesEntry
+	20,  // 140: wgpb.S3UploadConfiguration.storage:type_name -> wgpb.UploadStorageKind
+	111, // 141: wgpb.S3UploadConfiguration.directory:type_name -> wgpb.ConfigurationVariable
+	111, // 142: wgpb.S3UploadConfiguration.credentialsJSON:type_name -> wgpb.ConfigurationVariable
+	57,  // 143: wgpb.UserDefinedApi.engineConfiguration:type_name -> wgpb.EngineConfiguration
+	38,  // 144: wgpb.UserDefinedApi.operations:type_name -> wgpb.Operation
+	110, // 145: wgpb.UserDefinedApi.corsConfiguration:type_name -> wgpb.CorsConfiguration
+	26,  // 146: wgpb.UserDefinedApi.authenticationConfig:type_name -> wgpb.ApiAuthenticationConfig
+	88,  // 147: wgpb.UserDefinedApi.s3UploadConfiguration:type_name -> wgpb.S3UploadConfiguration
+	111, // 148: wgpb.UserDefinedApi.allowedHostNames:type_name -> wgpb.ConfigurationVariable
+	107, // 149: wgpb.UserDefinedApi.webhooks:type_name -> wgpb.WebhookConfiguration
+	99,  // 150: wgpb.UserDefinedApi.serverOptions:type_name -> wgpb.ServerOptions
+	95,  // 151: wgpb.UserDefinedApi.nodeOptions:type_name -> wgpb.NodeOptions
+	90,  // 152: wgpb.UserDefinedApi.experimentalConfig:type_name -> wgpb.ExperimentalConfiguration
+	108, // 153: wgpb.UserDefinedApi.eventWebhooks:type_name -> wgpb.EventWebhookConfiguration
+	111, // 154: wgpb.ListenerOptions.host:type_name -> wgpb.ConfigurationVariable
+	111, // 155: wgpb.ListenerOptions.port:type_name -> wgpb.ConfigurationVariable
+	111, // 156: wgpb.InternalListenerOptions.port:type_name -> wgpb.ConfigurationVariable
+	111, // 157: wgpb.NodeLogging.level:type_name -> wgpb.ConfigurationVariable
+	111, // 158: wgpb.PrometheusOptions.enabled:type_name -> wgpb.ConfigurationVariable
+	111, // 159: wgpb.PrometheusOptions.port:type_name -> wgpb.ConfigurationVariable
+	111, // 160: wgpb.NodeOptions.nodeUrl:type_name -> wgpb.ConfigurationVariable
+	111, // 161: wgpb.NodeOptions.publicNodeUrl:type_name -> wgpb.ConfigurationVariable
+	91,  // 162: wgpb.NodeOptions.listen:type_name -> wgpb.ListenerOptions
+	93,  // 163: wgpb.NodeOptions.logger:type_name -> wgpb.NodeLogging
+	92,  // 164: wgpb.NodeOptions.listenInternal:type_name -> wgpb.InternalListenerOptions
+	111, // 165: wgpb.NodeOptions.nodeInternalUrl:type_name -> wgpb.ConfigurationVariable
+	111, // 166: wgpb.NodeOptions.defaultHttpProxyUrl:type_name -> wgpb.ConfigurationVariable
+	97,  // 167: wgpb.NodeOptions.openTelemetry:type_name -> wgpb.TelemetryOptions
+	94,  // 168: wgpb.NodeOptions.prometheus:type_name -> wgpb.PrometheusOptions
+	96,  // 169: wgpb.NodeOptions.webhookQueue:type_name -> wgpb.WebhookQueueOptions
+	21,  // 170: wgpb.WebhookQueueOptions.kind:type_name -> wgpb.WebhookQueueKind
+	111, // 171: wgpb.WebhookQueueOptions.directory:type_name -> wgpb.ConfigurationVariable
+	111, // 172: wgpb.WebhookQueueOptions.natsUrl:type_name -> wgpb.ConfigurationVariable
+	111, // 173: wgpb.WebhookQueueOptions.natsToken:type_name -> wgpb.ConfigurationVariable
+	111, // 174: wgpb.TelemetryOptions.enabled:type_name -> wgpb.ConfigurationVariable
+	111, // 175: wgpb.TelemetryOptions.exporterHttpEndpoint:type_name -> wgpb.ConfigurationVariable
+	111, // 176: wgpb.TelemetryOptions.sampler:type_name -> wgpb.ConfigurationVariable
+	111, // 177: wgpb.TelemetryOptions.authToken:type_name -> wgpb.ConfigurationVariable
+	111, // 178: wgpb.ServerLogging.level:type_name -> wgpb.ConfigurationVariable
+	111, // 179: wgpb.ServerOptions.serverUrl:type_name -> wgpb.ConfigurationVariable
+	91,  // 180: wgpb.ServerOptions.listen:type_name -> wgpb.ListenerOptions
+	98,  // 181: wgpb.ServerOptions.logger:type_name -> wgpb.ServerLogging
+	105, // 182: wgpb.ServerOptions.wasmHooks:type_name -> wgpb.WasmHooksOptions
+	104, // 183: wgpb.ServerOptions.grpcHooks:type_name -> wgpb.GRPCHooksOptions
+	102, // 184: wgpb.ServerOptions.serverPool:type_name -> wgpb.HooksServerPoolOptions
+	101, // 185: wgpb.ServerOptions.hookPolicies:type_name -> wgpb.HookPolicy
+	100, // 186: wgpb.ServerOptions.asyncHooks:type_name -> wgpb.AsyncHooksOptions
+	111, // 187: wgpb.AsyncHooksOptions.directory:type_name -> wgpb.ConfigurationVariable
+	22,  // 188: wgpb.HookPolicy.failureMode:type_name -> wgpb.HookFailureMode
+	111, // 189: wgpb.HooksServerPoolOptions.serverUrls:type_name -> wgpb.ConfigurationVariable
+	23,  // 190: wgpb.HooksServerPoolOptions.loadBalancing:type_name -> wgpb.HooksLoadBalancing
+	103, // 191: wgpb.HooksServerPoolOptions.routes:type_name -> wgpb.HooksServerRoute
+	111, // 192: wgpb.HooksServerRoute.serverUrls:type_name -> wgpb.ConfigurationVariable
+	111, // 193: wgpb.GRPCHooksOptions.address:type_name -> wgpb.ConfigurationVariable
+	111, // 194: wgpb.WasmHooksOptions.modulePath:type_name -> wgpb.ConfigurationVariable
+	106, // 195: wgpb.WasmHooksOptions.hookLimits:type_name -> wgpb.WasmHookLimits
+	109, // 196: wgpb.WebhookConfiguration.verifier:type_name -> wgpb.WebhookVerifier
+	111, // 197: wgpb.EventWebhookConfiguration.url:type_name -> wgpb.ConfigurationVariable
+	111, // 198: wgpb.EventWebhookConfiguration.secret:type_name -> wgpb.ConfigurationVariable
+	24,  // 199: wgpb.WebhookVerifier.kind:type_name -> wgpb.WebhookVerifierKind
+	111, // 200: wgpb.WebhookVerifier.secret:type_name -> wgpb.ConfigurationVariable
+	111, // 201: wgpb.WebhookVerifier.additionalSecrets:type_name -> wgpb.ConfigurationVariable
+	111, // 202: wgpb.CorsConfiguration.allowedOrigins:type_name -> wgpb.ConfigurationVariable
+	25,  // 203: wgpb.ConfigurationVariable.kind:type_name -> wgpb.ConfigurationVariableKind
+	113, // 204: wgpb.BuildInfo.sdk:type_name -> wgpb.BuildInfoVersion
+	113, // 205: wgpb.BuildInfo.wunderctl:type_name -> wgpb.BuildInfoVersion
+	113, // 206: wgpb.BuildInfo.node:type_name -> wgpb.BuildInfoVersion
+	114, // 207: wgpb.BuildInfo.os:type_name -> wgpb.BuildInfoOS
+	115, // 208: wgpb.BuildInfo.stats:type_name -> wgpb.BuildInfoStats
+	77,  // 209: wgpb.FetchConfiguration.HeaderEntry.value:type_name -> wgpb.HTTPHeader
+	86,  // 210: wgpb.S3UploadConfiguration.UploadProfilesEntry.value:type_name -> wgpb.S3UploadProfile
+	211, // [211:211] is the sub-list for method output_type
+	211, // [211:211] is the sub-list for method input_type
+	211, // [211:211] is the sub-list for extension type_name
+	211, // [211:211] is the sub-list for extension extendee
+	0,   // [0:211] is the sub-list for field type_name
 }
 
 func init() { file_wundernode_config_proto_init() }
@@ -7763,8 +10365,200 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JwksBasedAuthentication); i {
+		file_wundernode_config_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JwksBasedAuthentication); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wundernode_config_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JwksAuthProvider); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wundernode_config_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiAuthenticationHooks); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wundernode_config_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CookieBasedAuthentication); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wundernode_config_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthProvider); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wundernode_config_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleMappingRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wundernode_config_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GithubAuthProviderConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wundernode_config_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenIDConnectQueryParameter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wundernode_config_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenIDConnectAuthProviderConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wundernode_config_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OAuth2AuthProviderConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wundernode_config_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SAMLAuthProviderConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wundernode_config_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Operation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wundernode_config_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostResolveTransformation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wundernode_config_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostResolveGetTransformation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wundernode_config_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperationVariablesConfiguration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wundernode_config_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VariableInjectionConfiguration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wundernode_config_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GraphQLDataSourceHooksConfiguration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7775,8 +10569,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JwksAuthProvider); i {
+		file_wundernode_config_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeaderMatcher); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7787,8 +10581,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApiAuthenticationHooks); i {
+		file_wundernode_config_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HookMatcher); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7799,8 +10593,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CookieBasedAuthentication); i {
+		file_wundernode_config_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Hook); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7811,8 +10605,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthProvider); i {
+		file_wundernode_config_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperationHooksConfiguration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7823,8 +10617,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GithubAuthProviderConfig); i {
+		file_wundernode_config_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpressionHook); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7835,8 +10629,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpenIDConnectQueryParameter); i {
+		file_wundernode_config_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MockResolveHookConfiguration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7847,8 +10641,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpenIDConnectAuthProviderConfig); i {
+		file_wundernode_config_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperationAuthorizationConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7859,8 +10653,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Operation); i {
+		file_wundernode_config_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperationRoleConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7871,8 +10665,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PostResolveTransformation); i {
+		file_wundernode_config_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomClaim); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7883,8 +10677,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PostResolveGetTransformation); i {
+		file_wundernode_config_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7895,8 +10689,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OperationVariablesConfiguration); i {
+		file_wundernode_config_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperationLiveQueryConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7907,8 +10701,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VariableInjectionConfiguration); i {
+		file_wundernode_config_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperationAuthenticationConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7919,8 +10713,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GraphQLDataSourceHooksConfiguration); i {
+		file_wundernode_config_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperationCacheConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7931,8 +10725,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HookMatcher); i {
+		file_wundernode_config_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EngineConfiguration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7943,8 +10737,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Hook); i {
+		file_wundernode_config_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InternedString); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7955,8 +10749,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OperationHooksConfiguration); i {
+		file_wundernode_config_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataSourceConfiguration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7967,8 +10761,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MockResolveHookConfiguration); i {
+		file_wundernode_config_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DirectiveConfiguration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7979,8 +10773,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OperationAuthorizationConfig); i {
+		file_wundernode_config_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataSourceCustom_NatsKv); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7991,8 +10785,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OperationRoleConfig); i {
+		file_wundernode_config_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataSourceCustom_WebhookEvents); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8003,8 +10797,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CustomClaim); i {
+		file_wundernode_config_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataSourceCustom_REST); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8015,8 +10809,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClaimConfig); i {
+		file_wundernode_config_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusCodeTypeMapping); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8027,8 +10821,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OperationLiveQueryConfig); i {
+		file_wundernode_config_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataSourceCustom_GraphQL); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8039,8 +10833,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OperationAuthenticationConfig); i {
+		file_wundernode_config_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataSourceCustom_Database); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8051,8 +10845,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OperationCacheConfig); i {
+		file_wundernode_config_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GraphQLFederationConfiguration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8063,8 +10857,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EngineConfiguration); i {
+		file_wundernode_config_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataSourceCustom_Static); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8075,8 +10869,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InternedString); i {
+		file_wundernode_config_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GraphQLSubscriptionConfiguration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8087,8 +10881,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DataSourceConfiguration); i {
+		file_wundernode_config_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchConfiguration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8099,8 +10893,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DirectiveConfiguration); i {
+		file_wundernode_config_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MTLSConfiguration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8111,8 +10905,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DataSourceCustom_NatsKv); i {
+		file_wundernode_config_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpstreamAuthentication); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8123,8 +10917,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DataSourceCustom_REST); i {
+		file_wundernode_config_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JwtUpstreamAuthenticationConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8135,8 +10929,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatusCodeTypeMapping); i {
+		file_wundernode_config_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JwtUpstreamAuthenticationWithAccessTokenExchange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8147,8 +10941,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DataSourceCustom_GraphQL); i {
+		file_wundernode_config_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RESTSubscriptionConfiguration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8159,8 +10953,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DataSourceCustom_Database); i {
+		file_wundernode_config_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*URLQueryConfiguration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8171,8 +10965,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GraphQLFederationConfiguration); i {
+		file_wundernode_config_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HTTPHeader); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8183,8 +10977,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DataSourceCustom_Static); i {
+		file_wundernode_config_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TypeConfiguration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8195,8 +10989,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GraphQLSubscriptionConfiguration); i {
+		file_wundernode_config_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldConfiguration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8207,8 +11001,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchConfiguration); i {
+		file_wundernode_config_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TypeField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8219,8 +11013,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MTLSConfiguration); i {
+		file_wundernode_config_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SingleTypeField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8231,8 +11025,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpstreamAuthentication); i {
+		file_wundernode_config_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArgumentConfiguration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8243,8 +11037,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JwtUpstreamAuthenticationConfig); i {
+		file_wundernode_config_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WunderGraphConfiguration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8255,8 +11049,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JwtUpstreamAuthenticationWithAccessTokenExchange); i {
+		file_wundernode_config_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnabledFeatures); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8267,8 +11061,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RESTSubscriptionConfiguration); i {
+		file_wundernode_config_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*S3UploadProfileHooksConfiguration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8279,8 +11073,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*URLQueryConfiguration); i {
+		file_wundernode_config_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*S3UploadProfile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8291,8 +11085,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HTTPHeader); i {
+		file_wundernode_config_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImageTransformation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8303,8 +11097,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TypeConfiguration); i {
+		file_wundernode_config_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*S3UploadConfiguration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8315,8 +11109,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FieldConfiguration); i {
+		file_wundernode_config_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserDefinedApi); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8327,8 +11121,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TypeField); i {
+		file_wundernode_config_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExperimentalConfiguration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8339,8 +11133,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SingleTypeField); i {
+		file_wundernode_config_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListenerOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8351,8 +11145,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArgumentConfiguration); i {
+		file_wundernode_config_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InternalListenerOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8363,8 +11157,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WunderGraphConfiguration); i {
+		file_wundernode_config_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeLogging); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8375,8 +11169,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnabledFeatures); i {
+		file_wundernode_config_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrometheusOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8387,8 +11181,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*S3UploadProfileHooksConfiguration); i {
+		file_wundernode_config_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8399,8 +11193,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*S3UploadProfile); i {
+		file_wundernode_config_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookQueueOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8411,8 +11205,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*S3UploadConfiguration); i {
+		file_wundernode_config_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TelemetryOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8423,8 +11217,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserDefinedApi); i {
+		file_wundernode_config_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerLogging); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8435,8 +11229,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExperimentalConfiguration); i {
+		file_wundernode_config_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8447,8 +11241,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListenerOptions); i {
+		file_wundernode_config_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AsyncHooksOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8459,8 +11253,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InternalListenerOptions); i {
+		file_wundernode_config_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HookPolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8471,8 +11265,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeLogging); i {
+		file_wundernode_config_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HooksServerPoolOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8483,8 +11277,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PrometheusOptions); i {
+		file_wundernode_config_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HooksServerRoute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8495,8 +11289,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeOptions); i {
+		file_wundernode_config_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GRPCHooksOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8507,8 +11301,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TelemetryOptions); i {
+		file_wundernode_config_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WasmHooksOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8519,8 +11313,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerLogging); i {
+		file_wundernode_config_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WasmHookLimits); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8531,8 +11325,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerOptions); i {
+		file_wundernode_config_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookConfiguration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8543,8 +11337,8 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WebhookConfiguration); i {
+		file_wundernode_config_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventWebhookConfiguration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8555,7 +11349,7 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+		file_wundernode_config_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WebhookVerifier); i {
 			case 0:
 				return &v.state
@@ -8567,7 +11361,7 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+		file_wundernode_config_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CorsConfiguration); i {
 			case 0:
 				return &v.state
@@ -8579,7 +11373,7 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+		file_wundernode_config_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ConfigurationVariable); i {
 			case 0:
 				return &v.state
@@ -8591,7 +11385,7 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+		file_wundernode_config_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BuildInfo); i {
 			case 0:
 				return &v.state
@@ -8603,7 +11397,7 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+		file_wundernode_config_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BuildInfoVersion); i {
 			case 0:
 				return &v.state
@@ -8615,7 +11409,7 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+		file_wundernode_config_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BuildInfoOS); i {
 			case 0:
 				return &v.state
@@ -8627,7 +11421,7 @@ func file_wundernode_config_proto_init() {
 				return nil
 			}
 		}
-		file_wundernode_config_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+		file_wundernode_config_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BuildInfoStats); i {
 			case 0:
 				return &v.state
@@ -8640,18 +11434,19 @@ func file_wundernode_config_proto_init() {
 			}
 		}
 	}
-	file_wundernode_config_proto_msgTypes[9].OneofWrappers = []interface{}{}
-	file_wundernode_config_proto_msgTypes[15].OneofWrappers = []interface{}{}
-	file_wundernode_config_proto_msgTypes[22].OneofWrappers = []interface{}{}
-	file_wundernode_config_proto_msgTypes[25].OneofWrappers = []interface{}{}
-	file_wundernode_config_proto_msgTypes[38].OneofWrappers = []interface{}{}
+	file_wundernode_config_proto_msgTypes[12].OneofWrappers = []interface{}{}
+	file_wundernode_config_proto_msgTypes[19].OneofWrappers = []interface{}{}
+	file_wundernode_config_proto_msgTypes[27].OneofWrappers = []interface{}{}
+	file_wundernode_config_proto_msgTypes[30].OneofWrappers = []interface{}{}
+	file_wundernode_config_proto_msgTypes[44].OneofWrappers = []interface{}{}
+	file_wundernode_config_proto_msgTypes[75].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_wundernode_config_proto_rawDesc,
-			NumEnums:      18,
-			NumMessages:   77,
+			NumEnums:      26,
+			NumMessages:   95,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
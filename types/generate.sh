@@ -8,11 +8,11 @@ protoc  \
   --go_out=.. \
   --go_opt=module=github.com/wundergraph/wundergraph \
   --go_opt=paths=import \
+  --go-grpc_out=.. \
+  --go-grpc_opt=module=github.com/wundergraph/wundergraph \
+  --go-grpc_opt=paths=import \
   --experimental_allow_proto3_optional \
-  wundernode_config.proto
-
-outfile="../pkg/wgpb/wundernode_config.pb.go"
-tmp="${outfile}.tmp"
+  wundernode_config.proto hooks.proto
 
 # To avoid requiring contributors to use the same exact protoc version, allowing
 # slightly different but compatible versions, remove the version numbers that the
@@ -21,4 +21,7 @@ tmp="${outfile}.tmp"
 # To make supported both Linux and macOS easier, use grep with a regular expression
 # instead of sed commands (grep is not that different between BSD and Linux, while
 # sed has more significant incompatibilities between both OSes).
-grep -v "^//.*protoc.*v" ${outfile} > ${tmp} && mv -f ${tmp} ${outfile} && rm -f ${tmp}
+for outfile in ../pkg/wgpb/wundernode_config.pb.go ../pkg/hookspb/hooks.pb.go ../pkg/hookspb/hooks_grpc.pb.go; do
+  tmp="${outfile}.tmp"
+  grep -v "^//.*protoc.*v" ${outfile} > ${tmp} && mv -f ${tmp} ${outfile} && rm -f ${tmp}
+done